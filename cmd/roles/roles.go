@@ -56,6 +56,7 @@ func init() {
 	Registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	Registry.MustRegister(prometheus.NewGoCollector())
 	metrics.RegisterEtcdMetrics(Registry)
+	metrics.RegisterStorageMetrics(Registry)
 }
 
 func stopRocksmq() {
@@ -17,19 +17,167 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"os"
+	"path"
+	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
+var (
+	remote  = flag.Bool("remote", false, "Read from the object storage configured in milvus.yaml instead of local files")
+	format  = flag.String("format", "text", "Output format: text, json or csv")
+	offset  = flag.Int("offset", 0, "Number of rows to skip")
+	limit   = flag.Int("limit", -1, "Max number of rows to output, negative means no limit")
+	filter  = flag.String("filter", "", "Only output the rows matching <fieldID>=<value>")
+	pkField = flag.Int64("pk", 0, "Field ID of the primary key, required to apply deletes and to diff segments by primary key")
+	deletes = flag.Bool("deletes", true, "Apply the deltalogs of the segment, the deltalog path is the insert log path with insert_log replaced by delta_log")
+)
+
+func usage() {
+	fmt.Fprintf(flag.CommandLine.Output(), `usage:
+  binlog [flags] dump <binlog>...            output the rows of the binlogs, laid out as .../{fieldID}/{logID},
+                                             all the fields of the columnar logs of segment format v2 are output
+  binlog [flags] segment <segment path>      reassemble all the field binlogs of a segment, laid out as {segment path}/{fieldID}/{logID}
+  binlog [flags] diff <segment path> <segment path>
+                                             output the rows added, removed or changed from the first segment to the second one
+  binlog <binlog>...                         print the events of local binlogs
+
+flags:
+`)
+	flag.PrintDefaults()
+}
+
 func main() {
-	if len(os.Args) == 1 {
-		fmt.Println("usage: binlog file1 file2 ...")
+	flag.Usage = usage
+	flag.Parse()
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+		os.Exit(2)
+	}
+	if err := run(context.Background(), args[0], args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err.Error())
+		os.Exit(1)
 	}
-	if err := storage.PrintBinlogFiles(os.Args[1:]); err != nil {
-		fmt.Printf("error: %s\n", err.Error())
-	} else {
+}
+
+func newChunkManager(ctx context.Context) (storage.ChunkManager, error) {
+	if !*remote {
+		return storage.NewLocalChunkManager(), nil
+	}
+	paramtable.Init()
+	return storage.NewChunkManagerFactoryWithParam(paramtable.Get()).NewPersistentStorageChunkManager(ctx)
+}
+
+func run(ctx context.Context, cmd string, args []string) error {
+	var t *table
+	switch cmd {
+	case "dump":
+		if len(args) == 0 {
+			return fmt.Errorf("no binlogs to dump")
+		}
+		cm, err := newChunkManager(ctx)
+		if err != nil {
+			return err
+		}
+		t, err = dump(cm, args)
+		if err != nil {
+			return err
+		}
+	case "segment":
+		if len(args) != 1 {
+			return fmt.Errorf("one segment path expected")
+		}
+		cm, err := newChunkManager(ctx)
+		if err != nil {
+			return err
+		}
+		t, err = loadSegment(ctx, cm, args[0], deltaPath(args[0]), *pkField)
+		if err != nil {
+			return err
+		}
+	case "diff":
+		if len(args) != 2 {
+			return fmt.Errorf("two segment paths expected")
+		}
+		cm, err := newChunkManager(ctx)
+		if err != nil {
+			return err
+		}
+		a, err := loadSegment(ctx, cm, args[0], deltaPath(args[0]), *pkField)
+		if err != nil {
+			return err
+		}
+		b, err := loadSegment(ctx, cm, args[1], deltaPath(args[1]), *pkField)
+		if err != nil {
+			return err
+		}
+		t, err = diffTables(a, b, *pkField)
+		if err != nil {
+			return err
+		}
+	default:
+		// compatible with the old usage: binlog file1 file2 ...
+		if err := storage.PrintBinlogFiles(append([]string{cmd}, args...)); err != nil {
+			return err
+		}
 		fmt.Printf("print binlog complete.\n")
+		return nil
+	}
+
+	filterField, filterValue, err := parseFilter(t)
+	if err != nil {
+		return err
+	}
+	return t.filter(filterField, filterValue, *offset, *limit).write(os.Stdout, *format)
+}
+
+func deltaPath(segmentPath string) string {
+	if !*deletes {
+		return ""
+	}
+	if *pkField <= 0 {
+		fmt.Fprintln(os.Stderr, "warning: deletes are not applied without -pk")
+		return ""
+	}
+	return deltaPathOf(segmentPath)
+}
+
+// dump reads the binlogs into a table, the field ids are parsed from the paths, or read from the columnar logs.
+func dump(cm storage.ChunkManager, files []string) (*table, error) {
+	t := newTable()
+	for _, file := range files {
+		fieldID, err := strconv.ParseInt(path.Base(path.Dir(file)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid binlog path %s, field id expected: %w", file, err)
+		}
+		if err := readBinlogs(cm, t, fieldID, []string{file}); err != nil {
+			return nil, err
+		}
+	}
+	return t, t.check()
+}
+
+func parseFilter(t *table) (int64, string, error) {
+	if *filter == "" {
+		return 0, "", nil
+	}
+	kv := strings.SplitN(*filter, "=", 2)
+	if len(kv) != 2 || kv[1] == "" {
+		return 0, "", fmt.Errorf("invalid filter %s, <fieldID>=<value> expected", *filter)
+	}
+	fieldID, err := strconv.ParseInt(kv[0], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid field id of filter %s: %w", *filter, err)
+	}
+	if _, ok := t.columns[fieldID]; !ok {
+		return 0, "", fmt.Errorf("field %d of filter not found", fieldID)
 	}
+	return fieldID, kv[1], nil
 }
@@ -0,0 +1,239 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+)
+
+const (
+	opAdded   = "added"
+	opRemoved = "removed"
+	opChanged = "changed"
+)
+
+// readBinlogs reads the binlogs of a field into @t, all the fields of a columnar log are read.
+func readBinlogs(cm storage.ChunkManager, t *table, fieldID int64, files []string) error {
+	bf, err := importutil.NewBinlogFile(cm)
+	if err != nil {
+		return err
+	}
+	defer bf.Close()
+	for _, file := range files {
+		if err := bf.Open(file); err != nil {
+			return err
+		}
+		if !bf.IsColumnar() {
+			if err := readField(bf, t, fieldID, file); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, columnarFieldID := range bf.FieldIDs() {
+			if err := bf.SelectField(columnarFieldID); err != nil {
+				return err
+			}
+			if err := readField(bf, t, columnarFieldID, file); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readField appends the values of the field read by @bf to the column of @fieldID.
+func readField(bf *importutil.BinlogFile, t *table, fieldID int64, file string) error {
+	values, err := readColumn(bf)
+	if err != nil {
+		return fmt.Errorf("failed to read field %d of %s: %w", fieldID, file, err)
+	}
+	return t.appendColumn(fieldID, bf.DataType(), values)
+}
+
+// listSegmentLogs lists the logs under @segmentPath, which is laid out as {segmentPath}/{fieldID}/{logID},
+// and returns them grouped by field id, the logs of a field are sorted by log id.
+func listSegmentLogs(ctx context.Context, cm storage.ChunkManager, segmentPath string) (map[int64][]string, error) {
+	files, _, err := cm.ListWithPrefix(ctx, strings.TrimSuffix(segmentPath, "/")+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	logs := make(map[int64][]string)
+	for _, file := range files {
+		fieldID, err := strconv.ParseInt(path.Base(path.Dir(file)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log path %s, field id expected: %w", file, err)
+		}
+		logs[fieldID] = append(logs[fieldID], file)
+	}
+	for _, files := range logs {
+		sort.Slice(files, func(i, j int) bool {
+			li, _ := strconv.ParseInt(path.Base(files[i]), 10, 64)
+			lj, _ := strconv.ParseInt(path.Base(files[j]), 10, 64)
+			return li < lj
+		})
+	}
+	return logs, nil
+}
+
+// deltaPathOf returns the deltalog path of the segment with insert log path @segmentPath.
+func deltaPathOf(segmentPath string) string {
+	return strings.Replace(segmentPath, "insert_log", "delta_log", 1)
+}
+
+// loadSegment reassembles all the field binlogs of the segment at @segmentPath into a table. If @pkField is
+// positive, the deletes in the deltalogs at @deltaPath are applied, a row is deleted if its primary key is
+// deleted at or after its timestamp.
+func loadSegment(ctx context.Context, cm storage.ChunkManager, segmentPath string, deltaPath string, pkField int64) (*table, error) {
+	logs, err := listSegmentLogs(ctx, cm, segmentPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) == 0 {
+		return nil, fmt.Errorf("no binlogs found in %s", segmentPath)
+	}
+	t := newTable()
+	for fieldID, files := range logs {
+		if err := readBinlogs(cm, t, fieldID, files); err != nil {
+			return nil, err
+		}
+	}
+	if err := t.check(); err != nil {
+		return nil, err
+	}
+	if pkField <= 0 || deltaPath == "" {
+		return t, nil
+	}
+	if _, ok := t.columns[pkField]; !ok {
+		return nil, fmt.Errorf("primary key field %d not found", pkField)
+	}
+
+	deltaFiles, _, err := cm.ListWithPrefix(ctx, strings.TrimSuffix(deltaPath, "/")+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	deletes := make(map[interface{}]storage.Timestamp)
+	for _, file := range deltaFiles {
+		data, err := cm.Read(ctx, file)
+		if err != nil {
+			return nil, err
+		}
+		_, _, deleteData, err := storage.NewDeleteCodec().Deserialize([]*storage.Blob{{Key: file, Value: data}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		for i, pk := range deleteData.Pks {
+			if ts := deleteData.Tss[i]; ts > deletes[pk.GetValue()] {
+				deletes[pk.GetValue()] = ts
+			}
+		}
+	}
+	if len(deletes) == 0 {
+		return t, nil
+	}
+
+	result := newTable()
+	pks, tss := t.columns[pkField], t.columns[common.TimeStampField]
+	for i := 0; i < t.numRows(); i++ {
+		deleteTs, deleted := deletes[pks[i]]
+		if deleted && (tss == nil || deleteTs >= storage.Timestamp(tss[i].(int64))) {
+			continue
+		}
+		result.appendRow(t, i)
+	}
+	for _, fieldID := range t.fieldIDs {
+		if _, ok := result.types[fieldID]; !ok {
+			result.fieldIDs = append(result.fieldIDs, fieldID)
+			result.types[fieldID] = t.types[fieldID]
+		}
+	}
+	return result, nil
+}
+
+// diffTables returns the rows added, removed or changed from @a to @b, the rows are matched by @pkField,
+// or by row offset if @pkField is not positive. The system fields are not compared.
+func diffTables(a, b *table, pkField int64) (*table, error) {
+	key := func(t *table, i int) (interface{}, error) {
+		if pkField <= 0 {
+			return i, nil
+		}
+		column, ok := t.columns[pkField]
+		if !ok {
+			return nil, fmt.Errorf("primary key field %d not found", pkField)
+		}
+		return column[i], nil
+	}
+	index := func(t *table) (map[interface{}]int, error) {
+		m := make(map[interface{}]int, t.numRows())
+		for i := 0; i < t.numRows(); i++ {
+			k, err := key(t, i)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = i
+		}
+		return m, nil
+	}
+	if !reflect.DeepEqual(a.fieldIDs, b.fieldIDs) {
+		return nil, fmt.Errorf("the segments have different fields %v and %v", a.fieldIDs, b.fieldIDs)
+	}
+	indexA, err := index(a)
+	if err != nil {
+		return nil, err
+	}
+	indexB, err := index(b)
+	if err != nil {
+		return nil, err
+	}
+
+	equal := func(i, j int) bool {
+		for _, fieldID := range a.fieldIDs {
+			if fieldID >= common.StartOfUserFieldID && !reflect.DeepEqual(a.columns[fieldID][i], b.columns[fieldID][j]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	result := newTable()
+	result.extras = []string{"op"}
+	for i := 0; i < a.numRows(); i++ {
+		k, _ := key(a, i)
+		j, ok := indexB[k]
+		if !ok {
+			result.appendRow(a, i, opRemoved)
+		} else if !equal(i, j) {
+			result.appendRow(b, j, opChanged)
+		}
+	}
+	for j := 0; j < b.numRows(); j++ {
+		k, _ := key(b, j)
+		if _, ok := indexA[k]; !ok {
+			result.appendRow(b, j, opAdded)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestLoadColumnarSegment(t *testing.T) {
+	ctx := context.Background()
+	meta := &etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: common.RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: common.TimeStampField, Name: "ts", DataType: schemapb.DataType_Int64},
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "str", DataType: schemapb.DataType_VarChar},
+			},
+		},
+	}
+	data := &storage.InsertData{
+		Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			common.TimeStampField: &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{10, 20}},
+			100:                   &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			101:                   &storage.StringFieldData{NumRows: []int64{2}, Data: []string{"a", "b"}},
+		},
+	}
+	blobs, _, _, err := storage.NewInsertCodec(meta).SerializeColumnar(2, 3, data)
+	require.NoError(t, err)
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	segmentPath := path.Join(cm.RootPath(), "insert_log/1/2/3")
+	require.NoError(t, cm.Write(ctx, path.Join(segmentPath, strconv.Itoa(common.ColumnarLogFieldID), "1"), blobs[0].Value))
+
+	tbl, err := loadSegment(ctx, cm, segmentPath, "", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{common.RowIDField, common.TimeStampField, 100, 101}, tbl.fieldIDs)
+	assert.Equal(t, []interface{}{int64(1), int64(2)}, tbl.columns[100])
+	assert.Equal(t, []interface{}{"a", "b"}, tbl.columns[101])
+}
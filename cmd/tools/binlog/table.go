@@ -0,0 +1,298 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+)
+
+// table holds the rows of binlogs column by column, every column is the data of a field.
+type table struct {
+	fieldIDs []int64
+	types    map[int64]schemapb.DataType
+	columns  map[int64][]interface{}
+	// extra columns which are not fields, such as the operation of a diff, they are output first
+	extras     []string
+	extraCells map[string][]interface{}
+}
+
+func newTable() *table {
+	return &table{
+		types:      make(map[int64]schemapb.DataType),
+		columns:    make(map[int64][]interface{}),
+		extraCells: make(map[string][]interface{}),
+	}
+}
+
+func (t *table) numRows() int {
+	for _, column := range t.columns {
+		return len(column)
+	}
+	return 0
+}
+
+// appendColumn appends @values to the column of @fieldID.
+func (t *table) appendColumn(fieldID int64, dataType schemapb.DataType, values []interface{}) error {
+	if typ, ok := t.types[fieldID]; ok && typ != dataType {
+		return fmt.Errorf("field %d has different data types %s and %s", fieldID, typ, dataType)
+	}
+	if _, ok := t.types[fieldID]; !ok {
+		t.fieldIDs = append(t.fieldIDs, fieldID)
+		sort.Slice(t.fieldIDs, func(i, j int) bool { return t.fieldIDs[i] < t.fieldIDs[j] })
+		t.types[fieldID] = dataType
+	}
+	t.columns[fieldID] = append(t.columns[fieldID], values...)
+	return nil
+}
+
+// check returns an error if the columns have different number of rows.
+func (t *table) check() error {
+	rows := -1
+	for _, fieldID := range t.fieldIDs {
+		if rows >= 0 && len(t.columns[fieldID]) != rows {
+			return fmt.Errorf("field %d has %d rows, %d rows expected", fieldID, len(t.columns[fieldID]), rows)
+		}
+		rows = len(t.columns[fieldID])
+	}
+	return nil
+}
+
+func (t *table) row(i int) []interface{} {
+	row := make([]interface{}, 0, len(t.extras)+len(t.fieldIDs))
+	for _, name := range t.extras {
+		row = append(row, t.extraCells[name][i])
+	}
+	for _, fieldID := range t.fieldIDs {
+		row = append(row, t.columns[fieldID][i])
+	}
+	return row
+}
+
+// appendRow appends a row copied from @src, @extras are the cells of the extra columns.
+func (t *table) appendRow(src *table, i int, extras ...interface{}) {
+	for j, name := range t.extras {
+		t.extraCells[name] = append(t.extraCells[name], extras[j])
+	}
+	for _, fieldID := range src.fieldIDs {
+		t.appendColumn(fieldID, src.types[fieldID], src.columns[fieldID][i:i+1])
+	}
+}
+
+// filter keeps the rows whose @fieldID equals @value, followed by @offset and @limit, @limit < 0 means no limit.
+func (t *table) filter(fieldID int64, value string, offset int, limit int) *table {
+	result := newTable()
+	result.extras = t.extras
+	for i := 0; i < t.numRows(); i++ {
+		if value != "" && formatValue(t.columns[fieldID][i]) != value {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if limit == 0 {
+			break
+		}
+		limit--
+		result.appendRow(t, i, t.row(i)[:len(t.extras)]...)
+	}
+	for _, fieldID := range t.fieldIDs {
+		if _, ok := result.types[fieldID]; !ok {
+			result.fieldIDs = append(result.fieldIDs, fieldID)
+			result.types[fieldID] = t.types[fieldID]
+		}
+	}
+	return result
+}
+
+func (t *table) header() []string {
+	header := append([]string{}, t.extras...)
+	for _, fieldID := range t.fieldIDs {
+		header = append(header, fieldName(fieldID))
+	}
+	return header
+}
+
+func fieldName(fieldID int64) string {
+	switch fieldID {
+	case common.RowIDField:
+		return common.RowIDFieldName
+	case common.TimeStampField:
+		return common.TimeStampFieldName
+	default:
+		return strconv.FormatInt(fieldID, 10)
+	}
+}
+
+// formatValue formats a cell as text, binary vectors are in hex.
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case []byte:
+		return hex.EncodeToString(v)
+	case []float32:
+		bs, _ := json.Marshal(v)
+		return string(bs)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// write outputs the table in @format, which is text, json or csv.
+func (t *table) write(w io.Writer, format string) error {
+	switch format {
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(t.header(), "\t"))
+		for i := 0; i < t.numRows(); i++ {
+			cells := make([]string, 0)
+			for _, v := range t.row(i) {
+				cells = append(cells, formatValue(v))
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+		return tw.Flush()
+	case "csv":
+		cw := csv.NewWriter(w)
+		if err := cw.Write(t.header()); err != nil {
+			return err
+		}
+		for i := 0; i < t.numRows(); i++ {
+			cells := make([]string, 0)
+			for _, v := range t.row(i) {
+				cells = append(cells, formatValue(v))
+			}
+			if err := cw.Write(cells); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case "json":
+		header := t.header()
+		rows := make([]map[string]interface{}, 0, t.numRows())
+		for i := 0; i < t.numRows(); i++ {
+			row := make(map[string]interface{})
+			for j, v := range t.row(i) {
+				if bs, ok := v.([]byte); ok {
+					v = hex.EncodeToString(bs)
+				}
+				row[header[j]] = v
+			}
+			rows = append(rows, row)
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(rows)
+	default:
+		return fmt.Errorf("unknown output format %s", format)
+	}
+}
+
+// readColumn reads all the rows of the opened binlog @bf, a vector is a row.
+func readColumn(bf *importutil.BinlogFile) ([]interface{}, error) {
+	var values []interface{}
+	appendValues := func(n int, get func(i int) interface{}) {
+		for i := 0; i < n; i++ {
+			values = append(values, get(i))
+		}
+	}
+	switch bf.DataType() {
+	case schemapb.DataType_Bool:
+		data, err := bf.ReadBool()
+		if err != nil {
+			return nil, err
+		}
+		appendValues(len(data), func(i int) interface{} { return data[i] })
+	case schemapb.DataType_Int8:
+		data, err := bf.ReadInt8()
+		if err != nil {
+			return nil, err
+		}
+		appendValues(len(data), func(i int) interface{} { return data[i] })
+	case schemapb.DataType_Int16:
+		data, err := bf.ReadInt16()
+		if err != nil {
+			return nil, err
+		}
+		appendValues(len(data), func(i int) interface{} { return data[i] })
+	case schemapb.DataType_Int32:
+		data, err := bf.ReadInt32()
+		if err != nil {
+			return nil, err
+		}
+		appendValues(len(data), func(i int) interface{} { return data[i] })
+	case schemapb.DataType_Int64:
+		data, err := bf.ReadInt64()
+		if err != nil {
+			return nil, err
+		}
+		appendValues(len(data), func(i int) interface{} { return data[i] })
+	case schemapb.DataType_Float:
+		data, err := bf.ReadFloat()
+		if err != nil {
+			return nil, err
+		}
+		appendValues(len(data), func(i int) interface{} { return data[i] })
+	case schemapb.DataType_Double:
+		data, err := bf.ReadDouble()
+		if err != nil {
+			return nil, err
+		}
+		appendValues(len(data), func(i int) interface{} { return data[i] })
+	case schemapb.DataType_VarChar:
+		data, err := bf.ReadVarchar()
+		if err != nil {
+			return nil, err
+		}
+		appendValues(len(data), func(i int) interface{} { return data[i] })
+	case schemapb.DataType_BinaryVector:
+		data, dim, err := bf.ReadBinaryVector()
+		if err != nil {
+			return nil, err
+		}
+		size := dim / 8
+		if size == 0 {
+			return nil, errors.New("invalid dimension of binary vector")
+		}
+		appendValues(len(data)/size, func(i int) interface{} { return data[i*size : (i+1)*size] })
+	case schemapb.DataType_FloatVector:
+		data, dim, err := bf.ReadFloatVector()
+		if err != nil {
+			return nil, err
+		}
+		if dim == 0 {
+			return nil, errors.New("invalid dimension of float vector")
+		}
+		appendValues(len(data)/dim, func(i int) interface{} { return data[i*dim : (i+1)*dim] })
+	default:
+		return nil, fmt.Errorf("unsupported data type %s", bf.DataType())
+	}
+	return values, nil
+}
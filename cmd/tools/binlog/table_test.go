@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+func newTestTable(t *testing.T, pks []int64, values []string) *table {
+	tbl := newTable()
+	var pkValues, strValues, vectors []interface{}
+	for i := range pks {
+		pkValues = append(pkValues, pks[i])
+		strValues = append(strValues, values[i])
+		vectors = append(vectors, []float32{float32(i), 1})
+	}
+	require.NoError(t, tbl.appendColumn(101, schemapb.DataType_VarChar, strValues))
+	require.NoError(t, tbl.appendColumn(100, schemapb.DataType_Int64, pkValues))
+	require.NoError(t, tbl.appendColumn(102, schemapb.DataType_FloatVector, vectors))
+	require.NoError(t, tbl.check())
+	return tbl
+}
+
+func TestTable(t *testing.T) {
+	tbl := newTestTable(t, []int64{1, 2, 3, 4}, []string{"a", "b", "a", "c"})
+	assert.Equal(t, []int64{100, 101, 102}, tbl.fieldIDs)
+	assert.Equal(t, 4, tbl.numRows())
+	assert.Error(t, tbl.appendColumn(100, schemapb.DataType_VarChar, nil))
+	assert.NoError(t, tbl.appendColumn(100, schemapb.DataType_Int64, []interface{}{int64(5)}))
+	assert.Error(t, tbl.check())
+	tbl.columns[100] = tbl.columns[100][:4]
+
+	filtered := tbl.filter(101, "a", 0, -1)
+	assert.Equal(t, []interface{}{int64(1), int64(3)}, filtered.columns[100])
+	filtered = tbl.filter(0, "", 1, 2)
+	assert.Equal(t, []interface{}{int64(2), int64(3)}, filtered.columns[100])
+	filtered = tbl.filter(101, "d", 0, -1)
+	assert.Equal(t, 0, filtered.numRows())
+	assert.Equal(t, []int64{100, 101, 102}, filtered.fieldIDs)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, tbl.filter(0, "", 0, 1).write(buf, "csv"))
+	assert.Equal(t, "100,101,102\n1,a,\"[0,1]\"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, tbl.filter(0, "", 0, 1).write(buf, "json"))
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rows))
+	assert.Equal(t, []map[string]interface{}{{"100": float64(1), "101": "a", "102": []interface{}{float64(0), float64(1)}}}, rows)
+
+	buf.Reset()
+	require.NoError(t, tbl.write(buf, "text"))
+	assert.Equal(t, 5, bytes.Count(buf.Bytes(), []byte("\n")))
+	assert.Error(t, tbl.write(buf, "xml"))
+}
+
+func TestDiffTables(t *testing.T) {
+	a := newTestTable(t, []int64{1, 2, 3}, []string{"a", "b", "c"})
+	b := newTestTable(t, []int64{1, 3, 4}, []string{"a", "d", "e"})
+
+	diff, err := diffTables(a, b, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{opRemoved, opChanged, opAdded}, diff.extraCells["op"])
+	assert.Equal(t, []interface{}{int64(2), int64(3), int64(4)}, diff.columns[100])
+	assert.Equal(t, []interface{}{"b", "d", "e"}, diff.columns[101])
+
+	// matched by row offset
+	diff, err = diffTables(a, b, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{opChanged, opChanged}, diff.extraCells["op"])
+
+	_, err = diffTables(a, b, 200)
+	assert.Error(t, err)
+	c := newTable()
+	require.NoError(t, c.appendColumn(100, schemapb.DataType_Int64, []interface{}{int64(1)}))
+	_, err = diffTables(a, c, 100)
+	assert.Error(t, err)
+}
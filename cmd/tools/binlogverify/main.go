@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"go.uber.org/zap"
+)
+
+var (
+	collectionID = flag.Int64("collection", 0, "Collection ID to filter with")
+	segmentID    = flag.Int64("segment", 0, "Segment ID to filter with")
+)
+
+// binlogverify walks the segments in datacoord meta with the etcd and object storage configured in milvus.yaml,
+// and reports the binlogs, statslogs and deltalogs which are missing or corrupted.
+func main() {
+	flag.Parse()
+	paramtable.Init()
+	params := paramtable.Get()
+	ctx := context.Background()
+
+	etcdCli, err := etcd.GetEtcdClient(
+		params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		params.EtcdCfg.Endpoints.GetAsStrings(),
+		params.EtcdCfg.EtcdTLSCert.GetValue(),
+		params.EtcdCfg.EtcdTLSKey.GetValue(),
+		params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	defer etcdCli.Close()
+
+	cm, err := storage.NewChunkManagerFactoryWithParam(params).NewPersistentStorageChunkManager(ctx)
+	if err != nil {
+		log.Fatal("failed to connect to storage", zap.Error(err))
+	}
+
+	catalog := &datacoord.Catalog{
+		Txn:                  etcdkv.NewEtcdKV(etcdCli, params.EtcdCfg.MetaRootPath.GetValue()),
+		ChunkManagerRootPath: cm.RootPath(),
+	}
+	segments, err := catalog.ListSegments(ctx)
+	if err != nil {
+		log.Fatal("failed to list segments", zap.Error(err))
+	}
+
+	verified, corrupted := 0, 0
+	for _, segment := range segments {
+		// the files of dropped segments are recycled by gc
+		if segment.GetState() == commonpb.SegmentState_Dropped {
+			continue
+		}
+		if *collectionID > 0 && segment.GetCollectionID() != *collectionID {
+			continue
+		}
+		if *segmentID > 0 && segment.GetID() != *segmentID {
+			continue
+		}
+
+		corruptedLogs, err := storage.VerifySegmentLogs(ctx, cm, segment)
+		if err != nil {
+			log.Fatal("failed to verify segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+		}
+		for _, l := range corruptedLogs {
+			fmt.Println(l.String())
+		}
+		verified++
+		corrupted += len(corruptedLogs)
+	}
+
+	fmt.Printf("verified %d segments, %d log files are missing or corrupted\n", verified, corrupted)
+	if corrupted > 0 {
+		os.Exit(1)
+	}
+}
@@ -35,6 +35,10 @@ const (
 	// TimeStampField is the ID of the Timestamp field reserved by the system
 	TimeStampField = 1
 
+	// ColumnarLogFieldID is the ID reserved for the insert logs of segment format v2,
+	// each of which holds all fields of a flush in a single columnar file
+	ColumnarLogFieldID = 99
+
 	// RowIDFieldName defines the name of the RowID field
 	RowIDFieldName = "RowID"
 
@@ -73,6 +77,9 @@ const (
 
 	// SegmentIndexPath storage path const for segment index files.
 	SegmentIndexPath = `index_files`
+
+	// EncryptionKeyPath storage path const for wrapped data encryption keys.
+	EncryptionKeyPath = `encryption_keys`
 )
 
 const (
@@ -82,6 +89,22 @@ const (
 	DimKey         = "dim"
 )
 
+// Field type params key
+
+const (
+	// NullableKey marks a scalar field as nullable when its value is "true"
+	NullableKey = "nullable"
+	// DefaultValueKey is the value of a scalar field used when the field is omitted on insert or import
+	DefaultValueKey = "default_value"
+	// BloomFilterCapacityKey is the max number of pks of a bloom filter block, only works on the primary key field
+	BloomFilterCapacityKey = "bloom_filter_capacity"
+	// BloomFilterFPRKey is the false positive rate of bloom filter blocks, only works on the primary key field
+	BloomFilterFPRKey = "bloom_filter_fpr"
+	// ClusteringKey marks a scalar field as the clustering key of the collection when its value is "true",
+	// clustering compaction splits the segments of a partition into disjoint ranges of this field
+	ClusteringKey = "clustering_key"
+)
+
 //  Collection properties key
 
 const (
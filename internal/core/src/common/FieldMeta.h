@@ -200,6 +200,18 @@ class FieldMeta {
         return type_;
     }
 
+    // nullable scalar field carries the validity of each row beside its data
+    bool
+    is_nullable() const {
+        return nullable_;
+    }
+
+    void
+    set_nullable(bool nullable) {
+        Assert(!is_vector() || !nullable);
+        nullable_ = nullable;
+    }
+
     int64_t
     get_sizeof() const {
         if (is_vector()) {
@@ -224,6 +236,7 @@ class FieldMeta {
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
     std::optional<StringInfo> string_info_;
+    bool nullable_ = false;
 };
 
 }  // namespace milvus
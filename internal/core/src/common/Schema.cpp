@@ -15,6 +15,7 @@
 // limitations under the License.
 
 #include <optional>
+#include <set>
 #include <string>
 #include <boost/lexical_cast.hpp>
 #include <google/protobuf/text_format.h>
@@ -35,6 +36,17 @@ RepeatedKeyValToMap(const google::protobuf::RepeatedPtrField<proto::common::KeyV
     return mapping;
 }
 
+static bool
+IsNullable(const std::map<string, string>& type_map) {
+    auto iter = type_map.find(NULLABLE);
+    if (iter == type_map.end()) {
+        return false;
+    }
+    // keep in line with strconv.ParseBool used by the go side
+    static const std::set<string> true_values = {"1", "t", "T", "TRUE", "true", "True"};
+    return true_values.count(iter->second) > 0;
+}
+
 std::shared_ptr<Schema>
 Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
     auto schema = std::make_shared<Schema>();
@@ -72,9 +84,14 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
             auto type_map = RepeatedKeyValToMap(child.type_params());
             AssertInfo(type_map.count(MAX_LENGTH), "max_length not found");
             auto max_len = boost::lexical_cast<int64_t>(type_map.at(MAX_LENGTH));
-            schema->AddField(name, field_id, data_type, max_len);
+            auto field_meta = FieldMeta(name, field_id, data_type, max_len);
+            field_meta.set_nullable(IsNullable(type_map));
+            schema->AddField(std::move(field_meta));
         } else {
-            schema->AddField(name, field_id, data_type);
+            auto type_map = RepeatedKeyValToMap(child.type_params());
+            auto field_meta = FieldMeta(name, field_id, data_type);
+            field_meta.set_nullable(IsNullable(type_map));
+            schema->AddField(std::move(field_meta));
         }
 
         if (child.is_primary_key()) {
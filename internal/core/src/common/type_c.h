@@ -93,6 +93,7 @@ typedef struct CStorageConfig {
     const char* iam_endpoint;
     bool useSSL;
     bool useIAM;
+    const char* encryption_keys;
 } CStorageConfig;
 
 #ifdef __cplusplus
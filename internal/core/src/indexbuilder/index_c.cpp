@@ -40,6 +40,7 @@ CreateIndex(enum CDataType dtype,
         std::string remote_root_path(c_storage_config.remote_root_path);
         std::string storage_type(c_storage_config.storage_type);
         std::string iam_endpoint(c_storage_config.iam_endpoint);
+        std::string encryption_keys(c_storage_config.encryption_keys);
         auto storage_config = milvus::storage::StorageConfig{address,
                                                              bucket_name,
                                                              access_key,
@@ -48,7 +49,8 @@ CreateIndex(enum CDataType dtype,
                                                              storage_type,
                                                              iam_endpoint,
                                                              c_storage_config.useSSL,
-                                                             c_storage_config.useIAM};
+                                                             c_storage_config.useIAM,
+                                                             encryption_keys};
 
         auto index = milvus::indexbuilder::IndexFactory::GetInstance().CreateIndex(
             dtype, serialized_type_params, serialized_index_params, storage_config);
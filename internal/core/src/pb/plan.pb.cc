@@ -15,13 +15,14 @@
 #include <google/protobuf/wire_format.h>
 // @@protoc_insertion_point(includes)
 #include <google/protobuf/port_def.inc>
-extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<7> scc_info_BinaryArithExpr_plan_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<8> scc_info_ArithCompareExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_BinaryRangeExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_ColumnExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_ColumnInfo_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_CompareExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_GenericValue_plan_2eproto;
+extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_NullExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_QueryInfo_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_TermExpr_plan_2eproto;
 extern PROTOBUF_INTERNAL_EXPORT_plan_2eproto ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_UnaryRangeExpr_plan_2eproto;
@@ -70,6 +71,10 @@ class TermExprDefaultTypeInternal {
  public:
   ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<TermExpr> _instance;
 } _TermExpr_default_instance_;
+class NullExprDefaultTypeInternal {
+ public:
+  ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<NullExpr> _instance;
+} _NullExpr_default_instance_;
 class UnaryExprDefaultTypeInternal {
  public:
   ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<UnaryExpr> _instance;
@@ -86,6 +91,10 @@ class BinaryArithExprDefaultTypeInternal {
  public:
   ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<BinaryArithExpr> _instance;
 } _BinaryArithExpr_default_instance_;
+class ArithCompareExprDefaultTypeInternal {
+ public:
+  ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<ArithCompareExpr> _instance;
+} _ArithCompareExpr_default_instance_;
 class BinaryArithOpEvalRangeExprDefaultTypeInternal {
  public:
   ::PROTOBUF_NAMESPACE_ID::internal::ExplicitlyConstructed<BinaryArithOpEvalRangeExpr> _instance;
@@ -103,6 +112,8 @@ class ExprDefaultTypeInternal {
   const ::milvus::proto::plan::BinaryArithExpr* binary_arith_expr_;
   const ::milvus::proto::plan::ValueExpr* value_expr_;
   const ::milvus::proto::plan::ColumnExpr* column_expr_;
+  const ::milvus::proto::plan::NullExpr* null_expr_;
+  const ::milvus::proto::plan::ArithCompareExpr* arith_compare_expr_;
 } _Expr_default_instance_;
 class VectorANNSDefaultTypeInternal {
  public:
@@ -117,7 +128,7 @@ class PlanNodeDefaultTypeInternal {
 }  // namespace plan
 }  // namespace proto
 }  // namespace milvus
-static void InitDefaultsscc_info_BinaryArithExpr_plan_2eproto() {
+static void InitDefaultsscc_info_ArithCompareExpr_plan_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
 
   {
@@ -135,6 +146,11 @@ static void InitDefaultsscc_info_BinaryArithExpr_plan_2eproto() {
     new (ptr) ::milvus::proto::plan::BinaryArithExpr();
     ::PROTOBUF_NAMESPACE_ID::internal::OnShutdownDestroyMessage(ptr);
   }
+  {
+    void* ptr = &::milvus::proto::plan::_ArithCompareExpr_default_instance_;
+    new (ptr) ::milvus::proto::plan::ArithCompareExpr();
+    ::PROTOBUF_NAMESPACE_ID::internal::OnShutdownDestroyMessage(ptr);
+  }
   {
     void* ptr = &::milvus::proto::plan::_Expr_default_instance_;
     new (ptr) ::milvus::proto::plan::Expr();
@@ -143,18 +159,20 @@ static void InitDefaultsscc_info_BinaryArithExpr_plan_2eproto() {
   ::milvus::proto::plan::UnaryExpr::InitAsDefaultInstance();
   ::milvus::proto::plan::BinaryExpr::InitAsDefaultInstance();
   ::milvus::proto::plan::BinaryArithExpr::InitAsDefaultInstance();
+  ::milvus::proto::plan::ArithCompareExpr::InitAsDefaultInstance();
   ::milvus::proto::plan::Expr::InitAsDefaultInstance();
 }
 
-::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<7> scc_info_BinaryArithExpr_plan_2eproto =
-    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 7, InitDefaultsscc_info_BinaryArithExpr_plan_2eproto}, {
+::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<8> scc_info_ArithCompareExpr_plan_2eproto =
+    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 8, InitDefaultsscc_info_ArithCompareExpr_plan_2eproto}, {
       &scc_info_TermExpr_plan_2eproto.base,
       &scc_info_CompareExpr_plan_2eproto.base,
       &scc_info_UnaryRangeExpr_plan_2eproto.base,
       &scc_info_BinaryRangeExpr_plan_2eproto.base,
       &scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto.base,
       &scc_info_ValueExpr_plan_2eproto.base,
-      &scc_info_ColumnExpr_plan_2eproto.base,}};
+      &scc_info_ColumnExpr_plan_2eproto.base,
+      &scc_info_NullExpr_plan_2eproto.base,}};
 
 static void InitDefaultsscc_info_BinaryArithOp_plan_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
@@ -262,6 +280,21 @@ static void InitDefaultsscc_info_GenericValue_plan_2eproto() {
 ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<0> scc_info_GenericValue_plan_2eproto =
     {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 0, InitDefaultsscc_info_GenericValue_plan_2eproto}, {}};
 
+static void InitDefaultsscc_info_NullExpr_plan_2eproto() {
+  GOOGLE_PROTOBUF_VERIFY_VERSION;
+
+  {
+    void* ptr = &::milvus::proto::plan::_NullExpr_default_instance_;
+    new (ptr) ::milvus::proto::plan::NullExpr();
+    ::PROTOBUF_NAMESPACE_ID::internal::OnShutdownDestroyMessage(ptr);
+  }
+  ::milvus::proto::plan::NullExpr::InitAsDefaultInstance();
+}
+
+::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<1> scc_info_NullExpr_plan_2eproto =
+    {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 1, InitDefaultsscc_info_NullExpr_plan_2eproto}, {
+      &scc_info_ColumnInfo_plan_2eproto.base,}};
+
 static void InitDefaultsscc_info_PlanNode_plan_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
 
@@ -276,7 +309,7 @@ static void InitDefaultsscc_info_PlanNode_plan_2eproto() {
 ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_PlanNode_plan_2eproto =
     {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 2, InitDefaultsscc_info_PlanNode_plan_2eproto}, {
       &scc_info_VectorANNS_plan_2eproto.base,
-      &scc_info_BinaryArithExpr_plan_2eproto.base,}};
+      &scc_info_ArithCompareExpr_plan_2eproto.base,}};
 
 static void InitDefaultsscc_info_QueryInfo_plan_2eproto() {
   GOOGLE_PROTOBUF_VERIFY_VERSION;
@@ -352,11 +385,11 @@ static void InitDefaultsscc_info_VectorANNS_plan_2eproto() {
 
 ::PROTOBUF_NAMESPACE_ID::internal::SCCInfo<2> scc_info_VectorANNS_plan_2eproto =
     {{ATOMIC_VAR_INIT(::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase::kUninitialized), 2, InitDefaultsscc_info_VectorANNS_plan_2eproto}, {
-      &scc_info_BinaryArithExpr_plan_2eproto.base,
+      &scc_info_ArithCompareExpr_plan_2eproto.base,
       &scc_info_QueryInfo_plan_2eproto.base,}};
 
-static ::PROTOBUF_NAMESPACE_ID::Metadata file_level_metadata_plan_2eproto[17];
-static const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* file_level_enum_descriptors_plan_2eproto[4];
+static ::PROTOBUF_NAMESPACE_ID::Metadata file_level_metadata_plan_2eproto[19];
+static const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* file_level_enum_descriptors_plan_2eproto[5];
 static constexpr ::PROTOBUF_NAMESPACE_ID::ServiceDescriptor const** file_level_service_descriptors_plan_2eproto = nullptr;
 
 const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOBUF_SECTION_VARIABLE(protodesc_cold) = {
@@ -434,6 +467,13 @@ const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOB
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::TermExpr, column_info_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::TermExpr, values_),
   ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::NullExpr, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::NullExpr, column_info_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::NullExpr, op_),
+  ~0u,  // no _has_bits_
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::UnaryExpr, _internal_metadata_),
   ~0u,  // no _extensions_
   ~0u,  // no _oneof_case_
@@ -464,6 +504,16 @@ const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOB
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithExpr, left_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithExpr, right_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithExpr, op_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithExpr, data_type_),
+  ~0u,  // no _has_bits_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::ArithCompareExpr, _internal_metadata_),
+  ~0u,  // no _extensions_
+  ~0u,  // no _oneof_case_
+  ~0u,  // no _weak_field_map_
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::ArithCompareExpr, left_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::ArithCompareExpr, right_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::ArithCompareExpr, op_),
+  PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::ArithCompareExpr, data_type_),
   ~0u,  // no _has_bits_
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::BinaryArithOpEvalRangeExpr, _internal_metadata_),
   ~0u,  // no _extensions_
@@ -489,6 +539,8 @@ const ::PROTOBUF_NAMESPACE_ID::uint32 TableStruct_plan_2eproto::offsets[] PROTOB
   offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, binary_arith_expr_),
   offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, value_expr_),
   offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, column_expr_),
+  offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, null_expr_),
+  offsetof(::milvus::proto::plan::ExprDefaultTypeInternal, arith_compare_expr_),
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::Expr, expr_),
   ~0u,  // no _has_bits_
   PROTOBUF_FIELD_OFFSET(::milvus::proto::plan::VectorANNS, _internal_metadata_),
@@ -520,14 +572,16 @@ static const ::PROTOBUF_NAMESPACE_ID::internal::MigrationSchema schemas[] PROTOB
   { 48, -1, sizeof(::milvus::proto::plan::BinaryRangeExpr)},
   { 58, -1, sizeof(::milvus::proto::plan::CompareExpr)},
   { 66, -1, sizeof(::milvus::proto::plan::TermExpr)},
-  { 73, -1, sizeof(::milvus::proto::plan::UnaryExpr)},
-  { 80, -1, sizeof(::milvus::proto::plan::BinaryExpr)},
-  { 88, -1, sizeof(::milvus::proto::plan::BinaryArithOp)},
-  { 96, -1, sizeof(::milvus::proto::plan::BinaryArithExpr)},
-  { 104, -1, sizeof(::milvus::proto::plan::BinaryArithOpEvalRangeExpr)},
-  { 114, -1, sizeof(::milvus::proto::plan::Expr)},
-  { 130, -1, sizeof(::milvus::proto::plan::VectorANNS)},
-  { 140, -1, sizeof(::milvus::proto::plan::PlanNode)},
+  { 73, -1, sizeof(::milvus::proto::plan::NullExpr)},
+  { 80, -1, sizeof(::milvus::proto::plan::UnaryExpr)},
+  { 87, -1, sizeof(::milvus::proto::plan::BinaryExpr)},
+  { 95, -1, sizeof(::milvus::proto::plan::BinaryArithOp)},
+  { 103, -1, sizeof(::milvus::proto::plan::BinaryArithExpr)},
+  { 112, -1, sizeof(::milvus::proto::plan::ArithCompareExpr)},
+  { 121, -1, sizeof(::milvus::proto::plan::BinaryArithOpEvalRangeExpr)},
+  { 131, -1, sizeof(::milvus::proto::plan::Expr)},
+  { 149, -1, sizeof(::milvus::proto::plan::VectorANNS)},
+  { 159, -1, sizeof(::milvus::proto::plan::PlanNode)},
 };
 
 static ::PROTOBUF_NAMESPACE_ID::Message const * const file_default_instances[] = {
@@ -540,10 +594,12 @@ static ::PROTOBUF_NAMESPACE_ID::Message const * const file_default_instances[] =
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_BinaryRangeExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_CompareExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_TermExpr_default_instance_),
+  reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_NullExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_UnaryExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_BinaryExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_BinaryArithOp_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_BinaryArithExpr_default_instance_),
+  reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_ArithCompareExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_BinaryArithOpEvalRangeExpr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_Expr_default_instance_),
   reinterpret_cast<const ::PROTOBUF_NAMESPACE_ID::Message*>(&::milvus::proto::plan::_VectorANNS_default_instance_),
@@ -580,67 +636,80 @@ const char descriptor_table_protodef_plan_2eproto[] PROTOBUF_SECTION_VARIABLE(pr
   "an.OpType\"o\n\010TermExpr\0222\n\013column_info\030\001 \001"
   "(\0132\035.milvus.proto.plan.ColumnInfo\022/\n\006val"
   "ues\030\002 \003(\0132\037.milvus.proto.plan.GenericVal"
-  "ue\"\206\001\n\tUnaryExpr\0220\n\002op\030\001 \001(\0162$.milvus.pr"
-  "oto.plan.UnaryExpr.UnaryOp\022&\n\005child\030\002 \001("
-  "\0132\027.milvus.proto.plan.Expr\"\037\n\007UnaryOp\022\013\n"
-  "\007Invalid\020\000\022\007\n\003Not\020\001\"\307\001\n\nBinaryExpr\0222\n\002op"
-  "\030\001 \001(\0162&.milvus.proto.plan.BinaryExpr.Bi"
-  "naryOp\022%\n\004left\030\002 \001(\0132\027.milvus.proto.plan"
-  ".Expr\022&\n\005right\030\003 \001(\0132\027.milvus.proto.plan"
-  ".Expr\"6\n\010BinaryOp\022\013\n\007Invalid\020\000\022\016\n\nLogica"
-  "lAnd\020\001\022\r\n\tLogicalOr\020\002\"\255\001\n\rBinaryArithOp\022"
-  "2\n\013column_info\030\001 \001(\0132\035.milvus.proto.plan"
-  ".ColumnInfo\0220\n\010arith_op\030\002 \001(\0162\036.milvus.p"
-  "roto.plan.ArithOpType\0226\n\rright_operand\030\003"
-  " \001(\0132\037.milvus.proto.plan.GenericValue\"\214\001"
-  "\n\017BinaryArithExpr\022%\n\004left\030\001 \001(\0132\027.milvus"
-  ".proto.plan.Expr\022&\n\005right\030\002 \001(\0132\027.milvus"
-  ".proto.plan.Expr\022*\n\002op\030\003 \001(\0162\036.milvus.pr"
-  "oto.plan.ArithOpType\"\221\002\n\032BinaryArithOpEv"
-  "alRangeExpr\0222\n\013column_info\030\001 \001(\0132\035.milvu"
-  "s.proto.plan.ColumnInfo\0220\n\010arith_op\030\002 \001("
-  "\0162\036.milvus.proto.plan.ArithOpType\0226\n\rrig"
-  "ht_operand\030\003 \001(\0132\037.milvus.proto.plan.Gen"
-  "ericValue\022%\n\002op\030\004 \001(\0162\031.milvus.proto.pla"
-  "n.OpType\022.\n\005value\030\005 \001(\0132\037.milvus.proto.p"
-  "lan.GenericValue\"\347\004\n\004Expr\0220\n\tterm_expr\030\001"
-  " \001(\0132\033.milvus.proto.plan.TermExprH\000\0222\n\nu"
-  "nary_expr\030\002 \001(\0132\034.milvus.proto.plan.Unar"
-  "yExprH\000\0224\n\013binary_expr\030\003 \001(\0132\035.milvus.pr"
-  "oto.plan.BinaryExprH\000\0226\n\014compare_expr\030\004 "
-  "\001(\0132\036.milvus.proto.plan.CompareExprH\000\022=\n"
-  "\020unary_range_expr\030\005 \001(\0132!.milvus.proto.p"
-  "lan.UnaryRangeExprH\000\022\?\n\021binary_range_exp"
-  "r\030\006 \001(\0132\".milvus.proto.plan.BinaryRangeE"
-  "xprH\000\022X\n\037binary_arith_op_eval_range_expr"
-  "\030\007 \001(\0132-.milvus.proto.plan.BinaryArithOp"
-  "EvalRangeExprH\000\022\?\n\021binary_arith_expr\030\010 \001"
-  "(\0132\".milvus.proto.plan.BinaryArithExprH\000"
-  "\0222\n\nvalue_expr\030\t \001(\0132\034.milvus.proto.plan"
-  ".ValueExprH\000\0224\n\013column_expr\030\n \001(\0132\035.milv"
-  "us.proto.plan.ColumnExprH\000B\006\n\004expr\"\251\001\n\nV"
-  "ectorANNS\022\021\n\tis_binary\030\001 \001(\010\022\020\n\010field_id"
-  "\030\002 \001(\003\022+\n\npredicates\030\003 \001(\0132\027.milvus.prot"
-  "o.plan.Expr\0220\n\nquery_info\030\004 \001(\0132\034.milvus"
-  ".proto.plan.QueryInfo\022\027\n\017placeholder_tag"
-  "\030\005 \001(\t\"\221\001\n\010PlanNode\0224\n\013vector_anns\030\001 \001(\013"
-  "2\035.milvus.proto.plan.VectorANNSH\000\022-\n\npre"
-  "dicates\030\002 \001(\0132\027.milvus.proto.plan.ExprH\000"
-  "\022\030\n\020output_field_ids\030\003 \003(\003B\006\n\004node*\272\001\n\006O"
-  "pType\022\013\n\007Invalid\020\000\022\017\n\013GreaterThan\020\001\022\020\n\014G"
-  "reaterEqual\020\002\022\014\n\010LessThan\020\003\022\r\n\tLessEqual"
-  "\020\004\022\t\n\005Equal\020\005\022\014\n\010NotEqual\020\006\022\017\n\013PrefixMat"
-  "ch\020\007\022\020\n\014PostfixMatch\020\010\022\t\n\005Match\020\t\022\t\n\005Ran"
-  "ge\020\n\022\006\n\002In\020\013\022\t\n\005NotIn\020\014*G\n\013ArithOpType\022\013"
-  "\n\007Unknown\020\000\022\007\n\003Add\020\001\022\007\n\003Sub\020\002\022\007\n\003Mul\020\003\022\007"
-  "\n\003Div\020\004\022\007\n\003Mod\020\005B3Z1github.com/milvus-io"
-  "/milvus/internal/proto/planpbb\006proto3"
+  "ue\"\240\001\n\010NullExpr\0222\n\013column_info\030\001 \001(\0132\035.m"
+  "ilvus.proto.plan.ColumnInfo\022.\n\002op\030\002 \001(\0162"
+  "\".milvus.proto.plan.NullExpr.NullOp\"0\n\006N"
+  "ullOp\022\013\n\007Invalid\020\000\022\n\n\006IsNull\020\001\022\r\n\tIsNotN"
+  "ull\020\002\"\206\001\n\tUnaryExpr\0220\n\002op\030\001 \001(\0162$.milvus"
+  ".proto.plan.UnaryExpr.UnaryOp\022&\n\005child\030\002"
+  " \001(\0132\027.milvus.proto.plan.Expr\"\037\n\007UnaryOp"
+  "\022\013\n\007Invalid\020\000\022\007\n\003Not\020\001\"\307\001\n\nBinaryExpr\0222\n"
+  "\002op\030\001 \001(\0162&.milvus.proto.plan.BinaryExpr"
+  ".BinaryOp\022%\n\004left\030\002 \001(\0132\027.milvus.proto.p"
+  "lan.Expr\022&\n\005right\030\003 \001(\0132\027.milvus.proto.p"
+  "lan.Expr\"6\n\010BinaryOp\022\013\n\007Invalid\020\000\022\016\n\nLog"
+  "icalAnd\020\001\022\r\n\tLogicalOr\020\002\"\255\001\n\rBinaryArith"
+  "Op\0222\n\013column_info\030\001 \001(\0132\035.milvus.proto.p"
+  "lan.ColumnInfo\0220\n\010arith_op\030\002 \001(\0162\036.milvu"
+  "s.proto.plan.ArithOpType\0226\n\rright_operan"
+  "d\030\003 \001(\0132\037.milvus.proto.plan.GenericValue"
+  "\"\276\001\n\017BinaryArithExpr\022%\n\004left\030\001 \001(\0132\027.mil"
+  "vus.proto.plan.Expr\022&\n\005right\030\002 \001(\0132\027.mil"
+  "vus.proto.plan.Expr\022*\n\002op\030\003 \001(\0162\036.milvus"
+  ".proto.plan.ArithOpType\0220\n\tdata_type\030\004 \001"
+  "(\0162\035.milvus.proto.schema.DataType\"\272\001\n\020Ar"
+  "ithCompareExpr\022%\n\004left\030\001 \001(\0132\027.milvus.pr"
+  "oto.plan.Expr\022&\n\005right\030\002 \001(\0132\027.milvus.pr"
+  "oto.plan.Expr\022%\n\002op\030\003 \001(\0162\031.milvus.proto"
+  ".plan.OpType\0220\n\tdata_type\030\004 \001(\0162\035.milvus"
+  ".proto.schema.DataType\"\221\002\n\032BinaryArithOp"
+  "EvalRangeExpr\0222\n\013column_info\030\001 \001(\0132\035.mil"
+  "vus.proto.plan.ColumnInfo\0220\n\010arith_op\030\002 "
+  "\001(\0162\036.milvus.proto.plan.ArithOpType\0226\n\rr"
+  "ight_operand\030\003 \001(\0132\037.milvus.proto.plan.G"
+  "enericValue\022%\n\002op\030\004 \001(\0162\031.milvus.proto.p"
+  "lan.OpType\022.\n\005value\030\005 \001(\0132\037.milvus.proto"
+  ".plan.GenericValue\"\334\005\n\004Expr\0220\n\tterm_expr"
+  "\030\001 \001(\0132\033.milvus.proto.plan.TermExprH\000\0222\n"
+  "\nunary_expr\030\002 \001(\0132\034.milvus.proto.plan.Un"
+  "aryExprH\000\0224\n\013binary_expr\030\003 \001(\0132\035.milvus."
+  "proto.plan.BinaryExprH\000\0226\n\014compare_expr\030"
+  "\004 \001(\0132\036.milvus.proto.plan.CompareExprH\000\022"
+  "=\n\020unary_range_expr\030\005 \001(\0132!.milvus.proto"
+  ".plan.UnaryRangeExprH\000\022\?\n\021binary_range_e"
+  "xpr\030\006 \001(\0132\".milvus.proto.plan.BinaryRang"
+  "eExprH\000\022X\n\037binary_arith_op_eval_range_ex"
+  "pr\030\007 \001(\0132-.milvus.proto.plan.BinaryArith"
+  "OpEvalRangeExprH\000\022\?\n\021binary_arith_expr\030\010"
+  " \001(\0132\".milvus.proto.plan.BinaryArithExpr"
+  "H\000\0222\n\nvalue_expr\030\t \001(\0132\034.milvus.proto.pl"
+  "an.ValueExprH\000\0224\n\013column_expr\030\n \001(\0132\035.mi"
+  "lvus.proto.plan.ColumnExprH\000\0220\n\tnull_exp"
+  "r\030\013 \001(\0132\033.milvus.proto.plan.NullExprH\000\022A"
+  "\n\022arith_compare_expr\030\014 \001(\0132#.milvus.prot"
+  "o.plan.ArithCompareExprH\000B\006\n\004expr\"\251\001\n\nVe"
+  "ctorANNS\022\021\n\tis_binary\030\001 \001(\010\022\020\n\010field_id\030"
+  "\002 \001(\003\022+\n\npredicates\030\003 \001(\0132\027.milvus.proto"
+  ".plan.Expr\0220\n\nquery_info\030\004 \001(\0132\034.milvus."
+  "proto.plan.QueryInfo\022\027\n\017placeholder_tag\030"
+  "\005 \001(\t\"\221\001\n\010PlanNode\0224\n\013vector_anns\030\001 \001(\0132"
+  "\035.milvus.proto.plan.VectorANNSH\000\022-\n\npred"
+  "icates\030\002 \001(\0132\027.milvus.proto.plan.ExprH\000\022"
+  "\030\n\020output_field_ids\030\003 \003(\003B\006\n\004node*\272\001\n\006Op"
+  "Type\022\013\n\007Invalid\020\000\022\017\n\013GreaterThan\020\001\022\020\n\014Gr"
+  "eaterEqual\020\002\022\014\n\010LessThan\020\003\022\r\n\tLessEqual\020"
+  "\004\022\t\n\005Equal\020\005\022\014\n\010NotEqual\020\006\022\017\n\013PrefixMatc"
+  "h\020\007\022\020\n\014PostfixMatch\020\010\022\t\n\005Match\020\t\022\t\n\005Rang"
+  "e\020\n\022\006\n\002In\020\013\022\t\n\005NotIn\020\014*G\n\013ArithOpType\022\013\n"
+  "\007Unknown\020\000\022\007\n\003Add\020\001\022\007\n\003Sub\020\002\022\007\n\003Mul\020\003\022\007\n"
+  "\003Div\020\004\022\007\n\003Mod\020\005B3Z1github.com/milvus-io/"
+  "milvus/internal/proto/planpbb\006proto3"
   ;
 static const ::PROTOBUF_NAMESPACE_ID::internal::DescriptorTable*const descriptor_table_plan_2eproto_deps[1] = {
   &::descriptor_table_schema_2eproto,
 };
-static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_plan_2eproto_sccs[14] = {
-  &scc_info_BinaryArithExpr_plan_2eproto.base,
+static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_plan_2eproto_sccs[15] = {
+  &scc_info_ArithCompareExpr_plan_2eproto.base,
   &scc_info_BinaryArithOp_plan_2eproto.base,
   &scc_info_BinaryArithOpEvalRangeExpr_plan_2eproto.base,
   &scc_info_BinaryRangeExpr_plan_2eproto.base,
@@ -648,6 +717,7 @@ static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_pla
   &scc_info_ColumnInfo_plan_2eproto.base,
   &scc_info_CompareExpr_plan_2eproto.base,
   &scc_info_GenericValue_plan_2eproto.base,
+  &scc_info_NullExpr_plan_2eproto.base,
   &scc_info_PlanNode_plan_2eproto.base,
   &scc_info_QueryInfo_plan_2eproto.base,
   &scc_info_TermExpr_plan_2eproto.base,
@@ -658,10 +728,10 @@ static ::PROTOBUF_NAMESPACE_ID::internal::SCCInfoBase*const descriptor_table_pla
 static ::PROTOBUF_NAMESPACE_ID::internal::once_flag descriptor_table_plan_2eproto_once;
 static bool descriptor_table_plan_2eproto_initialized = false;
 const ::PROTOBUF_NAMESPACE_ID::internal::DescriptorTable descriptor_table_plan_2eproto = {
-  &descriptor_table_plan_2eproto_initialized, descriptor_table_protodef_plan_2eproto, "plan.proto", 3357,
-  &descriptor_table_plan_2eproto_once, descriptor_table_plan_2eproto_sccs, descriptor_table_plan_2eproto_deps, 14, 1,
+  &descriptor_table_plan_2eproto_initialized, descriptor_table_protodef_plan_2eproto, "plan.proto", 3876,
+  &descriptor_table_plan_2eproto_once, descriptor_table_plan_2eproto_sccs, descriptor_table_plan_2eproto_deps, 15, 1,
   schemas, file_default_instances, TableStruct_plan_2eproto::offsets,
-  file_level_metadata_plan_2eproto, 17, file_level_enum_descriptors_plan_2eproto, file_level_service_descriptors_plan_2eproto,
+  file_level_metadata_plan_2eproto, 19, file_level_enum_descriptors_plan_2eproto, file_level_service_descriptors_plan_2eproto,
 };
 
 // Force running AddDescriptors() at dynamic initialization time.
@@ -669,10 +739,33 @@ static bool dynamic_init_dummy_plan_2eproto = (  ::PROTOBUF_NAMESPACE_ID::intern
 namespace milvus {
 namespace proto {
 namespace plan {
-const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* UnaryExpr_UnaryOp_descriptor() {
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* NullExpr_NullOp_descriptor() {
   ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
   return file_level_enum_descriptors_plan_2eproto[0];
 }
+bool NullExpr_NullOp_IsValid(int value) {
+  switch (value) {
+    case 0:
+    case 1:
+    case 2:
+      return true;
+    default:
+      return false;
+  }
+}
+
+#if (__cplusplus < 201703) && (!defined(_MSC_VER) || _MSC_VER >= 1900)
+constexpr NullExpr_NullOp NullExpr::Invalid;
+constexpr NullExpr_NullOp NullExpr::IsNull;
+constexpr NullExpr_NullOp NullExpr::IsNotNull;
+constexpr NullExpr_NullOp NullExpr::NullOp_MIN;
+constexpr NullExpr_NullOp NullExpr::NullOp_MAX;
+constexpr int NullExpr::NullOp_ARRAYSIZE;
+#endif  // (__cplusplus < 201703) && (!defined(_MSC_VER) || _MSC_VER >= 1900)
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* UnaryExpr_UnaryOp_descriptor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
+  return file_level_enum_descriptors_plan_2eproto[1];
+}
 bool UnaryExpr_UnaryOp_IsValid(int value) {
   switch (value) {
     case 0:
@@ -692,7 +785,7 @@ constexpr int UnaryExpr::UnaryOp_ARRAYSIZE;
 #endif  // (__cplusplus < 201703) && (!defined(_MSC_VER) || _MSC_VER >= 1900)
 const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* BinaryExpr_BinaryOp_descriptor() {
   ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
-  return file_level_enum_descriptors_plan_2eproto[1];
+  return file_level_enum_descriptors_plan_2eproto[2];
 }
 bool BinaryExpr_BinaryOp_IsValid(int value) {
   switch (value) {
@@ -715,7 +808,7 @@ constexpr int BinaryExpr::BinaryOp_ARRAYSIZE;
 #endif  // (__cplusplus < 201703) && (!defined(_MSC_VER) || _MSC_VER >= 1900)
 const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* OpType_descriptor() {
   ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
-  return file_level_enum_descriptors_plan_2eproto[2];
+  return file_level_enum_descriptors_plan_2eproto[3];
 }
 bool OpType_IsValid(int value) {
   switch (value) {
@@ -740,7 +833,7 @@ bool OpType_IsValid(int value) {
 
 const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* ArithOpType_descriptor() {
   ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&descriptor_table_plan_2eproto);
-  return file_level_enum_descriptors_plan_2eproto[3];
+  return file_level_enum_descriptors_plan_2eproto[4];
 }
 bool ArithOpType_IsValid(int value) {
   switch (value) {
@@ -4081,97 +4174,97 @@ void TermExpr::InternalSwap(TermExpr* other) {
 
 // ===================================================================
 
-void UnaryExpr::InitAsDefaultInstance() {
-  ::milvus::proto::plan::_UnaryExpr_default_instance_._instance.get_mutable()->child_ = const_cast< ::milvus::proto::plan::Expr*>(
-      ::milvus::proto::plan::Expr::internal_default_instance());
+void NullExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_NullExpr_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+      ::milvus::proto::plan::ColumnInfo::internal_default_instance());
 }
-class UnaryExpr::_Internal {
+class NullExpr::_Internal {
  public:
-  static const ::milvus::proto::plan::Expr& child(const UnaryExpr* msg);
+  static const ::milvus::proto::plan::ColumnInfo& column_info(const NullExpr* msg);
 };
 
-const ::milvus::proto::plan::Expr&
-UnaryExpr::_Internal::child(const UnaryExpr* msg) {
-  return *msg->child_;
+const ::milvus::proto::plan::ColumnInfo&
+NullExpr::_Internal::column_info(const NullExpr* msg) {
+  return *msg->column_info_;
 }
-UnaryExpr::UnaryExpr()
+NullExpr::NullExpr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.NullExpr)
 }
-UnaryExpr::UnaryExpr(const UnaryExpr& from)
+NullExpr::NullExpr(const NullExpr& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_child()) {
-    child_ = new ::milvus::proto::plan::Expr(*from.child_);
+  if (from.has_column_info()) {
+    column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
   } else {
-    child_ = nullptr;
+    column_info_ = nullptr;
   }
   op_ = from.op_;
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.NullExpr)
 }
 
-void UnaryExpr::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryArithExpr_plan_2eproto.base);
-  ::memset(&child_, 0, static_cast<size_t>(
+void NullExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_NullExpr_plan_2eproto.base);
+  ::memset(&column_info_, 0, static_cast<size_t>(
       reinterpret_cast<char*>(&op_) -
-      reinterpret_cast<char*>(&child_)) + sizeof(op_));
+      reinterpret_cast<char*>(&column_info_)) + sizeof(op_));
 }
 
-UnaryExpr::~UnaryExpr() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.UnaryExpr)
+NullExpr::~NullExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.NullExpr)
   SharedDtor();
 }
 
-void UnaryExpr::SharedDtor() {
-  if (this != internal_default_instance()) delete child_;
+void NullExpr::SharedDtor() {
+  if (this != internal_default_instance()) delete column_info_;
 }
 
-void UnaryExpr::SetCachedSize(int size) const {
+void NullExpr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const UnaryExpr& UnaryExpr::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryArithExpr_plan_2eproto.base);
+const NullExpr& NullExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_NullExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void UnaryExpr::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.UnaryExpr)
+void NullExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.NullExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == nullptr && child_ != nullptr) {
-    delete child_;
+  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
+    delete column_info_;
   }
-  child_ = nullptr;
+  column_info_ = nullptr;
   op_ = 0;
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* UnaryExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* NullExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
     ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
     CHK_(ptr);
     switch (tag >> 3) {
-      // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
       case 1:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 8)) {
-          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 10)) {
+          ptr = ctx->ParseMessage(mutable_column_info(), ptr);
           CHK_(ptr);
-          set_op(static_cast<::milvus::proto::plan::UnaryExpr_UnaryOp>(val));
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.Expr child = 2;
+      // .milvus.proto.plan.NullExpr.NullOp op = 2;
       case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 18)) {
-          ptr = ctx->ParseMessage(mutable_child(), ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
+          set_op(static_cast<::milvus::proto::plan::NullExpr_NullOp>(val));
         } else goto handle_unusual;
         continue;
       default: {
@@ -4194,35 +4287,35 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool UnaryExpr::MergePartialFromCodedStream(
+bool NullExpr::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.NullExpr)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
       case 1: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (8 & 0xFF)) {
-          int value = 0;
-          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
-                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
-                 input, &value)));
-          set_op(static_cast< ::milvus::proto::plan::UnaryExpr_UnaryOp >(value));
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_column_info()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.plan.Expr child = 2;
+      // .milvus.proto.plan.NullExpr.NullOp op = 2;
       case 2: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (18 & 0xFF)) {
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_child()));
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_op(static_cast< ::milvus::proto::plan::NullExpr_NullOp >(value));
         } else {
           goto handle_unusual;
         }
@@ -4241,69 +4334,69 @@ bool UnaryExpr::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.NullExpr)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.NullExpr)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void UnaryExpr::SerializeWithCachedSizes(
+void NullExpr::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.NullExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
-  if (this->op() != 0) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
-      1, this->op(), output);
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, _Internal::column_info(this), output);
   }
 
-  // .milvus.proto.plan.Expr child = 2;
-  if (this->has_child()) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, _Internal::child(this), output);
+  // .milvus.proto.plan.NullExpr.NullOp op = 2;
+  if (this->op() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      2, this->op(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.NullExpr)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* UnaryExpr::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* NullExpr::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.NullExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
-  if (this->op() != 0) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
-      1, this->op(), target);
-  }
-
-  // .milvus.proto.plan.Expr child = 2;
-  if (this->has_child()) {
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
       InternalWriteMessageToArray(
-        2, _Internal::child(this), target);
+        1, _Internal::column_info(this), target);
+  }
+
+  // .milvus.proto.plan.NullExpr.NullOp op = 2;
+  if (this->op() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      2, this->op(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.NullExpr)
   return target;
 }
 
-size_t UnaryExpr::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.UnaryExpr)
+size_t NullExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.NullExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -4315,14 +4408,14 @@ size_t UnaryExpr::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.Expr child = 2;
-  if (this->has_child()) {
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *child_);
+        *column_info_);
   }
 
-  // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
+  // .milvus.proto.plan.NullExpr.NullOp op = 2;
   if (this->op() != 0) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
@@ -4333,182 +4426,158 @@ size_t UnaryExpr::ByteSizeLong() const {
   return total_size;
 }
 
-void UnaryExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.UnaryExpr)
+void NullExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.NullExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const UnaryExpr* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<UnaryExpr>(
+  const NullExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<NullExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.NullExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.UnaryExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.NullExpr)
     MergeFrom(*source);
   }
 }
 
-void UnaryExpr::MergeFrom(const UnaryExpr& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.UnaryExpr)
+void NullExpr::MergeFrom(const NullExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.NullExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_child()) {
-    mutable_child()->::milvus::proto::plan::Expr::MergeFrom(from.child());
+  if (from.has_column_info()) {
+    mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
   }
   if (from.op() != 0) {
     set_op(from.op());
   }
 }
 
-void UnaryExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.UnaryExpr)
+void NullExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.NullExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void UnaryExpr::CopyFrom(const UnaryExpr& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.UnaryExpr)
+void NullExpr::CopyFrom(const NullExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.NullExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool UnaryExpr::IsInitialized() const {
+bool NullExpr::IsInitialized() const {
   return true;
 }
 
-void UnaryExpr::InternalSwap(UnaryExpr* other) {
+void NullExpr::InternalSwap(NullExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(child_, other->child_);
+  swap(column_info_, other->column_info_);
   swap(op_, other->op_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata UnaryExpr::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata NullExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
 
 // ===================================================================
 
-void BinaryExpr::InitAsDefaultInstance() {
-  ::milvus::proto::plan::_BinaryExpr_default_instance_._instance.get_mutable()->left_ = const_cast< ::milvus::proto::plan::Expr*>(
-      ::milvus::proto::plan::Expr::internal_default_instance());
-  ::milvus::proto::plan::_BinaryExpr_default_instance_._instance.get_mutable()->right_ = const_cast< ::milvus::proto::plan::Expr*>(
+void UnaryExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_UnaryExpr_default_instance_._instance.get_mutable()->child_ = const_cast< ::milvus::proto::plan::Expr*>(
       ::milvus::proto::plan::Expr::internal_default_instance());
 }
-class BinaryExpr::_Internal {
+class UnaryExpr::_Internal {
  public:
-  static const ::milvus::proto::plan::Expr& left(const BinaryExpr* msg);
-  static const ::milvus::proto::plan::Expr& right(const BinaryExpr* msg);
+  static const ::milvus::proto::plan::Expr& child(const UnaryExpr* msg);
 };
 
 const ::milvus::proto::plan::Expr&
-BinaryExpr::_Internal::left(const BinaryExpr* msg) {
-  return *msg->left_;
-}
-const ::milvus::proto::plan::Expr&
-BinaryExpr::_Internal::right(const BinaryExpr* msg) {
-  return *msg->right_;
+UnaryExpr::_Internal::child(const UnaryExpr* msg) {
+  return *msg->child_;
 }
-BinaryExpr::BinaryExpr()
+UnaryExpr::UnaryExpr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.UnaryExpr)
 }
-BinaryExpr::BinaryExpr(const BinaryExpr& from)
+UnaryExpr::UnaryExpr(const UnaryExpr& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_left()) {
-    left_ = new ::milvus::proto::plan::Expr(*from.left_);
-  } else {
-    left_ = nullptr;
-  }
-  if (from.has_right()) {
-    right_ = new ::milvus::proto::plan::Expr(*from.right_);
+  if (from.has_child()) {
+    child_ = new ::milvus::proto::plan::Expr(*from.child_);
   } else {
-    right_ = nullptr;
+    child_ = nullptr;
   }
   op_ = from.op_;
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.UnaryExpr)
 }
 
-void BinaryExpr::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryArithExpr_plan_2eproto.base);
-  ::memset(&left_, 0, static_cast<size_t>(
+void UnaryExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_ArithCompareExpr_plan_2eproto.base);
+  ::memset(&child_, 0, static_cast<size_t>(
       reinterpret_cast<char*>(&op_) -
-      reinterpret_cast<char*>(&left_)) + sizeof(op_));
+      reinterpret_cast<char*>(&child_)) + sizeof(op_));
 }
 
-BinaryExpr::~BinaryExpr() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryExpr)
+UnaryExpr::~UnaryExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.UnaryExpr)
   SharedDtor();
 }
 
-void BinaryExpr::SharedDtor() {
-  if (this != internal_default_instance()) delete left_;
-  if (this != internal_default_instance()) delete right_;
+void UnaryExpr::SharedDtor() {
+  if (this != internal_default_instance()) delete child_;
 }
 
-void BinaryExpr::SetCachedSize(int size) const {
+void UnaryExpr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const BinaryExpr& BinaryExpr::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryArithExpr_plan_2eproto.base);
+const UnaryExpr& UnaryExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_ArithCompareExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void BinaryExpr::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryExpr)
+void UnaryExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.UnaryExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == nullptr && left_ != nullptr) {
-    delete left_;
+  if (GetArenaNoVirtual() == nullptr && child_ != nullptr) {
+    delete child_;
   }
-  left_ = nullptr;
-  if (GetArenaNoVirtual() == nullptr && right_ != nullptr) {
-    delete right_;
-  }
-  right_ = nullptr;
+  child_ = nullptr;
   op_ = 0;
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* BinaryExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* UnaryExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
     ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
     CHK_(ptr);
     switch (tag >> 3) {
-      // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+      // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
       case 1:
         if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 8)) {
           ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
-          set_op(static_cast<::milvus::proto::plan::BinaryExpr_BinaryOp>(val));
+          set_op(static_cast<::milvus::proto::plan::UnaryExpr_UnaryOp>(val));
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.Expr left = 2;
+      // .milvus.proto.plan.Expr child = 2;
       case 2:
         if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 18)) {
-          ptr = ctx->ParseMessage(mutable_left(), ptr);
-          CHK_(ptr);
-        } else goto handle_unusual;
-        continue;
-      // .milvus.proto.plan.Expr right = 3;
-      case 3:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
-          ptr = ctx->ParseMessage(mutable_right(), ptr);
+          ptr = ctx->ParseMessage(mutable_child(), ptr);
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
@@ -4532,46 +4601,35 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool BinaryExpr::MergePartialFromCodedStream(
+bool UnaryExpr::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.UnaryExpr)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+      // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
       case 1: {
         if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (8 & 0xFF)) {
           int value = 0;
           DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
                    int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
                  input, &value)));
-          set_op(static_cast< ::milvus::proto::plan::BinaryExpr_BinaryOp >(value));
+          set_op(static_cast< ::milvus::proto::plan::UnaryExpr_UnaryOp >(value));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.plan.Expr left = 2;
+      // .milvus.proto.plan.Expr child = 2;
       case 2: {
         if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (18 & 0xFF)) {
           DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_left()));
-        } else {
-          goto handle_unusual;
-        }
-        break;
-      }
-
-      // .milvus.proto.plan.Expr right = 3;
-      case 3: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_right()));
+               input, mutable_child()));
         } else {
           goto handle_unusual;
         }
@@ -4590,82 +4648,69 @@ bool BinaryExpr::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.UnaryExpr)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.UnaryExpr)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void BinaryExpr::SerializeWithCachedSizes(
+void UnaryExpr::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.UnaryExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+  // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
   if (this->op() != 0) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
       1, this->op(), output);
   }
 
-  // .milvus.proto.plan.Expr left = 2;
-  if (this->has_left()) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      2, _Internal::left(this), output);
-  }
-
-  // .milvus.proto.plan.Expr right = 3;
-  if (this->has_right()) {
+  // .milvus.proto.plan.Expr child = 2;
+  if (this->has_child()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, _Internal::right(this), output);
+      2, _Internal::child(this), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.UnaryExpr)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* BinaryExpr::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* UnaryExpr::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.UnaryExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+  // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
   if (this->op() != 0) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
       1, this->op(), target);
   }
 
-  // .milvus.proto.plan.Expr left = 2;
-  if (this->has_left()) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
-      InternalWriteMessageToArray(
-        2, _Internal::left(this), target);
-  }
-
-  // .milvus.proto.plan.Expr right = 3;
-  if (this->has_right()) {
+  // .milvus.proto.plan.Expr child = 2;
+  if (this->has_child()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
       InternalWriteMessageToArray(
-        3, _Internal::right(this), target);
+        2, _Internal::child(this), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.UnaryExpr)
   return target;
 }
 
-size_t BinaryExpr::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryExpr)
+size_t UnaryExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.UnaryExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -4677,21 +4722,14 @@ size_t BinaryExpr::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.Expr left = 2;
-  if (this->has_left()) {
-    total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *left_);
-  }
-
-  // .milvus.proto.plan.Expr right = 3;
-  if (this->has_right()) {
+  // .milvus.proto.plan.Expr child = 2;
+  if (this->has_child()) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *right_);
+        *child_);
   }
 
-  // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+  // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
   if (this->op() != 0) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
@@ -4702,187 +4740,941 @@ size_t BinaryExpr::ByteSizeLong() const {
   return total_size;
 }
 
-void BinaryExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryExpr)
+void UnaryExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.UnaryExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const BinaryExpr* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryExpr>(
+  const UnaryExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<UnaryExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.UnaryExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.UnaryExpr)
     MergeFrom(*source);
   }
 }
 
-void BinaryExpr::MergeFrom(const BinaryExpr& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryExpr)
+void UnaryExpr::MergeFrom(const UnaryExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.UnaryExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_left()) {
-    mutable_left()->::milvus::proto::plan::Expr::MergeFrom(from.left());
-  }
-  if (from.has_right()) {
-    mutable_right()->::milvus::proto::plan::Expr::MergeFrom(from.right());
+  if (from.has_child()) {
+    mutable_child()->::milvus::proto::plan::Expr::MergeFrom(from.child());
   }
   if (from.op() != 0) {
     set_op(from.op());
   }
 }
 
-void BinaryExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryExpr)
+void UnaryExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.UnaryExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void BinaryExpr::CopyFrom(const BinaryExpr& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryExpr)
+void UnaryExpr::CopyFrom(const UnaryExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.UnaryExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool BinaryExpr::IsInitialized() const {
+bool UnaryExpr::IsInitialized() const {
   return true;
 }
 
-void BinaryExpr::InternalSwap(BinaryExpr* other) {
+void UnaryExpr::InternalSwap(UnaryExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(left_, other->left_);
-  swap(right_, other->right_);
+  swap(child_, other->child_);
   swap(op_, other->op_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata BinaryExpr::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata UnaryExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
 
 // ===================================================================
 
-void BinaryArithOp::InitAsDefaultInstance() {
-  ::milvus::proto::plan::_BinaryArithOp_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
-      ::milvus::proto::plan::ColumnInfo::internal_default_instance());
-  ::milvus::proto::plan::_BinaryArithOp_default_instance_._instance.get_mutable()->right_operand_ = const_cast< ::milvus::proto::plan::GenericValue*>(
-      ::milvus::proto::plan::GenericValue::internal_default_instance());
+void BinaryExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_BinaryExpr_default_instance_._instance.get_mutable()->left_ = const_cast< ::milvus::proto::plan::Expr*>(
+      ::milvus::proto::plan::Expr::internal_default_instance());
+  ::milvus::proto::plan::_BinaryExpr_default_instance_._instance.get_mutable()->right_ = const_cast< ::milvus::proto::plan::Expr*>(
+      ::milvus::proto::plan::Expr::internal_default_instance());
 }
-class BinaryArithOp::_Internal {
+class BinaryExpr::_Internal {
  public:
-  static const ::milvus::proto::plan::ColumnInfo& column_info(const BinaryArithOp* msg);
-  static const ::milvus::proto::plan::GenericValue& right_operand(const BinaryArithOp* msg);
+  static const ::milvus::proto::plan::Expr& left(const BinaryExpr* msg);
+  static const ::milvus::proto::plan::Expr& right(const BinaryExpr* msg);
 };
 
-const ::milvus::proto::plan::ColumnInfo&
-BinaryArithOp::_Internal::column_info(const BinaryArithOp* msg) {
-  return *msg->column_info_;
+const ::milvus::proto::plan::Expr&
+BinaryExpr::_Internal::left(const BinaryExpr* msg) {
+  return *msg->left_;
 }
-const ::milvus::proto::plan::GenericValue&
-BinaryArithOp::_Internal::right_operand(const BinaryArithOp* msg) {
-  return *msg->right_operand_;
+const ::milvus::proto::plan::Expr&
+BinaryExpr::_Internal::right(const BinaryExpr* msg) {
+  return *msg->right_;
 }
-BinaryArithOp::BinaryArithOp()
+BinaryExpr::BinaryExpr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryExpr)
 }
-BinaryArithOp::BinaryArithOp(const BinaryArithOp& from)
+BinaryExpr::BinaryExpr(const BinaryExpr& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
-  if (from.has_column_info()) {
-    column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
+  if (from.has_left()) {
+    left_ = new ::milvus::proto::plan::Expr(*from.left_);
   } else {
-    column_info_ = nullptr;
+    left_ = nullptr;
   }
-  if (from.has_right_operand()) {
-    right_operand_ = new ::milvus::proto::plan::GenericValue(*from.right_operand_);
+  if (from.has_right()) {
+    right_ = new ::milvus::proto::plan::Expr(*from.right_);
   } else {
-    right_operand_ = nullptr;
+    right_ = nullptr;
   }
-  arith_op_ = from.arith_op_;
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryArithOp)
+  op_ = from.op_;
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryExpr)
 }
 
-void BinaryArithOp::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryArithOp_plan_2eproto.base);
-  ::memset(&column_info_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&arith_op_) -
-      reinterpret_cast<char*>(&column_info_)) + sizeof(arith_op_));
+void BinaryExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_ArithCompareExpr_plan_2eproto.base);
+  ::memset(&left_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&op_) -
+      reinterpret_cast<char*>(&left_)) + sizeof(op_));
 }
 
-BinaryArithOp::~BinaryArithOp() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryArithOp)
+BinaryExpr::~BinaryExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryExpr)
   SharedDtor();
 }
 
-void BinaryArithOp::SharedDtor() {
-  if (this != internal_default_instance()) delete column_info_;
-  if (this != internal_default_instance()) delete right_operand_;
+void BinaryExpr::SharedDtor() {
+  if (this != internal_default_instance()) delete left_;
+  if (this != internal_default_instance()) delete right_;
 }
 
-void BinaryArithOp::SetCachedSize(int size) const {
+void BinaryExpr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const BinaryArithOp& BinaryArithOp::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryArithOp_plan_2eproto.base);
+const BinaryExpr& BinaryExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_ArithCompareExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void BinaryArithOp::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryArithOp)
+void BinaryExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
-    delete column_info_;
+  if (GetArenaNoVirtual() == nullptr && left_ != nullptr) {
+    delete left_;
   }
-  column_info_ = nullptr;
-  if (GetArenaNoVirtual() == nullptr && right_operand_ != nullptr) {
-    delete right_operand_;
+  left_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && right_ != nullptr) {
+    delete right_;
   }
-  right_operand_ = nullptr;
-  arith_op_ = 0;
+  right_ = nullptr;
+  op_ = 0;
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* BinaryArithOp::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* BinaryExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
     ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
     CHK_(ptr);
     switch (tag >> 3) {
-      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
       case 1:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 10)) {
-          ptr = ctx->ParseMessage(mutable_column_info(), ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 8)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
+          set_op(static_cast<::milvus::proto::plan::BinaryExpr_BinaryOp>(val));
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.ArithOpType arith_op = 2;
+      // .milvus.proto.plan.Expr left = 2;
       case 2:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
-          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 18)) {
+          ptr = ctx->ParseMessage(mutable_left(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.Expr right = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
+          ptr = ctx->ParseMessage(mutable_right(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->SetLastTag(tag);
+          goto success;
+        }
+        ptr = UnknownFieldParse(tag, &_internal_metadata_, ptr, ctx);
+        CHK_(ptr != nullptr);
+        continue;
+      }
+    }  // switch
+  }  // while
+success:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto success;
+#undef CHK_
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool BinaryExpr::MergePartialFromCodedStream(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryExpr)
+  for (;;) {
+    ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+      case 1: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (8 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_op(static_cast< ::milvus::proto::plan::BinaryExpr_BinaryOp >(value));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.Expr left = 2;
+      case 2: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_left()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.Expr right = 3;
+      case 3: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_right()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryExpr)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryExpr)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void BinaryExpr::SerializeWithCachedSizes(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+  if (this->op() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      1, this->op(), output);
+  }
+
+  // .milvus.proto.plan.Expr left = 2;
+  if (this->has_left()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, _Internal::left(this), output);
+  }
+
+  // .milvus.proto.plan.Expr right = 3;
+  if (this->has_right()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, _Internal::right(this), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
+        _internal_metadata_.unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryExpr)
+}
+
+::PROTOBUF_NAMESPACE_ID::uint8* BinaryExpr::InternalSerializeWithCachedSizesToArray(
+    ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+  if (this->op() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      1, this->op(), target);
+  }
+
+  // .milvus.proto.plan.Expr left = 2;
+  if (this->has_left()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        2, _Internal::left(this), target);
+  }
+
+  // .milvus.proto.plan.Expr right = 3;
+  if (this->has_right()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        3, _Internal::right(this), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryExpr)
+  return target;
+}
+
+size_t BinaryExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryExpr)
+  size_t total_size = 0;
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::ComputeUnknownFieldsSize(
+        _internal_metadata_.unknown_fields());
+  }
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.Expr left = 2;
+  if (this->has_left()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *left_);
+  }
+
+  // .milvus.proto.plan.Expr right = 3;
+  if (this->has_right()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *right_);
+  }
+
+  // .milvus.proto.plan.BinaryExpr.BinaryOp op = 1;
+  if (this->op() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
+  }
+
+  int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void BinaryExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryExpr)
+  GOOGLE_DCHECK_NE(&from, this);
+  const BinaryExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryExpr>(
+          &from);
+  if (source == nullptr) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryExpr)
+    ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryExpr)
+    MergeFrom(*source);
+  }
+}
+
+void BinaryExpr::MergeFrom(const BinaryExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryExpr)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.has_left()) {
+    mutable_left()->::milvus::proto::plan::Expr::MergeFrom(from.left());
+  }
+  if (from.has_right()) {
+    mutable_right()->::milvus::proto::plan::Expr::MergeFrom(from.right());
+  }
+  if (from.op() != 0) {
+    set_op(from.op());
+  }
+}
+
+void BinaryExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryExpr)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void BinaryExpr::CopyFrom(const BinaryExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryExpr)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool BinaryExpr::IsInitialized() const {
+  return true;
+}
+
+void BinaryExpr::InternalSwap(BinaryExpr* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  swap(left_, other->left_);
+  swap(right_, other->right_);
+  swap(op_, other->op_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata BinaryExpr::GetMetadata() const {
+  return GetMetadataStatic();
+}
+
+
+// ===================================================================
+
+void BinaryArithOp::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_BinaryArithOp_default_instance_._instance.get_mutable()->column_info_ = const_cast< ::milvus::proto::plan::ColumnInfo*>(
+      ::milvus::proto::plan::ColumnInfo::internal_default_instance());
+  ::milvus::proto::plan::_BinaryArithOp_default_instance_._instance.get_mutable()->right_operand_ = const_cast< ::milvus::proto::plan::GenericValue*>(
+      ::milvus::proto::plan::GenericValue::internal_default_instance());
+}
+class BinaryArithOp::_Internal {
+ public:
+  static const ::milvus::proto::plan::ColumnInfo& column_info(const BinaryArithOp* msg);
+  static const ::milvus::proto::plan::GenericValue& right_operand(const BinaryArithOp* msg);
+};
+
+const ::milvus::proto::plan::ColumnInfo&
+BinaryArithOp::_Internal::column_info(const BinaryArithOp* msg) {
+  return *msg->column_info_;
+}
+const ::milvus::proto::plan::GenericValue&
+BinaryArithOp::_Internal::right_operand(const BinaryArithOp* msg) {
+  return *msg->right_operand_;
+}
+BinaryArithOp::BinaryArithOp()
+  : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryArithOp)
+}
+BinaryArithOp::BinaryArithOp(const BinaryArithOp& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_column_info()) {
+    column_info_ = new ::milvus::proto::plan::ColumnInfo(*from.column_info_);
+  } else {
+    column_info_ = nullptr;
+  }
+  if (from.has_right_operand()) {
+    right_operand_ = new ::milvus::proto::plan::GenericValue(*from.right_operand_);
+  } else {
+    right_operand_ = nullptr;
+  }
+  arith_op_ = from.arith_op_;
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryArithOp)
+}
+
+void BinaryArithOp::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryArithOp_plan_2eproto.base);
+  ::memset(&column_info_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&arith_op_) -
+      reinterpret_cast<char*>(&column_info_)) + sizeof(arith_op_));
+}
+
+BinaryArithOp::~BinaryArithOp() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryArithOp)
+  SharedDtor();
+}
+
+void BinaryArithOp::SharedDtor() {
+  if (this != internal_default_instance()) delete column_info_;
+  if (this != internal_default_instance()) delete right_operand_;
+}
+
+void BinaryArithOp::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const BinaryArithOp& BinaryArithOp::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryArithOp_plan_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void BinaryArithOp::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryArithOp)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
+    delete column_info_;
+  }
+  column_info_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && right_operand_ != nullptr) {
+    delete right_operand_;
+  }
+  right_operand_ = nullptr;
+  arith_op_ = 0;
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* BinaryArithOp::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+    ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
+    CHK_(ptr);
+    switch (tag >> 3) {
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 10)) {
+          ptr = ctx->ParseMessage(mutable_column_info(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.ArithOpType arith_op = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 16)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
           set_arith_op(static_cast<::milvus::proto::plan::ArithOpType>(val));
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.GenericValue right_operand = 3;
+      // .milvus.proto.plan.GenericValue right_operand = 3;
+      case 3:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
+          ptr = ctx->ParseMessage(mutable_right_operand(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      default: {
+      handle_unusual:
+        if ((tag & 7) == 4 || tag == 0) {
+          ctx->SetLastTag(tag);
+          goto success;
+        }
+        ptr = UnknownFieldParse(tag, &_internal_metadata_, ptr, ctx);
+        CHK_(ptr != nullptr);
+        continue;
+      }
+    }  // switch
+  }  // while
+success:
+  return ptr;
+failure:
+  ptr = nullptr;
+  goto success;
+#undef CHK_
+}
+#else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+bool BinaryArithOp::MergePartialFromCodedStream(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
+  ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryArithOp)
+  for (;;) {
+    ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      case 1: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (10 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_column_info()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.ArithOpType arith_op = 2;
+      case 2: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_arith_op(static_cast< ::milvus::proto::plan::ArithOpType >(value));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.GenericValue right_operand = 3;
+      case 3: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_right_operand()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0) {
+          goto success;
+        }
+        DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SkipField(
+              input, tag, _internal_metadata_.mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryArithOp)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryArithOp)
+  return false;
+#undef DO_
+}
+#endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+
+void BinaryArithOp::SerializeWithCachedSizes(
+    ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryArithOp)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, _Internal::column_info(this), output);
+  }
+
+  // .milvus.proto.plan.ArithOpType arith_op = 2;
+  if (this->arith_op() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      2, this->arith_op(), output);
+  }
+
+  // .milvus.proto.plan.GenericValue right_operand = 3;
+  if (this->has_right_operand()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, _Internal::right_operand(this), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
+        _internal_metadata_.unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryArithOp)
+}
+
+::PROTOBUF_NAMESPACE_ID::uint8* BinaryArithOp::InternalSerializeWithCachedSizesToArray(
+    ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryArithOp)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        1, _Internal::column_info(this), target);
+  }
+
+  // .milvus.proto.plan.ArithOpType arith_op = 2;
+  if (this->arith_op() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      2, this->arith_op(), target);
+  }
+
+  // .milvus.proto.plan.GenericValue right_operand = 3;
+  if (this->has_right_operand()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        3, _Internal::right_operand(this), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
+        _internal_metadata_.unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryArithOp)
+  return target;
+}
+
+size_t BinaryArithOp::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryArithOp)
+  size_t total_size = 0;
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::ComputeUnknownFieldsSize(
+        _internal_metadata_.unknown_fields());
+  }
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  if (this->has_column_info()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *column_info_);
+  }
+
+  // .milvus.proto.plan.GenericValue right_operand = 3;
+  if (this->has_right_operand()) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+        *right_operand_);
+  }
+
+  // .milvus.proto.plan.ArithOpType arith_op = 2;
+  if (this->arith_op() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->arith_op());
+  }
+
+  int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
+  SetCachedSize(cached_size);
+  return total_size;
+}
+
+void BinaryArithOp::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryArithOp)
+  GOOGLE_DCHECK_NE(&from, this);
+  const BinaryArithOp* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryArithOp>(
+          &from);
+  if (source == nullptr) {
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryArithOp)
+    ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
+  } else {
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryArithOp)
+    MergeFrom(*source);
+  }
+}
+
+void BinaryArithOp::MergeFrom(const BinaryArithOp& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryArithOp)
+  GOOGLE_DCHECK_NE(&from, this);
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  (void) cached_has_bits;
+
+  if (from.has_column_info()) {
+    mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
+  }
+  if (from.has_right_operand()) {
+    mutable_right_operand()->::milvus::proto::plan::GenericValue::MergeFrom(from.right_operand());
+  }
+  if (from.arith_op() != 0) {
+    set_arith_op(from.arith_op());
+  }
+}
+
+void BinaryArithOp::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryArithOp)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void BinaryArithOp::CopyFrom(const BinaryArithOp& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryArithOp)
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool BinaryArithOp::IsInitialized() const {
+  return true;
+}
+
+void BinaryArithOp::InternalSwap(BinaryArithOp* other) {
+  using std::swap;
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  swap(column_info_, other->column_info_);
+  swap(right_operand_, other->right_operand_);
+  swap(arith_op_, other->arith_op_);
+}
+
+::PROTOBUF_NAMESPACE_ID::Metadata BinaryArithOp::GetMetadata() const {
+  return GetMetadataStatic();
+}
+
+
+// ===================================================================
+
+void BinaryArithExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_BinaryArithExpr_default_instance_._instance.get_mutable()->left_ = const_cast< ::milvus::proto::plan::Expr*>(
+      ::milvus::proto::plan::Expr::internal_default_instance());
+  ::milvus::proto::plan::_BinaryArithExpr_default_instance_._instance.get_mutable()->right_ = const_cast< ::milvus::proto::plan::Expr*>(
+      ::milvus::proto::plan::Expr::internal_default_instance());
+}
+class BinaryArithExpr::_Internal {
+ public:
+  static const ::milvus::proto::plan::Expr& left(const BinaryArithExpr* msg);
+  static const ::milvus::proto::plan::Expr& right(const BinaryArithExpr* msg);
+};
+
+const ::milvus::proto::plan::Expr&
+BinaryArithExpr::_Internal::left(const BinaryArithExpr* msg) {
+  return *msg->left_;
+}
+const ::milvus::proto::plan::Expr&
+BinaryArithExpr::_Internal::right(const BinaryArithExpr* msg) {
+  return *msg->right_;
+}
+BinaryArithExpr::BinaryArithExpr()
+  : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryArithExpr)
+}
+BinaryArithExpr::BinaryArithExpr(const BinaryArithExpr& from)
+  : ::PROTOBUF_NAMESPACE_ID::Message(),
+      _internal_metadata_(nullptr) {
+  _internal_metadata_.MergeFrom(from._internal_metadata_);
+  if (from.has_left()) {
+    left_ = new ::milvus::proto::plan::Expr(*from.left_);
+  } else {
+    left_ = nullptr;
+  }
+  if (from.has_right()) {
+    right_ = new ::milvus::proto::plan::Expr(*from.right_);
+  } else {
+    right_ = nullptr;
+  }
+  ::memcpy(&op_, &from.op_,
+    static_cast<size_t>(reinterpret_cast<char*>(&data_type_) -
+    reinterpret_cast<char*>(&op_)) + sizeof(data_type_));
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryArithExpr)
+}
+
+void BinaryArithExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_ArithCompareExpr_plan_2eproto.base);
+  ::memset(&left_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&data_type_) -
+      reinterpret_cast<char*>(&left_)) + sizeof(data_type_));
+}
+
+BinaryArithExpr::~BinaryArithExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryArithExpr)
+  SharedDtor();
+}
+
+void BinaryArithExpr::SharedDtor() {
+  if (this != internal_default_instance()) delete left_;
+  if (this != internal_default_instance()) delete right_;
+}
+
+void BinaryArithExpr::SetCachedSize(int size) const {
+  _cached_size_.Set(size);
+}
+const BinaryArithExpr& BinaryArithExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_ArithCompareExpr_plan_2eproto.base);
+  return *internal_default_instance();
+}
+
+
+void BinaryArithExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryArithExpr)
+  ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
+  // Prevent compiler warnings about cached_has_bits being unused
+  (void) cached_has_bits;
+
+  if (GetArenaNoVirtual() == nullptr && left_ != nullptr) {
+    delete left_;
+  }
+  left_ = nullptr;
+  if (GetArenaNoVirtual() == nullptr && right_ != nullptr) {
+    delete right_;
+  }
+  right_ = nullptr;
+  ::memset(&op_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&data_type_) -
+      reinterpret_cast<char*>(&op_)) + sizeof(data_type_));
+  _internal_metadata_.Clear();
+}
+
+#if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+const char* BinaryArithExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+#define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
+  while (!ctx->Done(&ptr)) {
+    ::PROTOBUF_NAMESPACE_ID::uint32 tag;
+    ptr = ::PROTOBUF_NAMESPACE_ID::internal::ReadTag(ptr, &tag);
+    CHK_(ptr);
+    switch (tag >> 3) {
+      // .milvus.proto.plan.Expr left = 1;
+      case 1:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 10)) {
+          ptr = ctx->ParseMessage(mutable_left(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.Expr right = 2;
+      case 2:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 18)) {
+          ptr = ctx->ParseMessage(mutable_right(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.ArithOpType op = 3;
       case 3:
-        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 26)) {
-          ptr = ctx->ParseMessage(mutable_right_operand(), ptr);
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 24)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+          set_op(static_cast<::milvus::proto::plan::ArithOpType>(val));
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.schema.DataType data_type = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 32)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
+          set_data_type(static_cast<::milvus::proto::schema::DataType>(val));
         } else goto handle_unusual;
         continue;
       default: {
@@ -4905,46 +5697,60 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool BinaryArithOp::MergePartialFromCodedStream(
+bool BinaryArithExpr::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryArithExpr)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::GetTagFieldNumber(tag)) {
-      // .milvus.proto.plan.ColumnInfo column_info = 1;
+      // .milvus.proto.plan.Expr left = 1;
       case 1: {
         if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (10 & 0xFF)) {
           DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_column_info()));
+               input, mutable_left()));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.plan.ArithOpType arith_op = 2;
+      // .milvus.proto.plan.Expr right = 2;
       case 2: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (16 & 0xFF)) {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (18 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_right()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.ArithOpType op = 3;
+      case 3: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (24 & 0xFF)) {
           int value = 0;
           DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
                    int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
                  input, &value)));
-          set_arith_op(static_cast< ::milvus::proto::plan::ArithOpType >(value));
+          set_op(static_cast< ::milvus::proto::plan::ArithOpType >(value));
         } else {
           goto handle_unusual;
         }
         break;
       }
 
-      // .milvus.proto.plan.GenericValue right_operand = 3;
-      case 3: {
-        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (26 & 0xFF)) {
-          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
-               input, mutable_right_operand()));
+      // .milvus.proto.schema.DataType data_type = 4;
+      case 4: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (32 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_data_type(static_cast< ::milvus::proto::schema::DataType >(value));
         } else {
           goto handle_unusual;
         }
@@ -4963,82 +5769,94 @@ bool BinaryArithOp::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryArithExpr)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryArithExpr)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void BinaryArithOp::SerializeWithCachedSizes(
+void BinaryArithExpr::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryArithExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo column_info = 1;
-  if (this->has_column_info()) {
+  // .milvus.proto.plan.Expr left = 1;
+  if (this->has_left()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      1, _Internal::column_info(this), output);
+      1, _Internal::left(this), output);
   }
 
-  // .milvus.proto.plan.ArithOpType arith_op = 2;
-  if (this->arith_op() != 0) {
+  // .milvus.proto.plan.Expr right = 2;
+  if (this->has_right()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, _Internal::right(this), output);
+  }
+
+  // .milvus.proto.plan.ArithOpType op = 3;
+  if (this->op() != 0) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
-      2, this->arith_op(), output);
+      3, this->op(), output);
   }
 
-  // .milvus.proto.plan.GenericValue right_operand = 3;
-  if (this->has_right_operand()) {
-    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
-      3, _Internal::right_operand(this), output);
+  // .milvus.proto.schema.DataType data_type = 4;
+  if (this->data_type() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      4, this->data_type(), output);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryArithExpr)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* BinaryArithOp::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* BinaryArithExpr::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryArithExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo column_info = 1;
-  if (this->has_column_info()) {
+  // .milvus.proto.plan.Expr left = 1;
+  if (this->has_left()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
       InternalWriteMessageToArray(
-        1, _Internal::column_info(this), target);
+        1, _Internal::left(this), target);
   }
 
-  // .milvus.proto.plan.ArithOpType arith_op = 2;
-  if (this->arith_op() != 0) {
+  // .milvus.proto.plan.Expr right = 2;
+  if (this->has_right()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        2, _Internal::right(this), target);
+  }
+
+  // .milvus.proto.plan.ArithOpType op = 3;
+  if (this->op() != 0) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
-      2, this->arith_op(), target);
+      3, this->op(), target);
   }
 
-  // .milvus.proto.plan.GenericValue right_operand = 3;
-  if (this->has_right_operand()) {
-    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
-      InternalWriteMessageToArray(
-        3, _Internal::right_operand(this), target);
+  // .milvus.proto.schema.DataType data_type = 4;
+  if (this->data_type() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      4, this->data_type(), target);
   }
 
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryArithExpr)
   return target;
 }
 
-size_t BinaryArithOp::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryArithOp)
+size_t BinaryArithExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryArithExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -5050,24 +5868,30 @@ size_t BinaryArithOp::ByteSizeLong() const {
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
 
-  // .milvus.proto.plan.ColumnInfo column_info = 1;
-  if (this->has_column_info()) {
+  // .milvus.proto.plan.Expr left = 1;
+  if (this->has_left()) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *column_info_);
+        *left_);
   }
 
-  // .milvus.proto.plan.GenericValue right_operand = 3;
-  if (this->has_right_operand()) {
+  // .milvus.proto.plan.Expr right = 2;
+  if (this->has_right()) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
-        *right_operand_);
+        *right_);
   }
 
-  // .milvus.proto.plan.ArithOpType arith_op = 2;
-  if (this->arith_op() != 0) {
+  // .milvus.proto.plan.ArithOpType op = 3;
+  if (this->op() != 0) {
     total_size += 1 +
-      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->arith_op());
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
+  }
+
+  // .milvus.proto.schema.DataType data_type = 4;
+  if (this->data_type() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->data_type());
   }
 
   int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
@@ -5075,98 +5899,102 @@ size_t BinaryArithOp::ByteSizeLong() const {
   return total_size;
 }
 
-void BinaryArithOp::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryArithOp)
+void BinaryArithExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryArithExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const BinaryArithOp* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryArithOp>(
+  const BinaryArithExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryArithExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryArithExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryArithOp)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryArithExpr)
     MergeFrom(*source);
   }
 }
 
-void BinaryArithOp::MergeFrom(const BinaryArithOp& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryArithOp)
+void BinaryArithExpr::MergeFrom(const BinaryArithExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryArithExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
-  if (from.has_column_info()) {
-    mutable_column_info()->::milvus::proto::plan::ColumnInfo::MergeFrom(from.column_info());
+  if (from.has_left()) {
+    mutable_left()->::milvus::proto::plan::Expr::MergeFrom(from.left());
   }
-  if (from.has_right_operand()) {
-    mutable_right_operand()->::milvus::proto::plan::GenericValue::MergeFrom(from.right_operand());
+  if (from.has_right()) {
+    mutable_right()->::milvus::proto::plan::Expr::MergeFrom(from.right());
   }
-  if (from.arith_op() != 0) {
-    set_arith_op(from.arith_op());
+  if (from.op() != 0) {
+    set_op(from.op());
+  }
+  if (from.data_type() != 0) {
+    set_data_type(from.data_type());
   }
 }
 
-void BinaryArithOp::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryArithOp)
+void BinaryArithExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryArithExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void BinaryArithOp::CopyFrom(const BinaryArithOp& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryArithOp)
+void BinaryArithExpr::CopyFrom(const BinaryArithExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryArithExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool BinaryArithOp::IsInitialized() const {
+bool BinaryArithExpr::IsInitialized() const {
   return true;
 }
 
-void BinaryArithOp::InternalSwap(BinaryArithOp* other) {
+void BinaryArithExpr::InternalSwap(BinaryArithExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
-  swap(column_info_, other->column_info_);
-  swap(right_operand_, other->right_operand_);
-  swap(arith_op_, other->arith_op_);
+  swap(left_, other->left_);
+  swap(right_, other->right_);
+  swap(op_, other->op_);
+  swap(data_type_, other->data_type_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata BinaryArithOp::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata BinaryArithExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
 
 // ===================================================================
 
-void BinaryArithExpr::InitAsDefaultInstance() {
-  ::milvus::proto::plan::_BinaryArithExpr_default_instance_._instance.get_mutable()->left_ = const_cast< ::milvus::proto::plan::Expr*>(
+void ArithCompareExpr::InitAsDefaultInstance() {
+  ::milvus::proto::plan::_ArithCompareExpr_default_instance_._instance.get_mutable()->left_ = const_cast< ::milvus::proto::plan::Expr*>(
       ::milvus::proto::plan::Expr::internal_default_instance());
-  ::milvus::proto::plan::_BinaryArithExpr_default_instance_._instance.get_mutable()->right_ = const_cast< ::milvus::proto::plan::Expr*>(
+  ::milvus::proto::plan::_ArithCompareExpr_default_instance_._instance.get_mutable()->right_ = const_cast< ::milvus::proto::plan::Expr*>(
       ::milvus::proto::plan::Expr::internal_default_instance());
 }
-class BinaryArithExpr::_Internal {
+class ArithCompareExpr::_Internal {
  public:
-  static const ::milvus::proto::plan::Expr& left(const BinaryArithExpr* msg);
-  static const ::milvus::proto::plan::Expr& right(const BinaryArithExpr* msg);
+  static const ::milvus::proto::plan::Expr& left(const ArithCompareExpr* msg);
+  static const ::milvus::proto::plan::Expr& right(const ArithCompareExpr* msg);
 };
 
 const ::milvus::proto::plan::Expr&
-BinaryArithExpr::_Internal::left(const BinaryArithExpr* msg) {
+ArithCompareExpr::_Internal::left(const ArithCompareExpr* msg) {
   return *msg->left_;
 }
 const ::milvus::proto::plan::Expr&
-BinaryArithExpr::_Internal::right(const BinaryArithExpr* msg) {
+ArithCompareExpr::_Internal::right(const ArithCompareExpr* msg) {
   return *msg->right_;
 }
-BinaryArithExpr::BinaryArithExpr()
+ArithCompareExpr::ArithCompareExpr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(constructor:milvus.proto.plan.ArithCompareExpr)
 }
-BinaryArithExpr::BinaryArithExpr(const BinaryArithExpr& from)
+ArithCompareExpr::ArithCompareExpr(const ArithCompareExpr& from)
   : ::PROTOBUF_NAMESPACE_ID::Message(),
       _internal_metadata_(nullptr) {
   _internal_metadata_.MergeFrom(from._internal_metadata_);
@@ -5180,38 +6008,40 @@ BinaryArithExpr::BinaryArithExpr(const BinaryArithExpr& from)
   } else {
     right_ = nullptr;
   }
-  op_ = from.op_;
-  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.BinaryArithExpr)
+  ::memcpy(&op_, &from.op_,
+    static_cast<size_t>(reinterpret_cast<char*>(&data_type_) -
+    reinterpret_cast<char*>(&op_)) + sizeof(data_type_));
+  // @@protoc_insertion_point(copy_constructor:milvus.proto.plan.ArithCompareExpr)
 }
 
-void BinaryArithExpr::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryArithExpr_plan_2eproto.base);
+void ArithCompareExpr::SharedCtor() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_ArithCompareExpr_plan_2eproto.base);
   ::memset(&left_, 0, static_cast<size_t>(
-      reinterpret_cast<char*>(&op_) -
-      reinterpret_cast<char*>(&left_)) + sizeof(op_));
+      reinterpret_cast<char*>(&data_type_) -
+      reinterpret_cast<char*>(&left_)) + sizeof(data_type_));
 }
 
-BinaryArithExpr::~BinaryArithExpr() {
-  // @@protoc_insertion_point(destructor:milvus.proto.plan.BinaryArithExpr)
+ArithCompareExpr::~ArithCompareExpr() {
+  // @@protoc_insertion_point(destructor:milvus.proto.plan.ArithCompareExpr)
   SharedDtor();
 }
 
-void BinaryArithExpr::SharedDtor() {
+void ArithCompareExpr::SharedDtor() {
   if (this != internal_default_instance()) delete left_;
   if (this != internal_default_instance()) delete right_;
 }
 
-void BinaryArithExpr::SetCachedSize(int size) const {
+void ArithCompareExpr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
-const BinaryArithExpr& BinaryArithExpr::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryArithExpr_plan_2eproto.base);
+const ArithCompareExpr& ArithCompareExpr::default_instance() {
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_ArithCompareExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
 
-void BinaryArithExpr::Clear() {
-// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.BinaryArithExpr)
+void ArithCompareExpr::Clear() {
+// @@protoc_insertion_point(message_clear_start:milvus.proto.plan.ArithCompareExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   // Prevent compiler warnings about cached_has_bits being unused
   (void) cached_has_bits;
@@ -5224,12 +6054,14 @@ void BinaryArithExpr::Clear() {
     delete right_;
   }
   right_ = nullptr;
-  op_ = 0;
+  ::memset(&op_, 0, static_cast<size_t>(
+      reinterpret_cast<char*>(&data_type_) -
+      reinterpret_cast<char*>(&op_)) + sizeof(data_type_));
   _internal_metadata_.Clear();
 }
 
 #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-const char* BinaryArithExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
+const char* ArithCompareExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) {
 #define CHK_(x) if (PROTOBUF_PREDICT_FALSE(!(x))) goto failure
   while (!ctx->Done(&ptr)) {
     ::PROTOBUF_NAMESPACE_ID::uint32 tag;
@@ -5250,12 +6082,20 @@ const char* BinaryArithExpr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPAC
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
-      // .milvus.proto.plan.ArithOpType op = 3;
+      // .milvus.proto.plan.OpType op = 3;
       case 3:
         if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 24)) {
           ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
           CHK_(ptr);
-          set_op(static_cast<::milvus::proto::plan::ArithOpType>(val));
+          set_op(static_cast<::milvus::proto::plan::OpType>(val));
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.schema.DataType data_type = 4;
+      case 4:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 32)) {
+          ::PROTOBUF_NAMESPACE_ID::uint64 val = ::PROTOBUF_NAMESPACE_ID::internal::ReadVarint(&ptr);
+          CHK_(ptr);
+          set_data_type(static_cast<::milvus::proto::schema::DataType>(val));
         } else goto handle_unusual;
         continue;
       default: {
@@ -5278,11 +6118,11 @@ failure:
 #undef CHK_
 }
 #else  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
-bool BinaryArithExpr::MergePartialFromCodedStream(
+bool ArithCompareExpr::MergePartialFromCodedStream(
     ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) {
 #define DO_(EXPRESSION) if (!PROTOBUF_PREDICT_TRUE(EXPRESSION)) goto failure
   ::PROTOBUF_NAMESPACE_ID::uint32 tag;
-  // @@protoc_insertion_point(parse_start:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(parse_start:milvus.proto.plan.ArithCompareExpr)
   for (;;) {
     ::std::pair<::PROTOBUF_NAMESPACE_ID::uint32, bool> p = input->ReadTagWithCutoffNoLastTag(127u);
     tag = p.first;
@@ -5310,14 +6150,28 @@ bool BinaryArithExpr::MergePartialFromCodedStream(
         break;
       }
 
-      // .milvus.proto.plan.ArithOpType op = 3;
+      // .milvus.proto.plan.OpType op = 3;
       case 3: {
         if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (24 & 0xFF)) {
           int value = 0;
           DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
                    int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
                  input, &value)));
-          set_op(static_cast< ::milvus::proto::plan::ArithOpType >(value));
+          set_op(static_cast< ::milvus::proto::plan::OpType >(value));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.schema.DataType data_type = 4;
+      case 4: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (32 & 0xFF)) {
+          int value = 0;
+          DO_((::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadPrimitive<
+                   int, ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          set_data_type(static_cast< ::milvus::proto::schema::DataType >(value));
         } else {
           goto handle_unusual;
         }
@@ -5336,18 +6190,18 @@ bool BinaryArithExpr::MergePartialFromCodedStream(
     }
   }
 success:
-  // @@protoc_insertion_point(parse_success:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(parse_success:milvus.proto.plan.ArithCompareExpr)
   return true;
 failure:
-  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(parse_failure:milvus.proto.plan.ArithCompareExpr)
   return false;
 #undef DO_
 }
 #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
 
-void BinaryArithExpr::SerializeWithCachedSizes(
+void ArithCompareExpr::SerializeWithCachedSizes(
     ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const {
-  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(serialize_start:milvus.proto.plan.ArithCompareExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -5363,22 +6217,28 @@ void BinaryArithExpr::SerializeWithCachedSizes(
       2, _Internal::right(this), output);
   }
 
-  // .milvus.proto.plan.ArithOpType op = 3;
+  // .milvus.proto.plan.OpType op = 3;
   if (this->op() != 0) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
       3, this->op(), output);
   }
 
+  // .milvus.proto.schema.DataType data_type = 4;
+  if (this->data_type() != 0) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnum(
+      4, this->data_type(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
   }
-  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(serialize_end:milvus.proto.plan.ArithCompareExpr)
 }
 
-::PROTOBUF_NAMESPACE_ID::uint8* BinaryArithExpr::InternalSerializeWithCachedSizesToArray(
+::PROTOBUF_NAMESPACE_ID::uint8* ArithCompareExpr::InternalSerializeWithCachedSizesToArray(
     ::PROTOBUF_NAMESPACE_ID::uint8* target) const {
-  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(serialize_to_array_start:milvus.proto.plan.ArithCompareExpr)
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
   (void) cached_has_bits;
 
@@ -5396,22 +6256,28 @@ void BinaryArithExpr::SerializeWithCachedSizes(
         2, _Internal::right(this), target);
   }
 
-  // .milvus.proto.plan.ArithOpType op = 3;
+  // .milvus.proto.plan.OpType op = 3;
   if (this->op() != 0) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
       3, this->op(), target);
   }
 
+  // .milvus.proto.schema.DataType data_type = 4;
+  if (this->data_type() != 0) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteEnumToArray(
+      4, this->data_type(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
   }
-  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(serialize_to_array_end:milvus.proto.plan.ArithCompareExpr)
   return target;
 }
 
-size_t BinaryArithExpr::ByteSizeLong() const {
-// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.BinaryArithExpr)
+size_t ArithCompareExpr::ByteSizeLong() const {
+// @@protoc_insertion_point(message_byte_size_start:milvus.proto.plan.ArithCompareExpr)
   size_t total_size = 0;
 
   if (_internal_metadata_.have_unknown_fields()) {
@@ -5437,34 +6303,40 @@ size_t BinaryArithExpr::ByteSizeLong() const {
         *right_);
   }
 
-  // .milvus.proto.plan.ArithOpType op = 3;
+  // .milvus.proto.plan.OpType op = 3;
   if (this->op() != 0) {
     total_size += 1 +
       ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->op());
   }
 
+  // .milvus.proto.schema.DataType data_type = 4;
+  if (this->data_type() != 0) {
+    total_size += 1 +
+      ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::EnumSize(this->data_type());
+  }
+
   int cached_size = ::PROTOBUF_NAMESPACE_ID::internal::ToCachedSize(total_size);
   SetCachedSize(cached_size);
   return total_size;
 }
 
-void BinaryArithExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.BinaryArithExpr)
+void ArithCompareExpr::MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_merge_from_start:milvus.proto.plan.ArithCompareExpr)
   GOOGLE_DCHECK_NE(&from, this);
-  const BinaryArithExpr* source =
-      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<BinaryArithExpr>(
+  const ArithCompareExpr* source =
+      ::PROTOBUF_NAMESPACE_ID::DynamicCastToGenerated<ArithCompareExpr>(
           &from);
   if (source == nullptr) {
-  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_fail:milvus.proto.plan.ArithCompareExpr)
     ::PROTOBUF_NAMESPACE_ID::internal::ReflectionOps::Merge(from, this);
   } else {
-  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.BinaryArithExpr)
+  // @@protoc_insertion_point(generalized_merge_from_cast_success:milvus.proto.plan.ArithCompareExpr)
     MergeFrom(*source);
   }
 }
 
-void BinaryArithExpr::MergeFrom(const BinaryArithExpr& from) {
-// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.BinaryArithExpr)
+void ArithCompareExpr::MergeFrom(const ArithCompareExpr& from) {
+// @@protoc_insertion_point(class_specific_merge_from_start:milvus.proto.plan.ArithCompareExpr)
   GOOGLE_DCHECK_NE(&from, this);
   _internal_metadata_.MergeFrom(from._internal_metadata_);
   ::PROTOBUF_NAMESPACE_ID::uint32 cached_has_bits = 0;
@@ -5479,35 +6351,39 @@ void BinaryArithExpr::MergeFrom(const BinaryArithExpr& from) {
   if (from.op() != 0) {
     set_op(from.op());
   }
+  if (from.data_type() != 0) {
+    set_data_type(from.data_type());
+  }
 }
 
-void BinaryArithExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
-// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.BinaryArithExpr)
+void ArithCompareExpr::CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) {
+// @@protoc_insertion_point(generalized_copy_from_start:milvus.proto.plan.ArithCompareExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-void BinaryArithExpr::CopyFrom(const BinaryArithExpr& from) {
-// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.BinaryArithExpr)
+void ArithCompareExpr::CopyFrom(const ArithCompareExpr& from) {
+// @@protoc_insertion_point(class_specific_copy_from_start:milvus.proto.plan.ArithCompareExpr)
   if (&from == this) return;
   Clear();
   MergeFrom(from);
 }
 
-bool BinaryArithExpr::IsInitialized() const {
+bool ArithCompareExpr::IsInitialized() const {
   return true;
 }
 
-void BinaryArithExpr::InternalSwap(BinaryArithExpr* other) {
+void ArithCompareExpr::InternalSwap(ArithCompareExpr* other) {
   using std::swap;
   _internal_metadata_.Swap(&other->_internal_metadata_);
   swap(left_, other->left_);
   swap(right_, other->right_);
   swap(op_, other->op_);
+  swap(data_type_, other->data_type_);
 }
 
-::PROTOBUF_NAMESPACE_ID::Metadata BinaryArithExpr::GetMetadata() const {
+::PROTOBUF_NAMESPACE_ID::Metadata ArithCompareExpr::GetMetadata() const {
   return GetMetadataStatic();
 }
 
@@ -6015,6 +6891,10 @@ void Expr::InitAsDefaultInstance() {
       ::milvus::proto::plan::ValueExpr::internal_default_instance());
   ::milvus::proto::plan::_Expr_default_instance_.column_expr_ = const_cast< ::milvus::proto::plan::ColumnExpr*>(
       ::milvus::proto::plan::ColumnExpr::internal_default_instance());
+  ::milvus::proto::plan::_Expr_default_instance_.null_expr_ = const_cast< ::milvus::proto::plan::NullExpr*>(
+      ::milvus::proto::plan::NullExpr::internal_default_instance());
+  ::milvus::proto::plan::_Expr_default_instance_.arith_compare_expr_ = const_cast< ::milvus::proto::plan::ArithCompareExpr*>(
+      ::milvus::proto::plan::ArithCompareExpr::internal_default_instance());
 }
 class Expr::_Internal {
  public:
@@ -6028,6 +6908,8 @@ class Expr::_Internal {
   static const ::milvus::proto::plan::BinaryArithExpr& binary_arith_expr(const Expr* msg);
   static const ::milvus::proto::plan::ValueExpr& value_expr(const Expr* msg);
   static const ::milvus::proto::plan::ColumnExpr& column_expr(const Expr* msg);
+  static const ::milvus::proto::plan::NullExpr& null_expr(const Expr* msg);
+  static const ::milvus::proto::plan::ArithCompareExpr& arith_compare_expr(const Expr* msg);
 };
 
 const ::milvus::proto::plan::TermExpr&
@@ -6070,6 +6952,14 @@ const ::milvus::proto::plan::ColumnExpr&
 Expr::_Internal::column_expr(const Expr* msg) {
   return *msg->expr_.column_expr_;
 }
+const ::milvus::proto::plan::NullExpr&
+Expr::_Internal::null_expr(const Expr* msg) {
+  return *msg->expr_.null_expr_;
+}
+const ::milvus::proto::plan::ArithCompareExpr&
+Expr::_Internal::arith_compare_expr(const Expr* msg) {
+  return *msg->expr_.arith_compare_expr_;
+}
 void Expr::set_allocated_term_expr(::milvus::proto::plan::TermExpr* term_expr) {
   ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
   clear_expr();
@@ -6210,6 +7100,34 @@ void Expr::set_allocated_column_expr(::milvus::proto::plan::ColumnExpr* column_e
   }
   // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.Expr.column_expr)
 }
+void Expr::set_allocated_null_expr(::milvus::proto::plan::NullExpr* null_expr) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  clear_expr();
+  if (null_expr) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      null_expr = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, null_expr, submessage_arena);
+    }
+    set_has_null_expr();
+    expr_.null_expr_ = null_expr;
+  }
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.Expr.null_expr)
+}
+void Expr::set_allocated_arith_compare_expr(::milvus::proto::plan::ArithCompareExpr* arith_compare_expr) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  clear_expr();
+  if (arith_compare_expr) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      arith_compare_expr = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, arith_compare_expr, submessage_arena);
+    }
+    set_has_arith_compare_expr();
+    expr_.arith_compare_expr_ = arith_compare_expr;
+  }
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.Expr.arith_compare_expr)
+}
 Expr::Expr()
   : ::PROTOBUF_NAMESPACE_ID::Message(), _internal_metadata_(nullptr) {
   SharedCtor();
@@ -6261,6 +7179,14 @@ Expr::Expr(const Expr& from)
       mutable_column_expr()->::milvus::proto::plan::ColumnExpr::MergeFrom(from.column_expr());
       break;
     }
+    case kNullExpr: {
+      mutable_null_expr()->::milvus::proto::plan::NullExpr::MergeFrom(from.null_expr());
+      break;
+    }
+    case kArithCompareExpr: {
+      mutable_arith_compare_expr()->::milvus::proto::plan::ArithCompareExpr::MergeFrom(from.arith_compare_expr());
+      break;
+    }
     case EXPR_NOT_SET: {
       break;
     }
@@ -6269,7 +7195,7 @@ Expr::Expr(const Expr& from)
 }
 
 void Expr::SharedCtor() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_BinaryArithExpr_plan_2eproto.base);
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&scc_info_ArithCompareExpr_plan_2eproto.base);
   clear_has_expr();
 }
 
@@ -6288,7 +7214,7 @@ void Expr::SetCachedSize(int size) const {
   _cached_size_.Set(size);
 }
 const Expr& Expr::default_instance() {
-  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_BinaryArithExpr_plan_2eproto.base);
+  ::PROTOBUF_NAMESPACE_ID::internal::InitSCC(&::scc_info_ArithCompareExpr_plan_2eproto.base);
   return *internal_default_instance();
 }
 
@@ -6336,6 +7262,14 @@ void Expr::clear_expr() {
       delete expr_.column_expr_;
       break;
     }
+    case kNullExpr: {
+      delete expr_.null_expr_;
+      break;
+    }
+    case kArithCompareExpr: {
+      delete expr_.arith_compare_expr_;
+      break;
+    }
     case EXPR_NOT_SET: {
       break;
     }
@@ -6432,6 +7366,20 @@ const char* Expr::_InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::inter
           CHK_(ptr);
         } else goto handle_unusual;
         continue;
+      // .milvus.proto.plan.NullExpr null_expr = 11;
+      case 11:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 90)) {
+          ptr = ctx->ParseMessage(mutable_null_expr(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
+      // .milvus.proto.plan.ArithCompareExpr arith_compare_expr = 12;
+      case 12:
+        if (PROTOBUF_PREDICT_TRUE(static_cast<::PROTOBUF_NAMESPACE_ID::uint8>(tag) == 98)) {
+          ptr = ctx->ParseMessage(mutable_arith_compare_expr(), ptr);
+          CHK_(ptr);
+        } else goto handle_unusual;
+        continue;
       default: {
       handle_unusual:
         if ((tag & 7) == 4 || tag == 0) {
@@ -6572,6 +7520,28 @@ bool Expr::MergePartialFromCodedStream(
         break;
       }
 
+      // .milvus.proto.plan.NullExpr null_expr = 11;
+      case 11: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (90 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_null_expr()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
+      // .milvus.proto.plan.ArithCompareExpr arith_compare_expr = 12;
+      case 12: {
+        if (static_cast< ::PROTOBUF_NAMESPACE_ID::uint8>(tag) == (98 & 0xFF)) {
+          DO_(::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::ReadMessage(
+               input, mutable_arith_compare_expr()));
+        } else {
+          goto handle_unusual;
+        }
+        break;
+      }
+
       default: {
       handle_unusual:
         if (tag == 0) {
@@ -6659,6 +7629,18 @@ void Expr::SerializeWithCachedSizes(
       10, _Internal::column_expr(this), output);
   }
 
+  // .milvus.proto.plan.NullExpr null_expr = 11;
+  if (has_null_expr()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, _Internal::null_expr(this), output);
+  }
+
+  // .milvus.proto.plan.ArithCompareExpr arith_compare_expr = 12;
+  if (has_arith_compare_expr()) {
+    ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, _Internal::arith_compare_expr(this), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFields(
         _internal_metadata_.unknown_fields(), output);
@@ -6742,6 +7724,20 @@ void Expr::SerializeWithCachedSizes(
         10, _Internal::column_expr(this), target);
   }
 
+  // .milvus.proto.plan.NullExpr null_expr = 11;
+  if (has_null_expr()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        11, _Internal::null_expr(this), target);
+  }
+
+  // .milvus.proto.plan.ArithCompareExpr arith_compare_expr = 12;
+  if (has_arith_compare_expr()) {
+    target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::
+      InternalWriteMessageToArray(
+        12, _Internal::arith_compare_expr(this), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::PROTOBUF_NAMESPACE_ID::internal::WireFormat::SerializeUnknownFieldsToArray(
         _internal_metadata_.unknown_fields(), target);
@@ -6834,6 +7830,20 @@ size_t Expr::ByteSizeLong() const {
           *expr_.column_expr_);
       break;
     }
+    // .milvus.proto.plan.NullExpr null_expr = 11;
+    case kNullExpr: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *expr_.null_expr_);
+      break;
+    }
+    // .milvus.proto.plan.ArithCompareExpr arith_compare_expr = 12;
+    case kArithCompareExpr: {
+      total_size += 1 +
+        ::PROTOBUF_NAMESPACE_ID::internal::WireFormatLite::MessageSize(
+          *expr_.arith_compare_expr_);
+      break;
+    }
     case EXPR_NOT_SET: {
       break;
     }
@@ -6906,6 +7916,14 @@ void Expr::MergeFrom(const Expr& from) {
       mutable_column_expr()->::milvus::proto::plan::ColumnExpr::MergeFrom(from.column_expr());
       break;
     }
+    case kNullExpr: {
+      mutable_null_expr()->::milvus::proto::plan::NullExpr::MergeFrom(from.null_expr());
+      break;
+    }
+    case kArithCompareExpr: {
+      mutable_arith_compare_expr()->::milvus::proto::plan::ArithCompareExpr::MergeFrom(from.arith_compare_expr());
+      break;
+    }
     case EXPR_NOT_SET: {
       break;
     }
@@ -7901,6 +8919,9 @@ template<> PROTOBUF_NOINLINE ::milvus::proto::plan::CompareExpr* Arena::CreateMa
 template<> PROTOBUF_NOINLINE ::milvus::proto::plan::TermExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::TermExpr >(Arena* arena) {
   return Arena::CreateInternal< ::milvus::proto::plan::TermExpr >(arena);
 }
+template<> PROTOBUF_NOINLINE ::milvus::proto::plan::NullExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::NullExpr >(Arena* arena) {
+  return Arena::CreateInternal< ::milvus::proto::plan::NullExpr >(arena);
+}
 template<> PROTOBUF_NOINLINE ::milvus::proto::plan::UnaryExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::UnaryExpr >(Arena* arena) {
   return Arena::CreateInternal< ::milvus::proto::plan::UnaryExpr >(arena);
 }
@@ -7913,6 +8934,9 @@ template<> PROTOBUF_NOINLINE ::milvus::proto::plan::BinaryArithOp* Arena::Create
 template<> PROTOBUF_NOINLINE ::milvus::proto::plan::BinaryArithExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::BinaryArithExpr >(Arena* arena) {
   return Arena::CreateInternal< ::milvus::proto::plan::BinaryArithExpr >(arena);
 }
+template<> PROTOBUF_NOINLINE ::milvus::proto::plan::ArithCompareExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::ArithCompareExpr >(Arena* arena) {
+  return Arena::CreateInternal< ::milvus::proto::plan::ArithCompareExpr >(arena);
+}
 template<> PROTOBUF_NOINLINE ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* Arena::CreateMaybeMessage< ::milvus::proto::plan::BinaryArithOpEvalRangeExpr >(Arena* arena) {
   return Arena::CreateInternal< ::milvus::proto::plan::BinaryArithOpEvalRangeExpr >(arena);
 }
@@ -49,7 +49,7 @@ struct TableStruct_plan_2eproto {
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
   static const ::PROTOBUF_NAMESPACE_ID::internal::AuxillaryParseTableField aux[]
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
-  static const ::PROTOBUF_NAMESPACE_ID::internal::ParseTable schema[17]
+  static const ::PROTOBUF_NAMESPACE_ID::internal::ParseTable schema[19]
     PROTOBUF_SECTION_VARIABLE(protodesc_cold);
   static const ::PROTOBUF_NAMESPACE_ID::internal::FieldMetadata field_metadata[];
   static const ::PROTOBUF_NAMESPACE_ID::internal::SerializationTable serialization_table[];
@@ -62,6 +62,9 @@ namespace plan {
 class BinaryArithExpr;
 class BinaryArithExprDefaultTypeInternal;
 extern BinaryArithExprDefaultTypeInternal _BinaryArithExpr_default_instance_;
+class ArithCompareExpr;
+class ArithCompareExprDefaultTypeInternal;
+extern ArithCompareExprDefaultTypeInternal _ArithCompareExpr_default_instance_;
 class BinaryArithOp;
 class BinaryArithOpDefaultTypeInternal;
 extern BinaryArithOpDefaultTypeInternal _BinaryArithOp_default_instance_;
@@ -89,6 +92,9 @@ extern ExprDefaultTypeInternal _Expr_default_instance_;
 class GenericValue;
 class GenericValueDefaultTypeInternal;
 extern GenericValueDefaultTypeInternal _GenericValue_default_instance_;
+class NullExpr;
+class NullExprDefaultTypeInternal;
+extern NullExprDefaultTypeInternal _NullExpr_default_instance_;
 class PlanNode;
 class PlanNodeDefaultTypeInternal;
 extern PlanNodeDefaultTypeInternal _PlanNode_default_instance_;
@@ -114,6 +120,7 @@ extern VectorANNSDefaultTypeInternal _VectorANNS_default_instance_;
 }  // namespace proto
 }  // namespace milvus
 PROTOBUF_NAMESPACE_OPEN
+template<> ::milvus::proto::plan::ArithCompareExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::ArithCompareExpr>(Arena*);
 template<> ::milvus::proto::plan::BinaryArithExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::BinaryArithExpr>(Arena*);
 template<> ::milvus::proto::plan::BinaryArithOp* Arena::CreateMaybeMessage<::milvus::proto::plan::BinaryArithOp>(Arena*);
 template<> ::milvus::proto::plan::BinaryArithOpEvalRangeExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::BinaryArithOpEvalRangeExpr>(Arena*);
@@ -124,6 +131,7 @@ template<> ::milvus::proto::plan::ColumnInfo* Arena::CreateMaybeMessage<::milvus
 template<> ::milvus::proto::plan::CompareExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::CompareExpr>(Arena*);
 template<> ::milvus::proto::plan::Expr* Arena::CreateMaybeMessage<::milvus::proto::plan::Expr>(Arena*);
 template<> ::milvus::proto::plan::GenericValue* Arena::CreateMaybeMessage<::milvus::proto::plan::GenericValue>(Arena*);
+template<> ::milvus::proto::plan::NullExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::NullExpr>(Arena*);
 template<> ::milvus::proto::plan::PlanNode* Arena::CreateMaybeMessage<::milvus::proto::plan::PlanNode>(Arena*);
 template<> ::milvus::proto::plan::QueryInfo* Arena::CreateMaybeMessage<::milvus::proto::plan::QueryInfo>(Arena*);
 template<> ::milvus::proto::plan::TermExpr* Arena::CreateMaybeMessage<::milvus::proto::plan::TermExpr>(Arena*);
@@ -136,6 +144,32 @@ namespace milvus {
 namespace proto {
 namespace plan {
 
+enum NullExpr_NullOp : int {
+  NullExpr_NullOp_Invalid = 0,
+  NullExpr_NullOp_IsNull = 1,
+  NullExpr_NullOp_IsNotNull = 2,
+  NullExpr_NullOp_NullExpr_NullOp_INT_MIN_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::min(),
+  NullExpr_NullOp_NullExpr_NullOp_INT_MAX_SENTINEL_DO_NOT_USE_ = std::numeric_limits<::PROTOBUF_NAMESPACE_ID::int32>::max()
+};
+bool NullExpr_NullOp_IsValid(int value);
+constexpr NullExpr_NullOp NullExpr_NullOp_NullOp_MIN = NullExpr_NullOp_Invalid;
+constexpr NullExpr_NullOp NullExpr_NullOp_NullOp_MAX = NullExpr_NullOp_IsNotNull;
+constexpr int NullExpr_NullOp_NullOp_ARRAYSIZE = NullExpr_NullOp_NullOp_MAX + 1;
+
+const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor* NullExpr_NullOp_descriptor();
+template<typename T>
+inline const std::string& NullExpr_NullOp_Name(T enum_t_value) {
+  static_assert(::std::is_same<T, NullExpr_NullOp>::value ||
+    ::std::is_integral<T>::value,
+    "Incorrect type passed to function NullExpr_NullOp_Name.");
+  return ::PROTOBUF_NAMESPACE_ID::internal::NameOfEnum(
+    NullExpr_NullOp_descriptor(), enum_t_value);
+}
+inline bool NullExpr_NullOp_Parse(
+    const std::string& name, NullExpr_NullOp* value) {
+  return ::PROTOBUF_NAMESPACE_ID::internal::ParseNamedEnum<NullExpr_NullOp>(
+    NullExpr_NullOp_descriptor(), name, value);
+}
 enum UnaryExpr_UnaryOp : int {
   UnaryExpr_UnaryOp_Invalid = 0,
   UnaryExpr_UnaryOp_Not = 1,
@@ -1647,6 +1681,178 @@ class TermExpr :
 };
 // -------------------------------------------------------------------
 
+class NullExpr :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.NullExpr) */ {
+ public:
+  NullExpr();
+  virtual ~NullExpr();
+
+  NullExpr(const NullExpr& from);
+  NullExpr(NullExpr&& from) noexcept
+    : NullExpr() {
+    *this = ::std::move(from);
+  }
+
+  inline NullExpr& operator=(const NullExpr& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline NullExpr& operator=(NullExpr&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const NullExpr& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const NullExpr* internal_default_instance() {
+    return reinterpret_cast<const NullExpr*>(
+               &_NullExpr_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    9;
+
+  friend void swap(NullExpr& a, NullExpr& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(NullExpr* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline NullExpr* New() const final {
+    return CreateMaybeMessage<NullExpr>(nullptr);
+  }
+
+  NullExpr* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<NullExpr>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const NullExpr& from);
+  void MergeFrom(const NullExpr& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(NullExpr* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.plan.NullExpr";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_plan_2eproto);
+    return ::descriptor_table_plan_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  typedef NullExpr_NullOp NullOp;
+  static constexpr NullOp Invalid =
+    NullExpr_NullOp_Invalid;
+  static constexpr NullOp IsNull =
+    NullExpr_NullOp_IsNull;
+  static constexpr NullOp IsNotNull =
+    NullExpr_NullOp_IsNotNull;
+  static inline bool NullOp_IsValid(int value) {
+    return NullExpr_NullOp_IsValid(value);
+  }
+  static constexpr NullOp NullOp_MIN =
+    NullExpr_NullOp_NullOp_MIN;
+  static constexpr NullOp NullOp_MAX =
+    NullExpr_NullOp_NullOp_MAX;
+  static constexpr int NullOp_ARRAYSIZE =
+    NullExpr_NullOp_NullOp_ARRAYSIZE;
+  static inline const ::PROTOBUF_NAMESPACE_ID::EnumDescriptor*
+  NullOp_descriptor() {
+    return NullExpr_NullOp_descriptor();
+  }
+  template<typename T>
+  static inline const std::string& NullOp_Name(T enum_t_value) {
+    static_assert(::std::is_same<T, NullOp>::value ||
+      ::std::is_integral<T>::value,
+      "Incorrect type passed to function NullOp_Name.");
+    return NullExpr_NullOp_Name(enum_t_value);
+  }
+  static inline bool NullOp_Parse(const std::string& name,
+      NullOp* value) {
+    return NullExpr_NullOp_Parse(name, value);
+  }
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kColumnInfoFieldNumber = 1,
+    kOpFieldNumber = 2,
+  };
+  // .milvus.proto.plan.ColumnInfo column_info = 1;
+  bool has_column_info() const;
+  void clear_column_info();
+  const ::milvus::proto::plan::ColumnInfo& column_info() const;
+  ::milvus::proto::plan::ColumnInfo* release_column_info();
+  ::milvus::proto::plan::ColumnInfo* mutable_column_info();
+  void set_allocated_column_info(::milvus::proto::plan::ColumnInfo* column_info);
+
+  // .milvus.proto.plan.NullExpr.NullOp op = 2;
+  void clear_op();
+  ::milvus::proto::plan::NullExpr_NullOp op() const;
+  void set_op(::milvus::proto::plan::NullExpr_NullOp value);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.plan.NullExpr)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  ::milvus::proto::plan::ColumnInfo* column_info_;
+  int op_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_plan_2eproto;
+};
+// -------------------------------------------------------------------
 class UnaryExpr :
     public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.UnaryExpr) */ {
  public:
@@ -1689,7 +1895,7 @@ class UnaryExpr :
                &_UnaryExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    9;
+    10;
 
   friend void swap(UnaryExpr& a, UnaryExpr& b) {
     a.Swap(&b);
@@ -1860,7 +2066,7 @@ class BinaryExpr :
                &_BinaryExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    10;
+    11;
 
   friend void swap(BinaryExpr& a, BinaryExpr& b) {
     a.Swap(&b);
@@ -2043,7 +2249,7 @@ class BinaryArithOp :
                &_BinaryArithOp_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    11;
+    12;
 
   friend void swap(BinaryArithOp& a, BinaryArithOp& b) {
     a.Swap(&b);
@@ -2194,7 +2400,7 @@ class BinaryArithExpr :
                &_BinaryArithExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    12;
+    13;
 
   friend void swap(BinaryArithExpr& a, BinaryArithExpr& b) {
     a.Swap(&b);
@@ -2268,6 +2474,7 @@ class BinaryArithExpr :
     kLeftFieldNumber = 1,
     kRightFieldNumber = 2,
     kOpFieldNumber = 3,
+    kDataTypeFieldNumber = 4,
   };
   // .milvus.proto.plan.Expr left = 1;
   bool has_left() const;
@@ -2290,6 +2497,11 @@ class BinaryArithExpr :
   ::milvus::proto::plan::ArithOpType op() const;
   void set_op(::milvus::proto::plan::ArithOpType value);
 
+  // .milvus.proto.schema.DataType data_type = 4;
+  void clear_data_type();
+  ::milvus::proto::schema::DataType data_type() const;
+  void set_data_type(::milvus::proto::schema::DataType value);
+
   // @@protoc_insertion_point(class_scope:milvus.proto.plan.BinaryArithExpr)
  private:
   class _Internal;
@@ -2298,11 +2510,169 @@ class BinaryArithExpr :
   ::milvus::proto::plan::Expr* left_;
   ::milvus::proto::plan::Expr* right_;
   int op_;
+  int data_type_;
   mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   friend struct ::TableStruct_plan_2eproto;
 };
 // -------------------------------------------------------------------
 
+class ArithCompareExpr :
+    public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.ArithCompareExpr) */ {
+ public:
+  ArithCompareExpr();
+  virtual ~ArithCompareExpr();
+
+  ArithCompareExpr(const ArithCompareExpr& from);
+  ArithCompareExpr(ArithCompareExpr&& from) noexcept
+    : ArithCompareExpr() {
+    *this = ::std::move(from);
+  }
+
+  inline ArithCompareExpr& operator=(const ArithCompareExpr& from) {
+    CopyFrom(from);
+    return *this;
+  }
+  inline ArithCompareExpr& operator=(ArithCompareExpr&& from) noexcept {
+    if (GetArenaNoVirtual() == from.GetArenaNoVirtual()) {
+      if (this != &from) InternalSwap(&from);
+    } else {
+      CopyFrom(from);
+    }
+    return *this;
+  }
+
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* descriptor() {
+    return GetDescriptor();
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Descriptor* GetDescriptor() {
+    return GetMetadataStatic().descriptor;
+  }
+  static const ::PROTOBUF_NAMESPACE_ID::Reflection* GetReflection() {
+    return GetMetadataStatic().reflection;
+  }
+  static const ArithCompareExpr& default_instance();
+
+  static void InitAsDefaultInstance();  // FOR INTERNAL USE ONLY
+  static inline const ArithCompareExpr* internal_default_instance() {
+    return reinterpret_cast<const ArithCompareExpr*>(
+               &_ArithCompareExpr_default_instance_);
+  }
+  static constexpr int kIndexInFileMessages =
+    14;
+
+  friend void swap(ArithCompareExpr& a, ArithCompareExpr& b) {
+    a.Swap(&b);
+  }
+  inline void Swap(ArithCompareExpr* other) {
+    if (other == this) return;
+    InternalSwap(other);
+  }
+
+  // implements Message ----------------------------------------------
+
+  inline ArithCompareExpr* New() const final {
+    return CreateMaybeMessage<ArithCompareExpr>(nullptr);
+  }
+
+  ArithCompareExpr* New(::PROTOBUF_NAMESPACE_ID::Arena* arena) const final {
+    return CreateMaybeMessage<ArithCompareExpr>(arena);
+  }
+  void CopyFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void MergeFrom(const ::PROTOBUF_NAMESPACE_ID::Message& from) final;
+  void CopyFrom(const ArithCompareExpr& from);
+  void MergeFrom(const ArithCompareExpr& from);
+  PROTOBUF_ATTRIBUTE_REINITIALIZES void Clear() final;
+  bool IsInitialized() const final;
+
+  size_t ByteSizeLong() const final;
+  #if GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  const char* _InternalParse(const char* ptr, ::PROTOBUF_NAMESPACE_ID::internal::ParseContext* ctx) final;
+  #else
+  bool MergePartialFromCodedStream(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedInputStream* input) final;
+  #endif  // GOOGLE_PROTOBUF_ENABLE_EXPERIMENTAL_PARSER
+  void SerializeWithCachedSizes(
+      ::PROTOBUF_NAMESPACE_ID::io::CodedOutputStream* output) const final;
+  ::PROTOBUF_NAMESPACE_ID::uint8* InternalSerializeWithCachedSizesToArray(
+      ::PROTOBUF_NAMESPACE_ID::uint8* target) const final;
+  int GetCachedSize() const final { return _cached_size_.Get(); }
+
+  private:
+  inline void SharedCtor();
+  inline void SharedDtor();
+  void SetCachedSize(int size) const final;
+  void InternalSwap(ArithCompareExpr* other);
+  friend class ::PROTOBUF_NAMESPACE_ID::internal::AnyMetadata;
+  static ::PROTOBUF_NAMESPACE_ID::StringPiece FullMessageName() {
+    return "milvus.proto.plan.ArithCompareExpr";
+  }
+  private:
+  inline ::PROTOBUF_NAMESPACE_ID::Arena* GetArenaNoVirtual() const {
+    return nullptr;
+  }
+  inline void* MaybeArenaPtr() const {
+    return nullptr;
+  }
+  public:
+
+  ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadata() const final;
+  private:
+  static ::PROTOBUF_NAMESPACE_ID::Metadata GetMetadataStatic() {
+    ::PROTOBUF_NAMESPACE_ID::internal::AssignDescriptors(&::descriptor_table_plan_2eproto);
+    return ::descriptor_table_plan_2eproto.file_level_metadata[kIndexInFileMessages];
+  }
+
+  public:
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  enum : int {
+    kLeftFieldNumber = 1,
+    kRightFieldNumber = 2,
+    kOpFieldNumber = 3,
+    kDataTypeFieldNumber = 4,
+  };
+  // .milvus.proto.plan.Expr left = 1;
+  bool has_left() const;
+  void clear_left();
+  const ::milvus::proto::plan::Expr& left() const;
+  ::milvus::proto::plan::Expr* release_left();
+  ::milvus::proto::plan::Expr* mutable_left();
+  void set_allocated_left(::milvus::proto::plan::Expr* left);
+
+  // .milvus.proto.plan.Expr right = 2;
+  bool has_right() const;
+  void clear_right();
+  const ::milvus::proto::plan::Expr& right() const;
+  ::milvus::proto::plan::Expr* release_right();
+  ::milvus::proto::plan::Expr* mutable_right();
+  void set_allocated_right(::milvus::proto::plan::Expr* right);
+
+  // .milvus.proto.plan.OpType op = 3;
+  void clear_op();
+  ::milvus::proto::plan::OpType op() const;
+  void set_op(::milvus::proto::plan::OpType value);
+
+  // .milvus.proto.schema.DataType data_type = 4;
+  void clear_data_type();
+  ::milvus::proto::schema::DataType data_type() const;
+  void set_data_type(::milvus::proto::schema::DataType value);
+
+  // @@protoc_insertion_point(class_scope:milvus.proto.plan.ArithCompareExpr)
+ private:
+  class _Internal;
+
+  ::PROTOBUF_NAMESPACE_ID::internal::InternalMetadataWithArena _internal_metadata_;
+  ::milvus::proto::plan::Expr* left_;
+  ::milvus::proto::plan::Expr* right_;
+  int op_;
+  int data_type_;
+  mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
+  friend struct ::TableStruct_plan_2eproto;
+};
+// -------------------------------------------------------------------
 class BinaryArithOpEvalRangeExpr :
     public ::PROTOBUF_NAMESPACE_ID::Message /* @@protoc_insertion_point(class_definition:milvus.proto.plan.BinaryArithOpEvalRangeExpr) */ {
  public:
@@ -2345,7 +2715,7 @@ class BinaryArithOpEvalRangeExpr :
                &_BinaryArithOpEvalRangeExpr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    13;
+    15;
 
   friend void swap(BinaryArithOpEvalRangeExpr& a, BinaryArithOpEvalRangeExpr& b) {
     a.Swap(&b);
@@ -2518,6 +2888,8 @@ class Expr :
     kBinaryArithExpr = 8,
     kValueExpr = 9,
     kColumnExpr = 10,
+    kNullExpr = 11,
+    kArithCompareExpr = 12,
     EXPR_NOT_SET = 0,
   };
 
@@ -2527,7 +2899,7 @@ class Expr :
                &_Expr_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    14;
+    16;
 
   friend void swap(Expr& a, Expr& b) {
     a.Swap(&b);
@@ -2608,6 +2980,8 @@ class Expr :
     kBinaryArithExprFieldNumber = 8,
     kValueExprFieldNumber = 9,
     kColumnExprFieldNumber = 10,
+    kNullExprFieldNumber = 11,
+    kArithCompareExprFieldNumber = 12,
   };
   // .milvus.proto.plan.TermExpr term_expr = 1;
   bool has_term_expr() const;
@@ -2689,6 +3063,22 @@ class Expr :
   ::milvus::proto::plan::ColumnExpr* mutable_column_expr();
   void set_allocated_column_expr(::milvus::proto::plan::ColumnExpr* column_expr);
 
+  // .milvus.proto.plan.NullExpr null_expr = 11;
+  bool has_null_expr() const;
+  void clear_null_expr();
+  const ::milvus::proto::plan::NullExpr& null_expr() const;
+  ::milvus::proto::plan::NullExpr* release_null_expr();
+  ::milvus::proto::plan::NullExpr* mutable_null_expr();
+  void set_allocated_null_expr(::milvus::proto::plan::NullExpr* null_expr);
+
+  // .milvus.proto.plan.ArithCompareExpr arith_compare_expr = 12;
+  bool has_arith_compare_expr() const;
+  void clear_arith_compare_expr();
+  const ::milvus::proto::plan::ArithCompareExpr& arith_compare_expr() const;
+  ::milvus::proto::plan::ArithCompareExpr* release_arith_compare_expr();
+  ::milvus::proto::plan::ArithCompareExpr* mutable_arith_compare_expr();
+  void set_allocated_arith_compare_expr(::milvus::proto::plan::ArithCompareExpr* arith_compare_expr);
+
   void clear_expr();
   ExprCase expr_case() const;
   // @@protoc_insertion_point(class_scope:milvus.proto.plan.Expr)
@@ -2704,6 +3094,8 @@ class Expr :
   void set_has_binary_arith_expr();
   void set_has_value_expr();
   void set_has_column_expr();
+  void set_has_null_expr();
+  void set_has_arith_compare_expr();
 
   inline bool has_expr() const;
   inline void clear_has_expr();
@@ -2721,6 +3113,8 @@ class Expr :
     ::milvus::proto::plan::BinaryArithExpr* binary_arith_expr_;
     ::milvus::proto::plan::ValueExpr* value_expr_;
     ::milvus::proto::plan::ColumnExpr* column_expr_;
+    ::milvus::proto::plan::NullExpr* null_expr_;
+    ::milvus::proto::plan::ArithCompareExpr* arith_compare_expr_;
   } expr_;
   mutable ::PROTOBUF_NAMESPACE_ID::internal::CachedSize _cached_size_;
   ::PROTOBUF_NAMESPACE_ID::uint32 _oneof_case_[1];
@@ -2771,7 +3165,7 @@ class VectorANNS :
                &_VectorANNS_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    15;
+    17;
 
   friend void swap(VectorANNS& a, VectorANNS& b) {
     a.Swap(&b);
@@ -2948,7 +3342,7 @@ class PlanNode :
                &_PlanNode_default_instance_);
   }
   static constexpr int kIndexInFileMessages =
-    16;
+    18;
 
   friend void swap(PlanNode& a, PlanNode& b) {
     a.Swap(&b);
@@ -4087,6 +4481,75 @@ TermExpr::values() const {
 
 // -------------------------------------------------------------------
 
+// NullExpr
+
+// .milvus.proto.plan.ColumnInfo column_info = 1;
+inline bool NullExpr::has_column_info() const {
+  return this != internal_default_instance() && column_info_ != nullptr;
+}
+inline void NullExpr::clear_column_info() {
+  if (GetArenaNoVirtual() == nullptr && column_info_ != nullptr) {
+    delete column_info_;
+  }
+  column_info_ = nullptr;
+}
+inline const ::milvus::proto::plan::ColumnInfo& NullExpr::column_info() const {
+  const ::milvus::proto::plan::ColumnInfo* p = column_info_;
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.NullExpr.column_info)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::plan::ColumnInfo*>(
+      &::milvus::proto::plan::_ColumnInfo_default_instance_);
+}
+inline ::milvus::proto::plan::ColumnInfo* NullExpr::release_column_info() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.NullExpr.column_info)
+  
+  ::milvus::proto::plan::ColumnInfo* temp = column_info_;
+  column_info_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::plan::ColumnInfo* NullExpr::mutable_column_info() {
+  
+  if (column_info_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::plan::ColumnInfo>(GetArenaNoVirtual());
+    column_info_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.NullExpr.column_info)
+  return column_info_;
+}
+inline void NullExpr::set_allocated_column_info(::milvus::proto::plan::ColumnInfo* column_info) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete column_info_;
+  }
+  if (column_info) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      column_info = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, column_info, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  column_info_ = column_info;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.NullExpr.column_info)
+}
+
+
+// .milvus.proto.plan.NullExpr.NullOp op = 2;
+inline void NullExpr::clear_op() {
+  op_ = 0;
+}
+inline ::milvus::proto::plan::NullExpr_NullOp NullExpr::op() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.NullExpr.op)
+  return static_cast< ::milvus::proto::plan::NullExpr_NullOp >(op_);
+}
+inline void NullExpr::set_op(::milvus::proto::plan::NullExpr_NullOp value) {
+  
+  op_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.NullExpr.op)
+}
+// -------------------------------------------------------------------
+
 // UnaryExpr
 
 // .milvus.proto.plan.UnaryExpr.UnaryOp op = 1;
@@ -4514,6 +4977,154 @@ inline void BinaryArithExpr::set_op(::milvus::proto::plan::ArithOpType value) {
   // @@protoc_insertion_point(field_set:milvus.proto.plan.BinaryArithExpr.op)
 }
 
+// .milvus.proto.schema.DataType data_type = 4;
+inline void BinaryArithExpr::clear_data_type() {
+  data_type_ = 0;
+}
+inline ::milvus::proto::schema::DataType BinaryArithExpr::data_type() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.BinaryArithExpr.data_type)
+  return static_cast< ::milvus::proto::schema::DataType >(data_type_);
+}
+inline void BinaryArithExpr::set_data_type(::milvus::proto::schema::DataType value) {
+  
+  data_type_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.BinaryArithExpr.data_type)
+}
+
+// -------------------------------------------------------------------
+
+// ArithCompareExpr
+
+// .milvus.proto.plan.Expr left = 1;
+inline bool ArithCompareExpr::has_left() const {
+  return this != internal_default_instance() && left_ != nullptr;
+}
+inline void ArithCompareExpr::clear_left() {
+  if (GetArenaNoVirtual() == nullptr && left_ != nullptr) {
+    delete left_;
+  }
+  left_ = nullptr;
+}
+inline const ::milvus::proto::plan::Expr& ArithCompareExpr::left() const {
+  const ::milvus::proto::plan::Expr* p = left_;
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.ArithCompareExpr.left)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::plan::Expr*>(
+      &::milvus::proto::plan::_Expr_default_instance_);
+}
+inline ::milvus::proto::plan::Expr* ArithCompareExpr::release_left() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.ArithCompareExpr.left)
+  
+  ::milvus::proto::plan::Expr* temp = left_;
+  left_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::plan::Expr* ArithCompareExpr::mutable_left() {
+  
+  if (left_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::plan::Expr>(GetArenaNoVirtual());
+    left_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.ArithCompareExpr.left)
+  return left_;
+}
+inline void ArithCompareExpr::set_allocated_left(::milvus::proto::plan::Expr* left) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete left_;
+  }
+  if (left) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      left = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, left, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  left_ = left;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.ArithCompareExpr.left)
+}
+
+// .milvus.proto.plan.Expr right = 2;
+inline bool ArithCompareExpr::has_right() const {
+  return this != internal_default_instance() && right_ != nullptr;
+}
+inline void ArithCompareExpr::clear_right() {
+  if (GetArenaNoVirtual() == nullptr && right_ != nullptr) {
+    delete right_;
+  }
+  right_ = nullptr;
+}
+inline const ::milvus::proto::plan::Expr& ArithCompareExpr::right() const {
+  const ::milvus::proto::plan::Expr* p = right_;
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.ArithCompareExpr.right)
+  return p != nullptr ? *p : *reinterpret_cast<const ::milvus::proto::plan::Expr*>(
+      &::milvus::proto::plan::_Expr_default_instance_);
+}
+inline ::milvus::proto::plan::Expr* ArithCompareExpr::release_right() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.ArithCompareExpr.right)
+  
+  ::milvus::proto::plan::Expr* temp = right_;
+  right_ = nullptr;
+  return temp;
+}
+inline ::milvus::proto::plan::Expr* ArithCompareExpr::mutable_right() {
+  
+  if (right_ == nullptr) {
+    auto* p = CreateMaybeMessage<::milvus::proto::plan::Expr>(GetArenaNoVirtual());
+    right_ = p;
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.ArithCompareExpr.right)
+  return right_;
+}
+inline void ArithCompareExpr::set_allocated_right(::milvus::proto::plan::Expr* right) {
+  ::PROTOBUF_NAMESPACE_ID::Arena* message_arena = GetArenaNoVirtual();
+  if (message_arena == nullptr) {
+    delete right_;
+  }
+  if (right) {
+    ::PROTOBUF_NAMESPACE_ID::Arena* submessage_arena = nullptr;
+    if (message_arena != submessage_arena) {
+      right = ::PROTOBUF_NAMESPACE_ID::internal::GetOwnedMessage(
+          message_arena, right, submessage_arena);
+    }
+    
+  } else {
+    
+  }
+  right_ = right;
+  // @@protoc_insertion_point(field_set_allocated:milvus.proto.plan.ArithCompareExpr.right)
+}
+
+// .milvus.proto.plan.OpType op = 3;
+inline void ArithCompareExpr::clear_op() {
+  op_ = 0;
+}
+inline ::milvus::proto::plan::OpType ArithCompareExpr::op() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.ArithCompareExpr.op)
+  return static_cast< ::milvus::proto::plan::OpType >(op_);
+}
+inline void ArithCompareExpr::set_op(::milvus::proto::plan::OpType value) {
+  
+  op_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.ArithCompareExpr.op)
+}
+
+// .milvus.proto.schema.DataType data_type = 4;
+inline void ArithCompareExpr::clear_data_type() {
+  data_type_ = 0;
+}
+inline ::milvus::proto::schema::DataType ArithCompareExpr::data_type() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.ArithCompareExpr.data_type)
+  return static_cast< ::milvus::proto::schema::DataType >(data_type_);
+}
+inline void ArithCompareExpr::set_data_type(::milvus::proto::schema::DataType value) {
+  
+  data_type_ = value;
+  // @@protoc_insertion_point(field_set:milvus.proto.plan.ArithCompareExpr.data_type)
+}
+
 // -------------------------------------------------------------------
 
 // BinaryArithOpEvalRangeExpr
@@ -5112,6 +5723,86 @@ inline ::milvus::proto::plan::ColumnExpr* Expr::mutable_column_expr() {
   // @@protoc_insertion_point(field_mutable:milvus.proto.plan.Expr.column_expr)
   return expr_.column_expr_;
 }
+// .milvus.proto.plan.NullExpr null_expr = 11;
+inline bool Expr::has_null_expr() const {
+  return expr_case() == kNullExpr;
+}
+inline void Expr::set_has_null_expr() {
+  _oneof_case_[0] = kNullExpr;
+}
+inline void Expr::clear_null_expr() {
+  if (has_null_expr()) {
+    delete expr_.null_expr_;
+    clear_has_expr();
+  }
+}
+inline ::milvus::proto::plan::NullExpr* Expr::release_null_expr() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.Expr.null_expr)
+  if (has_null_expr()) {
+    clear_has_expr();
+      ::milvus::proto::plan::NullExpr* temp = expr_.null_expr_;
+    expr_.null_expr_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
+}
+inline const ::milvus::proto::plan::NullExpr& Expr::null_expr() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.Expr.null_expr)
+  return has_null_expr()
+      ? *expr_.null_expr_
+      : *reinterpret_cast< ::milvus::proto::plan::NullExpr*>(&::milvus::proto::plan::_NullExpr_default_instance_);
+}
+inline ::milvus::proto::plan::NullExpr* Expr::mutable_null_expr() {
+  if (!has_null_expr()) {
+    clear_expr();
+    set_has_null_expr();
+    expr_.null_expr_ = CreateMaybeMessage< ::milvus::proto::plan::NullExpr >(
+        GetArenaNoVirtual());
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.Expr.null_expr)
+  return expr_.null_expr_;
+}
+// .milvus.proto.plan.ArithCompareExpr arith_compare_expr = 12;
+inline bool Expr::has_arith_compare_expr() const {
+  return expr_case() == kArithCompareExpr;
+}
+inline void Expr::set_has_arith_compare_expr() {
+  _oneof_case_[0] = kArithCompareExpr;
+}
+inline void Expr::clear_arith_compare_expr() {
+  if (has_arith_compare_expr()) {
+    delete expr_.arith_compare_expr_;
+    clear_has_expr();
+  }
+}
+inline ::milvus::proto::plan::ArithCompareExpr* Expr::release_arith_compare_expr() {
+  // @@protoc_insertion_point(field_release:milvus.proto.plan.Expr.arith_compare_expr)
+  if (has_arith_compare_expr()) {
+    clear_has_expr();
+      ::milvus::proto::plan::ArithCompareExpr* temp = expr_.arith_compare_expr_;
+    expr_.arith_compare_expr_ = nullptr;
+    return temp;
+  } else {
+    return nullptr;
+  }
+}
+inline const ::milvus::proto::plan::ArithCompareExpr& Expr::arith_compare_expr() const {
+  // @@protoc_insertion_point(field_get:milvus.proto.plan.Expr.arith_compare_expr)
+  return has_arith_compare_expr()
+      ? *expr_.arith_compare_expr_
+      : *reinterpret_cast< ::milvus::proto::plan::ArithCompareExpr*>(&::milvus::proto::plan::_ArithCompareExpr_default_instance_);
+}
+inline ::milvus::proto::plan::ArithCompareExpr* Expr::mutable_arith_compare_expr() {
+  if (!has_arith_compare_expr()) {
+    clear_expr();
+    set_has_arith_compare_expr();
+    expr_.arith_compare_expr_ = CreateMaybeMessage< ::milvus::proto::plan::ArithCompareExpr >(
+        GetArenaNoVirtual());
+  }
+  // @@protoc_insertion_point(field_mutable:milvus.proto.plan.Expr.arith_compare_expr)
+  return expr_.arith_compare_expr_;
+}
 
 inline bool Expr::has_expr() const {
   return expr_case() != EXPR_NOT_SET;
@@ -5476,6 +6167,11 @@ inline PlanNode::NodeCase PlanNode::node_case() const {
 
 PROTOBUF_NAMESPACE_OPEN
 
+template <> struct is_proto_enum< ::milvus::proto::plan::NullExpr_NullOp> : ::std::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::plan::NullExpr_NullOp>() {
+  return ::milvus::proto::plan::NullExpr_NullOp_descriptor();
+}
 template <> struct is_proto_enum< ::milvus::proto::plan::UnaryExpr_UnaryOp> : ::std::true_type {};
 template <>
 inline const EnumDescriptor* GetEnumDescriptor< ::milvus::proto::plan::UnaryExpr_UnaryOp>() {
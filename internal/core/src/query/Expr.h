@@ -200,4 +200,61 @@ struct CompareExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+struct NullExpr : Expr {
+    enum class OpType { Invalid = 0, IsNull = 1, IsNotNull = 2 };
+    const FieldId field_id_;
+    const DataType data_type_;
+    const OpType op_type_;
+
+    NullExpr(const FieldId field_id, const DataType data_type, const OpType op_type)
+        : field_id_(field_id), data_type_(data_type), op_type_(op_type) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+// ArithOperand is one side of an ArithCompareExpr: a column, a constant, or an arithmetic
+// operation over other operands. Constants are already cast to the promoted type.
+struct ArithOperand;
+using ArithOperandPtr = std::unique_ptr<ArithOperand>;
+
+struct ArithOperand {
+    enum class Kind { Column = 0, Value = 1, Arith = 2 };
+    const Kind kind_;
+    const DataType data_type_;
+
+    // Column
+    FieldId field_id_{-1};
+
+    // Value
+    int64_t int_value_{0};
+    double float_value_{0};
+
+    // Arith
+    ArithOpType arith_op_{ArithOpType::Unknown};
+    ArithOperandPtr left_;
+    ArithOperandPtr right_;
+
+    ArithOperand(const Kind kind, const DataType data_type) : kind_(kind), data_type_(data_type) {
+    }
+};
+
+struct ArithCompareExpr : Expr {
+    const ArithOperandPtr left_;
+    const ArithOperandPtr right_;
+    const OpType op_type_;
+    // type both sides are promoted to, INT64 or DOUBLE
+    const DataType data_type_;
+
+    ArithCompareExpr(ArithOperandPtr left, ArithOperandPtr right, const OpType op_type, const DataType data_type)
+        : left_(std::move(left)), right_(std::move(right)), op_type_(op_type), data_type_(data_type) {
+    }
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 }  // namespace milvus::query
@@ -411,6 +411,76 @@ ProtoParser::ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEva
     return result;
 }
 
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto data_type = schema[field_id].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    auto op = static_cast<NullExpr::OpType>(expr_pb.op());
+    Assert(op == NullExpr::OpType::IsNull || op == NullExpr::OpType::IsNotNull);
+    return std::make_unique<NullExpr>(field_id, data_type, op);
+}
+
+ArithOperandPtr
+ProtoParser::ParseArithOperand(const proto::plan::Expr& expr_pb) {
+    using ppe = proto::plan::Expr;
+    switch (expr_pb.expr_case()) {
+        case ppe::kColumnExpr: {
+            auto& column_info = expr_pb.column_expr().info();
+            auto field_id = FieldId(column_info.field_id());
+            auto data_type = schema[field_id].get_data_type();
+            Assert(data_type == static_cast<DataType>(column_info.data_type()));
+            AssertInfo(datatype_is_integer(data_type) || datatype_is_floating(data_type),
+                       "arithmetic operand must be an integer or floating field");
+            auto operand = std::make_unique<ArithOperand>(ArithOperand::Kind::Column, data_type);
+            operand->field_id_ = field_id;
+            return operand;
+        }
+        case ppe::kValueExpr: {
+            auto& value = expr_pb.value_expr().value();
+            switch (value.val_case()) {
+                case proto::plan::GenericValue::kInt64Val: {
+                    auto operand = std::make_unique<ArithOperand>(ArithOperand::Kind::Value, DataType::INT64);
+                    operand->int_value_ = value.int64_val();
+                    operand->float_value_ = static_cast<double>(value.int64_val());
+                    return operand;
+                }
+                case proto::plan::GenericValue::kFloatVal: {
+                    auto operand = std::make_unique<ArithOperand>(ArithOperand::Kind::Value, DataType::DOUBLE);
+                    operand->int_value_ = static_cast<int64_t>(value.float_val());
+                    operand->float_value_ = value.float_val();
+                    return operand;
+                }
+                default:
+                    PanicInfo("unsupported arithmetic operand value");
+            }
+        }
+        case ppe::kBinaryArithExpr: {
+            auto& arith_pb = expr_pb.binary_arith_expr();
+            auto data_type = static_cast<DataType>(arith_pb.data_type());
+            AssertInfo(data_type == DataType::INT64 || data_type == DataType::DOUBLE,
+                       "arithmetic expression must be promoted to int64 or double");
+            auto operand = std::make_unique<ArithOperand>(ArithOperand::Kind::Arith, data_type);
+            operand->arith_op_ = arith_pb.op();
+            operand->left_ = ParseArithOperand(arith_pb.left());
+            operand->right_ = ParseArithOperand(arith_pb.right());
+            return operand;
+        }
+        default:
+            PanicInfo("unsupported arithmetic operand");
+    }
+}
+
+ExprPtr
+ProtoParser::ParseArithCompareExpr(const proto::plan::ArithCompareExpr& expr_pb) {
+    auto data_type = static_cast<DataType>(expr_pb.data_type());
+    AssertInfo(data_type == DataType::INT64 || data_type == DataType::DOUBLE,
+               "arithmetic comparison must be promoted to int64 or double");
+    return std::make_unique<ArithCompareExpr>(ParseArithOperand(expr_pb.left()), ParseArithOperand(expr_pb.right()),
+                                              expr_pb.op(), data_type);
+}
+
 ExprPtr
 ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
     using ppe = proto::plan::Expr;
@@ -436,6 +506,12 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kBinaryArithOpEvalRangeExpr: {
             return ParseBinaryArithOpEvalRangeExpr(expr_pb.binary_arith_op_eval_range_expr());
         }
+        case ppe::kNullExpr: {
+            return ParseNullExpr(expr_pb.null_expr());
+        }
+        case ppe::kArithCompareExpr: {
+            return ParseArithCompareExpr(expr_pb.arith_compare_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
@@ -32,6 +32,15 @@ class ProtoParser {
     ExprPtr
     ParseBinaryArithOpEvalRangeExpr(const proto::plan::BinaryArithOpEvalRangeExpr& expr_pb);
 
+    ExprPtr
+    ParseNullExpr(const proto::plan::NullExpr& expr_pb);
+
+    ArithOperandPtr
+    ParseArithOperand(const proto::plan::Expr& expr_pb);
+
+    ExprPtr
+    ParseArithCompareExpr(const proto::plan::ArithCompareExpr& expr_pb);
+
     ExprPtr
     ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb);
 
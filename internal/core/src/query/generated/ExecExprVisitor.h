@@ -44,6 +44,12 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
         : segment_(segment), row_count_(row_count), timestamp_(timestamp) {
@@ -51,14 +57,27 @@ class ExecExprVisitor : public ExprVisitor {
 
     BitsetType
     call_child(Expr& expr) {
-        Assert(!bitset_opt_.has_value());
+        return call_child_with_unknown(expr).first;
+    }
+
+    // return the rows where the child holds, along with the rows whose result is unknown because of null values
+    std::pair<BitsetType, BitsetTypeOpt>
+    call_child_with_unknown(Expr& expr) {
+        AssertInfo(!bitset_opt_.has_value(), "[ExecExprVisitor]Bitset already has value before accept");
+        AssertInfo(!unknown_opt_.has_value(), "[ExecExprVisitor]Unknown bitset already has value before accept");
         expr.accept(*this);
-        Assert(bitset_opt_.has_value());
+        AssertInfo(bitset_opt_.has_value(), "[ExecExprVisitor]Bitset doesn't have value after accept");
         auto res = std::move(bitset_opt_);
+        auto unknown = std::move(unknown_opt_);
         bitset_opt_ = std::nullopt;
-        return std::move(res.value());
+        unknown_opt_ = std::nullopt;
+        return {std::move(res.value()), std::move(unknown)};
     }
 
+    // null value satisfies no predicate on its field, take its rows off and mark them unknown
+    void
+    MaskNullRows(FieldId field_id, BitsetType& res);
+
  public:
     template <typename T, typename IndexFunc, typename ElementFunc>
     auto
@@ -98,5 +117,6 @@ class ExecExprVisitor : public ExprVisitor {
     int64_t row_count_;
 
     BitsetTypeOpt bitset_opt_;
+    BitsetTypeOpt unknown_opt_;
 };
 }  // namespace milvus::query
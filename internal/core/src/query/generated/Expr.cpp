@@ -49,4 +49,14 @@ CompareExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
 }
 
+void
+NullExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+ArithCompareExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
 }  // namespace milvus::query
@@ -39,6 +39,12 @@ class ExtractInfoExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
     explicit ExtractInfoExprVisitor(ExtractedPlanInfo& plan_info) : plan_info_(plan_info) {
     }
@@ -44,6 +44,12 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(ArithCompareExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
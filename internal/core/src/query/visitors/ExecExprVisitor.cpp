@@ -9,8 +9,10 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <cmath>
 #include <deque>
 #include <optional>
+#include <type_traits>
 #include <unordered_set>
 #include <utility>
 #include <boost/variant.hpp>
@@ -33,14 +35,27 @@ class ExecExprVisitor : ExprVisitor {
 
     BitsetType
     call_child(Expr& expr) {
+        return call_child_with_unknown(expr).first;
+    }
+
+    // return the rows where the child holds, along with the rows whose result is unknown because of null values
+    std::pair<BitsetType, BitsetTypeOpt>
+    call_child_with_unknown(Expr& expr) {
         AssertInfo(!bitset_opt_.has_value(), "[ExecExprVisitor]Bitset already has value before accept");
+        AssertInfo(!unknown_opt_.has_value(), "[ExecExprVisitor]Unknown bitset already has value before accept");
         expr.accept(*this);
         AssertInfo(bitset_opt_.has_value(), "[ExecExprVisitor]Bitset doesn't have value after accept");
         auto res = std::move(bitset_opt_);
+        auto unknown = std::move(unknown_opt_);
         bitset_opt_ = std::nullopt;
-        return std::move(res.value());
+        unknown_opt_ = std::nullopt;
+        return {std::move(res.value()), std::move(unknown)};
     }
 
+    // null value satisfies no predicate on its field, take its rows off and mark them unknown
+    void
+    MaskNullRows(FieldId field_id, BitsetType& res);
+
  public:
     template <typename T, typename IndexFunc, typename ElementFunc>
     auto
@@ -75,17 +90,43 @@ class ExecExprVisitor : ExprVisitor {
     int64_t row_count_;
     Timestamp timestamp_;
     BitsetTypeOpt bitset_opt_;
+    BitsetTypeOpt unknown_opt_;
 };
 }  // namespace impl
 
+// rows where the child result is false, which are neither true nor unknown
+static BitsetType
+FalseRows(const BitsetType& res, const BitsetTypeOpt& unknown) {
+    auto false_rows = ~res;
+    if (unknown.has_value()) {
+        false_rows -= unknown.value();
+    }
+    return false_rows;
+}
+
+void
+ExecExprVisitor::MaskNullRows(FieldId field_id, BitsetType& res) {
+    auto null_rows = segment_.get_null_bitset(field_id, row_count_);
+    if (!null_rows.has_value()) {
+        return;
+    }
+    res -= null_rows.value();
+    if (unknown_opt_.has_value()) {
+        unknown_opt_.value() |= null_rows.value();
+    } else {
+        unknown_opt_ = std::move(null_rows);
+    }
+}
+
 void
 ExecExprVisitor::visit(LogicalUnaryExpr& expr) {
     using OpType = LogicalUnaryExpr::OpType;
-    auto child_res = call_child(*expr.child_);
-    BitsetType res = std::move(child_res);
+    auto [child_res, unknown] = call_child_with_unknown(*expr.child_);
+    BitsetType res;
     switch (expr.op_type_) {
         case OpType::LogicalNot: {
-            res.flip();
+            // not unknown is still unknown
+            res = FalseRows(child_res, unknown);
             break;
         }
         default: {
@@ -94,30 +135,54 @@ ExecExprVisitor::visit(LogicalUnaryExpr& expr) {
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
+    unknown_opt_ = std::move(unknown);
 }
 
 void
 ExecExprVisitor::visit(LogicalBinaryExpr& expr) {
     using OpType = LogicalBinaryExpr::OpType;
-    auto left = call_child(*expr.left_);
-    auto right = call_child(*expr.right_);
+    auto [left, left_unknown] = call_child_with_unknown(*expr.left_);
+    auto [right, right_unknown] = call_child_with_unknown(*expr.right_);
     AssertInfo(left.size() == right.size(), "[ExecExprVisitor]Left size not equal to right size");
-    auto res = std::move(left);
+    // results follow three-valued logic once a child is unknown on null values
+    auto has_unknown = left_unknown.has_value() || right_unknown.has_value();
+    BitsetTypeOpt unknown;
+    auto res = left;
     switch (expr.op_type_) {
+        case OpType::LogicalMinus:
         case OpType::LogicalAnd: {
+            if (expr.op_type_ == OpType::LogicalMinus) {
+                // a - b == a and not b
+                right = FalseRows(right, right_unknown);
+            }
             res &= right;
+            if (has_unknown) {
+                auto false_rows = FalseRows(left, left_unknown) | FalseRows(right, right_unknown);
+                unknown = ~(res | false_rows);
+            }
             break;
         }
         case OpType::LogicalOr: {
             res |= right;
+            if (has_unknown) {
+                auto false_rows = FalseRows(left, left_unknown) & FalseRows(right, right_unknown);
+                unknown = ~(res | false_rows);
+            }
             break;
         }
         case OpType::LogicalXor: {
             res ^= right;
-            break;
-        }
-        case OpType::LogicalMinus: {
-            res -= right;
+            if (has_unknown) {
+                BitsetType unknown_rows(row_count_);
+                if (left_unknown.has_value()) {
+                    unknown_rows |= left_unknown.value();
+                }
+                if (right_unknown.has_value()) {
+                    unknown_rows |= right_unknown.value();
+                }
+                res -= unknown_rows;
+                unknown = std::move(unknown_rows);
+            }
             break;
         }
         default: {
@@ -126,6 +191,7 @@ ExecExprVisitor::visit(LogicalBinaryExpr& expr) {
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
+    unknown_opt_ = std::move(unknown);
 }
 
 static auto
@@ -478,6 +544,7 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    MaskNullRows(expr.field_id_, res);
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -516,6 +583,7 @@ ExecExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    MaskNullRows(expr.field_id_, res);
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -562,6 +630,7 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    MaskNullRows(expr.field_id_, res);
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -749,6 +818,8 @@ ExecExprVisitor::visit(CompareExpr& expr) {
             PanicInfo("unsupported optype");
         }
     }
+    MaskNullRows(expr.left_field_id_, res);
+    MaskNullRows(expr.right_field_id_, res);
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -900,6 +971,266 @@ ExecExprVisitor::visit(TermExpr& expr) {
         default:
             PanicInfo("unsupported");
     }
+    MaskNullRows(expr.field_id_, res);
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
+
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    using OpType = NullExpr::OpType;
+    auto null_rows = segment_.get_null_bitset(expr.field_id_, row_count_);
+    BitsetType res = null_rows.has_value() ? std::move(null_rows.value()) : BitsetType(row_count_);
+    switch (expr.op_type_) {
+        case OpType::IsNull: {
+            break;
+        }
+        case OpType::IsNotNull: {
+            res.flip();
+            break;
+        }
+        default: {
+            PanicInfo("Invalid Null Op");
+        }
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    bitset_opt_ = std::move(res);
+}
+
+template <typename FieldType, typename T>
+static void
+FillArithColumn(const segcore::SegmentInternalInterface& segment,
+                FieldId field_id,
+                int64_t row_count,
+                std::vector<T>& values) {
+    auto size_per_chunk = segment.size_per_chunk();
+    auto num_chunk = upper_div(row_count, size_per_chunk);
+    auto indexing_barrier = segment.num_chunk_index(field_id);
+    auto data_barrier = segment.num_chunk_data(field_id);
+    AssertInfo(std::max(data_barrier, indexing_barrier) == num_chunk,
+               "max(data_barrier, indexing_barrier) not equal to num_chunk");
+
+    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+        auto size = chunk_id == num_chunk - 1 ? row_count - chunk_id * size_per_chunk : size_per_chunk;
+        auto offset = chunk_id * size_per_chunk;
+        if (chunk_id < data_barrier) {
+            auto chunk_data = segment.chunk_data<FieldType>(field_id, chunk_id).data();
+            for (int64_t i = 0; i < size; ++i) {
+                values[offset + i] = static_cast<T>(chunk_data[i]);
+            }
+        } else {
+            // for case, sealed segment has loaded index for scalar field instead of raw data
+            auto& indexing = segment.chunk_scalar_index<FieldType>(field_id, chunk_id);
+            for (int64_t i = 0; i < size; ++i) {
+                values[offset + i] = static_cast<T>(indexing.Reverse_Lookup(i));
+            }
+        }
+    }
+}
+
+template <typename T>
+static std::vector<T>
+EvalArithOperand(const segcore::SegmentInternalInterface& segment,
+                 int64_t row_count,
+                 const ArithOperand& operand,
+                 BitsetType& invalid);
+
+// evaluate an arithmetic operation in its own promoted type U, then cast the result to T
+template <typename T, typename U>
+static std::vector<T>
+EvalArithOperation(const segcore::SegmentInternalInterface& segment,
+                   int64_t row_count,
+                   const ArithOperand& operand,
+                   BitsetType& invalid) {
+    auto left = EvalArithOperand<U>(segment, row_count, *operand.left_, invalid);
+    auto right = EvalArithOperand<U>(segment, row_count, *operand.right_, invalid);
+    for (int64_t i = 0; i < row_count; ++i) {
+        switch (operand.arith_op_) {
+            case ArithOpType::Add: {
+                left[i] += right[i];
+                break;
+            }
+            case ArithOpType::Sub: {
+                left[i] -= right[i];
+                break;
+            }
+            case ArithOpType::Mul: {
+                left[i] *= right[i];
+                break;
+            }
+            case ArithOpType::Div: {
+                if constexpr (std::is_integral_v<U>) {
+                    // integer division by zero has no result, the row matches nothing
+                    if (right[i] == 0) {
+                        invalid[i] = true;
+                        left[i] = 0;
+                        break;
+                    }
+                }
+                left[i] /= right[i];
+                break;
+            }
+            case ArithOpType::Mod: {
+                if constexpr (std::is_integral_v<U>) {
+                    if (right[i] == 0) {
+                        invalid[i] = true;
+                        left[i] = 0;
+                        break;
+                    }
+                    left[i] %= right[i];
+                } else {
+                    left[i] = std::fmod(left[i], right[i]);
+                }
+                break;
+            }
+            default:
+                PanicInfo("unsupported arith op");
+        }
+    }
+    if constexpr (std::is_same_v<T, U>) {
+        return left;
+    } else {
+        return std::vector<T>(left.begin(), left.end());
+    }
+}
+
+template <typename T>
+static std::vector<T>
+EvalArithOperand(const segcore::SegmentInternalInterface& segment,
+                 int64_t row_count,
+                 const ArithOperand& operand,
+                 BitsetType& invalid) {
+    switch (operand.kind_) {
+        case ArithOperand::Kind::Column: {
+            std::vector<T> values(row_count);
+            switch (operand.data_type_) {
+                case DataType::INT8: {
+                    FillArithColumn<int8_t>(segment, operand.field_id_, row_count, values);
+                    break;
+                }
+                case DataType::INT16: {
+                    FillArithColumn<int16_t>(segment, operand.field_id_, row_count, values);
+                    break;
+                }
+                case DataType::INT32: {
+                    FillArithColumn<int32_t>(segment, operand.field_id_, row_count, values);
+                    break;
+                }
+                case DataType::INT64: {
+                    FillArithColumn<int64_t>(segment, operand.field_id_, row_count, values);
+                    break;
+                }
+                case DataType::FLOAT: {
+                    FillArithColumn<float>(segment, operand.field_id_, row_count, values);
+                    break;
+                }
+                case DataType::DOUBLE: {
+                    FillArithColumn<double>(segment, operand.field_id_, row_count, values);
+                    break;
+                }
+                default:
+                    PanicInfo("unsupported datatype");
+            }
+            return values;
+        }
+        case ArithOperand::Kind::Value: {
+            if (operand.data_type_ == DataType::DOUBLE) {
+                return std::vector<T>(row_count, static_cast<T>(operand.float_value_));
+            }
+            return std::vector<T>(row_count, static_cast<T>(operand.int_value_));
+        }
+        case ArithOperand::Kind::Arith: {
+            if (operand.data_type_ == DataType::DOUBLE) {
+                return EvalArithOperation<T, double>(segment, row_count, operand, invalid);
+            }
+            return EvalArithOperation<T, int64_t>(segment, row_count, operand, invalid);
+        }
+        default:
+            PanicInfo("unsupported arith operand");
+    }
+}
+
+static void
+CollectArithOperandFields(const ArithOperand& operand, std::vector<FieldId>& fields) {
+    if (operand.kind_ == ArithOperand::Kind::Column) {
+        fields.push_back(operand.field_id_);
+    } else if (operand.kind_ == ArithOperand::Kind::Arith) {
+        CollectArithOperandFields(*operand.left_, fields);
+        CollectArithOperandFields(*operand.right_, fields);
+    }
+}
+
+template <typename T, typename Op>
+static BitsetType
+ExecArithCompare(const std::vector<T>& left, const std::vector<T>& right, Op op) {
+    BitsetType res(left.size());
+    for (size_t i = 0; i < left.size(); ++i) {
+        res[i] = op(left[i], right[i]);
+    }
+    return res;
+}
+
+template <typename T>
+static BitsetType
+ExecArithCompareDispatcher(const segcore::SegmentInternalInterface& segment,
+                           int64_t row_count,
+                           ArithCompareExpr& expr,
+                           BitsetType& invalid) {
+    auto left = EvalArithOperand<T>(segment, row_count, *expr.left_, invalid);
+    auto right = EvalArithOperand<T>(segment, row_count, *expr.right_, invalid);
+    switch (expr.op_type_) {
+        case OpType::Equal: {
+            return ExecArithCompare(left, right, std::equal_to<>{});
+        }
+        case OpType::NotEqual: {
+            return ExecArithCompare(left, right, std::not_equal_to<>{});
+        }
+        case OpType::GreaterEqual: {
+            return ExecArithCompare(left, right, std::greater_equal<>{});
+        }
+        case OpType::GreaterThan: {
+            return ExecArithCompare(left, right, std::greater<>{});
+        }
+        case OpType::LessEqual: {
+            return ExecArithCompare(left, right, std::less_equal<>{});
+        }
+        case OpType::LessThan: {
+            return ExecArithCompare(left, right, std::less<>{});
+        }
+        default: {
+            PanicInfo("unsupported optype");
+        }
+    }
+}
+
+void
+ExecExprVisitor::visit(ArithCompareExpr& expr) {
+    BitsetType invalid(row_count_);
+    BitsetType res;
+    switch (expr.data_type_) {
+        case DataType::INT64: {
+            res = ExecArithCompareDispatcher<int64_t>(segment_, row_count_, expr, invalid);
+            break;
+        }
+        case DataType::DOUBLE: {
+            res = ExecArithCompareDispatcher<double>(segment_, row_count_, expr, invalid);
+            break;
+        }
+        default:
+            PanicInfo("unsupported datatype");
+    }
+    // like null values, rows divided by zero satisfy neither the comparison nor its negation
+    res -= invalid;
+    if (invalid.any()) {
+        unknown_opt_ = std::move(invalid);
+    }
+
+    std::vector<FieldId> fields;
+    CollectArithOperandFields(*expr.left_, fields);
+    CollectArithOperandFields(*expr.right_, fields);
+    for (auto field_id : fields) {
+        MaskNullRows(field_id, res);
+    }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
     bitset_opt_ = std::move(res);
 }
@@ -64,4 +64,32 @@ ExtractInfoExprVisitor::visit(BinaryArithOpEvalRangeExpr& expr) {
     plan_info_.add_involved_field(expr.field_id_);
 }
 
+void
+ExtractInfoExprVisitor::visit(NullExpr& expr) {
+    plan_info_.add_involved_field(expr.field_id_);
+}
+
+static void
+ExtractArithOperandFields(const ArithOperand& operand, ExtractedPlanInfo& plan_info) {
+    switch (operand.kind_) {
+        case ArithOperand::Kind::Column: {
+            plan_info.add_involved_field(operand.field_id_);
+            break;
+        }
+        case ArithOperand::Kind::Arith: {
+            ExtractArithOperandFields(*operand.left_, plan_info);
+            ExtractArithOperandFields(*operand.right_, plan_info);
+            break;
+        }
+        default:
+            break;
+    }
+}
+
+void
+ExtractInfoExprVisitor::visit(ArithCompareExpr& expr) {
+    ExtractArithOperandFields(*expr.left_, plan_info_);
+    ExtractArithOperandFields(*expr.right_, plan_info_);
+}
+
 }  // namespace milvus::query
@@ -248,6 +248,57 @@ ShowExprVisitor::visit(CompareExpr& expr) {
     json_opt_ = res;
 }
 
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    using proto::plan::NullExpr_NullOp;
+    using proto::plan::NullExpr_NullOp_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "Null"},
+             {"field_id", expr.field_id_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", NullExpr_NullOp_Name(static_cast<NullExpr_NullOp>(expr.op_type_))}};
+    json_opt_ = res;
+}
+
+static Json
+ArithOperandExtract(const ArithOperand& operand) {
+    using proto::plan::ArithOpType_Name;
+    switch (operand.kind_) {
+        case ArithOperand::Kind::Column: {
+            return Json{{"field_id", operand.field_id_.get()}, {"data_type", datatype_name(operand.data_type_)}};
+        }
+        case ArithOperand::Kind::Value: {
+            if (operand.data_type_ == DataType::DOUBLE) {
+                return Json{{"value", operand.float_value_}};
+            }
+            return Json{{"value", operand.int_value_}};
+        }
+        case ArithOperand::Kind::Arith: {
+            return Json{{"arith_op", ArithOpType_Name(operand.arith_op_)},
+                        {"data_type", datatype_name(operand.data_type_)},
+                        {"left", ArithOperandExtract(*operand.left_)},
+                        {"right", ArithOperandExtract(*operand.right_)}};
+        }
+        default:
+            PanicInfo("unsupported arith operand");
+    }
+}
+
+void
+ShowExprVisitor::visit(ArithCompareExpr& expr) {
+    using proto::plan::OpType;
+    using proto::plan::OpType_Name;
+    AssertInfo(!json_opt_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "ArithCompare"},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", OpType_Name(static_cast<OpType>(expr.op_type_))},
+             {"left", ArithOperandExtract(*expr.left_)},
+             {"right", ArithOperandExtract(*expr.right_)}};
+    json_opt_ = res;
+}
+
 template <typename T>
 static Json
 BinaryArithOpEvalRangeExtract(const BinaryArithOpEvalRangeExpr& expr_raw) {
@@ -45,4 +45,24 @@ Collection::parse() {
     schema_ = Schema::ParseFrom(collection_schema);
 }
 
+void
+Collection::update_schema(const std::string& collection_proto) {
+    milvus::proto::schema::CollectionSchema collection_schema;
+    auto suc = google::protobuf::TextFormat::ParseFromString(collection_proto, &collection_schema);
+    AssertInfo(suc, "unmarshal schema string failed");
+
+    auto schema = Schema::ParseFrom(collection_schema);
+    for (auto& field_id : schema_->get_field_ids()) {
+        auto& field_meta = (*schema_)[field_id];
+        auto& new_field_meta = (*schema)[field_id];
+        AssertInfo(field_meta.get_name() == new_field_meta.get_name() &&
+                       field_meta.get_data_type() == new_field_meta.get_data_type(),
+                   "field " + std::to_string(field_id.get()) + " is changed in the new schema");
+    }
+
+    schema_proto_ = collection_proto;
+    retired_schemas_.push_back(std::move(schema_));
+    schema_ = std::move(schema);
+}
+
 }  // namespace milvus::segcore
@@ -13,6 +13,7 @@
 
 #include <memory>
 #include <string>
+#include <vector>
 
 #include "common/Schema.h"
 
@@ -25,6 +26,10 @@ class Collection {
     void
     parse();
 
+    // replace the schema with the one of the same collection which has fields appended
+    void
+    update_schema(const std::string& collection_proto);
+
  public:
     SchemaPtr&
     get_schema() {
@@ -40,6 +45,8 @@ class Collection {
     std::string collection_name_;
     std::string schema_proto_;
     SchemaPtr schema_;
+    // plans created before the schema is updated refer to the former schemas
+    std::vector<SchemaPtr> retired_schemas_;
 };
 
 using CollectionPtr = std::unique_ptr<Collection>;
@@ -12,6 +12,7 @@
 #pragma once
 
 #include <memory>
+#include <optional>
 #include <vector>
 #include <string>
 #include <algorithm>
@@ -164,55 +165,65 @@ struct InsertRecord {
                     }
                 }
             }
-            if (field_meta.is_vector()) {
-                if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
-                    this->append_field_data<FloatVector>(field_id, field_meta.get_dim(), size_per_chunk);
-                    continue;
-                } else if (field_meta.get_data_type() == DataType::VECTOR_BINARY) {
-                    this->append_field_data<BinaryVector>(field_id, field_meta.get_dim(), size_per_chunk);
-                    continue;
-                } else {
-                    PanicInfo("unsupported");
-                }
+            this->append_field(field_meta, size_per_chunk);
+        }
+    }
+
+    // append the column of a field, also used when the field is added to the schema of an existing segment
+    void
+    append_field(const FieldMeta& field_meta, int64_t size_per_chunk) {
+        auto field_id = field_meta.get_id();
+        if (field_meta.is_vector()) {
+            if (field_meta.get_data_type() == DataType::VECTOR_FLOAT) {
+                this->append_field_data<FloatVector>(field_id, field_meta.get_dim(), size_per_chunk);
+                return;
+            } else if (field_meta.get_data_type() == DataType::VECTOR_BINARY) {
+                this->append_field_data<BinaryVector>(field_id, field_meta.get_dim(), size_per_chunk);
+                return;
+            } else {
+                PanicInfo("unsupported");
             }
-            switch (field_meta.get_data_type()) {
-                case DataType::BOOL: {
-                    this->append_field_data<bool>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::INT8: {
-                    this->append_field_data<int8_t>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::INT16: {
-                    this->append_field_data<int16_t>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::INT32: {
-                    this->append_field_data<int32_t>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::INT64: {
-                    this->append_field_data<int64_t>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::FLOAT: {
-                    this->append_field_data<float>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::DOUBLE: {
-                    this->append_field_data<double>(field_id, size_per_chunk);
-                    break;
-                }
-                case DataType::VARCHAR: {
-                    this->append_field_data<std::string>(field_id, size_per_chunk);
-                    break;
-                }
-                default: {
-                    PanicInfo("unsupported");
-                }
+        }
+        switch (field_meta.get_data_type()) {
+            case DataType::BOOL: {
+                this->append_field_data<bool>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::INT8: {
+                this->append_field_data<int8_t>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::INT16: {
+                this->append_field_data<int16_t>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::INT32: {
+                this->append_field_data<int32_t>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::INT64: {
+                this->append_field_data<int64_t>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::FLOAT: {
+                this->append_field_data<float>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::DOUBLE: {
+                this->append_field_data<double>(field_id, size_per_chunk);
+                break;
+            }
+            case DataType::VARCHAR: {
+                this->append_field_data<std::string>(field_id, size_per_chunk);
+                break;
+            }
+            default: {
+                PanicInfo("unsupported");
             }
         }
+        if (field_meta.is_nullable()) {
+            null_data_.emplace(field_id, std::make_unique<ConcurrentVector<bool>>(size_per_chunk));
+        }
     }
 
     std::vector<SegOffset>
@@ -258,6 +269,11 @@ struct InsertRecord {
         pk2offset_->seal();
     }
 
+    bool
+    has_field(FieldId field_id) const {
+        return fields_data_.count(field_id) > 0;
+    }
+
     // get field data without knowing the type
     VectorBase*
     get_field_data_base(FieldId field_id) const {
@@ -305,12 +321,46 @@ struct InsertRecord {
 
     void
     drop_field_data(FieldId field_id) {
+        // null flags are kept since they are still needed when raw data is replaced by index
         fields_data_.erase(field_id);
     }
 
+    // get null flags of a nullable field, nullptr if the field is not nullable, const version
+    const ConcurrentVector<bool>*
+    get_null_data(FieldId field_id) const {
+        auto iter = null_data_.find(field_id);
+        return iter == null_data_.end() ? nullptr : iter->second.get();
+    }
+
+    // get null flags of a nullable field, nullptr if the field is not nullable, non-const version
+    ConcurrentVector<bool>*
+    get_null_data(FieldId field_id) {
+        auto iter = null_data_.find(field_id);
+        return iter == null_data_.end() ? nullptr : iter->second.get();
+    }
+
+    // bitset of the first @count rows where the value of field is null,
+    // std::nullopt if the field is not nullable or none of its null flags is filled
+    std::optional<BitsetType>
+    get_null_bitset(FieldId field_id, int64_t count) const {
+        auto null_data = get_null_data(field_id);
+        if (null_data == nullptr || null_data->num_chunk() == 0) {
+            return std::nullopt;
+        }
+        // rows beyond the filled chunks are never null
+        auto filled = std::min(count, null_data->num_chunk() * null_data->get_size_per_chunk());
+        BitsetType bitset(count);
+        for (int64_t i = 0; i < filled; ++i) {
+            bitset[i] = (*null_data)[i];
+        }
+        return bitset;
+    }
+
  private:
     //    std::vector<std::unique_ptr<VectorBase>> fields_data_;
     std::unordered_map<FieldId, std::unique_ptr<VectorBase>> fields_data_;
+    // null flags of nullable fields, a row never filled is not null
+    std::unordered_map<FieldId, std::unique_ptr<ConcurrentVector<bool>>> null_data_;
     mutable std::shared_mutex shared_mutex_;
 };
 
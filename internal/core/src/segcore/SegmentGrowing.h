@@ -38,6 +38,10 @@ class SegmentGrowing : public SegmentInternalInterface {
            const Timestamp* timestamps,
            const InsertData* insert_data) = 0;
 
+    // fill validity of a nullable field for rows reserved by PreInsert, must be called before Insert
+    virtual void
+    InsertValidData(int64_t reserved_offset, int64_t size, FieldId field_id, const bool* valid_data) = 0;
+
     // virtual int64_t
     // PreDelete(int64_t size) = 0;
 
@@ -75,7 +75,13 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
     insert_record_.timestamps_.set_data_raw(reserved_offset, timestamps_raw, size);
     insert_record_.row_ids_.set_data_raw(reserved_offset, row_ids, size);
     for (auto [field_id, field_meta] : schema_->get_fields()) {
-        AssertInfo(field_id_to_offset.count(field_id), "Cannot find field_id");
+        if (!field_id_to_offset.count(field_id)) {
+            // only the rows reserved before the field was added can omit it, they are filled on adding
+            auto iter = added_field_rows_.find(field_id);
+            AssertInfo(iter != added_field_rows_.end() && reserved_offset + size <= iter->second,
+                       "Cannot find field_id");
+            continue;
+        }
         auto data_offset = field_id_to_offset[field_id];
         insert_record_.get_field_data_base(field_id)->set_data_raw(reserved_offset, size,
                                                                    &insert_data->fields_data(data_offset), field_meta);
@@ -98,6 +104,44 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
     }
 }
 
+void
+SegmentGrowingImpl::InsertValidData(int64_t reserved_offset, int64_t size, FieldId field_id, const bool* valid_data) {
+    auto null_data = insert_record_.get_null_data(field_id);
+    AssertInfo(null_data != nullptr, "field " + std::to_string(field_id.get()) + " is not nullable");
+    FixedVector<bool> nulls(size);
+    for (int64_t i = 0; i < size; ++i) {
+        nulls[i] = !valid_data[i];
+    }
+    null_data->set_data_raw(reserved_offset, nulls.data(), size);
+}
+
+void
+SegmentGrowingImpl::AddField(SchemaPtr schema, FieldId field_id, const DataArray* default_value) {
+    auto& field_meta = (*schema)[field_id];
+    AssertInfo(!field_meta.is_vector(), "only scalar field can be added to an existing segment");
+    AssertInfo(default_value != nullptr || field_meta.is_nullable(),
+               "field " + std::to_string(field_id.get()) + " is neither nullable nor has default value");
+
+    std::unique_lock lck(mutex_);
+    AssertInfo(!insert_record_.has_field(field_id),
+               "field " + std::to_string(field_id.get()) + " already exists in segment");
+    insert_record_.append_field(field_meta, segcore_config_.get_chunk_rows());
+
+    // fill the rows inserted or reserved before the field was added
+    auto row_count = insert_record_.reserved.load();
+    if (row_count > 0) {
+        auto data = default_value != nullptr ? RepeatScalarDataArray(*default_value, row_count, field_meta)
+                                             : CreateScalarDataArray(row_count, field_meta);
+        insert_record_.get_field_data_base(field_id)->set_data_raw(0, row_count, data.get(), field_meta);
+        if (default_value == nullptr) {
+            FixedVector<bool> nulls(row_count, true);
+            insert_record_.get_null_data(field_id)->set_data_raw(0, nulls.data(), row_count);
+        }
+    }
+    added_field_rows_.emplace(field_id, row_count);
+    schema_ = std::move(schema);
+}
+
 Status
 SegmentGrowingImpl::Delete(int64_t reserved_begin, int64_t size, const IdArray* ids, const Timestamp* timestamps_raw) {
     auto field_id = schema_->get_primary_field_id().value_or(FieldId(-1));
@@ -394,4 +438,10 @@ SegmentGrowingImpl::mask_with_timestamps(BitsetType& bitset_chunk, Timestamp tim
     // DO NOTHING
 }
 
+std::optional<BitsetType>
+SegmentGrowingImpl::get_null_bitset(FieldId field_id, int64_t count) const {
+    // null flags are filled before the rows are acked, no lock is needed
+    return insert_record_.get_null_bitset(field_id, count);
+}
+
 }  // namespace milvus::segcore
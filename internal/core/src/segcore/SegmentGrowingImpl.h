@@ -18,6 +18,7 @@
 #include <tbb/concurrent_priority_queue.h>
 #include <tbb/concurrent_unordered_map.h>
 #include <tbb/concurrent_vector.h>
+#include <unordered_map>
 #include <vector>
 #include <utility>
 
@@ -48,6 +49,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
            const Timestamp* timestamps,
            const InsertData* insert_data) override;
 
+    void
+    InsertValidData(int64_t reserved_offset, int64_t size, FieldId field_id, const bool* valid_data) override;
+
     int64_t
     PreDelete(int64_t size) override;
 
@@ -61,6 +65,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     LoadDeletedRecord(const LoadDeletedRecordInfo& info) override;
 
+    void
+    AddField(SchemaPtr schema, FieldId field_id, const DataArray* default_value) override;
+
     std::string
     debug() const override;
 
@@ -173,6 +180,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const override;
 
+    std::optional<BitsetType>
+    get_null_bitset(FieldId field_id, int64_t count) const override;
+
     void
     vector_search(SearchInfo& search_info,
                   const void* query_data,
@@ -230,6 +240,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     // deleted pks
     mutable DeletedRecord deleted_record_;
 
+    // added field -> count of rows reserved before the field was added, which are filled on adding
+    std::unordered_map<FieldId, int64_t> added_field_rows_;
+
     int64_t id_;
 
  private:
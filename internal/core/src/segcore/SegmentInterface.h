@@ -78,6 +78,11 @@ class SegmentInterface {
     virtual void
     LoadDeletedRecord(const LoadDeletedRecordInfo& info) = 0;
 
+    // append a field added to the collection schema, rows existing in the segment take @default_value,
+    // or null if @default_value is nullptr
+    virtual void
+    AddField(SchemaPtr schema, FieldId field_id, const DataArray* default_value) = 0;
+
     virtual int64_t
     get_segment_id() const = 0;
 };
@@ -152,6 +157,11 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const = 0;
 
+    // bitset of the first @count rows where the value of field is null,
+    // std::nullopt if no row of the field is null
+    virtual std::optional<BitsetType>
+    get_null_bitset(FieldId field_id, int64_t count) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -30,6 +30,8 @@ class SegmentSealed : public SegmentInternalInterface {
     virtual void
     LoadFieldData(const LoadFieldDataInfo& info) = 0;
     virtual void
+    LoadValidData(FieldId field_id, const bool* valid_data, int64_t row_count) = 0;
+    virtual void
     DropIndex(const FieldId field_id) = 0;
     virtual void
     DropFieldData(const FieldId field_id) = 0;
@@ -219,6 +219,61 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     update_row_count(info.row_count);
 }
 
+void
+SegmentSealedImpl::LoadValidData(FieldId field_id, const bool* valid_data, int64_t row_count) {
+    AssertInfo(row_count > 0, "The row count of valid data is 0");
+    AssertInfo(valid_data != nullptr, "Valid data is null");
+    if (row_count_opt_.has_value()) {
+        AssertInfo(row_count_opt_.value() == row_count, "field (" + std::to_string(field_id.get()) +
+                                                            ") valid data has different row count (" +
+                                                            std::to_string(row_count) +
+                                                            ") than other column's row count (" +
+                                                            std::to_string(row_count_opt_.value()) + ")");
+    }
+    FixedVector<bool> nulls(row_count);
+    for (int64_t i = 0; i < row_count; ++i) {
+        nulls[i] = !valid_data[i];
+    }
+
+    // write data under lock
+    std::unique_lock lck(mutex_);
+    auto null_data = insert_record_.get_null_data(field_id);
+    AssertInfo(null_data != nullptr, "field " + std::to_string(field_id.get()) + " is not nullable");
+    AssertInfo(null_data->empty(), "already exists");
+    null_data->fill_chunk_data(nulls.data(), row_count);
+    lck.unlock();
+    update_row_count(row_count);
+}
+
+void
+SegmentSealedImpl::AddField(SchemaPtr schema, FieldId field_id, const DataArray* default_value) {
+    auto& field_meta = (*schema)[field_id];
+    AssertInfo(!field_meta.is_vector(), "only scalar field can be added to an existing segment");
+    AssertInfo(default_value != nullptr || field_meta.is_nullable(),
+               "field " + std::to_string(field_id.get()) + " is neither nullable nor has default value");
+
+    std::unique_lock lck(mutex_);
+    AssertInfo(!insert_record_.has_field(field_id),
+               "field " + std::to_string(field_id.get()) + " already exists in segment");
+    insert_record_.append_field(field_meta, MAX_ROW_COUNT);
+    field_data_ready_bitset_.resize(schema->size());
+    index_ready_bitset_.resize(schema->size());
+
+    // fill the rows loaded before the field was added, otherwise the field is loaded as other fields
+    if (row_count_opt_.has_value() && row_count_opt_.value() > 0) {
+        auto row_count = row_count_opt_.value();
+        auto data = default_value != nullptr ? RepeatScalarDataArray(*default_value, row_count, field_meta)
+                                             : CreateScalarDataArray(row_count, field_meta);
+        insert_record_.get_field_data_base(field_id)->fill_chunk_data(row_count, data.get(), field_meta);
+        if (default_value == nullptr) {
+            FixedVector<bool> nulls(row_count, true);
+            insert_record_.get_null_data(field_id)->fill_chunk_data(nulls.data(), row_count);
+        }
+        set_bit(field_data_ready_bitset_, field_id, true);
+    }
+    schema_ = std::move(schema);
+}
+
 void
 SegmentSealedImpl::LoadDeletedRecord(const LoadDeletedRecordInfo& info) {
     AssertInfo(info.row_count > 0, "The row count of deleted record is 0");
@@ -399,8 +454,12 @@ SegmentSealedImpl::check_search(const query::Plan* plan) const {
                   std::to_string(this->id_));
     }
 
-    auto& request_fields = plan->extra_info_opt_.value().involved_fields_;
+    auto request_fields = plan->extra_info_opt_.value().involved_fields_;
     auto field_ready_bitset = field_data_ready_bitset_ | index_ready_bitset_;
+    // plans created before fields were added to the segment involve none of them
+    if (request_fields.size() < field_ready_bitset.size()) {
+        request_fields.resize(field_ready_bitset.size());
+    }
     AssertInfo(request_fields.size() == field_ready_bitset.size(),
                "Request fields size not equal to field ready bitset size when check search");
     auto absent_fields = request_fields - field_ready_bitset;
@@ -720,4 +779,10 @@ SegmentSealedImpl::mask_with_timestamps(BitsetType& bitset_chunk, Timestamp time
     bitset_chunk |= mask;
 }
 
+std::optional<BitsetType>
+SegmentSealedImpl::get_null_bitset(FieldId field_id, int64_t count) const {
+    std::shared_lock lck(mutex_);
+    return insert_record_.get_null_bitset(field_id, count);
+}
+
 }  // namespace milvus::segcore
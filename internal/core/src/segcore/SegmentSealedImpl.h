@@ -39,8 +39,12 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     LoadFieldData(const LoadFieldDataInfo& info) override;
     void
+    LoadValidData(FieldId field_id, const bool* valid_data, int64_t row_count) override;
+    void
     LoadDeletedRecord(const LoadDeletedRecordInfo& info) override;
     void
+    AddField(SchemaPtr schema, FieldId field_id, const DataArray* default_value) override;
+    void
     LoadSegmentMeta(const milvus::proto::segcore::LoadSegmentMeta& segment_meta) override;
     void
     DropIndex(const FieldId field_id) override;
@@ -141,6 +145,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(BitsetType& bitset_chunk, Timestamp timestamp) const override;
 
+    std::optional<BitsetType>
+    get_null_bitset(FieldId field_id, int64_t count) const override;
+
     void
     vector_search(SearchInfo& search_info,
                   const void* query_data,
@@ -223,6 +223,59 @@ CreateScalarDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta&
     return data_array;
 }
 
+std::unique_ptr<DataArray>
+RepeatScalarDataArray(const DataArray& value, int64_t count, const FieldMeta& field_meta) {
+    auto data_type = field_meta.get_data_type();
+    AssertInfo(DataType(value.type()) == data_type, "data type of value is inconsistent with the field");
+    auto data_array = std::make_unique<DataArray>();
+    data_array->set_field_id(field_meta.get_id().get());
+    data_array->set_type(milvus::proto::schema::DataType(field_meta.get_data_type()));
+
+    auto& src = value.scalars();
+    auto scalar_array = data_array->mutable_scalars();
+    switch (data_type) {
+        case DataType::BOOL: {
+            AssertInfo(src.bool_data().data_size() == 1, "value should have exactly one row");
+            scalar_array->mutable_bool_data()->mutable_data()->Resize(count, src.bool_data().data(0));
+            break;
+        }
+        case DataType::INT8:
+        case DataType::INT16:
+        case DataType::INT32: {
+            AssertInfo(src.int_data().data_size() == 1, "value should have exactly one row");
+            scalar_array->mutable_int_data()->mutable_data()->Resize(count, src.int_data().data(0));
+            break;
+        }
+        case DataType::INT64: {
+            AssertInfo(src.long_data().data_size() == 1, "value should have exactly one row");
+            scalar_array->mutable_long_data()->mutable_data()->Resize(count, src.long_data().data(0));
+            break;
+        }
+        case DataType::FLOAT: {
+            AssertInfo(src.float_data().data_size() == 1, "value should have exactly one row");
+            scalar_array->mutable_float_data()->mutable_data()->Resize(count, src.float_data().data(0));
+            break;
+        }
+        case DataType::DOUBLE: {
+            AssertInfo(src.double_data().data_size() == 1, "value should have exactly one row");
+            scalar_array->mutable_double_data()->mutable_data()->Resize(count, src.double_data().data(0));
+            break;
+        }
+        case DataType::VARCHAR: {
+            AssertInfo(src.string_data().data_size() == 1, "value should have exactly one row");
+            auto obj = scalar_array->mutable_string_data();
+            obj->mutable_data()->Reserve(count);
+            for (auto i = 0; i < count; i++) *(obj->mutable_data()->Add()) = src.string_data().data(0);
+            break;
+        }
+        default: {
+            PanicInfo("unsupported datatype");
+        }
+    }
+
+    return data_array;
+}
+
 std::unique_ptr<DataArray>
 CreateVectorDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_meta) {
     auto data_type = field_meta.get_data_type();
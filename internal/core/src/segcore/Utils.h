@@ -51,6 +51,10 @@ CreateVectorDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta&
 std::unique_ptr<DataArray>
 CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_meta);
 
+// create a scalar data array of count rows, all rows take the single value of @value
+std::unique_ptr<DataArray>
+RepeatScalarDataArray(const DataArray& value, int64_t count, const FieldMeta& field_meta);
+
 // TODO remove merge dataArray, instead fill target entity when get data slice
 std::unique_ptr<DataArray>
 MergeDataArray(std::vector<std::pair<milvus::SearchResult*, int64_t>>& result_offsets, const FieldMeta& field_meta);
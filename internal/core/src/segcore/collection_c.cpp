@@ -14,6 +14,7 @@
 #endif
 
 #include <iostream>
+#include "common/CGoHelper.h"
 #include "segcore/collection_c.h"
 #include "segcore/Collection.h"
 
@@ -30,6 +31,17 @@ DeleteCollection(CCollection collection) {
     delete col;
 }
 
+CStatus
+UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob) {
+    try {
+        auto col = (milvus::segcore::Collection*)collection;
+        col->update_schema(std::string(schema_proto_blob));
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 const char*
 GetCollectionName(CCollection collection) {
     auto col = (milvus::segcore::Collection*)collection;
@@ -15,6 +15,8 @@
 extern "C" {
 #endif
 
+#include "common/type_c.h"
+
 typedef void* CCollection;
 
 CCollection
@@ -23,6 +25,9 @@ NewCollection(const char* schema_proto_blob);
 void
 DeleteCollection(CCollection collection);
 
+CStatus
+UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+
 const char*
 GetCollectionName(CCollection collection);
 
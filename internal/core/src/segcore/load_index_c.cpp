@@ -33,6 +33,7 @@ NewLoadIndexInfo(CLoadIndexInfo* c_load_index_info, CStorageConfig c_storage_con
         storage_config.iam_endpoint = std::string(c_storage_config.iam_endpoint);
         storage_config.useSSL = c_storage_config.useSSL;
         storage_config.useIAM = c_storage_config.useIAM;
+        storage_config.encryption_keys = std::string(c_storage_config.encryption_keys);
 
         *c_load_index_info = load_index_info.release();
         auto status = CStatus();
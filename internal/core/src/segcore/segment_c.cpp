@@ -170,6 +170,21 @@ PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset) {
     }
 }
 
+CStatus
+InsertValidData(CSegmentInterface c_segment,
+                int64_t reserved_offset,
+                int64_t size,
+                int64_t field_id,
+                const bool* valid_data) {
+    try {
+        auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
+        segment->InsertValidData(reserved_offset, size, milvus::FieldId(field_id), valid_data);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
@@ -215,6 +230,19 @@ LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_in
     }
 }
 
+CStatus
+LoadFieldValidData(CSegmentInterface c_segment, int64_t field_id, const bool* valid_data, int64_t row_count) {
+    try {
+        auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment = dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        segment->LoadValidData(milvus::FieldId(field_id), valid_data, row_count);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment, CLoadDeletedRecordInfo deleted_record_info) {
     try {
@@ -232,6 +260,29 @@ LoadDeletedRecord(CSegmentInterface c_segment, CLoadDeletedRecordInfo deleted_re
     }
 }
 
+CStatus
+AddField(CSegmentInterface c_segment,
+         CCollection c_collection,
+         int64_t field_id,
+         const void* default_value_blob,
+         int64_t blob_size) {
+    try {
+        auto segment = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        auto col = (milvus::segcore::Collection*)c_collection;
+        std::unique_ptr<milvus::DataArray> default_value;
+        if (blob_size > 0) {
+            default_value = std::make_unique<milvus::DataArray>();
+            auto suc = default_value->ParseFromArray(default_value_blob, blob_size);
+            AssertInfo(suc, "unmarshal default value failed");
+        }
+        segment->AddField(col->get_schema(), milvus::FieldId(field_id), default_value.get());
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 CStatus
 UpdateSealedSegmentIndex(CSegmentInterface c_segment, CLoadIndexInfo c_load_index_info) {
     try {
@@ -75,13 +75,30 @@ Insert(CSegmentInterface c_segment,
 CStatus
 PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset);
 
+CStatus
+InsertValidData(CSegmentInterface c_segment,
+                int64_t reserved_offset,
+                int64_t size,
+                int64_t field_id,
+                const bool* valid_data);
+
 //////////////////////////////    interfaces for sealed segment    //////////////////////////////
 CStatus
 LoadFieldData(CSegmentInterface c_segment, CLoadFieldDataInfo load_field_data_info);
 
+CStatus
+LoadFieldValidData(CSegmentInterface c_segment, int64_t field_id, const bool* valid_data, int64_t row_count);
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment, CLoadDeletedRecordInfo deleted_record_info);
 
+CStatus
+AddField(CSegmentInterface c_segment,
+         CCollection c_collection,
+         int64_t field_id,
+         const void* default_value_blob,
+         int64_t blob_size);
+
 CStatus
 UpdateSealedSegmentIndex(CSegmentInterface c_segment, CLoadIndexInfo c_load_index_info);
 
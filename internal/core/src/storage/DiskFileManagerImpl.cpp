@@ -20,6 +20,7 @@
 
 #include "common/Common.h"
 #include "common/Slice.h"
+#include "exceptions/EasyAssert.h"
 #include "log/Log.h"
 #include "config/ConfigKnowhere.h"
 #include "storage/DiskFileManagerImpl.h"
@@ -64,8 +65,12 @@ DiskFileManagerImpl::DiskFileManagerImpl(const FieldDataMeta& field_mata,
                                          const IndexMeta& index_meta,
                                          const StorageConfig& storage_config)
     : field_meta_(field_mata), index_meta_(index_meta) {
+    AssertInfo(storage_config.storage_type != "azure", "disk index is not supported on azure blob storage");
     remote_root_path_ = storage_config.remote_root_path;
     rcm_ = std::make_unique<MinioChunkManager>(storage_config);
+    if (!storage_config.encryption_keys.empty()) {
+        data_keys_ = ParseDataKeys(storage_config.encryption_keys);
+    }
 }
 
 DiskFileManagerImpl::~DiskFileManagerImpl() {
@@ -80,6 +85,7 @@ DiskFileManagerImpl::LoadFile(const std::string& file) noexcept {
 
 std::pair<std::string, size_t>
 EncodeAndUploadIndexSlice(RemoteChunkManager* remote_chunk_manager,
+                          const std::optional<DataKeys>* data_keys,
                           uint8_t* buf,
                           int64_t offset,
                           int64_t batch_size,
@@ -91,6 +97,10 @@ EncodeAndUploadIndexSlice(RemoteChunkManager* remote_chunk_manager,
     indexData->set_index_meta(index_meta);
     indexData->SetFieldDataMeta(field_meta);
     auto serialized_index_data = indexData->serialize_to_remote_file();
+    if (data_keys->has_value()) {
+        serialized_index_data = EncryptFileData(data_keys->value(), serialized_index_data.data(),
+                                                serialized_index_data.size());
+    }
     auto serialized_index_size = serialized_index_data.size();
     remote_chunk_manager->Write(object_key, serialized_index_data.data(), serialized_index_size);
     return std::pair<std::string, size_t>(object_key, serialized_index_size);
@@ -125,7 +135,7 @@ DiskFileManagerImpl::AddFile(const std::string& file) noexcept {
         char objectKey[200];
         snprintf(objectKey, sizeof(objectKey), "%s/%s_%d", remotePrefix.c_str(), fileName.c_str(), slice_num);
         // use multi-thread to put part file
-        futures.push_back(pool.Submit(EncodeAndUploadIndexSlice, rcm_.get(), buf.get(), offset, batch_size, index_meta_,
+        futures.push_back(pool.Submit(EncodeAndUploadIndexSlice, rcm_.get(), &data_keys_, buf.get(), offset, batch_size, index_meta_,
                                       field_meta_, std::string(objectKey)));
         offset += batch_size;
     }
@@ -188,11 +198,17 @@ DiskFileManagerImpl::CacheIndexToDisk(std::vector<std::string> remote_files) {
 }
 
 std::unique_ptr<DataCodec>
-DownloadAndDecodeRemoteIndexfile(RemoteChunkManager* remote_chunk_manager, std::string file) {
+DownloadAndDecodeRemoteIndexfile(RemoteChunkManager* remote_chunk_manager,
+                                 const std::optional<DataKeys>* data_keys,
+                                 std::string file) {
     auto fileSize = remote_chunk_manager->Size(file);
     auto buf = std::shared_ptr<uint8_t[]>(new uint8_t[fileSize]);
     remote_chunk_manager->Read(file, buf.get(), fileSize);
 
+    if (data_keys->has_value()) {
+        auto plain = DecryptFileData(data_keys->value(), buf.get(), fileSize);
+        return DeserializeFileData(plain.data(), plain.size());
+    }
     return DeserializeFileData(buf.get(), fileSize);
 }
 
@@ -206,7 +222,7 @@ DiskFileManagerImpl::CacheBatchIndexFilesToDisk(const std::vector<std::string>&
 
     std::vector<std::future<std::unique_ptr<DataCodec>>> futures;
     for (int i = 0; i < batch_size; ++i) {
-        futures.push_back(pool.Submit(DownloadAndDecodeRemoteIndexfile, rcm_.get(), remote_files[i]));
+        futures.push_back(pool.Submit(DownloadAndDecodeRemoteIndexfile, rcm_.get(), &data_keys_, remote_files[i]));
     }
 
     uint64_t offset = local_file_init_offfset;
@@ -22,6 +22,7 @@
 #include <string>
 #include <vector>
 
+#include "storage/Encryption.h"
 #include "storage/IndexData.h"
 #include "storage/FileManager.h"
 #include "storage/LocalChunkManager.h"
@@ -118,6 +119,9 @@ class DiskFileManagerImpl : public FileManagerImpl {
 
     RemoteChunkManagerPtr rcm_;
     std::string remote_root_path_;
+
+    // the index files are encrypted if the data keys are set
+    std::optional<DataKeys> data_keys_;
 };
 
 using DiskANNFileManagerImplPtr = std::shared_ptr<DiskFileManagerImpl>;
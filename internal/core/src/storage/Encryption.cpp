@@ -0,0 +1,272 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include <algorithm>
+#include <cstring>
+#include <memory>
+
+#include <openssl/evp.h>
+#include <openssl/rand.h>
+
+#include "nlohmann/json.hpp"
+#include "storage/Encryption.h"
+#include "storage/Exception.h"
+
+namespace milvus::storage {
+
+namespace {
+
+// see internal/storage/encrypted_chunk_manager.go for the layout of an encrypted file:
+// | magic(4) | version(1) | collectionID(8) | dataKeyID(8) | chunkSize(4) | nonce(12) | chunk 0 | chunk 1 | ... |
+constexpr char kEncryptedFileMagic[] = "MVSE";
+constexpr int64_t kMagicSize = 4;
+constexpr uint8_t kEncryptedFileVersion = 2;
+constexpr int64_t kNonceSize = 12;
+constexpr int64_t kTagSize = 16;
+constexpr int64_t kHeaderSize = kMagicSize + 1 + 8 + 8 + 4 + kNonceSize;
+constexpr int64_t kChunkSize = 64 << 10;
+constexpr size_t kDataKeySize = 32;
+
+struct CipherCtxDeleter {
+    void
+    operator()(EVP_CIPHER_CTX* ctx) const {
+        EVP_CIPHER_CTX_free(ctx);
+    }
+};
+using CipherCtxPtr = std::unique_ptr<EVP_CIPHER_CTX, CipherCtxDeleter>;
+
+void
+PutUint64(uint8_t* dst, uint64_t value) {
+    for (int i = 0; i < 8; i++) {
+        dst[i] = static_cast<uint8_t>(value >> (8 * i));
+    }
+}
+
+uint64_t
+GetUint64(const uint8_t* src) {
+    uint64_t value = 0;
+    for (int i = 0; i < 8; i++) {
+        value |= static_cast<uint64_t>(src[i]) << (8 * i);
+    }
+    return value;
+}
+
+void
+PutUint32(uint8_t* dst, uint32_t value) {
+    for (int i = 0; i < 4; i++) {
+        dst[i] = static_cast<uint8_t>(value >> (8 * i));
+    }
+}
+
+uint32_t
+GetUint32(const uint8_t* src) {
+    uint32_t value = 0;
+    for (int i = 0; i < 4; i++) {
+        value |= static_cast<uint32_t>(src[i]) << (8 * i);
+    }
+    return value;
+}
+
+std::string
+DecodeHex(const std::string& hex) {
+    auto value_of = [](char c) -> int {
+        if (c >= '0' && c <= '9') {
+            return c - '0';
+        }
+        if (c >= 'a' && c <= 'f') {
+            return c - 'a' + 10;
+        }
+        if (c >= 'A' && c <= 'F') {
+            return c - 'A' + 10;
+        }
+        throw EncryptionException("invalid hex encoded data key");
+    };
+    if (hex.size() % 2 != 0) {
+        throw EncryptionException("invalid hex encoded data key");
+    }
+    std::string decoded(hex.size() / 2, '\0');
+    for (size_t i = 0; i < decoded.size(); i++) {
+        decoded[i] = static_cast<char>(value_of(hex[2 * i]) << 4 | value_of(hex[2 * i + 1]));
+    }
+    return decoded;
+}
+
+// the nonce of chunk @index is the file nonce xor @index, in big endian
+std::vector<uint8_t>
+ChunkNonce(const uint8_t* nonce, int64_t index) {
+    std::vector<uint8_t> chunk_nonce(nonce, nonce + kNonceSize);
+    for (int i = 0; i < 8; i++) {
+        chunk_nonce[kNonceSize - 1 - i] ^= static_cast<uint8_t>(static_cast<uint64_t>(index) >> (8 * i));
+    }
+    return chunk_nonce;
+}
+
+// the header, the chunk index and whether it is the last chunk are authenticated as additional data
+std::vector<uint8_t>
+ChunkAAD(const uint8_t* header, int64_t index, bool last) {
+    std::vector<uint8_t> aad(kHeaderSize + 9);
+    memcpy(aad.data(), header, kHeaderSize);
+    PutUint64(aad.data() + kHeaderSize, static_cast<uint64_t>(index));
+    aad[kHeaderSize + 8] = last ? 1 : 0;
+    return aad;
+}
+
+// SealChunk writes the ciphertext of @plain followed by the tag to @out
+void
+SealChunk(const std::string& key,
+          const std::vector<uint8_t>& nonce,
+          const std::vector<uint8_t>& aad,
+          const uint8_t* plain,
+          int size,
+          uint8_t* out) {
+    CipherCtxPtr ctx(EVP_CIPHER_CTX_new());
+    int len = 0;
+    if (ctx == nullptr || EVP_EncryptInit_ex(ctx.get(), EVP_aes_256_gcm(), nullptr, nullptr, nullptr) != 1 ||
+        EVP_CIPHER_CTX_ctrl(ctx.get(), EVP_CTRL_GCM_SET_IVLEN, kNonceSize, nullptr) != 1 ||
+        EVP_EncryptInit_ex(ctx.get(), nullptr, nullptr, reinterpret_cast<const uint8_t*>(key.data()), nonce.data()) !=
+            1 ||
+        EVP_EncryptUpdate(ctx.get(), nullptr, &len, aad.data(), static_cast<int>(aad.size())) != 1 ||
+        (size > 0 && EVP_EncryptUpdate(ctx.get(), out, &len, plain, size) != 1) ||
+        EVP_EncryptFinal_ex(ctx.get(), out + size, &len) != 1 ||
+        EVP_CIPHER_CTX_ctrl(ctx.get(), EVP_CTRL_GCM_GET_TAG, kTagSize, out + size) != 1) {
+        throw EncryptionException("failed to encrypt file");
+    }
+}
+
+// OpenChunk decrypts @sealed, which is the ciphertext followed by the tag, to @out
+void
+OpenChunk(const std::string& key,
+          const std::vector<uint8_t>& nonce,
+          const std::vector<uint8_t>& aad,
+          const uint8_t* sealed,
+          int size,
+          uint8_t* out) {
+    if (size < kTagSize) {
+        throw EncryptionException("encrypted file is truncated");
+    }
+    auto plain_size = size - static_cast<int>(kTagSize);
+    auto tag = const_cast<uint8_t*>(sealed + plain_size);
+    CipherCtxPtr ctx(EVP_CIPHER_CTX_new());
+    int len = 0;
+    if (ctx == nullptr || EVP_DecryptInit_ex(ctx.get(), EVP_aes_256_gcm(), nullptr, nullptr, nullptr) != 1 ||
+        EVP_CIPHER_CTX_ctrl(ctx.get(), EVP_CTRL_GCM_SET_IVLEN, kNonceSize, nullptr) != 1 ||
+        EVP_DecryptInit_ex(ctx.get(), nullptr, nullptr, reinterpret_cast<const uint8_t*>(key.data()), nonce.data()) !=
+            1 ||
+        EVP_DecryptUpdate(ctx.get(), nullptr, &len, aad.data(), static_cast<int>(aad.size())) != 1 ||
+        (plain_size > 0 && EVP_DecryptUpdate(ctx.get(), out, &len, sealed, plain_size) != 1) ||
+        EVP_CIPHER_CTX_ctrl(ctx.get(), EVP_CTRL_GCM_SET_TAG, kTagSize, tag) != 1 ||
+        EVP_DecryptFinal_ex(ctx.get(), out + plain_size, &len) != 1) {
+        throw EncryptionException("failed to decrypt file, message authentication failed");
+    }
+}
+
+}  // namespace
+
+DataKeys
+ParseDataKeys(const std::string& serialized) {
+    DataKeys data_keys;
+    try {
+        auto json = nlohmann::json::parse(serialized);
+        data_keys.collection_id = json.at("collection_id").get<int64_t>();
+        data_keys.active_key_id = json.at("active_key_id").get<int64_t>();
+        data_keys.strict = json.value("strict", false);
+        for (auto& [key_id, key] : json.at("keys").items()) {
+            data_keys.keys[std::stoll(key_id)] = DecodeHex(key.get<std::string>());
+        }
+    } catch (nlohmann::json::exception& e) {
+        throw EncryptionException(std::string("failed to parse data keys: ") + e.what());
+    }
+    for (auto& [key_id, key] : data_keys.keys) {
+        if (key.size() != kDataKeySize) {
+            throw EncryptionException("invalid size of data key " + std::to_string(key_id));
+        }
+    }
+    if (data_keys.keys.find(data_keys.active_key_id) == data_keys.keys.end()) {
+        throw EncryptionException("active data key " + std::to_string(data_keys.active_key_id) + " is missing");
+    }
+    return data_keys;
+}
+
+std::vector<uint8_t>
+EncryptFileData(const DataKeys& data_keys, const uint8_t* data, int64_t size) {
+    auto& key = data_keys.keys.at(data_keys.active_key_id);
+    auto chunks = size == 0 ? 1 : (size + kChunkSize - 1) / kChunkSize;
+    std::vector<uint8_t> encrypted(kHeaderSize + size + chunks * kTagSize);
+
+    auto header = encrypted.data();
+    memcpy(header, kEncryptedFileMagic, kMagicSize);
+    header[kMagicSize] = kEncryptedFileVersion;
+    PutUint64(header + kMagicSize + 1, static_cast<uint64_t>(data_keys.collection_id));
+    PutUint64(header + kMagicSize + 9, static_cast<uint64_t>(data_keys.active_key_id));
+    PutUint32(header + kMagicSize + 17, static_cast<uint32_t>(kChunkSize));
+    auto nonce = header + kMagicSize + 21;
+    if (RAND_bytes(nonce, kNonceSize) != 1) {
+        throw EncryptionException("failed to generate nonce");
+    }
+
+    auto out = encrypted.data() + kHeaderSize;
+    for (int64_t index = 0; index < chunks; index++) {
+        auto offset = index * kChunkSize;
+        auto chunk_size = std::min(kChunkSize, size - offset);
+        SealChunk(key, ChunkNonce(nonce, index), ChunkAAD(header, index, index == chunks - 1), data + offset,
+                  static_cast<int>(chunk_size), out);
+        out += chunk_size + kTagSize;
+    }
+    return encrypted;
+}
+
+std::vector<uint8_t>
+DecryptFileData(const DataKeys& data_keys, const uint8_t* data, int64_t size) {
+    if (size < kHeaderSize || memcmp(data, kEncryptedFileMagic, kMagicSize) != 0) {
+        if (data_keys.strict) {
+            throw EncryptionException("file is not encrypted");
+        }
+        return std::vector<uint8_t>(data, data + size);
+    }
+    if (data[kMagicSize] != kEncryptedFileVersion) {
+        throw EncryptionException("unsupported encrypted file version " + std::to_string(data[kMagicSize]));
+    }
+    auto key_id = static_cast<int64_t>(GetUint64(data + kMagicSize + 9));
+    auto chunk_size = static_cast<int64_t>(GetUint32(data + kMagicSize + 17));
+    auto nonce = data + kMagicSize + 21;
+    auto iter = data_keys.keys.find(key_id);
+    if (iter == data_keys.keys.end()) {
+        throw EncryptionException("data key " + std::to_string(key_id) + " is missing");
+    }
+    if (chunk_size == 0) {
+        throw EncryptionException("invalid chunk size 0 of encrypted file");
+    }
+
+    auto body = size - kHeaderSize;
+    auto sealed_chunk_size = chunk_size + kTagSize;
+    auto chunks = (body + sealed_chunk_size - 1) / sealed_chunk_size;
+    if (chunks == 0 || body - chunks * kTagSize < (chunks - 1) * chunk_size) {
+        throw EncryptionException("encrypted file is truncated");
+    }
+    std::vector<uint8_t> plain(body - chunks * kTagSize);
+    auto sealed = data + kHeaderSize;
+    auto out = plain.data();
+    for (int64_t index = 0; index < chunks; index++) {
+        auto n = std::min(sealed_chunk_size, body - index * sealed_chunk_size);
+        OpenChunk(iter->second, ChunkNonce(nonce, index), ChunkAAD(data, index, index == chunks - 1), sealed,
+                  static_cast<int>(n), out);
+        sealed += n;
+        out += n - kTagSize;
+    }
+    return plain;
+}
+
+}  // namespace milvus::storage
@@ -0,0 +1,47 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <cstdint>
+#include <map>
+#include <string>
+#include <vector>
+
+namespace milvus::storage {
+
+// DataKeys are the data keys of a collection exported by the EncryptedChunkManager of milvus,
+// files are encrypted with the active key and decrypted with the key named in their header.
+struct DataKeys {
+    int64_t collection_id = 0;
+    int64_t active_key_id = 0;
+    std::map<int64_t, std::string> keys;
+    // files without the encryption header are rejected in strict mode
+    bool strict = false;
+};
+
+DataKeys
+ParseDataKeys(const std::string& serialized);
+
+// EncryptFileData encrypts the content of a file in the layout of the EncryptedChunkManager of milvus.
+std::vector<uint8_t>
+EncryptFileData(const DataKeys& data_keys, const uint8_t* data, int64_t size);
+
+// DecryptFileData returns the decrypted content of a file, files without the encryption header are returned as is.
+std::vector<uint8_t>
+DecryptFileData(const DataKeys& data_keys, const uint8_t* data, int64_t size);
+
+}  // namespace milvus::storage
@@ -140,6 +140,14 @@ class DiskANNFileManagerException : public std::runtime_error {
     }
 };
 
+class EncryptionException : public std::runtime_error {
+ public:
+    explicit EncryptionException(const std::string& msg) : std::runtime_error(msg) {
+    }
+    virtual ~EncryptionException() {
+    }
+};
+
 class ArrowException : public std::runtime_error {
  public:
     explicit ArrowException(const std::string& msg) : std::runtime_error(msg) {
@@ -34,6 +34,8 @@ struct Payload {
     const uint8_t* raw_data;
     int rows;
     std::optional<int> dimension;
+    // validity of each row for nullable scalar data, nullptr means all rows are valid
+    const bool* valid_data = nullptr;
 };
 
 class PayloadOutputStream : public arrow::io::OutputStream {
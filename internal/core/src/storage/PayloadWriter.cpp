@@ -60,6 +60,7 @@ PayloadWriter::add_payload(const Payload& raw_data) {
     if (milvus::datatype_is_vector(column_type_)) {
         AssertInfo(dimension_.has_value(), "dimension has not been inited");
         AssertInfo(dimension_ == raw_data.dimension, "inconsistent dimension");
+        AssertInfo(raw_data.valid_data == nullptr, "vector data is not nullable");
     }
 
     AddPayloadToArrowBuilder(builder_, raw_data);
@@ -49,6 +49,11 @@ class PayloadWriter {
         return rows_;
     }
 
+    DataType
+    get_column_type() const {
+        return column_type_;
+    }
+
  private:
     void
     init_dimension(int dim);
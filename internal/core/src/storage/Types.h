@@ -89,6 +89,8 @@ struct StorageConfig {
     std::string iam_endpoint = "";
     bool useSSL = false;
     bool useIAM = false;
+    // the data keys exported by the EncryptedChunkManager of milvus, empty if encryption is disabled
+    std::string encryption_keys = "";
 };
 
 }  // namespace milvus::storage
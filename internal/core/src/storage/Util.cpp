@@ -49,10 +49,18 @@ add_vector_payload(std::shared_ptr<arrow::ArrayBuilder> builder, uint8_t* values
 // append values for numeric data
 template <typename DT, typename BT>
 void
-add_numeric_payload(std::shared_ptr<arrow::ArrayBuilder> builder, DT* start, int length) {
+add_numeric_payload(std::shared_ptr<arrow::ArrayBuilder> builder, DT* start, int length, const bool* valid_data) {
     AssertInfo(builder != nullptr, "empty arrow builder");
     auto numeric_builder = std::dynamic_pointer_cast<BT>(builder);
-    auto ast = numeric_builder->AppendValues(start, start + length);
+    arrow::Status ast;
+    if (valid_data == nullptr) {
+        ast = numeric_builder->AppendValues(start, start + length);
+    } else if constexpr (std::is_same_v<DT, bool>) {
+        ast = numeric_builder->AppendValues(reinterpret_cast<const uint8_t*>(start), length,
+                                            reinterpret_cast<const uint8_t*>(valid_data));
+    } else {
+        ast = numeric_builder->AppendValues(start, length, reinterpret_cast<const uint8_t*>(valid_data));
+    }
     AssertInfo(ast.ok(), "append value to arrow builder failed");
 }
 
@@ -66,37 +74,37 @@ AddPayloadToArrowBuilder(std::shared_ptr<arrow::ArrayBuilder> builder, const Pay
     switch (data_type) {
         case DataType::BOOL: {
             auto bool_data = reinterpret_cast<bool*>(raw_data);
-            add_numeric_payload<bool, arrow::BooleanBuilder>(builder, bool_data, length);
+            add_numeric_payload<bool, arrow::BooleanBuilder>(builder, bool_data, length, payload.valid_data);
             break;
         }
         case DataType::INT8: {
             auto int8_data = reinterpret_cast<int8_t*>(raw_data);
-            add_numeric_payload<int8_t, arrow::Int8Builder>(builder, int8_data, length);
+            add_numeric_payload<int8_t, arrow::Int8Builder>(builder, int8_data, length, payload.valid_data);
             break;
         }
         case DataType::INT16: {
             auto int16_data = reinterpret_cast<int16_t*>(raw_data);
-            add_numeric_payload<int16_t, arrow::Int16Builder>(builder, int16_data, length);
+            add_numeric_payload<int16_t, arrow::Int16Builder>(builder, int16_data, length, payload.valid_data);
             break;
         }
         case DataType::INT32: {
             auto int32_data = reinterpret_cast<int32_t*>(raw_data);
-            add_numeric_payload<int32_t, arrow::Int32Builder>(builder, int32_data, length);
+            add_numeric_payload<int32_t, arrow::Int32Builder>(builder, int32_data, length, payload.valid_data);
             break;
         }
         case DataType::INT64: {
             auto int64_data = reinterpret_cast<int64_t*>(raw_data);
-            add_numeric_payload<int64_t, arrow::Int64Builder>(builder, int64_data, length);
+            add_numeric_payload<int64_t, arrow::Int64Builder>(builder, int64_data, length, payload.valid_data);
             break;
         }
         case DataType::FLOAT: {
             auto float_data = reinterpret_cast<float*>(raw_data);
-            add_numeric_payload<float, arrow::FloatBuilder>(builder, float_data, length);
+            add_numeric_payload<float, arrow::FloatBuilder>(builder, float_data, length, payload.valid_data);
             break;
         }
         case DataType::DOUBLE: {
             auto double_data = reinterpret_cast<double_t*>(raw_data);
-            add_numeric_payload<double, arrow::DoubleBuilder>(builder, double_data, length);
+            add_numeric_payload<double, arrow::DoubleBuilder>(builder, double_data, length, payload.valid_data);
             break;
         }
         case DataType::VECTOR_BINARY:
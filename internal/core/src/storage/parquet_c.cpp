@@ -102,6 +102,19 @@ AddDoubleToPayload(CPayloadWriter payloadWriter, double* values, int length) {
     return AddValuesToPayload(payloadWriter, raw_data_info);
 }
 
+extern "C" CStatus
+AddNullableValuesToPayload(CPayloadWriter payloadWriter, uint8_t* values, bool* valid_data, int length) {
+    try {
+        auto p = reinterpret_cast<PayloadWriter*>(payloadWriter);
+        auto raw_data_info = Payload{p->get_column_type(), values, length};
+        raw_data_info.valid_data = valid_data;
+        p->add_payload(raw_data_info);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 extern "C" CStatus
 AddOneStringToPayload(CPayloadWriter payloadWriter, char* cstr, int str_size) {
     try {
@@ -51,6 +51,8 @@ AddFloatToPayload(CPayloadWriter payloadWriter, float* values, int length);
 CStatus
 AddDoubleToPayload(CPayloadWriter payloadWriter, double* values, int length);
 CStatus
+AddNullableValuesToPayload(CPayloadWriter payloadWriter, uint8_t* values, bool* valid_data, int length);
+CStatus
 AddOneStringToPayload(CPayloadWriter payloadWriter, char* cstr, int str_size);
 CStatus
 AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t* values, int dimension, int length);
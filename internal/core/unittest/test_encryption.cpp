@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2020 Zilliz. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software distributed under the License
+// is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+// or implied. See the License for the specific language governing permissions and limitations under the License
+
+#include <gtest/gtest.h>
+
+#include <string>
+#include <vector>
+
+#include "storage/Encryption.h"
+#include "storage/Exception.h"
+
+using namespace milvus::storage;
+
+namespace {
+const std::string kDataKeys =
+    R"({"collection_id":1,"active_key_id":2,"keys":{"2":"000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"}})";
+}
+
+TEST(Encryption, RoundTrip) {
+    auto data_keys = ParseDataKeys(kDataKeys);
+    EXPECT_EQ(data_keys.collection_id, 1);
+    EXPECT_EQ(data_keys.active_key_id, 2);
+
+    for (auto size : {0, 100, 64 << 10, (64 << 10) * 3 + 7}) {
+        std::vector<uint8_t> plain(size);
+        for (int i = 0; i < size; i++) {
+            plain[i] = static_cast<uint8_t>(i);
+        }
+        auto encrypted = EncryptFileData(data_keys, plain.data(), plain.size());
+        EXPECT_NE(encrypted.size(), plain.size());
+        auto decrypted = DecryptFileData(data_keys, encrypted.data(), encrypted.size());
+        EXPECT_EQ(decrypted, plain);
+    }
+}
+
+TEST(Encryption, Tampered) {
+    auto data_keys = ParseDataKeys(kDataKeys);
+    std::vector<uint8_t> plain(1000, 1);
+    auto encrypted = EncryptFileData(data_keys, plain.data(), plain.size());
+    encrypted.back() ^= 1;
+    EXPECT_THROW(DecryptFileData(data_keys, encrypted.data(), encrypted.size()), EncryptionException);
+    encrypted.pop_back();
+    EXPECT_THROW(DecryptFileData(data_keys, encrypted.data(), encrypted.size()), EncryptionException);
+}
+
+TEST(Encryption, PlainFile) {
+    auto data_keys = ParseDataKeys(kDataKeys);
+    std::vector<uint8_t> plain(100, 1);
+    EXPECT_EQ(DecryptFileData(data_keys, plain.data(), plain.size()), plain);
+
+    data_keys.strict = true;
+    EXPECT_THROW(DecryptFileData(data_keys, plain.data(), plain.size()), EncryptionException);
+}
+
+TEST(Encryption, InvalidDataKeys) {
+    EXPECT_THROW(ParseDataKeys(R"({"collection_id":1,"active_key_id":2,"keys":{}})"), EncryptionException);
+    EXPECT_THROW(ParseDataKeys(R"({"collection_id":1,"active_key_id":2,"keys":{"2":"0001"}})"), EncryptionException);
+    EXPECT_THROW(ParseDataKeys("not json"), EncryptionException);
+}
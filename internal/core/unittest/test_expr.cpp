@@ -1438,3 +1438,204 @@ TEST(Expr, TestBinaryArithOpEvalRangeWithScalarSortIndex) {
         }
     }
 }
+
+TEST(Expr, TestNullExpr) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto age_range = R"(unary_range_expr: <
+                            column_info: <
+                                field_id: %2%
+                                data_type: Int32
+                            >
+                            op: LessThan
+                            value: <
+                                int64_val: 0
+                            >
+                        >)";
+    auto is_null = R"(null_expr: <
+                          column_info: <
+                              field_id: %2%
+                              data_type: Int32
+                          >
+                          op: IsNull
+                      >)";
+    auto is_not_null = R"(null_expr: <
+                              column_info: <
+                                  field_id: %2%
+                                  data_type: Int32
+                              >
+                              op: IsNotNull
+                          >)";
+    std::vector<std::tuple<std::string, std::function<bool(bool, int32_t)>>> testcases = {
+        {is_null, [](bool valid, int32_t v) { return !valid; }},
+        {is_not_null, [](bool valid, int32_t v) { return valid; }},
+        // null value satisfies neither the predicate nor its negation
+        {age_range, [](bool valid, int32_t v) { return valid && v < 0; }},
+        {std::string("unary_expr: < op: Not child: < ") + age_range + " > >",
+         [](bool valid, int32_t v) { return valid && !(v < 0); }},
+        {std::string("binary_expr: < op: LogicalOr left: < ") + age_range + " > right: < " + is_null + " > >",
+         [](bool valid, int32_t v) { return !valid || v < 0; }},
+        {std::string("unary_expr: < op: Not child: < binary_expr: < op: LogicalAnd left: < ") + age_range +
+             " > right: < " + is_not_null + " > > > >",
+         [](bool valid, int32_t v) { return valid && !(v < 0); }},
+    };
+
+    std::string serialized_expr_plan = R"(vector_anns: <
+                                            field_id: %1%
+                                            predicates: <
+                                                @@@@
+                                            >
+                                            query_info: <
+                                                topk: 10
+                                                round_decimal: 3
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                            >
+                                            placeholder_tag: "$0"
+     >)";
+
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("id", DataType::INT64);
+    auto age_fid = FieldId(300);
+    auto age_meta = FieldMeta(FieldName("age"), age_fid, DataType::INT32);
+    age_meta.set_nullable(true);
+    schema->AddField(std::move(age_meta));
+    schema->set_primary_field_id(i64_fid);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    int num_iters = 10;
+    std::vector<int32_t> age_col;
+    std::vector<bool> valid_col;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_age_col = raw_data.get_col<int32_t>(age_fid);
+        age_col.insert(age_col.end(), new_age_col.begin(), new_age_col.end());
+        std::unique_ptr<bool[]> valid_data(new bool[N]);
+        for (int i = 0; i < N; ++i) {
+            valid_data[i] = i % 3 != 0;
+            valid_col.push_back(valid_data[i]);
+        }
+        auto offset = seg->PreInsert(N);
+        seg->InsertValidData(offset, N, age_fid, valid_data.get());
+        seg->Insert(offset, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [clause, ref_func] : testcases) {
+        auto expr_plan = serialized_expr_plan;
+        auto loc = expr_plan.find("@@@@");
+        expr_plan.replace(loc, 4, clause);
+        auto dsl_string = boost::format(expr_plan) % vec_fid.get() % age_fid.get();
+        auto binary_plan = translate_text_plan_to_binary_plan(dsl_string.str().data());
+        auto plan = CreateSearchPlanByExpr(*schema, binary_plan.data(), binary_plan.size());
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+            auto ref = ref_func(valid_col[i], age_col[i]);
+            ASSERT_EQ(ans, ref) << clause << "@" << i << "!!" << valid_col[i] << "," << age_col[i];
+        }
+    }
+}
+
+TEST(Expr, TestArithCompareExpr) {
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+    auto age1 = R"(column_expr: < info: < field_id: %2% data_type: Int32 > >)";
+    auto age2 = R"(column_expr: < info: < field_id: %3% data_type: Int64 > >)";
+    auto score = R"(column_expr: < info: < field_id: %4% data_type: Double > >)";
+    auto arith = [](std::string op, std::string data_type, std::string left, std::string right) {
+        return "binary_arith_expr: < left: < " + left + " > right: < " + right + " > op: " + op +
+               " data_type: " + data_type + " >";
+    };
+    auto compare = [](std::string op, std::string data_type, std::string left, std::string right) {
+        return "arith_compare_expr: < left: < " + left + " > right: < " + right + " > op: " + op +
+               " data_type: " + data_type + " >";
+    };
+    auto int_value = [](int64_t v) { return "value_expr: < value: < int64_val: " + std::to_string(v) + " > >"; };
+    auto float_value = [](double v) { return "value_expr: < value: < float_val: " + std::to_string(v) + " > >"; };
+
+    auto div_eq = compare("Equal", "Int64", arith("Div", "Int64", age2, age1), int_value(3));
+    std::vector<std::tuple<std::string, std::function<bool(int32_t, int64_t, double)>>> testcases = {
+        {compare("GreaterThan", "Int64", arith("Add", "Int64", age1, age2), int_value(100)),
+         [](int32_t a, int64_t b, double s) { return a + b > 100; }},
+        {compare("LessThan", "Double", arith("Mul", "Double", age1, score), float_value(10.5)),
+         [](int32_t a, int64_t b, double s) { return a * s < 10.5; }},
+        {compare("GreaterEqual", "Int64", arith("Sub", "Int64", age2, age1), age2),
+         [](int32_t a, int64_t b, double s) { return b - a >= b; }},
+        {compare("LessEqual", "Double", arith("Mul", "Int64", arith("Add", "Int64", age1, int_value(1)), int_value(2)),
+                 arith("Div", "Double", score, float_value(2))),
+         [](int32_t a, int64_t b, double s) { return (a + 1) * 2 <= s / 2; }},
+        // integer division by zero matches neither the comparison nor its negation
+        {div_eq, [](int32_t a, int64_t b, double s) { return a != 0 && b / a == 3; }},
+        {"unary_expr: < op: Not child: < " + div_eq + " > >",
+         [](int32_t a, int64_t b, double s) { return a != 0 && b / a != 3; }},
+    };
+
+    std::string serialized_expr_plan = R"(vector_anns: <
+                                            field_id: %1%
+                                            predicates: <
+                                                @@@@
+                                            >
+                                            query_info: <
+                                                topk: 10
+                                                round_decimal: 3
+                                                metric_type: "L2"
+                                                search_params: "{\"nprobe\": 10}"
+                                            >
+                                            placeholder_tag: "$0"
+     >)";
+
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, knowhere::metric::L2);
+    auto i64_fid = schema->AddDebugField("id", DataType::INT64);
+    auto age1_fid = schema->AddDebugField("age1", DataType::INT32);
+    auto age2_fid = schema->AddDebugField("age2", DataType::INT64);
+    auto score_fid = schema->AddDebugField("score", DataType::DOUBLE);
+    schema->set_primary_field_id(i64_fid);
+
+    auto seg = CreateGrowingSegment(schema);
+    int N = 1000;
+    int num_iters = 10;
+    std::vector<int32_t> age1_col;
+    std::vector<int64_t> age2_col;
+    std::vector<double> score_col;
+    for (int iter = 0; iter < num_iters; ++iter) {
+        auto raw_data = DataGen(schema, N, iter);
+        auto new_age1_col = raw_data.get_col<int32_t>(age1_fid);
+        auto new_age2_col = raw_data.get_col<int64_t>(age2_fid);
+        auto new_score_col = raw_data.get_col<double>(score_fid);
+        age1_col.insert(age1_col.end(), new_age1_col.begin(), new_age1_col.end());
+        age2_col.insert(age2_col.end(), new_age2_col.begin(), new_age2_col.end());
+        score_col.insert(score_col.end(), new_score_col.begin(), new_score_col.end());
+        seg->PreInsert(N);
+        seg->Insert(iter * N, N, raw_data.row_ids_.data(), raw_data.timestamps_.data(), raw_data.raw_);
+    }
+
+    auto seg_promote = dynamic_cast<SegmentGrowingImpl*>(seg.get());
+    ExecExprVisitor visitor(*seg_promote, seg_promote->get_row_count(), MAX_TIMESTAMP);
+    for (auto [clause, ref_func] : testcases) {
+        auto expr_plan = serialized_expr_plan;
+        auto loc = expr_plan.find("@@@@");
+        expr_plan.replace(loc, 4, clause);
+        // not every clause refers to all of the fields
+        boost::format dsl_string(expr_plan);
+        dsl_string.exceptions(boost::io::all_error_bits ^ boost::io::too_many_args_bit);
+        dsl_string % vec_fid.get() % age1_fid.get() % age2_fid.get() % score_fid.get();
+        auto binary_plan = translate_text_plan_to_binary_plan(dsl_string.str().data());
+        auto plan = CreateSearchPlanByExpr(*schema, binary_plan.data(), binary_plan.size());
+        auto final = visitor.call_child(*plan->plan_node_->predicate_.value());
+        EXPECT_EQ(final.size(), N * num_iters);
+
+        for (int i = 0; i < N * num_iters; ++i) {
+            auto ans = final[i];
+            auto ref = ref_func(age1_col[i], age2_col[i], score_col[i]);
+            ASSERT_EQ(ans, ref) << clause << "@" << i << "!!"
+                                << boost::format("[%1%, %2%, %3%]") % age1_col[i] % age2_col[i] % score_col[i];
+        }
+    }
+}
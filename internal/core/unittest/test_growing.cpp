@@ -13,6 +13,7 @@
 
 #include "segcore/SegmentGrowing.h"
 #include "segcore/SegmentGrowingImpl.h"
+#include "segcore/Utils.h"
 #include "pb/schema.pb.h"
 #include "test_utils/DataGen.h"
 
@@ -83,3 +84,42 @@ TEST(Growing, RealCount) {
     ASSERT_TRUE(status.ok());
     ASSERT_EQ(0, segment->get_real_count());
 }
+
+TEST(Growing, AddField) {
+    auto schema = std::make_shared<Schema>();
+    auto pk = schema->AddDebugField("pk", DataType::INT64);
+    schema->set_primary_field_id(pk);
+    auto segment = CreateGrowingSegment(schema);
+
+    int64_t c = 10;
+    auto offset = segment->PreInsert(c);
+    auto dataset = DataGen(schema, c);
+    segment->Insert(offset, c, dataset.row_ids_.data(), dataset.timestamps_.data(), dataset.raw_);
+    // reserved before the field is added, inserted after
+    auto pending_offset = segment->PreInsert(c);
+    auto pending_dataset = DataGen(schema, c, 43, c);
+
+    auto new_schema = std::make_shared<Schema>(*schema);
+    auto added = new_schema->AddDebugField("added", DataType::INT32);
+    int32_t default_value = 7;
+    auto value = CreateScalarDataArrayFrom(&default_value, 1, (*new_schema)[added]);
+    segment->AddField(new_schema, added, value.get());
+    ASSERT_ANY_THROW(segment->AddField(new_schema, added, value.get()));
+
+    segment->Insert(pending_offset, c, pending_dataset.row_ids_.data(), pending_dataset.timestamps_.data(),
+                    pending_dataset.raw_);
+    ASSERT_EQ(segment->get_row_count(), 2 * c);
+
+    // rows reserved after the field is added must carry it
+    auto later_offset = segment->PreInsert(c);
+    auto later_dataset = DataGen(schema, c, 44, 2 * c);
+    ASSERT_ANY_THROW(segment->Insert(later_offset, c, later_dataset.row_ids_.data(),
+                                     later_dataset.timestamps_.data(), later_dataset.raw_));
+
+    auto impl = dynamic_cast<SegmentGrowingImpl*>(segment.get());
+    ASSERT_NE(impl, nullptr);
+    auto field_data = impl->get_insert_record().get_field_data<int32_t>(added);
+    for (int64_t i = 0; i < 2 * c; ++i) {
+        ASSERT_EQ((*field_data)[i], default_value);
+    }
+}
@@ -130,7 +130,8 @@ get_default_cstorage_config() {
                           "minio",
                           iamEndPoint.c_str(),
                           useSSL,
-                          useIam};
+                          useIam,
+                          ""};
 }
 
 auto
@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// verifySegmentBinlogs reads the log files of all the healthy segments in meta, and returns the missing or
+// corrupted ones. Segments dropped during the verification are ignored, their files may be recycled by gc.
+func verifySegmentBinlogs(ctx context.Context, m *meta, cli storage.ChunkManager) ([]*storage.CorruptedLog, error) {
+	segments := m.SelectSegments(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment)
+	})
+
+	var result []*storage.CorruptedLog
+	for _, segment := range segments {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		corrupted, err := storage.VerifySegmentLogs(ctx, cli, segment.SegmentInfo)
+		if err != nil {
+			log.Warn("failed to verify segment logs", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			continue
+		}
+		if len(corrupted) == 0 || m.GetSegment(segment.GetID()) == nil {
+			continue
+		}
+		result = append(result, corrupted...)
+	}
+	return result, nil
+}
+
+// reportCorruptedBinlogs logs the missing or corrupted files and updates the metrics.
+func reportCorruptedBinlogs(corrupted []*storage.CorruptedLog) {
+	missing := 0
+	for _, l := range corrupted {
+		if l.Missing {
+			missing++
+			log.Error("segment log file is missing", zap.Int64("segmentID", l.SegmentID), zap.String("path", l.Path))
+		} else {
+			log.Error("segment log file is corrupted", zap.Int64("segmentID", l.SegmentID), zap.String("path", l.Path), zap.Error(l.Err))
+		}
+	}
+	metrics.DataCoordNumCorruptedBinlogs.WithLabelValues(metrics.MissingBinlogLabel).Set(float64(missing))
+	metrics.DataCoordNumCorruptedBinlogs.WithLabelValues(metrics.CorruptedBinlogLabel).Set(float64(len(corrupted) - missing))
+}
@@ -0,0 +1,68 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestVerifySegmentBinlogs(t *testing.T) {
+	ctx := context.Background()
+	cli := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	require.NoError(t, cli.Write(ctx, "stats_log/1", []byte(`{"fieldID":100}`)))
+	require.NoError(t, cli.Write(ctx, "stats_log/2", []byte(`{"fieldID":`)))
+
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, State: commonpb.SegmentState_Flushed, Statslogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "stats_log/1"}}},
+		}},
+		{ID: 2, State: commonpb.SegmentState_Flushed, Statslogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "stats_log/2"}, {LogPath: "stats_log/3"}}},
+		}},
+		{ID: 3, State: commonpb.SegmentState_Dropped, Statslogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "stats_log/4"}}},
+		}},
+	}
+	for _, segment := range segments {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+
+	corrupted, err := verifySegmentBinlogs(ctx, meta, cli)
+	assert.NoError(t, err)
+	require.Equal(t, 2, len(corrupted))
+	assert.Equal(t, int64(2), corrupted[0].SegmentID)
+	assert.Equal(t, "stats_log/2", corrupted[0].Path)
+	assert.False(t, corrupted[0].Missing)
+	assert.Equal(t, "stats_log/3", corrupted[1].Path)
+	assert.True(t, corrupted[1].Missing)
+	reportCorruptedBinlogs(corrupted)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = verifySegmentBinlogs(cancelCtx, meta, cli)
+	assert.Error(t, err)
+}
@@ -20,18 +20,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
 // TODO this num should be determined by resources of datanode, for now, we set to a fixed value for simple
-// TODO we should split compaction into different priorities, small compaction helps to merge segment, large compaction helps to handle delta and expiration of large segments
 const (
 	maxParallelCompactionTaskNum = 100
 	rpcCompactionTimeout         = 10 * time.Second
@@ -50,6 +53,10 @@ type compactionPlanContext interface {
 	isFull() bool
 	// get compaction tasks by signal id
 	getCompactionTasksBySignalID(signalID int64) []*compactionTask
+	// getCompactionMetrics returns the running and queued compaction tasks with their scores
+	getCompactionMetrics() *metricsinfo.DataCoordCompactionMetrics
+	// cancelCompaction cancels the executing tasks of a signal, or only the one of planID if it's not 0
+	cancelCompaction(signalID int64, planID int64) error
 }
 
 type compactionTaskState int8
@@ -59,8 +66,48 @@ const (
 	completed
 	failed
 	timeout
+	cancelling
+	cancelled
 )
 
+func (s compactionTaskState) String() string {
+	switch s {
+	case executing:
+		return "executing"
+	case completed:
+		return "completed"
+	case failed:
+		return "failed"
+	case timeout:
+		return "timeout"
+	case cancelling:
+		return "cancelling"
+	case cancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+func (s compactionTaskState) toPlanState() datapb.CompactionPlanState {
+	switch s {
+	case executing:
+		return datapb.CompactionPlanState_CompactionPlanExecuting
+	case completed:
+		return datapb.CompactionPlanState_CompactionPlanCompleted
+	case failed:
+		return datapb.CompactionPlanState_CompactionPlanFailed
+	case timeout:
+		return datapb.CompactionPlanState_CompactionPlanTimeout
+	case cancelling:
+		return datapb.CompactionPlanState_CompactionPlanCancelling
+	case cancelled:
+		return datapb.CompactionPlanState_CompactionPlanCancelled
+	default:
+		return datapb.CompactionPlanState_CompactionPlanUnknown
+	}
+}
+
 var (
 	errChannelNotWatched = errors.New("channel is not watched")
 	errChannelInBuffer   = errors.New("channel is in buffer")
@@ -72,6 +119,7 @@ type compactionTask struct {
 	state       compactionTaskState
 	dataNodeID  int64
 	result      *datapb.CompactionResult
+	score       compactionScore
 }
 
 func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask {
@@ -80,6 +128,7 @@ func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask
 		plan:        t.plan,
 		state:       t.state,
 		dataNodeID:  t.dataNodeID,
+		score:       t.score,
 	}
 	for _, opt := range opts {
 		opt(task)
@@ -101,20 +150,22 @@ type compactionPlanHandler struct {
 	wg               sync.WaitGroup
 	flushCh          chan UniqueID
 	segRefer         *SegmentReferenceManager
-	parallelCh       map[int64]chan struct{}
+	pendingTasks     map[int64][]*compactionTask // nodeID -> tasks waiting for a slot
+	runningTasks     map[int64]int               // nodeID -> number of slots in use
 }
 
 func newCompactionPlanHandler(sessions *SessionManager, cm *ChannelManager, meta *meta,
 	allocator allocator, flush chan UniqueID, segRefer *SegmentReferenceManager) *compactionPlanHandler {
 	return &compactionPlanHandler{
-		plans:      make(map[int64]*compactionTask),
-		chManager:  cm,
-		meta:       meta,
-		sessions:   sessions,
-		allocator:  allocator,
-		flushCh:    flush,
-		segRefer:   segRefer,
-		parallelCh: make(map[int64]chan struct{}),
+		plans:        make(map[int64]*compactionTask),
+		chManager:    cm,
+		meta:         meta,
+		sessions:     sessions,
+		allocator:    allocator,
+		flushCh:      flush,
+		segRefer:     segRefer,
+		pendingTasks: make(map[int64][]*compactionTask),
+		runningTasks: make(map[int64]int),
 	}
 }
 
@@ -172,40 +223,71 @@ func (c *compactionPlanHandler) execCompactionPlan(signal *compactionSignal, pla
 		plan:        plan,
 		state:       executing,
 		dataNodeID:  nodeID,
+		score:       scoreCompactionPlan(plan, time.Now()),
 	}
 	c.plans[plan.PlanID] = task
 	c.executingTaskNum++
+	c.pendingTasks[nodeID] = append(c.pendingTasks[nodeID], task)
+	log.Info("enqueue compaction plan", zap.Int64("nodeID", nodeID), zap.Int64("planID", plan.GetPlanID()),
+		zap.Float64("priority", task.score.priority()))
 
-	go func() {
-		log.Info("acquire queue", zap.Int64("nodeID", nodeID), zap.Int64("planID", plan.GetPlanID()))
-		c.acquireQueue(nodeID)
-
-		ts, err := c.allocator.allocTimestamp(context.TODO())
-		if err != nil {
-			log.Warn("Alloc start time for CompactionPlan failed", zap.Int64("planID", plan.GetPlanID()))
-			return
-		}
+	c.schedule(nodeID)
+	return nil
+}
 
-		c.mu.Lock()
-		c.plans[plan.PlanID] = c.plans[plan.PlanID].shadowClone(func(task *compactionTask) {
-			task.plan.StartTime = ts
+// schedule dispatches the pending tasks of a datanode by priority until its slots run out
+// not threadsafe, the caller must hold the lock
+func (c *compactionPlanHandler) schedule(nodeID int64) {
+	for c.runningTasks[nodeID] < Params.DataCoordCfg.CompactionSlotsPerNode && len(c.pendingTasks[nodeID]) > 0 {
+		pending := c.pendingTasks[nodeID]
+		sort.SliceStable(pending, func(i, j int) bool {
+			return pending[i].score.priority() > pending[j].score.priority()
 		})
-		c.mu.Unlock()
+		task := pending[0]
+		c.pendingTasks[nodeID] = pending[1:]
+		c.runningTasks[nodeID]++
+		go c.dispatch(task)
+	}
+}
 
-		err = c.sessions.Compaction(nodeID, plan)
-		if err != nil {
-			log.Warn("Try to Compaction but DataNode rejected", zap.Any("TargetNodeId", nodeID), zap.Any("planId", plan.GetPlanID()))
-			c.mu.Lock()
-			delete(c.plans, plan.PlanID)
-			c.executingTaskNum--
-			c.releaseQueue(nodeID)
-			c.mu.Unlock()
-			return
-		}
+// dispatch sends the plan of a task to the datanode that has acquired a slot for it
+func (c *compactionPlanHandler) dispatch(task *compactionTask) {
+	nodeID, plan := task.dataNodeID, task.plan
+	ts, err := c.allocator.allocTimestamp(context.TODO())
+	if err != nil {
+		log.Warn("Alloc start time for CompactionPlan failed", zap.Int64("planID", plan.GetPlanID()))
+		c.abandon(task)
+		return
+	}
 
-		log.Info("start compaction", zap.Int64("nodeID", nodeID), zap.Int64("planID", plan.GetPlanID()))
-	}()
-	return nil
+	c.mu.Lock()
+	c.plans[plan.PlanID] = c.plans[plan.PlanID].shadowClone(func(task *compactionTask) {
+		task.plan.StartTime = ts
+	})
+	c.mu.Unlock()
+
+	err = c.sessions.Compaction(nodeID, plan)
+	if err != nil {
+		log.Warn("Try to Compaction but DataNode rejected", zap.Any("TargetNodeId", nodeID), zap.Any("planId", plan.GetPlanID()))
+		c.abandon(task)
+		return
+	}
+
+	log.Info("start compaction", zap.Int64("nodeID", nodeID), zap.Int64("planID", plan.GetPlanID()))
+}
+
+// abandon removes a task which failed to start and releases its slot
+func (c *compactionPlanHandler) abandon(task *compactionTask) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the task may have been cancelled meanwhile, its slot is released by the cancellation
+	if current, ok := c.plans[task.plan.PlanID]; !ok || current.state != executing {
+		return
+	}
+	delete(c.plans, task.plan.PlanID)
+	c.setSegmentsCompacting(task.plan, false)
+	c.executingTaskNum--
+	c.releaseQueue(task.dataNodeID)
 }
 
 func (c *compactionPlanHandler) setSegmentsCompacting(plan *datapb.CompactionPlan, compacting bool) {
@@ -232,14 +314,22 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionResu
 		if err := c.handleMergeCompactionResult(plan, result); err != nil {
 			return err
 		}
+	case datapb.CompactionType_ClusteringCompaction:
+		if err := c.handleClusteringCompactionResult(plan, result); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
 	c.plans[planID] = c.plans[planID].shadowClone(setState(completed), setResult(result))
 	c.executingTaskNum--
-	if c.plans[planID].plan.GetType() == datapb.CompactionType_MergeCompaction ||
-		c.plans[planID].plan.GetType() == datapb.CompactionType_MixCompaction {
+	switch c.plans[planID].plan.GetType() {
+	case datapb.CompactionType_MergeCompaction, datapb.CompactionType_MixCompaction:
 		c.flushCh <- result.GetSegmentID()
+	case datapb.CompactionType_ClusteringCompaction:
+		for _, segment := range result.GetSegments() {
+			c.flushCh <- segment.GetSegmentID()
+		}
 	}
 	// TODO: when to clean task list
 
@@ -284,6 +374,46 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	return nil
 }
 
+func (c *compactionPlanHandler) handleClusteringCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionResult) error {
+	oldSegments, modSegments, newSegments, metricMutation, err := c.meta.PrepareCompleteClusteringCompactionMutation(plan.GetSegmentBinlogs(), result)
+	if err != nil {
+		return err
+	}
+	log := log.With(zap.Int64("planID", plan.GetPlanID()))
+
+	log.Info("handleClusteringCompactionResult: altering metastore after compaction")
+	if err := c.meta.alterMetaStoreAfterClusteringCompaction(modSegments, newSegments); err != nil {
+		log.Warn("handleClusteringCompactionResult: fail to alter metastore after compaction", zap.Error(err))
+		return fmt.Errorf("fail to alter metastore after compaction, err=%w", err)
+	}
+
+	var nodeID = c.plans[plan.GetPlanID()].dataNodeID
+	req := &datapb.SyncSegmentsRequest{
+		PlanID:        plan.PlanID,
+		CompactedFrom: lo.Map(modSegments, func(s *SegmentInfo, _ int) int64 { return s.GetID() }),
+	}
+	for _, s := range newSegments {
+		req.CompactedToSegments = append(req.CompactedToSegments, &datapb.CompactionSegment{
+			SegmentID:           s.GetID(),
+			NumOfRows:           s.GetNumOfRows(),
+			Field2StatslogPaths: s.GetStatslogs(),
+			ClusteringKeyRange:  s.GetClusteringKeyRange(),
+		})
+	}
+
+	log.Info("handleClusteringCompactionResult: syncing segments with node", zap.Int64("nodeID", nodeID))
+	if err := c.sessions.SyncSegments(nodeID, req); err != nil {
+		log.Warn("handleClusteringCompactionResult: fail to sync segments with node, reverting metastore",
+			zap.Int64("nodeID", nodeID), zap.String("reason", err.Error()))
+		return c.meta.revertAlterMetaStoreAfterClusteringCompaction(oldSegments, newSegments)
+	}
+	metricMutation.commit()
+
+	log.Info("handleClusteringCompactionResult: success to handle clustering compaction result",
+		zap.Int("result segment num", len(newSegments)))
+	return nil
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
@@ -296,9 +426,23 @@ func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 func (c *compactionPlanHandler) updateCompaction(ts Timestamp) error {
 	planStates := c.sessions.GetCompactionState()
 
+	liveNodes := typeutil.NewUniqueSet(c.sessions.getLiveNodeIDs()...)
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	for planID, task := range c.plans {
+		if task.state != cancelling {
+			continue
+		}
+		// the plan is gone with the datanode, otherwise ask it again to stop the plan
+		if !liveNodes.Contain(task.dataNodeID) {
+			c.confirmCancel(planID)
+			continue
+		}
+		go c.sendCancel(task.dataNodeID, planID)
+	}
+
 	tasks := c.getExecutingCompactions()
 	for _, task := range tasks {
 		stateResult, ok := planStates[task.plan.PlanID]
@@ -347,27 +491,83 @@ func (c *compactionPlanHandler) isTimeout(now Timestamp, start Timestamp, timeou
 	return int32(ts.Sub(startTime).Seconds()) >= timeout
 }
 
-func (c *compactionPlanHandler) acquireQueue(nodeID int64) {
+// releaseQueue frees a slot of the datanode and dispatches the next pending task
+// not threadsafe, the caller must hold the lock
+func (c *compactionPlanHandler) releaseQueue(nodeID int64) {
+	log.Info("try to release queue", zap.Int64("nodeID", nodeID))
+	if c.runningTasks[nodeID] <= 0 {
+		return
+	}
+	c.runningTasks[nodeID]--
+	c.schedule(nodeID)
+}
+
+// cancelCompaction cancels the executing tasks of a signal, or only the one of planID if it's not 0.
+// Queued tasks are removed from the queue and cancelled at once. The dispatched ones turn to cancelling,
+// they keep their segments compacting and their slot until the datanode confirms the plan is stopped.
+func (c *compactionPlanHandler) cancelCompaction(signalID int64, planID int64) error {
 	c.mu.Lock()
-	_, ok := c.parallelCh[nodeID]
-	if !ok {
-		c.parallelCh[nodeID] = make(chan struct{}, calculateParallel())
+	defer c.mu.Unlock()
+
+	cancelledNum := 0
+	for id, task := range c.plans {
+		if task.state != executing || task.triggerInfo.id != signalID || (planID != 0 && id != planID) {
+			continue
+		}
+		nodeID := task.dataNodeID
+		queued := false
+		c.pendingTasks[nodeID] = lo.Filter(c.pendingTasks[nodeID], func(pending *compactionTask, _ int) bool {
+			if pending.plan.GetPlanID() == id {
+				queued = true
+				return false
+			}
+			return true
+		})
+		cancelledNum++
+		if queued {
+			c.plans[id] = task.shadowClone(setState(cancelled))
+			c.setSegmentsCompacting(task.plan, false)
+			c.executingTaskNum--
+			log.Info("compaction cancelled", zap.Int64("signalID", signalID), zap.Int64("planID", id),
+				zap.Int64("nodeID", nodeID))
+			continue
+		}
+		c.plans[id] = task.shadowClone(setState(cancelling))
+		go c.sendCancel(nodeID, id)
+		log.Info("compaction cancelling", zap.Int64("signalID", signalID), zap.Int64("planID", id),
+			zap.Int64("nodeID", nodeID))
 	}
-	c.mu.Unlock()
+	if cancelledNum == 0 {
+		return fmt.Errorf("no executing compaction plan found, compactionID: %d, planID: %d", signalID, planID)
+	}
+	return nil
+}
 
-	c.mu.RLock()
-	ch := c.parallelCh[nodeID]
-	c.mu.RUnlock()
-	ch <- struct{}{}
+// sendCancel asks the datanode to stop a dispatched plan, the task is cancelled once the datanode confirms it.
+// It's sent again by updateCompaction if the datanode fails to confirm.
+func (c *compactionPlanHandler) sendCancel(nodeID int64, planID int64) {
+	if err := c.sessions.CancelCompaction(nodeID, planID); err != nil {
+		log.Warn("failed to cancel compaction on datanode, will retry", zap.Int64("nodeID", nodeID),
+			zap.Int64("planID", planID), zap.Error(err))
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.confirmCancel(planID)
 }
 
-func (c *compactionPlanHandler) releaseQueue(nodeID int64) {
-	log.Info("try to release queue", zap.Int64("nodeID", nodeID))
-	ch, ok := c.parallelCh[nodeID]
-	if !ok {
+// confirmCancel releases the segments and the slot of a cancelling task which is stopped on the datanode
+// not threadsafe, the caller must hold the lock
+func (c *compactionPlanHandler) confirmCancel(planID int64) {
+	task, ok := c.plans[planID]
+	if !ok || task.state != cancelling {
 		return
 	}
-	<-ch
+	c.plans[planID] = task.shadowClone(setState(cancelled))
+	c.setSegmentsCompacting(task.plan, false)
+	c.executingTaskNum--
+	c.releaseQueue(task.dataNodeID)
+	log.Info("compaction cancelled", zap.Int64("planID", planID), zap.Int64("nodeID", task.dataNodeID))
 }
 
 // isFull return true if the task pool is full
@@ -421,12 +621,46 @@ func setResult(result *datapb.CompactionResult) compactionTaskOpt {
 	}
 }
 
-// 0.5*min(8, NumCPU/2)
-func calculateParallel() int {
-	return 2
-	//cores := runtime.NumCPU()
-	//if cores < 16 {
-	//return 4
-	//}
-	//return cores / 2
+// getCompactionMetrics returns the executing tasks, including the ones waiting for a slot, ordered by priority
+func (c *compactionPlanHandler) getCompactionMetrics() *metricsinfo.DataCoordCompactionMetrics {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	queued := make(map[int64]bool)
+	for _, tasks := range c.pendingTasks {
+		for _, task := range tasks {
+			queued[task.plan.GetPlanID()] = true
+		}
+	}
+
+	ret := &metricsinfo.DataCoordCompactionMetrics{
+		SlotsPerNode: Params.DataCoordCfg.CompactionSlotsPerNode,
+		Tasks:        make([]metricsinfo.CompactionTaskMetrics, 0, len(c.plans)),
+	}
+	for _, task := range c.plans {
+		// cancelling tasks still hold their slots
+		if task.state != executing && task.state != cancelling {
+			continue
+		}
+		ret.Tasks = append(ret.Tasks, metricsinfo.CompactionTaskMetrics{
+			PlanID:        task.plan.GetPlanID(),
+			NodeID:        task.dataNodeID,
+			Channel:       task.plan.GetChannel(),
+			Type:          task.plan.GetType().String(),
+			State:         task.state.String(),
+			Queued:        queued[task.plan.GetPlanID()],
+			Priority:      task.score.priority(),
+			DeleteRatio:   task.score.DeleteRatio,
+			DeltalogRatio: task.score.DeltalogRatio,
+			Fragmentation: task.score.Fragmentation,
+			ExpiredRatio:  task.score.ExpiredRatio,
+		})
+	}
+	sort.Slice(ret.Tasks, func(i, j int) bool {
+		if ret.Tasks[i].Priority != ret.Tasks[j].Priority {
+			return ret.Tasks[i].Priority > ret.Tasks[j].Priority
+		}
+		return ret.Tasks[i].PlanID < ret.Tasks[j].PlanID
+	})
+	return ret
 }
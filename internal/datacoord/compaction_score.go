@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+// compactionScore measures how much a compaction plan is worth executing.
+// Every factor is normalized into [0, 1], the final priority is their weighted sum.
+type compactionScore struct {
+	// deleted rows / total rows of the plan
+	DeleteRatio float64
+	// deltalog file number, saturated at dataCoord.compaction.score.deltalogNum
+	DeltalogRatio float64
+	// number of segments merged by the plan, saturated at dataCoord.compaction.max.segment
+	Fragmentation float64
+	// rows expired by the collection TTL / total rows of the plan
+	ExpiredRatio float64
+}
+
+// priority returns the weighted sum of all factors, plans with higher priority are executed first
+func (s compactionScore) priority() float64 {
+	return s.DeleteRatio*Params.DataCoordCfg.CompactionDeleteRatioWeight +
+		s.DeltalogRatio*Params.DataCoordCfg.CompactionDeltalogWeight +
+		s.Fragmentation*Params.DataCoordCfg.CompactionFragmentationWeight +
+		s.ExpiredRatio*Params.DataCoordCfg.CompactionExpiredRatioWeight
+}
+
+// scoreCompactionPlan computes the score of a plan from the binlogs it carries, now is used to find out the expired rows
+func scoreCompactionPlan(plan *datapb.CompactionPlan, now time.Time) compactionScore {
+	var expireTs Timestamp
+	if plan.GetCollectionTtl() > 0 {
+		expireTs = tsoutil.ComposeTSByTime(now.Add(-time.Duration(plan.GetCollectionTtl())), 0)
+	}
+
+	var totalRows, deletedRows, expiredRows int64
+	deltalogNum := 0
+	for _, segment := range plan.GetSegmentBinlogs() {
+		// every field holds the same rows, so counting the first one is enough
+		if len(segment.GetFieldBinlogs()) > 0 {
+			for _, l := range segment.GetFieldBinlogs()[0].GetBinlogs() {
+				totalRows += l.GetEntriesNum()
+				if l.GetTimestampTo() < expireTs {
+					expiredRows += l.GetEntriesNum()
+				}
+			}
+		}
+		for _, deltalogs := range segment.GetDeltalogs() {
+			deltalogNum += len(deltalogs.GetBinlogs())
+			for _, l := range deltalogs.GetBinlogs() {
+				deletedRows += l.GetEntriesNum()
+			}
+		}
+	}
+
+	score := compactionScore{}
+	if totalRows > 0 {
+		score.DeleteRatio = saturate(float64(deletedRows), float64(totalRows))
+		score.ExpiredRatio = saturate(float64(expiredRows), float64(totalRows))
+	}
+	score.DeltalogRatio = saturate(float64(deltalogNum), float64(Params.DataCoordCfg.CompactionScoreDeltalogNum))
+	if segmentNum := len(plan.GetSegmentBinlogs()); segmentNum > 1 {
+		score.Fragmentation = saturate(float64(segmentNum-1), float64(Params.DataCoordCfg.MaxSegmentToMerge-1))
+	}
+	return score
+}
+
+// saturate returns value/limit capped at 1
+func saturate(value, limit float64) float64 {
+	if value <= 0 {
+		return 0
+	}
+	if limit <= 0 || value >= limit {
+		return 1
+	}
+	return value / limit
+}
@@ -27,7 +27,6 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
-	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -35,7 +34,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-	"go.uber.org/zap"
 )
 
 func Test_compactionPlanHandler_execCompactionPlan(t *testing.T) {
@@ -118,11 +116,12 @@ func Test_compactionPlanHandler_execCompactionPlan(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := &compactionPlanHandler{
-				plans:      tt.fields.plans,
-				sessions:   tt.fields.sessions,
-				chManager:  tt.fields.chManager,
-				parallelCh: make(map[int64]chan struct{}),
-				allocator:  newMockAllocator(),
+				plans:        tt.fields.plans,
+				sessions:     tt.fields.sessions,
+				chManager:    tt.fields.chManager,
+				allocator:    newMockAllocator(),
+				pendingTasks: make(map[int64][]*compactionTask),
+				runningTasks: make(map[int64]int),
 			}
 			err := c.execCompactionPlan(tt.args.signal, tt.args.plan)
 			assert.Equal(t, tt.err, err)
@@ -138,7 +137,7 @@ func Test_compactionPlanHandler_execCompactionPlan(t *testing.T) {
 						func() bool {
 							c.mu.RLock()
 							defer c.mu.RUnlock()
-							return c.executingTaskNum == 0 && len(c.parallelCh[1]) == 0
+							return c.executingTaskNum == 0 && c.runningTasks[1] == 0
 						},
 						5*time.Second, 100*time.Millisecond)
 				}
@@ -170,56 +169,184 @@ func Test_compactionPlanHandler_execWithParallels(t *testing.T) {
 				},
 			},
 		},
-		parallelCh: make(map[int64]chan struct{}),
-		allocator:  newMockAllocator(),
+		allocator:    newMockAllocator(),
+		pendingTasks: make(map[int64][]*compactionTask),
+		runningTasks: make(map[int64]int),
 	}
 
-	signal := &compactionSignal{id: 100}
-	plan1 := &datapb.CompactionPlan{PlanID: 1, Channel: "ch1", Type: datapb.CompactionType_MergeCompaction}
-	plan2 := &datapb.CompactionPlan{PlanID: 2, Channel: "ch1", Type: datapb.CompactionType_MergeCompaction}
-	plan3 := &datapb.CompactionPlan{PlanID: 3, Channel: "ch1", Type: datapb.CompactionType_MergeCompaction}
-
-	c.parallelCh[1] = make(chan struct{}, 2)
+	// all the slots of node 1 are in use
+	c.runningTasks[1] = Params.DataCoordCfg.CompactionSlotsPerNode
 
 	var mut sync.RWMutex
-	called := 0
+	var called []int64
 
 	mockDataNode.EXPECT().Compaction(mock.Anything, mock.Anything).Run(func(ctx context.Context, req *datapb.CompactionPlan) {
 		mut.Lock()
 		defer mut.Unlock()
-		called++
+		called = append(called, req.GetPlanID())
 	}).Return(&commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil).Times(3)
-	go func() {
-		c.execCompactionPlan(signal, plan1)
-		c.execCompactionPlan(signal, plan2)
-		c.execCompactionPlan(signal, plan3)
-	}()
-
-	// wait for dispatch signal
-	<-c.parallelCh[1]
-	<-c.parallelCh[1]
-	<-c.parallelCh[1]
-
-	// wait for compaction called
-	assert.Eventually(t, func() bool {
-		mut.RLock()
-		defer mut.RUnlock()
-		return called == 3
-	}, time.Second, time.Millisecond*10)
-
-	tasks := c.getCompactionTasksBySignalID(0)
-	max, min := uint64(0), uint64(0)
-	for _, v := range tasks {
-		if max < v.plan.GetStartTime() {
-			max = v.plan.GetStartTime()
+
+	withDeletes := func(planID int64, deleted int64) *datapb.CompactionPlan {
+		return &datapb.CompactionPlan{
+			PlanID:  planID,
+			Channel: "ch1",
+			Type:    datapb.CompactionType_MixCompaction,
+			SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{
+				SegmentID:    planID,
+				FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{{EntriesNum: 100}}}},
+				Deltalogs:    []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: deleted}}}},
+			}},
 		}
-		if min > v.plan.GetStartTime() {
-			min = v.plan.GetStartTime()
+	}
+	signal := &compactionSignal{id: 100}
+	assert.NoError(t, c.execCompactionPlan(signal, withDeletes(1, 10)))
+	assert.NoError(t, c.execCompactionPlan(signal, withDeletes(2, 80)))
+	assert.NoError(t, c.execCompactionPlan(signal, withDeletes(3, 40)))
+
+	metrics := c.getCompactionMetrics()
+	assert.Equal(t, 3, len(metrics.Tasks))
+	for i, planID := range []int64{2, 3, 1} {
+		assert.Equal(t, planID, metrics.Tasks[i].PlanID)
+		assert.True(t, metrics.Tasks[i].Queued)
+	}
+
+	// every released slot dispatches the pending plan with the highest score
+	for i := 1; i <= 3; i++ {
+		c.mu.Lock()
+		c.releaseQueue(1)
+		c.mu.Unlock()
+		assert.Eventually(t, func() bool {
+			mut.RLock()
+			defer mut.RUnlock()
+			return len(called) == i
+		}, time.Second, time.Millisecond*10)
+	}
+	assert.Equal(t, []int64{2, 3, 1}, called)
+	assert.Equal(t, Params.DataCoordCfg.CompactionSlotsPerNode, c.runningTasks[1])
+	assert.False(t, c.getCompactionMetrics().Tasks[0].Queued)
+}
+
+func Test_compactionPlanHandler_cancelCompaction(t *testing.T) {
+	segments := NewSegmentsInfo()
+	for _, id := range []int64{1, 2, 3} {
+		segments.SetSegment(id, NewSegmentInfo(&datapb.SegmentInfo{ID: id}).ShadowClone(SetIsCompacting(true)))
+	}
+	newTask := func(signalID, planID int64, state compactionTaskState) *compactionTask {
+		return &compactionTask{
+			triggerInfo: &compactionSignal{id: signalID},
+			plan: &datapb.CompactionPlan{
+				PlanID:         planID,
+				SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: planID}},
+			},
+			state:      state,
+			dataNodeID: 1,
 		}
 	}
+	running, queued, other := newTask(100, 1, executing), newTask(100, 2, executing), newTask(200, 3, executing)
+	other.dataNodeID = 2
+	// the datanode never confirms, the plans are left cancelling
+	dataNode, err := newMockDataNodeClient(1, nil)
+	assert.NoError(t, err)
+	dataNode.cancelCompactionResp = &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+	sessions := NewSessionManager()
+	sessions.sessions.data[1] = &Session{client: dataNode}
+	c := &compactionPlanHandler{
+		plans: map[int64]*compactionTask{
+			1: running,
+			2: queued,
+			3: other,
+			4: newTask(100, 4, completed),
+		},
+		meta:             &meta{segments: segments},
+		sessions:         sessions,
+		executingTaskNum: 3,
+		pendingTasks:     map[int64][]*compactionTask{1: {queued}, 2: {other}},
+		runningTasks:     map[int64]int{1: 1, 2: Params.DataCoordCfg.CompactionSlotsPerNode},
+	}
+
+	t.Run("plan not found", func(t *testing.T) {
+		assert.Error(t, c.cancelCompaction(100, 4))
+		assert.Error(t, c.cancelCompaction(300, 0))
+	})
+
+	t.Run("cancel a queued plan", func(t *testing.T) {
+		assert.NoError(t, c.cancelCompaction(100, 2))
+		assert.Equal(t, cancelled, c.plans[2].state)
+		assert.Empty(t, c.pendingTasks[1])
+		assert.Equal(t, 1, c.runningTasks[1])
+		assert.Equal(t, 2, c.executingTaskNum)
+		assert.False(t, c.meta.GetSegment(2).isCompacting)
+	})
+
+	t.Run("cancel all the plans of a signal", func(t *testing.T) {
+		assert.NoError(t, c.cancelCompaction(100, 0))
+		assert.Equal(t, cancelling, c.plans[1].state)
+		assert.Equal(t, completed, c.plans[4].state)
+		assert.Equal(t, executing, c.plans[3].state)
+		assert.Equal(t, 1, c.runningTasks[1])
+		assert.Equal(t, []*compactionTask{other}, c.pendingTasks[2])
+		assert.Equal(t, 2, c.executingTaskNum)
+		assert.True(t, c.meta.GetSegment(1).isCompacting)
+		assert.True(t, c.meta.GetSegment(3).isCompacting)
+		assert.Error(t, c.cancelCompaction(100, 1))
+	})
+
+	t.Run("confirm a cancelling plan", func(t *testing.T) {
+		c.mu.Lock()
+		c.confirmCancel(1)
+		c.confirmCancel(3)
+		c.mu.Unlock()
+		assert.Equal(t, cancelled, c.plans[1].state)
+		assert.Equal(t, executing, c.plans[3].state)
+		assert.Equal(t, 0, c.runningTasks[1])
+		assert.Equal(t, 1, c.executingTaskNum)
+		assert.False(t, c.meta.GetSegment(1).isCompacting)
+	})
+
+	t.Run("cancelling plan on an offline node", func(t *testing.T) {
+		segments.SetSegment(5, NewSegmentInfo(&datapb.SegmentInfo{ID: 5}).ShadowClone(SetIsCompacting(true)))
+		gone := newTask(300, 5, cancelling)
+		gone.dataNodeID = 3
+		c.plans[5] = gone
+		c.executingTaskNum++
+		c.runningTasks[3] = 1
+		assert.NoError(t, c.updateCompaction(0))
+		assert.Equal(t, cancelled, c.plans[5].state)
+		assert.Equal(t, 0, c.runningTasks[3])
+		assert.Equal(t, 1, c.executingTaskNum)
+		assert.False(t, c.meta.GetSegment(5).isCompacting)
+	})
+}
+
+func Test_scoreCompactionPlan(t *testing.T) {
+	now := time.Now()
+	plan := &datapb.CompactionPlan{
+		CollectionTtl: int64(time.Hour),
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{
+				FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{
+					{EntriesNum: 50, TimestampTo: tsoutil.ComposeTSByTime(now.Add(-2*time.Hour), 0)},
+					{EntriesNum: 50, TimestampTo: tsoutil.ComposeTSByTime(now, 0)},
+				}}},
+				Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 20}, {EntriesNum: 20}}}},
+			},
+			{
+				FieldBinlogs: []*datapb.FieldBinlog{{FieldID: 1, Binlogs: []*datapb.Binlog{
+					{EntriesNum: 100, TimestampTo: tsoutil.ComposeTSByTime(now, 0)},
+				}}},
+			},
+		},
+	}
+	score := scoreCompactionPlan(plan, now)
+	assert.InDelta(t, 0.2, score.DeleteRatio, 1e-9)
+	assert.InDelta(t, 0.25, score.ExpiredRatio, 1e-9)
+	assert.InDelta(t, 2/float64(Params.DataCoordCfg.CompactionScoreDeltalogNum), score.DeltalogRatio, 1e-9)
+	assert.InDelta(t, 1/float64(Params.DataCoordCfg.MaxSegmentToMerge-1), score.Fragmentation, 1e-9)
+	assert.Greater(t, score.priority(), 0.0)
 
-	log.Debug("start time", zap.Uint64("min", min), zap.Uint64("max", max))
-	assert.Less(t, uint64(2), max-min)
+	empty := scoreCompactionPlan(&datapb.CompactionPlan{}, now)
+	assert.Equal(t, compactionScore{}, empty)
+	assert.Equal(t, 0.0, empty.priority())
 }
 
 func getInsertLogPath(rootPath string, segmentID typeutil.UniqueID) string {
@@ -792,14 +919,15 @@ func Test_newCompactionPlanHandler(t *testing.T) {
 				&SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}},
 			},
 			&compactionPlanHandler{
-				plans:      map[int64]*compactionTask{},
-				sessions:   &SessionManager{},
-				chManager:  &ChannelManager{},
-				meta:       &meta{},
-				allocator:  newMockAllocator(),
-				flushCh:    nil,
-				segRefer:   &SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}},
-				parallelCh: make(map[int64]chan struct{}),
+				plans:        map[int64]*compactionTask{},
+				sessions:     &SessionManager{},
+				chManager:    &ChannelManager{},
+				meta:         &meta{},
+				allocator:    newMockAllocator(),
+				flushCh:      nil,
+				segRefer:     &SegmentReferenceManager{segmentsLock: map[UniqueID]map[UniqueID]*datapb.SegmentReferenceLock{}},
+				pendingTasks: make(map[int64][]*compactionTask),
+				runningTasks: make(map[int64]int),
 			},
 		},
 	}
@@ -33,6 +33,8 @@ import (
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/logutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 )
 
@@ -51,6 +53,8 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string) error
 	// forceTriggerCompaction force to start a compaction
 	forceTriggerCompaction(collectionID int64) (UniqueID, error)
+	// forceTriggerCompactionWithScope force to start a compaction limited by the scope
+	forceTriggerCompactionWithScope(collectionID int64, scope *compactionScope) (UniqueID, error)
 }
 
 type compactionSignal struct {
@@ -61,6 +65,61 @@ type compactionSignal struct {
 	partitionID  UniqueID
 	segmentID    UniqueID
 	channel      string
+	// only set by manual compactions with scope
+	scope *compactionScope
+}
+
+// compactionScope limits a manual compaction to a part of the collection
+type compactionScope struct {
+	partitionIDs []UniqueID
+	segmentIDs   []UniqueID
+	mode         datapb.ManualCompactionMode
+	// max size of the output segments in MB, dataCoord.segment.maxSize is used if not set
+	maxSegmentSize int64
+	// the deleted rows and old versions after timetravel are kept, the retention duration applies if not set
+	timetravel Timestamp
+}
+
+func (s *compactionScope) getMode() datapb.ManualCompactionMode {
+	if s == nil {
+		return datapb.ManualCompactionMode_DefaultCompaction
+	}
+	return s.mode
+}
+
+// contains returns whether the segment is within the scope, a nil scope contains all the segments
+func (s *compactionScope) contains(segment *SegmentInfo) bool {
+	if s == nil {
+		return true
+	}
+	if len(s.partitionIDs) > 0 && !lo.Contains(s.partitionIDs, segment.GetPartitionID()) {
+		return false
+	}
+	if len(s.segmentIDs) > 0 && !lo.Contains(s.segmentIDs, segment.GetID()) {
+		return false
+	}
+	return true
+}
+
+// limitSegmentSize returns the clones of segments whose max row num is scaled to the max segment size of the scope
+func (s *compactionScope) limitSegmentSize(segments []*SegmentInfo) []*SegmentInfo {
+	if s == nil || s.maxSegmentSize <= 0 {
+		return segments
+	}
+	ratio := float64(s.maxSegmentSize) / Params.DataCoordCfg.SegmentMaxSize
+	return lo.Map(segments, func(segment *SegmentInfo, _ int) *SegmentInfo {
+		clone := segment.ShadowClone()
+		clone.MaxRowNum = int64(float64(segment.GetMaxRowNum()) * ratio)
+		return clone
+	})
+}
+
+// limitTravelTime moves the travel time of the compaction back to the timetravel of the scope if it's earlier
+func (s *compactionScope) limitTravelTime(ct *compactTime) {
+	if s == nil || s.timetravel == 0 || s.timetravel >= ct.travelTime {
+		return
+	}
+	ct.travelTime = s.timetravel
 }
 
 var _ trigger = (*compactionTrigger)(nil)
@@ -240,6 +299,12 @@ func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, s
 // forceTriggerCompaction force to start a compaction
 // invoked by user `ManualCompaction` operation
 func (t *compactionTrigger) forceTriggerCompaction(collectionID int64) (UniqueID, error) {
+	return t.forceTriggerCompactionWithScope(collectionID, nil)
+}
+
+// forceTriggerCompactionWithScope force to start a compaction over the partitions and segments in scope
+// invoked by user `ManualCompactionWithScope` operation
+func (t *compactionTrigger) forceTriggerCompactionWithScope(collectionID int64, scope *compactionScope) (UniqueID, error) {
 	id, err := t.allocSignalID()
 	if err != nil {
 		return -1, err
@@ -249,6 +314,7 @@ func (t *compactionTrigger) forceTriggerCompaction(collectionID int64) (UniqueID
 		isForce:      true,
 		isGlobal:     true,
 		collectionID: collectionID,
+		scope:        scope,
 	}
 	t.handleGlobalSignal(signal)
 	return id, nil
@@ -313,7 +379,8 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			isSegmentHealthy(segment) &&
 			isFlush(segment) &&
 			!segment.isCompacting && // not compacting now
-			!segment.GetIsImporting() // not importing now
+			!segment.GetIsImporting() && // not importing now
+			signal.scope.contains(segment)
 	}) // m is list of chanPartSegments, which is channel-partition organized segments
 
 	if len(m) == 0 {
@@ -341,6 +408,7 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 			log.Warn("failed to update segment max size,", zap.Error(err))
 			continue
 		}
+		group.segments = signal.scope.limitSegmentSize(group.segments)
 
 		ct, err := t.getCompactTime(ts, group.collectionID)
 		if err != nil {
@@ -350,8 +418,22 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) {
 				zap.String("channel", group.channelName))
 			return
 		}
-
-		plans := t.generatePlans(group.segments, signal.isForce, ct)
+		signal.scope.limitTravelTime(ct)
+
+		var plans []*datapb.CompactionPlan
+		switch signal.scope.getMode() {
+		case datapb.ManualCompactionMode_MergeOnly:
+			plans = t.generateMergePlans(group.segments, ct)
+		case datapb.ManualCompactionMode_DeletePurge:
+			plans = t.generatePurgePlans(group.segments, ct)
+		default:
+			if clusteringPlans := t.generateClusteringPlans(group.collectionID, group.segments, signal.isForce, ct); len(clusteringPlans) > 0 {
+				// clustering compaction rewrites the unclustered segments of the partition, no need to merge them
+				plans = clusteringPlans
+			} else {
+				plans = t.generatePlans(group.segments, signal.isForce, ct)
+			}
+		}
 		for _, plan := range plans {
 			segIDs := fetchSegIDs(plan.GetSegmentBinlogs())
 
@@ -571,6 +653,156 @@ func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, c
 	return plans
 }
 
+// generateMergePlans only merges the segments which are not full together, segments with deleted or expired rows
+// are not compacted on their own.
+func (t *compactionTrigger) generateMergePlans(segments []*SegmentInfo, compactTime *compactTime) []*datapb.CompactionPlan {
+	var candidates []*SegmentInfo
+	for _, segment := range segments {
+		if segment.GetNumOfRows() < segment.GetMaxRowNum() {
+			candidates = append(candidates, segment.ShadowClone())
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].GetNumOfRows() != candidates[j].GetNumOfRows() {
+			return candidates[i].GetNumOfRows() > candidates[j].GetNumOfRows()
+		}
+		return candidates[i].GetID() < candidates[j].GetID()
+	})
+
+	var plans []*datapb.CompactionPlan
+	for len(candidates) > 0 {
+		segment := candidates[0]
+		candidates = candidates[1:]
+
+		var result []*SegmentInfo
+		free := segment.GetMaxRowNum() - segment.GetNumOfRows()
+		candidates, result, _ = reverseGreedySelect(candidates, free, Params.DataCoordCfg.MaxSegmentToMerge-1)
+		if len(result) == 0 {
+			continue
+		}
+		bucket := append([]*SegmentInfo{segment}, result...)
+		plan := segmentsToPlan(bucket, compactTime)
+		log.Info("generate a merge only plan", zap.Int64s("segment IDs", fetchSegIDs(plan.GetSegmentBinlogs())))
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// generatePurgePlans generates a plan for each segment which has deleted or expired rows to purge,
+// segments are never merged together.
+func (t *compactionTrigger) generatePurgePlans(segments []*SegmentInfo, compactTime *compactTime) []*datapb.CompactionPlan {
+	var plans []*datapb.CompactionPlan
+	for _, segment := range segments {
+		if !hasRowsToPurge(segment, compactTime) {
+			continue
+		}
+		plan := segmentsToPlan([]*SegmentInfo{segment.ShadowClone()}, compactTime)
+		log.Info("generate a delete purge plan", zap.Int64("segmentID", segment.GetID()))
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// hasRowsToPurge returns whether the segment has rows deleted before the time travel point or expired by the TTL
+func hasRowsToPurge(segment *SegmentInfo, compactTime *compactTime) bool {
+	for _, deltaLogs := range segment.GetDeltalogs() {
+		for _, l := range deltaLogs.GetBinlogs() {
+			if l.GetEntriesNum() > 0 && l.GetTimestampTo() < compactTime.travelTime {
+				return true
+			}
+		}
+	}
+	for _, binlogs := range segment.GetBinlogs() {
+		for _, l := range binlogs.GetBinlogs() {
+			if l.GetTimestampTo() < compactTime.expireTime {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// generateClusteringPlans generates clustering compaction plans over the unclustered segments of a channel-partition
+// group, each plan splits the data of its segments into segments of disjoint clustering key ranges. The segments already
+// clustered are left out, and the segments of a plan are limited to ClusteringCompactionMaxPlanSize in total. It returns
+// nil if clustering compaction is disabled, the collection has no clustering key or there are not enough unclustered
+// segments in the group.
+func (t *compactionTrigger) generateClusteringPlans(collectionID UniqueID, segments []*SegmentInfo, force bool, compactTime *compactTime) []*datapb.CompactionPlan {
+	if !Params.DataCoordCfg.EnableClusteringCompaction || len(segments) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	coll, err := t.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to get collection, skip clustering compaction",
+			zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil
+	}
+	clusteringField := typeutil.GetClusteringKeyField(coll.Schema)
+	if clusteringField == nil {
+		return nil
+	}
+
+	maxPlanSize := int64(Params.DataCoordCfg.ClusteringCompactionMaxPlanSize * 1024 * 1024)
+	var plans []*datapb.CompactionPlan
+	for _, bucket := range groupClusteringSegments(segments, maxPlanSize, Params.DataCoordCfg.ClusteringCompactionMinSegment, force) {
+		var maxRowNum int64
+		for _, segment := range bucket {
+			if segment.GetMaxRowNum() > maxRowNum {
+				maxRowNum = segment.GetMaxRowNum()
+			}
+		}
+		plan := segmentsToPlan(bucket, compactTime)
+		plan.Type = datapb.CompactionType_ClusteringCompaction
+		plan.ClusteringFieldID = clusteringField.GetFieldID()
+		plan.MaxSegmentRows = maxRowNum
+		log.Info("generate a clustering compaction plan",
+			zap.Int64("collectionID", collectionID),
+			zap.Int64("clusteringFieldID", plan.ClusteringFieldID),
+			zap.Int64s("segment IDs", fetchSegIDs(plan.GetSegmentBinlogs())))
+		plans = append(plans, plan)
+	}
+	return plans
+}
+
+// groupClusteringSegments groups the unclustered segments in @segments by their IDs, so that the segments of similar
+// age are clustered together, a group holds at most @maxSize bytes of segments. The groups of less than @minSegment
+// segments are dropped unless @force is true.
+func groupClusteringSegments(segments []*SegmentInfo, maxSize int64, minSegment int, force bool) [][]*SegmentInfo {
+	var unclustered []*SegmentInfo
+	for _, segment := range segments {
+		if segment.GetClusteringKeyRange() == nil {
+			unclustered = append(unclustered, segment)
+		}
+	}
+	sort.Slice(unclustered, func(i, j int) bool {
+		return unclustered[i].GetID() < unclustered[j].GetID()
+	})
+
+	var (
+		groups [][]*SegmentInfo
+		group  []*SegmentInfo
+		size   int64
+	)
+	flush := func() {
+		if len(group) > 0 && (force || len(group) >= minSegment) {
+			groups = append(groups, group)
+		}
+		group, size = nil, 0
+	}
+	for _, segment := range unclustered {
+		if len(group) > 0 && maxSize > 0 && size+segment.getSegmentSize() > maxSize {
+			flush()
+		}
+		group = append(group, segment.ShadowClone())
+		size += segment.getSegmentSize()
+	}
+	flush()
+	return groups
+}
+
 func segmentsToPlan(segments []*SegmentInfo, compactTime *compactTime) *datapb.CompactionPlan {
 	plan := &datapb.CompactionPlan{
 		Timetravel:    compactTime.travelTime,
@@ -663,10 +895,7 @@ func (t *compactionTrigger) isStaleSegment(segment *SegmentInfo) bool {
 
 func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, compactTime *compactTime) bool {
 	// count all the binlog file count
-	var totalLogNum int
-	for _, binlogs := range segment.GetBinlogs() {
-		totalLogNum += len(binlogs.GetBinlogs())
-	}
+	totalLogNum := segment.getInsertLogNum()
 
 	for _, deltaLogs := range segment.GetDeltalogs() {
 		totalLogNum += len(deltaLogs.GetBinlogs())
@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -68,6 +69,16 @@ func (h *spyCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*c
 	panic("not implemented") // TODO: Implement
 }
 
+// getCompactionMetrics returns the running and queued compaction tasks with their scores
+func (h *spyCompactionHandler) getCompactionMetrics() *metricsinfo.DataCoordCompactionMetrics {
+	panic("not implemented") // TODO: Implement
+}
+
+// cancelCompaction cancels the executing tasks of a signal, or only the one of planID if it's not 0
+func (h *spyCompactionHandler) cancelCompaction(signalID int64, planID int64) error {
+	panic("not implemented") // TODO: Implement
+}
+
 func (h *spyCompactionHandler) start() {}
 
 func (h *spyCompactionHandler) stop() {}
@@ -1551,3 +1562,121 @@ func Test_getCompactTime(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, ct)
 }
+
+func Test_compactionScope(t *testing.T) {
+	segment := NewSegmentInfo(&datapb.SegmentInfo{ID: 1, PartitionID: 10, NumOfRows: 100, MaxRowNum: 1000})
+
+	var nilScope *compactionScope
+	assert.True(t, nilScope.contains(segment))
+	assert.Equal(t, datapb.ManualCompactionMode_DefaultCompaction, nilScope.getMode())
+	assert.Equal(t, []*SegmentInfo{segment}, nilScope.limitSegmentSize([]*SegmentInfo{segment}))
+
+	assert.True(t, (&compactionScope{partitionIDs: []UniqueID{10}}).contains(segment))
+	assert.False(t, (&compactionScope{partitionIDs: []UniqueID{11}}).contains(segment))
+	assert.True(t, (&compactionScope{segmentIDs: []UniqueID{1, 2}}).contains(segment))
+	assert.False(t, (&compactionScope{partitionIDs: []UniqueID{10}, segmentIDs: []UniqueID{2}}).contains(segment))
+
+	scope := &compactionScope{mode: datapb.ManualCompactionMode_MergeOnly, maxSegmentSize: int64(Params.DataCoordCfg.SegmentMaxSize / 2)}
+	assert.Equal(t, datapb.ManualCompactionMode_MergeOnly, scope.getMode())
+	limited := scope.limitSegmentSize([]*SegmentInfo{segment})
+	assert.Equal(t, int64(500), limited[0].GetMaxRowNum())
+	assert.Equal(t, int64(1000), segment.GetMaxRowNum())
+
+	ct := &compactTime{travelTime: 100}
+	nilScope.limitTravelTime(ct)
+	assert.Equal(t, Timestamp(100), ct.travelTime)
+	(&compactionScope{timetravel: 200}).limitTravelTime(ct)
+	assert.Equal(t, Timestamp(100), ct.travelTime)
+	(&compactionScope{timetravel: 50}).limitTravelTime(ct)
+	assert.Equal(t, Timestamp(50), ct.travelTime)
+}
+
+func Test_compactionTrigger_generateMergePlans(t *testing.T) {
+	newSegment := func(id, rows int64) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{ID: id, NumOfRows: rows, MaxRowNum: 100, InsertChannel: "ch1"})
+	}
+	segments := []*SegmentInfo{
+		newSegment(1, 100), // full, never merged
+		newSegment(2, 60),
+		newSegment(3, 30),
+		newSegment(4, 50),
+		newSegment(5, 40),
+		newSegment(6, 90), // nothing left to merge with
+	}
+	tr := &compactionTrigger{}
+	plans := tr.generateMergePlans(segments, &compactTime{})
+	assert.Equal(t, 2, len(plans))
+	assert.Equal(t, []int64{2, 3}, fetchSegIDs(plans[0].GetSegmentBinlogs()))
+	assert.Equal(t, []int64{4, 5}, fetchSegIDs(plans[1].GetSegmentBinlogs()))
+}
+
+func Test_compactionTrigger_generatePurgePlans(t *testing.T) {
+	ct := &compactTime{travelTime: 100, expireTime: 50}
+	segments := []*SegmentInfo{
+		// no deletes nor expired rows
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 1, InsertChannel: "ch1",
+			Binlogs:   []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{TimestampTo: 60}}}},
+			Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampTo: 200}}}}}),
+		// deleted before the time travel point
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 2, InsertChannel: "ch1",
+			Binlogs:   []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{TimestampTo: 60}}}},
+			Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampTo: 90}}}}}),
+		// expired by the TTL
+		NewSegmentInfo(&datapb.SegmentInfo{ID: 3, InsertChannel: "ch1",
+			Binlogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{TimestampTo: 40}}}}}),
+	}
+	tr := &compactionTrigger{}
+	plans := tr.generatePurgePlans(segments, ct)
+	assert.Equal(t, 2, len(plans))
+	assert.Equal(t, []int64{2}, fetchSegIDs(plans[0].GetSegmentBinlogs()))
+	assert.Equal(t, []int64{3}, fetchSegIDs(plans[1].GetSegmentBinlogs()))
+}
+
+func Test_groupClusteringSegments(t *testing.T) {
+	newSegment := func(id int64, size int64, clustered bool) *SegmentInfo {
+		segment := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID: id,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogSize: size}}},
+			},
+		}}
+		if clustered {
+			segment.ClusteringKeyRange = &datapb.FieldStats{FieldID: 100}
+		}
+		return segment
+	}
+	fetchSegmentIDs := func(segments []*SegmentInfo) []int64 {
+		var ids []int64
+		for _, segment := range segments {
+			ids = append(ids, segment.GetID())
+		}
+		return ids
+	}
+	segments := []*SegmentInfo{
+		newSegment(4, 10, false),
+		newSegment(1, 10, false),
+		newSegment(2, 10, true),
+		newSegment(3, 10, false),
+		newSegment(5, 10, false),
+	}
+
+	// the clustered segment is left out
+	groups := groupClusteringSegments(segments, 0, 2, false)
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, []int64{1, 3, 4, 5}, fetchSegmentIDs(groups[0]))
+
+	// groups are limited by size, the last group has not enough segments
+	groups = groupClusteringSegments(segments, 25, 2, false)
+	assert.Equal(t, 2, len(groups))
+	assert.Equal(t, []int64{1, 3}, fetchSegmentIDs(groups[0]))
+	assert.Equal(t, []int64{4, 5}, fetchSegmentIDs(groups[1]))
+
+	groups = groupClusteringSegments(segments, 35, 2, false)
+	assert.Equal(t, 1, len(groups))
+	assert.Equal(t, []int64{1, 3, 4}, fetchSegmentIDs(groups[0]))
+	groups = groupClusteringSegments(segments, 35, 2, true)
+	assert.Equal(t, 2, len(groups))
+	assert.Equal(t, []int64{5}, fetchSegmentIDs(groups[1]))
+
+	assert.Nil(t, groupClusteringSegments(segments[2:3], 0, 1, true))
+}
@@ -22,6 +22,12 @@ const (
 	segmentReferPrefix = "segmentRefer"
 )
 
+// export job
+const (
+	// exportJobPrefix is the prefix of the export job path
+	exportJobPrefix = "exportJob"
+)
+
 const (
 	moduleName = "DataCoord"
 )
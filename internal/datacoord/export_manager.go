@@ -0,0 +1,782 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"go.uber.org/zap"
+)
+
+const (
+	rpcExportTimeout     = 10 * time.Second
+	exportSchedulePeriod = 3 * time.Second
+)
+
+// exportTask is a group of segments of an export job executed by one datanode.
+type exportTask struct {
+	taskID     UniqueID
+	segmentIDs []UniqueID
+	nodeID     UniqueID
+	state      datapb.ExportState
+	attempts   int
+	// the latest result reported by the datanode executing the task
+	result *datapb.ExportTaskResult
+}
+
+type exportJob struct {
+	jobID        UniqueID
+	collectionID UniqueID
+	partitionIDs []UniqueID
+	timestamp    Timestamp
+	format       datapb.ExportFormat
+	targetPrefix string
+	schema       *schemapb.CollectionSchema
+	// the vchannels of the collection, the job is planned after their checkpoints pass the timestamp
+	channels []string
+	state    datapb.ExportState
+	reason   string
+	// whether the segments are split into tasks
+	planned    bool
+	tasks      []*exportTask
+	segmentNum int
+	endTime    time.Time
+}
+
+func newExportJobFromInfo(info *datapb.ExportJobInfo) *exportJob {
+	job := &exportJob{
+		jobID:        info.GetJobID(),
+		collectionID: info.GetCollectionID(),
+		partitionIDs: info.GetPartitionIDs(),
+		timestamp:    info.GetTimestamp(),
+		format:       info.GetFormat(),
+		targetPrefix: info.GetTargetPrefix(),
+		schema:       info.GetSchema(),
+		channels:     info.GetChannels(),
+		state:        info.GetState(),
+		reason:       info.GetReason(),
+		planned:      info.GetPlanned(),
+		segmentNum:   int(info.GetSegmentNum()),
+	}
+	if info.GetEndTime() > 0 {
+		job.endTime = time.UnixMilli(info.GetEndTime())
+	}
+	for _, t := range info.GetTasks() {
+		job.tasks = append(job.tasks, &exportTask{
+			taskID:     t.GetTaskID(),
+			segmentIDs: t.GetSegmentIDs(),
+			nodeID:     t.GetNodeID(),
+			state:      t.GetState(),
+			attempts:   int(t.GetAttempts()),
+			result:     t.GetResult(),
+		})
+	}
+	return job
+}
+
+func (job *exportJob) toInfo() *datapb.ExportJobInfo {
+	info := &datapb.ExportJobInfo{
+		JobID:        job.jobID,
+		CollectionID: job.collectionID,
+		PartitionIDs: job.partitionIDs,
+		Timestamp:    job.timestamp,
+		Format:       job.format,
+		TargetPrefix: job.targetPrefix,
+		Schema:       job.schema,
+		Channels:     job.channels,
+		State:        job.state,
+		Reason:       job.reason,
+		Planned:      job.planned,
+		SegmentNum:   int64(job.segmentNum),
+	}
+	if !job.endTime.IsZero() {
+		info.EndTime = job.endTime.UnixMilli()
+	}
+	for _, t := range job.tasks {
+		info.Tasks = append(info.Tasks, &datapb.ExportTaskInfo{
+			TaskID:     t.taskID,
+			SegmentIDs: t.segmentIDs,
+			NodeID:     t.nodeID,
+			State:      t.state,
+			Attempts:   int32(t.attempts),
+			Result:     t.result,
+		})
+	}
+	return info
+}
+
+func (job *exportJob) segmentIDs() []UniqueID {
+	segIDs := make([]UniqueID, 0, job.segmentNum)
+	for _, task := range job.tasks {
+		segIDs = append(segIDs, task.segmentIDs...)
+	}
+	return segIDs
+}
+
+func (job *exportJob) isFinished() bool {
+	return job.state == datapb.ExportState_ExportCompleted ||
+		job.state == datapb.ExportState_ExportFailed ||
+		job.state == datapb.ExportState_ExportCanceled
+}
+
+// exportManager splits export jobs into tasks and dispatches them to datanodes. A job waits until the rows
+// inserted before its timestamp are flushed, then its flushed segments are split into tasks and protected from
+// gc by a reference lock until the job finishes. The jobs are persisted in the kv so they survive a restart.
+type exportManager struct {
+	mu             sync.RWMutex
+	jobs           map[UniqueID]*exportJob // jobID -> job
+	kv             kv.BaseKV
+	meta           *meta
+	allocator      allocator
+	sessions       *SessionManager
+	segRefer       *SegmentReferenceManager
+	segmentManager Manager
+	notifyCh       chan struct{}
+	quit           chan struct{}
+	wg             sync.WaitGroup
+}
+
+func newExportManager(kv kv.BaseKV, sessions *SessionManager, meta *meta, allocator allocator,
+	segRefer *SegmentReferenceManager, segmentManager Manager) (*exportManager, error) {
+	m := &exportManager{
+		jobs:           make(map[UniqueID]*exportJob),
+		kv:             kv,
+		meta:           meta,
+		allocator:      allocator,
+		sessions:       sessions,
+		segRefer:       segRefer,
+		segmentManager: segmentManager,
+		notifyCh:       make(chan struct{}, 1),
+	}
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// load recovers the persisted jobs, the reference locks of the unfinished jobs are added again since the locks
+// of the previous DataCoord are released on start.
+func (m *exportManager) load() error {
+	_, values, err := m.kv.LoadWithPrefix(exportJobPrefix)
+	if err != nil {
+		log.Error("load export jobs from kv failed", zap.Error(err))
+		return err
+	}
+	for _, value := range values {
+		info := &datapb.ExportJobInfo{}
+		if err := proto.Unmarshal([]byte(value), info); err != nil {
+			log.Error("unmarshal export job failed", zap.Error(err))
+			return err
+		}
+		job := newExportJobFromInfo(info)
+		if !job.isFinished() && job.planned {
+			if err := m.segRefer.AddSegmentsLock(job.jobID, job.segmentIDs(), paramtable.GetNodeID()); err != nil {
+				return err
+			}
+		}
+		m.jobs[job.jobID] = job
+	}
+	log.Info("export jobs loaded", zap.Int("jobNum", len(m.jobs)))
+	return nil
+}
+
+func exportJobKey(jobID UniqueID) string {
+	return path.Join(exportJobPrefix, strconv.FormatInt(jobID, 10))
+}
+
+// saveJob persists the job.
+func (m *exportManager) saveJob(job *exportJob) error {
+	value, err := proto.Marshal(job.toInfo())
+	if err != nil {
+		return err
+	}
+	return m.kv.Save(exportJobKey(job.jobID), string(value))
+}
+
+// updateJob persists the changed job, the failure is only logged since the job is persisted again on the next
+// change, and the tasks restored from a stale state are retried.
+func (m *exportManager) updateJob(job *exportJob) {
+	if err := m.saveJob(job); err != nil {
+		log.Warn("failed to save export job", zap.Int64("jobID", job.jobID), zap.Error(err))
+	}
+}
+
+func (m *exportManager) start() {
+	ticker := time.NewTicker(exportSchedulePeriod)
+	m.quit = make(chan struct{})
+	m.wg.Add(1)
+
+	go func() {
+		defer m.wg.Done()
+		for {
+			select {
+			case <-m.quit:
+				ticker.Stop()
+				log.Info("export manager quit")
+				return
+			case <-ticker.C:
+				m.schedule()
+			case <-m.notifyCh:
+				m.schedule()
+			}
+		}
+	}()
+}
+
+func (m *exportManager) stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+func (m *exportManager) notify() {
+	select {
+	case m.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// submit creates an export job of the collection and returns the job ID. The growing segments are sealed so that
+// the rows inserted before the timestamp get flushed, the job is planned once the checkpoints of the channels pass it.
+func (m *exportManager) submit(ctx context.Context, req *datapb.ExportRequest, schema *schemapb.CollectionSchema, channels []string) (UniqueID, error) {
+	if _, ok := datapb.ExportFormat_name[int32(req.GetFormat())]; !ok {
+		return 0, fmt.Errorf("invalid export format %d", req.GetFormat())
+	}
+	targetPrefix := strings.Trim(path.Clean(req.GetTargetPrefix()), "/")
+	if req.GetTargetPrefix() == "" || targetPrefix == "" || targetPrefix == "." {
+		return 0, fmt.Errorf("target prefix of export is empty")
+	}
+	if m.meta.chunkManager != nil {
+		rootPath := strings.Trim(path.Clean(m.meta.chunkManager.RootPath()), "/")
+		if rootPath != "" && rootPath != "." && (targetPrefix == rootPath || strings.HasPrefix(targetPrefix, rootPath+"/")) {
+			return 0, fmt.Errorf("target prefix %s is inside the root path %s of milvus data", targetPrefix, rootPath)
+		}
+	}
+
+	ts := req.GetTimestamp()
+	if ts == 0 {
+		var err error
+		ts, err = m.allocator.allocTimestamp(ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+	jobID, err := m.allocator.allocID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// seal after the timestamp is allocated, the rows before it are all in the sealed or flushed segments
+	if _, err := m.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), nil); err != nil {
+		return 0, err
+	}
+
+	job := &exportJob{
+		jobID:        jobID,
+		collectionID: req.GetCollectionID(),
+		partitionIDs: req.GetPartitionIDs(),
+		timestamp:    ts,
+		format:       req.GetFormat(),
+		targetPrefix: targetPrefix,
+		schema:       schema,
+		channels:     channels,
+		state:        datapb.ExportState_ExportPending,
+	}
+	if err := m.saveJob(job); err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	m.jobs[jobID] = job
+	m.mu.Unlock()
+	m.notify()
+
+	log.Info("export job submitted", zap.Int64("jobID", jobID), zap.Int64("collectionID", job.collectionID),
+		zap.Int64s("partitionIDs", job.partitionIDs), zap.Uint64("timestamp", ts), zap.String("format", job.format.String()),
+		zap.String("targetPrefix", targetPrefix), zap.Strings("channels", channels))
+	return jobID, nil
+}
+
+// isFlushed checks whether the rows inserted before the timestamp of the job are flushed, which is the case when
+// the checkpoints of all the channels pass the timestamp and no segment holding the rows is still growing or flushing.
+// The growing segments holding the rows are sealed so that they get flushed.
+func (m *exportManager) isFlushed(ctx context.Context, job *exportJob) bool {
+	for _, channel := range job.channels {
+		cp := m.meta.GetChannelCheckpoint(channel)
+		if cp == nil || cp.GetTimestamp() < job.timestamp {
+			return false
+		}
+	}
+
+	partitions := make(map[UniqueID]struct{}, len(job.partitionIDs))
+	for _, partitionID := range job.partitionIDs {
+		partitions[partitionID] = struct{}{}
+	}
+	unflushed := m.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		if !isSegmentHealthy(segment) || segment.GetCollectionID() != job.collectionID || segment.GetIsImporting() ||
+			segment.GetState() == commonpb.SegmentState_Flushed {
+			return false
+		}
+		if _, ok := partitions[segment.GetPartitionID()]; len(partitions) > 0 && !ok {
+			return false
+		}
+		return segment.GetStartPosition() != nil && segment.GetStartPosition().GetTimestamp() <= job.timestamp
+	})
+	var growing []UniqueID
+	for _, segment := range unflushed {
+		if segment.GetState() == commonpb.SegmentState_Growing {
+			growing = append(growing, segment.GetID())
+		}
+	}
+	if len(growing) > 0 {
+		if _, err := m.segmentManager.SealAllSegments(ctx, job.collectionID, growing); err != nil {
+			log.Warn("failed to seal segments for export", zap.Int64("jobID", job.jobID), zap.Int64s("segmentIDs", growing), zap.Error(err))
+		}
+	}
+	return len(unflushed) == 0
+}
+
+// plan splits the flushed segments of the job into tasks and locks the segments.
+func (m *exportManager) plan(ctx context.Context, job *exportJob) error {
+	partitions := make(map[UniqueID]struct{}, len(job.partitionIDs))
+	for _, partitionID := range job.partitionIDs {
+		partitions[partitionID] = struct{}{}
+	}
+	segments := m.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		if !isSegmentHealthy(segment) || segment.GetCollectionID() != job.collectionID ||
+			segment.GetState() != commonpb.SegmentState_Flushed || segment.GetIsImporting() {
+			return false
+		}
+		_, ok := partitions[segment.GetPartitionID()]
+		return len(partitions) == 0 || ok
+	})
+	sort.Slice(segments, func(i, j int) bool { return segments[i].GetID() < segments[j].GetID() })
+
+	segmentsPerTask := Params.DataCoordCfg.ExportSegmentsPerTask
+	var tasks []*exportTask
+	segIDs := make([]UniqueID, 0, len(segments))
+	for start := 0; start < len(segments); start += segmentsPerTask {
+		taskID, err := m.allocator.allocID(ctx)
+		if err != nil {
+			return err
+		}
+		task := &exportTask{
+			taskID: taskID,
+			state:  datapb.ExportState_ExportPending,
+		}
+		end := start + segmentsPerTask
+		if end > len(segments) {
+			end = len(segments)
+		}
+		for _, segment := range segments[start:end] {
+			task.segmentIDs = append(task.segmentIDs, segment.GetID())
+			segIDs = append(segIDs, segment.GetID())
+		}
+		tasks = append(tasks, task)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	// the job may be canceled while planning
+	if job.isFinished() || job.planned {
+		return nil
+	}
+	if len(segIDs) > 0 {
+		if err := m.segRefer.AddSegmentsLock(job.jobID, segIDs, paramtable.GetNodeID()); err != nil {
+			return err
+		}
+	}
+	job.planned = true
+	job.tasks = tasks
+	job.segmentNum = len(segIDs)
+	if len(segIDs) == 0 {
+		job.state = datapb.ExportState_ExportCompleted
+		job.endTime = time.Now()
+	}
+	m.updateJob(job)
+	log.Info("export job planned", zap.Int64("jobID", job.jobID), zap.Int("segmentNum", len(segIDs)), zap.Int("taskNum", len(tasks)))
+	return nil
+}
+
+type exportDispatch struct {
+	job      *exportJob
+	task     *exportTask
+	nodeID   UniqueID
+	attempts int
+	req      *datapb.ExportTask
+}
+
+// schedule plans the jobs whose rows are flushed, retries the tasks of the offline datanodes, dispatches the pending
+// tasks to the datanode with the least running export tasks and removes the expired finished jobs.
+func (m *exportManager) schedule() {
+	m.mu.RLock()
+	var unplanned []*exportJob
+	for _, job := range m.jobs {
+		if !job.isFinished() && !job.planned {
+			unplanned = append(unplanned, job)
+		}
+	}
+	m.mu.RUnlock()
+	for _, job := range unplanned {
+		ctx, cancel := context.WithTimeout(context.Background(), rpcExportTimeout)
+		if m.isFlushed(ctx, job) {
+			if err := m.plan(ctx, job); err != nil {
+				log.Warn("failed to plan export job", zap.Int64("jobID", job.jobID), zap.Error(err))
+			}
+		}
+		cancel()
+	}
+
+	m.mu.Lock()
+	liveNodes := make(map[UniqueID]struct{})
+	for _, nodeID := range m.sessions.getLiveNodeIDs() {
+		liveNodes[nodeID] = struct{}{}
+	}
+	running := make(map[UniqueID]int, len(liveNodes))
+	for nodeID := range liveNodes {
+		running[nodeID] = 0
+	}
+	cancels := make(map[UniqueID][]UniqueID)
+	for jobID, job := range m.jobs {
+		if job.isFinished() {
+			if time.Since(job.endTime) > Params.DataCoordCfg.ExportJobRetention {
+				if err := m.kv.Remove(exportJobKey(jobID)); err != nil {
+					log.Warn("failed to remove export job", zap.Int64("jobID", jobID), zap.Error(err))
+					continue
+				}
+				delete(m.jobs, jobID)
+			}
+			continue
+		}
+		changed := false
+		for _, task := range job.tasks {
+			if task.state != datapb.ExportState_ExportExecuting {
+				continue
+			}
+			if _, ok := liveNodes[task.nodeID]; !ok {
+				if nodes := m.retryTask(job, task, fmt.Sprintf("datanode %d is offline", task.nodeID)); len(nodes) > 0 {
+					cancels[jobID] = nodes
+				}
+				changed = true
+				continue
+			}
+			running[task.nodeID]++
+		}
+		if changed {
+			m.updateJob(job)
+		}
+	}
+
+	var dispatches []*exportDispatch
+	for jobID, job := range m.jobs {
+		if job.isFinished() || !job.planned {
+			continue
+		}
+		changed := false
+		for _, task := range job.tasks {
+			if task.state != datapb.ExportState_ExportPending {
+				continue
+			}
+			nodeID, ok := pickExportNode(running)
+			if !ok {
+				break
+			}
+			task.nodeID = nodeID
+			req, err := m.buildTask(job, task)
+			if err != nil {
+				task.nodeID = 0
+				cancels[jobID] = append(cancels[jobID], m.finishJob(job, datapb.ExportState_ExportFailed, err.Error())...)
+				changed = true
+				break
+			}
+			running[nodeID]++
+			task.state = datapb.ExportState_ExportExecuting
+			task.attempts++
+			task.result = nil
+			job.state = datapb.ExportState_ExportExecuting
+			changed = true
+			dispatches = append(dispatches, &exportDispatch{
+				job:      job,
+				task:     task,
+				nodeID:   nodeID,
+				attempts: task.attempts,
+				req:      req,
+			})
+		}
+		if changed {
+			m.updateJob(job)
+		}
+	}
+	m.mu.Unlock()
+
+	m.cancelOnNodes(cancels)
+	for _, d := range dispatches {
+		err := m.sessions.Export(d.nodeID, d.req)
+		if err == nil {
+			continue
+		}
+		var nodes []UniqueID
+		m.mu.Lock()
+		// the task may be retried or canceled while sending the request
+		if !d.job.isFinished() && d.task.state == datapb.ExportState_ExportExecuting && d.task.attempts == d.attempts {
+			nodes = m.retryTask(d.job, d.task, err.Error())
+			m.updateJob(d.job)
+		}
+		m.mu.Unlock()
+		if len(nodes) > 0 {
+			m.cancelOnNodes(map[UniqueID][]UniqueID{d.job.jobID: nodes})
+		}
+	}
+}
+
+// pickExportNode returns the node with the least running export tasks, which still has a free slot.
+func pickExportNode(running map[UniqueID]int) (UniqueID, bool) {
+	picked, found := UniqueID(0), false
+	for nodeID, num := range running {
+		if num >= Params.DataCoordCfg.ExportTasksPerNode {
+			continue
+		}
+		if !found || num < running[picked] || (num == running[picked] && nodeID < picked) {
+			picked, found = nodeID, true
+		}
+	}
+	return picked, found
+}
+
+// buildTask builds the request of the task with the current binlogs of its segments, the segments compacted after
+// the job is planned are still readable since their binlogs are kept by the reference lock.
+func (m *exportManager) buildTask(job *exportJob, task *exportTask) (*datapb.ExportTask, error) {
+	segmentBinlogs := make([]*datapb.CompactionSegmentBinlogs, 0, len(task.segmentIDs))
+	for _, segmentID := range task.segmentIDs {
+		segment := m.meta.GetSegmentUnsafe(segmentID)
+		if segment == nil {
+			return nil, fmt.Errorf("segment %d of export task %d not found", segmentID, task.taskID)
+		}
+		segmentBinlogs = append(segmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:           segment.GetID(),
+			FieldBinlogs:        segment.GetBinlogs(),
+			Field2StatslogPaths: segment.GetStatslogs(),
+			Deltalogs:           segment.GetDeltalogs(),
+		})
+	}
+	return &datapb.ExportTask{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			commonpbutil.WithTargetID(task.nodeID),
+		),
+		JobID:          job.jobID,
+		TaskID:         task.taskID,
+		CollectionID:   job.collectionID,
+		Schema:         job.schema,
+		Timestamp:      job.timestamp,
+		Format:         job.format,
+		TargetPrefix:   path.Join(job.targetPrefix, strconv.FormatInt(job.jobID, 10)),
+		SegmentBinlogs: segmentBinlogs,
+	}, nil
+}
+
+// retryTask moves the task back to pending, or fails the job if the task has run out of attempts.
+// It returns the nodes executing the other tasks of the failed job. Caller should hold the lock.
+func (m *exportManager) retryTask(job *exportJob, task *exportTask, reason string) []UniqueID {
+	log.Warn("export task failed", zap.Int64("jobID", job.jobID), zap.Int64("taskID", task.taskID),
+		zap.Int64("nodeID", task.nodeID), zap.Int("attempts", task.attempts), zap.String("reason", reason))
+	if task.attempts < Params.DataCoordCfg.ExportTaskMaxAttempts {
+		task.state = datapb.ExportState_ExportPending
+		task.nodeID = 0
+		task.result = nil
+		return nil
+	}
+	task.state = datapb.ExportState_ExportFailed
+	task.nodeID = 0
+	return m.finishJob(job, datapb.ExportState_ExportFailed,
+		fmt.Sprintf("task %d failed after %d attempts: %s", task.taskID, task.attempts, reason))
+}
+
+// finishJob sets the final state of the job and releases the reference lock of its segments.
+// It returns the nodes still executing the tasks of the job. Caller should hold the lock.
+func (m *exportManager) finishJob(job *exportJob, state datapb.ExportState, reason string) []UniqueID {
+	job.state = state
+	job.reason = reason
+	job.endTime = time.Now()
+
+	var nodes []UniqueID
+	for _, task := range job.tasks {
+		if task.state == datapb.ExportState_ExportExecuting {
+			nodes = append(nodes, task.nodeID)
+		}
+		if task.state != datapb.ExportState_ExportCompleted && task.state != datapb.ExportState_ExportFailed {
+			task.state = datapb.ExportState_ExportCanceled
+		}
+	}
+	if err := m.segRefer.ReleaseSegmentsLock(job.jobID, paramtable.GetNodeID()); err != nil {
+		log.Warn("failed to release the segment lock of export job", zap.Int64("jobID", job.jobID), zap.Error(err))
+	}
+	log.Info("export job finished", zap.Int64("jobID", job.jobID), zap.String("state", state.String()), zap.String("reason", reason))
+	return nodes
+}
+
+// cancelOnNodes asks the datanodes to stop executing the tasks of the jobs, jobID -> nodes.
+func (m *exportManager) cancelOnNodes(cancels map[UniqueID][]UniqueID) {
+	for jobID, nodes := range cancels {
+		for _, nodeID := range nodes {
+			req := &datapb.CancelExportRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+					commonpbutil.WithTargetID(nodeID),
+				),
+				JobID: jobID,
+			}
+			// the result of the canceled tasks are dropped anyway, so the error is only logged
+			_ = m.sessions.CancelExport(nodeID, req)
+		}
+	}
+}
+
+// report updates the task with the result reported by the datanode.
+func (m *exportManager) report(result *datapb.ExportTaskResult) error {
+	m.mu.Lock()
+	job, ok := m.jobs[result.GetJobID()]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("export job %d not found", result.GetJobID())
+	}
+	var task *exportTask
+	for _, t := range job.tasks {
+		if t.taskID == result.GetTaskID() {
+			task = t
+			break
+		}
+	}
+	if task == nil {
+		m.mu.Unlock()
+		return fmt.Errorf("export task %d not found in job %d", result.GetTaskID(), result.GetJobID())
+	}
+	if job.isFinished() || task.state != datapb.ExportState_ExportExecuting || task.nodeID != result.GetBase().GetSourceID() {
+		m.mu.Unlock()
+		log.Info("ignore the stale export task result", zap.Int64("jobID", job.jobID), zap.Int64("taskID", task.taskID),
+			zap.Int64("nodeID", result.GetBase().GetSourceID()), zap.String("taskState", task.state.String()))
+		return nil
+	}
+
+	var nodes []UniqueID
+	switch result.GetState() {
+	case datapb.ExportState_ExportExecuting:
+		task.result = result
+	case datapb.ExportState_ExportCompleted:
+		task.result = result
+		task.state = datapb.ExportState_ExportCompleted
+		completed := true
+		for _, t := range job.tasks {
+			completed = completed && t.state == datapb.ExportState_ExportCompleted
+		}
+		if completed {
+			m.finishJob(job, datapb.ExportState_ExportCompleted, "")
+		}
+	case datapb.ExportState_ExportFailed:
+		nodes = m.retryTask(job, task, result.GetReason())
+	default:
+		m.mu.Unlock()
+		return fmt.Errorf("unexpected export task state %s", result.GetState().String())
+	}
+	m.updateJob(job)
+	m.mu.Unlock()
+
+	if len(nodes) > 0 {
+		m.cancelOnNodes(map[UniqueID][]UniqueID{job.jobID: nodes})
+	}
+	m.notify()
+	return nil
+}
+
+// getJob returns the job, it must belong to the collection if collectionID is set. Caller should hold the lock.
+func (m *exportManager) getJob(jobID UniqueID, collectionID UniqueID) (*exportJob, error) {
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil, fmt.Errorf("export job %d not found", jobID)
+	}
+	if collectionID != 0 && job.collectionID != collectionID {
+		return nil, fmt.Errorf("export job %d not found in collection %d", jobID, collectionID)
+	}
+	return job, nil
+}
+
+// cancel stops the unfinished export job, the job must belong to the collection if collectionID is set.
+func (m *exportManager) cancel(jobID UniqueID, collectionID UniqueID) error {
+	m.mu.Lock()
+	job, err := m.getJob(jobID, collectionID)
+	if err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	if job.isFinished() {
+		m.mu.Unlock()
+		return fmt.Errorf("export job %d is already %s", jobID, job.state.String())
+	}
+	nodes := m.finishJob(job, datapb.ExportState_ExportCanceled, "canceled by user")
+	m.updateJob(job)
+	m.mu.Unlock()
+
+	m.cancelOnNodes(map[UniqueID][]UniqueID{jobID: nodes})
+	return nil
+}
+
+// getState returns the state and progress of the export job, the job must belong to the collection if collectionID is set.
+func (m *exportManager) getState(jobID UniqueID, collectionID UniqueID) (*datapb.GetExportStateResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	job, err := m.getJob(jobID, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &datapb.GetExportStateResponse{
+		JobID:        jobID,
+		State:        job.state,
+		CollectionID: job.collectionID,
+		Timestamp:    job.timestamp,
+		Reason:       job.reason,
+	}
+	exported := 0
+	for _, task := range job.tasks {
+		if task.result == nil {
+			continue
+		}
+		exported += len(task.result.GetSegmentIDs())
+		resp.RowCount += task.result.GetRowCount()
+		resp.Files = append(resp.Files, task.result.GetFiles()...)
+	}
+	resp.Progress = 100
+	if !job.planned {
+		resp.Progress = 0
+	} else if job.segmentNum > 0 {
+		resp.Progress = int64(exported * 100 / job.segmentNum)
+	}
+	return resp, nil
+}
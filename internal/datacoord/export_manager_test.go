@@ -0,0 +1,375 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+)
+
+func newTestExportManager(t *testing.T, segments []*datapb.SegmentInfo, nodes map[int64]*mockDataNodeClient) *exportManager {
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	for _, segment := range segments {
+		require.NoError(t, meta.AddSegment(NewSegmentInfo(segment)))
+	}
+	segRefer, err := NewSegmentReferenceManager(memkv.NewMemoryKV(), nil)
+	require.NoError(t, err)
+
+	sessions := &SessionManager{}
+	sessions.sessions.data = make(map[int64]*Session)
+	for nodeID, client := range nodes {
+		sessions.sessions.data[nodeID] = &Session{client: client}
+	}
+	m, err := newExportManager(memkv.NewMemoryKV(), sessions, meta, newMockAllocator(), segRefer,
+		newSegmentManager(meta, newMockAllocator(), nil))
+	require.NoError(t, err)
+	return m
+}
+
+// submitTestJob submits a job of a collection without channels and plans it.
+func submitTestJob(t *testing.T, m *exportManager, req *datapb.ExportRequest) *exportJob {
+	jobID, err := m.submit(context.Background(), req, nil, nil)
+	require.NoError(t, err)
+	job := m.jobs[jobID]
+	require.NoError(t, m.plan(context.Background(), job))
+	require.True(t, job.planned)
+	return job
+}
+
+func exportTaskResult(job *exportJob, task *exportTask, state datapb.ExportState, segmentNum int) *datapb.ExportTaskResult {
+	result := &datapb.ExportTaskResult{
+		Base:     commonpbutil.NewMsgBase(commonpbutil.WithSourceID(task.nodeID)),
+		JobID:    job.jobID,
+		TaskID:   task.taskID,
+		State:    state,
+		RowCount: int64(segmentNum * 10),
+	}
+	for _, segmentID := range task.segmentIDs[:segmentNum] {
+		result.SegmentIDs = append(result.SegmentIDs, segmentID)
+		result.Files = append(result.Files, path.Join(job.targetPrefix, "file"))
+	}
+	return result
+}
+
+func Test_exportManager_submit(t *testing.T) {
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+		{ID: 2, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing},
+		{ID: 3, CollectionID: 101, PartitionID: 12, State: commonpb.SegmentState_Growing},
+	}
+	m := newTestExportManager(t, segments, nil)
+	ctx := context.Background()
+
+	_, err := m.submit(ctx, &datapb.ExportRequest{CollectionID: 100, Format: 5, TargetPrefix: "export"}, nil, nil)
+	assert.Error(t, err)
+	_, err = m.submit(ctx, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "/"}, nil, nil)
+	assert.Error(t, err)
+
+	rootPath := t.TempDir()
+	m.meta.chunkManager = storage.NewLocalChunkManager(storage.RootPath(rootPath))
+	_, err = m.submit(ctx, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: path.Join(rootPath, "export")}, nil, nil)
+	assert.Error(t, err)
+
+	jobID, err := m.submit(ctx, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "/export/", Timestamp: 1000}, nil, []string{"ch1"})
+	assert.NoError(t, err)
+	job := m.jobs[jobID]
+	assert.Equal(t, datapb.ExportState_ExportPending, job.state)
+	assert.False(t, job.planned)
+	assert.Equal(t, "export", job.targetPrefix)
+	assert.Equal(t, Timestamp(1000), job.timestamp)
+	assert.Equal(t, []string{"ch1"}, job.channels)
+	// the growing segments of the collection are sealed
+	assert.Equal(t, commonpb.SegmentState_Sealed, m.meta.GetSegment(2).GetState())
+	assert.Equal(t, commonpb.SegmentState_Growing, m.meta.GetSegment(3).GetState())
+	_, err = m.kv.Load(exportJobKey(jobID))
+	assert.NoError(t, err)
+
+	jobID, err = m.submit(ctx, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export"}, nil, nil)
+	assert.NoError(t, err)
+	assert.NotZero(t, m.jobs[jobID].timestamp)
+}
+
+func Test_exportManager_plan(t *testing.T) {
+	startPosition := func(ts Timestamp) *internalpb.MsgPosition {
+		return &internalpb.MsgPosition{ChannelName: "ch1", Timestamp: ts}
+	}
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed},
+		{ID: 2, CollectionID: 100, PartitionID: 11, State: commonpb.SegmentState_Flushed},
+		{ID: 3, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushing, StartPosition: startPosition(500)},
+		{ID: 4, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Flushed, IsImporting: true},
+		{ID: 5, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Dropped},
+		{ID: 6, CollectionID: 101, PartitionID: 12, State: commonpb.SegmentState_Flushed},
+		{ID: 7, CollectionID: 100, PartitionID: 10, State: commonpb.SegmentState_Growing, StartPosition: startPosition(2000)},
+	}
+	m := newTestExportManager(t, segments, nil)
+	ctx := context.Background()
+
+	jobID, err := m.submit(ctx, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export", Timestamp: 1000}, nil, []string{"ch1"})
+	require.NoError(t, err)
+	job := m.jobs[jobID]
+	// segment 7 is sealed on submit, while its rows are after the timestamp
+	require.NoError(t, m.meta.SetState(7, commonpb.SegmentState_Growing))
+
+	// the checkpoint of the channel is behind the timestamp
+	assert.False(t, m.isFlushed(ctx, job))
+	require.NoError(t, m.meta.UpdateChannelCheckpoint("ch1", startPosition(999)))
+	assert.False(t, m.isFlushed(ctx, job))
+
+	// a growing segment holds rows before the timestamp
+	require.NoError(t, m.meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 8, CollectionID: 100, PartitionID: 10,
+		State: commonpb.SegmentState_Growing, StartPosition: startPosition(900)})))
+	require.NoError(t, m.meta.UpdateChannelCheckpoint("ch1", startPosition(1000)))
+	m.schedule()
+	assert.False(t, job.planned)
+	assert.Equal(t, commonpb.SegmentState_Sealed, m.meta.GetSegment(8).GetState())
+	assert.Equal(t, commonpb.SegmentState_Growing, m.meta.GetSegment(7).GetState())
+	state, err := m.getState(jobID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datapb.ExportState_ExportPending, state.GetState())
+	assert.Equal(t, int64(0), state.GetProgress())
+
+	require.NoError(t, m.meta.SetState(3, commonpb.SegmentState_Flushed))
+	require.NoError(t, m.meta.SetState(8, commonpb.SegmentState_Flushed))
+	m.schedule()
+	assert.True(t, job.planned)
+	assert.Equal(t, datapb.ExportState_ExportPending, job.state)
+	assert.Equal(t, 4, job.segmentNum)
+	require.Equal(t, 1, len(job.tasks))
+	assert.Equal(t, []UniqueID{1, 2, 3, 8}, job.tasks[0].segmentIDs)
+	assert.True(t, m.segRefer.HasSegmentLock(1))
+	assert.True(t, m.segRefer.HasSegmentLock(8))
+
+	job = submitTestJob(t, m, &datapb.ExportRequest{CollectionID: 100, PartitionIDs: []int64{11}, TargetPrefix: "export"})
+	require.Equal(t, 1, len(job.tasks))
+	assert.Equal(t, []UniqueID{2}, job.tasks[0].segmentIDs)
+
+	// no flushed segment
+	job = submitTestJob(t, m, &datapb.ExportRequest{CollectionID: 102, TargetPrefix: "export"})
+	state, err = m.getState(job.jobID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datapb.ExportState_ExportCompleted, state.GetState())
+	assert.Equal(t, int64(100), state.GetProgress())
+}
+
+func Test_exportManager_execute(t *testing.T) {
+	var segments []*datapb.SegmentInfo
+	for i := 1; i <= 5; i++ {
+		segments = append(segments, &datapb.SegmentInfo{ID: int64(i), CollectionID: 100, State: commonpb.SegmentState_Flushed})
+	}
+	m := newTestExportManager(t, segments, map[int64]*mockDataNodeClient{1: {}, 2: {}})
+
+	job := submitTestJob(t, m, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export"})
+	jobID := job.jobID
+	require.Equal(t, 2, len(job.tasks))
+
+	m.schedule()
+	assert.Equal(t, datapb.ExportState_ExportExecuting, job.state)
+	task1, task2 := job.tasks[0], job.tasks[1]
+	assert.Equal(t, datapb.ExportState_ExportExecuting, task1.state)
+	assert.Equal(t, datapb.ExportState_ExportExecuting, task2.state)
+	assert.NotEqual(t, task1.nodeID, task2.nodeID)
+	assert.Equal(t, 1, task1.attempts)
+
+	// result from a node not executing the task is ignored
+	stale := exportTaskResult(job, task1, datapb.ExportState_ExportCompleted, 4)
+	stale.Base.SourceID = 3
+	assert.NoError(t, m.report(stale))
+	assert.Equal(t, datapb.ExportState_ExportExecuting, task1.state)
+
+	assert.NoError(t, m.report(exportTaskResult(job, task1, datapb.ExportState_ExportExecuting, 1)))
+	state, err := m.getState(jobID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(20), state.GetProgress())
+	assert.Equal(t, int64(10), state.GetRowCount())
+
+	assert.NoError(t, m.report(exportTaskResult(job, task1, datapb.ExportState_ExportCompleted, 4)))
+	assert.Equal(t, datapb.ExportState_ExportExecuting, job.state)
+	assert.NoError(t, m.report(exportTaskResult(job, task2, datapb.ExportState_ExportCompleted, 1)))
+	state, err = m.getState(jobID, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datapb.ExportState_ExportCompleted, state.GetState())
+	assert.Equal(t, int64(100), state.GetProgress())
+	assert.Equal(t, int64(50), state.GetRowCount())
+	assert.Equal(t, 5, len(state.GetFiles()))
+	assert.False(t, m.segRefer.HasSegmentLock(1))
+
+	assert.Error(t, m.cancel(jobID, 0))
+	_, err = m.getState(jobID, 101)
+	assert.Error(t, err)
+	_, err = m.getState(jobID+100, 0)
+	assert.Error(t, err)
+	assert.Error(t, m.report(&datapb.ExportTaskResult{JobID: jobID + 100}))
+	assert.Error(t, m.report(&datapb.ExportTaskResult{JobID: jobID, TaskID: jobID + 100}))
+
+	// finished jobs are removed after the retention
+	job.endTime = time.Now().Add(-Params.DataCoordCfg.ExportJobRetention - time.Second)
+	m.schedule()
+	_, err = m.getState(jobID, 0)
+	assert.Error(t, err)
+	_, err = m.kv.Load(exportJobKey(jobID))
+	assert.Error(t, err)
+}
+
+func Test_exportManager_retry(t *testing.T) {
+	segments := []*datapb.SegmentInfo{{ID: 1, CollectionID: 100, State: commonpb.SegmentState_Flushed}}
+
+	t.Run("dispatch failed", func(t *testing.T) {
+		failed := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+		m := newTestExportManager(t, segments, map[int64]*mockDataNodeClient{1: {exportResp: failed}})
+		job := submitTestJob(t, m, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export"})
+
+		for i := 1; i < Params.DataCoordCfg.ExportTaskMaxAttempts; i++ {
+			m.schedule()
+			assert.Equal(t, datapb.ExportState_ExportPending, job.tasks[0].state)
+			assert.Equal(t, i, job.tasks[0].attempts)
+		}
+		m.schedule()
+		assert.Equal(t, datapb.ExportState_ExportFailed, job.state)
+		assert.NotEmpty(t, job.reason)
+		assert.False(t, m.segRefer.HasSegmentLock(1))
+	})
+
+	t.Run("node offline", func(t *testing.T) {
+		m := newTestExportManager(t, segments, map[int64]*mockDataNodeClient{1: {}})
+		task := submitTestJob(t, m, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export"}).tasks[0]
+		m.schedule()
+		assert.Equal(t, int64(1), task.nodeID)
+
+		m.sessions.sessions.data = map[int64]*Session{2: {client: &mockDataNodeClient{}}}
+		m.schedule()
+		assert.Equal(t, datapb.ExportState_ExportExecuting, task.state)
+		assert.Equal(t, int64(2), task.nodeID)
+		assert.Equal(t, 2, task.attempts)
+	})
+
+	t.Run("task failed", func(t *testing.T) {
+		m := newTestExportManager(t, segments, map[int64]*mockDataNodeClient{1: {}})
+		job := submitTestJob(t, m, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export"})
+		task := job.tasks[0]
+
+		for i := 0; i < Params.DataCoordCfg.ExportTaskMaxAttempts; i++ {
+			m.schedule()
+			require.Equal(t, datapb.ExportState_ExportExecuting, task.state)
+			result := exportTaskResult(job, task, datapb.ExportState_ExportFailed, 0)
+			result.Reason = "mock failure"
+			assert.NoError(t, m.report(result))
+		}
+		assert.Equal(t, datapb.ExportState_ExportFailed, job.state)
+		assert.Contains(t, job.reason, "mock failure")
+	})
+}
+
+func Test_exportManager_cancel(t *testing.T) {
+	segments := []*datapb.SegmentInfo{{ID: 1, CollectionID: 100, State: commonpb.SegmentState_Flushed}}
+	m := newTestExportManager(t, segments, map[int64]*mockDataNodeClient{1: {}})
+	job := submitTestJob(t, m, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export"})
+	jobID := job.jobID
+	task := job.tasks[0]
+	m.schedule()
+
+	assert.Error(t, m.cancel(jobID, 101))
+	assert.NoError(t, m.cancel(jobID, 100))
+	assert.Equal(t, datapb.ExportState_ExportCanceled, job.state)
+	assert.Equal(t, datapb.ExportState_ExportCanceled, task.state)
+	assert.False(t, m.segRefer.HasSegmentLock(1))
+	assert.Error(t, m.cancel(jobID, 0))
+	assert.Error(t, m.cancel(jobID+100, 0))
+
+	// the result of a canceled task is ignored
+	assert.NoError(t, m.report(exportTaskResult(job, task, datapb.ExportState_ExportCompleted, 1)))
+	assert.Equal(t, datapb.ExportState_ExportCanceled, job.state)
+}
+
+func Test_exportManager_recover(t *testing.T) {
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 100, State: commonpb.SegmentState_Flushed},
+		{ID: 2, CollectionID: 100, State: commonpb.SegmentState_Flushed},
+	}
+	m := newTestExportManager(t, segments, map[int64]*mockDataNodeClient{1: {}})
+	job := submitTestJob(t, m, &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export", Format: datapb.ExportFormat_ExportJSON})
+	m.schedule()
+	assert.NoError(t, m.report(exportTaskResult(job, job.tasks[0], datapb.ExportState_ExportExecuting, 1)))
+	unplanned, err := m.submit(context.Background(), &datapb.ExportRequest{CollectionID: 100, TargetPrefix: "export"}, nil, []string{"ch1"})
+	require.NoError(t, err)
+
+	// the segment locks of the previous DataCoord are released on start
+	segReferKV := memkv.NewMemoryKV()
+	segRefer, err := NewSegmentReferenceManager(segReferKV, nil)
+	require.NoError(t, err)
+	recovered, err := newExportManager(m.kv, m.sessions, m.meta, newMockAllocator(), segRefer, m.segmentManager)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(recovered.jobs))
+	assert.False(t, recovered.jobs[unplanned].planned)
+
+	job2 := recovered.jobs[job.jobID]
+	assert.True(t, job2.planned)
+	assert.Equal(t, datapb.ExportState_ExportExecuting, job2.state)
+	assert.Equal(t, datapb.ExportFormat_ExportJSON, job2.format)
+	assert.Equal(t, 2, job2.segmentNum)
+	require.Equal(t, 1, len(job2.tasks))
+	task := job2.tasks[0]
+	assert.Equal(t, []UniqueID{1, 2}, task.segmentIDs)
+	assert.Equal(t, int64(1), task.nodeID)
+	assert.Equal(t, 1, task.attempts)
+	assert.True(t, segRefer.HasSegmentLock(1))
+	state, err := recovered.getState(job.jobID, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), state.GetProgress())
+
+	assert.NoError(t, recovered.report(exportTaskResult(job2, task, datapb.ExportState_ExportCompleted, 2)))
+	assert.Equal(t, datapb.ExportState_ExportCompleted, job2.state)
+	assert.False(t, segRefer.HasSegmentLock(1))
+
+	// the finished job is recovered with its end time
+	recovered, err = newExportManager(m.kv, m.sessions, m.meta, newMockAllocator(), segRefer, m.segmentManager)
+	require.NoError(t, err)
+	job3 := recovered.jobs[job.jobID]
+	assert.Equal(t, datapb.ExportState_ExportCompleted, job3.state)
+	assert.Equal(t, job2.endTime.UnixMilli(), job3.endTime.UnixMilli())
+	assert.Equal(t, 2, len(job3.tasks[0].result.GetFiles()))
+
+	_, err = newExportManager(memkv.NewMemoryKV(), m.sessions, m.meta, newMockAllocator(), segRefer, m.segmentManager)
+	assert.NoError(t, err)
+	badKV := memkv.NewMemoryKV()
+	require.NoError(t, badKV.Save(exportJobKey(1), "bad"))
+	_, err = newExportManager(badKV, m.sessions, m.meta, newMockAllocator(), segRefer, m.segmentManager)
+	assert.Error(t, err)
+}
+
+func Test_pickExportNode(t *testing.T) {
+	_, ok := pickExportNode(map[UniqueID]int{})
+	assert.False(t, ok)
+	_, ok = pickExportNode(map[UniqueID]int{1: Params.DataCoordCfg.ExportTasksPerNode})
+	assert.False(t, ok)
+	nodeID, ok := pickExportNode(map[UniqueID]int{1: 1, 2: 0, 3: 0})
+	assert.True(t, ok)
+	assert.Equal(t, UniqueID(2), nodeID)
+}
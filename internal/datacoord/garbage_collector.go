@@ -253,7 +253,8 @@ func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
 
 func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
 	var logs []*datapb.Binlog
-	for _, flog := range sinfo.GetBinlogs() {
+	// columnar logs are shared by all fields, remove each of them once
+	for _, flog := range storage.GroupColumnarBinlogs(sinfo.GetBinlogs()) {
 		logs = append(logs, flog.GetBinlogs()...)
 	}
 
@@ -147,6 +147,13 @@ func (h *ServerHandler) GetQueryVChanPositions(channel *channel, partitionID Uni
 			droppedIDs.Remove(segmentInfos[id].GetCompactionFrom()...)
 		}
 	}
+	for id := range indexedIDs {
+		// A clustering compaction produces several segments from the same sources,
+		// the indexed ones are not served until all of them are indexed, or the data is duplicated
+		if from := segmentInfos[id].GetCompactionFrom(); len(from) > 0 && indexedIDs.Contain(from...) {
+			indexedIDs.Remove(id)
+		}
+	}
 
 	return &datapb.VchannelInfo{
 		CollectionID:        channel.CollectionID,
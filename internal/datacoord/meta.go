@@ -391,6 +391,7 @@ func (m *meta) UpdateFlushSegmentsInfo(
 	dropped bool,
 	importing bool,
 	binlogs, statslogs, deltalogs []*datapb.FieldBinlog,
+	fieldStats []*datapb.FieldStats,
 	checkpoints []*datapb.CheckPoint,
 	startPositions []*datapb.SegmentStartPosition,
 ) error {
@@ -478,6 +479,8 @@ func (m *meta) UpdateFlushSegmentsInfo(
 		}
 	}
 	clonedSegment.Deltalogs = currDeltaLogs
+	// zone maps
+	clonedSegment.FieldStats = storage.MergeFieldStatsProto(clonedSegment.GetFieldStats(), fieldStats)
 	modSegments[segmentID] = clonedSegment
 	var getClonedSegment = func(segmentID UniqueID) *SegmentInfo {
 		if s, ok := modSegments[segmentID]; ok {
@@ -956,8 +959,11 @@ func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.Compac
 	deltalogs := append(result.GetDeltalogs(), copiedDeltalogs...)
 
 	compactionFrom := make([]UniqueID, 0, len(modSegments))
+	var fieldStats []*datapb.FieldStats
 	for _, s := range modSegments {
 		compactionFrom = append(compactionFrom, s.GetID())
+		// the value range of the compacted segment is within the union of the source ranges
+		fieldStats = storage.MergeFieldStatsProto(fieldStats, s.GetFieldStats())
 	}
 
 	segmentInfo := &datapb.SegmentInfo{
@@ -975,6 +981,7 @@ func (m *meta) PrepareCompleteCompactionMutation(compactionLogs []*datapb.Compac
 		DmlPosition:         dmlPosition,
 		CreatedByCompaction: true,
 		CompactionFrom:      compactionFrom,
+		FieldStats:          fieldStats,
 	}
 	segment := NewSegmentInfo(segmentInfo)
 	metricMutation.addNewSeg(segment.GetState(), segment.GetNumOfRows())
@@ -1070,6 +1077,160 @@ func (m *meta) revertAlterMetaStoreAfterCompaction(oldSegments []*SegmentInfo, r
 	return nil
 }
 
+// PrepareCompleteClusteringCompactionMutation returns
+// - the segment info of compactedFrom segments before compaction to revert
+// - the segment info of compactedFrom segments after compaction to alter
+// - the segment infos of compactedTo segments after compaction to add, each one covers a clustering key range
+func (m *meta) PrepareCompleteClusteringCompactionMutation(compactionLogs []*datapb.CompactionSegmentBinlogs,
+	result *datapb.CompactionResult) ([]*SegmentInfo, []*SegmentInfo, []*SegmentInfo, *segMetricMutation, error) {
+	log.Info("meta update: prepare for complete clustering compaction mutation")
+	m.Lock()
+	defer m.Unlock()
+
+	var (
+		oldSegments = make([]*SegmentInfo, 0, len(compactionLogs))
+		modSegments = make([]*SegmentInfo, 0, len(compactionLogs))
+	)
+
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]int),
+	}
+	for _, cl := range compactionLogs {
+		if segment := m.segments.GetSegment(cl.GetSegmentID()); segment != nil {
+			oldSegments = append(oldSegments, segment.Clone())
+
+			cloned := segment.Clone()
+			updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+			cloned.DroppedAt = uint64(time.Now().UnixNano())
+			modSegments = append(modSegments, cloned)
+		}
+	}
+	if len(modSegments) == 0 {
+		return nil, nil, nil, nil, fmt.Errorf("segments of clustering compaction plan %d not found", result.GetPlanID())
+	}
+
+	var startPosition, dmlPosition *internalpb.MsgPosition
+	var originDeltalogs []*datapb.FieldBinlog
+	var fieldStats []*datapb.FieldStats
+	compactionFrom := make([]UniqueID, 0, len(modSegments))
+	for _, s := range modSegments {
+		if dmlPosition == nil ||
+			s.GetDmlPosition() != nil && s.GetDmlPosition().GetTimestamp() < dmlPosition.GetTimestamp() {
+			dmlPosition = s.GetDmlPosition()
+		}
+
+		if startPosition == nil ||
+			s.GetStartPosition() != nil && s.GetStartPosition().GetTimestamp() < startPosition.GetTimestamp() {
+			startPosition = s.GetStartPosition()
+		}
+		originDeltalogs = append(originDeltalogs, s.GetDeltalogs()...)
+		compactionFrom = append(compactionFrom, s.GetID())
+		// the value range of each result segment is within the union of the source ranges
+		fieldStats = storage.MergeFieldStatsProto(fieldStats, s.GetFieldStats())
+	}
+
+	// find new added delta logs when executing compaction, the deleted rows could be in any of the result segments
+	var deletedDeltalogs []*datapb.FieldBinlog
+	for _, l := range compactionLogs {
+		deletedDeltalogs = append(deletedDeltalogs, l.GetDeltalogs()...)
+	}
+	newAddedDeltalogs := m.updateDeltalogs(originDeltalogs, deletedDeltalogs, nil)
+
+	newSegments := make([]*SegmentInfo, 0, len(result.GetSegments()))
+	for _, rs := range result.GetSegments() {
+		copiedDeltalogs, err := m.copyDeltaFiles(newAddedDeltalogs, modSegments[0].CollectionID, modSegments[0].PartitionID, rs.GetSegmentID())
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+
+		segmentInfo := &datapb.SegmentInfo{
+			ID:                  rs.GetSegmentID(),
+			CollectionID:        modSegments[0].CollectionID,
+			PartitionID:         modSegments[0].PartitionID,
+			InsertChannel:       modSegments[0].InsertChannel,
+			NumOfRows:           rs.GetNumOfRows(),
+			State:               commonpb.SegmentState_Flushing,
+			MaxRowNum:           modSegments[0].MaxRowNum,
+			Binlogs:             rs.GetInsertLogs(),
+			Statslogs:           rs.GetField2StatslogPaths(),
+			Deltalogs:           append(rs.GetDeltalogs(), copiedDeltalogs...),
+			StartPosition:       startPosition,
+			DmlPosition:         dmlPosition,
+			CreatedByCompaction: true,
+			CompactionFrom:      compactionFrom,
+			FieldStats:          fieldStats,
+			ClusteringKeyRange:  rs.GetClusteringKeyRange(),
+		}
+		segment := NewSegmentInfo(segmentInfo)
+		metricMutation.addNewSeg(segment.GetState(), segment.GetNumOfRows())
+		newSegments = append(newSegments, segment)
+	}
+
+	log.Info("meta update: prepare for complete clustering compaction mutation - complete",
+		zap.Int64("collection ID", modSegments[0].GetCollectionID()),
+		zap.Int64("partition ID", modSegments[0].GetPartitionID()),
+		zap.Int64s("new segment IDs", lo.Map(newSegments, func(s *SegmentInfo, _ int) int64 { return s.GetID() })),
+		zap.Any("compacted from", compactionFrom))
+
+	return oldSegments, modSegments, newSegments, metricMutation, nil
+}
+
+func (m *meta) alterMetaStoreAfterClusteringCompaction(modSegments []*SegmentInfo, newSegments []*SegmentInfo) error {
+	log.Info("meta update: alter meta store for clustering compaction updates",
+		zap.Int64s("compact from segments (segments to be updated as dropped)", lo.Map(modSegments, func(s *SegmentInfo, _ int) int64 { return s.GetID() })),
+		zap.Int64s("compact to segments", lo.Map(newSegments, func(s *SegmentInfo, _ int) int64 { return s.GetID() })))
+
+	m.Lock()
+	defer m.Unlock()
+
+	toInfos := func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return item.SegmentInfo
+	}
+	if err := m.catalog.AlterSegmentsAndAddNewSegments(m.ctx, lo.Map(modSegments, toInfos), lo.Map(newSegments, toInfos)); err != nil {
+		return err
+	}
+
+	for _, s := range modSegments {
+		m.segments.SetSegment(s.GetID(), s)
+	}
+
+	for _, s := range newSegments {
+		if s.GetNumOfRows() > 0 {
+			m.segments.SetSegment(s.GetID(), s)
+		}
+	}
+
+	return nil
+}
+
+func (m *meta) revertAlterMetaStoreAfterClusteringCompaction(oldSegments []*SegmentInfo, removalSegments []*SegmentInfo) error {
+	log.Info("meta update: revert metastore after clustering compaction failure",
+		zap.Int64s("compactedTo (segments to remove)", lo.Map(removalSegments, func(s *SegmentInfo, _ int) int64 { return s.GetID() })),
+		zap.Int64s("compactedFrom (segments to add back)", lo.Map(oldSegments, func(s *SegmentInfo, _ int) int64 { return s.GetID() })),
+	)
+
+	m.Lock()
+	defer m.Unlock()
+
+	toInfos := func(item *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return item.SegmentInfo
+	}
+	if err := m.catalog.RevertAlterSegmentsAndAddNewSegments(m.ctx, lo.Map(oldSegments, toInfos), lo.Map(removalSegments, toInfos)); err != nil {
+		return err
+	}
+
+	for _, s := range oldSegments {
+		m.segments.SetSegment(s.GetID(), s)
+	}
+
+	for _, s := range removalSegments {
+		if s.GetNumOfRows() > 0 {
+			m.segments.DropSegment(s.GetID())
+		}
+	}
+	return nil
+}
+
 func (m *meta) updateBinlogs(origin []*datapb.FieldBinlog, removes []*datapb.FieldBinlog, adds []*datapb.FieldBinlog) []*datapb.FieldBinlog {
 	fieldBinlogs := make(map[int64]map[string]*datapb.Binlog)
 	for _, f := range origin {
@@ -28,6 +28,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
@@ -528,13 +529,15 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		assert.Nil(t, err)
 
 		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing, Binlogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog0", 1))},
-			Statslogs: []*datapb.FieldBinlog{getFieldBinlogPaths(1, getStatsLogPath("statslog0", 1))}}}
+			Statslogs:  []*datapb.FieldBinlog{getFieldBinlogPaths(1, getStatsLogPath("statslog0", 1))},
+			FieldStats: []*datapb.FieldStats{{FieldID: 101, DataType: schemapb.DataType_Int64, RowCount: 5, IntMin: 3, IntMax: 9}}}}
 		err = meta.AddSegment(segment1)
 		assert.Nil(t, err)
 
 		err = meta.UpdateFlushSegmentsInfo(1, true, false, true, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog1", 1))},
 			[]*datapb.FieldBinlog{getFieldBinlogPaths(1, getStatsLogPath("statslog1", 1))},
 			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampFrom: 100, TimestampTo: 200, LogSize: 1000, LogPath: getDeltaLogPath("deltalog1", 1)}}}},
+			[]*datapb.FieldStats{{FieldID: 101, DataType: schemapb.DataType_Int64, RowCount: 5, NullCount: 1, IntMin: 1, IntMax: 7}},
 			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
 		assert.Nil(t, err)
 
@@ -558,14 +561,18 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		assert.Equal(t, updated.State, expected.State)
 		assert.Equal(t, updated.size, expected.size)
 		assert.Equal(t, updated.NumOfRows, expected.NumOfRows)
-
+		assert.Equal(t, 1, len(updated.GetFieldStats()))
+		assert.EqualValues(t, 10, updated.GetFieldStats()[0].GetRowCount())
+		assert.EqualValues(t, 1, updated.GetFieldStats()[0].GetNullCount())
+		assert.EqualValues(t, 1, updated.GetFieldStats()[0].GetIntMin())
+		assert.EqualValues(t, 9, updated.GetFieldStats()[0].GetIntMax())
 	})
 
 	t.Run("update non-existed segment", func(t *testing.T) {
 		meta, err := newMeta(context.TODO(), memkv.NewMemoryKV(), "", nil)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, nil)
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, nil, nil)
 		assert.Nil(t, err)
 	})
 
@@ -577,7 +584,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.AddSegment(segment1)
 		assert.Nil(t, err)
 
-		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
+		err = meta.UpdateFlushSegmentsInfo(1, false, false, false, nil, nil, nil, nil, []*datapb.CheckPoint{{SegmentID: 2, NumOfRows: 10}},
 
 			[]*datapb.SegmentStartPosition{{SegmentID: 2, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
 		assert.Nil(t, err)
@@ -602,6 +609,7 @@ func TestUpdateFlushSegmentsInfo(t *testing.T) {
 		err = meta.UpdateFlushSegmentsInfo(1, true, false, false, []*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("binlog", 1))},
 			[]*datapb.FieldBinlog{getFieldBinlogPaths(1, getInsertLogPath("statslog", 1))},
 			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 1, TimestampFrom: 100, TimestampTo: 200, LogSize: 1000, LogPath: getDeltaLogPath("deltalog", 1)}}}},
+			nil,
 			[]*datapb.CheckPoint{{SegmentID: 1, NumOfRows: 10}}, []*datapb.SegmentStartPosition{{SegmentID: 1, StartPosition: &internalpb.MsgPosition{MsgID: []byte{1, 2, 3}}}})
 		assert.NotNil(t, err)
 		assert.Equal(t, "mocked fail", err.Error())
@@ -788,6 +796,82 @@ func TestMeta_PrepareCompleteCompactionMutation(t *testing.T) {
 	assert.NotZero(t, newSegment.lastFlushTime)
 }
 
+func TestMeta_PrepareCompleteClusteringCompactionMutation(t *testing.T) {
+	m := &meta{
+		catalog: &datacoord.Catalog{Txn: memkv.NewMemoryKV()},
+		segments: &SegmentsInfo{map[UniqueID]*SegmentInfo{
+			1: {SegmentInfo: &datapb.SegmentInfo{
+				ID:           1,
+				CollectionID: 100,
+				PartitionID:  10,
+				State:        commonpb.SegmentState_Flushed,
+				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log1", "log2")},
+				Deltalogs:    []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1")},
+				NumOfRows:    2,
+			}},
+			2: {SegmentInfo: &datapb.SegmentInfo{
+				ID:           2,
+				CollectionID: 100,
+				PartitionID:  10,
+				State:        commonpb.SegmentState_Flushed,
+				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log3", "log4")},
+				NumOfRows:    2,
+			}},
+		}},
+	}
+
+	inCompactionLogs := []*datapb.CompactionSegmentBinlogs{
+		{SegmentID: 1, Deltalogs: []*datapb.FieldBinlog{getFieldBinlogPaths(0, "deltalog1")}},
+		{SegmentID: 2},
+	}
+	inCompactionResult := &datapb.CompactionResult{
+		PlanID: 1,
+		Segments: []*datapb.CompactionSegment{
+			{
+				SegmentID:          3,
+				NumOfRows:          1,
+				InsertLogs:         []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log5")},
+				ClusteringKeyRange: &datapb.FieldStats{FieldID: 101, RowCount: 1, IntMin: 1, IntMax: 1},
+			},
+			{
+				SegmentID:          4,
+				NumOfRows:          2,
+				InsertLogs:         []*datapb.FieldBinlog{getFieldBinlogPaths(1, "log6")},
+				ClusteringKeyRange: &datapb.FieldStats{FieldID: 101, RowCount: 2, IntMin: 2, IntMax: 5},
+			},
+		},
+	}
+	beforeCompact, afterCompact, newSegments, metricMutation, err := m.PrepareCompleteClusteringCompactionMutation(inCompactionLogs, inCompactionResult)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(beforeCompact))
+	require.Equal(t, 2, len(afterCompact))
+	assert.Equal(t, commonpb.SegmentState_Dropped, afterCompact[0].GetState())
+	assert.Equal(t, int64(3), metricMutation.rowCountAccChange)
+
+	require.Equal(t, 2, len(newSegments))
+	for i, segment := range newSegments {
+		assert.Equal(t, inCompactionResult.Segments[i].GetSegmentID(), segment.GetID())
+		assert.Equal(t, inCompactionResult.Segments[i].GetNumOfRows(), segment.GetNumOfRows())
+		assert.Equal(t, commonpb.SegmentState_Flushing, segment.GetState())
+		assert.ElementsMatch(t, []UniqueID{1, 2}, segment.GetCompactionFrom())
+		assert.Equal(t, inCompactionResult.Segments[i].GetClusteringKeyRange(), segment.GetClusteringKeyRange())
+	}
+
+	err = m.alterMetaStoreAfterClusteringCompaction(afterCompact, newSegments)
+	assert.NoError(t, err)
+	assert.NotNil(t, m.GetSegment(3))
+	assert.NotNil(t, m.GetSegment(4))
+	assert.Nil(t, m.GetSegment(1))
+
+	err = m.revertAlterMetaStoreAfterClusteringCompaction(beforeCompact, newSegments)
+	assert.NoError(t, err)
+	assert.Nil(t, m.GetSegment(3))
+	assert.NotNil(t, m.GetSegment(1))
+
+	_, _, _, _, err = m.PrepareCompleteClusteringCompactionMutation([]*datapb.CompactionSegmentBinlogs{{SegmentID: 10}}, inCompactionResult)
+	assert.Error(t, err)
+}
+
 func Test_meta_SetSegmentCompacting(t *testing.T) {
 	type fields struct {
 		client   kv.TxnKV
@@ -140,6 +140,10 @@ func (s *Server) getDataCoordMetrics() metricsinfo.DataCoordInfos {
 		QuotaMetrics: s.getQuotaMetrics(),
 	}
 
+	if s.compactionHandler != nil {
+		ret.CompactionMetrics = s.compactionHandler.getCompactionMetrics()
+	}
+
 	metricsinfo.FillDeployMetricsWithEnv(&ret.BaseComponentInfos.SystemInfo)
 
 	return ret
@@ -135,6 +135,8 @@ type mockDataNodeClient struct {
 	compactionStateResp  *datapb.CompactionStateResponse
 	addImportSegmentResp *datapb.AddImportSegmentResponse
 	compactionResp       *commonpb.Status
+	exportResp           *commonpb.Status
+	cancelCompactionResp *commonpb.Status
 }
 
 func newMockDataNodeClient(id int64, ch chan interface{}) (*mockDataNodeClient, error) {
@@ -267,6 +269,24 @@ func (c *mockDataNodeClient) SyncSegments(ctx context.Context, req *datapb.SyncS
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (c *mockDataNodeClient) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	if c.cancelCompactionResp != nil {
+		return c.cancelCompactionResp, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (c *mockDataNodeClient) Export(ctx context.Context, req *datapb.ExportTask) (*commonpb.Status, error) {
+	if c.exportResp != nil {
+		return c.exportResp, nil
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (c *mockDataNodeClient) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (c *mockDataNodeClient) Stop() error {
 	c.state = commonpb.StateCode_Abnormal
 	return nil
@@ -372,6 +392,7 @@ func (m *mockRootCoordService) ShowCollections(ctx context.Context, req *milvusp
 			Reason:    "",
 		},
 		CollectionNames: []string{"test"},
+		CollectionIds:   []int64{1314},
 	}, nil
 }
 
@@ -379,6 +400,10 @@ func (m *mockRootCoordService) AlterCollection(ctx context.Context, request *mil
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockRootCoordService) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockRootCoordService) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -627,6 +652,26 @@ func (h *mockCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*
 	panic("not implemented")
 }
 
+// getCompactionMetrics returns the running and queued compaction tasks with their scores
+func (h *mockCompactionHandler) getCompactionMetrics() *metricsinfo.DataCoordCompactionMetrics {
+	if f, ok := h.methods["getCompactionMetrics"]; ok {
+		if ff, ok := f.(func() *metricsinfo.DataCoordCompactionMetrics); ok {
+			return ff()
+		}
+	}
+	panic("not implemented")
+}
+
+// cancelCompaction cancels the executing tasks of a signal, or only the one of planID if it's not 0
+func (h *mockCompactionHandler) cancelCompaction(signalID int64, planID int64) error {
+	if f, ok := h.methods["cancelCompaction"]; ok {
+		if ff, ok := f.(func(signalID int64, planID int64) error); ok {
+			return ff(signalID, planID)
+		}
+	}
+	panic("not implemented")
+}
+
 type mockCompactionTrigger struct {
 	methods map[string]interface{}
 }
@@ -661,6 +706,16 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64) (Uniq
 	panic("not implemented")
 }
 
+// forceTriggerCompactionWithScope force to start a compaction limited by the scope
+func (t *mockCompactionTrigger) forceTriggerCompactionWithScope(collectionID int64, scope *compactionScope) (UniqueID, error) {
+	if f, ok := t.methods["forceTriggerCompactionWithScope"]; ok {
+		if ff, ok := f.(func(collectionID int64, scope *compactionScope) (UniqueID, error)); ok {
+			return ff(collectionID, scope)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/storage"
 )
 
 // SegmentsInfo wraps a map, which maintains ID to SegmentInfo relation
@@ -341,6 +342,16 @@ func addSegmentBinlogs(field2Binlogs map[UniqueID][]*datapb.Binlog) SegmentInfoO
 	}
 }
 
+// getInsertLogNum returns the number of insert log files of the segment,
+// a columnar log of segment format v2 shared by all fields is counted once.
+func (s *SegmentInfo) getInsertLogNum() int {
+	var num int
+	for _, binlogs := range storage.GroupColumnarBinlogs(s.GetBinlogs()) {
+		num += len(binlogs.GetBinlogs())
+	}
+	return num
+}
+
 func (s *SegmentInfo) getSegmentSize() int64 {
 	if s.size <= 0 {
 		var size int64
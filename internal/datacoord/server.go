@@ -110,9 +110,14 @@ type Server struct {
 	gcOpt            GcOption
 	handler          Handler
 
+	// encryptedStorage is set when encryption at rest is enabled, its data keys are rotated periodically
+	encryptedStorage *storage.EncryptedChunkManager
+
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
 
+	exportManager *exportManager
+
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
 	flushCh chan UniqueID
@@ -261,6 +266,9 @@ func (s *Server) Init() error {
 	if err != nil {
 		return err
 	}
+	if encrypted, ok := storageCli.(*storage.EncryptedChunkManager); ok {
+		s.encryptedStorage = encrypted
+	}
 
 	if err = s.initMeta(storageCli.RootPath(), storageCli); err != nil {
 		return err
@@ -287,6 +295,10 @@ func (s *Server) Init() error {
 		s.createCompactionTrigger()
 	}
 	s.initSegmentManager()
+	s.exportManager, err = newExportManager(s.kvClient, s.sessionManager, s.meta, s.allocator, s.segReferManager, s.segmentManager)
+	if err != nil {
+		return err
+	}
 
 	s.initGarbageCollection(storageCli)
 
@@ -305,6 +317,7 @@ func (s *Server) Start() error {
 		s.compactionHandler.start()
 		s.compactionTrigger.start()
 	}
+	s.exportManager.start()
 
 	if s.enableActiveStandBy {
 		s.activateFunc = func() {
@@ -476,6 +489,14 @@ func (s *Server) startServerLoop() {
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
 	s.garbageCollector.start()
+	if s.encryptedStorage != nil {
+		s.serverLoopWg.Add(1)
+		s.startKeyRotationLoop(s.serverLoopCtx)
+	}
+	if Params.DataCoordCfg.EnableBinlogVerification {
+		s.serverLoopWg.Add(1)
+		s.startBinlogVerificationLoop(s.serverLoopCtx)
+	}
 }
 
 // startDataNodeTtLoop start a goroutine to recv data node tt msg from msgstream
@@ -771,6 +792,95 @@ func (s *Server) startFlushLoop(ctx context.Context) {
 	}()
 }
 
+// startKeyRotationLoop starts a goroutine to re-wrap data encryption keys with the active master key,
+// so retired master keys could be removed from the kms without rewriting any data.
+// Data keys of dropped collections are removed in the same loop.
+func (s *Server) startKeyRotationLoop(ctx context.Context) {
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(Params.DataCoordCfg.EncryptionKeyRotationInterval)
+		defer ticker.Stop()
+		for {
+			rotated, err := s.encryptedStorage.RotateDataKeys(ctx)
+			if err != nil {
+				log.Warn("failed to rotate data encryption keys", zap.Int("rotated", rotated), zap.Error(err))
+			} else if rotated > 0 {
+				log.Info("data encryption keys rotated", zap.Int("rotated", rotated))
+			}
+			s.removeDroppedDataKeys(ctx)
+			select {
+			case <-ctx.Done():
+				logutil.Logger(s.ctx).Info("key rotation loop shutdown")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// startBinlogVerificationLoop starts a goroutine to verify the log files of the segments in meta periodically,
+// the missing or corrupted files are reported by logs and metrics.
+func (s *Server) startBinlogVerificationLoop(ctx context.Context) {
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(Params.DataCoordCfg.BinlogVerificationInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logutil.Logger(s.ctx).Info("binlog verification loop shutdown")
+				return
+			case <-ticker.C:
+				corrupted, err := verifySegmentBinlogs(ctx, s.meta, s.meta.chunkManager)
+				if err != nil {
+					log.Warn("failed to verify segment binlogs", zap.Error(err))
+					continue
+				}
+				reportCorruptedBinlogs(corrupted)
+			}
+		}
+	}()
+}
+
+// removeDroppedDataKeys removes data encryption keys of the collections which are dropped in rootcoord
+// and have no segment left in meta, so all their binlogs are garbage collected.
+// Keys modified within the drop tolerance are kept, in case the collection was just created.
+func (s *Server) removeDroppedDataKeys(ctx context.Context) {
+	collections, err := s.encryptedStorage.ListDataKeyCollections(ctx)
+	if err != nil {
+		log.Warn("failed to list data encryption keys", zap.Error(err))
+		return
+	}
+	if len(collections) == 0 {
+		return
+	}
+	resp, err := s.rootCoordClient.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+	})
+	if err = VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to show collections, skip removing data encryption keys", zap.Error(err))
+		return
+	}
+	alive := typeutil.NewUniqueSet(resp.GetCollectionIds()...)
+	for _, segment := range s.meta.GetAllSegmentsUnsafe() {
+		alive.Insert(segment.GetCollectionID())
+	}
+	for collectionID, modTime := range collections {
+		// files of unknown collections share the data key of collection 0
+		if collectionID == 0 || alive.Contain(collectionID) || time.Since(modTime) < Params.DataCoordCfg.GCDropTolerance {
+			continue
+		}
+		if err := s.encryptedStorage.RemoveDataKeys(ctx, collectionID); err != nil {
+			log.Warn("failed to remove data encryption keys", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+	}
+}
+
 // post function after flush is done
 // 1. check segment id is valid
 // 2. notify RootCoord segment is flushed
@@ -831,6 +941,7 @@ func (s *Server) Stop() error {
 		s.stopCompactionTrigger()
 		s.stopCompactionHandler()
 	}
+	s.exportManager.stop()
 	return nil
 }
 
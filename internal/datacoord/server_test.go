@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -37,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -2725,6 +2727,263 @@ func TestManualCompaction(t *testing.T) {
 	})
 }
 
+func TestManualCompactionWithScope(t *testing.T) {
+	Params.DataCoordCfg.EnableCompaction = true
+	t.Run("test manual compaction with scope successfully", func(t *testing.T) {
+		svr := &Server{allocator: &MockAllocator{}}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		var got *compactionScope
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"forceTriggerCompactionWithScope": func(collectionID int64, scope *compactionScope) (UniqueID, error) {
+					got = scope
+					return 1, nil
+				},
+			},
+		}
+
+		resp, err := svr.ManualCompactionWithScope(context.TODO(), &datapb.ManualCompactionWithScopeRequest{
+			CollectionID:   1,
+			PartitionIDs:   []int64{2},
+			SegmentIDs:     []int64{3, 4},
+			Mode:           datapb.ManualCompactionMode_DeletePurge,
+			MaxSegmentSize: 256,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, int64(1), resp.GetCompactionID())
+		assert.Equal(t, &compactionScope{
+			partitionIDs:   []int64{2},
+			segmentIDs:     []int64{3, 4},
+			mode:           datapb.ManualCompactionMode_DeletePurge,
+			maxSegmentSize: 256,
+		}, got)
+	})
+
+	t.Run("test manual compaction with invalid segment size", func(t *testing.T) {
+		svr := &Server{allocator: &MockAllocator{}}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+
+		resp, err := svr.ManualCompactionWithScope(context.TODO(), &datapb.ManualCompactionWithScopeRequest{
+			CollectionID:   1,
+			MaxSegmentSize: -1,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("test manual compaction with scope failure", func(t *testing.T) {
+		svr := &Server{allocator: &MockAllocator{}}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionTrigger = &mockCompactionTrigger{
+			methods: map[string]interface{}{
+				"forceTriggerCompactionWithScope": func(collectionID int64, scope *compactionScope) (UniqueID, error) {
+					return 0, errors.New("mock error")
+				},
+			},
+		}
+
+		resp, err := svr.ManualCompactionWithScope(context.TODO(), &datapb.ManualCompactionWithScopeRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+	})
+
+	t.Run("test manual compaction with scope with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := svr.ManualCompactionWithScope(context.TODO(), &datapb.ManualCompactionWithScopeRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.Status.ErrorCode)
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), resp.Status.Reason)
+	})
+}
+
+func TestCancelCompaction(t *testing.T) {
+	Params.DataCoordCfg.EnableCompaction = true
+	t.Run("test cancel compaction successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"cancelCompaction": func(signalID int64, planID int64) error {
+					return nil
+				},
+			},
+		}
+
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("test cancel compaction failure", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"cancelCompaction": func(signalID int64, planID int64) error {
+					return errors.New("mock error")
+				},
+			},
+		}
+
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1, PlanID: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+
+	t.Run("test cancel compaction of another collection", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"getCompactionTasksBySignalID": func(signalID int64) []*compactionTask {
+					return []*compactionTask{{triggerInfo: &compactionSignal{id: signalID, collectionID: 10}}}
+				},
+				"cancelCompaction": func(signalID int64, planID int64) error {
+					return nil
+				},
+			},
+		}
+
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1, CollectionID: 11})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+
+		resp, err = svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1, CollectionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("test cancel compaction with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := svr.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), resp.GetReason())
+	})
+}
+
+func TestGetCompactionPlanStates(t *testing.T) {
+	Params.DataCoordCfg.EnableCompaction = true
+	t.Run("test get compaction plan states successfully", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Healthy)
+		svr.compactionHandler = &mockCompactionHandler{
+			methods: map[string]interface{}{
+				"getCompactionTasksBySignalID": func(signalID int64) []*compactionTask {
+					signal := &compactionSignal{id: signalID, collectionID: 10}
+					return []*compactionTask{
+						{
+							triggerInfo: signal,
+							plan:        &datapb.CompactionPlan{PlanID: 2, SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 3}}},
+							state:       cancelling,
+							dataNodeID:  1,
+						},
+						{
+							triggerInfo: signal,
+							plan:        &datapb.CompactionPlan{PlanID: 1, SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{SegmentID: 1}, {SegmentID: 2}}},
+							state:       completed,
+							dataNodeID:  1,
+							result:      &datapb.CompactionResult{PlanID: 1, SegmentID: 4},
+						},
+					}
+				},
+			},
+		}
+
+		resp, err := svr.GetCompactionPlanStates(context.TODO(), &datapb.GetCompactionPlanStatesRequest{CompactionID: 1, CollectionID: 10})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.Equal(t, []*datapb.CompactionPlanInfo{
+			{PlanID: 1, State: datapb.CompactionPlanState_CompactionPlanCompleted, Sources: []int64{1, 2}, Targets: []int64{4}, NodeID: 1},
+			{PlanID: 2, State: datapb.CompactionPlanState_CompactionPlanCancelling, Sources: []int64{3}, NodeID: 1},
+		}, resp.GetPlans())
+
+		resp, err = svr.GetCompactionPlanStates(context.TODO(), &datapb.GetCompactionPlanStatesRequest{CompactionID: 1, CollectionID: 11})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("test get compaction plan states with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := svr.GetCompactionPlanStates(context.TODO(), &datapb.GetCompactionPlanStatesRequest{CompactionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), resp.GetStatus().GetReason())
+	})
+}
+
+func TestExport(t *testing.T) {
+	t.Run("test export successfully", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&collectionInfo{ID: 1, Schema: newTestSchema()})
+
+		resp, err := svr.Export(context.TODO(), &datapb.ExportRequest{CollectionID: 1, TargetPrefix: "export"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		// the job waits for the checkpoint of the channel
+		stateResp, err := svr.GetExportState(context.TODO(), &datapb.GetExportStateRequest{JobID: resp.GetJobID()})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+		assert.Equal(t, datapb.ExportState_ExportPending, stateResp.GetState())
+
+		stateResp, err = svr.GetExportState(context.TODO(), &datapb.GetExportStateRequest{JobID: resp.GetJobID(), CollectionID: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.GetStatus().GetErrorCode())
+
+		status, err := svr.CancelExport(context.TODO(), &datapb.CancelExportRequest{JobID: resp.GetJobID(), CollectionID: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		status, err = svr.CancelExport(context.TODO(), &datapb.CancelExportRequest{JobID: resp.GetJobID()})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		status, err = svr.ReportExport(context.TODO(), &datapb.ExportTaskResult{JobID: resp.GetJobID() + 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("test export failure", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.meta.AddCollection(&collectionInfo{ID: 1, Schema: newTestSchema()})
+
+		resp, err := svr.Export(context.TODO(), &datapb.ExportRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		stateResp, err := svr.GetExportState(context.TODO(), &datapb.GetExportStateRequest{JobID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("test export with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.stateCode.Store(commonpb.StateCode_Abnormal)
+
+		resp, err := svr.Export(context.TODO(), &datapb.ExportRequest{CollectionID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), resp.GetStatus().GetReason())
+		stateResp, err := svr.GetExportState(context.TODO(), &datapb.GetExportStateRequest{JobID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), stateResp.GetStatus().GetReason())
+		status, err := svr.CancelExport(context.TODO(), &datapb.CancelExportRequest{JobID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), status.GetReason())
+		status, err = svr.ReportExport(context.TODO(), &datapb.ExportTaskResult{JobID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, msgDataCoordIsUnhealthy(paramtable.GetNodeID()), status.GetReason())
+	})
+}
+
 func TestGetCompactionStateWithPlans(t *testing.T) {
 	t.Run("test get compaction state successfully", func(t *testing.T) {
 		svr := &Server{}
@@ -3809,6 +4068,41 @@ func testDataCoordBase(t *testing.T, opts ...Option) *Server {
 	return svr
 }
 
+func TestServer_removeDroppedDataKeys(t *testing.T) {
+	ctx := context.Background()
+	keyFile := path.Join(t.TempDir(), "keys.json")
+	masterKey := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	err := os.WriteFile(keyFile, []byte(fmt.Sprintf(`{"active_key_id": "k1", "keys": {"k1": %q}}`, masterKey)), 0600)
+	require.NoError(t, err)
+
+	rootPath := t.TempDir()
+	cm := storage.NewEncryptedChunkManager(storage.NewLocalChunkManager(storage.RootPath(rootPath)), storage.NewLocalKMS(keyFile), false)
+	// collection 1314 exists in rootcoord, collection 1 is dropped but has segments left, collection 2 is dropped
+	for _, collectionID := range []int64{1314, 1, 2} {
+		err = cm.Write(ctx, metautil.BuildInsertLogPath(rootPath, collectionID, 0, 0, 0, 0), []byte("data"))
+		require.NoError(t, err)
+	}
+	meta, err := newMemoryMeta()
+	require.NoError(t, err)
+	err = meta.AddSegment(NewSegmentInfo(&datapb.SegmentInfo{ID: 1, CollectionID: 1, State: commonpb.SegmentState_Dropped}))
+	require.NoError(t, err)
+	svr := &Server{meta: meta, rootCoordClient: newMockRootCoordService(), encryptedStorage: cm}
+
+	// keys are kept within the drop tolerance
+	svr.removeDroppedDataKeys(ctx)
+	collections, err := cm.ListDataKeyCollections(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(collections))
+
+	dropTolerance := Params.DataCoordCfg.GCDropTolerance
+	defer func() { Params.DataCoordCfg.GCDropTolerance = dropTolerance }()
+	Params.DataCoordCfg.GCDropTolerance = 0
+	svr.removeDroppedDataKeys(ctx)
+	collections, err = cm.ListDataKeyCollections(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1314, 1}, lo.Keys(collections))
+}
+
 func TestDataCoord_DisableActiveStandby(t *testing.T) {
 	Params.Init()
 	Params.DataCoordCfg.EnableActiveStandby = false
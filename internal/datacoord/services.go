@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"sync"
 
@@ -436,6 +437,7 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 		req.GetField2BinlogPaths(),
 		req.GetField2StatslogPaths(),
 		req.GetDeltalogs(),
+		req.GetFieldStats(),
 		req.GetCheckPoints(),
 		req.GetStartPositions())
 	if err != nil {
@@ -638,6 +640,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	segment2StatsBinlogs := make(map[UniqueID][]*datapb.FieldBinlog)
 	segment2DeltaBinlogs := make(map[UniqueID][]*datapb.FieldBinlog)
 	segment2InsertChannel := make(map[UniqueID]string)
+	segment2ClusteringKeyRange := make(map[UniqueID]*datapb.FieldStats)
 	segmentsNumOfRows := make(map[UniqueID]int64)
 	for id := range flushedIDs {
 		segment := s.meta.GetSegmentUnsafe(id)
@@ -656,6 +659,7 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 			continue
 		}
 		segment2InsertChannel[segment.ID] = segment.InsertChannel
+		segment2ClusteringKeyRange[segment.ID] = segment.GetClusteringKeyRange()
 		binlogs := segment.GetBinlogs()
 
 		if len(binlogs) == 0 {
@@ -708,12 +712,13 @@ func (s *Server) GetRecoveryInfo(ctx context.Context, req *datapb.GetRecoveryInf
 	binlogs := make([]*datapb.SegmentBinlogs, 0, len(segment2Binlogs))
 	for segmentID := range flushedIDs {
 		sbl := &datapb.SegmentBinlogs{
-			SegmentID:     segmentID,
-			NumOfRows:     segmentsNumOfRows[segmentID],
-			FieldBinlogs:  segment2Binlogs[segmentID],
-			Statslogs:     segment2StatsBinlogs[segmentID],
-			Deltalogs:     segment2DeltaBinlogs[segmentID],
-			InsertChannel: segment2InsertChannel[segmentID],
+			SegmentID:          segmentID,
+			NumOfRows:          segmentsNumOfRows[segmentID],
+			FieldBinlogs:       segment2Binlogs[segmentID],
+			Statslogs:          segment2StatsBinlogs[segmentID],
+			Deltalogs:          segment2DeltaBinlogs[segmentID],
+			InsertChannel:      segment2InsertChannel[segmentID],
+			ClusteringKeyRange: segment2ClusteringKeyRange[segmentID],
 		}
 		binlogs = append(binlogs, sbl)
 	}
@@ -941,6 +946,261 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 	return resp, nil
 }
 
+// ManualCompactionWithScope triggers a compaction limited to the given partitions and segments of a collection
+func (s *Server) ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	log.Info("received manual compaction with scope", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()), zap.Int64s("segmentIDs", req.GetSegmentIDs()),
+		zap.String("mode", req.GetMode().String()), zap.Int64("maxSegmentSize", req.GetMaxSegmentSize()),
+		zap.Uint64("timetravel", req.GetTimetravel()))
+
+	resp := &milvuspb.ManualCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to execute manual compaction with scope", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(paramtable.GetNodeID())))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	if req.GetMaxSegmentSize() < 0 {
+		resp.Status.Reason = fmt.Sprintf("invalid max segment size %d", req.GetMaxSegmentSize())
+		return resp, nil
+	}
+
+	scope := &compactionScope{
+		partitionIDs:   req.GetPartitionIDs(),
+		segmentIDs:     req.GetSegmentIDs(),
+		mode:           req.GetMode(),
+		maxSegmentSize: req.GetMaxSegmentSize(),
+		timetravel:     req.GetTimetravel(),
+	}
+	id, err := s.compactionTrigger.forceTriggerCompactionWithScope(req.GetCollectionID(), scope)
+	if err != nil {
+		log.Error("failed to trigger manual compaction with scope", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Info("success to trigger manual compaction with scope", zap.Int64("collectionID", req.GetCollectionID()), zap.Int64("compactionID", id))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.CompactionID = id
+	return resp, nil
+}
+
+// CancelCompaction cancels the executing plans of a compaction, or only one of them if the plan ID is set
+func (s *Server) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	log.Info("received cancel compaction request", zap.Int64("compactionID", req.GetCompactionID()), zap.Int64("planID", req.GetPlanID()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to cancel compaction", zap.Int64("compactionID", req.GetCompactionID()),
+			zap.Error(errDataCoordIsUnhealthy(paramtable.GetNodeID())))
+		resp.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction {
+		resp.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	if err := s.checkCompactionCollection(req.GetCompactionID(), req.GetCollectionID()); err != nil {
+		log.Warn("failed to cancel compaction", zap.Int64("compactionID", req.GetCompactionID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	if err := s.compactionHandler.cancelCompaction(req.GetCompactionID(), req.GetPlanID()); err != nil {
+		log.Warn("failed to cancel compaction", zap.Int64("compactionID", req.GetCompactionID()),
+			zap.Int64("planID", req.GetPlanID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Info("success to cancel compaction", zap.Int64("compactionID", req.GetCompactionID()), zap.Int64("planID", req.GetPlanID()))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// GetCompactionPlanStates gets the state of each plan of a compaction, including the cancelling and cancelled ones
+func (s *Server) GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	log.Info("received get compaction plan states request", zap.Int64("compactionID", req.GetCompactionID()))
+	resp := &datapb.GetCompactionPlanStatesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get compaction plan states", zap.Int64("compactionID", req.GetCompactionID()),
+			zap.Error(errDataCoordIsUnhealthy(paramtable.GetNodeID())))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction {
+		resp.Status.Reason = "compaction disabled"
+		return resp, nil
+	}
+
+	if err := s.checkCompactionCollection(req.GetCompactionID(), req.GetCollectionID()); err != nil {
+		log.Warn("failed to get compaction plan states", zap.Int64("compactionID", req.GetCompactionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	tasks := s.compactionHandler.getCompactionTasksBySignalID(req.GetCompactionID())
+	for _, task := range tasks {
+		resp.Plans = append(resp.Plans, getCompactionPlanInfo(task))
+	}
+	sort.Slice(resp.Plans, func(i, j int) bool {
+		return resp.Plans[i].GetPlanID() < resp.Plans[j].GetPlanID()
+	})
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// checkCompactionCollection checks the compaction belongs to the collection, it passes if collectionID is not set
+func (s *Server) checkCompactionCollection(compactionID int64, collectionID int64) error {
+	if collectionID == 0 {
+		return nil
+	}
+	tasks := s.compactionHandler.getCompactionTasksBySignalID(compactionID)
+	if compactionID == 0 || len(tasks) == 0 || tasks[0].triggerInfo.collectionID != collectionID {
+		return fmt.Errorf("compaction %d not found in collection %d", compactionID, collectionID)
+	}
+	return nil
+}
+
+// Export creates a job to export the flushed segments of a collection to files on the object storage
+func (s *Server) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	log.Info("received export request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()), zap.Uint64("timestamp", req.GetTimestamp()),
+		zap.String("format", req.GetFormat().String()), zap.String("targetPrefix", req.GetTargetPrefix()))
+	resp := &datapb.ExportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to export", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(paramtable.GetNodeID())))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	dresp, err := s.rootCoordClient.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: req.GetCollectionID(),
+	})
+	if err = VerifyResponse(dresp, err); err != nil {
+		log.Warn("failed to describe collection for export", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	jobID, err := s.exportManager.submit(ctx, req, dresp.GetSchema(), dresp.GetVirtualChannelNames())
+	if err != nil {
+		log.Warn("failed to submit export job", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.JobID = jobID
+	return resp, nil
+}
+
+// GetExportState gets the state and progress of an export job
+func (s *Server) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	log.Debug("received get export state request", zap.Int64("jobID", req.GetJobID()))
+	failed := &datapb.GetExportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get export state", zap.Int64("jobID", req.GetJobID()),
+			zap.Error(errDataCoordIsUnhealthy(paramtable.GetNodeID())))
+		failed.Status.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return failed, nil
+	}
+
+	resp, err := s.exportManager.getState(req.GetJobID(), req.GetCollectionID())
+	if err != nil {
+		failed.Status.Reason = err.Error()
+		return failed, nil
+	}
+	resp.Status = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+	return resp, nil
+}
+
+// CancelExport cancels an unfinished export job
+func (s *Server) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	log.Info("received cancel export request", zap.Int64("jobID", req.GetJobID()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to cancel export", zap.Int64("jobID", req.GetJobID()),
+			zap.Error(errDataCoordIsUnhealthy(paramtable.GetNodeID())))
+		resp.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	if err := s.exportManager.cancel(req.GetJobID(), req.GetCollectionID()); err != nil {
+		log.Warn("failed to cancel export", zap.Int64("jobID", req.GetJobID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ReportExport receives the progress and the result of an export task from the datanode
+func (s *Server) ReportExport(ctx context.Context, req *datapb.ExportTaskResult) (*commonpb.Status, error) {
+	log.Info("received export task result", zap.Int64("jobID", req.GetJobID()), zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("nodeID", req.GetBase().GetSourceID()), zap.String("state", req.GetState().String()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()), zap.Int64("rowCount", req.GetRowCount()))
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to report export task", zap.Int64("taskID", req.GetTaskID()),
+			zap.Error(errDataCoordIsUnhealthy(paramtable.GetNodeID())))
+		resp.Reason = msgDataCoordIsUnhealthy(paramtable.GetNodeID())
+		return resp, nil
+	}
+
+	if err := s.exportManager.report(req); err != nil {
+		log.Warn("failed to report export task", zap.Int64("taskID", req.GetTaskID()), zap.Error(err))
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
 // GetCompactionState gets the state of a compaction
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	log.Info("received get compaction state request", zap.Int64("compactionID", req.GetCompactionID()))
@@ -963,7 +1223,7 @@ func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompac
 	}
 
 	tasks := s.compactionHandler.getCompactionTasksBySignalID(req.GetCompactionID())
-	state, executingCnt, completedCnt, failedCnt, timeoutCnt := getCompactionState(tasks)
+	state, executingCnt, completedCnt, failedCnt, timeoutCnt, cancelledCnt := getCompactionState(tasks)
 
 	resp.State = state
 	resp.ExecutingPlanNo = int64(executingCnt)
@@ -973,6 +1233,7 @@ func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompac
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	log.Info("success to get compaction state", zap.Any("state", state), zap.Int("executing", executingCnt),
 		zap.Int("completed", completedCnt), zap.Int("failed", failedCnt), zap.Int("timeout", timeoutCnt),
+		zap.Int("cancelled", cancelledCnt),
 		zap.Int64s("plans", lo.Map(tasks, func(t *compactionTask, _ int) int64 {
 			if t.plan == nil {
 				return -1
@@ -1006,7 +1267,7 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 		resp.MergeInfos = append(resp.MergeInfos, getCompactionMergeInfo(task))
 	}
 
-	state, _, _, _, _ := getCompactionState(tasks)
+	state, _, _, _, _, _ := getCompactionState(tasks)
 
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	resp.State = state
@@ -1038,10 +1299,33 @@ func getCompactionMergeInfo(task *compactionTask) *milvuspb.CompactionMergeInfo
 	}
 }
 
-func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState, executingCnt, completedCnt, failedCnt, timeoutCnt int) {
+func getCompactionPlanInfo(task *compactionTask) *datapb.CompactionPlanInfo {
+	info := &datapb.CompactionPlanInfo{
+		PlanID: task.plan.GetPlanID(),
+		State:  task.state.toPlanState(),
+		NodeID: task.dataNodeID,
+	}
+	for _, s := range task.plan.GetSegmentBinlogs() {
+		info.Sources = append(info.Sources, s.GetSegmentID())
+	}
+	if task.result != nil {
+		if task.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+			for _, s := range task.result.GetSegments() {
+				info.Targets = append(info.Targets, s.GetSegmentID())
+			}
+		} else {
+			info.Targets = append(info.Targets, task.result.GetSegmentID())
+		}
+	}
+	return info
+}
+
+// getCompactionState counts the plans of a compaction by state, the cancelling plans are still executing on the datanodes.
+// The cancelled plans are not counted as failed, GetCompactionPlanStates tells them apart.
+func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState, executingCnt, completedCnt, failedCnt, timeoutCnt, cancelledCnt int) {
 	for _, t := range tasks {
 		switch t.state {
-		case executing:
+		case executing, cancelling:
 			executingCnt++
 		case completed:
 			completedCnt++
@@ -1049,6 +1333,8 @@ func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState
 			failedCnt++
 		case timeout:
 			timeoutCnt++
+		case cancelled:
+			cancelledCnt++
 		}
 	}
 	if executingCnt != 0 {
@@ -1128,7 +1414,13 @@ func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateR
 // Import distributes the import tasks to dataNodes.
 // It returns a failed status if no dataNode is available or if any error occurs.
 func (s *Server) Import(ctx context.Context, itr *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
-	log.Info("DataCoord receives import request", zap.Any("import task request", itr))
+	// the infos of import task are not logged since they may contain the credentials of the import source
+	log.Info("DataCoord receives import request",
+		zap.Int64("task ID", itr.GetImportTask().GetTaskId()),
+		zap.Int64("collection ID", itr.GetImportTask().GetCollectionId()),
+		zap.Int64("partition ID", itr.GetImportTask().GetPartitionId()),
+		zap.Strings("files", itr.GetImportTask().GetFiles()),
+		zap.Int64s("working dataNodes", itr.GetWorkingNodes()))
 	resp := &datapb.ImportTaskResponse{
 		Status: &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
@@ -1439,6 +1731,10 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	// fields may be appended to the collection schema by altering
+	if req.GetSchema() != nil {
+		clonedColl.Schema = req.GetSchema()
+	}
 	s.meta.AddCollection(clonedColl)
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
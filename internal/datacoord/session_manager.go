@@ -183,6 +183,31 @@ func (c *SessionManager) SyncSegments(nodeID int64, req *datapb.SyncSegmentsRequ
 	return nil
 }
 
+// CancelCompaction asks the DataNode to stop the compaction plan and drop its result.
+func (c *SessionManager) CancelCompaction(nodeID int64, planID int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcCompactionTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.CancelCompaction(ctx, &datapb.CancelCompactionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		PlanID: planID,
+	})
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to cancel compaction", zap.Int64("node", nodeID), zap.Error(err), zap.Int64("planID", planID))
+		return err
+	}
+
+	log.Info("success to cancel compaction", zap.Int64("node", nodeID), zap.Int64("planID", planID))
+	return nil
+}
+
 // Import is a grpc interface. It will send request to DataNode with provided `nodeID` asynchronously.
 func (c *SessionManager) Import(ctx context.Context, nodeID int64, itr *datapb.ImportTaskRequest) {
 	go c.execImport(ctx, nodeID, itr)
@@ -203,7 +228,47 @@ func (c *SessionManager) execImport(ctx context.Context, nodeID int64, itr *data
 		return
 	}
 
-	log.Info("success to import", zap.Int64("node", nodeID), zap.Any("import task", itr))
+	log.Info("success to import", zap.Int64("node", nodeID), zap.Int64("task ID", itr.GetImportTask().GetTaskId()))
+}
+
+// Export is a grpc interface. It will send an export task to DataNode with provided `nodeID` synchronously.
+func (c *SessionManager) Export(nodeID int64, task *datapb.ExportTask) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcExportTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.Export(ctx, task)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to execute export task", zap.Int64("node", nodeID), zap.Error(err),
+			zap.Int64("jobID", task.GetJobID()), zap.Int64("taskID", task.GetTaskID()))
+		return err
+	}
+
+	log.Info("success to execute export task", zap.Int64("node", nodeID),
+		zap.Int64("jobID", task.GetJobID()), zap.Int64("taskID", task.GetTaskID()))
+	return nil
+}
+
+// CancelExport is a grpc interface. It will cancel the export tasks of a job on DataNode with provided `nodeID` synchronously.
+func (c *SessionManager) CancelExport(nodeID int64, req *datapb.CancelExportRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcExportTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.CancelExport(ctx, req)
+	if err := VerifyResponse(resp, err); err != nil {
+		log.Warn("failed to cancel export", zap.Int64("node", nodeID), zap.Error(err), zap.Int64("jobID", req.GetJobID()))
+		return err
+	}
+	return nil
 }
 
 // ReCollectSegmentStats collects segment stats info from DataNodes, after DataCoord reboots.
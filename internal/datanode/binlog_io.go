@@ -19,6 +19,7 @@ package datanode
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path"
 	"strconv"
 	"time"
@@ -85,7 +86,7 @@ func (b *binlogIO) download(ctx context.Context, paths []string) ([]*Blob, error
 					log.Warn("downloading failed, retry in 50ms", zap.Strings("paths", paths))
 					<-time.After(50 * time.Millisecond)
 				}
-				vs, err = b.MultiRead(ctx, paths)
+				vs, err = b.throttledMultiRead(ctx, paths)
 			}
 		}
 		return nil
@@ -103,11 +104,38 @@ func (b *binlogIO) download(ctx context.Context, paths []string) ([]*Blob, error
 	return rst, nil
 }
 
+// throttledMultiRead reads @paths once the download bandwidth of them is acquired,
+// binlogIO only serves compactions so the reads wait for the flushes in progress.
+func (b *binlogIO) throttledMultiRead(ctx context.Context, paths []string) ([][]byte, error) {
+	throttle := getOrCreateIOThrottle()
+	size := int64(0)
+	if throttle.downloadLimited() {
+		for _, p := range paths {
+			s, err := b.Size(ctx, p)
+			if err != nil {
+				return nil, err
+			}
+			size += s
+		}
+	}
+	if err := throttle.acquireDownload(ctx, int(size)); err != nil {
+		return nil, err
+	}
+	return b.MultiRead(ctx, paths)
+}
+
 func (b *binlogIO) uploadSegmentFiles(
 	ctx context.Context,
 	CollectionID UniqueID,
 	segID UniqueID,
 	kvs map[string][]byte) error {
+	if err := getOrCreateIOThrottle().acquireUpload(ctx, totalSize(kvs)); err != nil {
+		log.Warn("ctx done when waiting for the flushes in progress",
+			zap.Int64("collectionID", CollectionID),
+			zap.Int64("segmentID", segID))
+		return errUploadToBlobStorage
+	}
+
 	var err = errStart
 	for err != nil {
 		select {
@@ -254,10 +282,19 @@ func (b *binlogIO) genDeltaBlobs(data *DeleteData, collID, partID, segID UniqueI
 // genInsertBlobs returns kvs, insert-paths, stats-paths
 func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta *etcdpb.CollectionMeta) (map[string][]byte, map[UniqueID]*datapb.FieldBinlog, map[UniqueID]*datapb.FieldBinlog, error) {
 	inCodec := storage.NewInsertCodec(meta)
-	inlogs, statslogs, err := inCodec.Serialize(partID, segID, data)
+	serialize := inCodec.SerializeWithPkStats
+	if Params.DataNodeCfg.ColumnarFormat {
+		serialize = inCodec.SerializeColumnar
+	}
+	inlogs, statslogs, _, err := serialize(partID, segID, data)
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	fieldStatslogs, _, err := inCodec.SerializeFieldStats(data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	statslogs = append(statslogs, fieldStatslogs...)
 
 	var (
 		kvs        = make(map[string][]byte, len(inlogs)+len(statslogs))
@@ -283,6 +320,20 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		fileLen := len(value)
 
 		kvs[key] = value
+		if fID == common.ColumnarLogFieldID {
+			// every field refers to the columnar log holding all of them
+			for _, field := range meta.GetSchema().GetFields() {
+				fieldData, ok := data.Data[field.GetFieldID()]
+				if !ok {
+					return nil, nil, nil, fmt.Errorf("data of field %d is missing in columnar log", field.GetFieldID())
+				}
+				inpaths[field.GetFieldID()] = &datapb.FieldBinlog{
+					FieldID: field.GetFieldID(),
+					Binlogs: []*datapb.Binlog{{LogSize: int64(fieldData.GetMemorySize()), LogPath: key, EntriesNum: blob.RowNum}},
+				}
+			}
+			continue
+		}
 		inpaths[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
 			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, EntriesNum: blob.RowNum}},
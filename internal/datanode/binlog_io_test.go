@@ -59,7 +59,8 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		p, err := b.upload(context.TODO(), 1, 10, []*InsertData{iData}, dData, meta)
 		assert.NoError(t, err)
 		assert.Equal(t, 12, len(p.inPaths))
-		assert.Equal(t, 1, len(p.statsPaths))
+		// pk stats and the field stats of the other 7 scalar fields
+		assert.Equal(t, 8, len(p.statsPaths))
 		assert.Equal(t, 1, len(p.inPaths[0].GetBinlogs()))
 		assert.Equal(t, 1, len(p.statsPaths[0].GetBinlogs()))
 		assert.NotNil(t, p.deltaInfo)
@@ -67,7 +68,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		p, err = b.upload(context.TODO(), 1, 10, []*InsertData{iData, iData}, dData, meta)
 		assert.NoError(t, err)
 		assert.Equal(t, 12, len(p.inPaths))
-		assert.Equal(t, 1, len(p.statsPaths))
+		assert.Equal(t, 8, len(p.statsPaths))
 		assert.Equal(t, 2, len(p.inPaths[0].GetBinlogs()))
 		assert.Equal(t, 2, len(p.statsPaths[0].GetBinlogs()))
 		assert.NotNil(t, p.deltaInfo)
@@ -339,9 +340,9 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 				kvs, pin, pstats, err := b.genInsertBlobs(genInsertData(), 10, 1, meta)
 
 				assert.NoError(t, err)
-				assert.Equal(t, 1, len(pstats))
+				assert.Equal(t, 8, len(pstats))
 				assert.Equal(t, 12, len(pin))
-				assert.Equal(t, 13, len(kvs))
+				assert.Equal(t, 20, len(kvs))
 
 				log.Debug("test paths",
 					zap.Any("kvs no.", len(kvs)),
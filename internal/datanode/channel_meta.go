@@ -50,6 +50,7 @@ var (
 type Channel interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	refreshCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 	getChannelName(segID UniqueID) string
 
@@ -62,6 +63,8 @@ type Channel interface {
 	transferNewSegments(segmentIDs []UniqueID)
 	updateSegmentPKRange(segID UniqueID, ids storage.FieldData)
 	mergeFlushedSegments(seg *Segment, planID UniqueID, compactedFrom []UniqueID) error
+	mergeClusteredSegments(segs []*Segment, planID UniqueID, compactedFrom []UniqueID) error
+	getClusteredTo(segID UniqueID) []UniqueID
 	hasSegment(segID UniqueID, countFlushed bool) bool
 	removeSegments(segID ...UniqueID)
 	listCompactedSegmentIDs() map[UniqueID][]UniqueID
@@ -288,6 +291,7 @@ func (c *ChannelMeta) InitPKstats(ctx context.Context, s *Segment, statsBinlogs
 		log.Warn("failed to initPKBloomFilter, get schema return error", zap.Error(err))
 		return err
 	}
+	s.bloomFilterParams = storage.GetBloomFilterParams(schema)
 
 	// get pkfield id
 	pkField := int64(-1)
@@ -333,13 +337,10 @@ func (c *ChannelMeta) InitPKstats(ctx context.Context, s *Segment, statsBinlogs
 	}
 	var size uint
 	for _, stat := range stats {
-		pkStat := &storage.PkStatistics{
-			PkFilter: stat.BF,
-			MinPK:    stat.MinPk,
-			MaxPK:    stat.MaxPk,
+		for _, filter := range stat.BloomFilters() {
+			size += filter.Cap()
 		}
-		size += stat.BF.Cap()
-		s.historyStats = append(s.historyStats, pkStat)
+		s.historyStats = append(s.historyStats, storage.NewPkStatisticsFromStats(stat))
 	}
 	log.Info("Successfully load pk stats", zap.Any("time", time.Since(startTs)), zap.Uint("size", size))
 
@@ -353,12 +354,7 @@ func (c *ChannelMeta) RollPKstats(segID UniqueID, stats []*storage.PrimaryKeySta
 	log.Info("roll pk stats", zap.Int64("segment id", segID))
 	if ok && seg.notFlushed() {
 		for _, stat := range stats {
-			pkStat := &storage.PkStatistics{
-				PkFilter: stat.BF,
-				MinPK:    stat.MinPk,
-				MaxPK:    stat.MaxPk,
-			}
-			seg.historyStats = append(seg.historyStats, pkStat)
+			seg.historyStats = append(seg.historyStats, storage.NewPkStatisticsFromStats(stat))
 		}
 		seg.currentStat = nil
 		return
@@ -513,6 +509,24 @@ func (c *ChannelMeta) getCollectionSchema(collID UniqueID, ts Timestamp) (*schem
 	return c.collSchema, nil
 }
 
+// refreshCollectionSchema fetches collection schema from rootcoord for a certain timestamp and replaces the cached one,
+// it's called when fields are added to the collection.
+func (c *ChannelMeta) refreshCollectionSchema(collID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	if !c.validCollection(collID) {
+		return nil, fmt.Errorf("mismatch collection, want %d, actual %d", c.collectionID, collID)
+	}
+
+	sch, err := c.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.schemaMut.Lock()
+	defer c.schemaMut.Unlock()
+	c.collSchema = sch
+	return sch, nil
+}
+
 func (c *ChannelMeta) validCollection(collID UniqueID) bool {
 	return collID == c.collectionID
 }
@@ -564,6 +578,80 @@ func (c *ChannelMeta) mergeFlushedSegments(seg *Segment, planID UniqueID, compac
 	return nil
 }
 
+// mergeClusteredSegments replaces the compactedFrom segments with the result segments of a clustering compaction,
+// the compactedFrom segments are compacted to the first result segment and record all of the result segments,
+// so that their buffered deletes could be routed to the result segments containing the primary keys.
+func (c *ChannelMeta) mergeClusteredSegments(segs []*Segment, planID UniqueID, compactedFrom []UniqueID) error {
+	log := log.With(
+		zap.Int64s("compacted from", compactedFrom),
+		zap.Int64("planID", planID),
+		zap.String("channel name", c.channelName))
+
+	if len(segs) == 0 {
+		return fmt.Errorf("no result segments of clustering compaction, planID=%d", planID)
+	}
+	for _, seg := range segs {
+		if seg.collectionID != c.collectionID {
+			log.Warn("Mismatch collection",
+				zap.Int64("segment ID", seg.segmentID),
+				zap.Int64("expected collectionID", c.collectionID))
+			return fmt.Errorf("mismatch collection, ID=%d", seg.collectionID)
+		}
+	}
+
+	compactedFrom = lo.Filter[int64](compactedFrom, func(segID int64, _ int) bool {
+		// which means the segment is the `flushed` state
+		has := c.hasSegment(segID, true) && !c.hasSegment(segID, false)
+		if !has {
+			log.Warn("invalid segment", zap.Int64("segment_id", segID))
+		}
+		return has
+	})
+
+	// only store segments with numRows > 0
+	var clusteredTo []UniqueID
+	for _, seg := range segs {
+		if seg.numRows > 0 {
+			clusteredTo = append(clusteredTo, seg.segmentID)
+		}
+	}
+
+	log.Info("merge clustered segments", zap.Int64s("clustered to", clusteredTo))
+	c.segMu.Lock()
+	defer c.segMu.Unlock()
+	for _, ID := range compactedFrom {
+		// the existent of the segments are already checked
+		s := c.segments[ID]
+		s.compactedTo = segs[0].segmentID
+		s.clusteredTo = clusteredTo
+		s.setType(datapb.SegmentType_Compacted)
+		// release bloom filter
+		s.currentStat = nil
+		s.historyStats = nil
+	}
+
+	for _, seg := range segs {
+		if seg.numRows > 0 {
+			seg.setType(datapb.SegmentType_Flushed)
+			c.segments[seg.segmentID] = seg
+		}
+	}
+
+	return nil
+}
+
+// getClusteredTo returns the result segments of the clustering compaction which compacted the segment,
+// nil if the segment is not compacted by a clustering compaction.
+func (c *ChannelMeta) getClusteredTo(segID UniqueID) []UniqueID {
+	c.segMu.RLock()
+	defer c.segMu.RUnlock()
+
+	if seg, ok := c.segments[segID]; ok {
+		return seg.clusteredTo
+	}
+	return nil
+}
+
 // for tests only
 func (c *ChannelMeta) addFlushedSegmentWithPKs(segID, collID, partID UniqueID, numOfRows int64, ids storage.FieldData) error {
 	if collID != c.collectionID {
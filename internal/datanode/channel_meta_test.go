@@ -462,6 +462,25 @@ func TestChannelMeta_InterfaceMethod(t *testing.T) {
 		rc.setCollectionID(1)
 	})
 
+	t.Run("Test_refreshCollectionSchema", func(t *testing.T) {
+		channel := newChannel("a", 1, &schemapb.CollectionSchema{}, rc, cm)
+
+		_, err := channel.refreshCollectionSchema(2, Timestamp(0))
+		assert.Error(t, err)
+
+		s, err := channel.refreshCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, s.GetFields())
+		cached, err := channel.getCollectionSchema(1, Timestamp(0))
+		assert.NoError(t, err)
+		assert.Equal(t, s, cached)
+
+		rc.setCollectionID(-1)
+		_, err = channel.refreshCollectionSchema(1, Timestamp(0))
+		assert.Error(t, err)
+		rc.setCollectionID(1)
+	})
+
 	t.Run("Test listAllSegmentIDs", func(t *testing.T) {
 		s1 := Segment{segmentID: 1}
 		s2 := Segment{segmentID: 2}
@@ -693,6 +712,34 @@ func TestChannelMeta_InterfaceMethod(t *testing.T) {
 		}
 	})
 
+	t.Run("Test_mergeClusteredSegments", func(t *testing.T) {
+		channel := newChannel("channel", 1, nil, rc, cm)
+		primaryKeyData := &storage.Int64FieldData{
+			Data: []UniqueID{1},
+		}
+		channel.addFlushedSegmentWithPKs(1, 1, 0, 10, primaryKeyData)
+		channel.addFlushedSegmentWithPKs(2, 1, 0, 10, primaryKeyData)
+
+		assert.Error(t, channel.mergeClusteredSegments(nil, 100, []UniqueID{1, 2}))
+		assert.Error(t, channel.mergeClusteredSegments([]*Segment{{segmentID: 3, collectionID: -1}}, 100, []UniqueID{1, 2}))
+
+		err := channel.mergeClusteredSegments([]*Segment{
+			{segmentID: 3, collectionID: 1, numRows: 8},
+			{segmentID: 4, collectionID: 1, numRows: 0},
+			{segmentID: 5, collectionID: 1, numRows: 12},
+		}, 100, []UniqueID{1, 2, 6})
+		assert.NoError(t, err)
+		assert.True(t, channel.hasSegment(3, true))
+		assert.False(t, channel.hasSegment(4, true))
+		assert.True(t, channel.hasSegment(5, true))
+
+		to2from := channel.listCompactedSegmentIDs()
+		assert.ElementsMatch(t, []UniqueID{1, 2}, to2from[3])
+		assert.Equal(t, []UniqueID{3, 5}, channel.getClusteredTo(1))
+		assert.Equal(t, []UniqueID{3, 5}, channel.getClusteredTo(2))
+		assert.Nil(t, channel.getClusteredTo(3))
+	})
+
 }
 func TestChannelMeta_UpdatePKRange(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
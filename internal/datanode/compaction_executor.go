@@ -35,6 +35,7 @@ type compactionExecutor struct {
 	completed sync.Map // planID to CompactionResult
 	taskCh    chan compactor
 	dropped   sync.Map // vchannel dropped
+	cancelled sync.Map // planID of the plans cancelled before they start
 }
 
 func newCompactionExecutor() *compactionExecutor {
@@ -60,6 +61,10 @@ func (c *compactionExecutor) toCompleteState(task compactor) {
 
 // These two func are bounded for waitGroup
 func (c *compactionExecutor) executeWithState(task compactor) {
+	if _, ok := c.cancelled.LoadAndDelete(task.getPlanID()); ok {
+		log.Info("skip cancelled compaction task", zap.Int64("planID", task.getPlanID()))
+		return
+	}
 	c.toExecutingState(task)
 	go c.executeTask(task)
 }
@@ -80,6 +85,10 @@ func (c *compactionExecutor) executeTask(task compactor) {
 		c.toCompleteState(task)
 	}()
 
+	throttle := getOrCreateIOThrottle()
+	throttle.acquireCompactionSlot()
+	defer throttle.releaseCompactionSlot()
+
 	log.Info("start to execute compaction", zap.Int64("planID", task.getPlanID()))
 
 	result, err := task.compact()
@@ -103,6 +112,19 @@ func (c *compactionExecutor) stopTask(planID UniqueID) {
 	}
 }
 
+// cancelTask stops the plan and drops its result, a plan which has not started yet is skipped when it arrives
+func (c *compactionExecutor) cancelTask(planID UniqueID) {
+	if _, ok := c.executing.Load(planID); ok {
+		c.stopTask(planID)
+	} else if _, ok := c.completed.Load(planID); !ok {
+		c.cancelled.Store(planID, struct{}{})
+	}
+	// the plan may complete before it's stopped
+	if _, ok := c.completed.LoadAndDelete(planID); ok {
+		log.Info("drop the result of cancelled compaction task", zap.Int64("planID", planID))
+	}
+}
+
 func (c *compactionExecutor) channelValidateForCompaction(vChannelName string) bool {
 	// if vchannel marked dropped, compaction should not proceed
 	_, loaded := c.dropped.Load(vChannelName)
@@ -113,6 +113,46 @@ func TestCompactionExecutor(t *testing.T) {
 		}
 	})
 
+	t.Run("test cancel executing task", func(t *testing.T) {
+		ex := newCompactionExecutor()
+		mc := newMockCompactor(true)
+		mc.alwaysWorking = true
+		ex.executeWithState(mc)
+
+		ex.cancelTask(mc.getPlanID())
+		select {
+		case <-mc.ctx.Done():
+		default:
+			t.FailNow()
+		}
+		_, ok := ex.completed.Load(mc.getPlanID())
+		assert.False(t, ok)
+		_, ok = ex.cancelled.Load(mc.getPlanID())
+		assert.False(t, ok)
+	})
+
+	t.Run("test cancel task before it arrives", func(t *testing.T) {
+		ex := newCompactionExecutor()
+		mc := newMockCompactor(true)
+		ex.cancelTask(mc.getPlanID())
+
+		ex.executeWithState(mc)
+		_, ok := ex.executing.Load(mc.getPlanID())
+		assert.False(t, ok)
+		_, ok = ex.cancelled.Load(mc.getPlanID())
+		assert.False(t, ok)
+	})
+
+	t.Run("test cancel completed task", func(t *testing.T) {
+		ex := newCompactionExecutor()
+		ex.completed.Store(UniqueID(1), &datapb.CompactionResult{PlanID: 1})
+
+		ex.cancelTask(1)
+		_, ok := ex.completed.Load(UniqueID(1))
+		assert.False(t, ok)
+		_, ok = ex.cancelled.Load(UniqueID(1))
+		assert.False(t, ok)
+	})
 }
 
 func newMockCompactor(isvalid bool) *mockCompactor {
@@ -206,6 +206,11 @@ func (t *compactionTask) uploadSingleInsertLog(
 	iData := &InsertData{
 		Data: make(map[storage.FieldID]storage.FieldData)}
 
+	nullableFields := make(map[UniqueID]bool)
+	for _, fs := range meta.GetSchema().GetFields() {
+		nullableFields[fs.GetFieldID()] = typeutil.IsFieldNullable(fs)
+	}
+
 	for fID, content := range fID2Content {
 		tp, ok := fID2Type[fID]
 		if !ok {
@@ -213,7 +218,7 @@ func (t *compactionTask) uploadSingleInsertLog(
 			return nil, nil, errors.New("Unexpected error")
 		}
 
-		fData, err := interface2FieldData(tp, content, int64(len(content)))
+		fData, err := interface2FieldData(tp, content, int64(len(content)), nullableFields[fID])
 		if err != nil {
 			log.Warn("transfer interface to FieldData wrong", zap.Error(err))
 			return nil, nil, err
@@ -253,6 +258,7 @@ func (t *compactionTask) merge(
 
 		fID2Type    = make(map[UniqueID]schemapb.DataType)
 		fID2Content = make(map[UniqueID][]interface{})
+		fID2Default = make(map[UniqueID]interface{})
 
 		insertField2Path = make(map[UniqueID]*datapb.FieldBinlog)
 		insertPaths      = make([]*datapb.FieldBinlog, 0)
@@ -296,6 +302,19 @@ func (t *compactionTask) merge(
 	// get pkID, pkType, dim
 	for _, fs := range meta.GetSchema().GetFields() {
 		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		var (
+			defaultValue interface{}
+			hasDefault   bool
+		)
+		if defaultValue, hasDefault, err = typeutil.GetDefaultValue(fs); err != nil {
+			log.Warn("invalid default value", zap.Int64("fieldID", fs.GetFieldID()), zap.Error(err))
+			return nil, nil, 0, err
+		}
+		if hasDefault {
+			fID2Default[fs.GetFieldID()] = defaultValue
+		} else if typeutil.IsFieldNullable(fs) {
+			fID2Default[fs.GetFieldID()] = nil
+		}
 		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
 			pkID = fs.GetFieldID()
 			pkType = fs.GetDataType()
@@ -362,6 +381,13 @@ func (t *compactionTask) merge(
 				return nil, nil, 0, errors.New("unexpected error")
 			}
 
+			// rows written before fields were added to the collection take the default value or null
+			for fID, value := range fID2Default {
+				if _, ok := row[fID]; !ok {
+					row[fID] = value
+				}
+			}
+
 			for fID, vInter := range row {
 				if _, ok := fID2Content[fID]; !ok {
 					fID2Content[fID] = make([]interface{}, 0)
@@ -577,12 +603,12 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 	g, gCtx := errgroup.WithContext(ctxTimeout)
 	for _, s := range t.plan.GetSegmentBinlogs() {
 
-		// Get the number of field binlog files from non-empty segment
+		// Get the number of field binlog files from non-empty segment,
+		// fields added to the collection after some binlogs were flushed have fewer binlog files.
 		var binlogNum int
 		for _, b := range s.GetFieldBinlogs() {
-			if b != nil {
+			if b != nil && len(b.GetBinlogs()) > binlogNum {
 				binlogNum = len(b.GetBinlogs())
-				break
 			}
 		}
 		// Unable to deal with all empty segments cases, so return error
@@ -594,7 +620,17 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		for idx := 0; idx < binlogNum; idx++ {
 			var ps []string
 			for _, f := range s.GetFieldBinlogs() {
-				ps = append(ps, f.GetBinlogs()[idx].GetLogPath())
+				// the missing binlog files of an added field are the leading ones
+				offset := binlogNum - len(f.GetBinlogs())
+				if idx < offset {
+					continue
+				}
+				logPath := f.GetBinlogs()[idx-offset].GetLogPath()
+				// a columnar log holds all fields of the flush, download it once
+				if storage.IsColumnarLogPath(logPath) && funcutil.SliceContain(ps, logPath) {
+					continue
+				}
+				ps = append(ps, logPath)
 			}
 			allPs = append(allPs, ps)
 		}
@@ -636,6 +672,38 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 		return nil, err
 	}
 
+	if t.plan.GetType() == datapb.CompactionType_ClusteringCompaction {
+		segments, err := t.clusteringMerge(ctxTimeout, allPs, partID, meta, deltaPk2Ts, deltaBuf)
+		if err != nil {
+			log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
+			return nil, err
+		}
+		if len(segments) == 0 {
+			// all the rows are deleted or expired, the result is an empty segment like the merge compaction
+			segmentID, err := t.allocID()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, &datapb.CompactionSegment{SegmentID: segmentID})
+		}
+		// the flush packs of the compacted segments after the compaction are redirected to the first result segment
+		targetSegID = segments[0].GetSegmentID()
+
+		ti.injectDone(true)
+		log.Info("clustering compaction done",
+			zap.Int64("planID", t.plan.GetPlanID()),
+			zap.Int64s("compactedFrom", segIDs),
+			zap.Int("num of result segments", len(segments)),
+			zap.Float64("overall elapse in ms", nano2Milli(time.Since(compactStart))))
+		metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+
+		return &datapb.CompactionResult{
+			PlanID:   t.plan.GetPlanID(),
+			Channel:  t.plan.GetChannel(),
+			Segments: segments,
+		}, nil
+	}
+
 	inPaths, statsPaths, numRows, err := t.merge(ctxTimeout, allPs, targetSegID, partID, meta, deltaPk2Ts)
 	if err != nil {
 		log.Warn("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
@@ -691,20 +759,26 @@ func (t *compactionTask) compact() (*datapb.CompactionResult, error) {
 	return pack, nil
 }
 
+// interface2FieldData converts content to FieldData, nil rows are accepted as null values only if nullable is true.
 // TODO copy maybe expensive, but this seems to be the only convinent way.
-func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
+func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64, nullable bool) (storage.FieldData, error) {
 	var rst storage.FieldData
 	numOfRows := []int64{numRows}
+	var validData []bool
+	if nullable {
+		validData = contentValidData(content)
+	}
 	switch schemaDataType {
 	case schemapb.DataType_Bool:
 		var data = &storage.BoolFieldData{
-			NumRows: numOfRows,
-			Data:    make([]bool, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]bool, 0, len(content)),
+			ValidData: validData,
 		}
 
 		for _, c := range content {
 			r, ok := c.(bool)
-			if !ok {
+			if !ok && (!nullable || c != nil) {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -713,13 +787,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Int8:
 		var data = &storage.Int8FieldData{
-			NumRows: numOfRows,
-			Data:    make([]int8, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]int8, 0, len(content)),
+			ValidData: validData,
 		}
 
 		for _, c := range content {
 			r, ok := c.(int8)
-			if !ok {
+			if !ok && (!nullable || c != nil) {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -728,13 +803,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Int16:
 		var data = &storage.Int16FieldData{
-			NumRows: numOfRows,
-			Data:    make([]int16, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]int16, 0, len(content)),
+			ValidData: validData,
 		}
 
 		for _, c := range content {
 			r, ok := c.(int16)
-			if !ok {
+			if !ok && (!nullable || c != nil) {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -743,13 +819,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Int32:
 		var data = &storage.Int32FieldData{
-			NumRows: numOfRows,
-			Data:    make([]int32, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]int32, 0, len(content)),
+			ValidData: validData,
 		}
 
 		for _, c := range content {
 			r, ok := c.(int32)
-			if !ok {
+			if !ok && (!nullable || c != nil) {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -758,13 +835,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Int64:
 		var data = &storage.Int64FieldData{
-			NumRows: numOfRows,
-			Data:    make([]int64, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]int64, 0, len(content)),
+			ValidData: validData,
 		}
 
 		for _, c := range content {
 			r, ok := c.(int64)
-			if !ok {
+			if !ok && (!nullable || c != nil) {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -773,13 +851,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Float:
 		var data = &storage.FloatFieldData{
-			NumRows: numOfRows,
-			Data:    make([]float32, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]float32, 0, len(content)),
+			ValidData: validData,
 		}
 
 		for _, c := range content {
 			r, ok := c.(float32)
-			if !ok {
+			if !ok && (!nullable || c != nil) {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -788,13 +867,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_Double:
 		var data = &storage.DoubleFieldData{
-			NumRows: numOfRows,
-			Data:    make([]float64, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]float64, 0, len(content)),
+			ValidData: validData,
 		}
 
 		for _, c := range content {
 			r, ok := c.(float64)
-			if !ok {
+			if !ok && (!nullable || c != nil) {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -803,13 +883,14 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 	case schemapb.DataType_String, schemapb.DataType_VarChar:
 		var data = &storage.StringFieldData{
-			NumRows: numOfRows,
-			Data:    make([]string, 0, len(content)),
+			NumRows:   numOfRows,
+			Data:      make([]string, 0, len(content)),
+			ValidData: validData,
 		}
 
 		for _, c := range content {
 			r, ok := c.(string)
-			if !ok {
+			if !ok && (!nullable || c != nil) {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
@@ -857,6 +938,25 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 	return rst, nil
 }
 
+// contentValidData returns the validity of each row of content, null rows are represented by nil.
+// It returns nil if there is no null row.
+func contentValidData(content []interface{}) []bool {
+	var validData []bool
+	for i, c := range content {
+		if c != nil {
+			continue
+		}
+		if validData == nil {
+			validData = make([]bool, len(content))
+			for j := range validData {
+				validData[j] = true
+			}
+		}
+		validData[i] = false
+	}
+	return validData
+}
+
 func (t *compactionTask) getSegmentMeta(segID UniqueID) (UniqueID, UniqueID, *etcdpb.CollectionMeta, error) {
 	collID, partID, err := t.getCollectionAndPartitionID(segID)
 	if err != nil {
@@ -0,0 +1,539 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+
+	"go.uber.org/zap"
+)
+
+// clusteringBucket is one result segment of a clustering compaction, it holds the rows of a clustering key range.
+type clusteringBucket struct {
+	segmentID   UniqueID
+	numRows     int64
+	currentRows int
+	fID2Content map[UniqueID][]interface{}
+
+	insertField2Path map[UniqueID]*datapb.FieldBinlog
+	statField2Path   map[UniqueID]*datapb.FieldBinlog
+	keyRange         *storage.FieldStats
+	deltaBuf         *DelDataBuf
+}
+
+func newClusteringBucket(segmentID UniqueID, field *schemapb.FieldSchema) *clusteringBucket {
+	return &clusteringBucket{
+		segmentID:        segmentID,
+		fID2Content:      make(map[UniqueID][]interface{}),
+		insertField2Path: make(map[UniqueID]*datapb.FieldBinlog),
+		statField2Path:   make(map[UniqueID]*datapb.FieldBinlog),
+		keyRange:         storage.NewFieldStats(field.GetFieldID(), field.GetDataType()),
+		deltaBuf: &DelDataBuf{
+			delData: &DeleteData{},
+			Binlog: datapb.Binlog{
+				TimestampFrom: math.MaxUint64,
+				TimestampTo:   0,
+			},
+		},
+	}
+}
+
+// normalizeClusteringKey converts the clustering key value of a row to int64, float64 or string,
+// so that the keys are comparable, nil is returned for null values.
+func normalizeClusteringKey(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case nil:
+		return nil, nil
+	case int8:
+		return int64(value), nil
+	case int16:
+		return int64(value), nil
+	case int32:
+		return int64(value), nil
+	case int64:
+		return value, nil
+	case float32:
+		return float64(value), nil
+	case float64:
+		return value, nil
+	case string:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported clustering key type %T", v)
+	}
+}
+
+// compareClusteringKey compares two normalized clustering keys of the same type.
+func compareClusteringKey(a, b interface{}) int {
+	switch av := a.(type) {
+	case int64:
+		bv := b.(int64)
+		if av < bv {
+			return -1
+		} else if av > bv {
+			return 1
+		}
+	case float64:
+		bv := b.(float64)
+		if av < bv {
+			return -1
+		} else if av > bv {
+			return 1
+		}
+	case string:
+		return strings.Compare(av, b.(string))
+	}
+	return 0
+}
+
+// clusteringSampleSize is the max number of clustering keys sampled to decide the bounds of the key ranges
+const clusteringSampleSize = 10000
+
+// clusteringKeySampler keeps a uniform sample of at most clusteringSampleSize keys by reservoir sampling.
+type clusteringKeySampler struct {
+	samples []interface{}
+	numRows int64
+	rand    *rand.Rand
+}
+
+func newClusteringKeySampler(seed int64) *clusteringKeySampler {
+	return &clusteringKeySampler{rand: rand.New(rand.NewSource(seed))}
+}
+
+func (s *clusteringKeySampler) add(key interface{}) {
+	s.numRows++
+	if len(s.samples) < clusteringSampleSize {
+		s.samples = append(s.samples, key)
+		return
+	}
+	if i := s.rand.Int63n(s.numRows); i < clusteringSampleSize {
+		s.samples[i] = key
+	}
+}
+
+// splitClusteringKeys returns the lower bounds of the 2nd to the last ranges, which split @numRows keys into ranges
+// of about maxRows keys. The bounds are the quantiles of @samples, a uniform sample of the keys. Equal keys are always
+// in the same range, so a range could exceed maxRows if a key has too many duplicates.
+func splitClusteringKeys(samples []interface{}, numRows int64, maxRows int64) []interface{} {
+	if maxRows <= 0 || numRows <= maxRows || len(samples) == 0 {
+		return nil
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return compareClusteringKey(samples[i], samples[j]) < 0
+	})
+
+	numRanges := (numRows + maxRows - 1) / maxRows
+	var bounds []interface{}
+	for i := int64(1); i < numRanges; i++ {
+		bound := samples[int64(len(samples))*i/numRanges]
+		// skip the duplicates of the previous bound, and the bound which leaves the first range empty
+		if len(bounds) > 0 && compareClusteringKey(bounds[len(bounds)-1], bound) >= 0 {
+			continue
+		}
+		if compareClusteringKey(samples[0], bound) == 0 {
+			continue
+		}
+		bounds = append(bounds, bound)
+	}
+	return bounds
+}
+
+// locateClusteringBucket returns the index of the range the key belongs to, null keys are in the last range.
+func locateClusteringBucket(bounds []interface{}, key interface{}) int {
+	if key == nil {
+		return len(bounds)
+	}
+	return sort.Search(len(bounds), func(i int) bool {
+		return compareClusteringKey(bounds[i], key) > 0
+	})
+}
+
+// isClusteringKeyLog returns whether the insert log @logPath holds the clustering key @fieldID,
+// the columnar logs hold all the fields.
+func isClusteringKeyLog(logPath string, fieldID UniqueID) bool {
+	return storage.IsColumnarLogPath(logPath) || path.Base(path.Dir(logPath)) == strconv.FormatInt(fieldID, 10)
+}
+
+// sampleClusteringKeys reads the clustering key logs of each batch of insert logs, and samples the non-null keys.
+// The downloaded logs which only hold the clustering key are returned, so that they are not downloaded again.
+func (t *compactionTask) sampleClusteringKeys(
+	ctxTimeout context.Context,
+	unMergedInsertlogs [][]string,
+	meta *etcdpb.CollectionMeta,
+	clusteringKey *schemapb.FieldSchema) (*clusteringKeySampler, map[string]*Blob, error) {
+	sampler := newClusteringKeySampler(t.getPlanID())
+	keyBlobs := make(map[string]*Blob)
+	codec := storage.NewInsertCodec(meta)
+	for _, paths := range unMergedInsertlogs {
+		var keyPaths []string
+		for _, logPath := range paths {
+			if isClusteringKeyLog(logPath, clusteringKey.GetFieldID()) {
+				keyPaths = append(keyPaths, logPath)
+			}
+		}
+		// the batch was flushed before the clustering key was added, all the rows take the default value
+		if len(keyPaths) == 0 {
+			continue
+		}
+		blobs, err := t.download(ctxTimeout, keyPaths)
+		if err != nil {
+			log.Warn("download clustering key logs wrong")
+			return nil, nil, err
+		}
+
+		insertData := &InsertData{Data: make(map[UniqueID]storage.FieldData)}
+		if _, _, _, err = codec.DeserializeFieldsInto(blobs, 0, insertData, clusteringKey.GetFieldID()); err != nil {
+			return nil, nil, err
+		}
+		fieldData, ok := insertData.Data[clusteringKey.GetFieldID()]
+		if !ok {
+			continue
+		}
+		validData := fieldData.GetValidData()
+		for i := 0; i < fieldData.RowNum(); i++ {
+			if len(validData) > 0 && !validData[i] {
+				continue
+			}
+			key, err := normalizeClusteringKey(fieldData.GetRow(i))
+			if err != nil {
+				return nil, nil, err
+			}
+			sampler.add(key)
+		}
+
+		for i, logPath := range keyPaths {
+			if !storage.IsColumnarLogPath(logPath) {
+				keyBlobs[logPath] = blobs[i]
+			}
+		}
+	}
+	return sampler, keyBlobs, nil
+}
+
+// iterateRows reads the rows of the insert logs, skipping the deleted and expired ones, and calls fn with
+// the primary key and the field values of each row. The logs in @downloaded are not downloaded again.
+func (t *compactionTask) iterateRows(
+	ctxTimeout context.Context,
+	unMergedInsertlogs [][]string,
+	downloaded map[string]*Blob,
+	meta *etcdpb.CollectionMeta,
+	delta map[interface{}]Timestamp,
+	fn func(pk interface{}, row map[UniqueID]interface{}) error) (int64, error) {
+	var (
+		pkID        UniqueID
+		pkType      schemapb.DataType
+		expired     int64
+		fID2Default = make(map[UniqueID]interface{})
+	)
+	for _, fs := range meta.GetSchema().GetFields() {
+		defaultValue, hasDefault, err := typeutil.GetDefaultValue(fs)
+		if err != nil {
+			log.Warn("invalid default value", zap.Int64("fieldID", fs.GetFieldID()), zap.Error(err))
+			return 0, err
+		}
+		if hasDefault {
+			fID2Default[fs.GetFieldID()] = defaultValue
+		} else if typeutil.IsFieldNullable(fs) {
+			fID2Default[fs.GetFieldID()] = nil
+		}
+		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
+			pkID = fs.GetFieldID()
+			pkType = fs.GetDataType()
+		}
+	}
+
+	currentTs := t.GetCurrentTime()
+	for _, paths := range unMergedInsertlogs {
+		var (
+			data          []*Blob
+			downloadPaths []string
+		)
+		for _, logPath := range paths {
+			if blob, ok := downloaded[logPath]; ok {
+				data = append(data, blob)
+			} else {
+				downloadPaths = append(downloadPaths, logPath)
+			}
+		}
+		if len(downloadPaths) > 0 {
+			blobs, err := t.download(ctxTimeout, downloadPaths)
+			if err != nil {
+				log.Warn("download insertlogs wrong")
+				return 0, err
+			}
+			data = append(data, blobs...)
+		}
+
+		iter, err := storage.NewInsertBinlogIterator(data, pkID, pkType)
+		if err != nil {
+			log.Warn("new insert binlogs Itr wrong")
+			return 0, err
+		}
+		for iter.HasNext() {
+			vInter, _ := iter.Next()
+			v, ok := vInter.(*storage.Value)
+			if !ok {
+				log.Warn("transfer interface to Value wrong")
+				return 0, errors.New("unexpected error")
+			}
+
+			pk := v.PK.GetValue()
+			ts := Timestamp(v.Timestamp)
+			if deleteTs, ok := delta[pk]; ok && ts <= deleteTs {
+				continue
+			}
+			// Filtering expired entity
+			if t.isExpiredEntity(ts, currentTs) {
+				expired++
+				continue
+			}
+
+			row, ok := v.Value.(map[UniqueID]interface{})
+			if !ok {
+				log.Warn("transfer interface to map wrong")
+				return 0, errors.New("unexpected error")
+			}
+			// rows written before fields were added to the collection take the default value or null
+			for fID, value := range fID2Default {
+				if _, ok := row[fID]; !ok {
+					row[fID] = value
+				}
+			}
+
+			if err := fn(pk, row); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return expired, nil
+}
+
+// clusteringMerge splits the rows of the plan segments into segments of disjoint clustering key ranges, each one
+// has about plan.MaxSegmentRows rows. The first pass only reads the clustering key logs and samples the keys to decide
+// the range bounds, the second pass reads all the insert logs and writes every row into the segment of its range.
+// The deletions which are kept for time travel are written to the segments of the deleted rows.
+func (t *compactionTask) clusteringMerge(
+	ctxTimeout context.Context,
+	unMergedInsertlogs [][]string,
+	partID UniqueID,
+	meta *etcdpb.CollectionMeta,
+	delta map[interface{}]Timestamp,
+	deltaBuf *DelDataBuf) ([]*datapb.CompactionSegment, error) {
+	log := log.With(zap.Int64("planID", t.getPlanID()))
+	mergeStart := time.Now()
+
+	var (
+		dim            int
+		clusteringKey  *schemapb.FieldSchema
+		fID2Type       = make(map[UniqueID]schemapb.DataType)
+		nullableFields = make(map[UniqueID]bool)
+		err            error
+	)
+	for _, fs := range meta.GetSchema().GetFields() {
+		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		nullableFields[fs.GetFieldID()] = typeutil.IsFieldNullable(fs)
+		if fs.GetFieldID() == t.plan.GetClusteringFieldID() {
+			clusteringKey = fs
+		}
+		if fs.GetDataType() == schemapb.DataType_FloatVector ||
+			fs.GetDataType() == schemapb.DataType_BinaryVector {
+			for _, t := range fs.GetTypeParams() {
+				if t.Key == "dim" {
+					if dim, err = strconv.Atoi(t.Value); err != nil {
+						log.Warn("strconv wrong on get dim", zap.Error(err))
+						return nil, err
+					}
+					break
+				}
+			}
+		}
+	}
+	if clusteringKey == nil {
+		log.Warn("clustering key field not found", zap.Int64("fieldID", t.plan.GetClusteringFieldID()))
+		return nil, errIllegalCompactionPlan
+	}
+	clusteringFieldID := clusteringKey.GetFieldID()
+	if dim <= 0 {
+		dim = 1
+	}
+	maxRowsPerBinlog := int(Params.DataNodeCfg.FlushInsertBufferSize / (int64(dim) * 4))
+
+	// 1st pass, decide the bounds of the clustering key ranges by a sample of the keys
+	sampler, keyBlobs, err := t.sampleClusteringKeys(ctxTimeout, unMergedInsertlogs, meta, clusteringKey)
+	if err != nil {
+		return nil, err
+	}
+	bounds := splitClusteringKeys(sampler.samples, sampler.numRows, t.plan.GetMaxSegmentRows())
+
+	// 2nd pass, write the rows into the segments of their ranges
+	deletedPks := make(map[interface{}]int)
+	for _, pk := range deltaBuf.delData.Pks {
+		deletedPks[pk.GetValue()] = -1
+	}
+
+	// the rows buffered in all the buckets are limited to the rows of a binlog, the largest buffer is uploaded
+	// when the limit is reached
+	var bufferedRows int
+	buckets := make([]*clusteringBucket, len(bounds)+1)
+	upload := func(bucket *clusteringBucket) error {
+		keyData, err := interface2FieldData(clusteringKey.GetDataType(), bucket.fID2Content[clusteringFieldID],
+			int64(bucket.currentRows), nullableFields[clusteringFieldID])
+		if err != nil {
+			return err
+		}
+		if err := bucket.keyRange.Update(keyData); err != nil {
+			return err
+		}
+		inPaths, statsPaths, err := t.uploadSingleInsertLog(ctxTimeout, bucket.segmentID, partID, meta, bucket.fID2Content, fID2Type)
+		if err != nil {
+			return err
+		}
+		for fID, path := range inPaths {
+			if binlog, ok := bucket.insertField2Path[fID]; ok {
+				binlog.Binlogs = append(binlog.Binlogs, path.GetBinlogs()...)
+			} else {
+				bucket.insertField2Path[fID] = path
+			}
+		}
+		for fID, path := range statsPaths {
+			if binlog, ok := bucket.statField2Path[fID]; ok {
+				binlog.Binlogs = append(binlog.Binlogs, path.GetBinlogs()...)
+			} else {
+				bucket.statField2Path[fID] = path
+			}
+		}
+		bucket.numRows += int64(bucket.currentRows)
+		bufferedRows -= bucket.currentRows
+		bucket.currentRows = 0
+		bucket.fID2Content = make(map[UniqueID][]interface{})
+		return nil
+	}
+
+	expired, err := t.iterateRows(ctxTimeout, unMergedInsertlogs, keyBlobs, meta, delta, func(pk interface{}, row map[UniqueID]interface{}) error {
+		key, err := normalizeClusteringKey(row[clusteringFieldID])
+		if err != nil {
+			return err
+		}
+		idx := locateClusteringBucket(bounds, key)
+		if buckets[idx] == nil {
+			segmentID, err := t.allocID()
+			if err != nil {
+				return err
+			}
+			buckets[idx] = newClusteringBucket(segmentID, clusteringKey)
+		}
+		bucket := buckets[idx]
+		if _, ok := deletedPks[pk]; ok {
+			deletedPks[pk] = idx
+		}
+
+		for fID, value := range row {
+			bucket.fID2Content[fID] = append(bucket.fID2Content[fID], value)
+		}
+		bucket.currentRows++
+		bufferedRows++
+		if bufferedRows < maxRowsPerBinlog {
+			return nil
+		}
+		largest := bucket
+		for _, b := range buckets {
+			if b != nil && b.currentRows > largest.currentRows {
+				largest = b
+			}
+		}
+		return upload(largest)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// route the deletions to the segments of the deleted rows, the ones of rows out of these segments are dropped
+	for i, pk := range deltaBuf.delData.Pks {
+		idx := deletedPks[pk.GetValue()]
+		if idx < 0 {
+			continue
+		}
+		ts := deltaBuf.delData.Tss[i]
+		buf := buckets[idx].deltaBuf
+		buf.delData.Append(pk, ts)
+		buf.updateTimeRange(TimeRange{timestampMin: ts, timestampMax: ts})
+	}
+
+	var segments []*datapb.CompactionSegment
+	for _, bucket := range buckets {
+		if bucket == nil {
+			continue
+		}
+		if bucket.currentRows > 0 {
+			if err := upload(bucket); err != nil {
+				return nil, err
+			}
+		}
+
+		deltaInfo, err := t.uploadDeltaLog(ctxTimeout, bucket.segmentID, partID, bucket.deltaBuf.delData, meta)
+		if err != nil {
+			return nil, err
+		}
+		for _, fbl := range deltaInfo {
+			for _, deltaLogInfo := range fbl.GetBinlogs() {
+				deltaLogInfo.TimestampFrom = bucket.deltaBuf.GetTimestampFrom()
+				deltaLogInfo.TimestampTo = bucket.deltaBuf.GetTimestampTo()
+			}
+		}
+
+		keyRange := bucket.keyRange.ToProto()
+		// the range is enough for pruning, leave the sketch out of segment meta
+		keyRange.DistinctSketch = nil
+		segment := &datapb.CompactionSegment{
+			SegmentID:          bucket.segmentID,
+			NumOfRows:          bucket.numRows,
+			Deltalogs:          deltaInfo,
+			ClusteringKeyRange: keyRange,
+		}
+		for _, path := range bucket.insertField2Path {
+			segment.InsertLogs = append(segment.InsertLogs, path)
+		}
+		for _, path := range bucket.statField2Path {
+			segment.Field2StatslogPaths = append(segment.Field2StatslogPaths, path)
+		}
+		segments = append(segments, segment)
+	}
+
+	log.Info("clustering merge end",
+		zap.Int64("clusteringFieldID", clusteringFieldID),
+		zap.Int("num of segments", len(segments)),
+		zap.Int64("expired entities", expired),
+		zap.Float64("merge elapse in ms", nano2Milli(time.Since(mergeStart))))
+
+	return segments, nil
+}
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// incAllocator allocates increasing ids, so that the result segments of a clustering compaction are distinct
+type incAllocator struct {
+	*AllocatorFactory
+	next UniqueID
+}
+
+func (alloc *incAllocator) allocID() (UniqueID, error) {
+	alloc.next++
+	return alloc.next, nil
+}
+
+func TestSplitClusteringKeys(t *testing.T) {
+	keys := []interface{}{int64(5), int64(1), int64(3), int64(3), int64(3), int64(2), int64(4)}
+	assert.Nil(t, splitClusteringKeys(keys, 7, 0))
+	assert.Nil(t, splitClusteringKeys(keys, 7, 7))
+
+	// sorted: 1 2 3 3 3 4 5, the duplicates of 3 stay in one range
+	bounds := splitClusteringKeys(keys, 7, 2)
+	assert.Equal(t, []interface{}{int64(2), int64(3), int64(4)}, bounds)
+	assert.Equal(t, 0, locateClusteringBucket(bounds, int64(1)))
+	assert.Equal(t, 2, locateClusteringBucket(bounds, int64(3)))
+	assert.Equal(t, 3, locateClusteringBucket(bounds, int64(5)))
+	assert.Equal(t, 3, locateClusteringBucket(bounds, nil))
+
+	// the samples stand for more keys
+	assert.Equal(t, []interface{}{int64(2), int64(3), int64(4)}, splitClusteringKeys(keys, 70, 20))
+
+	// the duplicates of the first key don't make an empty range
+	assert.Equal(t, []interface{}{int64(2)}, splitClusteringKeys([]interface{}{int64(1), int64(1), int64(1), int64(2)}, 4, 1))
+
+	strKeys := []interface{}{"b", "a", "d", "c"}
+	assert.Equal(t, []interface{}{"c"}, splitClusteringKeys(strKeys, 4, 2))
+
+	sampler := newClusteringKeySampler(1)
+	for i := 0; i < clusteringSampleSize*3; i++ {
+		sampler.add(int64(i))
+	}
+	assert.EqualValues(t, clusteringSampleSize*3, sampler.numRows)
+	assert.Equal(t, clusteringSampleSize, len(sampler.samples))
+	bounds = splitClusteringKeys(sampler.samples, sampler.numRows, clusteringSampleSize)
+	require.Equal(t, 2, len(bounds))
+	assert.InDelta(t, clusteringSampleSize, bounds[0].(int64), clusteringSampleSize/10)
+	assert.InDelta(t, clusteringSampleSize*2, bounds[1].(int64), clusteringSampleSize/10)
+
+	key, err := normalizeClusteringKey(int8(3))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), key)
+	key, err = normalizeClusteringKey(float32(1.5))
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1.5), key)
+	_, err = normalizeClusteringKey(true)
+	assert.Error(t, err)
+}
+
+func TestCompactionTask_ClusteringMerge(t *testing.T) {
+	collectionID := int64(1)
+	meta := NewMetaFactory().GetCollectionMeta(collectionID, "test", schemapb.DataType_Int64)
+	rc := &mocks.RootCoord{}
+	rc.EXPECT().DescribeCollection(mock.Anything, mock.Anything).
+		Return(&milvuspb.DescribeCollectionResponse{
+			Schema: meta.GetSchema(),
+		}, nil)
+	channel := newChannel("a", collectionID, meta.GetSchema(), rc, nil)
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(compactTestDir))
+	defer cm.RemoveWithPrefix(context.Background(), cm.RootPath())
+	mockbIO := &binlogIO{cm, NewAllocatorFactory(1)}
+	inpath, _, err := mockbIO.uploadInsertLog(context.Background(), 1, 0, genInsertDataWithExpiredTS(), meta)
+	require.NoError(t, err)
+	var ps []string
+	for _, path := range inpath {
+		ps = append(ps, path.GetBinlogs()[0].GetLogPath())
+	}
+
+	// the row of pk 2 is deleted after the time travel point
+	deltaBuf := newDelDataBuf()
+	deltaBuf.delData.Append(storage.NewInt64PrimaryKey(2), 30000)
+	deltaBuf.delData.Append(storage.NewInt64PrimaryKey(3), 30000)
+
+	ct := &compactionTask{
+		Channel:            channel,
+		downloader:         mockbIO,
+		uploader:           mockbIO,
+		allocatorInterface: &incAllocator{AllocatorFactory: NewAllocatorFactory(1), next: 100},
+		plan: &datapb.CompactionPlan{
+			PlanID:            1,
+			Type:              datapb.CompactionType_ClusteringCompaction,
+			ClusteringFieldID: 105,
+			MaxSegmentRows:    1,
+		},
+	}
+	segments, err := ct.clusteringMerge(context.Background(), [][]string{ps}, 0, meta, map[interface{}]Timestamp{}, deltaBuf)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(segments))
+
+	assert.EqualValues(t, 101, segments[0].GetSegmentID())
+	assert.EqualValues(t, 1, segments[0].GetNumOfRows())
+	assert.EqualValues(t, 9, segments[0].GetClusteringKeyRange().GetIntMin())
+	assert.EqualValues(t, 9, segments[0].GetClusteringKeyRange().GetIntMax())
+	assert.Empty(t, segments[0].GetDeltalogs())
+
+	assert.EqualValues(t, 102, segments[1].GetSegmentID())
+	assert.EqualValues(t, 1, segments[1].GetNumOfRows())
+	assert.EqualValues(t, 10, segments[1].GetClusteringKeyRange().GetIntMin())
+	assert.EqualValues(t, 10, segments[1].GetClusteringKeyRange().GetIntMax())
+	require.Equal(t, 1, len(segments[1].GetDeltalogs()))
+	assert.EqualValues(t, 1, segments[1].GetDeltalogs()[0].GetBinlogs()[0].GetEntriesNum())
+
+	// unknown clustering key
+	ct.plan.ClusteringFieldID = 999
+	_, err = ct.clusteringMerge(context.Background(), [][]string{ps}, 0, meta, map[interface{}]Timestamp{}, deltaBuf)
+	assert.Error(t, err)
+}
@@ -117,11 +117,11 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 		for _, test := range tests {
 			t.Run(test.description, func(t *testing.T) {
 				if test.isvalid {
-					fd, err := interface2FieldData(test.tp, test.content, 2)
+					fd, err := interface2FieldData(test.tp, test.content, 2, false)
 					assert.NoError(t, err)
 					assert.Equal(t, 2, fd.RowNum())
 				} else {
-					fd, err := interface2FieldData(test.tp, test.content, 2)
+					fd, err := interface2FieldData(test.tp, test.content, 2, false)
 					assert.Error(t, err)
 					assert.Nil(t, fd)
 				}
@@ -130,6 +130,21 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 
 	})
 
+	t.Run("Test.interface2FieldData with null rows", func(t *testing.T) {
+		fd, err := interface2FieldData(schemapb.DataType_Int64, []interface{}{int64(1), nil}, 2, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, fd.RowNum())
+		assert.Equal(t, []int64{1, 0}, fd.(*storage.Int64FieldData).Data)
+		assert.Equal(t, []bool{true, false}, fd.GetValidData())
+
+		fd, err = interface2FieldData(schemapb.DataType_VarChar, []interface{}{"a", "b"}, 2, true)
+		assert.NoError(t, err)
+		assert.Nil(t, fd.GetValidData())
+
+		_, err = interface2FieldData(schemapb.DataType_FloatVector, []interface{}{nil}, 1, true)
+		assert.Error(t, err)
+	})
+
 	t.Run("Test mergeDeltalogs", func(t *testing.T) {
 		t.Run("One segment with timetravel", func(t *testing.T) {
 			invalidBlobs := map[UniqueID][]*Blob{
@@ -300,7 +315,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, int64(2), numOfRow)
 			assert.Equal(t, 1, len(inPaths[0].GetBinlogs()))
-			assert.Equal(t, 1, len(statsPaths))
+			assert.Equal(t, 8, len(statsPaths))
 		})
 		t.Run("Merge without expiration2", func(t *testing.T) {
 			alloc := NewAllocatorFactory(1)
@@ -336,7 +351,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, int64(2), numOfRow)
 			assert.Equal(t, 2, len(inPaths[0].GetBinlogs()))
-			assert.Equal(t, 1, len(statsPaths))
+			assert.Equal(t, 8, len(statsPaths))
 			assert.Equal(t, 2, len(statsPaths[0].GetBinlogs()))
 		})
 
@@ -844,7 +859,7 @@ type mockFlushManager struct {
 
 var _ flushManager = (*mockFlushManager)(nil)
 
-func (mfm *mockFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) ([]*Blob, error) {
+func (mfm *mockFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) ([]*storage.PrimaryKeyStats, error) {
 	if mfm.returnError {
 		return nil, fmt.Errorf("mock error")
 	}
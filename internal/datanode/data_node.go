@@ -121,6 +121,7 @@ type DataNode struct {
 	clearSignal        chan string // vchannel name
 	segmentCache       *Cache
 	compactionExecutor *compactionExecutor
+	exportTasks        sync.Map // taskID -> *exportTask
 
 	etcdCli   *clientv3.Client
 	address   string
@@ -893,6 +894,20 @@ func (node *DataNode) GetCompactionState(ctx context.Context, req *datapb.Compac
 	}, nil
 }
 
+// CancelCompaction called by DataCoord, stop a compaction plan and drop its result
+func (node *DataNode) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	log.Ctx(ctx).Info("DataNode receives CancelCompaction", zap.Int64("planID", req.GetPlanID()))
+	if !node.isHealthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "DataNode is unhealthy",
+		}, nil
+	}
+
+	node.compactionExecutor.cancelTask(req.GetPlanID())
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 // SyncSegments called by DataCoord, sync the compacted segments' meta between DC and DN
 func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	log.Ctx(ctx).Info("DataNode receives SyncSegments",
@@ -939,6 +954,9 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 
 	// oneSegment is definitely in the channel, guaranteed by the check before.
 	collID, partID, _ := channel.getCollectionAndPartitionID(oneSegment)
+	if len(req.GetCompactedToSegments()) > 0 {
+		return node.syncClusteredSegments(ctx, req, ds, channel, collID, partID), nil
+	}
 	targetSeg := &Segment{
 		collectionID: collID,
 		partitionID:  partID,
@@ -964,6 +982,38 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 	return status, nil
 }
 
+// syncClusteredSegments replaces the compacted segments with the result segments of a clustering compaction
+func (node *DataNode) syncClusteredSegments(ctx context.Context, req *datapb.SyncSegmentsRequest,
+	ds *dataSyncService, channel Channel, collID, partID UniqueID) *commonpb.Status {
+	status := &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError}
+
+	targetSegs := make([]*Segment, 0, len(req.GetCompactedToSegments()))
+	for _, s := range req.GetCompactedToSegments() {
+		targetSeg := &Segment{
+			collectionID: collID,
+			partitionID:  partID,
+			segmentID:    s.GetSegmentID(),
+			numRows:      s.GetNumOfRows(),
+		}
+		if err := channel.InitPKstats(ctx, targetSeg, s.GetField2StatslogPaths(), tsoutil.GetCurrentTime()); err != nil {
+			status.Reason = fmt.Sprintf("init pk stats fail, err=%s", err.Error())
+			return status
+		}
+		targetSegs = append(targetSegs, targetSeg)
+	}
+
+	// block all flow graph so it's safe to remove segment
+	ds.fg.Blockall()
+	defer ds.fg.Unblock()
+	if err := channel.mergeClusteredSegments(targetSegs, req.GetPlanID(), req.GetCompactedFrom()); err != nil {
+		status.Reason = err.Error()
+		return status
+	}
+
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status
+}
+
 // Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest) (*commonpb.Status, error) {
 	log.Info("DataNode receive import request",
@@ -1032,7 +1082,8 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 		msg := "DataNode alloc ts failed"
 		log.Warn(msg)
 		importResult.State = commonpb.ImportState_ImportFailed
-		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: msg})
+		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: msg},
+			importutil.RetryableInfo())
 		if reportErr := reportFunc(importResult); reportErr != nil {
 			log.Warn("fail to report import state to RootCoord", zap.Error(reportErr))
 		}
@@ -1055,7 +1106,8 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 			zap.Int64("collection ID", req.GetImportTask().GetCollectionId()),
 			zap.Error(err))
 		importResult.State = commonpb.ImportState_ImportFailed
-		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: err.Error()})
+		importResult.Infos = append(importResult.Infos, &commonpb.KeyValuePair{Key: "failed_reason", Value: err.Error()},
+			importutil.RetryableInfo())
 		reportErr := reportFunc(importResult)
 		if reportErr != nil {
 			log.Warn("fail to report import state to RootCoord", zap.Error(err))
@@ -1096,8 +1148,26 @@ func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTaskRequest)
 		return returnFailFunc(err)
 	}
 	log.Info("import time range", zap.Uint64("start_ts", tsStart), zap.Uint64("end_ts", tsEnd))
+	csvOptions, err := importutil.ParseCSVFromOptions(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	maxBadRows, err := importutil.ParseMaxBadRows(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	validateOnly := importutil.IsValidateOnly(req.GetImportTask().GetInfos())
+	source, err := importutil.ParseSourceFromOptions(req.GetImportTask().GetInfos())
+	if err != nil {
+		return returnFailFunc(err)
+	}
+	if source != nil {
+		source.AllowedLocalPaths = Params.DataNodeCfg.ImportAllowedLocalPaths
+	}
 	err = importWrapper.Import(req.GetImportTask().GetFiles(),
-		importutil.ImportOptions{OnlyValidate: false, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup})
+		importutil.ImportOptions{OnlyValidate: validateOnly, TsStartPoint: tsStart, TsEndPoint: tsEnd, IsBackup: isBackup,
+			MaxBadRows: maxBadRows, CSV: csvOptions, Source: source,
+			Chunk: importutil.ImportChunk{Index: req.GetImportTask().GetChunkIndex(), Count: req.GetImportTask().GetChunkCount()}})
 	if err != nil {
 		return returnFailFunc(err)
 	}
@@ -1197,6 +1267,65 @@ func (node *DataNode) AddImportSegment(ctx context.Context, req *datapb.AddImpor
 	}, nil
 }
 
+// Export starts an export task from DataCoord and returns immediately,
+// the progress and the result of the task are reported to DataCoord.
+func (node *DataNode) Export(ctx context.Context, req *datapb.ExportTask) (*commonpb.Status, error) {
+	log.Info("DataNode receives export task", zap.Int64("jobID", req.GetJobID()), zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("collectionID", req.GetCollectionID()), zap.Int("segmentNum", len(req.GetSegmentBinlogs())),
+		zap.String("format", req.GetFormat().String()))
+	if !node.isHealthy() {
+		log.Warn("DataNode export failed", zap.Int64("taskID", req.GetTaskID()),
+			zap.Error(errDataNodeIsUnhealthy(paramtable.GetNodeID())))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataNodeIsUnhealthy(paramtable.GetNodeID()),
+		}, nil
+	}
+
+	reportFunc := func(result *datapb.ExportTaskResult) error {
+		status, err := node.dataCoord.ReportExport(context.Background(), result)
+		if err != nil {
+			return err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(status.GetReason())
+		}
+		return nil
+	}
+	task := newExportTask(node.ctx, node.chunkManager, req, reportFunc)
+	// DataCoord resends the task if it doesn't know whether the last request arrived
+	if old, loaded := node.exportTasks.Load(req.GetTaskID()); loaded {
+		old.(*exportTask).stop()
+	}
+	node.exportTasks.Store(req.GetTaskID(), task)
+	go func() {
+		task.execute()
+		if current, ok := node.exportTasks.Load(req.GetTaskID()); ok && current == task {
+			node.exportTasks.Delete(req.GetTaskID())
+		}
+	}()
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
+// CancelExport stops the executing export tasks of a job
+func (node *DataNode) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	log.Info("DataNode receives cancel export", zap.Int64("jobID", req.GetJobID()))
+	node.exportTasks.Range(func(key, value interface{}) bool {
+		task := value.(*exportTask)
+		if task.getJobID() == req.GetJobID() {
+			task.stop()
+			node.exportTasks.Delete(key)
+		}
+		return true
+	})
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func assignSegmentFunc(node *DataNode, req *datapb.ImportTaskRequest) importutil.AssignSegmentFunc {
 	return func(shardID int) (int64, string, error) {
 		chNames := req.GetImportTask().GetChannelNames()
@@ -1409,7 +1538,12 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 		ID:     colID,
 		Schema: schema,
 	}
-	binLogs, statsBinLogs, err := storage.NewInsertCodec(meta).Serialize(partID, segmentID, data.buffer)
+	inCodec := storage.NewInsertCodec(meta)
+	serialize := inCodec.SerializeWithPkStats
+	if Params.DataNodeCfg.ColumnarFormat {
+		serialize = inCodec.SerializeColumnar
+	}
+	binLogs, statsBinLogs, _, err := serialize(partID, segmentID, data.buffer)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1437,6 +1571,20 @@ func createBinLogs(rowNum int, schema *schemapb.CollectionSchema, ts Timestamp,
 
 		key := path.Join(node.chunkManager.RootPath(), common.SegmentInsertLogPath, k)
 		kvs[key] = blob.Value[:]
+		if fieldID == common.ColumnarLogFieldID {
+			// every field refers to the columnar log holding all of them
+			for fieldID, fieldData := range fields {
+				field2Insert[fieldID] = &datapb.Binlog{
+					EntriesNum:    data.size,
+					TimestampFrom: ts,
+					TimestampTo:   ts,
+					LogPath:       key,
+					LogSize:       int64(fieldData.GetMemorySize()),
+				}
+				field2Logidx[fieldID] = logidx
+			}
+			continue
+		}
 		field2Insert[fieldID] = &datapb.Binlog{
 			EntriesNum:    data.size,
 			TimestampFrom: ts,
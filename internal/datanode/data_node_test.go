@@ -210,6 +210,20 @@ func TestDataNode(t *testing.T) {
 		node.UpdateStateCode(commonpb.StateCode_Healthy)
 	})
 
+	t.Run("Test CancelCompaction", func(t *testing.T) {
+		node.compactionExecutor.completed.Store(int64(4), &datapb.CompactionResult{PlanID: 4})
+		status, err := node.CancelCompaction(node.ctx, &datapb.CancelCompactionRequest{PlanID: 4})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		_, ok := node.compactionExecutor.completed.Load(int64(4))
+		assert.False(t, ok)
+
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		status, _ = node.CancelCompaction(ctx, &datapb.CancelCompactionRequest{PlanID: 4})
+		assert.Equal(t, "DataNode is unhealthy", status.GetReason())
+		node.UpdateStateCode(commonpb.StateCode_Healthy)
+	})
+
 	t.Run("Test FlushSegments", func(t *testing.T) {
 		dmChannelName := "fake-by-dev-rootcoord-dml-channel-test-FlushSegments"
 
@@ -1211,3 +1225,39 @@ func TestDataNode_ResendSegmentStats(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 	assert.ElementsMatch(t, []UniqueID{0, 1, 2}, resp.GetSegResent())
 }
+
+func TestDataNode_Export(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node := newIDLEDataNodeMock(ctx, schemapb.DataType_Int64)
+	node.chunkManager = storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	req := &datapb.ExportTask{
+		JobID:        1,
+		TaskID:       2,
+		Schema:       NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64).GetSchema(),
+		TargetPrefix: "export/1",
+	}
+
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	status, err := node.Export(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	status, err = node.Export(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Eventually(t, func() bool {
+		_, ok := node.exportTasks.Load(req.GetTaskID())
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	task := newExportTask(ctx, node.chunkManager, req, nil)
+	node.exportTasks.Store(req.GetTaskID(), task)
+	status, err = node.CancelExport(ctx, &datapb.CancelExportRequest{JobID: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	assert.Error(t, task.ctx.Err())
+	_, ok := node.exportTasks.Load(req.GetTaskID())
+	assert.False(t, ok)
+}
@@ -0,0 +1,318 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/commonpbutil"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const exportReportRetryTimes = 10
+
+// exportFileMaxSize is the approximate max size in bytes of an export file, the rows of a segment are split into
+// several files beyond it.
+var exportFileMaxSize = 256 * 1024 * 1024
+
+// exportTask reads the rows of the flushed segments visible at the export timestamp, and writes the files of each segment.
+type exportTask struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	chunkManager storage.ChunkManager
+	task         *datapb.ExportTask
+	// report sends the progress or the result of the task to DataCoord
+	report func(result *datapb.ExportTaskResult) error
+}
+
+func newExportTask(ctx context.Context, chunkManager storage.ChunkManager, task *datapb.ExportTask,
+	report func(result *datapb.ExportTaskResult) error) *exportTask {
+	ctx1, cancel := context.WithCancel(ctx)
+	return &exportTask{
+		ctx:          ctx1,
+		cancel:       cancel,
+		chunkManager: chunkManager,
+		task:         task,
+		report:       report,
+	}
+}
+
+func (t *exportTask) getJobID() UniqueID {
+	return t.task.GetJobID()
+}
+
+func (t *exportTask) getTaskID() UniqueID {
+	return t.task.GetTaskID()
+}
+
+func (t *exportTask) stop() {
+	t.cancel()
+}
+
+// download reads the log files directly, unlike the binlogIO of compaction it neither retries
+// on missing files nor takes the compaction bandwidth.
+func (t *exportTask) download(paths []string) ([]*Blob, error) {
+	values, err := t.chunkManager.MultiRead(t.ctx, paths)
+	if err != nil {
+		return nil, err
+	}
+	blobs := make([]*Blob, 0, len(values))
+	for i, value := range values {
+		blobs = append(blobs, &Blob{Key: paths[i], Value: value})
+	}
+	return blobs, nil
+}
+
+// execute exports the segments one by one, the progress is reported after each segment.
+func (t *exportTask) execute() {
+	log := log.With(zap.Int64("jobID", t.getJobID()), zap.Int64("taskID", t.getTaskID()))
+	log.Info("export task start", zap.Int("segmentNum", len(t.task.GetSegmentBinlogs())),
+		zap.Uint64("timestamp", t.task.GetTimestamp()), zap.String("targetPrefix", t.task.GetTargetPrefix()))
+	start := time.Now()
+
+	result := &datapb.ExportTaskResult{
+		Base:   commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		JobID:  t.getJobID(),
+		TaskID: t.getTaskID(),
+		State:  datapb.ExportState_ExportExecuting,
+	}
+	for i, segment := range t.task.GetSegmentBinlogs() {
+		files, rowCount, err := t.exportSegment(segment)
+		if err != nil {
+			if !funcutil.CheckCtxValid(t.ctx) {
+				log.Info("export task canceled")
+				return
+			}
+			log.Warn("failed to export segment", zap.Int64("segmentID", segment.GetSegmentID()), zap.Error(err))
+			result.State = datapb.ExportState_ExportFailed
+			result.Reason = err.Error()
+			break
+		}
+		result.SegmentIDs = append(result.SegmentIDs, segment.GetSegmentID())
+		result.RowCount += rowCount
+		result.Files = append(result.Files, files...)
+		if i < len(t.task.GetSegmentBinlogs())-1 {
+			// progress is not critical, the final result is reported with retry
+			if err := t.report(result); err != nil {
+				log.Warn("failed to report export progress", zap.Error(err))
+			}
+		}
+	}
+	if result.State != datapb.ExportState_ExportFailed {
+		result.State = datapb.ExportState_ExportCompleted
+	}
+
+	err := retry.Do(t.ctx, func() error {
+		return t.report(result)
+	}, retry.Attempts(exportReportRetryTimes))
+	if err != nil {
+		log.Warn("failed to report export result", zap.Error(err))
+		return
+	}
+	log.Info("export task done", zap.String("state", result.GetState().String()), zap.Int64("rowCount", result.GetRowCount()),
+		zap.Strings("files", result.GetFiles()), zap.Duration("elapse", time.Since(start)))
+}
+
+// exportSegment writes the rows inserted and not deleted until the export timestamp into files named by the segment ID
+// and the part number, a new part is started when the content exceeds exportFileMaxSize so that a segment is never
+// held in memory as a whole.
+func (t *exportTask) exportSegment(segment *datapb.CompactionSegmentBinlogs) ([]string, int64, error) {
+	schema := t.task.GetSchema()
+	var (
+		pkID        UniqueID
+		pkType      schemapb.DataType
+		fID2Default = make(map[UniqueID]interface{})
+	)
+	for _, fs := range schema.GetFields() {
+		defaultValue, hasDefault, err := typeutil.GetDefaultValue(fs)
+		if err != nil {
+			return nil, 0, err
+		}
+		if hasDefault {
+			fID2Default[fs.GetFieldID()] = defaultValue
+		} else if typeutil.IsFieldNullable(fs) {
+			fID2Default[fs.GetFieldID()] = nil
+		}
+		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
+			pkID = fs.GetFieldID()
+			pkType = fs.GetDataType()
+		}
+	}
+	if pkID == 0 {
+		return nil, 0, errors.New("collection schema has no primary key")
+	}
+
+	delta, err := t.loadDeletions(segment)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		files    []string
+		rowCount int64
+		partRows int64
+	)
+	writer, err := newExportWriter(t.task.GetFormat(), schema)
+	if err != nil {
+		return nil, 0, err
+	}
+	uploadPart := func() error {
+		content, err := writer.finish()
+		if err != nil {
+			return err
+		}
+		file := path.Join(t.task.GetTargetPrefix(),
+			fmt.Sprintf("%d_%d%s", segment.GetSegmentID(), len(files), exportFileExt(t.task.GetFormat())))
+		if err := t.chunkManager.Write(t.ctx, file, content); err != nil {
+			return err
+		}
+		files = append(files, file)
+		partRows = 0
+		return nil
+	}
+	for _, paths := range groupInsertLogs(segment) {
+		blobs, err := t.download(paths)
+		if err != nil {
+			return nil, 0, err
+		}
+		iter, err := storage.NewInsertBinlogIterator(blobs, pkID, pkType)
+		if err != nil {
+			return nil, 0, err
+		}
+		for iter.HasNext() {
+			vInter, _ := iter.Next()
+			v, ok := vInter.(*storage.Value)
+			if !ok {
+				return nil, 0, errors.New("unexpected value type of insert binlog iterator")
+			}
+			ts := Timestamp(v.Timestamp)
+			if ts > t.task.GetTimestamp() {
+				continue
+			}
+			if deleteTs, ok := delta[v.PK.GetValue()]; ok && ts <= deleteTs {
+				continue
+			}
+			row, ok := v.Value.(map[UniqueID]interface{})
+			if !ok {
+				return nil, 0, errors.New("unexpected row type of insert binlog iterator")
+			}
+			// rows written before fields were added to the collection take the default value or null
+			for fID, value := range fID2Default {
+				if _, ok := row[fID]; !ok {
+					row[fID] = value
+				}
+			}
+			if err := writer.write(row); err != nil {
+				return nil, 0, err
+			}
+			rowCount++
+			partRows++
+			if writer.size() >= exportFileMaxSize {
+				if err := uploadPart(); err != nil {
+					return nil, 0, err
+				}
+				if writer, err = newExportWriter(t.task.GetFormat(), schema); err != nil {
+					return nil, 0, err
+				}
+			}
+		}
+	}
+
+	// a segment without visible rows still has an empty file
+	if partRows > 0 || len(files) == 0 {
+		if err := uploadPart(); err != nil {
+			return nil, 0, err
+		}
+	}
+	log.Info("segment exported", zap.Int64("taskID", t.getTaskID()), zap.Int64("segmentID", segment.GetSegmentID()),
+		zap.Strings("files", files), zap.Int64("rowCount", rowCount), zap.Int("deletedPks", len(delta)))
+	return files, rowCount, nil
+}
+
+// loadDeletions returns the latest delete timestamp of each primary key deleted until the export timestamp.
+func (t *exportTask) loadDeletions(segment *datapb.CompactionSegmentBinlogs) (map[interface{}]Timestamp, error) {
+	delta := make(map[interface{}]Timestamp)
+	var paths []string
+	for _, d := range segment.GetDeltalogs() {
+		for _, l := range d.GetBinlogs() {
+			paths = append(paths, l.GetLogPath())
+		}
+	}
+	if len(paths) == 0 {
+		return delta, nil
+	}
+
+	blobs, err := t.download(paths)
+	if err != nil {
+		return nil, err
+	}
+	_, _, dData, err := storage.NewDeleteCodec().Deserialize(blobs)
+	if err != nil {
+		return nil, err
+	}
+	for i := int64(0); i < dData.RowCount; i++ {
+		pk, ts := dData.Pks[i].GetValue(), dData.Tss[i]
+		if ts <= t.task.GetTimestamp() && ts > delta[pk] {
+			delta[pk] = ts
+		}
+	}
+	return delta, nil
+}
+
+// groupInsertLogs returns the insert log paths of the segment grouped by flush, every group has a log of each field.
+func groupInsertLogs(segment *datapb.CompactionSegmentBinlogs) [][]string {
+	// fields added to the collection after some binlogs were flushed have fewer binlog files
+	var binlogNum int
+	for _, b := range segment.GetFieldBinlogs() {
+		if b != nil && len(b.GetBinlogs()) > binlogNum {
+			binlogNum = len(b.GetBinlogs())
+		}
+	}
+
+	groups := make([][]string, 0, binlogNum)
+	for idx := 0; idx < binlogNum; idx++ {
+		var ps []string
+		for _, f := range segment.GetFieldBinlogs() {
+			// the missing binlog files of an added field are the leading ones
+			offset := binlogNum - len(f.GetBinlogs())
+			if idx < offset {
+				continue
+			}
+			logPath := f.GetBinlogs()[idx-offset].GetLogPath()
+			// a columnar log holds all fields of the flush, download it once
+			if storage.IsColumnarLogPath(logPath) && funcutil.SliceContain(ps, logPath) {
+				continue
+			}
+			ps = append(ps, logPath)
+		}
+		groups = append(groups, ps)
+	}
+	return groups
+}
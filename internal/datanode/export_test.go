@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestExportTask(t *testing.T) {
+	ctx := context.Background()
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	meta := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64)
+	bIO := &binlogIO{cm, NewAllocatorFactory(1)}
+
+	// rows of pk 1 and 2 are inserted at ts 3 and 4, the row of pk 1 is deleted at ts 5
+	inpaths, _, err := bIO.uploadInsertLog(ctx, 10, 0, genInsertData(), meta)
+	require.NoError(t, err)
+	dData := &DeleteData{Pks: []primaryKey{storage.NewInt64PrimaryKey(1)}, Tss: []Timestamp{5}, RowCount: 1}
+	deltaLogs, err := bIO.uploadDeltaLog(ctx, 10, 0, dData, meta)
+	require.NoError(t, err)
+	segment := &datapb.CompactionSegmentBinlogs{SegmentID: 10, Deltalogs: deltaLogs}
+	for _, fieldBinlog := range inpaths {
+		segment.FieldBinlogs = append(segment.FieldBinlogs, fieldBinlog)
+	}
+
+	export := func(ts Timestamp, segments ...*datapb.CompactionSegmentBinlogs) []*datapb.ExportTaskResult {
+		var results []*datapb.ExportTaskResult
+		task := newExportTask(ctx, cm, &datapb.ExportTask{
+			JobID:          1,
+			TaskID:         2,
+			Schema:         meta.GetSchema(),
+			Timestamp:      ts,
+			Format:         datapb.ExportFormat_ExportJSON,
+			TargetPrefix:   "export/1",
+			SegmentBinlogs: segments,
+		}, func(result *datapb.ExportTaskResult) error {
+			results = append(results, &datapb.ExportTaskResult{
+				State:      result.GetState(),
+				SegmentIDs: append([]int64{}, result.GetSegmentIDs()...),
+				RowCount:   result.GetRowCount(),
+				Files:      append([]string{}, result.GetFiles()...),
+				Reason:     result.GetReason(),
+			})
+			return nil
+		})
+		task.execute()
+		return results
+	}
+	readPks := func(file string) []interface{} {
+		content, err := cm.Read(ctx, file)
+		require.NoError(t, err)
+		var doc map[string][]map[string]interface{}
+		require.NoError(t, json.Unmarshal(content, &doc))
+		var pks []interface{}
+		for _, row := range doc["rows"] {
+			pks = append(pks, row["int64_field"])
+		}
+		return pks
+	}
+
+	t.Run("deleted rows are skipped", func(t *testing.T) {
+		results := export(10, segment, &datapb.CompactionSegmentBinlogs{SegmentID: 11})
+		require.Equal(t, 2, len(results))
+		assert.Equal(t, datapb.ExportState_ExportExecuting, results[0].GetState())
+		assert.Equal(t, []int64{10}, results[0].GetSegmentIDs())
+		result := results[1]
+		assert.Equal(t, datapb.ExportState_ExportCompleted, result.GetState())
+		assert.Equal(t, []int64{10, 11}, result.GetSegmentIDs())
+		assert.Equal(t, int64(1), result.GetRowCount())
+		require.Equal(t, []string{path.Join("export/1", "10_0.json"), path.Join("export/1", "11_0.json")}, result.GetFiles())
+		assert.Equal(t, []interface{}{float64(2)}, readPks(result.GetFiles()[0]))
+		assert.Empty(t, readPks(result.GetFiles()[1]))
+	})
+
+	t.Run("rows after the timestamp are skipped", func(t *testing.T) {
+		results := export(3, segment)
+		require.Equal(t, 1, len(results))
+		assert.Equal(t, datapb.ExportState_ExportCompleted, results[0].GetState())
+		assert.Equal(t, int64(1), results[0].GetRowCount())
+		assert.Equal(t, []interface{}{float64(1)}, readPks(results[0].GetFiles()[0]))
+	})
+
+	t.Run("split into parts", func(t *testing.T) {
+		defer func(size int) { exportFileMaxSize = size }(exportFileMaxSize)
+		exportFileMaxSize = 1
+		results := export(4, segment)
+		require.Equal(t, 1, len(results))
+		assert.Equal(t, int64(2), results[0].GetRowCount())
+		require.Equal(t, []string{path.Join("export/1", "10_0.json"), path.Join("export/1", "10_1.json")}, results[0].GetFiles())
+		assert.Equal(t, []interface{}{float64(1)}, readPks(results[0].GetFiles()[0]))
+		assert.Equal(t, []interface{}{float64(2)}, readPks(results[0].GetFiles()[1]))
+	})
+
+	t.Run("missing binlogs", func(t *testing.T) {
+		bad := &datapb.CompactionSegmentBinlogs{SegmentID: 12, Deltalogs: []*datapb.FieldBinlog{{
+			Binlogs: []*datapb.Binlog{{LogPath: "not/exist"}},
+		}}}
+		results := export(10, bad)
+		require.Equal(t, 1, len(results))
+		assert.Equal(t, datapb.ExportState_ExportFailed, results[0].GetState())
+		assert.NotEmpty(t, results[0].GetReason())
+	})
+}
+
+func TestGroupInsertLogs(t *testing.T) {
+	segment := &datapb.CompactionSegmentBinlogs{FieldBinlogs: []*datapb.FieldBinlog{
+		{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "100/1"}, {LogPath: "100/2"}}},
+		// the field is added after the first flush
+		{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: "101/2"}}},
+	}}
+	assert.Equal(t, [][]string{{"100/1"}, {"100/2", "101/2"}}, groupInsertLogs(segment))
+}
@@ -0,0 +1,330 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// max number of rows in a row group of the exported parquet files
+	exportParquetRowGroupSize = 64 * 1024
+	// max size in bytes of the buffered rows of a row group of the exported parquet files
+	exportParquetRowGroupBytes = 16 * 1024 * 1024
+)
+
+// exportWriter converts the rows of a segment into the content of an export file,
+// the files are in the same layout as the ones accepted by bulk insert.
+type exportWriter interface {
+	// write appends a row, the values are keyed by field ID and nil is a null value
+	write(row map[UniqueID]interface{}) error
+	// size returns the approximate size in bytes of the file content written so far
+	size() int
+	// finish returns the content of the file
+	finish() ([]byte, error)
+}
+
+// newExportWriter returns the writer of the format, only the user fields of the schema are written.
+func newExportWriter(format datapb.ExportFormat, schema *schemapb.CollectionSchema) (exportWriter, error) {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			fields = append(fields, field)
+		}
+	}
+
+	switch format {
+	case datapb.ExportFormat_ExportJSON:
+		return newJSONExportWriter(fields), nil
+	case datapb.ExportFormat_ExportParquet:
+		return newParquetExportWriter(fields)
+	default:
+		return nil, fmt.Errorf("unsupported export format %s", format.String())
+	}
+}
+
+// exportFileExt returns the extension of the export files of the format.
+func exportFileExt(format datapb.ExportFormat) string {
+	if format == datapb.ExportFormat_ExportJSON {
+		return importutil.JSONFileExt
+	}
+	return importutil.ParquetFileExt
+}
+
+// jsonExportWriter writes the rows as {"rows": [{"field": value, ...}, ...]}, the keys are in the order of the schema.
+type jsonExportWriter struct {
+	fields []*schemapb.FieldSchema
+	buf    *bytes.Buffer
+	rows   int
+}
+
+func newJSONExportWriter(fields []*schemapb.FieldSchema) *jsonExportWriter {
+	w := &jsonExportWriter{
+		fields: fields,
+		buf:    new(bytes.Buffer),
+	}
+	w.buf.WriteString(`{"` + importutil.RowRootNode + `":[`)
+	return w
+}
+
+func (w *jsonExportWriter) write(row map[UniqueID]interface{}) error {
+	if w.rows > 0 {
+		w.buf.WriteByte(',')
+	}
+	w.buf.WriteByte('{')
+	for i, field := range w.fields {
+		value := row[field.GetFieldID()]
+		// a binary vector is a list of uint8, the default encoding of []byte is base64
+		if bytesValue, ok := value.([]byte); ok {
+			list := make([]int, 0, len(bytesValue))
+			for _, b := range bytesValue {
+				list = append(list, int(b))
+			}
+			value = list
+		}
+		name, err := json.Marshal(field.GetName())
+		if err != nil {
+			return err
+		}
+		content, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode value of field '%s', error: %w", field.GetName(), err)
+		}
+		if i > 0 {
+			w.buf.WriteByte(',')
+		}
+		w.buf.Write(name)
+		w.buf.WriteByte(':')
+		w.buf.Write(content)
+	}
+	w.buf.WriteByte('}')
+	w.rows++
+	return nil
+}
+
+func (w *jsonExportWriter) size() int {
+	return w.buf.Len()
+}
+
+func (w *jsonExportWriter) finish() ([]byte, error) {
+	w.buf.WriteString("]}")
+	return w.buf.Bytes(), nil
+}
+
+// parquetExportWriter writes a column for each field, float vectors are lists of float
+// and binary vectors are fixed size binaries of dim/8 bytes.
+type parquetExportWriter struct {
+	fields  []*schemapb.FieldSchema
+	builder *array.RecordBuilder
+	writer  *pqarrow.FileWriter
+	buf     *bytes.Buffer
+	// approximate size of the rows buffered in the builder
+	pending int
+}
+
+func newParquetExportWriter(fields []*schemapb.FieldSchema) (*parquetExportWriter, error) {
+	arrowFields := make([]arrow.Field, 0, len(fields))
+	for _, field := range fields {
+		dataType, err := exportArrowType(field)
+		if err != nil {
+			return nil, err
+		}
+		arrowFields = append(arrowFields, arrow.Field{
+			Name:     field.GetName(),
+			Type:     dataType,
+			Nullable: typeutil.IsFieldNullable(field),
+		})
+	}
+	arrowSchema := arrow.NewSchema(arrowFields, nil)
+
+	buf := new(bytes.Buffer)
+	writer, err := pqarrow.NewFileWriter(arrowSchema, buf, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	if err != nil {
+		return nil, err
+	}
+	return &parquetExportWriter{
+		fields:  fields,
+		builder: array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema),
+		writer:  writer,
+		buf:     buf,
+	}, nil
+}
+
+func exportArrowType(field *schemapb.FieldSchema) (arrow.DataType, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case schemapb.DataType_Int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case schemapb.DataType_Int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case schemapb.DataType_Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case schemapb.DataType_Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case schemapb.DataType_Float:
+		return arrow.PrimitiveTypes.Float32, nil
+	case schemapb.DataType_Double:
+		return arrow.PrimitiveTypes.Float64, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return arrow.BinaryTypes.String, nil
+	case schemapb.DataType_FloatVector:
+		return arrow.ListOf(arrow.PrimitiveTypes.Float32), nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := storage.GetDimFromParams(field.GetTypeParams())
+		if err != nil {
+			return nil, err
+		}
+		return &arrow.FixedSizeBinaryType{ByteWidth: dim / 8}, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field '%s'", field.GetDataType().String(), field.GetName())
+	}
+}
+
+func (w *parquetExportWriter) write(row map[UniqueID]interface{}) error {
+	for i, field := range w.fields {
+		if err := appendArrowValue(w.builder.Field(i), row[field.GetFieldID()]); err != nil {
+			return fmt.Errorf("failed to write value of field '%s', error: %w", field.GetName(), err)
+		}
+		w.pending += exportValueSize(row[field.GetFieldID()])
+	}
+	if w.builder.Field(0).Len() >= exportParquetRowGroupSize || w.pending >= exportParquetRowGroupBytes {
+		return w.flush()
+	}
+	return nil
+}
+
+// exportValueSize returns the approximate size in bytes of a value
+func exportValueSize(value interface{}) int {
+	switch v := value.(type) {
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	case []float32:
+		return len(v) * 4
+	default:
+		return 8
+	}
+}
+
+func appendArrowValue(builder array.Builder, value interface{}) error {
+	if value == nil {
+		builder.AppendNull()
+		return nil
+	}
+
+	ok := false
+	switch b := builder.(type) {
+	case *array.BooleanBuilder:
+		var v bool
+		if v, ok = value.(bool); ok {
+			b.Append(v)
+		}
+	case *array.Int8Builder:
+		var v int8
+		if v, ok = value.(int8); ok {
+			b.Append(v)
+		}
+	case *array.Int16Builder:
+		var v int16
+		if v, ok = value.(int16); ok {
+			b.Append(v)
+		}
+	case *array.Int32Builder:
+		var v int32
+		if v, ok = value.(int32); ok {
+			b.Append(v)
+		}
+	case *array.Int64Builder:
+		var v int64
+		if v, ok = value.(int64); ok {
+			b.Append(v)
+		}
+	case *array.Float32Builder:
+		var v float32
+		if v, ok = value.(float32); ok {
+			b.Append(v)
+		}
+	case *array.Float64Builder:
+		var v float64
+		if v, ok = value.(float64); ok {
+			b.Append(v)
+		}
+	case *array.StringBuilder:
+		var v string
+		if v, ok = value.(string); ok {
+			b.Append(v)
+		}
+	case *array.ListBuilder:
+		var v []float32
+		if v, ok = value.([]float32); ok {
+			b.Append(true)
+			b.ValueBuilder().(*array.Float32Builder).AppendValues(v, nil)
+		}
+	case *array.FixedSizeBinaryBuilder:
+		var v []byte
+		if v, ok = value.([]byte); ok {
+			b.Append(v)
+		}
+	}
+	if !ok {
+		return fmt.Errorf("unexpected value type %T for %T", value, builder)
+	}
+	return nil
+}
+
+// flush writes the buffered rows as a row group
+func (w *parquetExportWriter) flush() error {
+	record := w.builder.NewRecord()
+	defer record.Release()
+	if record.NumRows() == 0 {
+		return nil
+	}
+	w.pending = 0
+	return w.writer.Write(record)
+}
+
+func (w *parquetExportWriter) size() int {
+	return w.buf.Len() + w.pending
+}
+
+func (w *parquetExportWriter) finish() ([]byte, error) {
+	defer w.builder.Release()
+	if err := w.flush(); err != nil {
+		return nil, err
+	}
+	if err := w.writer.Close(); err != nil {
+		return nil, err
+	}
+	return w.buf.Bytes(), nil
+}
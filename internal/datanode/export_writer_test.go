@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func newExportTestSchema() *schemapb.CollectionSchema {
+	schema := NewMetaFactory().GetCollectionMeta(1, "test", schemapb.DataType_Int64).GetSchema()
+	// the int32 field is nullable
+	for _, field := range schema.GetFields() {
+		if field.GetDataType() == schemapb.DataType_Int32 {
+			field.TypeParams = append(field.TypeParams, &commonpb.KeyValuePair{Key: common.NullableKey, Value: "true"})
+		}
+	}
+	return schema
+}
+
+func newExportTestRow(i int) map[UniqueID]interface{} {
+	return map[UniqueID]interface{}{
+		0:   int64(i),
+		1:   uint64(i),
+		100: []float32{float32(i), 0.5},
+		101: []byte{uint8(i), 255, 0, 1},
+		102: i%2 == 0,
+		103: int8(i),
+		104: int16(i),
+		105: nil,
+		106: int64(i),
+		107: float32(i) + 0.25,
+		108: float64(i) + 0.5,
+		109: "row",
+	}
+}
+
+func TestExportWriter_JSON(t *testing.T) {
+	w, err := newExportWriter(datapb.ExportFormat_ExportJSON, newExportTestSchema())
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, w.write(newExportTestRow(i)))
+	}
+	assert.Positive(t, w.size())
+	content, err := w.finish()
+	require.NoError(t, err)
+
+	var doc map[string][]map[string]interface{}
+	require.NoError(t, json.Unmarshal(content, &doc))
+	rows := doc["rows"]
+	require.Equal(t, 2, len(rows))
+	assert.Equal(t, 10, len(rows[1]))
+	assert.NotContains(t, rows[1], "RowID")
+	assert.Equal(t, []interface{}{float64(1), 0.5}, rows[1]["float_vector_field"])
+	assert.Equal(t, []interface{}{float64(1), float64(255), float64(0), float64(1)}, rows[1]["binary_vector_field"])
+	assert.Equal(t, float64(1), rows[1]["int64_field"])
+	assert.Nil(t, rows[1]["int32_field"])
+	assert.Equal(t, "row", rows[1]["varChar_field"])
+	assert.Equal(t, ".json", exportFileExt(datapb.ExportFormat_ExportJSON))
+}
+
+func TestExportWriter_Parquet(t *testing.T) {
+	w, err := newExportWriter(datapb.ExportFormat_ExportParquet, newExportTestSchema())
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, w.write(newExportTestRow(i)))
+	}
+	assert.Positive(t, w.size())
+	content, err := w.finish()
+	require.NoError(t, err)
+
+	reader, err := file.NewParquetReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	defer reader.Close()
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	require.NoError(t, err)
+	table, err := fileReader.ReadTable(context.Background())
+	require.NoError(t, err)
+	defer table.Release()
+	assert.Equal(t, int64(3), table.NumRows())
+	assert.Equal(t, int64(10), table.NumCols())
+
+	for i := 0; i < int(table.NumCols()); i++ {
+		column := table.Column(i)
+		switch column.Name() {
+		case "int64_field":
+			assert.Equal(t, []int64{0, 1, 2}, column.Data().Chunk(0).(*array.Int64).Int64Values())
+		case "int32_field":
+			assert.Equal(t, 3, column.NullN())
+		case "binary_vector_field":
+			assert.Equal(t, []byte{2, 255, 0, 1}, column.Data().Chunk(0).(*array.FixedSizeBinary).Value(2))
+		case "float_vector_field":
+			assert.Equal(t, 0, column.NullN())
+		}
+	}
+	assert.Equal(t, ".parquet", exportFileExt(datapb.ExportFormat_ExportParquet))
+}
+
+func TestExportWriter_Error(t *testing.T) {
+	_, err := newExportWriter(datapb.ExportFormat(5), newExportTestSchema())
+	assert.Error(t, err)
+
+	w, err := newExportWriter(datapb.ExportFormat_ExportParquet, newExportTestSchema())
+	require.NoError(t, err)
+	row := newExportTestRow(0)
+	row[106] = "bad"
+	assert.Error(t, w.write(row))
+
+	schema := newExportTestSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 200, Name: "unknown", DataType: schemapb.DataType_None})
+	_, err = newExportWriter(datapb.ExportFormat_ExportParquet, schema)
+	assert.Error(t, err)
+}
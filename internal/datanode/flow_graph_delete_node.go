@@ -180,6 +180,18 @@ func (dn *deleteNode) updateCompactedSegments() {
 	compactedTo2From := dn.channel.listCompactedSegmentIDs()
 
 	for compactedTo, compactedFrom := range compactedTo2From {
+		// the data of segments compacted by a clustering compaction is split into several segments,
+		// route the buffered deletes to the ones which may contain the primary keys
+		if clusteredTo := dn.channel.getClusteredTo(compactedFrom[0]); len(clusteredTo) > 0 {
+			dn.routeClusteredDeletes(compactedFrom)
+			log.Info("update delBuf for clustered segments",
+				zap.Int64s("clusteredTo segmentIDs", clusteredTo),
+				zap.Int64s("compactedFrom segmentIDs", compactedFrom),
+			)
+			dn.channel.removeSegments(compactedFrom...)
+			continue
+		}
+
 		// if the compactedTo segment has 0 numRows, remove all segments related
 		if !dn.channel.hasSegment(compactedTo, true) {
 			for _, segID := range compactedFrom {
@@ -198,6 +210,29 @@ func (dn *deleteNode) updateCompactedSegments() {
 	}
 }
 
+// routeClusteredDeletes moves the buffered deletes of the compactedFrom segments to the segments
+// which may contain the primary keys by the bloom filter check
+func (dn *deleteNode) routeClusteredDeletes(compactedFrom []UniqueID) {
+	for _, segID := range compactedFrom {
+		delDataBuf, loaded := dn.delBufferManager.Load(segID)
+		if !loaded {
+			continue
+		}
+		dn.delBufferManager.Delete(segID)
+
+		_, partID, err := dn.channel.getCollectionAndPartitionID(segID)
+		if err != nil {
+			log.Warn("failed to get partition of compacted segment", zap.Int64("segmentID", segID), zap.Error(err))
+			continue
+		}
+		tr := TimeRange{timestampMin: delDataBuf.TimestampFrom, timestampMax: delDataBuf.TimestampTo}
+		segIDToPks, segIDToTss := dn.filterSegmentByPK(partID, delDataBuf.delData.Pks, delDataBuf.delData.Tss)
+		for targetID, pks := range segIDToPks {
+			dn.delBufferManager.StoreNewDeletes(targetID, pks, segIDToTss[targetID], tr, delDataBuf.startPos, delDataBuf.endPos)
+		}
+	}
+}
+
 func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange, startPos, endPos *internalpb.MsgPosition) ([]UniqueID, error) {
 	log.Debug("bufferDeleteMsg", zap.Any("primary keys", msg.PrimaryKeys), zap.String("vChannelName", dn.channelName))
 
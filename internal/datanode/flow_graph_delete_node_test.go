@@ -505,6 +505,49 @@ func TestFlowGraphDeleteNode_showDelBuf(t *testing.T) {
 	delNode.showDelBuf([]UniqueID{111, 112, 113}, 100)
 }
 
+func TestFlowGraphDeleteNode_updateClusteredSegments(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	cm := storage.NewLocalChunkManager(storage.RootPath(deleteNodeTestDir))
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	fm := NewRendezvousFlushManager(NewAllocatorFactory(), cm, nil, func(*segmentFlushPack) {}, emptyFlushAndDropFunc)
+	channel := &ChannelMeta{
+		collectionID: 1,
+		segments:     make(map[UniqueID]*Segment),
+	}
+	delNode, err := newDeleteNode(ctx, fm, make(chan string, 1), &nodeConfig{
+		channel:      channel,
+		allocator:    NewAllocatorFactory(),
+		vChannelName: "datanode-test-FlowGraphDeletenode-clustered",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, channel.addFlushedSegmentWithPKs(100, 1, 0, 2, &storage.Int64FieldData{Data: []int64{1, 2}}))
+	pks := []primaryKey{newInt64PrimaryKey(1), newInt64PrimaryKey(2)}
+	delNode.delBufferManager.StoreNewDeletes(100, pks, []Timestamp{10, 20}, TimeRange{timestampMin: 10, timestampMax: 20}, nil, nil)
+
+	// the rows of segment 100 are split into 201 and 202 by the clustering compaction
+	require.NoError(t, channel.mergeClusteredSegments([]*Segment{
+		{segmentID: 201, collectionID: 1, numRows: 1},
+		{segmentID: 202, collectionID: 1, numRows: 1},
+	}, 1, []UniqueID{100}))
+	channel.updateSegmentPKRange(201, &storage.Int64FieldData{Data: []int64{1}})
+	channel.updateSegmentPKRange(202, &storage.Int64FieldData{Data: []int64{2}})
+
+	delNode.updateCompactedSegments()
+	assert.False(t, channel.hasSegment(100, true))
+	_, ok := delNode.delBufferManager.Load(100)
+	assert.False(t, ok)
+
+	buf, ok := delNode.delBufferManager.Load(201)
+	require.True(t, ok)
+	assert.Equal(t, []primaryKey{newInt64PrimaryKey(1)}, buf.delData.Pks)
+	buf, ok = delNode.delBufferManager.Load(202)
+	require.True(t, ok)
+	assert.Equal(t, []primaryKey{newInt64PrimaryKey(2)}, buf.delData.Pks)
+}
+
 func TestFlowGraphDeleteNode_updateCompactedSegments(t *testing.T) {
 
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
@@ -29,6 +29,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
@@ -408,23 +409,16 @@ func (ibNode *insertBufferNode) Sync(fgMsg *flowGraphMsg, seg2Upload []UniqueID,
 			zap.Any("position", endPosition),
 			zap.String("channel", ibNode.channelName),
 		)
-		// use the flushed pk stats to take current stat
+		// use the flushed pk stats to take current stat, the bloom filter blocks are appended to the segment as is
 		var pkStats []*storage.PrimaryKeyStats
 		err := retry.Do(ibNode.ctx, func() error {
-			statBlobs, err := ibNode.flushManager.flushBufferData(task.buffer,
+			var err error
+			pkStats, err = ibNode.flushManager.flushBufferData(task.buffer,
 				task.segmentID,
 				task.flushed,
 				task.dropped,
 				endPosition)
-			if err != nil {
-				return err
-			}
-			pkStats, err = storage.DeserializeStats(statBlobs)
-			if err != nil {
-				log.Warn("failed to deserialize bloom filter files", zap.Error(err))
-				return err
-			}
-			return nil
+			return err
 		}, getFlowGraphRetryOpt())
 		if err != nil {
 			metrics.DataNodeFlushBufferCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.FailLabel).Inc()
@@ -514,6 +508,15 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 		log.Warn("Get schema wrong:", zap.Error(err))
 		return err
 	}
+	if hasUnknownField(collSchema, msg) {
+		log.Info("insert msg contains fields unknown to the cached schema, refresh schema",
+			zap.Int64("collectionID", collectionID), zap.String("channel", ibNode.channelName))
+		collSchema, err = ibNode.channel.refreshCollectionSchema(collectionID, msg.EndTs())
+		if err != nil {
+			log.Warn("Refresh schema wrong:", zap.Error(err))
+			return err
+		}
+	}
 
 	// load or store insertBuffer
 	var buffer *BufferData
@@ -541,6 +544,11 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 
 	// Maybe there are large write zoom if frequent insert requests are met.
 	buffer.buffer = storage.MergeInsertData(buffer.buffer, addedBuffer)
+	// rows buffered before fields were added take the default value or null of the added fields
+	if err := storage.FillMissingFields(collSchema, buffer.buffer); err != nil {
+		log.Warn("failed to fill missing fields of insert buffer", zap.Error(err))
+		return err
+	}
 
 	tsData, err := storage.GetTimestampFromInsertData(addedBuffer)
 	if err != nil {
@@ -562,6 +570,23 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, startP
 	return nil
 }
 
+// hasUnknownField returns whether the column based insert msg carries fields absent from the schema.
+func hasUnknownField(schema *schemapb.CollectionSchema, msg *msgstream.InsertMsg) bool {
+	if msg.IsRowBased() {
+		return false
+	}
+	fieldIDs := make(map[int64]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	for _, fieldData := range msg.GetFieldsData() {
+		if _, ok := fieldIDs[fieldData.GetFieldId()]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (ibNode *insertBufferNode) getTimestampRange(tsData *storage.Int64FieldData) TimeRange {
 	tr := TimeRange{
 		timestampMin: math.MaxUint64,
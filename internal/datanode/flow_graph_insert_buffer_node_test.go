@@ -669,7 +669,8 @@ func TestRollBF(t *testing.T) {
 
 		assert.Nil(t, seg.currentStat)
 		assert.True(t, len(seg.historyStats) == 1)
-		assert.True(t, seg.historyStats[0].PkFilter.Cap() < 100)
+		assert.Equal(t, 1, len(seg.historyStats[0].Blocks))
+		assert.True(t, seg.historyStats[0].Blocks[0].Cap() < 100)
 	})
 }
 
@@ -1053,6 +1054,25 @@ func TestInsertBufferNode_getTimestampRange(t *testing.T) {
 	}
 }
 
+func TestInsertBufferNode_hasUnknownField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101}},
+	}
+	msg := &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			Version:    internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{{FieldId: 100}, {FieldId: 101}},
+		},
+	}
+	assert.False(t, hasUnknownField(schema, msg))
+
+	msg.FieldsData = append(msg.FieldsData, &schemapb.FieldData{FieldId: 102})
+	assert.True(t, hasUnknownField(schema, msg))
+
+	msg.Version = internalpb.InsertDataVersion_RowBased
+	assert.False(t, hasUnknownField(schema, msg))
+}
+
 func TestInsertBufferNode_collectSegmentsToSync(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -46,7 +46,7 @@ import (
 // flushManager defines a flush manager signature
 type flushManager interface {
 	// notify flush manager insert buffer data
-	flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) ([]*Blob, error)
+	flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) ([]*storage.PrimaryKeyStats, error)
 	// notify flush manager del buffer data
 	flushDelData(data *DelDataBuf, segmentID UniqueID, pos *internalpb.MsgPosition) error
 	// injectFlush injects compaction or other blocking task before flush sync
@@ -65,6 +65,7 @@ type segmentFlushPack struct {
 	insertLogs map[UniqueID]*datapb.Binlog
 	statsLogs  map[UniqueID]*datapb.Binlog
 	deltaLogs  []*datapb.Binlog
+	fieldStats []*datapb.FieldStats
 	pos        *internalpb.MsgPosition
 	flushed    bool
 	dropped    bool
@@ -338,9 +339,9 @@ func (m *rendezvousFlushManager) handleDeleteTask(segmentID UniqueID, task flush
 	m.getFlushQueue(segmentID).enqueueDelFlush(task, deltaLogs, pos)
 }
 
-// flushBufferData notifies flush manager insert buffer data.
+// flushBufferData notifies flush manager insert buffer data, returns the pk stats written to the stats binlog.
 // This method will be retired on errors. Final errors will be propagated upstream and logged.
-func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) ([]*Blob, error) {
+func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID UniqueID, flushed bool, dropped bool, pos *internalpb.MsgPosition) ([]*storage.PrimaryKeyStats, error) {
 	tr := timerecord.NewTimeRecorder("flushDuration")
 	// empty flush
 	if data == nil || data.buffer == nil {
@@ -355,6 +356,10 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	if err != nil {
 		return nil, err
 	}
+	// fields may be added to the collection after the data was buffered
+	if err := storage.FillMissingFields(meta.GetSchema(), data.buffer); err != nil {
+		return nil, err
+	}
 	// get memory size of buffer data
 	fieldMemorySize := make(map[int64]int)
 	for fieldID, fieldData := range data.buffer.Data {
@@ -364,13 +369,21 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	// encode data and convert output data
 	inCodec := storage.NewInsertCodec(meta)
 
-	binLogs, statsBinlogs, err := inCodec.Serialize(partID, segmentID, data.buffer)
+	serialize := inCodec.SerializeWithPkStats
+	if Params.DataNodeCfg.ColumnarFormat {
+		serialize = inCodec.SerializeColumnar
+	}
+	binLogs, statsBinlogs, pkStats, err := serialize(partID, segmentID, data.buffer)
+	if err != nil {
+		return nil, err
+	}
+	fieldStatsBinlogs, fieldStats, err := inCodec.SerializeFieldStats(data.buffer)
 	if err != nil {
 		return nil, err
 	}
 
 	// binlogs
-	start, _, err := m.allocIDBatch(uint32(len(binLogs) + len(statsBinlogs)))
+	start, _, err := m.allocIDBatch(uint32(len(binLogs) + len(statsBinlogs) + len(fieldStatsBinlogs)))
 	if err != nil {
 		return nil, err
 	}
@@ -392,6 +405,19 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		// [rootPath]/[insert_log]/key
 		key := path.Join(m.ChunkManager.RootPath(), common.SegmentInsertLogPath, k)
 		kvs[key] = blob.Value[:]
+		if fieldID == common.ColumnarLogFieldID {
+			// every field refers to the columnar log holding all of them
+			for fieldID, memorySize := range fieldMemorySize {
+				field2Insert[fieldID] = &datapb.Binlog{
+					EntriesNum:    data.size,
+					TimestampFrom: data.tsFrom,
+					TimestampTo:   data.tsTo,
+					LogPath:       key,
+					LogSize:       int64(memorySize),
+				}
+			}
+			continue
+		}
 		field2Insert[fieldID] = &datapb.Binlog{
 			EntriesNum:    data.size,
 			TimestampFrom: data.tsFrom,
@@ -402,8 +428,8 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	}
 
 	field2Stats := make(map[UniqueID]*datapb.Binlog)
-	// write stats binlog
-	for idx, blob := range statsBinlogs {
+	// write stats binlog, field stats are written after pk stats under their own field ids
+	for idx, blob := range append(statsBinlogs, fieldStatsBinlogs...) {
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
 		if err != nil {
 			log.Error("Flush failed ... cannot parse string to fieldID ..", zap.Error(err))
@@ -426,13 +452,19 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		}
 	}
 
+	pbFieldStats := make([]*datapb.FieldStats, 0, len(fieldStats))
+	for _, stats := range fieldStats {
+		pbFieldStats = append(pbFieldStats, stats.ToProto())
+	}
+
 	m.handleInsertTask(segmentID, &flushBufferInsertTask{
 		ChunkManager: m.ChunkManager,
 		data:         kvs,
+		fieldStats:   pbFieldStats,
 	}, field2Insert, field2Stats, flushed, dropped, pos)
 
 	metrics.DataNodeEncodeBufferLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
-	return statsBinlogs, nil
+	return pkStats, nil
 }
 
 // notify flush manager del buffer data
@@ -571,7 +603,13 @@ func (m *rendezvousFlushManager) close() {
 
 type flushBufferInsertTask struct {
 	storage.ChunkManager
-	data map[string][]byte
+	data       map[string][]byte
+	fieldStats []*datapb.FieldStats
+}
+
+// getFieldStats implements fieldStatsTask
+func (t *flushBufferInsertTask) getFieldStats() []*datapb.FieldStats {
+	return t.fieldStats
 }
 
 // flushInsertData implements flushInsertTask
@@ -579,6 +617,7 @@ func (t *flushBufferInsertTask) flushInsertData() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	if t.ChunkManager != nil && len(t.data) > 0 {
+		defer getOrCreateIOThrottle().startFlush()()
 		tr := timerecord.NewTimeRecorder("insertData")
 		err := t.MultiWrite(ctx, t.data)
 		metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -602,6 +641,7 @@ func (t *flushBufferDeleteTask) flushDeleteData() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	if len(t.data) > 0 && t.ChunkManager != nil {
+		defer getOrCreateIOThrottle().startFlush()()
 		tr := timerecord.NewTimeRecorder("deleteData")
 		err := t.MultiWrite(ctx, t.data)
 		metrics.DataNodeSave2StorageLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
@@ -811,6 +851,7 @@ func flushNotifyFunc(dsService *dataSyncService, opts ...retry.Option) notifyMet
 			StartPositions: startPos,
 			Flushed:        pack.flushed,
 			Dropped:        pack.dropped,
+			FieldStats:     pack.fieldStats,
 		}
 		err := retry.Do(context.Background(), func() error {
 			rsp, err := dsService.dataCoord.SaveBinlogPaths(context.Background(), req)
@@ -39,6 +39,11 @@ type flushInsertTask interface {
 	flushInsertData() error
 }
 
+// fieldStatsTask is implemented by the insert tasks carrying the zone maps of the flushed data
+type fieldStatsTask interface {
+	getFieldStats() []*datapb.FieldStats
+}
+
 // flushDeleteTask defines action for flush delete
 type flushDeleteTask interface {
 	flushDeleteData() error
@@ -63,6 +68,7 @@ type flushTaskRunner struct {
 	insertLogs map[UniqueID]*datapb.Binlog
 	statsLogs  map[UniqueID]*datapb.Binlog
 	deltaLogs  []*datapb.Binlog //[]*DelDataBuf
+	fieldStats []*datapb.FieldStats
 	pos        *internalpb.MsgPosition
 	flushed    bool
 	dropped    bool
@@ -128,6 +134,9 @@ func (t *flushTaskRunner) runFlushInsert(task flushInsertTask,
 	t.insertOnce.Do(func() {
 		t.insertLogs = binlogs
 		t.statsLogs = statslogs
+		if statsTask, ok := task.(fieldStatsTask); ok {
+			t.fieldStats = statsTask.getFieldStats()
+		}
 		t.flushed = flushed
 		t.pos = pos
 		t.dropped = dropped
@@ -212,6 +221,7 @@ func (t *flushTaskRunner) getFlushPack() *segmentFlushPack {
 		segmentID:  t.segmentID,
 		insertLogs: t.insertLogs,
 		statsLogs:  t.statsLogs,
+		fieldStats: t.fieldStats,
 		pos:        t.pos,
 		deltaLogs:  t.deltaLogs,
 		flushed:    t.flushed,
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+// throttleRetryInterval is how long a throttled request sleeps before asking for tokens again
+const throttleRetryInterval = 10 * time.Millisecond
+
+// ioThrottle limits the object storage download bandwidth and the compaction concurrency of a datanode.
+//
+// The download limiter is a token bucket with punishment, a request larger than the burst passes
+// as long as there are tokens left and the following ones wait until the debt is paid.
+// The upload bandwidth is limited by the chunk manager, see common.storage.uploadRateLimit.
+// Compaction requests never start their I/O while a flush is in progress, so flushes preempt
+// the compaction I/O without starving it once the flushes are done.
+type ioThrottle struct {
+	downloadLimiter *ratelimitutil.Limiter
+	// compactionLimiter never refills, the running compactions give their tokens back once they are done
+	compactionLimiter *ratelimitutil.Limiter
+	// number of flushes in progress
+	pendingFlushes atomic.Int32
+}
+
+var ioThrottler *ioThrottle
+var ioThrottleInitOnce sync.Once
+
+// newIOThrottle creates an ioThrottle, the rate is in MB/s and no larger than 0 means no limit
+func newIOThrottle(downloadRate float64, compactionConcurrency int) *ioThrottle {
+	if compactionConcurrency < 1 {
+		compactionConcurrency = 1
+	}
+	return &ioThrottle{
+		downloadLimiter:   newBandwidthLimiter(downloadRate),
+		compactionLimiter: ratelimitutil.NewLimiter(0, float64(compactionConcurrency)),
+	}
+}
+
+func newBandwidthLimiter(rate float64) *ratelimitutil.Limiter {
+	if rate <= 0 {
+		return ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+	}
+	limit := ratelimitutil.Limit(rate * 1024 * 1024)
+	return ratelimitutil.NewLimiter(limit, float64(limit))
+}
+
+func getOrCreateIOThrottle() *ioThrottle {
+	ioThrottleInitOnce.Do(func() {
+		ioThrottler = newIOThrottle(Params.DataNodeCfg.IODownloadRate, Params.DataNodeCfg.CompactionConcurrency)
+	})
+	return ioThrottler
+}
+
+// startFlush marks a flush in progress until the returned function is called,
+// compaction I/O waits in the meantime.
+func (t *ioThrottle) startFlush() func() {
+	t.pendingFlushes.Inc()
+	return func() {
+		t.pendingFlushes.Dec()
+	}
+}
+
+// acquireUpload blocks until no flush is in progress, the bandwidth is left to the chunk manager.
+func (t *ioThrottle) acquireUpload(ctx context.Context, size int) error {
+	return t.acquire(ctx, nil, metrics.UploadLabel, size)
+}
+
+// downloadLimited returns whether the download bandwidth is limited,
+// the callers could skip the size estimation if it's not.
+func (t *ioThrottle) downloadLimited() bool {
+	return t.downloadLimiter.Limit() != ratelimitutil.Inf
+}
+
+// acquireDownload blocks until no flush is in progress and size bytes are allowed to be downloaded.
+func (t *ioThrottle) acquireDownload(ctx context.Context, size int) error {
+	return t.acquire(ctx, t.downloadLimiter, metrics.DownloadLabel, size)
+}
+
+// acquire blocks until no flush is in progress and the limiter, if any, allows size tokens.
+func (t *ioThrottle) acquire(ctx context.Context, limiter *ratelimitutil.Limiter, throttleType string, size int) error {
+	start := time.Now()
+	for {
+		if t.pendingFlushes.Load() == 0 && (limiter == nil || limiter.AllowN(time.Now(), size)) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(throttleRetryInterval):
+		}
+	}
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.DataNodeThrottledTime.WithLabelValues(nodeID, throttleType).Observe(float64(time.Since(start).Milliseconds()))
+	metrics.DataNodeThrottledBytes.WithLabelValues(nodeID, throttleType).Add(float64(size))
+	return nil
+}
+
+// acquireCompactionSlot blocks until the number of running compactions drops below the concurrency,
+// releaseCompactionSlot must be called once the compaction is done.
+func (t *ioThrottle) acquireCompactionSlot() {
+	start := time.Now()
+	for !t.compactionLimiter.AllowN(time.Now(), 1) {
+		time.Sleep(throttleRetryInterval)
+	}
+	metrics.DataNodeThrottledTime.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.CompactionSlotLabel).
+		Observe(float64(time.Since(start).Milliseconds()))
+}
+
+func (t *ioThrottle) releaseCompactionSlot() {
+	t.compactionLimiter.Cancel(1)
+}
+
+func totalSize(data map[string][]byte) int {
+	size := 0
+	for _, value := range data {
+		size += len(value)
+	}
+	return size
+}
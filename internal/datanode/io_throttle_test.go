@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIOThrottle(t *testing.T) {
+	t.Run("no limit", func(t *testing.T) {
+		throttle := newIOThrottle(0, 1)
+		assert.False(t, throttle.downloadLimited())
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, throttle.acquireUpload(context.Background(), 1024*1024*1024))
+			assert.NoError(t, throttle.acquireDownload(context.Background(), 1024*1024*1024))
+		}
+	})
+
+	t.Run("throttled until ctx done", func(t *testing.T) {
+		throttle := newIOThrottle(1, 1)
+		assert.True(t, throttle.downloadLimited())
+		// the first request takes all the tokens and more
+		assert.NoError(t, throttle.acquireDownload(context.Background(), 10*1024*1024))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		assert.Error(t, throttle.acquireDownload(ctx, 1))
+		// upload bandwidth is left to the chunk manager
+		assert.NoError(t, throttle.acquireUpload(context.Background(), 10*1024*1024))
+	})
+
+	t.Run("flush preempts compaction", func(t *testing.T) {
+		throttle := newIOThrottle(0, 1)
+		done := throttle.startFlush()
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		assert.Error(t, throttle.acquireUpload(ctx, 1))
+		assert.Error(t, throttle.acquireDownload(ctx, 1))
+
+		done()
+		assert.NoError(t, throttle.acquireUpload(context.Background(), 1))
+		assert.NoError(t, throttle.acquireDownload(context.Background(), 1))
+	})
+
+	t.Run("compaction slots", func(t *testing.T) {
+		throttle := newIOThrottle(0, 1)
+		throttle.acquireCompactionSlot()
+
+		acquired := make(chan struct{})
+		go func() {
+			throttle.acquireCompactionSlot()
+			close(acquired)
+		}()
+		select {
+		case <-acquired:
+			t.Fatal("compaction slot acquired while all the slots are in use")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		throttle.releaseCompactionSlot()
+		<-acquired
+		throttle.releaseCompactionSlot()
+	})
+}
@@ -272,6 +272,12 @@ func (ds *DataCoordFactory) SaveImportSegment(ctx context.Context, req *datapb.S
 	}, nil
 }
 
+func (ds *DataCoordFactory) ReportExport(ctx context.Context, req *datapb.ExportTaskResult) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
+
 func (ds *DataCoordFactory) UnsetIsImportingState(context.Context, *datapb.UnsetIsImportingStateRequest) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
@@ -20,7 +20,6 @@ import (
 	"sync"
 	"sync/atomic"
 
-	"github.com/bits-and-blooms/bloom/v3"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
@@ -40,15 +39,18 @@ type Segment struct {
 	numRows     int64
 	memorySize  int64
 	compactedTo UniqueID
+	// clusteredTo is the result segments of the clustering compaction the segment is compacted by
+	clusteredTo []UniqueID
 
 	curInsertBuf     *BufferData
 	curDeleteBuf     *DelDataBuf
 	historyInsertBuf []*BufferData
 	historyDeleteBuf []*DelDataBuf
 
-	statLock     sync.RWMutex
-	currentStat  *storage.PkStatistics
-	historyStats []*storage.PkStatistics
+	statLock          sync.RWMutex
+	currentStat       *storage.PkStatistics
+	historyStats      []*storage.PkStatistics
+	bloomFilterParams storage.BloomFilterParams
 
 	lastSyncTs Timestamp
 	startPos   *internalpb.MsgPosition // TODO readonly
@@ -92,9 +94,7 @@ func (s *Segment) updatePKRange(ids storage.FieldData) {
 
 func (s *Segment) InitCurrentStat() {
 	if s.currentStat == nil {
-		s.currentStat = &storage.PkStatistics{
-			PkFilter: bloom.NewWithEstimates(storage.BloomFilterSize, storage.MaxBloomFalsePositive),
-		}
+		s.currentStat = storage.NewPkStatistics(s.bloomFilterParams)
 	}
 }
 
@@ -346,6 +346,41 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return s.dataCoord.GetCompactionStateWithPlans(ctx, req)
 }
 
+// ManualCompactionWithScope triggers a compaction limited to the given partitions or segments of a collection
+func (s *Server) ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return s.dataCoord.ManualCompactionWithScope(ctx, req)
+}
+
+// CancelCompaction cancels the plans of a compaction which are not completed yet
+func (s *Server) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CancelCompaction(ctx, req)
+}
+
+// GetCompactionPlanStates gets the state of each plan of a compaction, including the cancelled ones
+func (s *Server) GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return s.dataCoord.GetCompactionPlanStates(ctx, req)
+}
+
+// Export starts a job which exports the flushed segments of a collection to Parquet or JSON files
+func (s *Server) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	return s.dataCoord.Export(ctx, req)
+}
+
+// GetExportState gets the state and progress of an export job
+func (s *Server) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return s.dataCoord.GetExportState(ctx, req)
+}
+
+// CancelExport cancels an export job which is not finished yet
+func (s *Server) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CancelExport(ctx, req)
+}
+
+// ReportExport reports the progress or result of an export task
+func (s *Server) ReportExport(ctx context.Context, req *datapb.ExportTaskResult) (*commonpb.Status, error) {
+	return s.dataCoord.ReportExport(ctx, req)
+}
+
 // WatchChannels starts watch channels by give request
 func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return s.dataCoord.WatchChannels(ctx, req)
@@ -56,7 +56,10 @@ type MockDataCoord struct {
 	metricResp                *milvuspb.GetMetricsResponse
 	compactionStateResp       *milvuspb.GetCompactionStateResponse
 	manualCompactionResp      *milvuspb.ManualCompactionResponse
+	exportResp                *datapb.ExportResponse
+	exportStateResp           *datapb.GetExportStateResponse
 	compactionPlansResp       *milvuspb.GetCompactionPlansResponse
+	compactionPlanStatesResp  *datapb.GetCompactionPlanStatesResponse
 	watchChannelsResp         *datapb.WatchChannelsResponse
 	getFlushStateResp         *milvuspb.GetFlushStateResponse
 	dropVChanResp             *datapb.DropVirtualChannelResponse
@@ -181,6 +184,34 @@ func (m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *mi
 	return m.compactionPlansResp, m.err
 }
 
+func (m *MockDataCoord) ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return m.manualCompactionResp, m.err
+}
+
+func (m *MockDataCoord) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return m.compactionPlanStatesResp, m.err
+}
+
+func (m *MockDataCoord) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	return m.exportResp, m.err
+}
+
+func (m *MockDataCoord) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return m.exportStateResp, m.err
+}
+
+func (m *MockDataCoord) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataCoord) ReportExport(ctx context.Context, req *datapb.ExportTaskResult) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return m.watchChannelsResp, m.err
 }
@@ -436,6 +467,69 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("ManualCompactionWithScope", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			manualCompactionResp: &milvuspb.ManualCompactionResponse{},
+		}
+		resp, err := server.ManualCompactionWithScope(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("CancelCompaction", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.CancelCompaction(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetCompactionPlanStates", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			compactionPlanStatesResp: &datapb.GetCompactionPlanStatesResponse{},
+		}
+		resp, err := server.GetCompactionPlanStates(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			exportResp: &datapb.ExportResponse{},
+		}
+		resp, err := server.Export(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetExportState", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			exportStateResp: &datapb.GetExportStateResponse{},
+		}
+		resp, err := server.GetExportState(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("CancelExport", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.CancelExport(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ReportExport", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			status: &commonpb.Status{},
+		}
+		resp, err := server.ReportExport(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	t.Run("GetCompactionState", func(t *testing.T) {
 		server.dataCoord = &MockDataCoord{
 			compactionStateResp: &milvuspb.GetCompactionStateResponse{},
@@ -385,6 +385,21 @@ func (s *Server) AddImportSegment(ctx context.Context, request *datapb.AddImport
 	return s.datanode.AddImportSegment(ctx, request)
 }
 
+// Export writes the rows of the task segments into files on the object storage
+func (s *Server) Export(ctx context.Context, request *datapb.ExportTask) (*commonpb.Status, error) {
+	return s.datanode.Export(ctx, request)
+}
+
+// CancelExport stops the executing export tasks of a job
+func (s *Server) CancelExport(ctx context.Context, request *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	return s.datanode.CancelExport(ctx, request)
+}
+
 func (s *Server) SyncSegments(ctx context.Context, request *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	return s.datanode.SyncSegments(ctx, request)
 }
+
+// CancelCompaction stops a compaction plan and drops its result
+func (s *Server) CancelCompaction(ctx context.Context, request *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return s.datanode.CancelCompaction(ctx, request)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 )
 
@@ -34,6 +35,7 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/collection/load", wrapHandler(h.handleReleaseCollection))
 	router.GET("/collection/statistics", wrapHandler(h.handleGetCollectionStatistics))
 	router.GET("/collections", wrapHandler(h.handleShowCollections))
+	router.POST("/collection/field", wrapHandler(h.handleAddCollectionField))
 
 	router.POST("/partition", wrapHandler(h.handleCreatePartition))
 	router.DELETE("/partition", wrapHandler(h.handleDropPartition))
@@ -57,6 +59,7 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.DELETE("/entities", wrapHandler(h.handleDelete))
 	router.POST("/search", wrapHandler(h.handleSearch))
 	router.POST("/query", wrapHandler(h.handleQuery))
+	router.POST("/expr/validate", wrapHandler(h.handleValidateExpr))
 
 	router.POST("/persist", wrapHandler(h.handleFlush))
 	router.GET("/distance", wrapHandler(h.handleCalcDistance))
@@ -70,11 +73,18 @@ func (h *Handlers) RegisterRoutesTo(router gin.IRouter) {
 	router.GET("/compaction/state", wrapHandler(h.handleGetCompactionState))
 	router.GET("/compaction/plans", wrapHandler(h.handleGetCompactionStateWithPlans))
 	router.POST("/compaction", wrapHandler(h.handleManualCompaction))
+	router.POST("/compaction/scope", wrapHandler(h.handleManualCompactionWithScope))
+	router.DELETE("/compaction", wrapHandler(h.handleCancelCompaction))
+	router.GET("/compaction/plan-states", wrapHandler(h.handleGetCompactionPlanStates))
 
 	router.POST("/import", wrapHandler(h.handleImport))
 	router.GET("/import/state", wrapHandler(h.handleGetImportState))
 	router.GET("/import/tasks", wrapHandler(h.handleListImportTasks))
 
+	router.POST("/export", wrapHandler(h.handleExport))
+	router.GET("/export/state", wrapHandler(h.handleGetExportState))
+	router.DELETE("/export", wrapHandler(h.handleCancelExport))
+
 	router.POST("/credential", wrapHandler(h.handleCreateCredential))
 	router.PATCH("/credential", wrapHandler(h.handleUpdateCredential))
 	router.DELETE("/credential", wrapHandler(h.handleDeleteCredential))
@@ -181,6 +191,15 @@ func (h *Handlers) handleShowCollections(c *gin.Context) (interface{}, error) {
 	return h.proxy.ShowCollections(c, &req)
 }
 
+func (h *Handlers) handleAddCollectionField(c *gin.Context) (interface{}, error) {
+	req := proxypb.AddCollectionFieldRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.AddCollectionField(c, &req)
+}
+
 func (h *Handlers) handleCreatePartition(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreatePartitionRequest{}
 	err := shouldBind(c, &req)
@@ -374,6 +393,15 @@ func (h *Handlers) handleQuery(c *gin.Context) (interface{}, error) {
 	return h.proxy.Query(c, &req)
 }
 
+func (h *Handlers) handleValidateExpr(c *gin.Context) (interface{}, error) {
+	req := proxypb.ValidateExprRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ValidateExpr(c, &req)
+}
+
 func (h *Handlers) handleFlush(c *gin.Context) (interface{}, error) {
 	req := milvuspb.FlushRequest{}
 	err := shouldBind(c, &req)
@@ -480,6 +508,34 @@ func (h *Handlers) handleManualCompaction(c *gin.Context) (interface{}, error) {
 	return h.proxy.ManualCompaction(c, &req)
 }
 
+// handleManualCompactionWithScope compacts the whole collection if neither partitions nor segments are given.
+func (h *Handlers) handleManualCompactionWithScope(c *gin.Context) (interface{}, error) {
+	req := proxypb.ManualCompactionWithScopeRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.ManualCompactionWithScope(c, &req)
+}
+
+func (h *Handlers) handleCancelCompaction(c *gin.Context) (interface{}, error) {
+	req := proxypb.CancelCompactionRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CancelCompaction(c, &req)
+}
+
+func (h *Handlers) handleGetCompactionPlanStates(c *gin.Context) (interface{}, error) {
+	req := proxypb.GetCompactionPlanStatesRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetCompactionPlanStates(c, &req)
+}
+
 func (h *Handlers) handleImport(c *gin.Context) (interface{}, error) {
 	req := milvuspb.ImportRequest{}
 	err := shouldBind(c, &req)
@@ -507,6 +563,33 @@ func (h *Handlers) handleListImportTasks(c *gin.Context) (interface{}, error) {
 	return h.proxy.ListImportTasks(c, &req)
 }
 
+func (h *Handlers) handleExport(c *gin.Context) (interface{}, error) {
+	req := proxypb.ExportRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.Export(c, &req)
+}
+
+func (h *Handlers) handleGetExportState(c *gin.Context) (interface{}, error) {
+	req := proxypb.GetExportStateRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.GetExportState(c, &req)
+}
+
+func (h *Handlers) handleCancelExport(c *gin.Context) (interface{}, error) {
+	req := proxypb.CancelExportRequest{}
+	err := shouldBind(c, &req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse body failed: %v", errBadRequest, err)
+	}
+	return h.proxy.CancelExport(c, &req)
+}
+
 func (h *Handlers) handleCreateCredential(c *gin.Context) (interface{}, error) {
 	req := milvuspb.CreateCredentialRequest{}
 	err := shouldBind(c, &req)
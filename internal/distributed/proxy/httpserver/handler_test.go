@@ -15,6 +15,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -80,6 +82,10 @@ func (m *mockProxyComponent) ShowCollections(ctx context.Context, request *milvu
 	return &milvuspb.ShowCollectionsResponse{Status: testStatus}, nil
 }
 
+func (m *mockProxyComponent) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
 func (m *mockProxyComponent) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return testStatus, nil
 }
@@ -180,6 +186,18 @@ func (m *mockProxyComponent) Query(ctx context.Context, request *milvuspb.QueryR
 	return &queryResult, nil
 }
 
+var validateExprResult = proxypb.ValidateExprResponse{
+	Status: testStatus,
+	Valid:  true,
+}
+
+func (m *mockProxyComponent) ValidateExpr(ctx context.Context, request *proxypb.ValidateExprRequest) (*proxypb.ValidateExprResponse, error) {
+	if request.Expr == "" {
+		return nil, errors.New("body parse err")
+	}
+	return &validateExprResult, nil
+}
+
 var flushResult = milvuspb.FlushResponse{
 	DbName: "default",
 }
@@ -242,6 +260,30 @@ func (m *mockProxyComponent) ManualCompaction(ctx context.Context, request *milv
 	return &milvuspb.ManualCompactionResponse{Status: testStatus}, nil
 }
 
+func (m *mockProxyComponent) ManualCompactionWithScope(ctx context.Context, request *proxypb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return &milvuspb.ManualCompactionResponse{Status: testStatus, CompactionID: int64(len(request.GetPartitionNames()))}, nil
+}
+
+func (m *mockProxyComponent) CancelCompaction(ctx context.Context, request *proxypb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
+func (m *mockProxyComponent) GetCompactionPlanStates(ctx context.Context, request *proxypb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return &datapb.GetCompactionPlanStatesResponse{Status: testStatus}, nil
+}
+
+func (m *mockProxyComponent) Export(ctx context.Context, request *proxypb.ExportRequest) (*datapb.ExportResponse, error) {
+	return &datapb.ExportResponse{Status: testStatus, JobID: int64(len(request.GetPartitionNames()))}, nil
+}
+
+func (m *mockProxyComponent) GetExportState(ctx context.Context, request *proxypb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return &datapb.GetExportStateResponse{Status: testStatus, JobID: request.GetJobID()}, nil
+}
+
+func (m *mockProxyComponent) CancelExport(ctx context.Context, request *proxypb.CancelExportRequest) (*commonpb.Status, error) {
+	return testStatus, nil
+}
+
 func (m *mockProxyComponent) Import(ctx context.Context, request *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
 	return &milvuspb.ImportResponse{Status: testStatus}, nil
 }
@@ -374,6 +416,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/collections", emptyBody,
 			http.StatusOK, &milvuspb.ShowCollectionsResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/collection/field", proxypb.AddCollectionFieldRequest{CollectionName: "c", Field: &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}},
+			http.StatusOK, testStatus,
+		},
 		{
 			http.MethodPost, "/partition", emptyBody,
 			http.StatusOK, testStatus,
@@ -450,6 +496,10 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/query", milvuspb.QueryRequest{Expr: "some expr"},
 			http.StatusOK, &queryResult,
 		},
+		{
+			http.MethodPost, "/expr/validate", proxypb.ValidateExprRequest{CollectionName: "test", Expr: "some expr"},
+			http.StatusOK, &validateExprResult,
+		},
 		{
 			http.MethodPost, "/persist", milvuspb.FlushRequest{CollectionNames: []string{"c1"}},
 			http.StatusOK, flushResult,
@@ -497,6 +547,18 @@ func TestHandlers(t *testing.T) {
 			http.MethodPost, "/compaction", emptyBody,
 			http.StatusOK, &milvuspb.ManualCompactionResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/compaction/scope", proxypb.ManualCompactionWithScopeRequest{CollectionName: "c", PartitionNames: []string{"p"}, Mode: datapb.ManualCompactionMode_MergeOnly},
+			http.StatusOK, &milvuspb.ManualCompactionResponse{Status: testStatus, CompactionID: 1},
+		},
+		{
+			http.MethodDelete, "/compaction", proxypb.CancelCompactionRequest{CollectionName: "c", CompactionID: 1},
+			http.StatusOK, testStatus,
+		},
+		{
+			http.MethodGet, "/compaction/plan-states", emptyBody,
+			http.StatusOK, &datapb.GetCompactionPlanStatesResponse{Status: testStatus},
+		},
 		{
 			http.MethodPost, "/import", emptyBody,
 			http.StatusOK, &milvuspb.ImportResponse{Status: testStatus},
@@ -509,6 +571,18 @@ func TestHandlers(t *testing.T) {
 			http.MethodGet, "/import/tasks", emptyBody,
 			http.StatusOK, &milvuspb.ListImportTasksResponse{Status: testStatus},
 		},
+		{
+			http.MethodPost, "/export", proxypb.ExportRequest{CollectionName: "c", PartitionNames: []string{"p"}, Format: datapb.ExportFormat_ExportJSON, TargetPrefix: "exports/c1"},
+			http.StatusOK, &datapb.ExportResponse{Status: testStatus, JobID: 1},
+		},
+		{
+			http.MethodGet, "/export/state", proxypb.GetExportStateRequest{CollectionName: "c", JobID: 2},
+			http.StatusOK, &datapb.GetExportStateResponse{Status: testStatus, JobID: 2},
+		},
+		{
+			http.MethodDelete, "/export", proxypb.CancelExportRequest{CollectionName: "c", JobID: 2},
+			http.StatusOK, testStatus,
+		},
 		{
 			http.MethodPost, "/credential", emptyBody,
 			http.StatusOK, testStatus,
@@ -45,6 +45,7 @@ import (
 	qcc "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy"
@@ -225,6 +226,7 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	}
 	s.grpcExternalServer = grpc.NewServer(grpcOpts...)
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
+	proxypb.RegisterMilvusExtServiceServer(s.grpcExternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
 	errChan <- nil
 
@@ -877,6 +879,46 @@ func (s *Server) SetRates(ctx context.Context, request *proxypb.SetRatesRequest)
 	return s.proxy.SetRates(ctx, request)
 }
 
+// ValidateExpr checks whether the expression is valid against the schema of the collection.
+func (s *Server) ValidateExpr(ctx context.Context, request *proxypb.ValidateExprRequest) (*proxypb.ValidateExprResponse, error) {
+	return s.proxy.ValidateExpr(ctx, request)
+}
+
+// ManualCompactionWithScope triggers a compaction limited to the given partitions or segments of a collection.
+func (s *Server) ManualCompactionWithScope(ctx context.Context, request *proxypb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return s.proxy.ManualCompactionWithScope(ctx, request)
+}
+
+// CancelCompaction cancels the plans of a compaction which are not completed yet.
+func (s *Server) CancelCompaction(ctx context.Context, request *proxypb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return s.proxy.CancelCompaction(ctx, request)
+}
+
+// GetCompactionPlanStates gets the state of each plan of a compaction, including the cancelled ones.
+func (s *Server) GetCompactionPlanStates(ctx context.Context, request *proxypb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return s.proxy.GetCompactionPlanStates(ctx, request)
+}
+
+// Export starts a job which exports the flushed segments of a collection to Parquet or JSON files.
+func (s *Server) Export(ctx context.Context, request *proxypb.ExportRequest) (*datapb.ExportResponse, error) {
+	return s.proxy.Export(ctx, request)
+}
+
+// GetExportState gets the state and progress of an export job of the collection.
+func (s *Server) GetExportState(ctx context.Context, request *proxypb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return s.proxy.GetExportState(ctx, request)
+}
+
+// CancelExport cancels an export job of the collection which is not finished yet.
+func (s *Server) CancelExport(ctx context.Context, request *proxypb.CancelExportRequest) (*commonpb.Status, error) {
+	return s.proxy.CancelExport(ctx, request)
+}
+
+// AddCollectionField appends a nullable or defaulted scalar field to the collection.
+func (s *Server) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionField(ctx, request)
+}
+
 // GetProxyMetrics gets the metrics of proxy.
 func (s *Server) GetProxyMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.proxy.GetProxyMetrics(ctx, request)
@@ -145,6 +145,10 @@ func (m *MockRootCoord) AlterCollection(ctx context.Context, request *milvuspb.A
 	return nil, nil
 }
 
+func (m *MockRootCoord) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -455,6 +459,10 @@ func (m *MockQueryCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHea
 	}, nil
 }
 
+func (m *MockQueryCoord) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockDataCoord struct {
 	MockBase
@@ -569,6 +577,34 @@ func (m *MockDataCoord) GetCompactionStateWithPlans(ctx context.Context, req *mi
 	return nil, nil
 }
 
+func (m *MockDataCoord) ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ReportExport(ctx context.Context, req *datapb.ExportTaskResult) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockDataCoord) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return nil, nil
 }
@@ -800,6 +836,38 @@ func (m *MockProxy) SetRates(ctx context.Context, request *proxypb.SetRatesReque
 	return nil, nil
 }
 
+func (m *MockProxy) ValidateExpr(ctx context.Context, request *proxypb.ValidateExprRequest) (*proxypb.ValidateExprResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ManualCompactionWithScope(ctx context.Context, request *proxypb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CancelCompaction(ctx context.Context, request *proxypb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetCompactionPlanStates(ctx context.Context, request *proxypb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) Export(ctx context.Context, request *proxypb.ExportRequest) (*datapb.ExportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetExportState(ctx context.Context, request *proxypb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CancelExport(ctx context.Context, request *proxypb.CancelExportRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) GetProxyMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, nil
 }
@@ -1277,6 +1345,41 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("ManualCompactionWithScope", func(t *testing.T) {
+		_, err := server.ManualCompactionWithScope(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CancelCompaction", func(t *testing.T) {
+		_, err := server.CancelCompaction(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetCompactionPlanStates", func(t *testing.T) {
+		_, err := server.GetCompactionPlanStates(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Export", func(t *testing.T) {
+		_, err := server.Export(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetExportState", func(t *testing.T) {
+		_, err := server.GetExportState(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CancelExport", func(t *testing.T) {
+		_, err := server.CancelExport(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("AddCollectionField", func(t *testing.T) {
+		_, err := server.AddCollectionField(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("CreateCredential", func(t *testing.T) {
 		_, err := server.CreateCredential(ctx, nil)
 		assert.Nil(t, err)
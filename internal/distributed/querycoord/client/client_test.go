@@ -124,6 +124,9 @@ func Test_NewClient(t *testing.T) {
 
 		r20, err := client.CheckHealth(ctx, nil)
 		retCheck(retNotNil, r20, err)
+
+		r21, err := client.UpdateCollectionSchema(ctx, &querypb.UpdateSchemaRequest{})
+		retCheck(retNotNil, r21, err)
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[querypb.QueryCoordClient]{
@@ -415,3 +415,8 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return s.queryCoord.CheckHealth(ctx, req)
 }
+
+// UpdateCollectionSchema forwards the altered schema of a loaded collection to its query nodes.
+func (s *Server) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return s.queryCoord.UpdateCollectionSchema(ctx, req)
+}
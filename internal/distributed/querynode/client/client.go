@@ -396,3 +396,21 @@ func (c *Client) SyncDistribution(ctx context.Context, req *querypb.SyncDistribu
 	}
 	return ret.(*commonpb.Status), err
 }
+
+// UpdateSchema notifies QueryNode of the fields added to a loaded collection.
+func (c *Client) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID()))
+	ret, err := c.grpcClient.Call(ctx, func(client querypb.QueryNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.UpdateSchema(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
@@ -337,3 +337,8 @@ func (s *Server) GetDataDistribution(ctx context.Context, req *querypb.GetDataDi
 func (s *Server) SyncDistribution(ctx context.Context, req *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	return s.querynode.SyncDistribution(ctx, req)
 }
+
+// UpdateSchema applies the fields added to a loaded collection.
+func (s *Server) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return s.querynode.UpdateSchema(ctx, req)
+}
@@ -153,6 +153,9 @@ func (m *MockQueryNode) GetDataDistribution(context.Context, *querypb.GetDataDis
 func (m *MockQueryNode) SyncDistribution(context.Context, *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	return m.status, m.err
 }
+func (m *MockQueryNode) UpdateSchema(context.Context, *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
 
 type MockRootCoord struct {
 	types.RootCoord
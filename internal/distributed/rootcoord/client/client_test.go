@@ -224,6 +224,10 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.CheckHealth(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.AddCollectionField(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 	}
 
 	client.grpcClient = &mock.GRPCClientBase[rootcoordpb.RootCoordClient]{
@@ -426,6 +430,10 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.CheckHealth(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.AddCollectionField(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	// clean up
 	err = client.Stop()
 	assert.Nil(t, err)
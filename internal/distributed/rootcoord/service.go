@@ -487,3 +487,8 @@ func (s *Server) ListPolicy(ctx context.Context, request *internalpb.ListPolicyR
 func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.AlterCollection(ctx, request)
 }
+
+// AddCollectionField appends a nullable or defaulted scalar field to a collection.
+func (s *Server) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddCollectionField(ctx, request)
+}
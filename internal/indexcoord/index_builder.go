@@ -279,6 +279,17 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 				RootPath:    Params.LocalStorageCfg.Path.GetValue(),
 				StorageType: Params.CommonCfg.StorageType,
 			}
+		} else if Params.CommonCfg.StorageType == "azure" {
+			storageConfig = &indexpb.StorageConfig{
+				Address:         Params.AzureCfg.Endpoint.GetValue(),
+				AccountName:     Params.AzureCfg.AccountName.GetValue(),
+				SecretAccessKey: Params.AzureCfg.AccountKey.GetValue(),
+				ContainerName:   Params.AzureCfg.ContainerName.GetValue(),
+				RootPath:        Params.AzureCfg.RootPath.GetValue(),
+				UseIAM:          Params.AzureCfg.UseManagedIdentity.GetAsBool(),
+				ClientID:        Params.AzureCfg.ManagedIdentityClientID.GetValue(),
+				StorageType:     Params.CommonCfg.StorageType,
+			}
 		} else {
 			storageConfig = &indexpb.StorageConfig{
 				Address:         Params.MinioCfg.Address.GetValue(),
@@ -302,6 +313,7 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			IndexParams:     indexParams,
 			TypeParams:      typeParams,
 			NumRows:         meta.NumRows,
+			FieldID:         fieldID,
 		}
 		if err := ib.ic.assignTask(client, req); err != nil {
 			// need to release lock then reassign, so set task state to retry
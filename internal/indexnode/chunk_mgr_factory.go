@@ -3,11 +3,13 @@ package indexnode
 import (
 	"context"
 	"fmt"
+	"path"
 	"sync"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 type StorageFactory interface {
@@ -16,10 +18,21 @@ type StorageFactory interface {
 
 type chunkMgr struct {
 	cached sync.Map
+	// the chunk manager of a storage config is created only once, so it's never left unclosed
+	mu sync.Mutex
 }
 
 func (m *chunkMgr) NewChunkManager(ctx context.Context, config *indexpb.StorageConfig) (storage.ChunkManager, error) {
-	key := m.cacheKey(config.StorageType, config.BucketName, config.Address)
+	bucket := config.GetBucketName()
+	if config.GetStorageType() == "azure" {
+		bucket = path.Join(config.GetAccountName(), config.GetContainerName())
+	}
+	key := m.cacheKey(config.GetStorageType(), bucket, config.GetAddress())
+	if v, ok := m.cached.Load(key); ok {
+		return v.(storage.ChunkManager), nil
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	if v, ok := m.cached.Load(key); ok {
 		return v.(storage.ChunkManager), nil
 	}
@@ -29,6 +42,10 @@ func (m *chunkMgr) NewChunkManager(ctx context.Context, config *indexpb.StorageC
 	if err != nil {
 		return nil, err
 	}
+	mgr, err = storage.NewCachedChunkManagerWithParam(mgr, Params, typeutil.IndexNodeRole, key)
+	if err != nil {
+		return nil, err
+	}
 	v, _ := m.cached.LoadOrStore(key, mgr)
 	log.Ctx(ctx).Info("index node successfully init chunk manager")
 	return v.(storage.ChunkManager), nil
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -247,7 +248,7 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 	dType := dataset.DType
 	var err error
 	if dType != schemapb.DataType_None {
-		it.index, err = indexcgowrapper.NewCgoIndex(dType, it.newTypeParams, it.newIndexParams, it.req.GetStorageConfig())
+		it.index, err = indexcgowrapper.NewCgoIndex(dType, it.newTypeParams, it.newIndexParams, it.req.GetStorageConfig(), "")
 		if err == nil {
 			err = it.index.Build(dataset)
 		}
@@ -312,6 +313,22 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 			zap.Bool("enable disk", Params.IndexNodeCfg.EnableDisk))
 		return errors.New("index node don't support build disk index")
 	}
+	// disk index files are uploaded and loaded by the minio chunk manager of segcore
+	if it.req.GetStorageConfig().GetStorageType() == "azure" {
+		log.Ctx(ctx).Error("disk index is not supported on azure blob storage",
+			zap.String("index type", it.newIndexParams["index_type"]))
+		return errors.New("disk index is not supported on azure blob storage")
+	}
+	// disk index files are uploaded by segcore directly, hand over the data keys to encrypt them
+	var encryptionKeys string
+	if ecm, ok := storage.GetEncryptedChunkManager(it.cm); ok {
+		keys, err := ecm.ExportDataKeys(ctx, it.collectionID)
+		if err != nil {
+			log.Ctx(ctx).Error("failed to export data keys", zap.Int64("collectionID", it.collectionID), zap.Error(err))
+			return err
+		}
+		encryptionKeys = keys
+	}
 
 	// check load size and size of field data
 	localUsedSize, err := indexcgowrapper.GetLocalUsedSize()
@@ -356,7 +373,7 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 			zap.Int64("buildID", it.BuildID),
 			zap.String("index params", string(jsonIndexParams)))
 
-		it.index, err = indexcgowrapper.NewCgoIndex(dType, it.newTypeParams, it.newIndexParams, it.req.GetStorageConfig())
+		it.index, err = indexcgowrapper.NewCgoIndex(dType, it.newTypeParams, it.newIndexParams, it.req.GetStorageConfig(), encryptionKeys)
 		if err != nil {
 			log.Ctx(ctx).Error("failed to create index", zap.Error(err))
 		} else {
@@ -405,6 +422,8 @@ func (it *indexBuildTask) BuildDiskAnnIndex(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
+	// index file paths don't contain the collection id, which is required to choose the data key when encrypted
+	ctx = storage.WithCollectionID(ctx, it.collectionID)
 	// support build diskann index
 	indexType := it.newIndexParams["index_type"]
 	if indexType == indexparamcheck.IndexDISKANN {
@@ -509,8 +528,20 @@ func (it *indexBuildTask) SaveDiskAnnIndexFiles(ctx context.Context) error {
 }
 
 func (it *indexBuildTask) decodeBlobs(ctx context.Context, blobs []*storage.Blob) error {
-	var insertCodec storage.InsertCodec
-	collectionID, partitionID, segmentID, insertData, err2 := insertCodec.DeserializeAll(blobs)
+	var (
+		insertCodec                          storage.InsertCodec
+		collectionID, partitionID, segmentID UniqueID
+		insertData                           *storage.InsertData
+		err2                                 error
+	)
+	if fieldID := it.req.GetFieldID(); fieldID != 0 {
+		// columnar logs of segment format v2 hold all fields, only read the field to build index on
+		sort.Sort(storage.BlobList(blobs))
+		insertData = &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+		collectionID, partitionID, segmentID, err2 = insertCodec.DeserializeFieldsInto(blobs, 0, insertData, fieldID)
+	} else {
+		collectionID, partitionID, segmentID, insertData, err2 = insertCodec.DeserializeAll(blobs)
+	}
 	if err2 != nil {
 		return err2
 	}
@@ -105,10 +105,13 @@ type DataCoordCatalog interface {
 	AlterSegments(ctx context.Context, newSegments []*datapb.SegmentInfo) error
 	// AlterSegmentsAndAddNewSegment for transaction
 	AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error
+	// AlterSegmentsAndAddNewSegments for transaction, used by compactions with several result segments
+	AlterSegmentsAndAddNewSegments(ctx context.Context, segments []*datapb.SegmentInfo, newSegments []*datapb.SegmentInfo) error
 	AlterSegment(ctx context.Context, newSegment *datapb.SegmentInfo, oldSegment *datapb.SegmentInfo) error
 	SaveDroppedSegmentsInBatch(ctx context.Context, segments []*datapb.SegmentInfo) error
 	DropSegment(ctx context.Context, segment *datapb.SegmentInfo) error
 	RevertAlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, removalSegment *datapb.SegmentInfo) error
+	RevertAlterSegmentsAndAddNewSegments(ctx context.Context, segments []*datapb.SegmentInfo, removalSegments []*datapb.SegmentInfo) error
 
 	MarkChannelDeleted(ctx context.Context, channel string) error
 	IsChannelDropped(ctx context.Context, channel string) bool
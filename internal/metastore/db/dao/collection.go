@@ -104,6 +104,7 @@ func generateCollectionUpdatesWithoutID(in *dbmodel.Collection) map[string]inter
 		"consistency_level": in.ConsistencyLevel,
 		"status":            in.Status,
 		"properties":        in.Properties,
+		"schema_version":    in.SchemaVersion,
 		"ts":                in.Ts,
 		"is_deleted":        in.IsDeleted,
 		"created_at":        in.CreatedAt,
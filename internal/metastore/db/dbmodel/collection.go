@@ -24,6 +24,7 @@ type Collection struct {
 	ConsistencyLevel int32              `gorm:"consistency_level"`
 	Status           int32              `gorm:"status"`
 	Properties       string             `gorm:"properties"`
+	SchemaVersion    int32              `gorm:"schema_version"`
 	Ts               typeutil.Timestamp `gorm:"ts"`
 	IsDeleted        bool               `gorm:"is_deleted"`
 	CreatedAt        time.Time          `gorm:"created_at"`
@@ -75,6 +76,7 @@ func UnmarshalCollectionModel(coll *Collection) (*model.Collection, error) {
 		ConsistencyLevel: commonpb.ConsistencyLevel(coll.ConsistencyLevel),
 		CreateTime:       coll.Ts,
 		Properties:       properties,
+		SchemaVersion:    coll.SchemaVersion,
 	}, nil
 }
 
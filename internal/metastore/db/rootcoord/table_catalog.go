@@ -69,43 +69,16 @@ func (tc *Catalog) CreateCollection(ctx context.Context, collection *model.Colle
 			Status:           int32(collection.State),
 			Ts:               ts,
 			Properties:       properties,
+			SchemaVersion:    collection.SchemaVersion,
 		})
 		if err != nil {
 			return err
 		}
 
 		// insert field
-		var fields = make([]*dbmodel.Field, 0, len(collection.Fields))
-		for _, field := range collection.Fields {
-			typeParamsBytes, err := json.Marshal(field.TypeParams)
-			if err != nil {
-				log.Error("marshal TypeParams of field failed", zap.Error(err))
-				return err
-			}
-			typeParamsStr := string(typeParamsBytes)
-
-			indexParamsBytes, err := json.Marshal(field.IndexParams)
-			if err != nil {
-				log.Error("marshal IndexParams of field failed", zap.Error(err))
-				return err
-			}
-			indexParamsStr := string(indexParamsBytes)
-
-			f := &dbmodel.Field{
-				TenantID:     collection.TenantID,
-				FieldID:      field.FieldID,
-				FieldName:    field.Name,
-				IsPrimaryKey: field.IsPrimaryKey,
-				Description:  field.Description,
-				DataType:     field.DataType,
-				TypeParams:   typeParamsStr,
-				IndexParams:  indexParamsStr,
-				AutoID:       field.AutoID,
-				CollectionID: collection.CollectionID,
-				Ts:           ts,
-			}
-
-			fields = append(fields, f)
+		fields, err := marshalFields(collection.TenantID, collection.CollectionID, collection.Fields, ts)
+		if err != nil {
+			return err
 		}
 
 		err = tc.metaDomain.FieldDb(txCtx).Insert(fields)
@@ -387,6 +360,42 @@ func (tc *Catalog) DropCollection(ctx context.Context, collection *model.Collect
 	})
 }
 
+func marshalFields(tenantID string, collectionID typeutil.UniqueID, fieldModels []*model.Field, ts typeutil.Timestamp) ([]*dbmodel.Field, error) {
+	var fields = make([]*dbmodel.Field, 0, len(fieldModels))
+	for _, field := range fieldModels {
+		typeParamsBytes, err := json.Marshal(field.TypeParams)
+		if err != nil {
+			log.Error("marshal TypeParams of field failed", zap.Error(err))
+			return nil, err
+		}
+		typeParamsStr := string(typeParamsBytes)
+
+		indexParamsBytes, err := json.Marshal(field.IndexParams)
+		if err != nil {
+			log.Error("marshal IndexParams of field failed", zap.Error(err))
+			return nil, err
+		}
+		indexParamsStr := string(indexParamsBytes)
+
+		f := &dbmodel.Field{
+			TenantID:     tenantID,
+			FieldID:      field.FieldID,
+			FieldName:    field.Name,
+			IsPrimaryKey: field.IsPrimaryKey,
+			Description:  field.Description,
+			DataType:     field.DataType,
+			TypeParams:   typeParamsStr,
+			IndexParams:  indexParamsStr,
+			AutoID:       field.AutoID,
+			CollectionID: collectionID,
+			Ts:           ts,
+		}
+
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
 func (tc *Catalog) alterModifyCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts typeutil.Timestamp) error {
 	if oldColl.TenantID != newColl.TenantID || oldColl.CollectionID != newColl.CollectionID {
 		return fmt.Errorf("altering tenant id or collection id is forbidden")
@@ -422,9 +431,34 @@ func (tc *Catalog) alterModifyCollection(ctx context.Context, oldColl *model.Col
 		CreatedAt:        createdAt,
 		UpdatedAt:        time.Now(),
 		Properties:       properties,
+		SchemaVersion:    newColl.SchemaVersion,
 	}
 
-	return tc.metaDomain.CollectionDb(ctx).Update(coll)
+	oldFields := make(map[int64]struct{}, len(oldColl.Fields))
+	for _, field := range oldColl.Fields {
+		oldFields[field.FieldID] = struct{}{}
+	}
+	var addedFields []*model.Field
+	for _, field := range newColl.Fields {
+		if _, ok := oldFields[field.FieldID]; !ok {
+			addedFields = append(addedFields, field)
+		}
+	}
+	if len(addedFields) == 0 {
+		return tc.metaDomain.CollectionDb(ctx).Update(coll)
+	}
+
+	// added fields share the timestamp of existing fields, so that they're listed together.
+	fields, err := marshalFields(tenantID, newColl.CollectionID, addedFields, newColl.CreateTime)
+	if err != nil {
+		return err
+	}
+	return tc.txImpl.Transaction(ctx, func(txCtx context.Context) error {
+		if err := tc.metaDomain.CollectionDb(txCtx).Update(coll); err != nil {
+			return err
+		}
+		return tc.metaDomain.FieldDb(txCtx).Insert(fields)
+	})
 }
 
 func (tc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
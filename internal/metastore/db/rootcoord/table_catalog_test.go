@@ -791,6 +791,29 @@ func TestCatalog_AlterCollection(t *testing.T) {
 	require.NoError(t, gotErr)
 }
 
+func TestCatalog_AlterCollection_AddField(t *testing.T) {
+	coll := &model.Collection{
+		TenantID:     tenantID,
+		CollectionID: collID1,
+		Name:         collName1,
+		State:        pb.CollectionState_CollectionCreated,
+		Fields:       []*model.Field{{FieldID: fieldID1, Name: "test_field_name_1"}},
+	}
+	newColl := coll.Clone()
+	newColl.Fields = append(newColl.Fields, &model.Field{FieldID: fieldID1 + 1, Name: "test_field_name_2"})
+	newColl.SchemaVersion = 1
+
+	collDbMock.On("Update", mock.MatchedBy(func(c *dbmodel.Collection) bool {
+		return c.SchemaVersion == 1
+	})).Return(nil).Once()
+	fieldDbMock.On("Insert", mock.MatchedBy(func(fields []*dbmodel.Field) bool {
+		return len(fields) == 1 && fields[0].FieldID == fieldID1+1
+	})).Return(nil).Once()
+
+	gotErr := mockCatalog.AlterCollection(ctx, coll, newColl, metastore.MODIFY, ts)
+	require.NoError(t, gotErr)
+}
+
 func TestTableCatalog_AlterCollection_TsNot0_AlterTypeError(t *testing.T) {
 	coll := &model.Collection{
 		TenantID:     tenantID,
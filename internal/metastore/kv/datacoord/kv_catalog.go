@@ -204,6 +204,16 @@ func (kc *Catalog) hasBinlogPrefix(segment *datapb.SegmentInfo) (bool, error) {
 }
 
 func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments []*datapb.SegmentInfo, newSegment *datapb.SegmentInfo) error {
+	var newSegments []*datapb.SegmentInfo
+	if newSegment != nil {
+		newSegments = append(newSegments, newSegment)
+	}
+	return kc.AlterSegmentsAndAddNewSegments(ctx, segments, newSegments)
+}
+
+// AlterSegmentsAndAddNewSegments alters the segments and adds the new segments in one transaction,
+// the new segments are the results of a compaction which splits the data into several segments.
+func (kc *Catalog) AlterSegmentsAndAddNewSegments(ctx context.Context, segments []*datapb.SegmentInfo, newSegments []*datapb.SegmentInfo) error {
 	kvs := make(map[string]string)
 
 	for _, s := range segments {
@@ -236,7 +246,7 @@ func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments [
 		kvs[k] = v
 	}
 
-	if newSegment != nil {
+	for _, newSegment := range newSegments {
 		if newSegment.GetNumOfRows() > 0 {
 			segmentKvs, err := buildSegmentAndBinlogsKvs(newSegment)
 			if err != nil {
@@ -260,6 +270,15 @@ func (kc *Catalog) AlterSegmentsAndAddNewSegment(ctx context.Context, segments [
 
 // RevertAlterSegmentsAndAddNewSegment reverts the metastore operation of AlterSegmentsAndAddNewSegment
 func (kc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegment *datapb.SegmentInfo) error {
+	var removeSegments []*datapb.SegmentInfo
+	if removeSegment != nil {
+		removeSegments = append(removeSegments, removeSegment)
+	}
+	return kc.RevertAlterSegmentsAndAddNewSegments(ctx, oldSegments, removeSegments)
+}
+
+// RevertAlterSegmentsAndAddNewSegments reverts the metastore operation of AlterSegmentsAndAddNewSegments
+func (kc *Catalog) RevertAlterSegmentsAndAddNewSegments(ctx context.Context, oldSegments []*datapb.SegmentInfo, removeSegments []*datapb.SegmentInfo) error {
 	var (
 		kvs      = make(map[string]string)
 		removals []string
@@ -274,7 +293,7 @@ func (kc *Catalog) RevertAlterSegmentsAndAddNewSegment(ctx context.Context, oldS
 		maps.Copy(kvs, segmentKvs)
 	}
 
-	if removeSegment != nil {
+	for _, removeSegment := range removeSegments {
 		segKey := buildSegmentPath(removeSegment.GetCollectionID(), removeSegment.GetPartitionID(), removeSegment.GetID())
 		removals = append(removals, segKey)
 		binlogKeys := buildBinlogKeys(removeSegment)
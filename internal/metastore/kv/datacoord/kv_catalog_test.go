@@ -496,6 +496,27 @@ func Test_AlterSegmentsAndAddNewSegment(t *testing.T) {
 	})
 }
 
+func Test_AlterSegmentsAndAddNewSegments(t *testing.T) {
+	txn := &MockedTxnKV{}
+	savedKvs := make(map[string]string, 0)
+	txn.multiSave = func(kvs map[string]string) error {
+		maps.Copy(savedKvs, kvs)
+		return nil
+	}
+	txn.loadWithPrefix = func(key string) ([]string, []string, error) {
+		return []string{}, []string{}, nil
+	}
+
+	segment2 := proto.Clone(segment1).(*datapb.SegmentInfo)
+	segment2.ID = segmentID + 100
+	catalog := &Catalog{txn, "a"}
+	err := catalog.AlterSegmentsAndAddNewSegments(context.TODO(), []*datapb.SegmentInfo{droppedSegment}, []*datapb.SegmentInfo{segment1, segment2})
+	assert.NoError(t, err)
+	assert.Contains(t, savedKvs, buildSegmentPath(collectionID, partitionID, segment1.ID))
+	assert.Contains(t, savedKvs, buildSegmentPath(collectionID, partitionID, segment2.ID))
+	assert.Contains(t, savedKvs, buildSegmentPath(collectionID, partitionID, droppedSegment.ID))
+}
+
 func Test_DropSegment(t *testing.T) {
 	t.Run("remove failed", func(t *testing.T) {
 		txn := &MockedTxnKV{}
@@ -374,12 +374,36 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 	oldCollClone.CreateTime = newColl.CreateTime
 	oldCollClone.ConsistencyLevel = newColl.ConsistencyLevel
 	oldCollClone.State = newColl.State
+	oldCollClone.Properties = newColl.Properties
+	oldCollClone.SchemaVersion = newColl.SchemaVersion
 	key := BuildCollectionKey(oldColl.CollectionID)
 	value, err := proto.Marshal(model.MarshalCollectionModel(oldCollClone))
 	if err != nil {
 		return err
 	}
-	return kc.Snapshot.Save(key, string(value), ts)
+
+	// save newly added fields to newly path, existing fields are never changed by altering.
+	kvs := map[string]string{}
+	oldFields := make(map[int64]struct{}, len(oldColl.Fields))
+	for _, field := range oldColl.Fields {
+		oldFields[field.FieldID] = struct{}{}
+	}
+	for _, field := range newColl.Fields {
+		if _, ok := oldFields[field.FieldID]; ok {
+			continue
+		}
+		k := BuildFieldKey(oldColl.CollectionID, field.FieldID)
+		v, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return err
+		}
+		kvs[k] = string(v)
+	}
+	if len(kvs) == 0 {
+		return kc.Snapshot.Save(key, string(value), ts)
+	}
+	kvs[key] = string(value)
+	return kc.Snapshot.MultiSave(kvs, ts)
 }
 
 func (kc *Catalog) AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType metastore.AlterType, ts typeutil.Timestamp) error {
@@ -25,6 +25,7 @@ type Collection struct {
 	Aliases              []string // TODO: deprecate this.
 	Properties           []*commonpb.KeyValuePair
 	State                pb.CollectionState
+	SchemaVersion        int32
 }
 
 func (c Collection) Available() bool {
@@ -49,6 +50,7 @@ func (c Collection) Clone() *Collection {
 		Aliases:              common.CloneStringList(c.Aliases),
 		Properties:           common.CloneKeyValuePairs(c.Properties),
 		State:                c.State,
+		SchemaVersion:        c.SchemaVersion,
 	}
 }
 
@@ -67,7 +69,8 @@ func (c Collection) Equal(other Collection) bool {
 		c.AutoID == other.AutoID &&
 		CheckFieldsEqual(c.Fields, other.Fields) &&
 		c.ShardsNum == other.ShardsNum &&
-		c.ConsistencyLevel == other.ConsistencyLevel
+		c.ConsistencyLevel == other.ConsistencyLevel &&
+		c.SchemaVersion == other.SchemaVersion
 }
 
 func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
@@ -100,6 +103,7 @@ func UnmarshalCollectionModel(coll *pb.CollectionInfo) *Collection {
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
 		Properties:           coll.Properties,
+		SchemaVersion:        coll.SchemaVersion,
 	}
 }
 
@@ -159,6 +163,7 @@ func marshalCollectionModelWithConfig(coll *Collection, c *config) *pb.Collectio
 		StartPositions:       coll.StartPositions,
 		State:                coll.State,
 		Properties:           coll.Properties,
+		SchemaVersion:        coll.SchemaVersion,
 	}
 
 	if c.withPartitions {
@@ -57,6 +57,7 @@ var (
 				Value: "v",
 			},
 		},
+		SchemaVersion: 1,
 	}
 
 	deprecatedColPb = &pb.CollectionInfo{
@@ -88,6 +89,7 @@ var (
 				Value: "v",
 			},
 		},
+		SchemaVersion: 1,
 	}
 )
 
@@ -95,6 +95,14 @@ var (
 			Help:      "binlog size of segments",
 		}, []string{segmentStateLabelName})
 
+	DataCoordNumCorruptedBinlogs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "corrupted_binlog_num",
+			Help:      "number of missing or corrupted log files found by the last binlog verification",
+		}, []string{statusLabelName})
+
 	/* hard to implement, commented now
 	DataCoordSegmentSizeRatio = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -151,4 +159,5 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(DataCoordNumStoredRowsCounter)
 	registry.MustRegister(DataCoordConsumeDataNodeTimeTickLag)
 	registry.MustRegister(DataCoordStoredBinlogSize)
+	registry.MustRegister(DataCoordNumCorruptedBinlogs)
 }
@@ -197,6 +197,31 @@ var (
 			Help:      "forward delete message time taken",
 			Buckets:   buckets, // unit: ms
 		}, []string{nodeIDLabelName})
+
+	// DataNodeThrottledTime records the time compactions wait for the I/O bandwidth or a compaction slot.
+	DataNodeThrottledTime = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "throttled_time_ms",
+			Help:      "time waited for the io bandwidth or a compaction slot",
+			Buckets:   buckets, // unit: ms
+		}, []string{
+			nodeIDLabelName,
+			throttleTypeLabelName,
+		})
+
+	// DataNodeThrottledBytes counts the bytes passed through the I/O throttles.
+	DataNodeThrottledBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "throttled_bytes",
+			Help:      "bytes passed through the io throttles",
+		}, []string{
+			nodeIDLabelName,
+			throttleTypeLabelName,
+		})
 )
 
 // RegisterDataNode registers DataNode metrics
@@ -217,6 +242,8 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeProduceTimeTickLag)
 	registry.MustRegister(DataNodeConsumeBytesCount)
 	registry.MustRegister(DataNodeForwardDeleteMsgTimeTaken)
+	registry.MustRegister(DataNodeThrottledTime)
+	registry.MustRegister(DataNodeThrottledBytes)
 }
 
 func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel string) {
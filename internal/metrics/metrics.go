@@ -48,6 +48,13 @@ const (
 	FailedIndexTaskLabel     = "failed"
 	RecycledIndexTaskLabel   = "recycled"
 
+	MissingBinlogLabel   = "missing"
+	CorruptedBinlogLabel = "corrupted"
+
+	UploadLabel         = "upload"
+	DownloadLabel       = "download"
+	CompactionSlotLabel = "compaction_slot"
+
 	// Note: below must matchcommonpb.SegmentState_name fields.
 	SealedSegmentLabel   = "Sealed"
 	GrowingSegmentLabel  = "Growing"
@@ -75,6 +82,9 @@ const (
 	cacheStateLabelName      = "cache_state"
 	indexCountLabelName      = "indexed_field_count"
 	requestScope             = "scope"
+	componentLabelName       = "component"
+	storageOpLabelName       = "operation"
+	throttleTypeLabelName    = "throttle_type"
 )
 
 var (
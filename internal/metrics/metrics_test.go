@@ -34,5 +34,6 @@ func TestRegisterMetrics(t *testing.T) {
 	RegisterQueryNode(r)
 	RegisterQueryCoord(r)
 	RegisterEtcdMetrics(r)
+	RegisterStorageMetrics(r)
 	Register(r)
 }
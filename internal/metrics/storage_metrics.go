@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	storageSubsystem      = "storage"
+	storageCacheSubsystem = "storage_cache"
+)
+
+var (
+	StorageRequestTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageSubsystem,
+			Name:      "request_count",
+			Help:      "count of requests to the object storage, retries are not counted",
+		}, []string{componentLabelName, storageOpLabelName, statusLabelName})
+
+	StorageRequestRetries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageSubsystem,
+			Name:      "request_retry_count",
+			Help:      "count of retried requests to the object storage",
+		}, []string{componentLabelName, storageOpLabelName})
+
+	StorageRequestLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageSubsystem,
+			Name:      "request_latency",
+			Help:      "latency in milliseconds of requests to the object storage, including retries",
+			Buckets:   buckets,
+		}, []string{componentLabelName, storageOpLabelName})
+
+	StorageRequestBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageSubsystem,
+			Name:      "request_bytes",
+			Help:      "bytes read from or written to the object storage",
+		}, []string{componentLabelName, storageOpLabelName})
+
+	StorageCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageCacheSubsystem,
+			Name:      "request_count",
+			Help:      "count of reads served by the local storage cache",
+		}, []string{cacheStateLabelName})
+
+	StorageCacheEvictions = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageCacheSubsystem,
+			Name:      "eviction_count",
+			Help:      "count of files evicted from the local storage cache",
+		})
+
+	StorageCacheSize = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageCacheSubsystem,
+			Name:      "size",
+			Help:      "disk size in bytes of the files in the local storage cache",
+		})
+
+	StorageCacheLoadLatency = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: storageCacheSubsystem,
+			Name:      "load_latency",
+			Help:      "latency in milliseconds of loading a file from the remote storage into the local storage cache",
+			Buckets:   buckets,
+		})
+)
+
+// RegisterStorageMetrics registers storage metrics
+func RegisterStorageMetrics(registry *prometheus.Registry) {
+	registry.MustRegister(StorageRequestTotal)
+	registry.MustRegister(StorageRequestRetries)
+	registry.MustRegister(StorageRequestLatency)
+	registry.MustRegister(StorageRequestBytes)
+	registry.MustRegister(StorageCacheRequests)
+	registry.MustRegister(StorageCacheEvictions)
+	registry.MustRegister(StorageCacheSize)
+	registry.MustRegister(StorageCacheLoadLatency)
+}
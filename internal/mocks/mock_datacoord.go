@@ -170,6 +170,100 @@ func (_c *DataCoord_BroadcastAlteredCollection_Call) Return(_a0 *commonpb.Status
 	return _c
 }
 
+// CancelCompaction provides a mock function with given fields: ctx, req
+func (_m *DataCoord) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelCompactionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_CancelCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCompaction'
+type DataCoord_CancelCompaction_Call struct {
+	*mock.Call
+}
+
+// CancelCompaction is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.CancelCompactionRequest
+func (_e *DataCoord_Expecter) CancelCompaction(ctx interface{}, req interface{}) *DataCoord_CancelCompaction_Call {
+	return &DataCoord_CancelCompaction_Call{Call: _e.mock.On("CancelCompaction", ctx, req)}
+}
+
+func (_c *DataCoord_CancelCompaction_Call) Run(run func(ctx context.Context, req *datapb.CancelCompactionRequest)) *DataCoord_CancelCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelCompactionRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_CancelCompaction_Call) Return(_a0 *commonpb.Status, _a1 error) *DataCoord_CancelCompaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// CancelExport provides a mock function with given fields: ctx, req
+func (_m *DataCoord) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelExportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_CancelExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExport'
+type DataCoord_CancelExport_Call struct {
+	*mock.Call
+}
+
+// CancelExport is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.CancelExportRequest
+func (_e *DataCoord_Expecter) CancelExport(ctx interface{}, req interface{}) *DataCoord_CancelExport_Call {
+	return &DataCoord_CancelExport_Call{Call: _e.mock.On("CancelExport", ctx, req)}
+}
+
+func (_c *DataCoord_CancelExport_Call) Run(run func(ctx context.Context, req *datapb.CancelExportRequest)) *DataCoord_CancelExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelExportRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_CancelExport_Call) Return(_a0 *commonpb.Status, _a1 error) *DataCoord_CancelExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, req
 func (_m *DataCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -264,6 +358,53 @@ func (_c *DataCoord_DropVirtualChannel_Call) Return(_a0 *datapb.DropVirtualChann
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx, req
+func (_m *DataCoord) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.ExportResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportRequest) *datapb.ExportResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.ExportResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type DataCoord_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.ExportRequest
+func (_e *DataCoord_Expecter) Export(ctx interface{}, req interface{}) *DataCoord_Export_Call {
+	return &DataCoord_Export_Call{Call: _e.mock.On("Export", ctx, req)}
+}
+
+func (_c *DataCoord_Export_Call) Run(run func(ctx context.Context, req *datapb.ExportRequest)) *DataCoord_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ExportRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_Export_Call) Return(_a0 *datapb.ExportResponse, _a1 error) *DataCoord_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Flush provides a mock function with given fields: ctx, req
 func (_m *DataCoord) Flush(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -358,6 +499,53 @@ func (_c *DataCoord_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollecti
 	return _c
 }
 
+// GetCompactionPlanStates provides a mock function with given fields: ctx, req
+func (_m *DataCoord) GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetCompactionPlanStatesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionPlanStatesRequest) *datapb.GetCompactionPlanStatesResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetCompactionPlanStatesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCompactionPlanStatesRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_GetCompactionPlanStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionPlanStates'
+type DataCoord_GetCompactionPlanStates_Call struct {
+	*mock.Call
+}
+
+// GetCompactionPlanStates is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.GetCompactionPlanStatesRequest
+func (_e *DataCoord_Expecter) GetCompactionPlanStates(ctx interface{}, req interface{}) *DataCoord_GetCompactionPlanStates_Call {
+	return &DataCoord_GetCompactionPlanStates_Call{Call: _e.mock.On("GetCompactionPlanStates", ctx, req)}
+}
+
+func (_c *DataCoord_GetCompactionPlanStates_Call) Run(run func(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest)) *DataCoord_GetCompactionPlanStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetCompactionPlanStatesRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_GetCompactionPlanStates_Call) Return(_a0 *datapb.GetCompactionPlanStatesResponse, _a1 error) *DataCoord_GetCompactionPlanStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetCompactionState provides a mock function with given fields: ctx, req
 func (_m *DataCoord) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -498,6 +686,53 @@ func (_c *DataCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentState
 	return _c
 }
 
+// GetExportState provides a mock function with given fields: ctx, req
+func (_m *DataCoord) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *datapb.GetExportStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetExportStateRequest) *datapb.GetExportStateResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetExportStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetExportStateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_GetExportState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExportState'
+type DataCoord_GetExportState_Call struct {
+	*mock.Call
+}
+
+// GetExportState is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.GetExportStateRequest
+func (_e *DataCoord_Expecter) GetExportState(ctx interface{}, req interface{}) *DataCoord_GetExportState_Call {
+	return &DataCoord_GetExportState_Call{Call: _e.mock.On("GetExportState", ctx, req)}
+}
+
+func (_c *DataCoord_GetExportState_Call) Run(run func(ctx context.Context, req *datapb.GetExportStateRequest)) *DataCoord_GetExportState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetExportStateRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_GetExportState_Call) Return(_a0 *datapb.GetExportStateResponse, _a1 error) *DataCoord_GetExportState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetFlushState provides a mock function with given fields: ctx, req
 func (_m *DataCoord) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -1189,6 +1424,53 @@ func (_c *DataCoord_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompaction
 	return _c
 }
 
+// ManualCompactionWithScope provides a mock function with given fields: ctx, req
+func (_m *DataCoord) ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *milvuspb.ManualCompactionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ManualCompactionWithScopeRequest) *milvuspb.ManualCompactionResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ManualCompactionWithScopeRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_ManualCompactionWithScope_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompactionWithScope'
+type DataCoord_ManualCompactionWithScope_Call struct {
+	*mock.Call
+}
+
+// ManualCompactionWithScope is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.ManualCompactionWithScopeRequest
+func (_e *DataCoord_Expecter) ManualCompactionWithScope(ctx interface{}, req interface{}) *DataCoord_ManualCompactionWithScope_Call {
+	return &DataCoord_ManualCompactionWithScope_Call{Call: _e.mock.On("ManualCompactionWithScope", ctx, req)}
+}
+
+func (_c *DataCoord_ManualCompactionWithScope_Call) Run(run func(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest)) *DataCoord_ManualCompactionWithScope_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ManualCompactionWithScopeRequest))
+	})
+	return _c
+}
+
+func (_c *DataCoord_ManualCompactionWithScope_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *DataCoord_ManualCompactionWithScope_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // MarkSegmentsDropped provides a mock function with given fields: ctx, req
 func (_m *DataCoord) MarkSegmentsDropped(ctx context.Context, req *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -1319,6 +1601,53 @@ func (_c *DataCoord_ReleaseSegmentLock_Call) Return(_a0 *commonpb.Status, _a1 er
 	return _c
 }
 
+// ReportExport provides a mock function with given fields: ctx, req
+func (_m *DataCoord) ReportExport(ctx context.Context, req *datapb.ExportTaskResult) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportTaskResult) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportTaskResult) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoord_ReportExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportExport'
+type DataCoord_ReportExport_Call struct {
+	*mock.Call
+}
+
+// ReportExport is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.ExportTaskResult
+func (_e *DataCoord_Expecter) ReportExport(ctx interface{}, req interface{}) *DataCoord_ReportExport_Call {
+	return &DataCoord_ReportExport_Call{Call: _e.mock.On("ReportExport", ctx, req)}
+}
+
+func (_c *DataCoord_ReportExport_Call) Run(run func(ctx context.Context, req *datapb.ExportTaskResult)) *DataCoord_ReportExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ExportTaskResult))
+	})
+	return _c
+}
+
+func (_c *DataCoord_ReportExport_Call) Return(_a0 *commonpb.Status, _a1 error) *DataCoord_ReportExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // SaveBinlogPaths provides a mock function with given fields: ctx, req
 func (_m *DataCoord) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -76,6 +76,100 @@ func (_c *DataNode_AddImportSegment_Call) Return(_a0 *datapb.AddImportSegmentRes
 	return _c
 }
 
+// CancelCompaction provides a mock function with given fields: ctx, req
+func (_m *DataNode) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelCompactionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataNode_CancelCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCompaction'
+type DataNode_CancelCompaction_Call struct {
+	*mock.Call
+}
+
+// CancelCompaction is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.CancelCompactionRequest
+func (_e *DataNode_Expecter) CancelCompaction(ctx interface{}, req interface{}) *DataNode_CancelCompaction_Call {
+	return &DataNode_CancelCompaction_Call{Call: _e.mock.On("CancelCompaction", ctx, req)}
+}
+
+func (_c *DataNode_CancelCompaction_Call) Run(run func(ctx context.Context, req *datapb.CancelCompactionRequest)) *DataNode_CancelCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelCompactionRequest))
+	})
+	return _c
+}
+
+func (_c *DataNode_CancelCompaction_Call) Return(_a0 *commonpb.Status, _a1 error) *DataNode_CancelCompaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// CancelExport provides a mock function with given fields: ctx, req
+func (_m *DataNode) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelExportRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelExportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataNode_CancelExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelExport'
+type DataNode_CancelExport_Call struct {
+	*mock.Call
+}
+
+// CancelExport is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.CancelExportRequest
+func (_e *DataNode_Expecter) CancelExport(ctx interface{}, req interface{}) *DataNode_CancelExport_Call {
+	return &DataNode_CancelExport_Call{Call: _e.mock.On("CancelExport", ctx, req)}
+}
+
+func (_c *DataNode_CancelExport_Call) Run(run func(ctx context.Context, req *datapb.CancelExportRequest)) *DataNode_CancelExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CancelExportRequest))
+	})
+	return _c
+}
+
+func (_c *DataNode_CancelExport_Call) Return(_a0 *commonpb.Status, _a1 error) *DataNode_CancelExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Compaction provides a mock function with given fields: ctx, req
 func (_m *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -123,6 +217,53 @@ func (_c *DataNode_Compaction_Call) Return(_a0 *commonpb.Status, _a1 error) *Dat
 	return _c
 }
 
+// Export provides a mock function with given fields: ctx, req
+func (_m *DataNode) Export(ctx context.Context, req *datapb.ExportTask) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportTask) *commonpb.Status); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportTask) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataNode_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type DataNode_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//  - ctx context.Context
+//  - req *datapb.ExportTask
+func (_e *DataNode_Expecter) Export(ctx interface{}, req interface{}) *DataNode_Export_Call {
+	return &DataNode_Export_Call{Call: _e.mock.On("Export", ctx, req)}
+}
+
+func (_c *DataNode_Export_Call) Run(run func(ctx context.Context, req *datapb.ExportTask)) *DataNode_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.ExportTask))
+	})
+	return _c
+}
+
+func (_c *DataNode_Export_Call) Return(_a0 *commonpb.Status, _a1 error) *DataNode_Export_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // FlushSegments provides a mock function with given fields: ctx, req
 func (_m *DataNode) FlushSegments(ctx context.Context, req *datapb.FlushSegmentsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, req)
@@ -31,6 +31,53 @@ func (_m *RootCoord) EXPECT() *RootCoord_Expecter {
 	return &RootCoord_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, request
+func (_m *RootCoord) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *commonpb.Status
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type RootCoord_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//  - ctx context.Context
+//  - request *proxypb.AddCollectionFieldRequest
+func (_e *RootCoord_Expecter) AddCollectionField(ctx interface{}, request interface{}) *RootCoord_AddCollectionField_Call {
+	return &RootCoord_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", ctx, request)}
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Run(run func(ctx context.Context, request *proxypb.AddCollectionFieldRequest)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: ctx, req
 func (_m *RootCoord) AllocID(ctx context.Context, req *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret := _m.Called(ctx, req)
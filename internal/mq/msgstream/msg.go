@@ -204,6 +204,12 @@ func (it *InsertMsg) CheckAligned() error {
 				return numRowsOfFieldDataMismatch(field.FieldName, fieldNumRows, rowNums)
 			}
 		}
+		for _, validData := range it.GetValidData() {
+			if uint64(len(validData.GetValidData())) != rowNums {
+				return fmt.Errorf("the num_rows(%d) of valid data of field %d is not equal to passed NumRows(%d)",
+					len(validData.GetValidData()), validData.GetFieldID(), rowNums)
+			}
+		}
 	}
 
 	if len(it.GetRowIDs()) != len(it.GetTimestamps()) {
@@ -243,6 +249,13 @@ func (it *InsertMsg) columnBasedIndexRequest(index int) internalpb.InsertRequest
 	colNum := len(it.GetFieldsData())
 	fieldsData := make([]*schemapb.FieldData, colNum)
 	typeutil.AppendFieldData(fieldsData, it.GetFieldsData(), int64(index))
+	var validData []*internalpb.FieldValidData
+	for _, fieldValidData := range it.GetValidData() {
+		validData = append(validData, &internalpb.FieldValidData{
+			FieldID:   fieldValidData.GetFieldID(),
+			ValidData: []bool{fieldValidData.GetValidData()[index]},
+		})
+	}
 	return internalpb.InsertRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgType(commonpb.MsgType_Insert),
@@ -260,6 +273,7 @@ func (it *InsertMsg) columnBasedIndexRequest(index int) internalpb.InsertRequest
 		Timestamps:     []uint64{it.Timestamps[index]},
 		RowIDs:         []int64{it.RowIDs[index]},
 		FieldsData:     fieldsData,
+		ValidData:      validData,
 		NumRows:        1,
 		Version:        internalpb.InsertDataVersion_ColumnBased,
 	}
@@ -210,6 +210,11 @@ func TestInsertMsg_CheckAligned(t *testing.T) {
 
 	msg1.Version = internalpb.InsertDataVersion_ColumnBased
 	assert.NoError(t, msg1.CheckAligned())
+
+	msg1.InsertRequest.ValidData = []*internalpb.FieldValidData{{FieldID: 100, ValidData: []bool{false}}}
+	assert.NoError(t, msg1.CheckAligned())
+	msg1.InsertRequest.ValidData[0].ValidData = []bool{false, true}
+	assert.Error(t, msg1.CheckAligned())
 }
 
 func TestInsertMsg_IndexMsg(t *testing.T) {
@@ -264,10 +269,12 @@ func TestInsertMsg_IndexMsg(t *testing.T) {
 			FieldId: 0,
 		},
 	}
+	msg.ValidData = []*internalpb.FieldValidData{{FieldID: 0, ValidData: []bool{false}}}
 	indexMsg = msg.IndexMsg(0)
 	assert.Equal(t, uint64(10), indexMsg.GetTimestamps()[0])
 	assert.Equal(t, int64(11), indexMsg.GetRowIDs()[0])
 	assert.Equal(t, int64(1), indexMsg.FieldsData[0].Field.(*schemapb.FieldData_Scalars).Scalars.Data.(*schemapb.ScalarField_LongData).LongData.Data[0])
+	assert.Equal(t, []bool{false}, indexMsg.GetValidData()[0].GetValidData())
 }
 
 func TestDeleteMsg(t *testing.T) {
@@ -1,17 +1,49 @@
 package planparserv2
 
 import (
-	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 )
 
+// SyntaxError is a syntax error reported by the lexer or parser, along with its position in the expression.
+// Line starts from 1 and Column starts from 0, as reported by antlr.
+type SyntaxError struct {
+	Line    int
+	Column  int
+	Token   string
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return "line " + strconv.Itoa(e.Line) + ":" + strconv.Itoa(e.Column) + " " + e.Message
+}
+
+// SyntaxErrors are all the syntax errors of an expression, in the order they were reported.
+type SyntaxErrors []*SyntaxError
+
+func (e SyntaxErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, syntaxErr := range e {
+		msgs = append(msgs, syntaxErr.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
 type errorListener struct {
 	*antlr.DefaultErrorListener
-	err error
+	err SyntaxErrors
 }
 
 func (l *errorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-	l.err = fmt.Errorf("line " + strconv.Itoa(line) + ":" + strconv.Itoa(column) + " " + msg)
+	syntaxErr := &SyntaxError{
+		Line:    line,
+		Column:  column,
+		Message: msg,
+	}
+	if token, ok := offendingSymbol.(antlr.Token); ok {
+		syntaxErr.Token = token.GetText()
+	}
+	l.err = append(l.err, syntaxErr)
 }
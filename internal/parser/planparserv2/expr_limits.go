@@ -0,0 +1,131 @@
+package planparserv2
+
+import (
+	"fmt"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	antlrparser "github.com/milvus-io/milvus/internal/parser/planparserv2/generated"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// exprLimits bounds the cost of a single expression, a non-positive value means no limit.
+type exprLimits struct {
+	maxLength   int64 // max length of the expression string in bytes
+	maxTermSize int64 // max number of values in an `in` list
+	maxDepth    int64 // max depth of the syntax tree
+}
+
+func getExprLimits() exprLimits {
+	params := paramtable.Get()
+	return exprLimits{
+		maxLength:   params.ProxyCfg.MaxExprLength,
+		maxTermSize: params.ProxyCfg.MaxExprTermSize,
+		maxDepth:    params.ProxyCfg.MaxExprDepth,
+	}
+}
+
+func (l exprLimits) checkLength(exprStr string) error {
+	if l.maxLength > 0 && int64(len(exprStr)) > l.maxLength {
+		return fmt.Errorf("expression length %d exceeds the limit %d", len(exprStr), l.maxLength)
+	}
+	return nil
+}
+
+// checkTokens scans the tokens before they are parsed. The parser recurses into every parenthesis,
+// `in` list and prefix operator, so their nesting is bounded by maxDepth before the parser runs,
+// and the values of every `in` list are counted against maxTermSize.
+func (l exprLimits) checkTokens(tokens []antlr.Token) error {
+	type level struct {
+		term   bool  // whether the level is an `in` list
+		values int64 // number of values of the `in` list
+		unary  int64 // number of prefix operators applied to the current operand
+	}
+	levels := []*level{{}}
+	var depth int64
+	// whether the next token starts an operand, so that `+`, `-` are prefix operators
+	operand := true
+	var prev int
+	for _, token := range tokens {
+		cur := levels[len(levels)-1]
+		tokenType := token.GetTokenType()
+		switch tokenType {
+		case antlrparser.PlanLexerT__0, antlrparser.PlanLexerT__2: // '(' '['
+			depth++
+			levels = append(levels, &level{term: tokenType == antlrparser.PlanLexerT__2})
+			operand = true
+		case antlrparser.PlanLexerT__1, antlrparser.PlanLexerT__4: // ')' ']'
+			if len(levels) == 1 {
+				// unbalanced, reported by the parser
+				break
+			}
+			if cur.term && prev != antlrparser.PlanLexerT__2 && prev != antlrparser.PlanLexerT__3 {
+				cur.values++
+			}
+			depth -= cur.unary + 1
+			levels = levels[:len(levels)-1]
+			operand = false
+		case antlrparser.PlanLexerT__3: // ','
+			if cur.term {
+				cur.values++
+			}
+			depth -= cur.unary
+			cur.unary = 0
+			operand = true
+		case antlrparser.PlanLexerADD, antlrparser.PlanLexerSUB, antlrparser.PlanLexerBNOT, antlrparser.PlanLexerNOT:
+			if operand {
+				depth++
+				cur.unary++
+			} else {
+				depth -= cur.unary
+				cur.unary = 0
+			}
+			operand = true
+		case antlrparser.PlanLexerPOW, antlrparser.PlanLexerLIKE:
+			// bind tighter than prefix operators
+			operand = true
+		case antlrparser.PlanLexerBooleanConstant, antlrparser.PlanLexerIntegerConstant, antlrparser.PlanLexerFloatingConstant,
+			antlrparser.PlanLexerIdentifier, antlrparser.PlanLexerStringLiteral, antlrparser.PlanLexerEmptyTerm,
+			antlrparser.PlanLexerISNULL, antlrparser.PlanLexerISNOTNULL:
+			operand = false
+		default:
+			depth -= cur.unary
+			cur.unary = 0
+			operand = true
+		}
+		prev = tokenType
+
+		if l.maxDepth > 0 && depth > l.maxDepth {
+			return fmt.Errorf("expression depth exceeds the limit %d", l.maxDepth)
+		}
+		if l.maxTermSize > 0 && cur.values > l.maxTermSize {
+			return fmt.Errorf("number of values in 'term' exceeds the limit %d", l.maxTermSize)
+		}
+	}
+	return nil
+}
+
+// checkDepth walks the syntax tree without recursion, so that a tree deepened by long operator
+// chains is rejected before the visitor recurses into it.
+func (l exprLimits) checkDepth(tree antlr.Tree) error {
+	if l.maxDepth <= 0 {
+		return nil
+	}
+
+	type node struct {
+		tree  antlr.Tree
+		depth int64
+	}
+	stack := []node{{tree: tree, depth: 1}}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if n.depth > l.maxDepth {
+			return fmt.Errorf("expression depth exceeds the limit %d", l.maxDepth)
+		}
+		for _, child := range n.tree.GetChildren() {
+			stack = append(stack, node{tree: child, depth: n.depth + 1})
+		}
+	}
+	return nil
+}
@@ -52,6 +52,9 @@ type PlanVisitor interface {
 	// Visit a parse tree produced by PlanParser#Identifier.
 	VisitIdentifier(ctx *IdentifierContext) interface{}
 
+	// Visit a parse tree produced by PlanParser#NullCheck.
+	VisitNullCheck(ctx *NullCheckContext) interface{}
+
 	// Visit a parse tree produced by PlanParser#BitXor.
 	VisitBitXor(ctx *BitXorContext) interface{}
 
@@ -12,6 +12,7 @@ type LogicalExprVisitor interface {
 	VisitBinaryRangeExpr(expr *planpb.BinaryRangeExpr) interface{}
 	VisitBinaryArithOpEvalRangeExpr(expr *planpb.BinaryArithOpEvalRangeExpr) interface{}
 	VisitBinaryArithExpr(expr *planpb.BinaryArithExpr) interface{}
+	VisitArithCompareExpr(expr *planpb.ArithCompareExpr) interface{}
 	VisitValueExpr(expr *planpb.ValueExpr) interface{}
 	VisitColumnExpr(expr *planpb.ColumnExpr) interface{}
 }
@@ -157,12 +157,10 @@ func (v *ParserVisitor) VisitAddSub(ctx *parser.AddSubContext) interface{} {
 
 	var leftExpr *ExprWithType
 	var rightExpr *ExprWithType
-	reverse := true
 
 	if leftValue != nil {
 		leftExpr = toValueExpr(leftValue)
 	} else {
-		reverse = false
 		leftExpr = getExpr(left)
 	}
 	if rightValue != nil {
@@ -179,19 +177,20 @@ func (v *ParserVisitor) VisitAddSub(ctx *parser.AddSubContext) interface{} {
 		return fmt.Errorf("'%s' can only be used between integer or floating expressions", arithNameMap[ctx.GetOp().GetTokenType()])
 	}
 
+	dataType, err := promoteArithType(leftExpr.dataType, rightExpr.dataType)
+	if err != nil {
+		return err
+	}
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_BinaryArithExpr{
 			BinaryArithExpr: &planpb.BinaryArithExpr{
-				Left:  leftExpr.expr,
-				Right: rightExpr.expr,
-				Op:    arithExprMap[ctx.GetOp().GetTokenType()],
+				Left:     leftExpr.expr,
+				Right:    rightExpr.expr,
+				Op:       arithExprMap[ctx.GetOp().GetTokenType()],
+				DataType: dataType,
 			},
 		},
 	}
-	dataType, err := calcDataType(leftExpr, rightExpr, reverse)
-	if err != nil {
-		return err
-	}
 	return &ExprWithType{
 		expr:     expr,
 		dataType: dataType,
@@ -234,13 +233,11 @@ func (v *ParserVisitor) VisitMulDivMod(ctx *parser.MulDivModContext) interface{}
 
 	var leftExpr *ExprWithType
 	var rightExpr *ExprWithType
-	reverse := true
 
 	if leftValue != nil {
 		leftExpr = toValueExpr(leftValue)
 	} else {
 		leftExpr = getExpr(left)
-		reverse = false
 	}
 	if rightValue != nil {
 		rightExpr = toValueExpr(rightValue)
@@ -264,19 +261,30 @@ func (v *ParserVisitor) VisitMulDivMod(ctx *parser.MulDivModContext) interface{}
 	default:
 		break
 	}
+	if IsZero(rightValue) {
+		switch ctx.GetOp().GetTokenType() {
+		case parser.PlanParserDIV:
+			return fmt.Errorf("cannot divide by zero")
+		case parser.PlanParserMOD:
+			return fmt.Errorf("cannot modulo by zero")
+		default:
+			break
+		}
+	}
+	dataType, err := promoteArithType(leftExpr.dataType, rightExpr.dataType)
+	if err != nil {
+		return err
+	}
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_BinaryArithExpr{
 			BinaryArithExpr: &planpb.BinaryArithExpr{
-				Left:  leftExpr.expr,
-				Right: rightExpr.expr,
-				Op:    arithExprMap[ctx.GetOp().GetTokenType()],
+				Left:     leftExpr.expr,
+				Right:    rightExpr.expr,
+				Op:       arithExprMap[ctx.GetOp().GetTokenType()],
+				DataType: dataType,
 			},
 		},
 	}
-	dataType, err := calcDataType(leftExpr, rightExpr, reverse)
-	if err != nil {
-		return err
-	}
 	return &ExprWithType{
 		expr:     expr,
 		dataType: dataType,
@@ -531,6 +539,39 @@ func (v *ParserVisitor) VisitEmptyTerm(ctx *parser.EmptyTermContext) interface{}
 	}
 }
 
+// VisitNullCheck translates expr to null plan.
+func (v *ParserVisitor) VisitNullCheck(ctx *parser.NullCheckContext) interface{} {
+	identifier := ctx.Identifier().GetText()
+	field, err := v.schema.GetFieldFromName(identifier)
+	if err != nil {
+		return err
+	}
+	if !typeutil.IsFieldNullable(field) {
+		return fmt.Errorf("'is null' can only be used on nullable field, but got: %s", identifier)
+	}
+
+	expr, err := v.translateIdentifier(identifier)
+	if err != nil {
+		return err
+	}
+
+	op := planpb.NullExpr_IsNull
+	if ctx.GetOp().GetTokenType() == parser.PlanParserISNOTNULL {
+		op = planpb.NullExpr_IsNotNull
+	}
+	return &ExprWithType{
+		expr: &planpb.Expr{
+			Expr: &planpb.Expr_NullExpr{
+				NullExpr: &planpb.NullExpr{
+					ColumnInfo: toColumnInfo(expr),
+					Op:         op,
+				},
+			},
+		},
+		dataType: schemapb.DataType_Bool,
+	}
+}
+
 // VisitRange translates expr to range plan.
 func (v *ParserVisitor) VisitRange(ctx *parser.RangeContext) interface{} {
 	identifier := ctx.Identifier().GetText()
@@ -11,10 +11,18 @@ import (
 )
 
 func handleExpr(schema *typeutil.SchemaHelper, exprStr string) interface{} {
+	return handleExprWithLimits(schema, exprStr, getExprLimits())
+}
+
+func handleExprWithLimits(schema *typeutil.SchemaHelper, exprStr string, limits exprLimits) interface{} {
 	if exprStr == "" {
 		return nil
 	}
 
+	if err := limits.checkLength(exprStr); err != nil {
+		return err
+	}
+
 	inputStream := antlr.NewInputStream(exprStr)
 	errorListener := &errorListener{}
 
@@ -28,6 +36,16 @@ func handleExpr(schema *typeutil.SchemaHelper, exprStr string) interface{} {
 		return errorListener.err
 	}
 
+	// lex the whole expression ahead, the parser reads the buffered tokens
+	tokenStream := parser.GetTokenStream().(*antlr.CommonTokenStream)
+	tokenStream.Fill()
+	if errorListener.err != nil {
+		return errorListener.err
+	}
+	if err := limits.checkTokens(tokenStream.GetAllTokens()); err != nil {
+		return err
+	}
+
 	ast := parser.Expr()
 	if errorListener.err != nil {
 		return errorListener.err
@@ -37,6 +55,10 @@ func handleExpr(schema *typeutil.SchemaHelper, exprStr string) interface{} {
 	putLexer(lexer)
 	putParser(parser)
 
+	if err := limits.checkDepth(ast); err != nil {
+		return err
+	}
+
 	visitor := NewParserVisitor(schema)
 	return ast.Accept(visitor)
 }
@@ -49,7 +71,7 @@ func ParseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, err
 	ret := handleExpr(schema, exprStr)
 
 	if err := getError(ret); err != nil {
-		return nil, fmt.Errorf("cannot parse expression: %s, error: %s", exprStr, err)
+		return nil, fmt.Errorf("cannot parse expression: %s, error: %w", exprStr, err)
 	}
 
 	predicate := getExpr(ret)
@@ -1,13 +1,18 @@
 package planparserv2
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -172,23 +177,106 @@ func TestExpr_BinaryArith(t *testing.T) {
 	exprStrs := []string{
 		`Int64Field % 10 == 9`,
 		`Int64Field % 10 != 9`,
-	}
-	for _, exprStr := range exprStrs {
-		assertValidExpr(t, helper, exprStr)
-	}
-
-	// TODO: enable these after execution backend is ready.
-	unsupported := []string{
 		`Int8Field + 1 < 2`,
 		`Int16Field - 3 <= 4`,
 		`Int32Field * 5 > 6`,
 		`Int64Field / 7 >= 8`,
 		`FloatField + 11 < 12`,
 		`DoubleField - 13 < 14`,
+		`10 - Int64Field == 9`,
+		`10 / Int64Field != 9`,
 	}
-	for _, exprStr := range unsupported {
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	t.Run("range operators", func(t *testing.T) {
+		ops := map[string]planpb.OpType{
+			"<":  planpb.OpType_LessThan,
+			"<=": planpb.OpType_LessEqual,
+			">":  planpb.OpType_GreaterThan,
+			">=": planpb.OpType_GreaterEqual,
+		}
+		for opStr, op := range ops {
+			expr, err := ParseExpr(helper, fmt.Sprintf("Int64Field + 1 %s 2", opStr))
+			assert.NoError(t, err)
+			arithCompareExpr := expr.GetArithCompareExpr()
+			assert.NotNil(t, arithCompareExpr)
+			assert.Equal(t, op, arithCompareExpr.GetOp())
+			assert.Equal(t, schemapb.DataType_Int64, arithCompareExpr.GetDataType())
+			assert.Equal(t, planpb.ArithOpType_Add, arithCompareExpr.GetLeft().GetBinaryArithExpr().GetOp())
+			assert.Equal(t, int64(2), arithCompareExpr.GetRight().GetValueExpr().GetValue().GetInt64Val())
+		}
+
+		// the constant on the left is reversed
+		expr, err := ParseExpr(helper, `2 > Int64Field * 3`)
+		assert.NoError(t, err)
+		assert.Equal(t, planpb.OpType_LessThan, expr.GetArithCompareExpr().GetOp())
+	})
+
+	t.Run("equality operators", func(t *testing.T) {
+		expr, err := ParseExpr(helper, `Int64Field % 10 == 9`)
+		assert.NoError(t, err)
+		assert.NotNil(t, expr.GetBinaryArithOpEvalRangeExpr())
+
+		expr, err = ParseExpr(helper, `10 - Int64Field == 9`)
+		assert.NoError(t, err)
+		assert.NotNil(t, expr.GetArithCompareExpr())
+	})
+}
+
+func TestExpr_ArithCompare(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	exprStrs := []string{
+		`FloatField * Int64Field > 100`,
+		`Int8Field + Int16Field < Int32Field`,
+		`Int64Field - Int32Field >= 1.5`,
+		`(Int64Field + 1) * 2 == 3`,
+		`Int64Field + Int32Field != 3`,
+		`100 <= Int8Field * DoubleField`,
+		`Int64Field % Int32Field == 1`,
+		`Int64Field / Int32Field > FloatField / DoubleField`,
+		`(Int8Field + Int16Field) * 2 < 3 and DoubleField > 1`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprStrs := []string{
+		`Int64Field + Int32Field < VarCharField`,
+		`Int64Field + Int32Field == true`,
+		`Int64Field + Int32Field == "str"`,
+		`FloatField % Int64Field == 1`,
+		`Int64Field / 0 > Int32Field`,
+		`Int64Field % 0 > Int32Field`,
+		`BoolField + Int64Field > 1`,
+	}
+	for _, exprStr := range invalidExprStrs {
 		assertInvalidExpr(t, helper, exprStr)
 	}
+
+	t.Run("promoted type", func(t *testing.T) {
+		expr, err := ParseExpr(helper, `Int8Field * FloatField > 100`)
+		assert.NoError(t, err)
+		arithCompareExpr := expr.GetArithCompareExpr()
+		assert.NotNil(t, arithCompareExpr)
+		assert.Equal(t, planpb.OpType_GreaterThan, arithCompareExpr.GetOp())
+		assert.Equal(t, schemapb.DataType_Double, arithCompareExpr.GetDataType())
+		assert.Equal(t, schemapb.DataType_Double, arithCompareExpr.GetLeft().GetBinaryArithExpr().GetDataType())
+		assert.Equal(t, float64(100), arithCompareExpr.GetRight().GetValueExpr().GetValue().GetFloatVal())
+
+		expr, err = ParseExpr(helper, `1 < Int8Field + Int16Field`)
+		assert.NoError(t, err)
+		arithCompareExpr = expr.GetArithCompareExpr()
+		assert.NotNil(t, arithCompareExpr)
+		assert.Equal(t, planpb.OpType_GreaterThan, arithCompareExpr.GetOp())
+		assert.Equal(t, schemapb.DataType_Int64, arithCompareExpr.GetDataType())
+		assert.NotNil(t, arithCompareExpr.GetLeft().GetBinaryArithExpr())
+		assert.Equal(t, int64(1), arithCompareExpr.GetRight().GetValueExpr().GetValue().GetInt64Val())
+	})
 }
 
 func TestExpr_Value(t *testing.T) {
@@ -325,6 +413,43 @@ func TestExpr_Combinations(t *testing.T) {
 	}
 }
 
+func TestExpr_NullCheck(t *testing.T) {
+	schema := newTestSchema()
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID: 1000, Name: "NullableInt64Field", DataType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	})
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr, err := ParseExpr(helper, `NullableInt64Field is null`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNull, expr.GetNullExpr().GetOp())
+	assert.Equal(t, int64(1000), expr.GetNullExpr().GetColumnInfo().GetFieldId())
+
+	expr, err = ParseExpr(helper, `NullableInt64Field IS NOT NULL`)
+	assert.NoError(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNotNull, expr.GetNullExpr().GetOp())
+
+	exprStrs := []string{
+		`NullableInt64Field is not null and NullableInt64Field > 10`,
+		`not (NullableInt64Field is null)`,
+		`NullableInt64Field is null || Int64Field in [1, 2]`,
+	}
+	for _, exprStr := range exprStrs {
+		assertValidExpr(t, helper, exprStr)
+	}
+
+	invalidExprs := []string{
+		`Int64Field is null`,
+		`NotExistField is not null`,
+		`1 is null`,
+	}
+	for _, exprStr := range invalidExprs {
+		assertInvalidExpr(t, helper, exprStr)
+	}
+}
+
 func TestCreateRetrievePlan(t *testing.T) {
 	schema := newTestSchema()
 	_, err := CreateRetrievePlan(schema, "Int64Field > 0")
@@ -535,3 +660,59 @@ func Test_handleExpr_17126(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestExpr_Limits(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	limits := exprLimits{maxLength: 64, maxTermSize: 3, maxDepth: 16}
+	parse := func(exprStr string) error {
+		return getError(handleExprWithLimits(helper, exprStr, limits))
+	}
+
+	assert.NoError(t, parse(`Int64Field in [1, 2, 3]`))
+	assert.Error(t, parse(`Int64Field in [1, 2, 3, 4]`))
+
+	assert.NoError(t, parse(`Int64Field > 1`))
+	assert.Error(t, parse(`Int64Field > 1 and `+strings.Repeat(`Int64Field > 1 and `, 3)+`Int64Field > 1`))
+
+	assert.NoError(t, parse(`(((Int64Field > 1)))`))
+	assert.Error(t, parse(strings.Repeat("(", 15)+`Int64Field > 1`+strings.Repeat(")", 15)))
+
+	// limits are checked on the tokens before parsing
+	limits = exprLimits{maxTermSize: 3, maxDepth: 16}
+	assert.NoError(t, parse(`Int64Field in [1, 2, 3,]`))
+	assert.Error(t, parse(`Int64Field in [1, 2, 3, 4,]`))
+	assert.Error(t, parse(`Int64Field in [`+strings.Repeat("1, ", 100000)+`1]`))
+	assert.NoError(t, parse(`Int64Field > -1 and Int64Field < - - 1`))
+	assert.Error(t, parse(`Int64Field > `+strings.Repeat("-", 17)+`1`))
+	assert.Error(t, parse(strings.Repeat("(", 100000)+`Int64Field > 1`+strings.Repeat(")", 100000)))
+	assert.Error(t, parse(strings.Repeat("not (", 9)+`Int64Field > 1`+strings.Repeat(")", 9)))
+
+	// non-positive limits mean no limit.
+	limits = exprLimits{}
+	assert.NoError(t, parse(`Int64Field in [`+strings.Repeat("1, ", 100)+`1]`))
+	assert.NoError(t, parse(strings.Repeat("(", 100)+`Int64Field > 1`+strings.Repeat(")", 100)))
+}
+
+func TestExpr_SyntaxError(t *testing.T) {
+	schema := newTestSchema()
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	_, err = ParseExpr(helper, `Int64Field > 1 and and`)
+	assert.Error(t, err)
+	var syntaxErrs SyntaxErrors
+	assert.True(t, errors.As(err, &syntaxErrs))
+	assert.Equal(t, 2, len(syntaxErrs))
+	assert.Equal(t, 1, syntaxErrs[0].Line)
+	assert.Equal(t, 19, syntaxErrs[0].Column)
+	assert.Equal(t, "and", syntaxErrs[0].Token)
+	assert.Equal(t, 22, syntaxErrs[1].Column)
+	assert.Equal(t, "<EOF>", syntaxErrs[1].Token)
+
+	_, err = ParseExpr(helper, `not_in_schema > 1`)
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &syntaxErrs))
+}
@@ -59,6 +59,10 @@ func (v *ShowExprVisitor) VisitExpr(expr *planpb.Expr) interface{} {
 		js["expr"] = v.VisitValueExpr(realExpr.ValueExpr)
 	case *planpb.Expr_ColumnExpr:
 		js["expr"] = v.VisitColumnExpr(realExpr.ColumnExpr)
+	case *planpb.Expr_NullExpr:
+		js["expr"] = v.VisitNullExpr(realExpr.NullExpr)
+	case *planpb.Expr_ArithCompareExpr:
+		js["expr"] = v.VisitArithCompareExpr(realExpr.ArithCompareExpr)
 	default:
 		js["expr"] = ""
 	}
@@ -101,6 +105,14 @@ func (v *ShowExprVisitor) VisitCompareExpr(expr *planpb.CompareExpr) interface{}
 	return js
 }
 
+func (v *ShowExprVisitor) VisitNullExpr(expr *planpb.NullExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "null"
+	js["op"] = expr.Op.String()
+	js["column_info"] = extractColumnInfo(expr.GetColumnInfo())
+	return js
+}
+
 func (v *ShowExprVisitor) VisitUnaryRangeExpr(expr *planpb.UnaryRangeExpr) interface{} {
 	js := make(map[string]interface{})
 	js["expr_type"] = "unary_range"
@@ -138,6 +150,17 @@ func (v *ShowExprVisitor) VisitBinaryArithExpr(expr *planpb.BinaryArithExpr) int
 	js["left_expr"] = v.VisitExpr(expr.GetLeft())
 	js["right_expr"] = v.VisitExpr(expr.GetRight())
 	js["op"] = expr.Op.String()
+	js["data_type"] = expr.GetDataType().String()
+	return js
+}
+
+func (v *ShowExprVisitor) VisitArithCompareExpr(expr *planpb.ArithCompareExpr) interface{} {
+	js := make(map[string]interface{})
+	js["expr_type"] = "arith_compare"
+	js["left_expr"] = v.VisitExpr(expr.GetLeft())
+	js["right_expr"] = v.VisitExpr(expr.GetRight())
+	js["op"] = expr.Op.String()
+	js["data_type"] = expr.GetDataType().String()
 	return js
 }
 
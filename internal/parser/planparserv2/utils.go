@@ -37,6 +37,16 @@ func IsNumber(n *planpb.GenericValue) bool {
 	return IsInteger(n) || IsFloating(n)
 }
 
+func IsZero(n *planpb.GenericValue) bool {
+	switch n.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		return n.GetInt64Val() == 0
+	case *planpb.GenericValue_FloatVal:
+		return n.GetFloatVal() == 0
+	}
+	return false
+}
+
 func IsString(n *planpb.GenericValue) bool {
 	switch n.GetVal().(type) {
 	case *planpb.GenericValue_StringVal:
@@ -112,23 +122,19 @@ func toValueExpr(n *planpb.GenericValue) *ExprWithType {
 	}
 }
 
-func getSameType(a, b schemapb.DataType) (schemapb.DataType, error) {
-	if typeutil.IsFloatingType(a) && typeutil.IsArithmetic(b) {
-		return schemapb.DataType_Double, nil
+// promoteArithType returns the type that the operands of an arithmetic operation or
+// comparison are promoted to: integers are widened to Int64, and a floating operand
+// on either side promotes the result to Double.
+func promoteArithType(a, b schemapb.DataType) (schemapb.DataType, error) {
+	if !typeutil.IsArithmetic(a) || !typeutil.IsArithmetic(b) {
+		return schemapb.DataType_None, fmt.Errorf("incompatible data type, %s, %s", a.String(), b.String())
 	}
 
 	if typeutil.IsIntegerType(a) && typeutil.IsIntegerType(b) {
 		return schemapb.DataType_Int64, nil
 	}
 
-	return schemapb.DataType_None, fmt.Errorf("incompatible data type, %s, %s", a.String(), b.String())
-}
-
-func calcDataType(left, right *ExprWithType, reverse bool) (schemapb.DataType, error) {
-	if reverse {
-		return getSameType(right.dataType, left.dataType)
-	}
-	return getSameType(left.dataType, right.dataType)
+	return schemapb.DataType_Double, nil
 }
 
 func reverseOrder(op planpb.OpType) (planpb.OpType, error) {
@@ -181,10 +187,10 @@ func castValue(dataType schemapb.DataType, value *planpb.GenericValue) (*planpb.
 	return nil, fmt.Errorf("cannot cast value to %s, value: %s", dataType.String(), value)
 }
 
-func combineBinaryArithExpr(op planpb.OpType, arithOp planpb.ArithOpType, columnInfo *planpb.ColumnInfo, operand *planpb.GenericValue, value *planpb.GenericValue) *planpb.Expr {
+func combineBinaryArithExpr(op planpb.OpType, arithOp planpb.ArithOpType, columnInfo *planpb.ColumnInfo, operand *planpb.GenericValue, value *planpb.GenericValue) (*planpb.Expr, error) {
 	castedValue, err := castValue(columnInfo.GetDataType(), operand)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 	return &planpb.Expr{
 		Expr: &planpb.Expr_BinaryArithOpEvalRangeExpr{
@@ -196,7 +202,7 @@ func combineBinaryArithExpr(op planpb.OpType, arithOp planpb.ArithOpType, column
 				Value:        value,
 			},
 		},
-	}
+	}, nil
 }
 
 func handleBinaryArithExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr, valueExpr *planpb.ValueExpr) (*planpb.Expr, error) {
@@ -204,8 +210,8 @@ func handleBinaryArithExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr,
 	case planpb.OpType_Equal, planpb.OpType_NotEqual:
 		break
 	default:
-		// TODO: enable this after execution is ready.
-		return nil, fmt.Errorf("%s is not supported in execution backend", op)
+		// other comparisons are built by handleArithCompare
+		return nil, fmt.Errorf("%s is not supported by binary arithmetic range expression", op)
 	}
 
 	leftExpr, leftValue := arithExpr.Left.GetColumnExpr(), arithExpr.Left.GetValueExpr()
@@ -227,7 +233,7 @@ func handleBinaryArithExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr,
 		// a * 2 == 3
 		// a / 2 == 3
 		// a % 2 == 3
-		return combineBinaryArithExpr(op, arithExpr.GetOp(), leftExpr.GetInfo(), rightValue.GetValue(), valueExpr.GetValue()), nil
+		return combineBinaryArithExpr(op, arithExpr.GetOp(), leftExpr.GetInfo(), rightValue.GetValue(), valueExpr.GetValue())
 	} else if rightExpr != nil && leftValue != nil {
 		// 2 + a == 3
 		// 2 - a == 3
@@ -237,7 +243,7 @@ func handleBinaryArithExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr,
 
 		switch arithExpr.GetOp() {
 		case planpb.ArithOpType_Add, planpb.ArithOpType_Mul:
-			return combineBinaryArithExpr(op, arithExpr.GetOp(), rightExpr.GetInfo(), leftValue.GetValue(), valueExpr.GetValue()), nil
+			return combineBinaryArithExpr(op, arithExpr.GetOp(), rightExpr.GetInfo(), leftValue.GetValue(), valueExpr.GetValue())
 		default:
 			return nil, fmt.Errorf("todo")
 		}
@@ -247,7 +253,72 @@ func handleBinaryArithExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr,
 	}
 }
 
+// isArithRangeExpr checks if comparing the arithmetic expression with a value by op can be evaluated
+// as a range on a single column, which is only supported for `column arith value` and the commutative
+// `value + column`, `value * column` compared by == or !=.
+func isArithRangeExpr(op planpb.OpType, arithExpr *planpb.BinaryArithExpr) bool {
+	if op != planpb.OpType_Equal && op != planpb.OpType_NotEqual {
+		return false
+	}
+	left, right := arithExpr.GetLeft(), arithExpr.GetRight()
+	if left.GetColumnExpr() != nil && right.GetValueExpr() != nil {
+		return true
+	}
+	commutative := arithExpr.GetOp() == planpb.ArithOpType_Add || arithExpr.GetOp() == planpb.ArithOpType_Mul
+	return commutative && left.GetValueExpr() != nil && right.GetColumnExpr() != nil
+}
+
+// handleArithCompare builds a comparison between two arithmetic expressions, both sides are
+// promoted to the same type before comparing, e.g. `Int8Field * FloatField > 100` is
+// evaluated in Double.
+func handleArithCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*planpb.Expr, error) {
+	if op == planpb.OpType_Invalid {
+		return nil, fmt.Errorf("unsupported op type: %s", op)
+	}
+
+	dataType, err := promoteArithType(left.dataType, right.dataType)
+	if err != nil {
+		return nil, fmt.Errorf("arithmetic expressions can only be compared with integer or floating expressions: %w", err)
+	}
+
+	operands := make([]*planpb.Expr, 0, 2)
+	for _, operand := range []*ExprWithType{left, right} {
+		expr := operand.expr
+		if valueExpr := expr.GetValueExpr(); valueExpr != nil {
+			castedValue, err := castValue(dataType, valueExpr.GetValue())
+			if err != nil {
+				return nil, err
+			}
+			expr = &planpb.Expr{
+				Expr: &planpb.Expr_ValueExpr{
+					ValueExpr: &planpb.ValueExpr{
+						Value: castedValue,
+					},
+				},
+			}
+		}
+		operands = append(operands, expr)
+	}
+
+	return &planpb.Expr{
+		Expr: &planpb.Expr_ArithCompareExpr{
+			ArithCompareExpr: &planpb.ArithCompareExpr{
+				Left:     operands[0],
+				Right:    operands[1],
+				Op:       op,
+				DataType: dataType,
+			},
+		},
+	}, nil
+}
+
 func handleCompareRightValue(op planpb.OpType, left *ExprWithType, right *planpb.ValueExpr) (*planpb.Expr, error) {
+	if leftArithExpr := left.expr.GetBinaryArithExpr(); leftArithExpr != nil && !isArithRangeExpr(op, leftArithExpr) {
+		// (a + b) * 2 > 3
+		// a + 1 < 2
+		return handleArithCompare(op, left, toValueExpr(right.GetValue()))
+	}
+
 	castedValue, err := castValue(left.dataType, right.GetValue())
 	if err != nil {
 		return nil, err
@@ -281,6 +352,11 @@ func handleCompareRightValue(op planpb.OpType, left *ExprWithType, right *planpb
 }
 
 func handleCompare(op planpb.OpType, left *ExprWithType, right *ExprWithType) (*planpb.Expr, error) {
+	if left.expr.GetBinaryArithExpr() != nil || right.expr.GetBinaryArithExpr() != nil {
+		// a + b < c
+		return handleArithCompare(op, left, right)
+	}
+
 	leftColumnInfo := toColumnInfo(left)
 	rightColumnInfo := toColumnInfo(right)
 
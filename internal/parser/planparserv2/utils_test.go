@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/stretchr/testify/assert"
 )
 
 func Test_relationalCompatible(t *testing.T) {
@@ -57,3 +59,49 @@ func Test_relationalCompatible(t *testing.T) {
 		})
 	}
 }
+
+func Test_promoteArithType(t *testing.T) {
+	tests := []struct {
+		a, b    schemapb.DataType
+		want    schemapb.DataType
+		wantErr bool
+	}{
+		{a: schemapb.DataType_Int8, b: schemapb.DataType_Int32, want: schemapb.DataType_Int64},
+		{a: schemapb.DataType_Int64, b: schemapb.DataType_Float, want: schemapb.DataType_Double},
+		{a: schemapb.DataType_Float, b: schemapb.DataType_Int16, want: schemapb.DataType_Double},
+		{a: schemapb.DataType_Float, b: schemapb.DataType_Double, want: schemapb.DataType_Double},
+		{a: schemapb.DataType_Bool, b: schemapb.DataType_Int64, wantErr: true},
+		{a: schemapb.DataType_Int64, b: schemapb.DataType_VarChar, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := promoteArithType(tt.a, tt.b)
+		if tt.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func Test_handleArithCompare(t *testing.T) {
+	arith := &ExprWithType{
+		expr:     &planpb.Expr{Expr: &planpb.Expr_BinaryArithExpr{BinaryArithExpr: &planpb.BinaryArithExpr{}}},
+		dataType: schemapb.DataType_Int64,
+	}
+	str := toValueExpr(NewString("str"))
+	boolean := toValueExpr(NewBool(true))
+
+	_, err := handleArithCompare(planpb.OpType_LessThan, arith, str)
+	assert.Error(t, err)
+	_, err = handleArithCompare(planpb.OpType_LessThan, str, arith)
+	assert.Error(t, err)
+	_, err = handleArithCompare(planpb.OpType_Equal, boolean, arith)
+	assert.Error(t, err)
+	_, err = handleArithCompare(planpb.OpType_Invalid, arith, arith)
+	assert.Error(t, err)
+
+	expr, err := handleArithCompare(planpb.OpType_LessThan, arith, toValueExpr(NewInt(1)))
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int64, expr.GetArithCompareExpr().GetDataType())
+}
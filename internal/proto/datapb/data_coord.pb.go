@@ -105,21 +105,24 @@ func (ChannelWatchState) EnumDescriptor() ([]byte, []int) {
 type CompactionType int32
 
 const (
-	CompactionType_UndefinedCompaction CompactionType = 0
-	CompactionType_MergeCompaction     CompactionType = 2
-	CompactionType_MixCompaction       CompactionType = 3
+	CompactionType_UndefinedCompaction  CompactionType = 0
+	CompactionType_MergeCompaction      CompactionType = 2
+	CompactionType_MixCompaction        CompactionType = 3
+	CompactionType_ClusteringCompaction CompactionType = 4
 )
 
 var CompactionType_name = map[int32]string{
 	0: "UndefinedCompaction",
 	2: "MergeCompaction",
 	3: "MixCompaction",
+	4: "ClusteringCompaction",
 }
 
 var CompactionType_value = map[string]int32{
-	"UndefinedCompaction": 0,
-	"MergeCompaction":     2,
-	"MixCompaction":       3,
+	"UndefinedCompaction":  0,
+	"MergeCompaction":      2,
+	"MixCompaction":        3,
+	"ClusteringCompaction": 4,
 }
 
 func (x CompactionType) String() string {
@@ -130,6 +133,139 @@ func (CompactionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_82cd95f524594f49, []int{2}
 }
 
+type ManualCompactionMode int32
+
+const (
+	// merge small segments and purge the deleted or expired rows, the same as ManualCompaction
+	ManualCompactionMode_DefaultCompaction ManualCompactionMode = 0
+	// only merge small segments together
+	ManualCompactionMode_MergeOnly ManualCompactionMode = 1
+	// only rewrite the segments with deleted or expired rows, one segment a plan
+	ManualCompactionMode_DeletePurge ManualCompactionMode = 2
+)
+
+var ManualCompactionMode_name = map[int32]string{
+	0: "DefaultCompaction",
+	1: "MergeOnly",
+	2: "DeletePurge",
+}
+
+var ManualCompactionMode_value = map[string]int32{
+	"DefaultCompaction": 0,
+	"MergeOnly":         1,
+	"DeletePurge":       2,
+}
+
+func (x ManualCompactionMode) String() string {
+	return proto.EnumName(ManualCompactionMode_name, int32(x))
+}
+
+func (ManualCompactionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{3}
+}
+
+type ExportFormat int32
+
+const (
+	// parquet files, float vectors are written as lists of float and binary vectors as fixed size binaries
+	ExportFormat_ExportParquet ExportFormat = 0
+	// JSON files in the row-based format of bulk insert
+	ExportFormat_ExportJSON ExportFormat = 1
+)
+
+var ExportFormat_name = map[int32]string{
+	0: "ExportParquet",
+	1: "ExportJSON",
+}
+
+var ExportFormat_value = map[string]int32{
+	"ExportParquet": 0,
+	"ExportJSON":    1,
+}
+
+func (x ExportFormat) String() string {
+	return proto.EnumName(ExportFormat_name, int32(x))
+}
+
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{4}
+}
+
+type ExportState int32
+
+const (
+	ExportState_ExportPending   ExportState = 0
+	ExportState_ExportExecuting ExportState = 1
+	ExportState_ExportCompleted ExportState = 2
+	ExportState_ExportFailed    ExportState = 3
+	ExportState_ExportCanceled  ExportState = 4
+)
+
+var ExportState_name = map[int32]string{
+	0: "ExportPending",
+	1: "ExportExecuting",
+	2: "ExportCompleted",
+	3: "ExportFailed",
+	4: "ExportCanceled",
+}
+
+var ExportState_value = map[string]int32{
+	"ExportPending":   0,
+	"ExportExecuting": 1,
+	"ExportCompleted": 2,
+	"ExportFailed":    3,
+	"ExportCanceled":  4,
+}
+
+func (x ExportState) String() string {
+	return proto.EnumName(ExportState_name, int32(x))
+}
+
+func (ExportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{5}
+}
+
+type CompactionPlanState int32
+
+const (
+	CompactionPlanState_CompactionPlanUnknown   CompactionPlanState = 0
+	CompactionPlanState_CompactionPlanExecuting CompactionPlanState = 1
+	CompactionPlanState_CompactionPlanCompleted CompactionPlanState = 2
+	CompactionPlanState_CompactionPlanFailed    CompactionPlanState = 3
+	CompactionPlanState_CompactionPlanTimeout   CompactionPlanState = 4
+	// the plan is cancelled but the datanode has not confirmed it stopped
+	CompactionPlanState_CompactionPlanCancelling CompactionPlanState = 5
+	CompactionPlanState_CompactionPlanCancelled  CompactionPlanState = 6
+)
+
+var CompactionPlanState_name = map[int32]string{
+	0: "CompactionPlanUnknown",
+	1: "CompactionPlanExecuting",
+	2: "CompactionPlanCompleted",
+	3: "CompactionPlanFailed",
+	4: "CompactionPlanTimeout",
+	5: "CompactionPlanCancelling",
+	6: "CompactionPlanCancelled",
+}
+
+var CompactionPlanState_value = map[string]int32{
+	"CompactionPlanUnknown":    0,
+	"CompactionPlanExecuting":  1,
+	"CompactionPlanCompleted":  2,
+	"CompactionPlanFailed":     3,
+	"CompactionPlanTimeout":    4,
+	"CompactionPlanCancelling": 5,
+	"CompactionPlanCancelled":  6,
+}
+
+func (x CompactionPlanState) String() string {
+	return proto.EnumName(CompactionPlanState_name, int32(x))
+}
+
+func (CompactionPlanState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{6}
+}
+
 // TODO: import google/protobuf/empty.proto
 type Empty struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1592,11 +1728,15 @@ type SegmentInfo struct {
 	// A flag indicating if:
 	// (1) this segment is created by bulk insert, and
 	// (2) the bulk insert task that creates this segment has not yet reached `ImportCompleted` state.
-	IsImporting          bool     `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
-	IsFake               bool     `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	IsImporting bool `protobuf:"varint,17,opt,name=is_importing,json=isImporting,proto3" json:"is_importing,omitempty"`
+	IsFake      bool `protobuf:"varint,18,opt,name=is_fake,json=isFake,proto3" json:"is_fake,omitempty"`
+	// zone maps of the scalar fields, merged from every flush of the segment
+	FieldStats []*FieldStats `protobuf:"bytes,19,rep,name=field_stats,json=fieldStats,proto3" json:"field_stats,omitempty"`
+	// value range of the clustering key field, only set on the segments generated by clustering compactions
+	ClusteringKeyRange   *FieldStats `protobuf:"bytes,20,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *SegmentInfo) Reset()         { *m = SegmentInfo{} }
@@ -1750,6 +1890,20 @@ func (m *SegmentInfo) GetIsFake() bool {
 	return false
 }
 
+func (m *SegmentInfo) GetFieldStats() []*FieldStats {
+	if m != nil {
+		return m.FieldStats
+	}
+	return nil
+}
+
+func (m *SegmentInfo) GetClusteringKeyRange() *FieldStats {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 type SegmentStartPosition struct {
 	StartPosition        *internalpb.MsgPosition `protobuf:"bytes,1,opt,name=start_position,json=startPosition,proto3" json:"start_position,omitempty"`
 	SegmentID            int64                   `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -1809,6 +1963,7 @@ type SaveBinlogPathsRequest struct {
 	Deltalogs            []*FieldBinlog          `protobuf:"bytes,9,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	Dropped              bool                    `protobuf:"varint,10,opt,name=dropped,proto3" json:"dropped,omitempty"`
 	Importing            bool                    `protobuf:"varint,11,opt,name=importing,proto3" json:"importing,omitempty"`
+	FieldStats           []*FieldStats           `protobuf:"bytes,12,rep,name=field_stats,json=fieldStats,proto3" json:"field_stats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -1916,6 +2071,13 @@ func (m *SaveBinlogPathsRequest) GetImporting() bool {
 	return false
 }
 
+func (m *SaveBinlogPathsRequest) GetFieldStats() []*FieldStats {
+	if m != nil {
+		return m.FieldStats
+	}
+	return nil
+}
+
 type CheckPoint struct {
 	SegmentID            int64                   `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	Position             *internalpb.MsgPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
@@ -2269,6 +2431,7 @@ type SegmentBinlogs struct {
 	Statslogs            []*FieldBinlog `protobuf:"bytes,4,rep,name=statslogs,proto3" json:"statslogs,omitempty"`
 	Deltalogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
 	InsertChannel        string         `protobuf:"bytes,6,opt,name=insert_channel,json=insertChannel,proto3" json:"insert_channel,omitempty"`
+	ClusteringKeyRange   *FieldStats    `protobuf:"bytes,7,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -2341,6 +2504,13 @@ func (m *SegmentBinlogs) GetInsertChannel() string {
 	return ""
 }
 
+func (m *SegmentBinlogs) GetClusteringKeyRange() *FieldStats {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 type FieldBinlog struct {
 	FieldID              int64     `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
 	Binlogs              []*Binlog `protobuf:"bytes,2,rep,name=binlogs,proto3" json:"binlogs,omitempty"`
@@ -2959,14 +3129,16 @@ func (m *CompactionStateRequest) GetBase() *commonpb.MsgBase {
 }
 
 type SyncSegmentsRequest struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	CompactedTo          int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	CompactedFrom        []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
-	StatsLogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID        int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	CompactedTo   int64          `protobuf:"varint,2,opt,name=compacted_to,json=compactedTo,proto3" json:"compacted_to,omitempty"`
+	NumOfRows     int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	CompactedFrom []int64        `protobuf:"varint,4,rep,packed,name=compacted_from,json=compactedFrom,proto3" json:"compacted_from,omitempty"`
+	StatsLogs     []*FieldBinlog `protobuf:"bytes,5,rep,name=stats_logs,json=statsLogs,proto3" json:"stats_logs,omitempty"`
+	// all the segments compacted to, only set by clustering compactions
+	CompactedToSegments  []*CompactionSegment `protobuf:"bytes,6,rep,name=compacted_to_segments,json=compactedToSegments,proto3" json:"compacted_to_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *SyncSegmentsRequest) Reset()         { *m = SyncSegmentsRequest{} }
@@ -3029,6 +3201,13 @@ func (m *SyncSegmentsRequest) GetStatsLogs() []*FieldBinlog {
 	return nil
 }
 
+func (m *SyncSegmentsRequest) GetCompactedToSegments() []*CompactionSegment {
+	if m != nil {
+		return m.CompactedToSegments
+	}
+	return nil
+}
+
 type CompactionSegmentBinlogs struct {
 	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
 	FieldBinlogs         []*FieldBinlog `protobuf:"bytes,2,rep,name=fieldBinlogs,proto3" json:"fieldBinlogs,omitempty"`
@@ -3101,17 +3280,20 @@ func (m *CompactionSegmentBinlogs) GetInsertChannel() string {
 }
 
 type CompactionPlan struct {
-	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
-	StartTime            uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TimeoutInSeconds     int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
-	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
-	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
-	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	CollectionTtl        int64                       `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	PlanID           int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs   []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime        uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type             CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel       uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel          string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	CollectionTtl    int64                       `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
+	// only used by clustering compactions
+	ClusteringFieldID    int64    `protobuf:"varint,9,opt,name=clustering_fieldID,json=clusteringFieldID,proto3" json:"clustering_fieldID,omitempty"`
+	MaxSegmentRows       int64    `protobuf:"varint,10,opt,name=max_segment_rows,json=maxSegmentRows,proto3" json:"max_segment_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
@@ -3195,17 +3377,33 @@ func (m *CompactionPlan) GetCollectionTtl() int64 {
 	return 0
 }
 
+func (m *CompactionPlan) GetClusteringFieldID() int64 {
+	if m != nil {
+		return m.ClusteringFieldID
+	}
+	return 0
+}
+
+func (m *CompactionPlan) GetMaxSegmentRows() int64 {
+	if m != nil {
+		return m.MaxSegmentRows
+	}
+	return 0
+}
+
 type CompactionResult struct {
-	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NumOfRows            int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
-	InsertLogs           []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
-	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
-	Deltalogs            []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
-	Channel              string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	PlanID              int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentID           int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows           int64          `protobuf:"varint,3,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs          []*FieldBinlog `protobuf:"bytes,4,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths []*FieldBinlog `protobuf:"bytes,5,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs           []*FieldBinlog `protobuf:"bytes,6,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	Channel             string         `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// output segments of clustering compactions, the fields above are not used then
+	Segments             []*CompactionSegment `protobuf:"bytes,8,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *CompactionResult) Reset()         { *m = CompactionResult{} }
@@ -3282,6 +3480,13 @@ func (m *CompactionResult) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionResult) GetSegments() []*CompactionSegment {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
 type CompactionStateResult struct {
 	PlanID               int64                    `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
@@ -3826,6 +4031,8 @@ type ImportTask struct {
 	TaskId               int64                    `protobuf:"varint,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	Files                []string                 `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
 	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,8,rep,name=infos,proto3" json:"infos,omitempty"`
+	ChunkIndex           int64                    `protobuf:"varint,9,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	ChunkCount           int64                    `protobuf:"varint,10,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -3912,6 +4119,20 @@ func (m *ImportTask) GetInfos() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *ImportTask) GetChunkIndex() int64 {
+	if m != nil {
+		return m.ChunkIndex
+	}
+	return 0
+}
+
+func (m *ImportTask) GetChunkCount() int64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
 type ImportTaskState struct {
 	StateCode            commonpb.ImportState `protobuf:"varint,1,opt,name=stateCode,proto3,enum=milvus.proto.common.ImportState" json:"stateCode,omitempty"`
 	Segments             []int64              `protobuf:"varint,2,rep,packed,name=segments,proto3" json:"segments,omitempty"`
@@ -3999,6 +4220,7 @@ type ImportTaskInfo struct {
 	PartitionName        string                   `protobuf:"bytes,13,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	Infos                []*commonpb.KeyValuePair `protobuf:"bytes,14,rep,name=infos,proto3" json:"infos,omitempty"`
 	StartTs              int64                    `protobuf:"varint,15,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	SubTasks             []*ImportSubTaskInfo     `protobuf:"bytes,16,rep,name=sub_tasks,json=subTasks,proto3" json:"sub_tasks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -4135,6 +4357,13 @@ func (m *ImportTaskInfo) GetStartTs() int64 {
 	return 0
 }
 
+func (m *ImportTaskInfo) GetSubTasks() []*ImportSubTaskInfo {
+	if m != nil {
+		return m.SubTasks
+	}
+	return nil
+}
+
 type ImportTaskResponse struct {
 	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	DatanodeId           int64            `protobuf:"varint,2,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
@@ -4874,403 +5103,1979 @@ func (m *AlterCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
-	proto.RegisterType((*Empty)(nil), "milvus.proto.data.Empty")
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*AcquireSegmentLockRequest)(nil), "milvus.proto.data.AcquireSegmentLockRequest")
-	proto.RegisterType((*ReleaseSegmentLockRequest)(nil), "milvus.proto.data.ReleaseSegmentLockRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
-	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
-	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.data.SegmentStats")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetSegmentsByStatesRequest)(nil), "milvus.proto.data.GetSegmentsByStatesRequest")
-	proto.RegisterType((*GetSegmentsByStatesResponse)(nil), "milvus.proto.data.GetSegmentsByStatesResponse")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
-	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
-	proto.RegisterType((*CompactionStateRequest)(nil), "milvus.proto.data.CompactionStateRequest")
-	proto.RegisterType((*SyncSegmentsRequest)(nil), "milvus.proto.data.SyncSegmentsRequest")
-	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
-	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
-	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
-	proto.RegisterType((*CompactionStateResult)(nil), "milvus.proto.data.CompactionStateResult")
-	proto.RegisterType((*CompactionStateResponse)(nil), "milvus.proto.data.CompactionStateResponse")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
-	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
-	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
-	proto.RegisterType((*SetSegmentStateRequest)(nil), "milvus.proto.data.SetSegmentStateRequest")
-	proto.RegisterType((*SetSegmentStateResponse)(nil), "milvus.proto.data.SetSegmentStateResponse")
-	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
-	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
-	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
-	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
-	proto.RegisterType((*ImportTaskState)(nil), "milvus.proto.data.ImportTaskState")
-	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
-	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
-	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
-	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
-	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
-	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
-	proto.RegisterType((*ResendSegmentStatsResponse)(nil), "milvus.proto.data.ResendSegmentStatsResponse")
-	proto.RegisterType((*AddImportSegmentRequest)(nil), "milvus.proto.data.AddImportSegmentRequest")
-	proto.RegisterType((*AddImportSegmentResponse)(nil), "milvus.proto.data.AddImportSegmentResponse")
-	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
-	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
-	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
-	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
-	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
+// FieldStats is the zone map of a scalar field in a segment, segments whose value range
+// cannot satisfy a filter are skipped.
+type FieldStats struct {
+	FieldID       int64             `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	DataType      schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	RowCount      int64             `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	NullCount     int64             `protobuf:"varint,4,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	DistinctCount int64             `protobuf:"varint,5,opt,name=distinct_count,json=distinctCount,proto3" json:"distinct_count,omitempty"`
+	// hyperloglog registers, merged to estimate the distinct count of the whole segment
+	DistinctSketch []byte `protobuf:"bytes,6,opt,name=distinct_sketch,json=distinctSketch,proto3" json:"distinct_sketch,omitempty"`
+	// min/max of bool and integer fields
+	IntMin int64 `protobuf:"varint,7,opt,name=int_min,json=intMin,proto3" json:"int_min,omitempty"`
+	IntMax int64 `protobuf:"varint,8,opt,name=int_max,json=intMax,proto3" json:"int_max,omitempty"`
+	// min/max of float and double fields
+	FloatMin float64 `protobuf:"fixed64,9,opt,name=float_min,json=floatMin,proto3" json:"float_min,omitempty"`
+	FloatMax float64 `protobuf:"fixed64,10,opt,name=float_max,json=floatMax,proto3" json:"float_max,omitempty"`
+	// min/max of varchar fields
+	StringMin            string   `protobuf:"bytes,11,opt,name=string_min,json=stringMin,proto3" json:"string_min,omitempty"`
+	StringMax            string   `protobuf:"bytes,12,opt,name=string_max,json=stringMax,proto3" json:"string_max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+func (m *FieldStats) Reset()         { *m = FieldStats{} }
+func (m *FieldStats) String() string { return proto.CompactTextString(m) }
+func (*FieldStats) ProtoMessage()    {}
+func (*FieldStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{76}
+}
 
-var fileDescriptor_82cd95f524594f49 = []byte{
-	// 4414 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4b, 0x8f, 0x1c, 0x49,
-	0x5a, 0xce, 0x7a, 0x75, 0xd5, 0x57, 0x8f, 0xae, 0x0e, 0x7b, 0xda, 0xe5, 0x1a, 0xbf, 0x26, 0x3d,
-	0x9e, 0xf1, 0x78, 0x3c, 0xf6, 0x8c, 0x87, 0xd1, 0x0e, 0x78, 0x67, 0x56, 0x6e, 0xf7, 0xb4, 0xa7,
-	0xa0, 0xbb, 0xb7, 0x37, 0xbb, 0x3d, 0x96, 0x76, 0x91, 0x4a, 0xd9, 0x95, 0xd1, 0xd5, 0xb9, 0x5d,
-	0x99, 0x59, 0xce, 0xcc, 0xea, 0x76, 0x2f, 0x87, 0x1d, 0xb1, 0x12, 0x68, 0x11, 0x62, 0x11, 0x12,
-	0x02, 0x0e, 0x48, 0x88, 0xd3, 0xb2, 0x68, 0x11, 0xd2, 0x8a, 0x0b, 0x17, 0xae, 0x23, 0x38, 0xac,
-	0x10, 0x12, 0x3f, 0x80, 0x03, 0x70, 0xe7, 0xca, 0x01, 0xc5, 0x23, 0x23, 0x5f, 0x91, 0x55, 0xd9,
-	0x55, 0xf6, 0x18, 0xc1, 0xad, 0xe2, 0xcb, 0x2f, 0xe2, 0x8b, 0xc7, 0xf7, 0x8e, 0x2f, 0x0a, 0xda,
-	0x86, 0xee, 0xeb, 0xfd, 0x81, 0xe3, 0xb8, 0xc6, 0xdd, 0xb1, 0xeb, 0xf8, 0x0e, 0x5a, 0xb1, 0xcc,
-	0xd1, 0xf1, 0xc4, 0x63, 0xad, 0xbb, 0xe4, 0x73, 0xb7, 0x31, 0x70, 0x2c, 0xcb, 0xb1, 0x19, 0xa8,
-	0xdb, 0x32, 0x6d, 0x1f, 0xbb, 0xb6, 0x3e, 0xe2, 0xed, 0x46, 0xb4, 0x43, 0xb7, 0xe1, 0x0d, 0x0e,
-	0xb1, 0xa5, 0xb3, 0x96, 0xba, 0x04, 0xe5, 0xcf, 0xac, 0xb1, 0x7f, 0xaa, 0xfe, 0xa9, 0x02, 0x8d,
-	0x8d, 0xd1, 0xc4, 0x3b, 0xd4, 0xf0, 0xb3, 0x09, 0xf6, 0x7c, 0xf4, 0x3e, 0x94, 0xf6, 0x75, 0x0f,
-	0x77, 0x94, 0xeb, 0xca, 0xad, 0xfa, 0xfd, 0xcb, 0x77, 0x63, 0x54, 0x39, 0xbd, 0x2d, 0x6f, 0xb8,
-	0xa6, 0x7b, 0x58, 0xa3, 0x98, 0x08, 0x41, 0xc9, 0xd8, 0xef, 0xad, 0x77, 0x0a, 0xd7, 0x95, 0x5b,
-	0x45, 0x8d, 0xfe, 0x46, 0x57, 0x01, 0x3c, 0x3c, 0xb4, 0xb0, 0xed, 0xf7, 0xd6, 0xbd, 0x4e, 0xf1,
-	0x7a, 0xf1, 0x56, 0x51, 0x8b, 0x40, 0x90, 0x0a, 0x8d, 0x81, 0x33, 0x1a, 0xe1, 0x81, 0x6f, 0x3a,
-	0x76, 0x6f, 0xbd, 0x53, 0xa2, 0x7d, 0x63, 0x30, 0xf5, 0xdf, 0x15, 0x68, 0xf2, 0xa9, 0x79, 0x63,
-	0xc7, 0xf6, 0x30, 0xfa, 0x10, 0x2a, 0x9e, 0xaf, 0xfb, 0x13, 0x8f, 0xcf, 0xee, 0x75, 0xe9, 0xec,
-	0x76, 0x29, 0x8a, 0xc6, 0x51, 0xa5, 0xd3, 0x4b, 0x92, 0x2f, 0xa6, 0xc9, 0x27, 0x96, 0x50, 0x4a,
-	0x2d, 0xe1, 0x16, 0x2c, 0x1f, 0x90, 0xd9, 0xed, 0x86, 0x48, 0x65, 0x8a, 0x94, 0x04, 0x93, 0x91,
-	0x7c, 0xd3, 0xc2, 0xdf, 0x3e, 0xd8, 0xc5, 0xfa, 0xa8, 0x53, 0xa1, 0xb4, 0x22, 0x10, 0xf5, 0x9f,
-	0x15, 0x68, 0x0b, 0xf4, 0xe0, 0x1c, 0x2e, 0x40, 0x79, 0xe0, 0x4c, 0x6c, 0x9f, 0x2e, 0xb5, 0xa9,
-	0xb1, 0x06, 0x7a, 0x03, 0x1a, 0x83, 0x43, 0xdd, 0xb6, 0xf1, 0xa8, 0x6f, 0xeb, 0x16, 0xa6, 0x8b,
-	0xaa, 0x69, 0x75, 0x0e, 0xdb, 0xd6, 0x2d, 0x9c, 0x6b, 0x6d, 0xd7, 0xa1, 0x3e, 0xd6, 0x5d, 0xdf,
-	0x8c, 0xed, 0x7e, 0x14, 0x84, 0xba, 0x50, 0x35, 0xbd, 0x9e, 0x35, 0x76, 0x5c, 0xbf, 0x53, 0xbe,
-	0xae, 0xdc, 0xaa, 0x6a, 0xa2, 0x4d, 0x28, 0x98, 0xf4, 0xd7, 0x9e, 0xee, 0x1d, 0xf5, 0xd6, 0xf9,
-	0x8a, 0x62, 0x30, 0xf5, 0x2f, 0x14, 0x58, 0x7d, 0xe8, 0x79, 0xe6, 0xd0, 0x4e, 0xad, 0x6c, 0x15,
-	0x2a, 0xb6, 0x63, 0xe0, 0xde, 0x3a, 0x5d, 0x5a, 0x51, 0xe3, 0x2d, 0xf4, 0x3a, 0xd4, 0xc6, 0x18,
-	0xbb, 0x7d, 0xd7, 0x19, 0x05, 0x0b, 0xab, 0x12, 0x80, 0xe6, 0x8c, 0x30, 0xfa, 0x0e, 0xac, 0x78,
-	0x89, 0x81, 0x18, 0x5f, 0xd5, 0xef, 0xdf, 0xb8, 0x9b, 0x92, 0x8c, 0xbb, 0x49, 0xa2, 0x5a, 0xba,
-	0xb7, 0xfa, 0x65, 0x01, 0xce, 0x0b, 0x3c, 0x36, 0x57, 0xf2, 0x9b, 0xec, 0xbc, 0x87, 0x87, 0x62,
-	0x7a, 0xac, 0x91, 0x67, 0xe7, 0xc5, 0x91, 0x15, 0xa3, 0x47, 0x96, 0x83, 0xd5, 0x93, 0xe7, 0x51,
-	0x4e, 0x9f, 0xc7, 0x35, 0xa8, 0xe3, 0xe7, 0x63, 0xd3, 0xc5, 0x7d, 0xc2, 0x38, 0x74, 0xcb, 0x4b,
-	0x1a, 0x30, 0xd0, 0x9e, 0x69, 0x45, 0x65, 0x63, 0x29, 0xb7, 0x6c, 0xa8, 0x7f, 0xa9, 0xc0, 0xc5,
-	0xd4, 0x29, 0x71, 0x61, 0xd3, 0xa0, 0x4d, 0x57, 0x1e, 0xee, 0x0c, 0x11, 0x3b, 0xb2, 0xe1, 0x6f,
-	0x4d, 0xdb, 0xf0, 0x10, 0x5d, 0x4b, 0xf5, 0x8f, 0x4c, 0xb2, 0x90, 0x7f, 0x92, 0x47, 0x70, 0xf1,
-	0x31, 0xf6, 0x39, 0x01, 0xf2, 0x0d, 0x7b, 0xf3, 0x2b, 0xab, 0xb8, 0x54, 0x17, 0x92, 0x52, 0xad,
-	0xfe, 0x6d, 0x41, 0xc8, 0x22, 0x25, 0xd5, 0xb3, 0x0f, 0x1c, 0x74, 0x19, 0x6a, 0x02, 0x85, 0x73,
-	0x45, 0x08, 0x40, 0xdf, 0x80, 0x32, 0x99, 0x29, 0x63, 0x89, 0xd6, 0xfd, 0x37, 0xe4, 0x6b, 0x8a,
-	0x8c, 0xa9, 0x31, 0x7c, 0xd4, 0x83, 0x96, 0xe7, 0xeb, 0xae, 0xdf, 0x1f, 0x3b, 0x1e, 0x3d, 0x67,
-	0xca, 0x38, 0xf5, 0xfb, 0x6a, 0x7c, 0x04, 0xa1, 0xd6, 0xb7, 0xbc, 0xe1, 0x0e, 0xc7, 0xd4, 0x9a,
-	0xb4, 0x67, 0xd0, 0x44, 0x9f, 0x41, 0x03, 0xdb, 0x46, 0x38, 0x50, 0x29, 0xf7, 0x40, 0x75, 0x6c,
-	0x1b, 0x62, 0x98, 0xf0, 0x7c, 0xca, 0xf9, 0xcf, 0xe7, 0xf7, 0x15, 0xe8, 0xa4, 0x0f, 0x68, 0x11,
-	0x95, 0xfd, 0x80, 0x75, 0xc2, 0xec, 0x80, 0xa6, 0x4a, 0xb8, 0x38, 0x24, 0x8d, 0x77, 0x51, 0xff,
-	0x58, 0x81, 0xd7, 0xc2, 0xe9, 0xd0, 0x4f, 0x2f, 0x8b, 0x5b, 0xd0, 0x6d, 0x68, 0x9b, 0xf6, 0x60,
-	0x34, 0x31, 0xf0, 0x13, 0xfb, 0x73, 0xac, 0x8f, 0xfc, 0xc3, 0x53, 0x7a, 0x86, 0x55, 0x2d, 0x05,
-	0x57, 0x7f, 0xa4, 0xc0, 0x6a, 0x72, 0x5e, 0x8b, 0x6c, 0xd2, 0xaf, 0x40, 0xd9, 0xb4, 0x0f, 0x9c,
-	0x60, 0x8f, 0xae, 0x4e, 0x11, 0x4a, 0x42, 0x8b, 0x21, 0xab, 0x16, 0xbc, 0xfe, 0x18, 0xfb, 0x3d,
-	0xdb, 0xc3, 0xae, 0xbf, 0x66, 0xda, 0x23, 0x67, 0xb8, 0xa3, 0xfb, 0x87, 0x0b, 0x08, 0x54, 0x4c,
-	0x36, 0x0a, 0x09, 0xd9, 0x50, 0x7f, 0xaa, 0xc0, 0x65, 0x39, 0x3d, 0xbe, 0xf4, 0x2e, 0x54, 0x0f,
-	0x4c, 0x3c, 0x32, 0xc8, 0xfe, 0x2a, 0x74, 0x7f, 0x45, 0x9b, 0x08, 0xd6, 0x98, 0x20, 0xf3, 0x15,
-	0xbe, 0x91, 0xc1, 0xcd, 0xbb, 0xbe, 0x6b, 0xda, 0xc3, 0x4d, 0xd3, 0xf3, 0x35, 0x86, 0x1f, 0xd9,
-	0xcf, 0x62, 0x7e, 0x36, 0xfe, 0x3d, 0x05, 0xae, 0x3e, 0xc6, 0xfe, 0x23, 0xa1, 0x97, 0xc9, 0x77,
-	0xd3, 0xf3, 0xcd, 0x81, 0xf7, 0x62, 0x7d, 0xa3, 0x1c, 0x06, 0x5a, 0xfd, 0x89, 0x02, 0xd7, 0x32,
-	0x27, 0xc3, 0xb7, 0x8e, 0xeb, 0x9d, 0x40, 0x2b, 0xcb, 0xf5, 0xce, 0x6f, 0xe0, 0xd3, 0x2f, 0xf4,
-	0xd1, 0x04, 0xef, 0xe8, 0xa6, 0xcb, 0xf4, 0xce, 0x9c, 0x5a, 0xf8, 0xe7, 0x0a, 0x5c, 0x79, 0x8c,
-	0xfd, 0x9d, 0xc0, 0x26, 0xbd, 0xc2, 0xdd, 0x21, 0x38, 0x11, 0xdb, 0x18, 0x38, 0x67, 0x31, 0x98,
-	0xfa, 0x07, 0xec, 0x38, 0xa5, 0xf3, 0x7d, 0x25, 0x1b, 0x78, 0x95, 0x4a, 0x42, 0x44, 0x24, 0x1f,
-	0x31, 0xd7, 0x81, 0x6f, 0x9f, 0xfa, 0xe7, 0x0a, 0x5c, 0x7a, 0x38, 0x78, 0x36, 0x31, 0x5d, 0xcc,
-	0x91, 0x36, 0x9d, 0xc1, 0xd1, 0xfc, 0x9b, 0x1b, 0xba, 0x59, 0x85, 0x98, 0x9b, 0x35, 0xcb, 0x35,
-	0x5f, 0x85, 0x8a, 0xcf, 0xfc, 0x3a, 0xe6, 0xa9, 0xf0, 0x16, 0x9d, 0x9f, 0x86, 0x47, 0x58, 0xf7,
-	0xfe, 0x77, 0xce, 0xef, 0x27, 0x25, 0x68, 0x7c, 0xc1, 0xdd, 0x31, 0x6a, 0xb5, 0x93, 0x9c, 0xa4,
-	0xc8, 0x1d, 0xaf, 0x88, 0x07, 0x27, 0x73, 0xea, 0x1e, 0x43, 0xd3, 0xc3, 0xf8, 0x68, 0x1e, 0x1b,
-	0xdd, 0x20, 0x1d, 0x85, 0x6d, 0xdd, 0x84, 0x95, 0x89, 0x4d, 0x43, 0x03, 0x6c, 0xf0, 0x0d, 0x64,
-	0x9c, 0x3b, 0x5b, 0x77, 0xa7, 0x3b, 0xa2, 0xcf, 0x79, 0xf4, 0x11, 0x19, 0xab, 0x9c, 0x6b, 0xac,
-	0x64, 0x37, 0xd4, 0x83, 0xb6, 0xe1, 0x3a, 0xe3, 0x31, 0x36, 0xfa, 0x5e, 0x30, 0x54, 0x25, 0xdf,
-	0x50, 0xbc, 0x9f, 0x18, 0xea, 0x7d, 0x38, 0x9f, 0x9c, 0x69, 0xcf, 0x20, 0x0e, 0x29, 0x39, 0x43,
-	0xd9, 0x27, 0x74, 0x07, 0x56, 0xd2, 0xf8, 0x55, 0x8a, 0x9f, 0xfe, 0x80, 0xde, 0x03, 0x94, 0x98,
-	0x2a, 0x41, 0xaf, 0x31, 0xf4, 0xf8, 0x64, 0x7a, 0x86, 0xa7, 0xfe, 0x58, 0x81, 0xd5, 0xa7, 0xba,
-	0x3f, 0x38, 0x5c, 0xb7, 0xb8, 0xac, 0x2d, 0xa0, 0xab, 0x3e, 0x81, 0xda, 0x31, 0xe7, 0x8b, 0xc0,
-	0x20, 0x5d, 0x93, 0xec, 0x4f, 0x94, 0x03, 0xb5, 0xb0, 0x07, 0x89, 0x87, 0x2e, 0x6c, 0x44, 0xe2,
-	0xc2, 0x57, 0xa0, 0x35, 0x67, 0x04, 0xb4, 0xea, 0x73, 0x00, 0x3e, 0xb9, 0x2d, 0x6f, 0x38, 0xc7,
-	0xbc, 0x3e, 0x86, 0x25, 0x3e, 0x1a, 0x57, 0x8b, 0xb3, 0xf8, 0x27, 0x40, 0x57, 0x7f, 0x56, 0x81,
-	0x7a, 0xe4, 0x03, 0x6a, 0x41, 0x41, 0xc8, 0x6b, 0x41, 0xb2, 0xba, 0xc2, 0xec, 0x10, 0xaa, 0x98,
-	0x0e, 0xa1, 0x6e, 0x42, 0xcb, 0xa4, 0x7e, 0x48, 0x9f, 0x9f, 0x0a, 0x55, 0x20, 0x35, 0xad, 0xc9,
-	0xa0, 0x9c, 0x45, 0xd0, 0x55, 0xa8, 0xdb, 0x13, 0xab, 0xef, 0x1c, 0xf4, 0x5d, 0xe7, 0xc4, 0xe3,
-	0xb1, 0x58, 0xcd, 0x9e, 0x58, 0xdf, 0x3e, 0xd0, 0x9c, 0x13, 0x2f, 0x74, 0xf7, 0x2b, 0x67, 0x74,
-	0xf7, 0xaf, 0x42, 0xdd, 0xd2, 0x9f, 0x93, 0x51, 0xfb, 0xf6, 0xc4, 0xa2, 0x61, 0x5a, 0x51, 0xab,
-	0x59, 0xfa, 0x73, 0xcd, 0x39, 0xd9, 0x9e, 0x58, 0xe8, 0x16, 0xb4, 0x47, 0xba, 0xe7, 0xf7, 0xa3,
-	0x71, 0x5e, 0x95, 0xc6, 0x79, 0x2d, 0x02, 0xff, 0x2c, 0x8c, 0xf5, 0xd2, 0x81, 0x43, 0x6d, 0x81,
-	0xc0, 0xc1, 0xb0, 0x46, 0xe1, 0x40, 0x90, 0x3f, 0x70, 0x30, 0xac, 0x91, 0x18, 0xe6, 0x63, 0x58,
-	0xda, 0xa7, 0xde, 0x9d, 0xd7, 0xa9, 0x67, 0xea, 0x8e, 0x0d, 0xe2, 0xd8, 0x31, 0x27, 0x50, 0x0b,
-	0xd0, 0xd1, 0x37, 0xa1, 0x46, 0x8d, 0x2a, 0xed, 0xdb, 0xc8, 0xd5, 0x37, 0xec, 0x40, 0x7a, 0x1b,
-	0x78, 0xe4, 0xeb, 0xb4, 0x77, 0x33, 0x5f, 0x6f, 0xd1, 0x81, 0xe8, 0xab, 0x81, 0x8b, 0x75, 0x1f,
-	0x1b, 0x6b, 0xa7, 0x8f, 0x1c, 0x6b, 0xac, 0x53, 0x66, 0xea, 0xb4, 0xa8, 0x07, 0x2f, 0xfb, 0x84,
-	0xde, 0x82, 0xd6, 0x40, 0xb4, 0x36, 0x5c, 0xc7, 0xea, 0x2c, 0x53, 0x39, 0x4a, 0x40, 0xd1, 0x15,
-	0x80, 0x40, 0x53, 0xe9, 0x7e, 0xa7, 0x4d, 0x4f, 0xb1, 0xc6, 0x21, 0x0f, 0x69, 0x1a, 0xc7, 0xf4,
-	0xfa, 0x2c, 0x61, 0x62, 0xda, 0xc3, 0xce, 0x0a, 0xa5, 0x58, 0x0f, 0x32, 0x2c, 0xa6, 0x3d, 0x44,
-	0x17, 0x61, 0xc9, 0xf4, 0xfa, 0x07, 0xfa, 0x11, 0xee, 0x20, 0xfa, 0xb5, 0x62, 0x7a, 0x1b, 0xfa,
-	0x11, 0x56, 0x7f, 0x08, 0x17, 0x42, 0xee, 0x8a, 0x9c, 0x64, 0x9a, 0x29, 0x94, 0x79, 0x99, 0x62,
-	0xba, 0x4f, 0xff, 0xcb, 0x12, 0xac, 0xee, 0xea, 0xc7, 0xf8, 0xe5, 0x87, 0x0f, 0xb9, 0xd4, 0xda,
-	0x26, 0xac, 0xd0, 0x88, 0xe1, 0x7e, 0x64, 0x3e, 0x53, 0xec, 0x6a, 0x94, 0x15, 0xd2, 0x1d, 0xd1,
-	0xb7, 0x88, 0x43, 0x80, 0x07, 0x47, 0x3b, 0x8e, 0x19, 0xda, 0xd4, 0x2b, 0x92, 0x71, 0x1e, 0x09,
-	0x2c, 0x2d, 0xda, 0x03, 0xed, 0xc0, 0x72, 0xfc, 0x18, 0x02, 0x6b, 0xfa, 0xf6, 0xd4, 0x20, 0x36,
-	0xdc, 0x7d, 0xad, 0x15, 0x3b, 0x0c, 0x0f, 0x75, 0x60, 0x89, 0x9b, 0x42, 0xaa, 0x33, 0xaa, 0x5a,
-	0xd0, 0x44, 0x3b, 0x70, 0x9e, 0xad, 0x60, 0x97, 0x0b, 0x04, 0x5b, 0x7c, 0x35, 0xd7, 0xe2, 0x65,
-	0x5d, 0xe3, 0xf2, 0x54, 0x3b, 0xab, 0x3c, 0x75, 0x60, 0x89, 0xf3, 0x38, 0xd5, 0x23, 0x55, 0x2d,
-	0x68, 0x92, 0x63, 0x0e, 0xb9, 0xbd, 0x4e, 0xbf, 0x85, 0x00, 0x12, 0x7a, 0x41, 0xb8, 0x9f, 0x33,
-	0xd2, 0x2d, 0x9f, 0x42, 0x55, 0x70, 0x78, 0x21, 0x37, 0x87, 0x8b, 0x3e, 0x49, 0xfd, 0x5e, 0x4c,
-	0xe8, 0x77, 0xf5, 0x9f, 0x14, 0x68, 0xac, 0x93, 0x25, 0x6d, 0x3a, 0x43, 0x6a, 0x8d, 0x6e, 0x42,
-	0xcb, 0xc5, 0x03, 0xc7, 0x35, 0xfa, 0xd8, 0xf6, 0x5d, 0x13, 0xb3, 0x28, 0xbd, 0xa4, 0x35, 0x19,
-	0xf4, 0x33, 0x06, 0x24, 0x68, 0x44, 0x65, 0x7b, 0xbe, 0x6e, 0x8d, 0xfb, 0x07, 0x44, 0x35, 0x14,
-	0x18, 0x9a, 0x80, 0x52, 0xcd, 0xf0, 0x06, 0x34, 0x42, 0x34, 0xdf, 0xa1, 0xf4, 0x4b, 0x5a, 0x5d,
-	0xc0, 0xf6, 0x1c, 0xf4, 0x26, 0xb4, 0xe8, 0x9e, 0xf6, 0x47, 0xce, 0xb0, 0x4f, 0x22, 0x5a, 0x6e,
-	0xa8, 0x1a, 0x06, 0x9f, 0x16, 0x39, 0xab, 0x38, 0x96, 0x67, 0xfe, 0x00, 0x73, 0x53, 0x25, 0xb0,
-	0x76, 0xcd, 0x1f, 0x60, 0xf5, 0x1f, 0x15, 0x68, 0xae, 0xeb, 0xbe, 0xbe, 0xed, 0x18, 0x78, 0x6f,
-	0x4e, 0xc3, 0x9e, 0x23, 0xf5, 0x79, 0x19, 0x6a, 0x62, 0x05, 0x7c, 0x49, 0x21, 0x00, 0x6d, 0x40,
-	0x2b, 0x70, 0x2d, 0xfb, 0x2c, 0xe2, 0x2a, 0x65, 0x3a, 0x50, 0x11, 0xcb, 0xe9, 0x69, 0xcd, 0xa0,
-	0x1b, 0x6d, 0xaa, 0x1b, 0xd0, 0x88, 0x7e, 0x26, 0x54, 0x77, 0x93, 0x8c, 0x22, 0x00, 0x84, 0x1b,
-	0xb7, 0x27, 0x16, 0x39, 0x53, 0xae, 0x58, 0x82, 0xa6, 0xfa, 0x23, 0x05, 0x9a, 0xdc, 0xdc, 0xef,
-	0x8a, 0x4b, 0x02, 0xba, 0x34, 0x85, 0x2e, 0x8d, 0xfe, 0x46, 0xbf, 0x16, 0xcf, 0xeb, 0xbd, 0x29,
-	0x55, 0x02, 0x74, 0x10, 0xea, 0x64, 0xc6, 0x6c, 0x7d, 0x9e, 0x18, 0xff, 0x4b, 0xc2, 0x68, 0xfc,
-	0x68, 0x28, 0xa3, 0x75, 0x60, 0x49, 0x37, 0x0c, 0x17, 0x7b, 0x1e, 0x9f, 0x47, 0xd0, 0x24, 0x5f,
-	0x8e, 0xb1, 0xeb, 0x05, 0x2c, 0x5f, 0xd4, 0x82, 0x26, 0xfa, 0x26, 0x54, 0x85, 0x57, 0xca, 0xd2,
-	0xe1, 0xd7, 0xb3, 0xe7, 0xc9, 0x23, 0x52, 0xd1, 0x43, 0xfd, 0xbb, 0x02, 0xb4, 0xf8, 0x86, 0xad,
-	0x71, 0x7b, 0x3c, 0x5d, 0xf8, 0xd6, 0xa0, 0x71, 0x10, 0xca, 0xfe, 0xb4, 0xdc, 0x53, 0x54, 0x45,
-	0xc4, 0xfa, 0xcc, 0x12, 0xc0, 0xb8, 0x47, 0x50, 0x5a, 0xc8, 0x23, 0x28, 0x9f, 0x55, 0x83, 0xa5,
-	0x7d, 0xc4, 0x8a, 0xc4, 0x47, 0x54, 0x7f, 0x13, 0xea, 0x91, 0x01, 0xa8, 0x86, 0x66, 0x49, 0x2b,
-	0xbe, 0x63, 0x41, 0x13, 0x7d, 0x18, 0xfa, 0x45, 0x6c, 0xab, 0x2e, 0x49, 0xe6, 0x92, 0x70, 0x89,
-	0xd4, 0x7f, 0x50, 0xa0, 0xc2, 0x47, 0xbe, 0x06, 0x75, 0xae, 0x74, 0xa8, 0xcf, 0xc8, 0x46, 0x07,
-	0x0e, 0x22, 0x4e, 0xe3, 0x8b, 0xd3, 0x3a, 0x97, 0xa0, 0x9a, 0xd0, 0x37, 0x4b, 0xdc, 0x2c, 0x04,
-	0x9f, 0x22, 0x4a, 0x86, 0x7c, 0x22, 0xfa, 0x05, 0x5d, 0x80, 0xf2, 0xc8, 0x19, 0x8a, 0x4b, 0x20,
-	0xd6, 0x50, 0xbf, 0x52, 0x68, 0xce, 0x5e, 0xc3, 0x03, 0xe7, 0x18, 0xbb, 0xa7, 0x8b, 0x27, 0x3b,
-	0x1f, 0x44, 0xd8, 0x3c, 0x67, 0xf0, 0x25, 0x3a, 0xa0, 0x07, 0xe1, 0x21, 0x14, 0x65, 0x99, 0x9e,
-	0xa8, 0xde, 0xe1, 0x4c, 0x1a, 0x1e, 0xc6, 0x1f, 0xb2, 0xb4, 0x6d, 0x7c, 0x29, 0xf3, 0x7a, 0x3b,
-	0x2f, 0x24, 0x90, 0x51, 0x7f, 0xa9, 0x40, 0x37, 0x4c, 0x25, 0x79, 0x6b, 0xa7, 0x8b, 0x5e, 0x8a,
-	0xbc, 0x98, 0xf8, 0xea, 0x57, 0x45, 0xd6, 0x9e, 0x08, 0x6d, 0xae, 0xc8, 0x28, 0xc8, 0xd9, 0xdb,
-	0x34, 0x2b, 0x9d, 0x5e, 0xd0, 0x22, 0x2c, 0xd3, 0x85, 0xaa, 0xc8, 0x67, 0xb0, 0xcc, 0xbd, 0x68,
-	0x13, 0x09, 0xbb, 0xf4, 0x18, 0xfb, 0x1b, 0xf1, 0x54, 0xc8, 0xab, 0xde, 0xc0, 0xe8, 0x6d, 0xc2,
-	0x21, 0xbf, 0x4d, 0x28, 0x25, 0x6e, 0x13, 0x38, 0x5c, 0xb5, 0x28, 0x0b, 0xa4, 0x16, 0xf0, 0xb2,
-	0x36, 0xec, 0x77, 0x14, 0xe8, 0x70, 0x2a, 0x94, 0x26, 0x09, 0x89, 0x46, 0xd8, 0xc7, 0xc6, 0xd7,
-	0x9d, 0x2a, 0xf8, 0x6f, 0x05, 0xda, 0x51, 0xab, 0x4b, 0x0d, 0xe7, 0x47, 0x50, 0xa6, 0x99, 0x16,
-	0x3e, 0x83, 0x99, 0xaa, 0x81, 0x61, 0x13, 0xb5, 0x4d, 0x5d, 0xed, 0x3d, 0xe1, 0x20, 0xf0, 0x66,
-	0x68, 0xfa, 0x8b, 0x67, 0x37, 0xfd, 0xdc, 0x15, 0x72, 0x26, 0x64, 0x5c, 0x96, 0xa2, 0x0c, 0x01,
-	0xe8, 0x13, 0xa8, 0xb0, 0x42, 0x0c, 0x7e, 0xc3, 0x76, 0x33, 0x3e, 0x34, 0x2f, 0xd2, 0x88, 0xe4,
-	0xfd, 0x29, 0x40, 0xe3, 0x9d, 0xd4, 0x5f, 0x87, 0xd5, 0x30, 0x1a, 0x65, 0x64, 0xe7, 0x65, 0x5a,
-	0xf5, 0x5f, 0x15, 0x38, 0xbf, 0x7b, 0x6a, 0x0f, 0x92, 0xec, 0xbf, 0x0a, 0x95, 0xf1, 0x48, 0x0f,
-	0x33, 0xa6, 0xbc, 0x45, 0xdd, 0x40, 0x46, 0x1b, 0x1b, 0xc4, 0x86, 0xb0, 0x3d, 0xab, 0x0b, 0xd8,
-	0x9e, 0x33, 0xd3, 0xb4, 0xdf, 0x14, 0xe1, 0x33, 0x36, 0x98, 0xb5, 0x62, 0x69, 0xa8, 0xa6, 0x80,
-	0x52, 0x6b, 0xf5, 0x09, 0x00, 0x35, 0xe8, 0xfd, 0xb3, 0x18, 0x71, 0xda, 0x63, 0x93, 0xa8, 0xec,
-	0x5f, 0x14, 0xa0, 0x13, 0xd9, 0xa5, 0xaf, 0xdb, 0xbf, 0xc9, 0x88, 0xca, 0x8a, 0x2f, 0x28, 0x2a,
-	0x2b, 0x2d, 0xee, 0xd3, 0x94, 0x65, 0x3e, 0xcd, 0xbf, 0x15, 0xa0, 0x15, 0xee, 0xda, 0xce, 0x48,
-	0xb7, 0x33, 0x39, 0x61, 0x57, 0xf8, 0xf3, 0xf1, 0x7d, 0x7a, 0x57, 0x26, 0x27, 0x19, 0x07, 0xa1,
-	0x25, 0x86, 0x40, 0x57, 0xe8, 0xa1, 0xbb, 0x3e, 0x4b, 0x7c, 0xf1, 0x18, 0x82, 0x09, 0xa4, 0x69,
-	0x61, 0x74, 0x07, 0x10, 0x97, 0xa2, 0xbe, 0x69, 0xf7, 0x3d, 0x3c, 0x70, 0x6c, 0x83, 0xc9, 0x57,
-	0x59, 0x6b, 0xf3, 0x2f, 0x3d, 0x7b, 0x97, 0xc1, 0xd1, 0x47, 0x50, 0xf2, 0x4f, 0xc7, 0xcc, 0x5b,
-	0x69, 0x49, 0xed, 0x7d, 0x38, 0xaf, 0xbd, 0xd3, 0x31, 0xd6, 0x28, 0x7a, 0x50, 0xa9, 0xe3, 0xbb,
-	0xfa, 0x31, 0x77, 0xfd, 0x4a, 0x5a, 0x04, 0x42, 0x34, 0x46, 0xb0, 0x87, 0x4b, 0xcc, 0x45, 0xe2,
-	0x4d, 0xc6, 0xd9, 0x81, 0xd0, 0xf6, 0x7d, 0x7f, 0x44, 0x53, 0x77, 0x94, 0xb3, 0x03, 0xe8, 0x9e,
-	0x3f, 0x52, 0xff, 0xa5, 0x00, 0xed, 0x90, 0xb2, 0x86, 0xbd, 0xc9, 0x28, 0x5b, 0xe0, 0xa6, 0xe7,
-	0x46, 0x66, 0xc9, 0xda, 0xb7, 0xa0, 0xce, 0x8f, 0xfd, 0x0c, 0x6c, 0x03, 0xac, 0xcb, 0xe6, 0x14,
-	0x3e, 0x2e, 0xbf, 0x20, 0x3e, 0xae, 0xcc, 0x91, 0x5d, 0x90, 0x6f, 0xbe, 0xfa, 0x53, 0x05, 0x5e,
-	0x4b, 0xa9, 0xc5, 0xa9, 0x5b, 0x3b, 0x3d, 0xb6, 0xe3, 0xea, 0x32, 0x39, 0x24, 0x57, 0xf0, 0x0f,
-	0xa0, 0xe2, 0xd2, 0xd1, 0xf9, 0x55, 0xd0, 0x8d, 0xa9, 0xdc, 0xc5, 0x26, 0xa2, 0xf1, 0x2e, 0xea,
-	0x1f, 0x29, 0x70, 0x31, 0x3d, 0xd5, 0x05, 0xac, 0xf6, 0x1a, 0x2c, 0xb1, 0xa1, 0x03, 0x21, 0xbc,
-	0x35, 0x5d, 0x08, 0xc3, 0xcd, 0xd1, 0x82, 0x8e, 0xea, 0x2e, 0xac, 0x06, 0xc6, 0x3d, 0xdc, 0xfa,
-	0x2d, 0xec, 0xeb, 0x53, 0x22, 0x9b, 0x6b, 0x50, 0x67, 0x2e, 0x32, 0x8b, 0x18, 0x58, 0x4e, 0x00,
-	0xf6, 0x45, 0x2a, 0x4d, 0xfd, 0x4f, 0x05, 0x2e, 0x50, 0xeb, 0x98, 0xbc, 0x7b, 0xc9, 0x73, 0x2f,
-	0xa7, 0x8a, 0x94, 0xc3, 0xb6, 0x6e, 0xf1, 0x3a, 0x90, 0x9a, 0x16, 0x83, 0xa1, 0x5e, 0x3a, 0xd3,
-	0x26, 0x8d, 0x80, 0xc3, 0x8b, 0x5c, 0x12, 0x6d, 0xd3, 0x7b, 0xdc, 0x64, 0x8a, 0x2d, 0xb4, 0xca,
-	0xa5, 0x79, 0xac, 0xf2, 0x26, 0xbc, 0x96, 0x58, 0xe9, 0x02, 0x27, 0xaa, 0xfe, 0x95, 0x42, 0x8e,
-	0x23, 0x56, 0x4f, 0x33, 0xbf, 0x67, 0x7a, 0x45, 0x5c, 0xfa, 0xf4, 0x4d, 0x23, 0xa9, 0x44, 0x0c,
-	0xf4, 0x29, 0xd4, 0x6c, 0x7c, 0xd2, 0x8f, 0x3a, 0x3b, 0x39, 0xdc, 0xf6, 0xaa, 0x8d, 0x4f, 0xe8,
-	0x2f, 0x75, 0x1b, 0x2e, 0xa6, 0xa6, 0xba, 0xc8, 0xda, 0xff, 0x5e, 0x81, 0x4b, 0xeb, 0xae, 0x33,
-	0xfe, 0xc2, 0x74, 0xfd, 0x89, 0x3e, 0x8a, 0x5f, 0x91, 0xbf, 0x9c, 0xd4, 0xd5, 0xe7, 0x11, 0xb7,
-	0x97, 0xf1, 0xcf, 0x1d, 0x89, 0x04, 0xa5, 0x27, 0xc5, 0x17, 0x1d, 0x71, 0x92, 0xff, 0xa3, 0x28,
-	0x9b, 0x3c, 0xc7, 0x9b, 0xe1, 0x78, 0xe4, 0x89, 0x20, 0xa4, 0x99, 0xee, 0xe2, 0xbc, 0x99, 0xee,
-	0x0c, 0xf5, 0x5e, 0x7a, 0x41, 0xea, 0xfd, 0xcc, 0xa9, 0x97, 0xcf, 0x21, 0x7e, 0x0b, 0x41, 0xcd,
-	0xef, 0x5c, 0xd7, 0x17, 0x6b, 0x00, 0x61, 0x46, 0x9e, 0x97, 0x43, 0xe6, 0x19, 0x26, 0xd2, 0x8b,
-	0x9c, 0x96, 0x30, 0xa5, 0xdc, 0x94, 0x47, 0x72, 0xc4, 0xdf, 0x81, 0xae, 0x8c, 0x4b, 0x17, 0xe1,
-	0xfc, 0x5f, 0x14, 0x00, 0x7a, 0xa2, 0x82, 0x76, 0x3e, 0x5b, 0x70, 0x03, 0x22, 0xee, 0x46, 0x28,
-	0xef, 0x51, 0x2e, 0x32, 0x88, 0x48, 0x88, 0xa0, 0x93, 0xe0, 0xa4, 0x02, 0x51, 0x83, 0x8e, 0x13,
-	0x91, 0x1a, 0xc6, 0x14, 0x49, 0xf5, 0xfb, 0x3a, 0xd4, 0x5c, 0xe7, 0xa4, 0x4f, 0xc4, 0xcc, 0x08,
-	0x4a, 0x84, 0x5d, 0xe7, 0x84, 0x08, 0x9f, 0x81, 0x2e, 0xc2, 0x92, 0xaf, 0x7b, 0x47, 0x64, 0xfc,
-	0x4a, 0xa4, 0x4a, 0xc3, 0x40, 0x17, 0xa0, 0x7c, 0x60, 0x8e, 0x30, 0x2b, 0x0a, 0xa8, 0x69, 0xac,
-	0x81, 0xbe, 0x11, 0xd4, 0xb2, 0x55, 0x73, 0x57, 0xe2, 0xb0, 0x72, 0xb6, 0xaf, 0x14, 0x58, 0x0e,
-	0x77, 0x8d, 0x2a, 0x20, 0xa2, 0xd3, 0xa8, 0x3e, 0x7b, 0xe4, 0x18, 0x4c, 0x55, 0xb4, 0x32, 0x2c,
-	0x02, 0xeb, 0xc8, 0xb4, 0x56, 0xd8, 0x65, 0x5a, 0x1c, 0x4c, 0xd6, 0x45, 0x16, 0x6d, 0x1a, 0x41,
-	0x65, 0x4a, 0xc5, 0x75, 0x4e, 0x7a, 0x86, 0xd8, 0x0d, 0x56, 0xff, 0xcb, 0xa2, 0x3e, 0xb2, 0x1b,
-	0x8f, 0x68, 0x09, 0xf0, 0x0d, 0x68, 0x62, 0xd7, 0x75, 0xdc, 0xbe, 0x85, 0x3d, 0x4f, 0x1f, 0x62,
-	0xee, 0x80, 0x37, 0x28, 0x70, 0x8b, 0xc1, 0xd4, 0x3f, 0x29, 0x41, 0x2b, 0x5c, 0x4a, 0x70, 0x0f,
-	0x6e, 0x1a, 0xc1, 0x3d, 0xb8, 0x49, 0x8e, 0x0e, 0x5c, 0xa6, 0x0a, 0xc5, 0xe1, 0xae, 0x15, 0x3a,
-	0x8a, 0x56, 0xe3, 0xd0, 0x9e, 0x41, 0xcc, 0x32, 0x11, 0x32, 0xdb, 0x31, 0x70, 0x78, 0xb8, 0x10,
-	0x80, 0xf8, 0xd9, 0xc6, 0x78, 0xa4, 0x94, 0x83, 0x47, 0xca, 0x39, 0x78, 0xa4, 0x22, 0xe1, 0x91,
-	0x55, 0xa8, 0xec, 0x4f, 0x06, 0x47, 0xd8, 0xe7, 0x1e, 0x1b, 0x6f, 0xc5, 0x79, 0xa7, 0x9a, 0xe0,
-	0x1d, 0xc1, 0x22, 0xb5, 0x28, 0x8b, 0xbc, 0x0e, 0x35, 0x76, 0x21, 0xdb, 0xf7, 0x3d, 0x7a, 0xbb,
-	0x54, 0xd4, 0xaa, 0x0c, 0xb0, 0xe7, 0xa1, 0x8f, 0x03, 0x77, 0xae, 0x2e, 0x13, 0x76, 0xaa, 0x75,
-	0x12, 0x5c, 0x12, 0x38, 0x73, 0x6f, 0xc3, 0x72, 0x64, 0x3b, 0xa8, 0x8d, 0x68, 0xd0, 0xa9, 0x46,
-	0xdc, 0x79, 0x6a, 0x26, 0x6e, 0x42, 0x2b, 0xdc, 0x12, 0x8a, 0xd7, 0x64, 0x51, 0x94, 0x80, 0x52,
-	0x34, 0xc1, 0xc9, 0xad, 0xb3, 0x71, 0x32, 0xba, 0x04, 0x55, 0x1e, 0xfe, 0x78, 0x9d, 0xe5, 0x58,
-	0x36, 0x42, 0xfd, 0x3e, 0xa0, 0x70, 0xf6, 0x8b, 0x79, 0x8b, 0x09, 0xf6, 0x28, 0x24, 0xd9, 0x43,
-	0xfd, 0x99, 0x02, 0x2b, 0x51, 0x62, 0xf3, 0x1a, 0xde, 0x4f, 0xa1, 0xce, 0xee, 0xf7, 0xfa, 0x44,
-	0xf0, 0x79, 0x96, 0xe7, 0xca, 0xd4, 0x73, 0xd1, 0x20, 0x7c, 0x41, 0x40, 0xd8, 0xeb, 0xc4, 0x71,
-	0x8f, 0x4c, 0x7b, 0xd8, 0x27, 0x33, 0x0b, 0xc4, 0xad, 0xc1, 0x81, 0xdb, 0x04, 0xa6, 0xfe, 0x58,
-	0x81, 0xab, 0x4f, 0xc6, 0x86, 0xee, 0xe3, 0x88, 0x07, 0xb2, 0x68, 0x51, 0xe2, 0x47, 0x41, 0x55,
-	0x60, 0x21, 0xdf, 0x1d, 0x15, 0xc3, 0x56, 0xff, 0x46, 0xcc, 0x85, 0x9b, 0x03, 0x7a, 0xa1, 0x39,
-	0xa6, 0x17, 0xc4, 0x73, 0xcf, 0xa5, 0x0b, 0xd5, 0x63, 0x3e, 0x5c, 0xf0, 0x22, 0x22, 0x68, 0xc7,
-	0xee, 0x41, 0x8b, 0x67, 0xbf, 0x07, 0x55, 0xb7, 0xe0, 0x92, 0x86, 0x3d, 0x6c, 0x1b, 0xb1, 0xd5,
-	0xcc, 0x9d, 0x4d, 0x1a, 0x43, 0x57, 0x36, 0xdc, 0x22, 0xcc, 0xca, 0x7c, 0xd7, 0xbe, 0x4b, 0x86,
-	0xf5, 0xb9, 0x2a, 0x26, 0x2e, 0x13, 0xa5, 0xe3, 0xab, 0x7f, 0x5d, 0x80, 0x8b, 0x0f, 0x0d, 0x83,
-	0x6b, 0x71, 0xee, 0x8d, 0xbd, 0x2c, 0x47, 0x39, 0xe9, 0x48, 0x16, 0xd3, 0x8e, 0xe4, 0x8b, 0xd2,
-	0xac, 0xdc, 0xc6, 0xd8, 0x13, 0x2b, 0xb0, 0x9d, 0x2e, 0x2b, 0x10, 0x7a, 0xc0, 0x2f, 0xc6, 0x48,
-	0x40, 0x4f, 0xed, 0xe7, 0x6c, 0xff, 0xaa, 0x1a, 0x64, 0xc5, 0xd4, 0x31, 0x74, 0xd2, 0x9b, 0xb5,
-	0xa0, 0x2a, 0x09, 0x76, 0x64, 0xec, 0xb0, 0x0c, 0x6a, 0x83, 0xb8, 0x50, 0x14, 0xb4, 0xe3, 0x78,
-	0xea, 0x7f, 0x15, 0xa0, 0xb3, 0xab, 0x1f, 0xe3, 0xff, 0x3f, 0x07, 0xf4, 0x5d, 0xb8, 0xe0, 0xe9,
-	0xc7, 0xb8, 0x1f, 0x09, 0x8c, 0xfb, 0x2e, 0x7e, 0xc6, 0x5d, 0xd0, 0x77, 0x64, 0x9a, 0x44, 0x5a,
-	0x47, 0xa3, 0xad, 0x78, 0x31, 0xb8, 0x86, 0x9f, 0xa1, 0xb7, 0x60, 0x39, 0x5a, 0xa8, 0x45, 0xa6,
-	0x56, 0xa5, 0x5b, 0xde, 0x8c, 0xd4, 0x61, 0xf5, 0x0c, 0xf5, 0x19, 0x5c, 0x7e, 0x62, 0x7b, 0xd8,
-	0xef, 0x85, 0xb5, 0x44, 0x0b, 0x86, 0x90, 0xd7, 0xa0, 0x1e, 0x6e, 0x7c, 0xea, 0x15, 0x84, 0xe1,
-	0xa9, 0x0e, 0x74, 0xb7, 0x74, 0xf7, 0x28, 0xc8, 0x23, 0xaf, 0xb3, 0x9a, 0x8f, 0x97, 0x48, 0xf0,
-	0x40, 0x94, 0x40, 0x69, 0xf8, 0x00, 0xbb, 0xd8, 0x1e, 0xe0, 0x4d, 0x67, 0x70, 0x14, 0x29, 0x0d,
-	0x56, 0xa2, 0xa5, 0xc1, 0xf3, 0x96, 0x1a, 0xab, 0x3f, 0x2f, 0xc0, 0xea, 0xc3, 0x91, 0x8f, 0xdd,
-	0x30, 0xf2, 0x3f, 0x4b, 0x12, 0x23, 0xcc, 0x2a, 0x14, 0xe6, 0xc8, 0x2a, 0xa4, 0xaa, 0xdc, 0x8b,
-	0xe9, 0x2a, 0x77, 0x59, 0x0e, 0xa4, 0x34, 0x67, 0x0e, 0xe4, 0x21, 0xc0, 0xd8, 0x75, 0xc6, 0xd8,
-	0xf5, 0x4d, 0x1c, 0x84, 0x6f, 0x39, 0xdc, 0x97, 0x48, 0xa7, 0xdb, 0x9f, 0x8a, 0x32, 0xce, 0xbd,
-	0xd3, 0x31, 0x46, 0x4b, 0x50, 0xdc, 0xc6, 0x27, 0xed, 0x73, 0x08, 0xa0, 0xb2, 0xed, 0xb8, 0x96,
-	0x3e, 0x6a, 0x2b, 0xa8, 0x0e, 0x4b, 0xfc, 0xd6, 0xaa, 0x5d, 0x40, 0x4d, 0xa8, 0x3d, 0x0a, 0x32,
-	0xff, 0xed, 0xe2, 0xed, 0x3f, 0x53, 0x60, 0x25, 0x75, 0xaf, 0x82, 0x5a, 0x00, 0x4f, 0xec, 0x01,
-	0xbf, 0x70, 0x6a, 0x9f, 0x43, 0x0d, 0xa8, 0x06, 0xd7, 0x4f, 0x6c, 0xbc, 0x3d, 0x87, 0x62, 0xb7,
-	0x0b, 0xa8, 0x0d, 0x0d, 0xd6, 0x71, 0x32, 0x18, 0x60, 0xcf, 0x6b, 0x17, 0x05, 0x64, 0x43, 0x37,
-	0x47, 0x13, 0x17, 0xb7, 0x4b, 0x84, 0xe6, 0x9e, 0xc3, 0x0b, 0xd9, 0xdb, 0x65, 0x84, 0xa0, 0x15,
-	0x54, 0xb5, 0xf3, 0x4e, 0x95, 0x08, 0x2c, 0xe8, 0xb6, 0x74, 0xfb, 0x69, 0x34, 0x3b, 0x4e, 0x97,
-	0x77, 0x11, 0xce, 0x3f, 0xb1, 0x0d, 0x7c, 0x60, 0xda, 0xd8, 0x08, 0x3f, 0xb5, 0xcf, 0xa1, 0xf3,
-	0xb0, 0xbc, 0x85, 0xdd, 0x21, 0x8e, 0x00, 0x0b, 0x68, 0x05, 0x9a, 0x5b, 0xe6, 0xf3, 0x08, 0xa8,
-	0xa8, 0x96, 0xaa, 0x4a, 0x5b, 0xb9, 0xff, 0xbb, 0x57, 0xa0, 0x46, 0x0e, 0xe5, 0x91, 0xe3, 0xb8,
-	0x06, 0x1a, 0x01, 0xa2, 0xef, 0x3e, 0xac, 0xb1, 0x63, 0x8b, 0xd7, 0x54, 0xe8, 0x6e, 0xfc, 0x1c,
-	0x78, 0x23, 0x8d, 0xc8, 0xb9, 0xb3, 0xfb, 0xa6, 0x14, 0x3f, 0x81, 0xac, 0x9e, 0x43, 0x16, 0xa5,
-	0xb6, 0x67, 0x5a, 0x78, 0xcf, 0x1c, 0x1c, 0x05, 0x9e, 0xc5, 0xfb, 0x19, 0x7e, 0x44, 0x1a, 0x35,
-	0xa0, 0x77, 0x43, 0x4a, 0x8f, 0x3d, 0xcc, 0x09, 0xac, 0x8c, 0x7a, 0x0e, 0x3d, 0x83, 0x0b, 0x8f,
-	0x71, 0xc4, 0x49, 0x0b, 0x08, 0xde, 0xcf, 0x26, 0x98, 0x42, 0x3e, 0x23, 0xc9, 0x4d, 0x28, 0x53,
-	0x76, 0x43, 0x32, 0x3f, 0x2e, 0xfa, 0xf0, 0xb9, 0x7b, 0x3d, 0x1b, 0x41, 0x8c, 0xf6, 0x7d, 0x58,
-	0x4e, 0x3c, 0x97, 0x44, 0x32, 0xad, 0x2e, 0x7f, 0xf8, 0xda, 0xbd, 0x9d, 0x07, 0x55, 0xd0, 0x1a,
-	0x42, 0x2b, 0xfe, 0x5e, 0x04, 0xc9, 0x32, 0xbb, 0xd2, 0x97, 0x6e, 0xdd, 0x77, 0x72, 0x60, 0x0a,
-	0x42, 0x16, 0xb4, 0x93, 0xcf, 0xf7, 0xd0, 0xed, 0xa9, 0x03, 0xc4, 0x99, 0xed, 0xdd, 0x5c, 0xb8,
-	0x82, 0xdc, 0x29, 0x65, 0x82, 0xd4, 0x8b, 0xb0, 0x24, 0x8f, 0x07, 0xc3, 0x64, 0x3d, 0x55, 0xeb,
-	0xde, 0xcb, 0x8d, 0x2f, 0x48, 0xff, 0x36, 0x2b, 0x4b, 0x91, 0xbd, 0xaa, 0x42, 0x1f, 0xc8, 0x87,
-	0x9b, 0xf2, 0x1c, 0xac, 0x7b, 0xff, 0x2c, 0x5d, 0xc4, 0x24, 0x7e, 0x48, 0xeb, 0x49, 0x24, 0xef,
-	0x92, 0x92, 0x72, 0x17, 0x8c, 0x97, 0xfd, 0xe4, 0xaa, 0xfb, 0xc1, 0x19, 0x7a, 0x88, 0x09, 0x38,
-	0xc9, 0xf7, 0x91, 0x81, 0x18, 0xde, 0x9b, 0xc9, 0x35, 0xf3, 0xc9, 0xe0, 0xf7, 0x60, 0x39, 0xe1,
-	0xe7, 0xa0, 0xfc, 0xbe, 0x50, 0x77, 0x9a, 0x33, 0xca, 0x44, 0x32, 0x51, 0x9e, 0x83, 0x32, 0xb8,
-	0x5f, 0x52, 0xc2, 0xd3, 0xbd, 0x9d, 0x07, 0x55, 0x2c, 0xc4, 0xa3, 0xea, 0x32, 0x51, 0x74, 0x81,
-	0xee, 0xc8, 0xc7, 0x90, 0x17, 0x97, 0x74, 0xdf, 0xcb, 0x89, 0x2d, 0x88, 0x1e, 0xc3, 0x79, 0x49,
-	0x6d, 0x0c, 0x7a, 0x6f, 0xea, 0x61, 0x25, 0x8b, 0x82, 0xba, 0x77, 0xf3, 0xa2, 0x0b, 0xba, 0xbf,
-	0x05, 0x68, 0xf7, 0xd0, 0x39, 0x79, 0xe4, 0xd8, 0x07, 0xe6, 0x70, 0xe2, 0xea, 0xcc, 0x4b, 0xc8,
-	0xb2, 0x0d, 0x69, 0xd4, 0x0c, 0x1e, 0x9d, 0xda, 0x43, 0x10, 0xef, 0x03, 0x3c, 0xc6, 0xfe, 0x16,
-	0xf6, 0x5d, 0x22, 0x18, 0x6f, 0x65, 0x99, 0x3f, 0x8e, 0x10, 0x90, 0x7a, 0x7b, 0x26, 0x5e, 0xc4,
-	0x14, 0xb5, 0xb7, 0x74, 0x7b, 0xa2, 0x8f, 0x22, 0xc5, 0xfd, 0x77, 0xa4, 0xdd, 0x93, 0x68, 0x19,
-	0x07, 0x99, 0x89, 0x2d, 0x48, 0x9e, 0x08, 0xd3, 0x1e, 0xb9, 0x8a, 0x9b, 0x6e, 0xda, 0xd3, 0x75,
-	0x1e, 0x49, 0xb5, 0x37, 0x05, 0x5f, 0x10, 0xfe, 0x52, 0xa1, 0xe5, 0x55, 0x09, 0x84, 0xa7, 0xa6,
-	0x7f, 0xb8, 0x33, 0xd2, 0x6d, 0x2f, 0xcf, 0x14, 0x28, 0xe2, 0x19, 0xa6, 0xc0, 0xf1, 0xc5, 0x14,
-	0x0c, 0x68, 0xc6, 0x6e, 0xc8, 0x90, 0xac, 0x1a, 0x5e, 0x76, 0x5b, 0xd8, 0xbd, 0x35, 0x1b, 0x51,
-	0x50, 0x39, 0x84, 0x66, 0x20, 0x4a, 0x6c, 0x73, 0xdf, 0xc9, 0x9a, 0x69, 0x88, 0x93, 0xa1, 0x09,
-	0xe4, 0xa8, 0x51, 0x4d, 0x90, 0xbe, 0x00, 0x40, 0xf9, 0x2e, 0x8e, 0xa6, 0x69, 0x82, 0xec, 0x5b,
-	0x05, 0xa6, 0xea, 0x12, 0x97, 0x6d, 0x72, 0x3d, 0x2a, 0xbd, 0x3b, 0x94, 0xaa, 0xba, 0x8c, 0xbb,
-	0x3b, 0xf5, 0x1c, 0x7a, 0x0a, 0x15, 0xfe, 0x6f, 0x1f, 0x6f, 0x4e, 0x4f, 0xda, 0xf1, 0xd1, 0x6f,
-	0xce, 0xc0, 0x12, 0x03, 0x1f, 0xc1, 0xc5, 0x8c, 0x94, 0x9d, 0xd4, 0x04, 0x4f, 0x4f, 0xef, 0xcd,
-	0x32, 0x0e, 0x82, 0x58, 0x2a, 0x27, 0x37, 0x85, 0x58, 0x56, 0xfe, 0x6e, 0x16, 0x31, 0x1d, 0x50,
-	0xfa, 0xfd, 0xae, 0x94, 0x27, 0x32, 0x9f, 0xf9, 0xe6, 0x20, 0x91, 0x7e, 0x82, 0x2b, 0x25, 0x91,
-	0xf9, 0x52, 0x77, 0x16, 0x89, 0x3e, 0xac, 0xa4, 0x92, 0x36, 0xe8, 0xdd, 0x0c, 0x73, 0x2d, 0x4b,
-	0xed, 0xcc, 0x22, 0x30, 0x84, 0xd7, 0xa4, 0x09, 0x0a, 0xa9, 0xfb, 0x31, 0x2d, 0x95, 0x31, 0x8b,
-	0xd0, 0x00, 0xce, 0x4b, 0xd2, 0x12, 0x52, 0xc3, 0x99, 0x9d, 0xbe, 0x98, 0x45, 0xe4, 0x00, 0xba,
-	0x6b, 0xae, 0xa3, 0x1b, 0x03, 0xdd, 0xf3, 0x69, 0xaa, 0x80, 0xc4, 0x82, 0x81, 0xff, 0x27, 0x0f,
-	0x0e, 0xa4, 0x09, 0x85, 0x59, 0x74, 0xf6, 0xa1, 0x4e, 0x19, 0x92, 0xfd, 0x9b, 0x04, 0x92, 0x5b,
-	0xba, 0x08, 0x46, 0x86, 0xfa, 0x94, 0x21, 0x06, 0xa2, 0x79, 0xff, 0xab, 0x1a, 0x54, 0x83, 0x07,
-	0x09, 0x5f, 0x73, 0x20, 0xfa, 0x0a, 0x22, 0xc3, 0xef, 0xc1, 0x72, 0xe2, 0x71, 0xb0, 0xf4, 0xb8,
-	0xe4, 0x0f, 0x88, 0x67, 0x1d, 0xd7, 0x53, 0xfe, 0xd7, 0x55, 0xc2, 0x49, 0x7c, 0x3b, 0x2b, 0xba,
-	0x4c, 0xfa, 0x87, 0x33, 0x06, 0xfe, 0xbf, 0xed, 0x95, 0x6d, 0x03, 0x44, 0xfc, 0xb1, 0xe9, 0x65,
-	0x7b, 0xc4, 0xc5, 0x98, 0xb5, 0x5b, 0x96, 0xd4, 0xe5, 0x7a, 0x27, 0x4f, 0x85, 0x54, 0xb6, 0xd1,
-	0xcc, 0x76, 0xb4, 0x9e, 0x40, 0x23, 0x5a, 0x50, 0x8b, 0xa4, 0x7f, 0x94, 0x94, 0xae, 0xb8, 0x9d,
-	0xb5, 0x8a, 0xad, 0x33, 0xda, 0xe2, 0x19, 0xc3, 0x79, 0xc4, 0x88, 0x24, 0x6f, 0x6a, 0x32, 0x8c,
-	0x48, 0xc6, 0xfd, 0x90, 0xd4, 0x77, 0xc9, 0xbe, 0xfe, 0x61, 0x49, 0x86, 0xe4, 0xf5, 0x83, 0x34,
-	0xc9, 0x90, 0x71, 0xa1, 0x23, 0x4d, 0x32, 0x64, 0xdd, 0x67, 0xa8, 0xe7, 0xd6, 0x3e, 0xfc, 0xee,
-	0x07, 0x43, 0xd3, 0x3f, 0x9c, 0xec, 0x93, 0xd5, 0xdf, 0x63, 0x5d, 0xdf, 0x33, 0x1d, 0xfe, 0xeb,
-	0x5e, 0xc0, 0xee, 0xf7, 0xe8, 0x68, 0xf7, 0xc8, 0x68, 0xe3, 0xfd, 0xfd, 0x0a, 0x6d, 0x7d, 0xf8,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x75, 0x90, 0x7f, 0x7c, 0x4f, 0x00, 0x00,
+func (m *FieldStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldStats.Unmarshal(m, b)
+}
+func (m *FieldStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldStats.Marshal(b, m, deterministic)
+}
+func (m *FieldStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldStats.Merge(m, src)
+}
+func (m *FieldStats) XXX_Size() int {
+	return xxx_messageInfo_FieldStats.Size(m)
+}
+func (m *FieldStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldStats.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_FieldStats proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *FieldStats) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
 
-// DataCoordClient is the client API for DataCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataCoordClient interface {
-	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
-	GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error)
-	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
-	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
+func (m *FieldStats) GetDataType() schemapb.DataType {
+	if m != nil {
+		return m.DataType
+	}
+	return schemapb.DataType_None
+}
+
+func (m *FieldStats) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *FieldStats) GetNullCount() int64 {
+	if m != nil {
+		return m.NullCount
+	}
+	return 0
+}
+
+func (m *FieldStats) GetDistinctCount() int64 {
+	if m != nil {
+		return m.DistinctCount
+	}
+	return 0
+}
+
+func (m *FieldStats) GetDistinctSketch() []byte {
+	if m != nil {
+		return m.DistinctSketch
+	}
+	return nil
+}
+
+func (m *FieldStats) GetIntMin() int64 {
+	if m != nil {
+		return m.IntMin
+	}
+	return 0
+}
+
+func (m *FieldStats) GetIntMax() int64 {
+	if m != nil {
+		return m.IntMax
+	}
+	return 0
+}
+
+func (m *FieldStats) GetFloatMin() float64 {
+	if m != nil {
+		return m.FloatMin
+	}
+	return 0
+}
+
+func (m *FieldStats) GetFloatMax() float64 {
+	if m != nil {
+		return m.FloatMax
+	}
+	return 0
+}
+
+func (m *FieldStats) GetStringMin() string {
+	if m != nil {
+		return m.StringMin
+	}
+	return ""
+}
+
+func (m *FieldStats) GetStringMax() string {
+	if m != nil {
+		return m.StringMax
+	}
+	return ""
+}
+
+// CompactionSegment is an output segment of the compactions generating several segments.
+type CompactionSegment struct {
+	SegmentID            int64          `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64          `protobuf:"varint,2,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog `protobuf:"bytes,3,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog `protobuf:"bytes,4,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	Deltalogs            []*FieldBinlog `protobuf:"bytes,5,rep,name=deltalogs,proto3" json:"deltalogs,omitempty"`
+	ClusteringKeyRange   *FieldStats    `protobuf:"bytes,6,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CompactionSegment) Reset()         { *m = CompactionSegment{} }
+func (m *CompactionSegment) String() string { return proto.CompactTextString(m) }
+func (*CompactionSegment) ProtoMessage()    {}
+func (*CompactionSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{77}
+}
+
+func (m *CompactionSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionSegment.Unmarshal(m, b)
+}
+func (m *CompactionSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionSegment.Marshal(b, m, deterministic)
+}
+func (m *CompactionSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionSegment.Merge(m, src)
+}
+func (m *CompactionSegment) XXX_Size() int {
+	return xxx_messageInfo_CompactionSegment.Size(m)
+}
+func (m *CompactionSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionSegment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionSegment proto.InternalMessageInfo
+
+func (m *CompactionSegment) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *CompactionSegment) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *CompactionSegment) GetInsertLogs() []*FieldBinlog {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetDeltalogs() []*FieldBinlog {
+	if m != nil {
+		return m.Deltalogs
+	}
+	return nil
+}
+
+func (m *CompactionSegment) GetClusteringKeyRange() *FieldStats {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
+type ManualCompactionWithScopeRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// compact all the partitions if empty
+	PartitionIDs []int64 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	// compact all the segments of the partitions if empty
+	SegmentIDs []int64              `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	Mode       ManualCompactionMode `protobuf:"varint,5,opt,name=mode,proto3,enum=milvus.proto.data.ManualCompactionMode" json:"mode,omitempty"`
+	// max size of the output segments in MB, dataCoord.segment.maxSize is used if not set
+	MaxSegmentSize int64 `protobuf:"varint,6,opt,name=max_segment_size,json=maxSegmentSize,proto3" json:"max_segment_size,omitempty"`
+	// the deleted rows and old versions after timetravel are kept, the retention duration applies if not set
+	Timetravel           uint64   `protobuf:"varint,7,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManualCompactionWithScopeRequest) Reset()         { *m = ManualCompactionWithScopeRequest{} }
+func (m *ManualCompactionWithScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionWithScopeRequest) ProtoMessage()    {}
+func (*ManualCompactionWithScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{78}
+}
+
+func (m *ManualCompactionWithScopeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionWithScopeRequest.Unmarshal(m, b)
+}
+func (m *ManualCompactionWithScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionWithScopeRequest.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionWithScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionWithScopeRequest.Merge(m, src)
+}
+func (m *ManualCompactionWithScopeRequest) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionWithScopeRequest.Size(m)
+}
+func (m *ManualCompactionWithScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionWithScopeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionWithScopeRequest proto.InternalMessageInfo
+
+func (m *ManualCompactionWithScopeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ManualCompactionWithScopeRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ManualCompactionWithScopeRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ManualCompactionWithScopeRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ManualCompactionWithScopeRequest) GetMode() ManualCompactionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return ManualCompactionMode_DefaultCompaction
+}
+
+func (m *ManualCompactionWithScopeRequest) GetMaxSegmentSize() int64 {
+	if m != nil {
+		return m.MaxSegmentSize
+	}
+	return 0
+}
+
+func (m *ManualCompactionWithScopeRequest) GetTimetravel() uint64 {
+	if m != nil {
+		return m.Timetravel
+	}
+	return 0
+}
+
+type CancelCompactionRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CompactionID int64             `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	// cancel all the plans of the compaction if not set
+	PlanID int64 `protobuf:"varint,3,opt,name=planID,proto3" json:"planID,omitempty"`
+	// the compaction must belong to the collection if set
+	CollectionID         int64    `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelCompactionRequest) Reset()         { *m = CancelCompactionRequest{} }
+func (m *CancelCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelCompactionRequest) ProtoMessage()    {}
+func (*CancelCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{79}
+}
+
+func (m *CancelCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelCompactionRequest.Unmarshal(m, b)
+}
+func (m *CancelCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCompactionRequest.Merge(m, src)
+}
+func (m *CancelCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelCompactionRequest.Size(m)
+}
+func (m *CancelCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCompactionRequest proto.InternalMessageInfo
+
+func (m *CancelCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelCompactionRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+func (m *CancelCompactionRequest) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+func (m *CancelCompactionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type ExportRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// export all the partitions if empty
+	PartitionIDs []int64 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	// the rows inserted and not deleted until the timestamp are exported, the current time is used if not set
+	Timestamp uint64       `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Format    ExportFormat `protobuf:"varint,5,opt,name=format,proto3,enum=milvus.proto.data.ExportFormat" json:"format,omitempty"`
+	// the files are written under the prefix of the object storage
+	TargetPrefix         string   `protobuf:"bytes,6,opt,name=target_prefix,json=targetPrefix,proto3" json:"target_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{80}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_ExportParquet
+}
+
+func (m *ExportRequest) GetTargetPrefix() string {
+	if m != nil {
+		return m.TargetPrefix
+	}
+	return ""
+}
+
+type ExportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	JobID                int64            `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{81}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+type GetExportStateRequest struct {
+	Base  *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	JobID int64             `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	// the job must belong to the collection if set
+	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{82}
+}
+
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
+}
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
+}
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
+}
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
+
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetExportStateRequest) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *GetExportStateRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetExportStateResponse struct {
+	Status       *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	JobID        int64            `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	State        ExportState      `protobuf:"varint,3,opt,name=state,proto3,enum=milvus.proto.data.ExportState" json:"state,omitempty"`
+	CollectionID int64            `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Timestamp    uint64           `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// percentage of the exported segments
+	Progress             int64    `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	RowCount             int64    `protobuf:"varint,7,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Files                []string `protobuf:"bytes,8,rep,name=files,proto3" json:"files,omitempty"`
+	Reason               string   `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExportStateResponse) Reset()         { *m = GetExportStateResponse{} }
+func (m *GetExportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateResponse) ProtoMessage()    {}
+func (*GetExportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{83}
+}
+
+func (m *GetExportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateResponse.Unmarshal(m, b)
+}
+func (m *GetExportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateResponse.Merge(m, src)
+}
+func (m *GetExportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateResponse.Size(m)
+}
+func (m *GetExportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateResponse proto.InternalMessageInfo
+
+func (m *GetExportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *GetExportStateResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetProgress() int64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *GetExportStateResponse) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *GetExportStateResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CancelExportRequest struct {
+	Base  *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	JobID int64             `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	// the job must belong to the collection if set
+	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelExportRequest) Reset()         { *m = CancelExportRequest{} }
+func (m *CancelExportRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelExportRequest) ProtoMessage()    {}
+func (*CancelExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{84}
+}
+
+func (m *CancelExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelExportRequest.Unmarshal(m, b)
+}
+func (m *CancelExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelExportRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelExportRequest.Merge(m, src)
+}
+func (m *CancelExportRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelExportRequest.Size(m)
+}
+func (m *CancelExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelExportRequest proto.InternalMessageInfo
+
+func (m *CancelExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelExportRequest) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *CancelExportRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type ExportTask struct {
+	Base                 *commonpb.MsgBase           `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	JobID                int64                       `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	TaskID               int64                       `protobuf:"varint,3,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	CollectionID         int64                       `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Schema               *schemapb.CollectionSchema  `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	Timestamp            uint64                      `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Format               ExportFormat                `protobuf:"varint,7,opt,name=format,proto3,enum=milvus.proto.data.ExportFormat" json:"format,omitempty"`
+	TargetPrefix         string                      `protobuf:"bytes,8,opt,name=target_prefix,json=targetPrefix,proto3" json:"target_prefix,omitempty"`
+	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,9,rep,name=segment_binlogs,json=segmentBinlogs,proto3" json:"segment_binlogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ExportTask) Reset()         { *m = ExportTask{} }
+func (m *ExportTask) String() string { return proto.CompactTextString(m) }
+func (*ExportTask) ProtoMessage()    {}
+func (*ExportTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{85}
+}
+
+func (m *ExportTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTask.Unmarshal(m, b)
+}
+func (m *ExportTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTask.Marshal(b, m, deterministic)
+}
+func (m *ExportTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTask.Merge(m, src)
+}
+func (m *ExportTask) XXX_Size() int {
+	return xxx_messageInfo_ExportTask.Size(m)
+}
+func (m *ExportTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTask proto.InternalMessageInfo
+
+func (m *ExportTask) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportTask) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *ExportTask) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ExportTask) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ExportTask) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *ExportTask) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportTask) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_ExportParquet
+}
+
+func (m *ExportTask) GetTargetPrefix() string {
+	if m != nil {
+		return m.TargetPrefix
+	}
+	return ""
+}
+
+func (m *ExportTask) GetSegmentBinlogs() []*CompactionSegmentBinlogs {
+	if m != nil {
+		return m.SegmentBinlogs
+	}
+	return nil
+}
+
+type ExportTaskResult struct {
+	Base   *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	JobID  int64             `protobuf:"varint,2,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	TaskID int64             `protobuf:"varint,3,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	State  ExportState       `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.data.ExportState" json:"state,omitempty"`
+	// the segments exported so far
+	SegmentIDs           []int64  `protobuf:"varint,5,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	RowCount             int64    `protobuf:"varint,6,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Files                []string `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`
+	Reason               string   `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportTaskResult) Reset()         { *m = ExportTaskResult{} }
+func (m *ExportTaskResult) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskResult) ProtoMessage()    {}
+func (*ExportTaskResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{86}
+}
+
+func (m *ExportTaskResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskResult.Unmarshal(m, b)
+}
+func (m *ExportTaskResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskResult.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskResult.Merge(m, src)
+}
+func (m *ExportTaskResult) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskResult.Size(m)
+}
+func (m *ExportTaskResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskResult proto.InternalMessageInfo
+
+func (m *ExportTaskResult) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportTaskResult) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *ExportTaskResult) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ExportTaskResult) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *ExportTaskResult) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ExportTaskResult) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ExportTaskResult) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ExportTaskResult) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CompactionPlanInfo struct {
+	PlanID               int64               `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	State                CompactionPlanState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.data.CompactionPlanState" json:"state,omitempty"`
+	Sources              []int64             `protobuf:"varint,3,rep,packed,name=sources,proto3" json:"sources,omitempty"`
+	Targets              []int64             `protobuf:"varint,4,rep,packed,name=targets,proto3" json:"targets,omitempty"`
+	NodeID               int64               `protobuf:"varint,5,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CompactionPlanInfo) Reset()         { *m = CompactionPlanInfo{} }
+func (m *CompactionPlanInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactionPlanInfo) ProtoMessage()    {}
+func (*CompactionPlanInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{87}
+}
+
+func (m *CompactionPlanInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionPlanInfo.Unmarshal(m, b)
+}
+func (m *CompactionPlanInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionPlanInfo.Marshal(b, m, deterministic)
+}
+func (m *CompactionPlanInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionPlanInfo.Merge(m, src)
+}
+func (m *CompactionPlanInfo) XXX_Size() int {
+	return xxx_messageInfo_CompactionPlanInfo.Size(m)
+}
+func (m *CompactionPlanInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionPlanInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionPlanInfo proto.InternalMessageInfo
+
+func (m *CompactionPlanInfo) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+func (m *CompactionPlanInfo) GetState() CompactionPlanState {
+	if m != nil {
+		return m.State
+	}
+	return CompactionPlanState_CompactionPlanUnknown
+}
+
+func (m *CompactionPlanInfo) GetSources() []int64 {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *CompactionPlanInfo) GetTargets() []int64 {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *CompactionPlanInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+type GetCompactionPlanStatesRequest struct {
+	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CompactionID int64             `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	// the compaction must belong to the collection if set
+	CollectionID         int64    `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionPlanStatesRequest) Reset()         { *m = GetCompactionPlanStatesRequest{} }
+func (m *GetCompactionPlanStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPlanStatesRequest) ProtoMessage()    {}
+func (*GetCompactionPlanStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{88}
+}
+
+func (m *GetCompactionPlanStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPlanStatesRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionPlanStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPlanStatesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPlanStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPlanStatesRequest.Merge(m, src)
+}
+func (m *GetCompactionPlanStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPlanStatesRequest.Size(m)
+}
+func (m *GetCompactionPlanStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPlanStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPlanStatesRequest proto.InternalMessageInfo
+
+func (m *GetCompactionPlanStatesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCompactionPlanStatesRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+func (m *GetCompactionPlanStatesRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+type GetCompactionPlanStatesResponse struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Plans                []*CompactionPlanInfo `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCompactionPlanStatesResponse) Reset()         { *m = GetCompactionPlanStatesResponse{} }
+func (m *GetCompactionPlanStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPlanStatesResponse) ProtoMessage()    {}
+func (*GetCompactionPlanStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{89}
+}
+
+func (m *GetCompactionPlanStatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPlanStatesResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionPlanStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPlanStatesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPlanStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPlanStatesResponse.Merge(m, src)
+}
+func (m *GetCompactionPlanStatesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPlanStatesResponse.Size(m)
+}
+func (m *GetCompactionPlanStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPlanStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPlanStatesResponse proto.InternalMessageInfo
+
+func (m *GetCompactionPlanStatesResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionPlanStatesResponse) GetPlans() []*CompactionPlanInfo {
+	if m != nil {
+		return m.Plans
+	}
+	return nil
+}
+
+// the export task persisted by DataCoord
+type ExportTaskInfo struct {
+	TaskID     int64       `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	SegmentIDs []int64     `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	NodeID     int64       `protobuf:"varint,3,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	State      ExportState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.data.ExportState" json:"state,omitempty"`
+	Attempts   int32       `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// the latest result reported by the datanode executing the task
+	Result               *ExportTaskResult `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportTaskInfo) Reset()         { *m = ExportTaskInfo{} }
+func (m *ExportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ExportTaskInfo) ProtoMessage()    {}
+func (*ExportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{90}
+}
+
+func (m *ExportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportTaskInfo.Unmarshal(m, b)
+}
+func (m *ExportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ExportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTaskInfo.Merge(m, src)
+}
+func (m *ExportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ExportTaskInfo.Size(m)
+}
+func (m *ExportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTaskInfo proto.InternalMessageInfo
+
+func (m *ExportTaskInfo) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ExportTaskInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *ExportTaskInfo) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ExportTaskInfo) GetResult() *ExportTaskResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+// the export job persisted by DataCoord
+type ExportJobInfo struct {
+	JobID        int64                      `protobuf:"varint,1,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	CollectionID int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs []int64                    `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	Timestamp    uint64                     `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Format       ExportFormat               `protobuf:"varint,5,opt,name=format,proto3,enum=milvus.proto.data.ExportFormat" json:"format,omitempty"`
+	TargetPrefix string                     `protobuf:"bytes,6,opt,name=target_prefix,json=targetPrefix,proto3" json:"target_prefix,omitempty"`
+	Schema       *schemapb.CollectionSchema `protobuf:"bytes,7,opt,name=schema,proto3" json:"schema,omitempty"`
+	// the vchannels whose checkpoints must pass the timestamp before the segments are split into tasks
+	Channels []string    `protobuf:"bytes,8,rep,name=channels,proto3" json:"channels,omitempty"`
+	State    ExportState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.data.ExportState" json:"state,omitempty"`
+	Reason   string      `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	// the segments are split into tasks once the rows until the timestamp are flushed
+	Planned    bool              `protobuf:"varint,11,opt,name=planned,proto3" json:"planned,omitempty"`
+	SegmentNum int64             `protobuf:"varint,12,opt,name=segment_num,json=segmentNum,proto3" json:"segment_num,omitempty"`
+	Tasks      []*ExportTaskInfo `protobuf:"bytes,13,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// unix time in milliseconds when the job finished
+	EndTime              int64    `protobuf:"varint,14,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportJobInfo) Reset()         { *m = ExportJobInfo{} }
+func (m *ExportJobInfo) String() string { return proto.CompactTextString(m) }
+func (*ExportJobInfo) ProtoMessage()    {}
+func (*ExportJobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{91}
+}
+
+func (m *ExportJobInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportJobInfo.Unmarshal(m, b)
+}
+func (m *ExportJobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportJobInfo.Marshal(b, m, deterministic)
+}
+func (m *ExportJobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportJobInfo.Merge(m, src)
+}
+func (m *ExportJobInfo) XXX_Size() int {
+	return xxx_messageInfo_ExportJobInfo.Size(m)
+}
+func (m *ExportJobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportJobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportJobInfo proto.InternalMessageInfo
+
+func (m *ExportJobInfo) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+func (m *ExportJobInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ExportJobInfo) GetPartitionIDs() []int64 {
+	if m != nil {
+		return m.PartitionIDs
+	}
+	return nil
+}
+
+func (m *ExportJobInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportJobInfo) GetFormat() ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ExportFormat_ExportParquet
+}
+
+func (m *ExportJobInfo) GetTargetPrefix() string {
+	if m != nil {
+		return m.TargetPrefix
+	}
+	return ""
+}
+
+func (m *ExportJobInfo) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *ExportJobInfo) GetChannels() []string {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *ExportJobInfo) GetState() ExportState {
+	if m != nil {
+		return m.State
+	}
+	return ExportState_ExportPending
+}
+
+func (m *ExportJobInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ExportJobInfo) GetPlanned() bool {
+	if m != nil {
+		return m.Planned
+	}
+	return false
+}
+
+func (m *ExportJobInfo) GetSegmentNum() int64 {
+	if m != nil {
+		return m.SegmentNum
+	}
+	return 0
+}
+
+func (m *ExportJobInfo) GetTasks() []*ExportTaskInfo {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *ExportJobInfo) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// a part of an import task executed by one DataNode, the first sub-task shares the ID of its task
+type ImportSubTaskInfo struct {
+	Id    int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Files []string `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	// the sub-task imports the chunk_index-th of the chunk_count parts of the rows in its files
+	ChunkIndex int64                `protobuf:"varint,3,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	ChunkCount int64                `protobuf:"varint,4,opt,name=chunk_count,json=chunkCount,proto3" json:"chunk_count,omitempty"`
+	State      commonpb.ImportState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	DatanodeId int64                `protobuf:"varint,6,opt,name=datanode_id,json=datanodeId,proto3" json:"datanode_id,omitempty"`
+	// how many times the sub-task is sent out
+	Attempts int64 `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// the DataNodes which failed the sub-task
+	FailedNodes          []int64  `protobuf:"varint,8,rep,packed,name=failed_nodes,json=failedNodes,proto3" json:"failed_nodes,omitempty"`
+	RowCount             int64    `protobuf:"varint,9,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Segments             []int64  `protobuf:"varint,10,rep,packed,name=segments,proto3" json:"segments,omitempty"`
+	AutoIds              []int64  `protobuf:"varint,11,rep,packed,name=auto_ids,json=autoIds,proto3" json:"auto_ids,omitempty"`
+	ProcessedBytes       int64    `protobuf:"varint,12,opt,name=processed_bytes,json=processedBytes,proto3" json:"processed_bytes,omitempty"`
+	TotalBytes           int64    `protobuf:"varint,13,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	ErrorMessage         string   `protobuf:"bytes,14,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportSubTaskInfo) Reset()         { *m = ImportSubTaskInfo{} }
+func (m *ImportSubTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ImportSubTaskInfo) ProtoMessage()    {}
+func (*ImportSubTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{92}
+}
+
+func (m *ImportSubTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportSubTaskInfo.Unmarshal(m, b)
+}
+func (m *ImportSubTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportSubTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ImportSubTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportSubTaskInfo.Merge(m, src)
+}
+func (m *ImportSubTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ImportSubTaskInfo.Size(m)
+}
+func (m *ImportSubTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportSubTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportSubTaskInfo proto.InternalMessageInfo
+
+func (m *ImportSubTaskInfo) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ImportSubTaskInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ImportSubTaskInfo) GetChunkIndex() int64 {
+	if m != nil {
+		return m.ChunkIndex
+	}
+	return 0
+}
+
+func (m *ImportSubTaskInfo) GetChunkCount() int64 {
+	if m != nil {
+		return m.ChunkCount
+	}
+	return 0
+}
+
+func (m *ImportSubTaskInfo) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportSubTaskInfo) GetDatanodeId() int64 {
+	if m != nil {
+		return m.DatanodeId
+	}
+	return 0
+}
+
+func (m *ImportSubTaskInfo) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *ImportSubTaskInfo) GetFailedNodes() []int64 {
+	if m != nil {
+		return m.FailedNodes
+	}
+	return nil
+}
+
+func (m *ImportSubTaskInfo) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
+}
+
+func (m *ImportSubTaskInfo) GetSegments() []int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportSubTaskInfo) GetAutoIds() []int64 {
+	if m != nil {
+		return m.AutoIds
+	}
+	return nil
+}
+
+func (m *ImportSubTaskInfo) GetProcessedBytes() int64 {
+	if m != nil {
+		return m.ProcessedBytes
+	}
+	return 0
+}
+
+func (m *ImportSubTaskInfo) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+func (m *ImportSubTaskInfo) GetErrorMessage() string {
+	if m != nil {
+		return m.ErrorMessage
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.SegmentType", SegmentType_name, SegmentType_value)
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterEnum("milvus.proto.data.ManualCompactionMode", ManualCompactionMode_name, ManualCompactionMode_value)
+	proto.RegisterEnum("milvus.proto.data.ExportFormat", ExportFormat_name, ExportFormat_value)
+	proto.RegisterEnum("milvus.proto.data.ExportState", ExportState_name, ExportState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionPlanState", CompactionPlanState_name, CompactionPlanState_value)
+	proto.RegisterType((*Empty)(nil), "milvus.proto.data.Empty")
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*AcquireSegmentLockRequest)(nil), "milvus.proto.data.AcquireSegmentLockRequest")
+	proto.RegisterType((*ReleaseSegmentLockRequest)(nil), "milvus.proto.data.ReleaseSegmentLockRequest")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
+	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.data.SegmentStats")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetSegmentsByStatesRequest)(nil), "milvus.proto.data.GetSegmentsByStatesRequest")
+	proto.RegisterType((*GetSegmentsByStatesResponse)(nil), "milvus.proto.data.GetSegmentsByStatesResponse")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*CompactionStateRequest)(nil), "milvus.proto.data.CompactionStateRequest")
+	proto.RegisterType((*SyncSegmentsRequest)(nil), "milvus.proto.data.SyncSegmentsRequest")
+	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
+	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*CompactionStateResult)(nil), "milvus.proto.data.CompactionStateResult")
+	proto.RegisterType((*CompactionStateResponse)(nil), "milvus.proto.data.CompactionStateResponse")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
+	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
+	proto.RegisterType((*SetSegmentStateRequest)(nil), "milvus.proto.data.SetSegmentStateRequest")
+	proto.RegisterType((*SetSegmentStateResponse)(nil), "milvus.proto.data.SetSegmentStateResponse")
+	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
+	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
+	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
+	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
+	proto.RegisterType((*ImportTaskState)(nil), "milvus.proto.data.ImportTaskState")
+	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
+	proto.RegisterType((*ImportTaskResponse)(nil), "milvus.proto.data.ImportTaskResponse")
+	proto.RegisterType((*ImportTaskRequest)(nil), "milvus.proto.data.ImportTaskRequest")
+	proto.RegisterType((*UpdateSegmentStatisticsRequest)(nil), "milvus.proto.data.UpdateSegmentStatisticsRequest")
+	proto.RegisterType((*UpdateChannelCheckpointRequest)(nil), "milvus.proto.data.UpdateChannelCheckpointRequest")
+	proto.RegisterType((*ResendSegmentStatsRequest)(nil), "milvus.proto.data.ResendSegmentStatsRequest")
+	proto.RegisterType((*ResendSegmentStatsResponse)(nil), "milvus.proto.data.ResendSegmentStatsResponse")
+	proto.RegisterType((*AddImportSegmentRequest)(nil), "milvus.proto.data.AddImportSegmentRequest")
+	proto.RegisterType((*AddImportSegmentResponse)(nil), "milvus.proto.data.AddImportSegmentResponse")
+	proto.RegisterType((*SaveImportSegmentRequest)(nil), "milvus.proto.data.SaveImportSegmentRequest")
+	proto.RegisterType((*UnsetIsImportingStateRequest)(nil), "milvus.proto.data.UnsetIsImportingStateRequest")
+	proto.RegisterType((*MarkSegmentsDroppedRequest)(nil), "milvus.proto.data.MarkSegmentsDroppedRequest")
+	proto.RegisterType((*SegmentReferenceLock)(nil), "milvus.proto.data.SegmentReferenceLock")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
+	proto.RegisterType((*FieldStats)(nil), "milvus.proto.data.FieldStats")
+	proto.RegisterType((*CompactionSegment)(nil), "milvus.proto.data.CompactionSegment")
+	proto.RegisterType((*ManualCompactionWithScopeRequest)(nil), "milvus.proto.data.ManualCompactionWithScopeRequest")
+	proto.RegisterType((*CancelCompactionRequest)(nil), "milvus.proto.data.CancelCompactionRequest")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.data.ExportRequest")
+	proto.RegisterType((*ExportResponse)(nil), "milvus.proto.data.ExportResponse")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.data.GetExportStateRequest")
+	proto.RegisterType((*GetExportStateResponse)(nil), "milvus.proto.data.GetExportStateResponse")
+	proto.RegisterType((*CancelExportRequest)(nil), "milvus.proto.data.CancelExportRequest")
+	proto.RegisterType((*ExportTask)(nil), "milvus.proto.data.ExportTask")
+	proto.RegisterType((*ExportTaskResult)(nil), "milvus.proto.data.ExportTaskResult")
+	proto.RegisterType((*CompactionPlanInfo)(nil), "milvus.proto.data.CompactionPlanInfo")
+	proto.RegisterType((*GetCompactionPlanStatesRequest)(nil), "milvus.proto.data.GetCompactionPlanStatesRequest")
+	proto.RegisterType((*GetCompactionPlanStatesResponse)(nil), "milvus.proto.data.GetCompactionPlanStatesResponse")
+	proto.RegisterType((*ExportTaskInfo)(nil), "milvus.proto.data.ExportTaskInfo")
+	proto.RegisterType((*ExportJobInfo)(nil), "milvus.proto.data.ExportJobInfo")
+	proto.RegisterType((*ImportSubTaskInfo)(nil), "milvus.proto.data.ImportSubTaskInfo")
+}
+
+func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+
+var fileDescriptor_82cd95f524594f49 = []byte{
+	// 5780 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0xdb, 0x8f, 0x1c, 0x57,
+	0x5a, 0xb8, 0xab, 0xef, 0xfd, 0x75, 0x4f, 0x4f, 0xcf, 0xf1, 0x78, 0xdc, 0x6e, 0x5f, 0x62, 0x57,
+	0xe2, 0x4b, 0x26, 0x89, 0x9d, 0x38, 0xbf, 0xfd, 0x25, 0xac, 0x93, 0x2c, 0x1e, 0x8f, 0xc7, 0x99,
+	0x5d, 0x8f, 0x3d, 0x5b, 0x33, 0x4e, 0xd0, 0x06, 0xd4, 0xaa, 0xe9, 0x3a, 0xdd, 0x53, 0x99, 0xee,
+	0xaa, 0x76, 0x55, 0xb5, 0x3d, 0xb3, 0x3c, 0x6c, 0x96, 0x15, 0x2b, 0x81, 0x10, 0x41, 0xac, 0x56,
+	0x5a, 0x90, 0x90, 0x58, 0x9e, 0x16, 0x56, 0x8b, 0x90, 0x90, 0x78, 0x60, 0x1f, 0x90, 0x40, 0x42,
+	0x08, 0x1e, 0x10, 0x12, 0xe2, 0x61, 0xff, 0x00, 0xe0, 0x8d, 0x07, 0xc4, 0x1b, 0x0f, 0xe8, 0x5c,
+	0xea, 0xd4, 0xa9, 0x5b, 0x77, 0x4d, 0xb7, 0x1d, 0x73, 0x79, 0xeb, 0xf3, 0xd5, 0x77, 0xee, 0xdf,
+	0xfd, 0xfb, 0xaa, 0x1a, 0x9a, 0x86, 0xee, 0xe9, 0x9d, 0xae, 0x6d, 0x3b, 0xc6, 0xf5, 0x91, 0x63,
+	0x7b, 0x36, 0x5a, 0x1a, 0x9a, 0x83, 0x27, 0x63, 0x97, 0xb5, 0xae, 0x93, 0xc7, 0xed, 0x7a, 0xd7,
+	0x1e, 0x0e, 0x6d, 0x8b, 0x81, 0xda, 0x0d, 0xd3, 0xf2, 0xb0, 0x63, 0xe9, 0x03, 0xde, 0xae, 0xcb,
+	0x1d, 0xda, 0x75, 0xb7, 0xbb, 0x8f, 0x87, 0x3a, 0x6b, 0xa9, 0x65, 0x28, 0xde, 0x1d, 0x8e, 0xbc,
+	0x23, 0xf5, 0x07, 0x0a, 0xd4, 0x37, 0x06, 0x63, 0x77, 0x5f, 0xc3, 0x8f, 0xc7, 0xd8, 0xf5, 0xd0,
+	0x9b, 0x50, 0xd8, 0xd3, 0x5d, 0xdc, 0x52, 0x2e, 0x2a, 0xd7, 0x6a, 0x37, 0xcf, 0x5d, 0x0f, 0xcd,
+	0xca, 0xe7, 0xdb, 0x72, 0xfb, 0x6b, 0xba, 0x8b, 0x35, 0x8a, 0x89, 0x10, 0x14, 0x8c, 0xbd, 0xcd,
+	0xf5, 0x56, 0xee, 0xa2, 0x72, 0x2d, 0xaf, 0xd1, 0xdf, 0xe8, 0x02, 0x80, 0x8b, 0xfb, 0x43, 0x6c,
+	0x79, 0x9b, 0xeb, 0x6e, 0x2b, 0x7f, 0x31, 0x7f, 0x2d, 0xaf, 0x49, 0x10, 0xa4, 0x42, 0xbd, 0x6b,
+	0x0f, 0x06, 0xb8, 0xeb, 0x99, 0xb6, 0xb5, 0xb9, 0xde, 0x2a, 0xd0, 0xbe, 0x21, 0x98, 0xfa, 0xcf,
+	0x0a, 0x2c, 0xf0, 0xa5, 0xb9, 0x23, 0xdb, 0x72, 0x31, 0x7a, 0x1b, 0x4a, 0xae, 0xa7, 0x7b, 0x63,
+	0x97, 0xaf, 0xee, 0x6c, 0xe2, 0xea, 0x76, 0x28, 0x8a, 0xc6, 0x51, 0x13, 0x97, 0x17, 0x9d, 0x3e,
+	0x1f, 0x9f, 0x3e, 0xb2, 0x85, 0x42, 0x6c, 0x0b, 0xd7, 0x60, 0xb1, 0x47, 0x56, 0xb7, 0x13, 0x20,
+	0x15, 0x29, 0x52, 0x14, 0x4c, 0x46, 0xf2, 0xcc, 0x21, 0x7e, 0xd8, 0xdb, 0xc1, 0xfa, 0xa0, 0x55,
+	0xa2, 0x73, 0x49, 0x10, 0xf5, 0x1f, 0x14, 0x68, 0x0a, 0x74, 0xff, 0x1e, 0x96, 0xa1, 0xd8, 0xb5,
+	0xc7, 0x96, 0x47, 0xb7, 0xba, 0xa0, 0xb1, 0x06, 0xba, 0x04, 0xf5, 0xee, 0xbe, 0x6e, 0x59, 0x78,
+	0xd0, 0xb1, 0xf4, 0x21, 0xa6, 0x9b, 0xaa, 0x6a, 0x35, 0x0e, 0x7b, 0xa0, 0x0f, 0x71, 0xa6, 0xbd,
+	0x5d, 0x84, 0xda, 0x48, 0x77, 0x3c, 0x33, 0x74, 0xfa, 0x32, 0x08, 0xb5, 0xa1, 0x62, 0xba, 0x9b,
+	0xc3, 0x91, 0xed, 0x78, 0xad, 0xe2, 0x45, 0xe5, 0x5a, 0x45, 0x13, 0x6d, 0x32, 0x83, 0x49, 0x7f,
+	0xed, 0xea, 0xee, 0xc1, 0xe6, 0x3a, 0xdf, 0x51, 0x08, 0xa6, 0xfe, 0xbe, 0x02, 0x2b, 0xb7, 0x5d,
+	0xd7, 0xec, 0x5b, 0xb1, 0x9d, 0xad, 0x40, 0xc9, 0xb2, 0x0d, 0xbc, 0xb9, 0x4e, 0xb7, 0x96, 0xd7,
+	0x78, 0x0b, 0x9d, 0x85, 0xea, 0x08, 0x63, 0xa7, 0xe3, 0xd8, 0x03, 0x7f, 0x63, 0x15, 0x02, 0xd0,
+	0xec, 0x01, 0x46, 0x5f, 0x87, 0x25, 0x37, 0x32, 0x10, 0xa3, 0xab, 0xda, 0xcd, 0x97, 0xaf, 0xc7,
+	0x38, 0xe3, 0x7a, 0x74, 0x52, 0x2d, 0xde, 0x5b, 0xfd, 0x2c, 0x07, 0x27, 0x05, 0x1e, 0x5b, 0x2b,
+	0xf9, 0x4d, 0x4e, 0xde, 0xc5, 0x7d, 0xb1, 0x3c, 0xd6, 0xc8, 0x72, 0xf2, 0xe2, 0xca, 0xf2, 0xf2,
+	0x95, 0x65, 0x20, 0xf5, 0xe8, 0x7d, 0x14, 0xe3, 0xf7, 0xf1, 0x12, 0xd4, 0xf0, 0xe1, 0xc8, 0x74,
+	0x70, 0x87, 0x10, 0x0e, 0x3d, 0xf2, 0x82, 0x06, 0x0c, 0xb4, 0x6b, 0x0e, 0x65, 0xde, 0x28, 0x67,
+	0xe6, 0x0d, 0xf5, 0x0f, 0x14, 0x38, 0x1d, 0xbb, 0x25, 0xce, 0x6c, 0x1a, 0x34, 0xe9, 0xce, 0x83,
+	0x93, 0x21, 0x6c, 0x47, 0x0e, 0xfc, 0xca, 0xa4, 0x03, 0x0f, 0xd0, 0xb5, 0x58, 0x7f, 0x69, 0x91,
+	0xb9, 0xec, 0x8b, 0x3c, 0x80, 0xd3, 0xf7, 0xb0, 0xc7, 0x27, 0x20, 0xcf, 0xb0, 0x3b, 0xbb, 0xb0,
+	0x0a, 0x73, 0x75, 0x2e, 0xca, 0xd5, 0xea, 0x9f, 0xe4, 0xa0, 0x29, 0x4f, 0xb5, 0x69, 0xf5, 0x6c,
+	0x74, 0x0e, 0xaa, 0x02, 0x85, 0x53, 0x45, 0x00, 0x40, 0xef, 0x40, 0x91, 0xac, 0x94, 0x91, 0x44,
+	0xe3, 0xe6, 0xa5, 0xe4, 0x3d, 0x49, 0x63, 0x6a, 0x0c, 0x1f, 0x6d, 0x42, 0xc3, 0xf5, 0x74, 0xc7,
+	0xeb, 0x8c, 0x6c, 0x97, 0xde, 0x33, 0x25, 0x9c, 0xda, 0x4d, 0x35, 0x3c, 0x82, 0x10, 0xeb, 0x5b,
+	0x6e, 0x7f, 0x9b, 0x63, 0x6a, 0x0b, 0xb4, 0xa7, 0xdf, 0x44, 0x77, 0xa1, 0x8e, 0x2d, 0x23, 0x18,
+	0xa8, 0x90, 0x79, 0xa0, 0x1a, 0xb6, 0x0c, 0x31, 0x4c, 0x70, 0x3f, 0xc5, 0xec, 0xf7, 0xf3, 0x1b,
+	0x0a, 0xb4, 0xe2, 0x17, 0x34, 0x8f, 0xc8, 0xbe, 0xc5, 0x3a, 0x61, 0x76, 0x41, 0x13, 0x39, 0x5c,
+	0x5c, 0x92, 0xc6, 0xbb, 0xa8, 0xdf, 0x57, 0xe0, 0x54, 0xb0, 0x1c, 0xfa, 0xe8, 0x79, 0x51, 0x0b,
+	0x5a, 0x85, 0xa6, 0x69, 0x75, 0x07, 0x63, 0x03, 0x3f, 0xb2, 0x3e, 0xc4, 0xfa, 0xc0, 0xdb, 0x3f,
+	0xa2, 0x77, 0x58, 0xd1, 0x62, 0x70, 0xf5, 0x3b, 0x0a, 0xac, 0x44, 0xd7, 0x35, 0xcf, 0x21, 0xfd,
+	0x3f, 0x28, 0x9a, 0x56, 0xcf, 0xf6, 0xcf, 0xe8, 0xc2, 0x04, 0xa6, 0x24, 0x73, 0x31, 0x64, 0x75,
+	0x08, 0x67, 0xef, 0x61, 0x6f, 0xd3, 0x72, 0xb1, 0xe3, 0xad, 0x99, 0xd6, 0xc0, 0xee, 0x6f, 0xeb,
+	0xde, 0xfe, 0x1c, 0x0c, 0x15, 0xe2, 0x8d, 0x5c, 0x84, 0x37, 0xd4, 0x1f, 0x29, 0x70, 0x2e, 0x79,
+	0x3e, 0xbe, 0xf5, 0x36, 0x54, 0x7a, 0x26, 0x1e, 0x18, 0x9b, 0xeb, 0x4c, 0xba, 0xe4, 0x35, 0xd1,
+	0x26, 0x8c, 0x35, 0x22, 0xc8, 0x7c, 0x87, 0x97, 0x52, 0xa8, 0x79, 0xc7, 0x73, 0x4c, 0xab, 0x7f,
+	0xdf, 0x74, 0x3d, 0x8d, 0xe1, 0x4b, 0xe7, 0x99, 0xcf, 0x4e, 0xc6, 0xbf, 0xae, 0xc0, 0x85, 0x7b,
+	0xd8, 0xbb, 0x23, 0xe4, 0x32, 0x79, 0x6e, 0xba, 0x9e, 0xd9, 0x75, 0x9f, 0xad, 0x6d, 0x94, 0x41,
+	0x41, 0xab, 0x9f, 0x2b, 0xf0, 0x52, 0xea, 0x62, 0xf8, 0xd1, 0x71, 0xb9, 0xe3, 0x4b, 0xe5, 0x64,
+	0xb9, 0xf3, 0x35, 0x7c, 0xf4, 0x91, 0x3e, 0x18, 0xe3, 0x6d, 0xdd, 0x74, 0x98, 0xdc, 0x99, 0x51,
+	0x0a, 0xff, 0x44, 0x81, 0xf3, 0xf7, 0xb0, 0xb7, 0xed, 0xeb, 0xa4, 0x17, 0x78, 0x3a, 0x04, 0x47,
+	0xd2, 0x8d, 0xbe, 0x71, 0x16, 0x82, 0xa9, 0xbf, 0xc9, 0xae, 0x33, 0x71, 0xbd, 0x2f, 0xe4, 0x00,
+	0x2f, 0x50, 0x4e, 0x90, 0x58, 0xf2, 0x0e, 0x33, 0x1d, 0xf8, 0xf1, 0xa9, 0xbf, 0xa7, 0xc0, 0x99,
+	0xdb, 0xdd, 0xc7, 0x63, 0xd3, 0xc1, 0x1c, 0xe9, 0xbe, 0xdd, 0x3d, 0x98, 0xfd, 0x70, 0x03, 0x33,
+	0x2b, 0x17, 0x32, 0xb3, 0xa6, 0x99, 0xe6, 0x2b, 0x50, 0xf2, 0x98, 0x5d, 0xc7, 0x2c, 0x15, 0xde,
+	0xa2, 0xeb, 0xd3, 0xf0, 0x00, 0xeb, 0xee, 0x7f, 0xcf, 0xf5, 0x7d, 0x5e, 0x80, 0xfa, 0x47, 0xdc,
+	0x1c, 0xa3, 0x5a, 0x3b, 0x4a, 0x49, 0x4a, 0xb2, 0xe1, 0x25, 0x59, 0x70, 0x49, 0x46, 0xdd, 0x3d,
+	0x58, 0x70, 0x31, 0x3e, 0x98, 0x45, 0x47, 0xd7, 0x49, 0x47, 0xbf, 0x85, 0xee, 0xc3, 0xd2, 0xd8,
+	0xa2, 0xae, 0x01, 0x36, 0xf8, 0x01, 0x32, 0xca, 0x9d, 0x2e, 0xbb, 0xe3, 0x1d, 0xd1, 0x87, 0xb0,
+	0x18, 0x01, 0xb5, 0x8a, 0x99, 0xc6, 0x8a, 0x76, 0x43, 0x9b, 0xd0, 0x34, 0x1c, 0x7b, 0x34, 0xc2,
+	0x46, 0xc7, 0xf5, 0x87, 0x2a, 0x65, 0x1b, 0x8a, 0xf7, 0x13, 0x43, 0xbd, 0x09, 0x27, 0xa3, 0x2b,
+	0xdd, 0x34, 0x88, 0x41, 0x4a, 0xee, 0x30, 0xe9, 0x11, 0x7a, 0x1d, 0x96, 0xe2, 0xf8, 0x15, 0x8a,
+	0x1f, 0x7f, 0x80, 0xde, 0x00, 0x14, 0x59, 0x2a, 0x41, 0xaf, 0x32, 0xf4, 0xf0, 0x62, 0x36, 0x0d,
+	0x57, 0xfd, 0x35, 0x05, 0x56, 0x3e, 0xd6, 0xbd, 0xee, 0xfe, 0xfa, 0x90, 0xf3, 0xda, 0x1c, 0xb2,
+	0xea, 0x7d, 0xa8, 0x3e, 0xe1, 0x74, 0xe1, 0x2b, 0xa4, 0x97, 0x12, 0xce, 0x47, 0xa6, 0x40, 0x2d,
+	0xe8, 0x41, 0xfc, 0xa1, 0xe5, 0x0d, 0xc9, 0x2f, 0x7c, 0x01, 0x52, 0x73, 0x8a, 0x43, 0xab, 0x1e,
+	0x02, 0xf0, 0xc5, 0x6d, 0xb9, 0xfd, 0x19, 0xd6, 0xf5, 0x2e, 0x94, 0xf9, 0x68, 0x5c, 0x2c, 0x4e,
+	0xa3, 0x1f, 0x1f, 0x5d, 0xfd, 0x69, 0x19, 0x6a, 0xd2, 0x03, 0xd4, 0x80, 0x9c, 0xe0, 0xd7, 0x5c,
+	0xc2, 0xee, 0x72, 0xd3, 0x5d, 0xa8, 0x7c, 0xdc, 0x85, 0xba, 0x0c, 0x0d, 0x93, 0xda, 0x21, 0x1d,
+	0x7e, 0x2b, 0x54, 0x80, 0x54, 0xb5, 0x05, 0x06, 0xe5, 0x24, 0x82, 0x2e, 0x40, 0xcd, 0x1a, 0x0f,
+	0x3b, 0x76, 0xaf, 0xe3, 0xd8, 0x4f, 0x5d, 0xee, 0x8b, 0x55, 0xad, 0xf1, 0xf0, 0x61, 0x4f, 0xb3,
+	0x9f, 0xba, 0x81, 0xb9, 0x5f, 0x3a, 0xa6, 0xb9, 0x7f, 0x01, 0x6a, 0x43, 0xfd, 0x90, 0x8c, 0xda,
+	0xb1, 0xc6, 0x43, 0xea, 0xa6, 0xe5, 0xb5, 0xea, 0x50, 0x3f, 0xd4, 0xec, 0xa7, 0x0f, 0xc6, 0x43,
+	0x74, 0x0d, 0x9a, 0x03, 0xdd, 0xf5, 0x3a, 0xb2, 0x9f, 0x57, 0xa1, 0x7e, 0x5e, 0x83, 0xc0, 0xef,
+	0x06, 0xbe, 0x5e, 0xdc, 0x71, 0xa8, 0xce, 0xe1, 0x38, 0x18, 0xc3, 0x41, 0x30, 0x10, 0x64, 0x77,
+	0x1c, 0x8c, 0xe1, 0x40, 0x0c, 0xf3, 0x2e, 0x94, 0xf7, 0xa8, 0x75, 0xe7, 0xb6, 0x6a, 0xa9, 0xb2,
+	0x63, 0x83, 0x18, 0x76, 0xcc, 0x08, 0xd4, 0x7c, 0x74, 0xf4, 0x1e, 0x54, 0xa9, 0x52, 0xa5, 0x7d,
+	0xeb, 0x99, 0xfa, 0x06, 0x1d, 0x48, 0x6f, 0x03, 0x0f, 0x3c, 0x9d, 0xf6, 0x5e, 0xc8, 0xd6, 0x5b,
+	0x74, 0x20, 0xf2, 0xaa, 0xeb, 0x60, 0xdd, 0xc3, 0xc6, 0xda, 0xd1, 0x1d, 0x7b, 0x38, 0xd2, 0x29,
+	0x31, 0xb5, 0x1a, 0xd4, 0x82, 0x4f, 0x7a, 0x84, 0xae, 0x40, 0xa3, 0x2b, 0x5a, 0x1b, 0x8e, 0x3d,
+	0x6c, 0x2d, 0x52, 0x3e, 0x8a, 0x40, 0xd1, 0x79, 0x00, 0x5f, 0x52, 0xe9, 0x5e, 0xab, 0x49, 0x6f,
+	0xb1, 0xca, 0x21, 0xb7, 0x69, 0x18, 0xc7, 0x74, 0x3b, 0x2c, 0x60, 0x62, 0x5a, 0xfd, 0xd6, 0x12,
+	0x9d, 0xb1, 0xe6, 0x47, 0x58, 0x4c, 0xab, 0x8f, 0x4e, 0x43, 0xd9, 0x74, 0x3b, 0x3d, 0xfd, 0x00,
+	0xb7, 0x10, 0x7d, 0x5a, 0x32, 0xdd, 0x0d, 0xfd, 0x00, 0xa3, 0x0f, 0xa0, 0x46, 0x2d, 0xe4, 0x0e,
+	0xb3, 0x5d, 0x4e, 0xd2, 0x4d, 0x9f, 0x4f, 0xdb, 0x34, 0xa1, 0x40, 0x57, 0x83, 0x9e, 0xf8, 0x8d,
+	0x1e, 0xc2, 0x72, 0x77, 0x30, 0x76, 0x3d, 0x4c, 0xac, 0xe6, 0xce, 0x01, 0x3e, 0xea, 0x38, 0xba,
+	0xd5, 0xc7, 0xad, 0xe5, 0x8b, 0xca, 0xf4, 0x81, 0x50, 0xd0, 0xf5, 0x6b, 0xf8, 0x48, 0x23, 0x1d,
+	0xd5, 0x6f, 0xc1, 0x72, 0x40, 0xee, 0x12, 0x69, 0xc5, 0xa9, 0x54, 0x99, 0x95, 0x4a, 0x27, 0x3b,
+	0x19, 0x9f, 0x17, 0x61, 0x65, 0x47, 0x7f, 0x82, 0x9f, 0xbf, 0x3f, 0x93, 0x49, 0xce, 0xde, 0x87,
+	0x25, 0x7a, 0xdc, 0x37, 0xa5, 0xf5, 0x4c, 0x50, 0xf4, 0x32, 0x6d, 0xc6, 0x3b, 0xa2, 0xaf, 0x10,
+	0x0b, 0x05, 0x77, 0x0f, 0xb6, 0x6d, 0x33, 0x50, 0xf2, 0x49, 0xb7, 0x74, 0x47, 0x60, 0x69, 0x72,
+	0x0f, 0xb4, 0x0d, 0x8b, 0xe1, 0x6b, 0xf0, 0xd5, 0xfb, 0xd5, 0x89, 0x5e, 0x75, 0x70, 0xfa, 0x5a,
+	0x23, 0x74, 0x19, 0x2e, 0x6a, 0x41, 0x99, 0xeb, 0x66, 0x2a, 0xc4, 0x2a, 0x9a, 0xdf, 0x44, 0xdb,
+	0x70, 0x92, 0xed, 0x60, 0x87, 0x73, 0x28, 0xdb, 0x7c, 0x25, 0xd3, 0xe6, 0x93, 0xba, 0x86, 0x19,
+	0xbc, 0x7a, 0x5c, 0x06, 0x6f, 0x41, 0x99, 0x33, 0x1d, 0x15, 0x6c, 0x15, 0xcd, 0x6f, 0x92, 0x6b,
+	0x0e, 0xd8, 0xaf, 0x46, 0x9f, 0x05, 0x80, 0x28, 0x8f, 0xd5, 0x8f, 0xc9, 0x63, 0xc4, 0x97, 0x84,
+	0xe0, 0x3e, 0xa6, 0xc4, 0x8f, 0x3e, 0x80, 0x8a, 0xe0, 0x90, 0x5c, 0x66, 0x0e, 0x11, 0x7d, 0xa2,
+	0x0a, 0x2b, 0x1f, 0x51, 0x58, 0xea, 0xdf, 0x29, 0x50, 0x5f, 0x27, 0x47, 0x72, 0xdf, 0xee, 0x53,
+	0xf5, 0x7a, 0x19, 0x1a, 0x0e, 0xee, 0xda, 0x8e, 0xd1, 0xc1, 0x96, 0xe7, 0x98, 0x98, 0x85, 0x1d,
+	0x0a, 0xda, 0x02, 0x83, 0xde, 0x65, 0x40, 0x82, 0x46, 0x74, 0x90, 0xeb, 0xe9, 0xc3, 0x51, 0xa7,
+	0x47, 0x64, 0x5d, 0x8e, 0xa1, 0x09, 0x28, 0x15, 0x75, 0x97, 0xa0, 0x1e, 0xa0, 0x79, 0x36, 0x9d,
+	0xbf, 0xa0, 0xd5, 0x04, 0x6c, 0xd7, 0x46, 0xaf, 0x40, 0x83, 0xde, 0x49, 0x67, 0x60, 0xf7, 0x3b,
+	0xc4, 0x45, 0xe7, 0x9a, 0xb7, 0x6e, 0xf0, 0x65, 0x91, 0xbb, 0x0e, 0x63, 0xb9, 0xe6, 0x37, 0x31,
+	0xd7, 0xbd, 0x02, 0x6b, 0xc7, 0xfc, 0x26, 0x56, 0xff, 0x56, 0x81, 0x85, 0x75, 0xdd, 0xd3, 0x1f,
+	0xd8, 0x06, 0xde, 0x9d, 0xd1, 0x52, 0xc9, 0x10, 0xcb, 0x3d, 0x07, 0x55, 0xb1, 0x03, 0xbe, 0xa5,
+	0x00, 0x80, 0x36, 0xa0, 0xe1, 0xdb, 0xca, 0x9c, 0x44, 0x0a, 0xa9, 0x16, 0xa1, 0x64, 0x0a, 0xb8,
+	0xda, 0x82, 0xdf, 0x8d, 0xd1, 0xc9, 0x06, 0xd4, 0xe5, 0xc7, 0x64, 0xd6, 0x9d, 0x28, 0xa1, 0x08,
+	0x00, 0xa1, 0xe6, 0x07, 0xe3, 0x21, 0xb9, 0x53, 0x2e, 0x98, 0xfc, 0x26, 0x89, 0x2d, 0x2d, 0x70,
+	0xfb, 0x65, 0x47, 0x64, 0x3d, 0xe8, 0xd6, 0x14, 0xba, 0x35, 0xfa, 0x1b, 0x7d, 0x39, 0x1c, 0xa8,
+	0x7c, 0x25, 0x51, 0x88, 0xd0, 0x41, 0xa8, 0xd5, 0x1c, 0x32, 0x5e, 0xb2, 0x04, 0x2d, 0x3e, 0x23,
+	0x84, 0xc6, 0xaf, 0x86, 0x12, 0x5a, 0x0b, 0xca, 0xba, 0x61, 0x38, 0xd8, 0x75, 0xf9, 0x3a, 0xfc,
+	0x26, 0x79, 0xf2, 0x04, 0x3b, 0xae, 0x4f, 0xf2, 0x79, 0xcd, 0x6f, 0xa2, 0xf7, 0xa0, 0x22, 0xcc,
+	0x6c, 0x16, 0xdf, 0xbf, 0x98, 0xbe, 0x4e, 0xee, 0x62, 0x8b, 0x1e, 0xea, 0x77, 0xf3, 0xd0, 0xe0,
+	0x07, 0xb6, 0xc6, 0x0d, 0x8c, 0xc9, 0xcc, 0xb7, 0x06, 0xf5, 0x5e, 0x20, 0x3b, 0x26, 0x05, 0xd3,
+	0x64, 0x11, 0x13, 0xea, 0x33, 0x8d, 0x01, 0xc3, 0x26, 0x4e, 0x61, 0x2e, 0x13, 0xa7, 0x78, 0x5c,
+	0x09, 0x18, 0x37, 0x7a, 0x4b, 0x49, 0x46, 0x6f, 0x9a, 0x51, 0x50, 0x9e, 0xd5, 0x28, 0xf8, 0x45,
+	0xa8, 0x49, 0x2b, 0xa2, 0x2a, 0x83, 0x85, 0xf5, 0xf8, 0x15, 0xf8, 0x4d, 0xf4, 0x76, 0x60, 0x39,
+	0xb2, 0xb3, 0x3f, 0x93, 0x30, 0x59, 0xc4, 0x68, 0x54, 0xff, 0x42, 0x81, 0x12, 0x1f, 0x99, 0x24,
+	0x46, 0x98, 0xc0, 0xa2, 0x56, 0x35, 0x1b, 0x1d, 0x38, 0x88, 0x98, 0xd5, 0xcf, 0x4e, 0x8c, 0x9d,
+	0x81, 0x4a, 0x44, 0x80, 0x95, 0xb9, 0x9e, 0xf2, 0x1f, 0x49, 0x52, 0xab, 0x3c, 0x60, 0x02, 0x8b,
+	0x64, 0x85, 0x06, 0x76, 0x5f, 0xa4, 0xc9, 0x58, 0x43, 0xfd, 0x1b, 0x85, 0x66, 0x35, 0x34, 0xdc,
+	0xb5, 0x9f, 0x60, 0xe7, 0x68, 0xfe, 0x70, 0xf0, 0x2d, 0x89, 0x6f, 0x32, 0xba, 0xa7, 0xa2, 0x03,
+	0xba, 0x15, 0x5c, 0x42, 0x3e, 0x29, 0x16, 0x26, 0x0b, 0x32, 0x4e, 0xf5, 0xc1, 0x65, 0xfc, 0x16,
+	0x0b, 0x6c, 0x87, 0xb7, 0x32, 0xab, 0xf9, 0xf5, 0x4c, 0x5c, 0x3d, 0xf5, 0xef, 0x15, 0x68, 0x07,
+	0xc1, 0x36, 0x77, 0xed, 0x68, 0xde, 0xb4, 0xd1, 0xb3, 0xf1, 0x40, 0x7f, 0x4e, 0xe4, 0x35, 0x88,
+	0x14, 0xc8, 0xe4, 0x3b, 0xf2, 0x0e, 0xaa, 0x45, 0xe3, 0xf6, 0xf1, 0x0d, 0xcd, 0x43, 0x32, 0x6d,
+	0xa8, 0x88, 0x88, 0x0f, 0xcb, 0x6d, 0x88, 0x36, 0xe1, 0xb0, 0x33, 0xf7, 0xb0, 0xb7, 0x11, 0x0e,
+	0x16, 0xbd, 0xe8, 0x03, 0x94, 0xf3, 0x2d, 0xfb, 0x3c, 0xdf, 0x52, 0x88, 0xe4, 0x5b, 0x38, 0x5c,
+	0x1d, 0x42, 0x3b, 0x69, 0x03, 0xcf, 0xeb, 0xc0, 0xbe, 0xab, 0x40, 0x8b, 0xcf, 0x42, 0xe7, 0x24,
+	0x4e, 0xe3, 0x00, 0x7b, 0xd8, 0xf8, 0xa2, 0x83, 0x29, 0xff, 0xa9, 0x40, 0x53, 0x56, 0xe3, 0xe4,
+	0x29, 0xfa, 0x12, 0x14, 0x69, 0x2c, 0x8a, 0xaf, 0x60, 0xaa, 0x68, 0x60, 0xd8, 0x44, 0x6c, 0x53,
+	0xdb, 0x7f, 0x57, 0x58, 0x1c, 0xbc, 0x19, 0xd8, 0x12, 0xf9, 0xe3, 0xdb, 0x12, 0xdc, 0xb6, 0xb2,
+	0xc7, 0x64, 0x5c, 0x16, 0xc4, 0x0d, 0x00, 0xe8, 0x7d, 0x28, 0xb1, 0x52, 0x15, 0x9e, 0x83, 0xbc,
+	0x1c, 0x1e, 0x9a, 0x3d, 0xbb, 0x2e, 0x65, 0x46, 0x28, 0x40, 0xe3, 0x9d, 0xd4, 0xaf, 0xc2, 0x4a,
+	0xe0, 0xaf, 0xb3, 0x69, 0x67, 0x25, 0x5a, 0x92, 0x0c, 0x3e, 0xb9, 0x73, 0x64, 0x75, 0xa3, 0xe4,
+	0xbf, 0x02, 0xa5, 0xd1, 0x40, 0x0f, 0x62, 0xca, 0xbc, 0x45, 0xed, 0x4a, 0x36, 0x37, 0x36, 0x88,
+	0x0e, 0x61, 0x67, 0x56, 0x13, 0xb0, 0x5d, 0x7b, 0xaa, 0xad, 0x70, 0x59, 0x04, 0x18, 0xb0, 0xc1,
+	0xb4, 0x15, 0x0b, 0xd4, 0x2d, 0x08, 0x28, 0xd5, 0x56, 0xef, 0x03, 0x50, 0x0b, 0xa1, 0x73, 0x1c,
+	0xab, 0x80, 0xf6, 0xb8, 0x4f, 0xac, 0x82, 0x5f, 0x80, 0x53, 0xf2, 0x42, 0xa3, 0x81, 0xdf, 0xc4,
+	0xdb, 0x0c, 0x0e, 0x95, 0x21, 0x6b, 0x27, 0xa5, 0x7d, 0xed, 0xf8, 0x6c, 0xf0, 0xa7, 0x39, 0x68,
+	0xc5, 0x50, 0xbf, 0x38, 0x53, 0x2c, 0xc5, 0x01, 0xcd, 0x3f, 0x23, 0x07, 0xb4, 0x30, 0xbf, 0xf9,
+	0x55, 0x4c, 0x30, 0xbf, 0xd4, 0x9f, 0xe6, 0xa1, 0x11, 0x9c, 0xda, 0xf6, 0x40, 0xb7, 0x52, 0x69,
+	0x6c, 0x47, 0xb8, 0x1e, 0xe1, 0x73, 0x7a, 0x2d, 0xcb, 0x9d, 0xf1, 0x2e, 0x5a, 0x64, 0x08, 0x12,
+	0xae, 0x62, 0x31, 0x02, 0x1a, 0x74, 0xe4, 0xee, 0x0e, 0x63, 0x75, 0x12, 0x6f, 0x7c, 0x1d, 0x10,
+	0xe7, 0xcf, 0x8e, 0x69, 0x75, 0x5c, 0xdc, 0xb5, 0x2d, 0x83, 0x71, 0x6e, 0x51, 0x6b, 0xf2, 0x27,
+	0x9b, 0xd6, 0x0e, 0x83, 0xa3, 0x2f, 0x41, 0xc1, 0x3b, 0x1a, 0x31, 0x3b, 0xa8, 0x71, 0xf3, 0xd2,
+	0xc4, 0x75, 0xed, 0x1e, 0x8d, 0xb0, 0x46, 0xd1, 0xfd, 0x2a, 0x29, 0xcf, 0xd1, 0x9f, 0x70, 0x2b,
+	0xb5, 0xa0, 0x49, 0x10, 0x22, 0x8b, 0xfc, 0x33, 0x2c, 0x33, 0xe3, 0x8b, 0x37, 0x19, 0xcf, 0xf8,
+	0xe2, 0xa0, 0xe3, 0x79, 0x03, 0x1a, 0x36, 0xa5, 0x3c, 0xe3, 0x43, 0x77, 0xbd, 0x01, 0xc9, 0x1e,
+	0x48, 0x36, 0xae, 0x6f, 0x8e, 0x56, 0x29, 0xea, 0x52, 0xf0, 0x64, 0x83, 0x3d, 0x20, 0xe1, 0x58,
+	0x12, 0xae, 0xe5, 0x27, 0xc5, 0xd8, 0x15, 0x28, 0x72, 0x63, 0xa8, 0x1f, 0xfa, 0x4c, 0x40, 0xbc,
+	0xaf, 0xef, 0xe7, 0xa1, 0x19, 0x6c, 0x49, 0xc3, 0xee, 0x78, 0x90, 0x2e, 0x23, 0x26, 0xc7, 0x97,
+	0xa6, 0x89, 0x87, 0xaf, 0x40, 0x8d, 0xd3, 0xd3, 0x31, 0xe8, 0x11, 0x58, 0x97, 0xfb, 0x13, 0x18,
+	0xa4, 0xf8, 0x8c, 0x18, 0xa4, 0x34, 0x43, 0x84, 0x26, 0xe5, 0x56, 0x7f, 0x5e, 0x52, 0xb6, 0x95,
+	0x63, 0x88, 0xa5, 0x40, 0x25, 0xff, 0x48, 0x81, 0x53, 0x31, 0x5d, 0x30, 0xf1, 0x72, 0x26, 0x7b,
+	0xc8, 0x5c, 0x47, 0x44, 0x87, 0xe4, 0x5a, 0xed, 0x16, 0x94, 0x1c, 0x3a, 0x3a, 0xcf, 0x10, 0xbe,
+	0x3c, 0x71, 0xb5, 0x6c, 0x21, 0x1a, 0xef, 0xa2, 0xfe, 0xb6, 0x02, 0xa7, 0xe3, 0x4b, 0x9d, 0xc3,
+	0x54, 0x59, 0x83, 0x32, 0x1b, 0xda, 0x97, 0x0f, 0xd7, 0x26, 0x1f, 0x5e, 0x70, 0x38, 0x9a, 0xdf,
+	0x51, 0xdd, 0x81, 0x15, 0xdf, 0xa2, 0x09, 0x2e, 0x6f, 0x0b, 0x7b, 0xfa, 0x04, 0x77, 0xee, 0x25,
+	0xa8, 0x31, 0xbf, 0x80, 0xb9, 0x49, 0x2c, 0xb2, 0x02, 0x7b, 0x22, 0xa0, 0xa9, 0xfe, 0xab, 0x02,
+	0xcb, 0xd4, 0x24, 0x88, 0xa6, 0xe4, 0xb2, 0xa4, 0x6b, 0x55, 0xa8, 0x4b, 0x41, 0x1a, 0xb6, 0xb5,
+	0xaa, 0x16, 0x82, 0xa1, 0xcd, 0x78, 0xbc, 0x33, 0x31, 0x8e, 0x10, 0xe4, 0xf7, 0x49, 0xcc, 0x82,
+	0xa6, 0xf7, 0xa3, 0x81, 0xce, 0xc0, 0x14, 0x29, 0xcc, 0x62, 0x8a, 0xdc, 0x87, 0x53, 0x91, 0x9d,
+	0xce, 0x71, 0xa3, 0xea, 0x1f, 0x2a, 0xe4, 0x3a, 0x42, 0x65, 0x56, 0xb3, 0x9b, 0xe3, 0xe7, 0x45,
+	0x2e, 0xb0, 0x63, 0x1a, 0x51, 0x31, 0x64, 0xa0, 0x0f, 0xa0, 0x6a, 0xe1, 0xa7, 0x1d, 0xd9, 0xc2,
+	0xcb, 0xe0, 0xab, 0x54, 0x2c, 0xfc, 0x94, 0xfe, 0x52, 0x1f, 0xc0, 0xe9, 0xd8, 0x52, 0xe7, 0xd9,
+	0xfb, 0x9f, 0x2b, 0x70, 0x66, 0xdd, 0xb1, 0x47, 0x1f, 0x99, 0x8e, 0x37, 0xd6, 0x07, 0xe1, 0xca,
+	0x89, 0xe7, 0x13, 0x00, 0xfc, 0x50, 0x12, 0x3f, 0x8c, 0x7e, 0x5e, 0x4f, 0xe0, 0xa0, 0xf8, 0xa2,
+	0xe2, 0x62, 0xe8, 0x5f, 0xf2, 0x70, 0x26, 0x15, 0x6f, 0x8a, 0x4d, 0x94, 0xc5, 0x6d, 0x4a, 0xcc,
+	0x37, 0xe4, 0x67, 0xcd, 0x37, 0xa4, 0x28, 0x88, 0xc2, 0x33, 0x52, 0x10, 0xc7, 0x0e, 0x60, 0x7d,
+	0x08, 0xe1, 0x5c, 0x50, 0xab, 0x94, 0x39, 0x44, 0x1e, 0xee, 0x88, 0xd6, 0x00, 0x82, 0xbc, 0x48,
+	0xab, 0x9c, 0x79, 0x18, 0xa9, 0x17, 0xb9, 0x2d, 0xa1, 0x8c, 0xb9, 0x95, 0x11, 0x00, 0xd4, 0xaf,
+	0x43, 0x3b, 0x89, 0x4a, 0xe7, 0xa1, 0xfc, 0xff, 0xc8, 0x01, 0x6c, 0x8a, 0xc2, 0xea, 0xd9, 0x74,
+	0xc1, 0xcb, 0x20, 0x59, 0x42, 0x01, 0xbf, 0xcb, 0x54, 0x64, 0x10, 0x96, 0x10, 0x9e, 0x36, 0xc1,
+	0x89, 0x79, 0xdf, 0x06, 0x1d, 0x47, 0xe2, 0x1a, 0x46, 0x14, 0x51, 0xf1, 0x7b, 0x16, 0xaa, 0x24,
+	0xc3, 0x4d, 0xd8, 0xcc, 0xf0, 0x2b, 0xc7, 0x1d, 0xfb, 0x29, 0x61, 0x3e, 0x83, 0x24, 0x35, 0x49,
+	0xb5, 0x0e, 0x19, 0xbf, 0x24, 0x15, 0xef, 0x18, 0x24, 0x48, 0xd6, 0x33, 0x07, 0x98, 0xd5, 0x8a,
+	0x54, 0x35, 0xd6, 0x20, 0xa9, 0x76, 0x56, 0xe2, 0x58, 0xc9, 0x5c, 0xa0, 0x45, 0xf1, 0x89, 0x16,
+	0xea, 0xee, 0x8f, 0xad, 0x83, 0x8e, 0x69, 0x19, 0xf8, 0x90, 0xdb, 0x78, 0x40, 0x41, 0x9b, 0x04,
+	0x12, 0x20, 0xb0, 0x82, 0x6d, 0x90, 0x10, 0xee, 0x10, 0x08, 0x89, 0xcf, 0x2d, 0x06, 0xe7, 0x4e,
+	0x45, 0x18, 0x91, 0x8a, 0x54, 0x22, 0xde, 0xb1, 0x0d, 0x26, 0x6c, 0x1a, 0x29, 0x3a, 0x85, 0x75,
+	0xa4, 0x9d, 0xb4, 0xa0, 0xcb, 0xa4, 0xf0, 0x01, 0x39, 0x19, 0x72, 0x6c, 0xa6, 0xe1, 0x97, 0x3c,
+	0x95, 0x1c, 0xfb, 0xe9, 0xa6, 0x21, 0xce, 0x93, 0xad, 0x93, 0x39, 0xcb, 0xe4, 0x3c, 0xe9, 0x2a,
+	0xc9, 0x8d, 0x60, 0xc7, 0xb1, 0x9d, 0xce, 0x10, 0xbb, 0xae, 0xde, 0xc7, 0xdc, 0xbb, 0xa8, 0x53,
+	0xe0, 0x16, 0x83, 0xa9, 0x3f, 0x2b, 0x40, 0x23, 0xd8, 0x8a, 0x5f, 0x60, 0x61, 0x1a, 0x7e, 0x81,
+	0x85, 0x49, 0x2e, 0x1f, 0x1c, 0x26, 0x4c, 0x05, 0x79, 0xac, 0xe5, 0x5a, 0x8a, 0x56, 0xe5, 0xd0,
+	0x4d, 0x83, 0x9c, 0x18, 0x61, 0x53, 0xcb, 0x36, 0x70, 0x40, 0x1e, 0xe0, 0x83, 0x38, 0x75, 0x84,
+	0xa8, 0xac, 0x90, 0x81, 0xca, 0x8a, 0x19, 0xa8, 0xac, 0x94, 0x40, 0x65, 0x2b, 0x50, 0xda, 0x1b,
+	0x77, 0x0f, 0xb0, 0xc7, 0xad, 0x46, 0xde, 0x0a, 0x53, 0x5f, 0x25, 0x42, 0x7d, 0x82, 0xc8, 0xaa,
+	0x32, 0x91, 0x9d, 0x85, 0x2a, 0xcb, 0xf4, 0x77, 0x3c, 0xdf, 0xc0, 0xaf, 0x30, 0xc0, 0xae, 0x8b,
+	0xde, 0xf5, 0x0d, 0xc2, 0x5a, 0x92, 0xb8, 0xa0, 0x72, 0x2b, 0x42, 0x25, 0xbe, 0x39, 0x78, 0x15,
+	0x16, 0xa5, 0xe3, 0xa0, 0x5a, 0xa6, 0x4e, 0x97, 0x2a, 0xf9, 0x2a, 0x54, 0xd1, 0x5c, 0x86, 0x46,
+	0x70, 0x24, 0x14, 0x6f, 0x81, 0xb9, 0x88, 0x02, 0x4a, 0xd1, 0x04, 0x2f, 0x34, 0x8e, 0xc9, 0x0b,
+	0x67, 0xa0, 0xc2, 0x7d, 0x3b, 0xb7, 0xb5, 0x18, 0x0e, 0xe2, 0xdc, 0x86, 0xaa, 0x3b, 0xde, 0xeb,
+	0x10, 0x1e, 0x74, 0x5b, 0xcd, 0x54, 0x1b, 0x9b, 0x93, 0xf3, 0x78, 0xcf, 0xa7, 0x1f, 0xad, 0xe2,
+	0xb2, 0x86, 0xab, 0x7e, 0x0a, 0x28, 0x38, 0x80, 0xf9, 0x4c, 0xd6, 0x08, 0x85, 0xe5, 0xa2, 0x14,
+	0xa6, 0xfe, 0x91, 0x02, 0x4b, 0xf2, 0x64, 0xb3, 0x6a, 0xff, 0x0f, 0xa0, 0xc6, 0x52, 0xbd, 0x74,
+	0xe7, 0xad, 0x5c, 0x6a, 0x8e, 0x43, 0x9a, 0x0c, 0x82, 0xb7, 0x5b, 0x08, 0x85, 0x3e, 0xb5, 0x9d,
+	0x03, 0xe2, 0x45, 0x92, 0x95, 0xf9, 0x1c, 0x5b, 0xe7, 0x40, 0x92, 0xfe, 0xa2, 0xc5, 0x67, 0x17,
+	0x1e, 0x8d, 0x0c, 0xdd, 0xc3, 0x92, 0x19, 0x34, 0x6f, 0xc1, 0xec, 0x97, 0xfc, 0x8a, 0xd5, 0x5c,
+	0xb6, 0x74, 0x23, 0xc3, 0x56, 0xff, 0x58, 0xac, 0x85, 0xeb, 0x24, 0x9a, 0x9b, 0x1e, 0xd1, 0x5a,
+	0x81, 0x99, 0xd7, 0xd2, 0x86, 0xca, 0x13, 0x3e, 0x9c, 0xff, 0xb6, 0x8e, 0xdf, 0x0e, 0xa5, 0xb4,
+	0xf3, 0xc7, 0x4f, 0x69, 0xab, 0x5b, 0xa4, 0xd4, 0xd4, 0xc5, 0x96, 0x11, 0xda, 0xcd, 0xcc, 0x71,
+	0xbc, 0x11, 0xb4, 0x93, 0x86, 0x9b, 0x87, 0x58, 0x99, 0x01, 0xdd, 0x71, 0xb0, 0xcb, 0x42, 0xb4,
+	0x79, 0x6e, 0xb7, 0xd1, 0x79, 0x3c, 0xf5, 0xc7, 0x39, 0x38, 0x7d, 0xdb, 0x30, 0x38, 0xe7, 0xb0,
+	0x59, 0x9f, 0x9b, 0xb5, 0x1e, 0xb5, 0x66, 0xf3, 0x71, 0x6b, 0xf6, 0x59, 0x09, 0x67, 0xae, 0xa6,
+	0x48, 0xa6, 0x8d, 0x2b, 0x70, 0x87, 0x15, 0xaf, 0xdd, 0xe2, 0x39, 0x4e, 0x12, 0x97, 0x68, 0x95,
+	0x33, 0x19, 0x79, 0x15, 0x3f, 0x1e, 0xa9, 0x8e, 0xa0, 0x15, 0x3f, 0xac, 0x39, 0x45, 0x89, 0x7f,
+	0x22, 0x23, 0x9b, 0xc5, 0xae, 0xeb, 0x1a, 0x70, 0xd0, 0xb6, 0xed, 0xaa, 0xff, 0x9e, 0x83, 0x16,
+	0x29, 0x19, 0xfa, 0xbf, 0x73, 0x41, 0xdf, 0x80, 0x65, 0x57, 0x7f, 0x82, 0x3b, 0x92, 0x77, 0xde,
+	0x71, 0xf0, 0x63, 0x6e, 0x07, 0xbf, 0x9a, 0x24, 0x49, 0x12, 0x4b, 0xaa, 0xb4, 0x25, 0x37, 0x04,
+	0xd7, 0xf0, 0x63, 0x74, 0x05, 0x16, 0xe5, 0x22, 0xc2, 0x8e, 0xc9, 0x74, 0x6f, 0x5d, 0x5b, 0x90,
+	0x6a, 0x04, 0x37, 0x0d, 0xf5, 0x31, 0x9c, 0x7b, 0x64, 0xb9, 0xd8, 0xdb, 0x0c, 0xea, 0xdc, 0xe6,
+	0xf4, 0x63, 0x5f, 0x82, 0x5a, 0x70, 0xf0, 0xb1, 0x37, 0x74, 0x0c, 0x57, 0xb5, 0xa1, 0xbd, 0xa5,
+	0x3b, 0x07, 0xfc, 0x86, 0xdd, 0x75, 0x56, 0xfe, 0xf3, 0x1c, 0x27, 0xec, 0x89, 0x6a, 0x38, 0x0d,
+	0xf7, 0xb0, 0x83, 0xad, 0x2e, 0x26, 0x75, 0xf2, 0x52, 0xd9, 0xba, 0x22, 0x97, 0xad, 0xcf, 0x5a,
+	0x06, 0xaf, 0xfe, 0x24, 0x07, 0x2b, 0xb7, 0x07, 0x1e, 0x76, 0x82, 0xf0, 0xc3, 0x71, 0x22, 0x29,
+	0x41, 0x68, 0x23, 0x37, 0x43, 0x68, 0x23, 0xf6, 0x06, 0x46, 0x3e, 0xfe, 0x06, 0x46, 0x52, 0x20,
+	0xa6, 0x30, 0x63, 0x20, 0xe6, 0x36, 0xc0, 0xc8, 0xb1, 0x47, 0xd8, 0xf1, 0x4c, 0xec, 0xfb, 0x90,
+	0x19, 0x2c, 0x20, 0xa9, 0x93, 0xfa, 0x83, 0x3c, 0x40, 0x50, 0xb3, 0x30, 0x21, 0x82, 0xf5, 0x65,
+	0xa8, 0xd2, 0x77, 0xaf, 0x69, 0x0c, 0x9b, 0xc5, 0x01, 0xcf, 0x27, 0x1e, 0x0e, 0x59, 0x2d, 0x8d,
+	0x5f, 0x57, 0x0c, 0xfe, 0x2b, 0x6c, 0xac, 0xe7, 0x23, 0xc6, 0xfa, 0x79, 0x00, 0x6b, 0x3c, 0x18,
+	0x84, 0x4c, 0xf9, 0x2a, 0x81, 0xb0, 0xc7, 0x97, 0xa1, 0x61, 0x10, 0xfb, 0xc0, 0xea, 0x7a, 0x1c,
+	0x85, 0xb1, 0xf7, 0x82, 0x0f, 0x65, 0x68, 0x57, 0x61, 0x51, 0xa0, 0xb9, 0x07, 0xd8, 0xeb, 0xee,
+	0x53, 0x46, 0xaf, 0x6b, 0xa2, 0xf7, 0x0e, 0x85, 0xd2, 0x02, 0x52, 0xcb, 0xeb, 0x0c, 0x4d, 0x8b,
+	0x97, 0x1a, 0x97, 0x4c, 0xcb, 0xdb, 0x32, 0x2d, 0xf1, 0x40, 0x3f, 0x6c, 0x55, 0x82, 0x07, 0xfa,
+	0x21, 0x59, 0x7d, 0x6f, 0x60, 0xeb, 0xac, 0x0f, 0xf1, 0x99, 0x14, 0xad, 0x42, 0x01, 0xa4, 0x57,
+	0xf0, 0x50, 0x3f, 0x6c, 0x81, 0xfc, 0x50, 0x3f, 0x64, 0xf9, 0x03, 0x1a, 0x56, 0x27, 0x5d, 0x6b,
+	0x54, 0xbc, 0x55, 0x19, 0x84, 0xf4, 0x95, 0x1e, 0xeb, 0x87, 0xad, 0x7a, 0xe8, 0xb1, 0x7e, 0x48,
+	0x84, 0xf1, 0x52, 0x2c, 0x8e, 0x3b, 0x25, 0x30, 0x12, 0x09, 0x94, 0xe7, 0xa6, 0x04, 0xca, 0xf3,
+	0xcf, 0x2a, 0x50, 0xfe, 0xc2, 0xe2, 0x20, 0x69, 0x15, 0x3a, 0xa5, 0x59, 0x2b, 0x74, 0xfe, 0x2a,
+	0x07, 0x17, 0xb7, 0x74, 0x8b, 0x04, 0x2a, 0xc4, 0xd9, 0x7f, 0x6c, 0x7a, 0xfb, 0x3b, 0x5d, 0x7b,
+	0x84, 0x9f, 0x6f, 0xa2, 0x3f, 0x8b, 0xf4, 0x98, 0xf6, 0xfa, 0xfd, 0x2d, 0x28, 0x0c, 0x89, 0x1f,
+	0xce, 0xb2, 0x4c, 0x49, 0xb5, 0xac, 0xd1, 0xcd, 0x6d, 0xd9, 0x06, 0xd6, 0x68, 0xa7, 0x68, 0x6e,
+	0x87, 0x96, 0xed, 0x94, 0xa2, 0xb9, 0x1d, 0x5a, 0xbd, 0x13, 0xce, 0x4a, 0x95, 0xa3, 0x59, 0x29,
+	0xf5, 0xc7, 0x24, 0x70, 0xaf, 0x5b, 0x5d, 0x3c, 0x90, 0x63, 0xfb, 0x73, 0x1d, 0x9e, 0x3f, 0x8c,
+	0x7c, 0x78, 0x01, 0x4c, 0xca, 0x5d, 0xe4, 0x43, 0xb9, 0x8b, 0x2c, 0x9f, 0x54, 0xf8, 0xd5, 0x1c,
+	0x2c, 0xdc, 0x3d, 0x24, 0xaa, 0xf7, 0xc5, 0x5f, 0x70, 0xa8, 0xc2, 0xb2, 0x10, 0xad, 0xb0, 0x7c,
+	0x07, 0x4a, 0x3d, 0xdb, 0x19, 0xea, 0x1e, 0xbf, 0xe0, 0x24, 0x57, 0x87, 0xed, 0x64, 0x83, 0xa2,
+	0x69, 0x1c, 0x9d, 0x18, 0x52, 0x9e, 0xee, 0xf4, 0xb1, 0xd7, 0x19, 0x39, 0xb8, 0x67, 0x1e, 0xf2,
+	0x7a, 0xb7, 0x3a, 0x03, 0x6e, 0x53, 0x98, 0xfa, 0x09, 0x34, 0xfc, 0x63, 0x98, 0xc7, 0xcc, 0x5c,
+	0x86, 0xe2, 0xa7, 0x76, 0xf0, 0x2a, 0x0e, 0x6b, 0xa8, 0xdf, 0x61, 0x2f, 0x20, 0xb3, 0x09, 0xe6,
+	0xb4, 0x6f, 0x12, 0x67, 0xc8, 0x54, 0x8c, 0xf9, 0x97, 0x39, 0x58, 0x89, 0xae, 0xe2, 0x99, 0xef,
+	0x95, 0xbc, 0x84, 0x2c, 0x27, 0x09, 0x2e, 0xa4, 0xde, 0xd2, 0xc4, 0x62, 0xd2, 0xa4, 0x4f, 0x22,
+	0x84, 0xc8, 0xa3, 0x18, 0x25, 0x8f, 0x36, 0x54, 0x46, 0x8e, 0xdd, 0xa7, 0xa5, 0xa5, 0x8c, 0x71,
+	0x45, 0x3b, 0xac, 0x84, 0xcb, 0x11, 0x25, 0x2c, 0x62, 0x40, 0x15, 0x39, 0x06, 0xb4, 0x42, 0x72,
+	0x77, 0xba, 0xcb, 0x5f, 0xa4, 0xa9, 0x6a, 0xbc, 0xa5, 0x7e, 0x5b, 0x81, 0x93, 0x8c, 0xbb, 0xe7,
+	0xe5, 0x9a, 0xd9, 0x2f, 0xf2, 0x87, 0x79, 0x80, 0xbb, 0x87, 0x22, 0xf8, 0xf0, 0xac, 0xa6, 0x0e,
+	0xec, 0xd1, 0x7c, 0xc8, 0x1e, 0xcd, 0x72, 0x37, 0xf3, 0x95, 0xe8, 0x84, 0xaf, 0xb6, 0x94, 0xce,
+	0xf9, 0xe5, 0x39, 0x39, 0xbf, 0x12, 0xe7, 0x7c, 0xb4, 0x0b, 0x8b, 0xbe, 0xd4, 0xf7, 0x2b, 0x1e,
+	0xab, 0x73, 0xd7, 0x4f, 0xa8, 0xdf, 0xcb, 0x41, 0x33, 0xb8, 0x23, 0x9e, 0x64, 0x7e, 0xde, 0x37,
+	0x25, 0x78, 0xaf, 0x70, 0x1c, 0xde, 0x0b, 0xeb, 0xd5, 0x62, 0x4c, 0xaf, 0x86, 0xb8, 0xa7, 0x94,
+	0xc6, 0x3d, 0xe5, 0x64, 0xee, 0xa9, 0x84, 0xb8, 0xe7, 0xcf, 0x14, 0x40, 0xe1, 0xaa, 0x16, 0x1a,
+	0x7c, 0x4e, 0x4b, 0xbe, 0xbf, 0x17, 0x4e, 0xbe, 0x5f, 0x99, 0x78, 0x21, 0x64, 0xb4, 0xd0, 0xbe,
+	0x48, 0xa9, 0x9a, 0x3d, 0x76, 0xba, 0x22, 0x1c, 0xe7, 0x37, 0xc9, 0x13, 0x46, 0x02, 0xbe, 0x19,
+	0xe1, 0x37, 0x25, 0xdf, 0xab, 0x28, 0xfb, 0x5e, 0xea, 0xef, 0xfa, 0x9f, 0x02, 0x88, 0xcd, 0xe6,
+	0x3e, 0x5f, 0xdd, 0x9e, 0x45, 0x1e, 0x7c, 0xcf, 0xff, 0x34, 0x40, 0xd2, 0xe2, 0xe6, 0xab, 0x20,
+	0x2e, 0x92, 0x9b, 0xf0, 0x83, 0x8b, 0x97, 0xa7, 0x9e, 0x3f, 0xab, 0x14, 0xa4, 0x7d, 0xd4, 0x7f,
+	0x53, 0xa0, 0x11, 0x70, 0x80, 0x7f, 0xcf, 0x89, 0x1e, 0xef, 0xb4, 0x8f, 0x6a, 0x04, 0xb7, 0x92,
+	0x0f, 0x79, 0xc4, 0xb3, 0xd1, 0x7b, 0x1b, 0x2a, 0xba, 0xe7, 0xe1, 0xe1, 0xc8, 0x63, 0xef, 0x72,
+	0x16, 0x35, 0xd1, 0x96, 0x4a, 0x36, 0x4a, 0xa9, 0x25, 0x1b, 0x51, 0xb6, 0x16, 0x25, 0x1b, 0x3f,
+	0x2c, 0xf8, 0xb6, 0xd4, 0x57, 0xed, 0x3d, 0xba, 0x61, 0xc1, 0xbe, 0xca, 0x24, 0x19, 0xff, 0x3f,
+	0xd0, 0x5e, 0x92, 0x04, 0x7e, 0x79, 0x16, 0x81, 0xdf, 0x96, 0x6a, 0xd3, 0x99, 0xde, 0x15, 0xed,
+	0xe0, 0x56, 0xab, 0xc7, 0xb9, 0xd5, 0x40, 0xe4, 0x80, 0x2c, 0x72, 0x08, 0xaf, 0x13, 0x7a, 0xb4,
+	0xb0, 0xc1, 0x5f, 0xea, 0xf2, 0x9b, 0x72, 0xe0, 0x86, 0x04, 0xc7, 0xea, 0x2c, 0xbb, 0xc0, 0x41,
+	0x24, 0x40, 0xf6, 0x0e, 0x14, 0x59, 0x22, 0x64, 0x21, 0xb5, 0x02, 0x3e, 0x4c, 0xe0, 0x1a, 0xc3,
+	0x27, 0x09, 0x16, 0xf2, 0xed, 0x1d, 0x5a, 0x3a, 0xd7, 0x60, 0xb1, 0x04, 0x6c, 0x19, 0xa4, 0x70,
+	0x4e, 0xfd, 0xa7, 0x3c, 0x2c, 0xc5, 0xb2, 0x27, 0xb1, 0xec, 0x9b, 0x90, 0xaa, 0x39, 0x59, 0xaa,
+	0x46, 0x72, 0x98, 0xf9, 0x69, 0x39, 0xcc, 0x42, 0x34, 0x87, 0x89, 0xfe, 0xbf, 0x7f, 0xb4, 0xc5,
+	0x8c, 0xb9, 0x4a, 0x7e, 0xb8, 0x91, 0x44, 0x4c, 0x29, 0x96, 0xea, 0x93, 0x79, 0x8a, 0x1b, 0x58,
+	0x82, 0xa7, 0x2e, 0x41, 0xbd, 0xa7, 0x9b, 0x03, 0x6c, 0xf0, 0xdc, 0x08, 0x7b, 0x99, 0xbf, 0xc6,
+	0x60, 0x34, 0x35, 0x12, 0x56, 0x31, 0xd5, 0x88, 0x8a, 0x91, 0x93, 0xa4, 0x10, 0x49, 0x92, 0x9e,
+	0x81, 0x8a, 0x3e, 0xf6, 0x6c, 0x1a, 0x79, 0xab, 0x31, 0x51, 0x4e, 0xda, 0x24, 0x4d, 0x7a, 0x15,
+	0x16, 0x47, 0x8e, 0xdd, 0xc5, 0xae, 0x8b, 0x8d, 0xce, 0xde, 0x91, 0x87, 0x5d, 0x7e, 0xc5, 0x0d,
+	0x01, 0x5e, 0x23, 0x50, 0xb2, 0x39, 0xcf, 0xf6, 0xf4, 0x01, 0x47, 0x5a, 0x60, 0x9b, 0xa3, 0x20,
+	0x86, 0x10, 0xcb, 0xa9, 0x36, 0xe2, 0x39, 0xd5, 0xd5, 0x0f, 0xc4, 0x0b, 0xeb, 0x34, 0xee, 0x53,
+	0x86, 0xfc, 0x03, 0xfc, 0xb4, 0x79, 0x02, 0x01, 0x94, 0x1e, 0x10, 0xbe, 0x1a, 0x34, 0x15, 0x54,
+	0x83, 0x32, 0xaf, 0x3e, 0x6f, 0xe6, 0xd0, 0x02, 0x54, 0xef, 0xf8, 0xe5, 0xb2, 0xcd, 0xfc, 0xea,
+	0xef, 0x28, 0xb0, 0x14, 0xab, 0x8f, 0x46, 0x0d, 0x80, 0x47, 0x56, 0x97, 0x17, 0x8e, 0x37, 0x4f,
+	0xa0, 0x3a, 0x54, 0xfc, 0x32, 0x72, 0x36, 0xde, 0xae, 0x4d, 0xb1, 0x9b, 0x39, 0xd4, 0x84, 0x3a,
+	0xeb, 0x38, 0xee, 0x92, 0xdd, 0x35, 0xf3, 0x02, 0xb2, 0xa1, 0x9b, 0x83, 0xb1, 0x83, 0x9b, 0x05,
+	0x32, 0xe7, 0xae, 0xcd, 0x3f, 0xd9, 0xd1, 0x2c, 0x22, 0x04, 0x0d, 0xde, 0xf0, 0x3b, 0x95, 0x24,
+	0x98, 0xdf, 0xad, 0xbc, 0xfa, 0x58, 0xae, 0x45, 0xa5, 0xdb, 0x3b, 0x0d, 0x27, 0x1f, 0x59, 0x06,
+	0xee, 0x99, 0x16, 0x36, 0x82, 0x47, 0xcd, 0x13, 0xe8, 0x24, 0x2c, 0x6e, 0x61, 0xa7, 0x8f, 0x25,
+	0x60, 0x0e, 0x2d, 0xc1, 0xc2, 0x96, 0x79, 0x28, 0x81, 0xf2, 0xa8, 0x05, 0xcb, 0x77, 0x44, 0x04,
+	0x42, 0x7a, 0x52, 0x50, 0x0b, 0x15, 0xa5, 0xa9, 0xac, 0x6e, 0xc1, 0x72, 0x92, 0xb7, 0x8e, 0x4e,
+	0xc1, 0xd2, 0x3a, 0xee, 0xe9, 0xe3, 0x81, 0x17, 0x9a, 0x76, 0x01, 0xaa, 0x74, 0xda, 0x87, 0xd6,
+	0xe0, 0xa8, 0xa9, 0xa0, 0x45, 0xa8, 0xad, 0x63, 0x72, 0x48, 0xdb, 0x63, 0xa7, 0x8f, 0x9b, 0xb9,
+	0xd5, 0xb7, 0xa0, 0x2e, 0xcb, 0x3a, 0xb2, 0x22, 0xd6, 0xde, 0xd6, 0x9d, 0xc7, 0x63, 0xec, 0x35,
+	0x4f, 0x90, 0xa3, 0xe6, 0xc2, 0x7b, 0xe7, 0xe1, 0x83, 0xa6, 0xb2, 0x3a, 0x82, 0x9a, 0x24, 0x66,
+	0xa4, 0x1e, 0xd8, 0x32, 0x4c, 0xab, 0xcf, 0xf6, 0xca, 0x40, 0x77, 0x0f, 0x71, 0x77, 0x4c, 0x62,
+	0xd7, 0x4d, 0x25, 0x00, 0x8a, 0x72, 0x7f, 0x76, 0x37, 0x7c, 0x7a, 0x4a, 0xf4, 0xcd, 0x3c, 0x39,
+	0x66, 0x8e, 0x46, 0x9d, 0x09, 0x6c, 0x34, 0x0b, 0xab, 0x3f, 0x23, 0xbe, 0x45, 0x5c, 0x89, 0xa3,
+	0x33, 0x70, 0x2a, 0x0c, 0x7e, 0x64, 0x1d, 0x58, 0xf6, 0x53, 0xb2, 0xef, 0xb3, 0x70, 0x3a, 0xfc,
+	0x48, 0x5e, 0x4a, 0xec, 0xa1, 0xbc, 0x24, 0x72, 0x01, 0xa1, 0x87, 0x62, 0x69, 0xb1, 0xe9, 0x76,
+	0x59, 0x3d, 0x6f, 0xb3, 0x80, 0xce, 0x41, 0x2b, 0xfc, 0x88, 0xad, 0x7e, 0x40, 0xe6, 0x2b, 0x26,
+	0xcc, 0xc7, 0x9e, 0x62, 0xa3, 0x59, 0xba, 0xf9, 0x8f, 0x2a, 0x54, 0x49, 0x7c, 0xf4, 0x8e, 0x6d,
+	0x3b, 0x06, 0x1a, 0x00, 0xe2, 0x16, 0x8b, 0x6d, 0x89, 0x4f, 0x84, 0xa1, 0xeb, 0x61, 0x79, 0xc4,
+	0x1b, 0x71, 0x44, 0x6e, 0x72, 0xb5, 0x5f, 0x49, 0xc4, 0x8f, 0x20, 0xab, 0x27, 0xd0, 0x90, 0xce,
+	0x46, 0xb6, 0xb1, 0x6b, 0x76, 0x0f, 0xfc, 0x94, 0xe4, 0x9b, 0x29, 0x09, 0xc8, 0x38, 0xaa, 0x3f,
+	0xdf, 0xcb, 0x89, 0xf3, 0xb1, 0xaf, 0x4d, 0xf9, 0x96, 0x96, 0x7a, 0x02, 0x3d, 0x86, 0xe5, 0x7b,
+	0x58, 0xca, 0xee, 0xfa, 0x13, 0xde, 0x4c, 0x9f, 0x30, 0x86, 0x7c, 0xcc, 0x29, 0xef, 0x43, 0x91,
+	0x4a, 0x16, 0x94, 0xa4, 0xe5, 0xe5, 0xaf, 0x79, 0xb6, 0x2f, 0xa6, 0x23, 0x88, 0xd1, 0x3e, 0x85,
+	0xc5, 0xc8, 0x37, 0x00, 0x51, 0x52, 0x3a, 0x28, 0xf9, 0x6b, 0x8e, 0xed, 0xd5, 0x2c, 0xa8, 0x62,
+	0xae, 0x3e, 0x34, 0xc2, 0x1f, 0x41, 0x42, 0x49, 0x75, 0xa9, 0x89, 0x9f, 0x6f, 0x6b, 0xbf, 0x9a,
+	0x01, 0x53, 0x4c, 0x34, 0x84, 0x66, 0xf4, 0x9b, 0x74, 0x68, 0x75, 0xe2, 0x00, 0x61, 0x62, 0x7b,
+	0x2d, 0x13, 0xae, 0x98, 0xee, 0x08, 0x96, 0x93, 0x3e, 0x73, 0x86, 0xae, 0x27, 0x0f, 0x93, 0xf6,
+	0xfd, 0xb5, 0xf6, 0x8d, 0xcc, 0xf8, 0x62, 0xea, 0x5f, 0x61, 0x6f, 0x12, 0x26, 0x7d, 0x2a, 0x0c,
+	0xbd, 0x95, 0x3c, 0xdc, 0x84, 0x6f, 0x9c, 0xb5, 0x6f, 0x1e, 0xa7, 0x8b, 0x58, 0xc4, 0xb7, 0x60,
+	0x25, 0xf9, 0x63, 0x5b, 0xe8, 0xcd, 0xe4, 0xf1, 0xd2, 0xbf, 0x23, 0xd6, 0x7e, 0xeb, 0x18, 0x3d,
+	0xc4, 0x02, 0xec, 0xe8, 0x47, 0xff, 0x7c, 0x36, 0xbc, 0x31, 0x95, 0x6a, 0x66, 0xe3, 0xc1, 0x4f,
+	0x60, 0x31, 0x92, 0x20, 0x45, 0xd9, 0x93, 0xa8, 0xed, 0x49, 0x0e, 0x19, 0x63, 0xc9, 0xc8, 0x1b,
+	0x95, 0x28, 0x85, 0xfa, 0x13, 0xde, 0xba, 0x6c, 0xaf, 0x66, 0x41, 0x15, 0x1b, 0x71, 0xa9, 0xb8,
+	0x8c, 0xbc, 0x27, 0x87, 0x5e, 0x4f, 0x1e, 0x23, 0xf9, 0x7d, 0xc0, 0xf6, 0x1b, 0x19, 0xb1, 0xc5,
+	0xa4, 0x4f, 0xe0, 0x64, 0xc2, 0xeb, 0x8c, 0xe8, 0x8d, 0x89, 0x97, 0x15, 0x7d, 0x8f, 0xb3, 0x7d,
+	0x3d, 0x2b, 0xba, 0x98, 0xf7, 0x97, 0x01, 0xed, 0xec, 0x13, 0x53, 0xd3, 0xea, 0x99, 0xfd, 0xb1,
+	0xa3, 0xb3, 0xf4, 0x62, 0x9a, 0x6e, 0x88, 0xa3, 0xa6, 0xd0, 0xe8, 0xc4, 0x1e, 0x62, 0xf2, 0x0e,
+	0xc0, 0x3d, 0xec, 0x6d, 0x61, 0xcf, 0x21, 0x8c, 0x71, 0x25, 0x4d, 0xfd, 0x71, 0x04, 0x7f, 0xaa,
+	0xab, 0x53, 0xf1, 0x24, 0x55, 0xd4, 0x8c, 0x9a, 0x51, 0xe8, 0xf5, 0xc4, 0xee, 0x51, 0xb4, 0x94,
+	0x8b, 0x4c, 0xc5, 0x16, 0x53, 0x3e, 0x15, 0xaa, 0x5d, 0x7a, 0x91, 0x60, 0xb2, 0x6a, 0x8f, 0xbf,
+	0x9a, 0xd7, 0xbe, 0x91, 0x19, 0x5f, 0x4c, 0xfc, 0x99, 0x02, 0x67, 0xe3, 0x08, 0x24, 0x81, 0x45,
+	0x6c, 0x11, 0x37, 0xcb, 0x12, 0x28, 0xe2, 0x31, 0x96, 0xc0, 0xf1, 0xc5, 0x12, 0x0c, 0x58, 0x08,
+	0xd5, 0xf7, 0xa3, 0xa4, 0x2c, 0x54, 0xd2, 0xbb, 0x0e, 0xed, 0x6b, 0xd3, 0x11, 0xc5, 0x2c, 0xfb,
+	0xb0, 0xe0, 0xb3, 0x12, 0x3b, 0xdc, 0x57, 0xd3, 0x56, 0x1a, 0xe0, 0xa4, 0x48, 0x82, 0x64, 0x54,
+	0x59, 0x12, 0xc4, 0xcb, 0x97, 0x51, 0xb6, 0xb2, 0xf7, 0x49, 0x92, 0x20, 0xbd, 0x26, 0x9a, 0x89,
+	0xba, 0xc8, 0xab, 0x02, 0xc9, 0x72, 0x34, 0xf1, 0xcd, 0x87, 0xf6, 0x6a, 0x16, 0x54, 0x31, 0xd7,
+	0xc7, 0x50, 0xe2, 0x9f, 0xb0, 0x7e, 0x65, 0x72, 0xb5, 0x1f, 0x1f, 0xfd, 0xf2, 0x14, 0x2c, 0x31,
+	0xf0, 0x01, 0x9c, 0x4e, 0xa9, 0xf5, 0x4b, 0x54, 0xc1, 0x93, 0xeb, 0x02, 0xa7, 0x29, 0x07, 0x31,
+	0x59, 0xac, 0x98, 0x6f, 0xc2, 0x64, 0x69, 0x85, 0x7f, 0xd3, 0x26, 0xd3, 0x01, 0xc5, 0x3f, 0x4a,
+	0x99, 0x48, 0x13, 0xa9, 0xdf, 0xae, 0xcc, 0x30, 0x45, 0xfc, 0xbb, 0x92, 0x89, 0x53, 0xa4, 0x7e,
+	0x7e, 0x72, 0xda, 0x14, 0x1d, 0x58, 0x8a, 0x55, 0x7b, 0xa1, 0xd7, 0x52, 0xd4, 0x75, 0x52, 0x4d,
+	0xd8, 0xb4, 0x09, 0xfa, 0x70, 0x2a, 0xb1, 0xb2, 0x29, 0xd1, 0xfc, 0x98, 0x54, 0x03, 0x35, 0x6d,
+	0xa2, 0x2e, 0x9c, 0x4c, 0xa8, 0x67, 0x4a, 0x54, 0x9c, 0xe9, 0x75, 0x4f, 0xd3, 0x26, 0xe9, 0x41,
+	0x7b, 0xcd, 0xb1, 0x75, 0xa3, 0xab, 0xbb, 0x1e, 0xad, 0x31, 0xc2, 0x46, 0x60, 0xff, 0x25, 0x3b,
+	0x07, 0x89, 0x95, 0x48, 0xd3, 0xe6, 0xd9, 0x83, 0x1a, 0x25, 0x48, 0xf6, 0x89, 0x64, 0x94, 0xac,
+	0xe9, 0x24, 0x8c, 0x14, 0xf1, 0x99, 0x84, 0x28, 0x58, 0xf3, 0xdb, 0x0a, 0x9c, 0x49, 0x2d, 0x73,
+	0x40, 0x6f, 0x67, 0xa8, 0x1b, 0x88, 0x16, 0x45, 0x1c, 0x5f, 0x49, 0xfe, 0x12, 0x34, 0xa3, 0x35,
+	0x02, 0x89, 0xce, 0x48, 0x4a, 0x21, 0xc1, 0xb4, 0x63, 0x7c, 0x08, 0x25, 0x16, 0x5d, 0x40, 0x17,
+	0x53, 0x83, 0x96, 0xfe, 0x50, 0x97, 0x26, 0x60, 0x44, 0xbc, 0x34, 0x39, 0x1e, 0x92, 0xe2, 0xa5,
+	0xc5, 0x73, 0xdc, 0xed, 0x57, 0x33, 0x60, 0x8a, 0x89, 0x1e, 0x41, 0x5d, 0x4e, 0xaf, 0xa2, 0x2b,
+	0xa9, 0x87, 0x12, 0xde, 0xc5, 0x94, 0x03, 0xd1, 0xa0, 0xae, 0x61, 0x16, 0xaa, 0xa1, 0xc3, 0x66,
+	0x89, 0xeb, 0x4f, 0x1b, 0x53, 0xb8, 0x59, 0xf1, 0xb4, 0x4b, 0xba, 0x9b, 0x95, 0x9a, 0x3f, 0x6a,
+	0xdf, 0x3c, 0x4e, 0x17, 0xff, 0xbc, 0x6e, 0xfe, 0x75, 0x0d, 0x2a, 0xfe, 0x17, 0x96, 0xbe, 0xe0,
+	0xa8, 0xca, 0x0b, 0x08, 0x73, 0x7c, 0x02, 0x8b, 0x91, 0xcf, 0xb7, 0x26, 0xca, 0x9e, 0xe4, 0x4f,
+	0xbc, 0x4e, 0xbb, 0xcf, 0x8f, 0xf9, 0x9f, 0x8b, 0x08, 0x8f, 0xe7, 0x6a, 0x5a, 0xa8, 0x24, 0xea,
+	0xec, 0x4c, 0x19, 0xf8, 0x7f, 0xb7, 0x8b, 0xf1, 0x00, 0x40, 0x12, 0x62, 0x97, 0xa6, 0xe6, 0x08,
+	0xa7, 0x9d, 0xd6, 0x30, 0xd1, 0x7f, 0x78, 0x35, 0xcb, 0xcb, 0xca, 0xe9, 0x16, 0x60, 0xba, 0xd7,
+	0xf0, 0x08, 0xea, 0xf2, 0x07, 0x3d, 0x12, 0x05, 0x4e, 0xc2, 0x17, 0x3f, 0xa6, 0xed, 0x62, 0xeb,
+	0x98, 0x86, 0xe5, 0x94, 0xe1, 0x5c, 0x40, 0xf1, 0xf7, 0x15, 0x52, 0x2c, 0xa2, 0x94, 0xb7, 0x24,
+	0xda, 0x6f, 0x64, 0xc4, 0x96, 0x23, 0x66, 0xd1, 0x22, 0xfc, 0x44, 0x25, 0x95, 0xf2, 0x5a, 0x43,
+	0xfb, 0xb5, 0x4c, 0xb8, 0x62, 0xba, 0x0d, 0xa1, 0xb4, 0xce, 0x4f, 0x94, 0xce, 0xd3, 0xce, 0xea,
+	0x39, 0xa9, 0x90, 0xe7, 0xab, 0xb2, 0xd7, 0xde, 0xfe, 0xc6, 0x5b, 0x7d, 0xd3, 0xdb, 0x1f, 0xef,
+	0x91, 0x27, 0x37, 0x18, 0xea, 0x1b, 0xa6, 0xcd, 0x7f, 0xdd, 0xf0, 0x99, 0xfd, 0x06, 0xed, 0x7d,
+	0x83, 0xcc, 0x34, 0xda, 0xdb, 0x2b, 0xd1, 0xd6, 0xdb, 0xff, 0x35, 0x00, 0x39, 0x3f, 0x48, 0xb8,
+	0x1c, 0x6a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCoordClient is the client API for DataCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordClient interface {
+	GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	GetSegmentsByStates(ctx context.Context, in *GetSegmentsByStatesRequest, opts ...grpc.CallOption) (*GetSegmentsByStatesResponse, error)
+	ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
+	ManualCompactionWithScope(ctx context.Context, in *ManualCompactionWithScopeRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetCompactionPlanStates(ctx context.Context, in *GetCompactionPlanStatesRequest, opts ...grpc.CallOption) (*GetCompactionPlanStatesResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error)
+	CancelExport(ctx context.Context, in *CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ReportExport(ctx context.Context, in *ExportTaskResult, opts ...grpc.CallOption) (*commonpb.Status, error)
 	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
 	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
@@ -5476,6 +7281,69 @@ func (c *dataCoordClient) GetCompactionStateWithPlans(ctx context.Context, in *m
 	return out, nil
 }
 
+func (c *dataCoordClient) ManualCompactionWithScope(ctx context.Context, in *ManualCompactionWithScopeRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ManualCompactionWithScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CancelCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCompactionPlanStates(ctx context.Context, in *GetCompactionPlanStatesRequest, opts ...grpc.CallOption) (*GetCompactionPlanStatesResponse, error) {
+	out := new(GetCompactionPlanStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCompactionPlanStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
+	out := new(ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*GetExportStateResponse, error) {
+	out := new(GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CancelExport(ctx context.Context, in *CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CancelExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportExport(ctx context.Context, in *ExportTaskResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataCoordClient) WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error) {
 	out := new(WatchChannelsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/WatchChannels", in, out, opts...)
@@ -5625,6 +7493,13 @@ type DataCoordServer interface {
 	ManualCompaction(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionState(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	GetCompactionStateWithPlans(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	ManualCompactionWithScope(context.Context, *ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error)
+	CancelCompaction(context.Context, *CancelCompactionRequest) (*commonpb.Status, error)
+	GetCompactionPlanStates(context.Context, *GetCompactionPlanStatesRequest) (*GetCompactionPlanStatesResponse, error)
+	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*GetExportStateResponse, error)
+	CancelExport(context.Context, *CancelExportRequest) (*commonpb.Status, error)
+	ReportExport(context.Context, *ExportTaskResult) (*commonpb.Status, error)
 	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
 	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
@@ -5706,6 +7581,27 @@ func (*UnimplementedDataCoordServer) GetCompactionState(ctx context.Context, req
 func (*UnimplementedDataCoordServer) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
 }
+func (*UnimplementedDataCoordServer) ManualCompactionWithScope(ctx context.Context, req *ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompactionWithScope not implemented")
+}
+func (*UnimplementedDataCoordServer) CancelCompaction(ctx context.Context, req *CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompaction not implemented")
+}
+func (*UnimplementedDataCoordServer) GetCompactionPlanStates(ctx context.Context, req *GetCompactionPlanStatesRequest) (*GetCompactionPlanStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionPlanStates not implemented")
+}
+func (*UnimplementedDataCoordServer) Export(ctx context.Context, req *ExportRequest) (*ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedDataCoordServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
+func (*UnimplementedDataCoordServer) CancelExport(ctx context.Context, req *CancelExportRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExport not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportExport(ctx context.Context, req *ExportTaskResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportExport not implemented")
+}
 func (*UnimplementedDataCoordServer) WatchChannels(ctx context.Context, req *WatchChannelsRequest) (*WatchChannelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WatchChannels not implemented")
 }
@@ -6113,6 +8009,132 @@ func _DataCoord_GetCompactionStateWithPlans_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_ManualCompactionWithScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManualCompactionWithScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ManualCompactionWithScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ManualCompactionWithScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ManualCompactionWithScope(ctx, req.(*ManualCompactionWithScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CancelCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CancelCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CancelCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CancelCompaction(ctx, req.(*CancelCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetCompactionPlanStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionPlanStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetCompactionPlanStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetCompactionPlanStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetCompactionPlanStates(ctx, req.(*GetCompactionPlanStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetExportState(ctx, req.(*GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_CancelExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).CancelExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/CancelExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).CancelExport(ctx, req.(*CancelExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ReportExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTaskResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReportExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ReportExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReportExport(ctx, req.(*ExportTaskResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataCoord_WatchChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WatchChannelsRequest)
 	if err := dec(in); err != nil {
@@ -6449,6 +8471,34 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompactionStateWithPlans",
 			Handler:    _DataCoord_GetCompactionStateWithPlans_Handler,
 		},
+		{
+			MethodName: "ManualCompactionWithScope",
+			Handler:    _DataCoord_ManualCompactionWithScope_Handler,
+		},
+		{
+			MethodName: "CancelCompaction",
+			Handler:    _DataCoord_CancelCompaction_Handler,
+		},
+		{
+			MethodName: "GetCompactionPlanStates",
+			Handler:    _DataCoord_GetCompactionPlanStates_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _DataCoord_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _DataCoord_GetExportState_Handler,
+		},
+		{
+			MethodName: "CancelExport",
+			Handler:    _DataCoord_CancelExport_Handler,
+		},
+		{
+			MethodName: "ReportExport",
+			Handler:    _DataCoord_ReportExport_Handler,
+		},
 		{
 			MethodName: "WatchChannels",
 			Handler:    _DataCoord_WatchChannels_Handler,
@@ -6524,10 +8574,14 @@ type DataNodeClient interface {
 	Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error)
 	GetCompactionState(ctx context.Context, in *CompactionStateRequest, opts ...grpc.CallOption) (*CompactionStateResponse, error)
 	SyncSegments(ctx context.Context, in *SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	// CancelCompaction stops the plan of planID and drops its result
+	CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	ResendSegmentStats(ctx context.Context, in *ResendSegmentStatsRequest, opts ...grpc.CallOption) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(ctx context.Context, in *AddImportSegmentRequest, opts ...grpc.CallOption) (*AddImportSegmentResponse, error)
+	Export(ctx context.Context, in *ExportTask, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CancelExport(ctx context.Context, in *CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataNodeClient struct {
@@ -6619,6 +8673,15 @@ func (c *dataNodeClient) SyncSegments(ctx context.Context, in *SyncSegmentsReque
 	return out, nil
 }
 
+func (c *dataNodeClient) CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/CancelCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataNodeClient) Import(ctx context.Context, in *ImportTaskRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Import", in, out, opts...)
@@ -6646,6 +8709,24 @@ func (c *dataNodeClient) AddImportSegment(ctx context.Context, in *AddImportSegm
 	return out, nil
 }
 
+func (c *dataNodeClient) Export(ctx context.Context, in *ExportTask, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) CancelExport(ctx context.Context, in *CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/CancelExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -6658,10 +8739,14 @@ type DataNodeServer interface {
 	Compaction(context.Context, *CompactionPlan) (*commonpb.Status, error)
 	GetCompactionState(context.Context, *CompactionStateRequest) (*CompactionStateResponse, error)
 	SyncSegments(context.Context, *SyncSegmentsRequest) (*commonpb.Status, error)
+	// CancelCompaction stops the plan of planID and drops its result
+	CancelCompaction(context.Context, *CancelCompactionRequest) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+24+--+Support+bulk+load
 	Import(context.Context, *ImportTaskRequest) (*commonpb.Status, error)
 	ResendSegmentStats(context.Context, *ResendSegmentStatsRequest) (*ResendSegmentStatsResponse, error)
 	AddImportSegment(context.Context, *AddImportSegmentRequest) (*AddImportSegmentResponse, error)
+	Export(context.Context, *ExportTask) (*commonpb.Status, error)
+	CancelExport(context.Context, *CancelExportRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -6695,6 +8780,9 @@ func (*UnimplementedDataNodeServer) GetCompactionState(ctx context.Context, req
 func (*UnimplementedDataNodeServer) SyncSegments(ctx context.Context, req *SyncSegmentsRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncSegments not implemented")
 }
+func (*UnimplementedDataNodeServer) CancelCompaction(ctx context.Context, req *CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompaction not implemented")
+}
 func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTaskRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
 }
@@ -6704,6 +8792,12 @@ func (*UnimplementedDataNodeServer) ResendSegmentStats(ctx context.Context, req
 func (*UnimplementedDataNodeServer) AddImportSegment(ctx context.Context, req *AddImportSegmentRequest) (*AddImportSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddImportSegment not implemented")
 }
+func (*UnimplementedDataNodeServer) Export(ctx context.Context, req *ExportTask) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedDataNodeServer) CancelExport(ctx context.Context, req *CancelExportRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExport not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -6871,6 +8965,24 @@ func _DataNode_SyncSegments_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_CancelCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).CancelCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/CancelCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).CancelCompaction(ctx, req.(*CancelCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ImportTaskRequest)
 	if err := dec(in); err != nil {
@@ -6925,6 +9037,42 @@ func _DataNode_AddImportSegment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Export(ctx, req.(*ExportTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_CancelExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).CancelExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/CancelExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).CancelExport(ctx, req.(*CancelExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -6965,6 +9113,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SyncSegments",
 			Handler:    _DataNode_SyncSegments_Handler,
 		},
+		{
+			MethodName: "CancelCompaction",
+			Handler:    _DataNode_CancelCompaction_Handler,
+		},
 		{
 			MethodName: "Import",
 			Handler:    _DataNode_Import_Handler,
@@ -6977,6 +9129,14 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddImportSegment",
 			Handler:    _DataNode_AddImportSegment_Handler,
 		},
+		{
+			MethodName: "Export",
+			Handler:    _DataNode_Export_Handler,
+		},
+		{
+			MethodName: "CancelExport",
+			Handler:    _DataNode_CancelExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
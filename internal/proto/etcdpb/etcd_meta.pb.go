@@ -252,9 +252,11 @@ type CollectionInfo struct {
 	ConsistencyLevel           commonpb.ConsistencyLevel `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	State                      CollectionState           `protobuf:"varint,13,opt,name=state,proto3,enum=milvus.proto.etcd.CollectionState" json:"state,omitempty"`
 	Properties                 []*commonpb.KeyValuePair  `protobuf:"bytes,14,rep,name=properties,proto3" json:"properties,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                  `json:"-"`
-	XXX_unrecognized           []byte                    `json:"-"`
-	XXX_sizecache              int32                     `json:"-"`
+	// schema_version is increased every time a field is added to the collection.
+	SchemaVersion        int32    `protobuf:"varint,15,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CollectionInfo) Reset()         { *m = CollectionInfo{} }
@@ -380,6 +382,13 @@ func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
 	return nil
 }
 
+func (m *CollectionInfo) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
 type PartitionInfo struct {
 	PartitionID               int64          `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
 	PartitionName             string         `protobuf:"bytes,2,opt,name=partitionName,proto3" json:"partitionName,omitempty"`
@@ -779,69 +788,71 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 1020 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x8e, 0xdc, 0x44,
-	0x14, 0x8d, 0xdb, 0xfd, 0xf2, 0xed, 0xc7, 0x74, 0x17, 0xc9, 0xc8, 0x19, 0x12, 0x70, 0x1a, 0x02,
-	0x56, 0xa4, 0xcc, 0x88, 0x19, 0x5e, 0x1b, 0x10, 0x61, 0xac, 0x48, 0x2d, 0x20, 0x6a, 0x79, 0x46,
-	0x59, 0xb0, 0xb1, 0xaa, 0xed, 0x9a, 0xee, 0x42, 0x7e, 0xc9, 0x55, 0x3d, 0x30, 0x7f, 0xc0, 0x9f,
-	0xf0, 0x09, 0x7c, 0x01, 0x5f, 0xc3, 0x9a, 0x15, 0x1b, 0x54, 0x55, 0x7e, 0x77, 0x0f, 0x62, 0xc5,
-	0xce, 0xf7, 0x54, 0xdd, 0x5b, 0xf7, 0xdc, 0xc7, 0x31, 0x1c, 0x11, 0xee, 0x07, 0x5e, 0x44, 0x38,
-	0x3e, 0x4d, 0xb3, 0x84, 0x27, 0x68, 0x1e, 0xd1, 0xf0, 0x76, 0xc7, 0x94, 0x75, 0x2a, 0x4e, 0x4f,
-	0xc6, 0x7e, 0x12, 0x45, 0x49, 0xac, 0xa0, 0x93, 0x31, 0xf3, 0xb7, 0x24, 0xca, 0xaf, 0x2f, 0xfe,
-	0xd0, 0xc0, 0x58, 0xc6, 0x01, 0xf9, 0x65, 0x19, 0xdf, 0x24, 0xe8, 0x29, 0x00, 0x15, 0x86, 0x17,
-	0xe3, 0x88, 0x98, 0x9a, 0xa5, 0xd9, 0x86, 0x6b, 0x48, 0xe4, 0x0d, 0x8e, 0x08, 0x32, 0x61, 0x20,
-	0x8d, 0xa5, 0x63, 0x76, 0x2c, 0xcd, 0xd6, 0xdd, 0xc2, 0x44, 0x0e, 0x8c, 0x95, 0x63, 0x8a, 0x33,
-	0x1c, 0x31, 0x53, 0xb7, 0x74, 0x7b, 0x74, 0xfe, 0xec, 0xb4, 0x91, 0x4c, 0x9e, 0xc6, 0x77, 0xe4,
-	0xee, 0x2d, 0x0e, 0x77, 0x64, 0x85, 0x69, 0xe6, 0x8e, 0xa4, 0xdb, 0x4a, 0x7a, 0x89, 0xf8, 0x01,
-	0x09, 0x09, 0x27, 0x81, 0xd9, 0xb5, 0x34, 0x7b, 0xe8, 0x16, 0x26, 0x7a, 0x1f, 0x46, 0x7e, 0x46,
-	0x30, 0x27, 0x1e, 0xa7, 0x11, 0x31, 0x7b, 0x96, 0x66, 0x77, 0x5d, 0x50, 0xd0, 0x35, 0x8d, 0xc8,
-	0xc2, 0x81, 0xe9, 0x6b, 0x4a, 0xc2, 0xa0, 0xe2, 0x62, 0xc2, 0xe0, 0x86, 0x86, 0x24, 0x58, 0x3a,
-	0x92, 0x88, 0xee, 0x16, 0xe6, 0xfd, 0x34, 0x16, 0x7f, 0xf7, 0x60, 0x7a, 0x99, 0x84, 0x21, 0xf1,
-	0x39, 0x4d, 0x62, 0x19, 0x66, 0x0a, 0x9d, 0x32, 0x42, 0x67, 0xe9, 0xa0, 0xaf, 0xa0, 0xaf, 0x0a,
-	0x28, 0x7d, 0x47, 0xe7, 0xcf, 0x9b, 0x1c, 0xf3, 0xe2, 0x56, 0x41, 0xae, 0x24, 0xe0, 0xe6, 0x4e,
-	0x6d, 0x22, 0x7a, 0x9b, 0x08, 0x5a, 0xc0, 0x38, 0xc5, 0x19, 0xa7, 0x32, 0x01, 0x87, 0x99, 0x5d,
-	0x4b, 0xb7, 0x75, 0xb7, 0x81, 0xa1, 0x8f, 0x60, 0x5a, 0xda, 0xa2, 0x31, 0xcc, 0xec, 0x59, 0xba,
-	0x6d, 0xb8, 0x2d, 0x14, 0xbd, 0x86, 0xc9, 0x8d, 0x28, 0x8a, 0x27, 0xf9, 0x11, 0x66, 0xf6, 0x0f,
-	0xb5, 0x45, 0xcc, 0xc8, 0x69, 0xb3, 0x78, 0xee, 0xf8, 0xa6, 0xb4, 0x09, 0x43, 0xe7, 0xf0, 0xe8,
-	0x96, 0x66, 0x7c, 0x87, 0x43, 0xcf, 0xdf, 0xe2, 0x38, 0x26, 0xa1, 0x1c, 0x10, 0x66, 0x0e, 0xe4,
-	0xb3, 0xef, 0xe4, 0x87, 0x97, 0xea, 0x4c, 0xbd, 0xfd, 0x29, 0x1c, 0xa7, 0xdb, 0x3b, 0x46, 0xfd,
-	0x3d, 0xa7, 0xa1, 0x74, 0x7a, 0x58, 0x9c, 0x36, 0xbc, 0xbe, 0x81, 0x27, 0x25, 0x07, 0x4f, 0x55,
-	0x25, 0x90, 0x95, 0x62, 0x1c, 0x47, 0x29, 0x33, 0x0d, 0x4b, 0xb7, 0xbb, 0xee, 0x49, 0x79, 0xe7,
-	0x52, 0x5d, 0xb9, 0x2e, 0x6f, 0x88, 0x11, 0x66, 0x5b, 0x9c, 0x05, 0xcc, 0x8b, 0x77, 0x91, 0x09,
-	0x96, 0x66, 0xf7, 0x5c, 0x43, 0x21, 0x6f, 0x76, 0x11, 0x5a, 0xc2, 0x11, 0xe3, 0x38, 0xe3, 0x5e,
-	0x9a, 0x30, 0x19, 0x81, 0x99, 0x23, 0x59, 0x14, 0xeb, 0xbe, 0x59, 0x75, 0x30, 0xc7, 0x72, 0x54,
-	0xa7, 0xd2, 0x71, 0x55, 0xf8, 0x21, 0x17, 0xe6, 0x7e, 0x12, 0x33, 0xca, 0x38, 0x89, 0xfd, 0x3b,
-	0x2f, 0x24, 0xb7, 0x24, 0x34, 0xc7, 0x96, 0x66, 0x4f, 0xdb, 0x43, 0x91, 0x07, 0xbb, 0xac, 0x6e,
-	0x7f, 0x2f, 0x2e, 0xbb, 0x33, 0xbf, 0x85, 0xa0, 0x2f, 0xa1, 0xc7, 0x38, 0xe6, 0xc4, 0x9c, 0xc8,
-	0x38, 0x8b, 0x03, 0x9d, 0xaa, 0x8d, 0x96, 0xb8, 0xe9, 0x2a, 0x07, 0xf4, 0x0a, 0x20, 0xcd, 0x92,
-	0x94, 0x64, 0x9c, 0x12, 0x66, 0x4e, 0xff, 0xeb, 0xfe, 0xd5, 0x9c, 0x16, 0x7f, 0x69, 0x30, 0x59,
-	0x95, 0x73, 0x26, 0x86, 0xdf, 0x82, 0x51, 0x6d, 0xf0, 0xf2, 0x2d, 0xa8, 0x43, 0xe8, 0x43, 0x98,
-	0x34, 0x86, 0x4e, 0x6e, 0x85, 0xe1, 0x36, 0x41, 0xf4, 0x35, 0xbc, 0xfb, 0x2f, 0x6d, 0xcd, 0xb7,
-	0xe0, 0xf1, 0xbd, 0x5d, 0x45, 0x1f, 0xc0, 0xc4, 0x2f, 0x69, 0x7b, 0x54, 0xc9, 0x83, 0xee, 0x8e,
-	0x2b, 0x70, 0x19, 0xa0, 0x2f, 0x8a, 0xda, 0xf5, 0x64, 0xed, 0x0e, 0x4d, 0x79, 0xc9, 0xae, 0x5e,
-	0xba, 0xc5, 0x6f, 0x1a, 0x18, 0xaf, 0x42, 0x8a, 0x59, 0xa1, 0x81, 0x58, 0x18, 0x0d, 0x0d, 0x94,
-	0x88, 0xa4, 0xb2, 0x97, 0x4a, 0xe7, 0x40, 0x2a, 0xcf, 0x60, 0x5c, 0x67, 0x99, 0x13, 0xcc, 0x37,
-	0x5f, 0xf2, 0x42, 0x17, 0x45, 0xb6, 0x5d, 0x99, 0xed, 0xd3, 0x03, 0xd9, 0xca, 0x9c, 0x1a, 0x99,
-	0xfe, 0xda, 0x81, 0xd9, 0x15, 0xd9, 0x44, 0x24, 0xe6, 0x95, 0xd0, 0x2d, 0xa0, 0xfe, 0x78, 0xd1,
-	0xa5, 0x06, 0xd6, 0x6e, 0x64, 0x67, 0xbf, 0x91, 0x4f, 0xc0, 0x60, 0x79, 0x64, 0x47, 0xe6, 0xab,
-	0xbb, 0x15, 0xa0, 0xc4, 0x54, 0x28, 0x82, 0x93, 0x97, 0xbe, 0x30, 0xeb, 0x62, 0xda, 0x6b, 0xfe,
-	0x13, 0x4c, 0x18, 0xac, 0x77, 0x54, 0xfa, 0xf4, 0xd5, 0x49, 0x6e, 0x8a, 0xf2, 0x90, 0x18, 0xaf,
-	0x43, 0xa2, 0x84, 0xc9, 0x1c, 0x48, 0xb1, 0x1f, 0x29, 0x4c, 0x12, 0x6b, 0xeb, 0xe4, 0x70, 0x4f,
-	0xf0, 0xff, 0xd4, 0xea, 0x52, 0xfd, 0x03, 0xe1, 0xf8, 0x7f, 0x97, 0xea, 0xf7, 0x00, 0xca, 0x0a,
-	0x15, 0x42, 0x5d, 0x43, 0xd0, 0xf3, 0x9a, 0x4c, 0x7b, 0x1c, 0x6f, 0x0a, 0x99, 0xae, 0x96, 0xe3,
-	0x1a, 0x6f, 0xd8, 0x9e, 0xe2, 0xf7, 0xf7, 0x15, 0x7f, 0xf1, 0xbb, 0x60, 0x9b, 0x91, 0x80, 0xc4,
-	0x9c, 0xe2, 0x50, 0xb6, 0xfd, 0x04, 0x86, 0x3b, 0x46, 0xb2, 0xda, 0x94, 0x96, 0x36, 0x7a, 0x09,
-	0x88, 0xc4, 0x7e, 0x76, 0x97, 0x8a, 0x09, 0x4c, 0x31, 0x63, 0x3f, 0x27, 0x59, 0x90, 0xaf, 0xe6,
-	0xbc, 0x3c, 0x59, 0xe5, 0x07, 0xe8, 0x18, 0xfa, 0x9c, 0xc4, 0x38, 0xe6, 0x92, 0xa4, 0xe1, 0xe6,
-	0x16, 0x7a, 0x0c, 0x43, 0xca, 0x3c, 0xb6, 0x4b, 0x49, 0x56, 0xfc, 0x90, 0x29, 0xbb, 0x12, 0x26,
-	0xfa, 0x18, 0x8e, 0xd8, 0x16, 0x9f, 0x7f, 0xf6, 0x79, 0x15, 0xbe, 0x27, 0x7d, 0xa7, 0x0a, 0x2e,
-	0x62, 0xbf, 0x48, 0xe0, 0xa8, 0xa5, 0x58, 0xe8, 0x11, 0xcc, 0x2b, 0x28, 0xdf, 0xf5, 0xd9, 0x03,
-	0x74, 0x0c, 0xa8, 0x05, 0xd3, 0x78, 0x33, 0xd3, 0x9a, 0xb8, 0x93, 0x25, 0x69, 0x2a, 0xf0, 0x4e,
-	0x33, 0x8c, 0xc4, 0x49, 0x30, 0xd3, 0x5f, 0xfc, 0x04, 0xd3, 0xe6, 0x9a, 0xa3, 0x87, 0x30, 0x5b,
-	0xb5, 0xa4, 0x65, 0xf6, 0x40, 0xb8, 0x37, 0x51, 0xf5, 0x5a, 0x1d, 0xae, 0x3d, 0x56, 0x8f, 0x51,
-	0xbd, 0xf5, 0x16, 0xa0, 0x5a, 0x52, 0x34, 0x83, 0xb1, 0xb4, 0xaa, 0x37, 0xe6, 0x30, 0xa9, 0x10,
-	0x15, 0xbf, 0x80, 0x6a, 0xb1, 0x0b, 0xbf, 0x32, 0xee, 0xb7, 0x17, 0x3f, 0x7e, 0xb2, 0xa1, 0x7c,
-	0xbb, 0x5b, 0x0b, 0xcd, 0x3e, 0x53, 0x53, 0xfb, 0x92, 0x26, 0xf9, 0xd7, 0x19, 0x8d, 0xb9, 0x68,
-	0x74, 0x78, 0x26, 0x07, 0xf9, 0x4c, 0x88, 0x45, 0xba, 0x5e, 0xf7, 0xa5, 0x75, 0xf1, 0x4f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf9, 0x76, 0x1c, 0x4f, 0x13, 0x0a, 0x00, 0x00,
+	// 1041 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcb, 0x6e, 0x23, 0x45,
+	0x14, 0x9d, 0x76, 0xc7, 0x4e, 0x7c, 0xfd, 0x88, 0x53, 0xcc, 0x8c, 0x6a, 0xc2, 0x0c, 0xf4, 0x18,
+	0x06, 0xac, 0x91, 0x26, 0x11, 0x09, 0xaf, 0x0d, 0x88, 0x21, 0xd6, 0x48, 0x16, 0x30, 0xb2, 0x3a,
+	0x51, 0x16, 0x6c, 0x5a, 0x95, 0xee, 0x9b, 0xb8, 0x50, 0xbf, 0xd4, 0x55, 0x0e, 0xe4, 0x0f, 0xf8,
+	0x0e, 0x36, 0x7c, 0x02, 0x5f, 0xc0, 0xd7, 0xb0, 0x66, 0x8f, 0xaa, 0xaa, 0xdf, 0x76, 0x10, 0x2b,
+	0x76, 0x7d, 0x4f, 0xd5, 0xbd, 0x75, 0x1f, 0xe7, 0x9e, 0x86, 0x7d, 0x94, 0x7e, 0xe0, 0x45, 0x28,
+	0xd9, 0x51, 0x9a, 0x25, 0x32, 0x21, 0x07, 0x11, 0x0f, 0x6f, 0xd7, 0xc2, 0x58, 0x47, 0xea, 0xf4,
+	0x70, 0xe8, 0x27, 0x51, 0x94, 0xc4, 0x06, 0x3a, 0x1c, 0x0a, 0x7f, 0x85, 0x51, 0x7e, 0x7d, 0xfa,
+	0xa7, 0x05, 0xfd, 0x45, 0x1c, 0xe0, 0x2f, 0x8b, 0xf8, 0x3a, 0x21, 0xcf, 0x00, 0xb8, 0x32, 0xbc,
+	0x98, 0x45, 0x48, 0x2d, 0xc7, 0x9a, 0xf5, 0xdd, 0xbe, 0x46, 0xde, 0xb2, 0x08, 0x09, 0x85, 0x5d,
+	0x6d, 0x2c, 0xe6, 0xb4, 0xe3, 0x58, 0x33, 0xdb, 0x2d, 0x4c, 0x32, 0x87, 0xa1, 0x71, 0x4c, 0x59,
+	0xc6, 0x22, 0x41, 0x6d, 0xc7, 0x9e, 0x0d, 0x4e, 0x9e, 0x1f, 0x35, 0x92, 0xc9, 0xd3, 0xf8, 0x0e,
+	0xef, 0x2e, 0x59, 0xb8, 0xc6, 0x25, 0xe3, 0x99, 0x3b, 0xd0, 0x6e, 0x4b, 0xed, 0xa5, 0xe2, 0x07,
+	0x18, 0xa2, 0xc4, 0x80, 0xee, 0x38, 0xd6, 0x6c, 0xcf, 0x2d, 0x4c, 0xf2, 0x3e, 0x0c, 0xfc, 0x0c,
+	0x99, 0x44, 0x4f, 0xf2, 0x08, 0x69, 0xd7, 0xb1, 0x66, 0x3b, 0x2e, 0x18, 0xe8, 0x82, 0x47, 0x38,
+	0x9d, 0xc3, 0xf8, 0x0d, 0xc7, 0x30, 0xa8, 0x6a, 0xa1, 0xb0, 0x7b, 0xcd, 0x43, 0x0c, 0x16, 0x73,
+	0x5d, 0x88, 0xed, 0x16, 0xe6, 0xfd, 0x65, 0x4c, 0x7f, 0xeb, 0xc1, 0xf8, 0x2c, 0x09, 0x43, 0xf4,
+	0x25, 0x4f, 0x62, 0x1d, 0x66, 0x0c, 0x9d, 0x32, 0x42, 0x67, 0x31, 0x27, 0x5f, 0x41, 0xcf, 0x34,
+	0x50, 0xfb, 0x0e, 0x4e, 0x5e, 0x34, 0x6b, 0xcc, 0x9b, 0x5b, 0x05, 0x39, 0xd7, 0x80, 0x9b, 0x3b,
+	0xb5, 0x0b, 0xb1, 0xdb, 0x85, 0x90, 0x29, 0x0c, 0x53, 0x96, 0x49, 0xae, 0x13, 0x98, 0x0b, 0xba,
+	0xe3, 0xd8, 0x33, 0xdb, 0x6d, 0x60, 0xe4, 0x23, 0x18, 0x97, 0xb6, 0x1a, 0x8c, 0xa0, 0x5d, 0xc7,
+	0x9e, 0xf5, 0xdd, 0x16, 0x4a, 0xde, 0xc0, 0xe8, 0x5a, 0x35, 0xc5, 0xd3, 0xf5, 0xa1, 0xa0, 0xbd,
+	0x6d, 0x63, 0x51, 0x1c, 0x39, 0x6a, 0x36, 0xcf, 0x1d, 0x5e, 0x97, 0x36, 0x0a, 0x72, 0x02, 0x8f,
+	0x6e, 0x79, 0x26, 0xd7, 0x2c, 0xf4, 0xfc, 0x15, 0x8b, 0x63, 0x0c, 0x35, 0x41, 0x04, 0xdd, 0xd5,
+	0xcf, 0xbe, 0x93, 0x1f, 0x9e, 0x99, 0x33, 0xf3, 0xf6, 0xa7, 0xf0, 0x38, 0x5d, 0xdd, 0x09, 0xee,
+	0x6f, 0x38, 0xed, 0x69, 0xa7, 0x87, 0xc5, 0x69, 0xc3, 0xeb, 0x1b, 0x78, 0x5a, 0xd6, 0xe0, 0x99,
+	0xae, 0x04, 0xba, 0x53, 0x42, 0xb2, 0x28, 0x15, 0xb4, 0xef, 0xd8, 0xb3, 0x1d, 0xf7, 0xb0, 0xbc,
+	0x73, 0x66, 0xae, 0x5c, 0x94, 0x37, 0x14, 0x85, 0xc5, 0x8a, 0x65, 0x81, 0xf0, 0xe2, 0x75, 0x44,
+	0xc1, 0xb1, 0x66, 0x5d, 0xb7, 0x6f, 0x90, 0xb7, 0xeb, 0x88, 0x2c, 0x60, 0x5f, 0x48, 0x96, 0x49,
+	0x2f, 0x4d, 0x84, 0x8e, 0x20, 0xe8, 0x40, 0x37, 0xc5, 0xb9, 0x8f, 0xab, 0x73, 0x26, 0x99, 0xa6,
+	0xea, 0x58, 0x3b, 0x2e, 0x0b, 0x3f, 0xe2, 0xc2, 0x81, 0x9f, 0xc4, 0x82, 0x0b, 0x89, 0xb1, 0x7f,
+	0xe7, 0x85, 0x78, 0x8b, 0x21, 0x1d, 0x3a, 0xd6, 0x6c, 0xdc, 0x26, 0x45, 0x1e, 0xec, 0xac, 0xba,
+	0xfd, 0xbd, 0xba, 0xec, 0x4e, 0xfc, 0x16, 0x42, 0xbe, 0x84, 0xae, 0x90, 0x4c, 0x22, 0x1d, 0xe9,
+	0x38, 0xd3, 0x2d, 0x93, 0xaa, 0x51, 0x4b, 0xdd, 0x74, 0x8d, 0x03, 0x79, 0x0d, 0x90, 0x66, 0x49,
+	0x8a, 0x99, 0xe4, 0x28, 0xe8, 0xf8, 0xbf, 0xee, 0x5f, 0xcd, 0x89, 0xbc, 0x80, 0xb1, 0x61, 0xa9,
+	0x77, 0x8b, 0x99, 0xe0, 0x49, 0x4c, 0xf7, 0x75, 0xfb, 0x46, 0x06, 0xbd, 0x34, 0xe0, 0xf4, 0x6f,
+	0x0b, 0x46, 0xcb, 0x92, 0x8e, 0x6a, 0x47, 0x1c, 0x18, 0xd4, 0xf8, 0x99, 0x2f, 0x4b, 0x1d, 0x22,
+	0x1f, 0xc2, 0xa8, 0xc1, 0x4d, 0xbd, 0x3c, 0x7d, 0xb7, 0x09, 0x92, 0xaf, 0xe1, 0xdd, 0x7f, 0x99,
+	0x7e, 0xbe, 0x2c, 0x4f, 0xee, 0x1d, 0x3e, 0xf9, 0x00, 0x46, 0x7e, 0xd9, 0x1d, 0x8f, 0x1b, 0x15,
+	0xb1, 0xdd, 0x61, 0x05, 0x2e, 0x02, 0xf2, 0x45, 0xd1, 0xe2, 0xae, 0x6e, 0xf1, 0xb6, 0x65, 0x28,
+	0xab, 0xab, 0x77, 0x78, 0xfa, 0xbb, 0x05, 0xfd, 0xd7, 0x21, 0x67, 0xa2, 0x90, 0x4a, 0xa6, 0x8c,
+	0x86, 0x54, 0x6a, 0x44, 0x97, 0xb2, 0x91, 0x4a, 0x67, 0x4b, 0x2a, 0xcf, 0x61, 0x58, 0xaf, 0x32,
+	0x2f, 0x30, 0x17, 0x08, 0x5d, 0x17, 0x39, 0x2d, 0xb2, 0xdd, 0xd1, 0xd9, 0x3e, 0xdb, 0x92, 0xad,
+	0xce, 0xa9, 0x91, 0xe9, 0xaf, 0x1d, 0x98, 0x9c, 0xe3, 0x4d, 0x84, 0xb1, 0xac, 0xf4, 0x70, 0x0a,
+	0xf5, 0xc7, 0x8b, 0x29, 0x35, 0xb0, 0xf6, 0x20, 0x3b, 0x9b, 0x83, 0x7c, 0x0a, 0x7d, 0x91, 0x47,
+	0x9e, 0xeb, 0x7c, 0x6d, 0xb7, 0x02, 0x8c, 0xe6, 0x2a, 0xe1, 0x98, 0xe7, 0xad, 0x2f, 0xcc, 0xba,
+	0xe6, 0x76, 0x9b, 0xbf, 0x0e, 0x0a, 0xbb, 0x57, 0x6b, 0xae, 0x7d, 0x7a, 0xe6, 0x24, 0x37, 0x55,
+	0x7b, 0x30, 0x66, 0x57, 0x21, 0x1a, 0xfd, 0xa2, 0xbb, 0xfa, 0x9f, 0x30, 0x30, 0x98, 0x2e, 0xac,
+	0x2d, 0xa7, 0x7b, 0x1b, 0xff, 0x85, 0xbf, 0xac, 0xba, 0xa2, 0xff, 0x80, 0x92, 0xfd, 0xef, 0x8a,
+	0xfe, 0x1e, 0x40, 0xd9, 0xa1, 0x42, 0xcf, 0x6b, 0x88, 0x5a, 0xbb, 0x8a, 0xf5, 0x92, 0xdd, 0x14,
+	0x6a, 0x5e, 0x2d, 0xc7, 0x05, 0xbb, 0x11, 0x1b, 0x3f, 0x86, 0xde, 0xe6, 0x8f, 0x61, 0xfa, 0x87,
+	0xaa, 0x36, 0xc3, 0x00, 0x63, 0xc9, 0x59, 0xa8, 0xc7, 0x7e, 0x08, 0x7b, 0x6b, 0x81, 0x59, 0x8d,
+	0xa5, 0xa5, 0x4d, 0x5e, 0x01, 0xc1, 0xd8, 0xcf, 0xee, 0x52, 0xc5, 0xc0, 0x94, 0x09, 0xf1, 0x73,
+	0x92, 0x05, 0xf9, 0x6a, 0x1e, 0x94, 0x27, 0xcb, 0xfc, 0x80, 0x3c, 0x86, 0x9e, 0xc4, 0x98, 0xc5,
+	0x52, 0x17, 0xd9, 0x77, 0x73, 0x8b, 0x3c, 0x81, 0x3d, 0x2e, 0x3c, 0xb1, 0x4e, 0x31, 0x2b, 0xfe,
+	0xdb, 0x5c, 0x9c, 0x2b, 0x93, 0x7c, 0x0c, 0xfb, 0x62, 0xc5, 0x4e, 0x3e, 0xfb, 0xbc, 0x0a, 0xdf,
+	0xd5, 0xbe, 0x63, 0x03, 0x17, 0xb1, 0x5f, 0x26, 0xb0, 0xdf, 0x12, 0x36, 0xf2, 0x08, 0x0e, 0x2a,
+	0x28, 0xdf, 0xf5, 0xc9, 0x03, 0xf2, 0x18, 0x48, 0x0b, 0xe6, 0xf1, 0xcd, 0xc4, 0x6a, 0xe2, 0xf3,
+	0x2c, 0x49, 0x53, 0x85, 0x77, 0x9a, 0x61, 0x34, 0x8e, 0xc1, 0xc4, 0x7e, 0xf9, 0x13, 0x8c, 0x9b,
+	0x6b, 0x4e, 0x1e, 0xc2, 0x64, 0xd9, 0x92, 0x96, 0xc9, 0x03, 0xe5, 0xde, 0x44, 0xcd, 0x6b, 0x75,
+	0xb8, 0xf6, 0x58, 0x3d, 0x46, 0xf5, 0xd6, 0x25, 0x40, 0xb5, 0xa4, 0x64, 0x02, 0x43, 0x6d, 0x55,
+	0x6f, 0x1c, 0xc0, 0xa8, 0x42, 0x4c, 0xfc, 0x02, 0xaa, 0xc5, 0x2e, 0xfc, 0xca, 0xb8, 0xdf, 0x9e,
+	0xfe, 0xf8, 0xc9, 0x0d, 0x97, 0xab, 0xf5, 0x95, 0x92, 0xf6, 0x63, 0xc3, 0xda, 0x57, 0x3c, 0xc9,
+	0xbf, 0x8e, 0x79, 0x2c, 0xd5, 0xa0, 0xc3, 0x63, 0x4d, 0xe4, 0x63, 0x25, 0x16, 0xe9, 0xd5, 0x55,
+	0x4f, 0x5b, 0xa7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x85, 0x21, 0xeb, 0x3a, 0x0a, 0x00,
+	0x00,
 }
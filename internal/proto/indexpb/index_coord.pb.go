@@ -1364,6 +1364,9 @@ type StorageConfig struct {
 	UseIAM               bool     `protobuf:"varint,7,opt,name=useIAM,proto3" json:"useIAM,omitempty"`
 	IAMEndpoint          string   `protobuf:"bytes,8,opt,name=IAMEndpoint,proto3" json:"IAMEndpoint,omitempty"`
 	StorageType          string   `protobuf:"bytes,9,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	AccountName          string   `protobuf:"bytes,10,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	ContainerName        string   `protobuf:"bytes,11,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	ClientID             string   `protobuf:"bytes,12,opt,name=clientID,proto3" json:"clientID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1457,21 +1460,44 @@ func (m *StorageConfig) GetStorageType() string {
 	return ""
 }
 
+func (m *StorageConfig) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
+}
+
+func (m *StorageConfig) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *StorageConfig) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
 type CreateJobRequest struct {
-	ClusterID            string                   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
-	IndexFilePrefix      string                   `protobuf:"bytes,2,opt,name=index_file_prefix,json=indexFilePrefix,proto3" json:"index_file_prefix,omitempty"`
-	BuildID              int64                    `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	DataPaths            []string                 `protobuf:"bytes,4,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
-	IndexVersion         int64                    `protobuf:"varint,5,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
-	IndexID              int64                    `protobuf:"varint,6,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	IndexName            string                   `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	StorageConfig        *StorageConfig           `protobuf:"bytes,8,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
-	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
-	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,10,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
-	NumRows              int64                    `protobuf:"varint,11,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	ClusterID       string                   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
+	IndexFilePrefix string                   `protobuf:"bytes,2,opt,name=index_file_prefix,json=indexFilePrefix,proto3" json:"index_file_prefix,omitempty"`
+	BuildID         int64                    `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	DataPaths       []string                 `protobuf:"bytes,4,rep,name=data_paths,json=dataPaths,proto3" json:"data_paths,omitempty"`
+	IndexVersion    int64                    `protobuf:"varint,5,opt,name=index_version,json=indexVersion,proto3" json:"index_version,omitempty"`
+	IndexID         int64                    `protobuf:"varint,6,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	IndexName       string                   `protobuf:"bytes,7,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	StorageConfig   *StorageConfig           `protobuf:"bytes,8,opt,name=storage_config,json=storageConfig,proto3" json:"storage_config,omitempty"`
+	IndexParams     []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
+	TypeParams      []*commonpb.KeyValuePair `protobuf:"bytes,10,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	NumRows         int64                    `protobuf:"varint,11,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	// the field to build index on, required to pick the field from columnar logs of segment format v2
+	FieldID              int64    `protobuf:"varint,12,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateJobRequest) Reset()         { *m = CreateJobRequest{} }
@@ -1576,6 +1602,13 @@ func (m *CreateJobRequest) GetNumRows() int64 {
 	return 0
 }
 
+func (m *CreateJobRequest) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
 type QueryJobsRequest struct {
 	ClusterID            string   `protobuf:"bytes,1,opt,name=clusterID,proto3" json:"clusterID,omitempty"`
 	BuildIDs             []int64  `protobuf:"varint,2,rep,packed,name=buildIDs,proto3" json:"buildIDs,omitempty"`
@@ -2029,144 +2062,146 @@ func init() {
 func init() { proto.RegisterFile("index_coord.proto", fileDescriptor_f9e019eb3fda53c2) }
 
 var fileDescriptor_f9e019eb3fda53c2 = []byte{
-	// 2184 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x8e, 0xdb, 0xc8,
-	0xf1, 0x37, 0x45, 0xcd, 0x8c, 0x58, 0x94, 0xe6, 0xa3, 0xed, 0xfd, 0xff, 0x65, 0xd9, 0x8e, 0xc7,
-	0xf4, 0xda, 0x56, 0x02, 0xec, 0xd8, 0x99, 0xcd, 0x06, 0x9b, 0x20, 0x09, 0x30, 0x9e, 0x59, 0xdb,
-	0xb2, 0xd7, 0xc6, 0x84, 0x32, 0x16, 0xc8, 0x22, 0x80, 0x42, 0x89, 0xad, 0x99, 0xde, 0xa1, 0xd8,
-	0x32, 0xbb, 0x69, 0x7b, 0x1c, 0x20, 0xc8, 0x65, 0x0f, 0x59, 0x2c, 0x10, 0x20, 0x08, 0x92, 0x17,
-	0xc8, 0x69, 0x73, 0xc8, 0x3d, 0x97, 0xbc, 0x40, 0x4e, 0x79, 0x84, 0xbc, 0x44, 0xae, 0x41, 0x7f,
-	0x90, 0x22, 0x29, 0x6a, 0xa4, 0xf9, 0xc8, 0x25, 0xb9, 0xa9, 0x8b, 0xd5, 0x5f, 0x55, 0xbf, 0xaa,
-	0xfa, 0x55, 0x0b, 0x36, 0x48, 0xe8, 0xe3, 0xb7, 0xbd, 0x01, 0xa5, 0x91, 0xbf, 0x35, 0x8e, 0x28,
-	0xa7, 0x08, 0x8d, 0x48, 0xf0, 0x3a, 0x66, 0x6a, 0xb4, 0x25, 0xbf, 0xb7, 0xea, 0x03, 0x3a, 0x1a,
-	0xd1, 0x50, 0xc9, 0x5a, 0xab, 0x24, 0xe4, 0x38, 0x0a, 0xbd, 0x40, 0x8f, 0xeb, 0xd9, 0x19, 0xce,
-	0x5f, 0xaa, 0x60, 0x75, 0xc4, 0xac, 0x4e, 0x38, 0xa4, 0xc8, 0x81, 0xfa, 0x80, 0x06, 0x01, 0x1e,
-	0x70, 0x42, 0xc3, 0xce, 0x5e, 0xd3, 0xd8, 0x34, 0xda, 0xa6, 0x9b, 0x93, 0xa1, 0x26, 0xac, 0x0c,
-	0x09, 0x0e, 0xfc, 0xce, 0x5e, 0xb3, 0x22, 0x3f, 0x27, 0x43, 0x74, 0x03, 0x40, 0x1d, 0x30, 0xf4,
-	0x46, 0xb8, 0x69, 0x6e, 0x1a, 0x6d, 0xcb, 0xb5, 0xa4, 0xe4, 0x85, 0x37, 0xc2, 0x62, 0xa2, 0x1c,
-	0x74, 0xf6, 0x9a, 0x55, 0x35, 0x51, 0x0f, 0xd1, 0x43, 0xb0, 0xf9, 0xf1, 0x18, 0xf7, 0xc6, 0x5e,
-	0xe4, 0x8d, 0x58, 0x73, 0x69, 0xd3, 0x6c, 0xdb, 0xdb, 0xb7, 0xb6, 0x72, 0x57, 0xd3, 0x77, 0x7a,
-	0x86, 0x8f, 0x3f, 0xf3, 0x82, 0x18, 0xef, 0x7b, 0x24, 0x72, 0x41, 0xcc, 0xda, 0x97, 0x93, 0xd0,
-	0x1e, 0xd4, 0xd5, 0xe6, 0x7a, 0x91, 0xe5, 0x45, 0x17, 0xb1, 0xe5, 0x34, 0xbd, 0xca, 0x2d, 0xbd,
-	0x0a, 0xf6, 0x7b, 0x11, 0x7d, 0xc3, 0x9a, 0x2b, 0xf2, 0xa0, 0xb6, 0x96, 0xb9, 0xf4, 0x0d, 0x13,
-	0xb7, 0xe4, 0x94, 0x7b, 0x81, 0x52, 0xa8, 0x49, 0x05, 0x4b, 0x4a, 0xe4, 0xe7, 0x8f, 0x60, 0x89,
-	0x71, 0x8f, 0xe3, 0xa6, 0xb5, 0x69, 0xb4, 0x57, 0xb7, 0x6f, 0x96, 0x1e, 0x40, 0x5a, 0xbc, 0x2b,
-	0xd4, 0x5c, 0xa5, 0x8d, 0x3e, 0x82, 0xff, 0x57, 0xc7, 0x97, 0xc3, 0xde, 0xd0, 0x23, 0x41, 0x2f,
-	0xc2, 0x1e, 0xa3, 0x61, 0x13, 0xa4, 0x21, 0xaf, 0x90, 0x74, 0xce, 0x23, 0x8f, 0x04, 0xae, 0xfc,
-	0x86, 0x1c, 0x68, 0x10, 0xd6, 0xf3, 0x62, 0x4e, 0x7b, 0xf2, 0x7b, 0xd3, 0xde, 0x34, 0xda, 0x35,
-	0xd7, 0x26, 0x6c, 0x27, 0xe6, 0x54, 0x6e, 0x83, 0x9e, 0xc3, 0x46, 0xcc, 0x70, 0xd4, 0xcb, 0x99,
-	0xa7, 0xbe, 0xa8, 0x79, 0xd6, 0xc4, 0xdc, 0xce, 0xc4, 0x44, 0xce, 0x97, 0x06, 0xc0, 0x23, 0xe9,
-	0x71, 0xb9, 0xfa, 0x8f, 0x12, 0xa7, 0x93, 0x70, 0x48, 0x25, 0x60, 0xec, 0xed, 0x1b, 0x5b, 0xd3,
-	0xa8, 0xdc, 0x4a, 0x51, 0xa6, 0x31, 0x21, 0x01, 0xd7, 0x84, 0x15, 0x1f, 0x07, 0x98, 0x63, 0x5f,
-	0x82, 0xa9, 0xe6, 0x26, 0x43, 0x74, 0x13, 0xec, 0x41, 0x84, 0x85, 0x2d, 0x38, 0xd1, 0x68, 0xaa,
-	0xba, 0xa0, 0x44, 0x2f, 0xc9, 0x08, 0x3b, 0x5f, 0x56, 0xa1, 0xde, 0xc5, 0x07, 0x23, 0x1c, 0x72,
-	0x75, 0x92, 0x45, 0xc0, 0xbb, 0x09, 0xf6, 0xd8, 0x8b, 0x38, 0xd1, 0x2a, 0x0a, 0xc0, 0x59, 0x11,
-	0xba, 0x0e, 0x16, 0xd3, 0xab, 0xee, 0xc9, 0x5d, 0x4d, 0x77, 0x22, 0x40, 0x57, 0xa1, 0x16, 0xc6,
-	0x23, 0xe5, 0x7a, 0x0d, 0xe2, 0x30, 0x1e, 0x49, 0xc7, 0x67, 0xe0, 0xbd, 0x94, 0x87, 0x77, 0x13,
-	0x56, 0xfa, 0x31, 0x91, 0x11, 0xb3, 0xac, 0xbe, 0xe8, 0x21, 0xfa, 0x3f, 0x58, 0x0e, 0xa9, 0x8f,
-	0x3b, 0x7b, 0x1a, 0x68, 0x7a, 0x84, 0x6e, 0x43, 0x43, 0x19, 0xf5, 0x35, 0x8e, 0x18, 0xa1, 0xa1,
-	0x86, 0x99, 0xc2, 0xe6, 0x67, 0x4a, 0x76, 0x56, 0xa4, 0xdd, 0x04, 0x7b, 0x1a, 0x5d, 0x30, 0x9c,
-	0x60, 0xea, 0x2e, 0xac, 0xa9, 0xcd, 0x87, 0x24, 0xc0, 0xbd, 0x23, 0x7c, 0xcc, 0x9a, 0xf6, 0xa6,
-	0xd9, 0xb6, 0x5c, 0x75, 0xa6, 0x47, 0x24, 0xc0, 0xcf, 0xf0, 0x31, 0xcb, 0xfa, 0xae, 0x7e, 0xa2,
-	0xef, 0x1a, 0x45, 0xdf, 0xa1, 0x3b, 0xb0, 0xca, 0x70, 0x44, 0xbc, 0x80, 0xbc, 0xc3, 0x3d, 0x46,
-	0xde, 0xe1, 0xe6, 0xaa, 0xd4, 0x69, 0xa4, 0xd2, 0x2e, 0x79, 0x87, 0x85, 0x19, 0xde, 0x44, 0x84,
-	0xe3, 0xde, 0xa1, 0x17, 0xfa, 0x74, 0x38, 0x6c, 0xae, 0xc9, 0x7d, 0xea, 0x52, 0xf8, 0x44, 0xc9,
-	0x9c, 0x3f, 0x1a, 0x70, 0xd9, 0xc5, 0x07, 0x84, 0x71, 0x1c, 0xbd, 0xa0, 0x3e, 0x76, 0xf1, 0xab,
-	0x18, 0x33, 0x8e, 0x1e, 0x40, 0xb5, 0xef, 0x31, 0xac, 0x21, 0x79, 0xbd, 0xd4, 0x3a, 0xcf, 0xd9,
-	0xc1, 0x43, 0x8f, 0x61, 0x57, 0x6a, 0xa2, 0xef, 0xc3, 0x8a, 0xe7, 0xfb, 0x11, 0x66, 0x4c, 0x02,
-	0x63, 0xd6, 0xa4, 0x1d, 0xa5, 0xe3, 0x26, 0xca, 0x19, 0x2f, 0x9a, 0x59, 0x2f, 0x3a, 0xbf, 0x35,
-	0xe0, 0x4a, 0xfe, 0x64, 0x6c, 0x4c, 0x43, 0x86, 0xd1, 0x87, 0xb0, 0x2c, 0x7c, 0x11, 0x33, 0x7d,
-	0xb8, 0x6b, 0xa5, 0xfb, 0x74, 0xa5, 0x8a, 0xab, 0x55, 0x45, 0x92, 0x24, 0x21, 0xe1, 0x49, 0x00,
-	0xab, 0x13, 0xde, 0x2a, 0x46, 0x9a, 0x4e, 0xf5, 0x9d, 0x90, 0x70, 0x15, 0xaf, 0x2e, 0x90, 0xf4,
-	0xb7, 0xf3, 0x33, 0xb8, 0xf2, 0x18, 0xf3, 0x0c, 0x26, 0xb4, 0xad, 0x16, 0x09, 0x9d, 0x7c, 0x76,
-	0xaf, 0x14, 0xb2, 0xbb, 0xf3, 0x27, 0x03, 0xde, 0x2b, 0xac, 0x7d, 0x9e, 0xdb, 0xa6, 0xe0, 0xae,
-	0x9c, 0x07, 0xdc, 0x66, 0x11, 0xdc, 0xce, 0xaf, 0x0d, 0xb8, 0xf6, 0x18, 0xf3, 0x6c, 0xe2, 0xb8,
-	0x60, 0x4b, 0xa0, 0x6f, 0x01, 0xa4, 0x09, 0x83, 0x35, 0xcd, 0x4d, 0xb3, 0x6d, 0xba, 0x19, 0x89,
-	0xf3, 0x1b, 0x03, 0x36, 0xa6, 0xf6, 0xcf, 0xe7, 0x1d, 0xa3, 0x98, 0x77, 0xfe, 0x53, 0xe6, 0xf8,
-	0x9d, 0x01, 0xd7, 0xcb, 0xcd, 0x71, 0x1e, 0xe7, 0xfd, 0x58, 0x4d, 0xc2, 0x02, 0xa5, 0xa2, 0xcc,
-	0xdc, 0x29, 0xab, 0x07, 0xd3, 0x7b, 0xea, 0x49, 0xce, 0xd7, 0x26, 0xa0, 0x5d, 0x99, 0x2c, 0xe4,
-	0xc7, 0xd3, 0xb8, 0xe6, 0xcc, 0xe4, 0xa4, 0x40, 0x41, 0xaa, 0x17, 0x41, 0x41, 0x96, 0xce, 0x44,
-	0x41, 0xae, 0x83, 0x25, 0xb2, 0x26, 0xe3, 0xde, 0x68, 0x2c, 0xeb, 0x45, 0xd5, 0x9d, 0x08, 0xa6,
-	0x0b, 0xfe, 0xca, 0x82, 0x05, 0xbf, 0x76, 0xe6, 0x82, 0xff, 0x16, 0x2e, 0x27, 0x81, 0x2d, 0xcb,
-	0xf7, 0x29, 0xdc, 0x91, 0x0f, 0x85, 0x4a, 0x31, 0x14, 0xe6, 0x38, 0xc5, 0xf9, 0x57, 0x05, 0x36,
-	0x3a, 0x49, 0xcd, 0xd9, 0xf7, 0xf8, 0xa1, 0xe4, 0x0c, 0x27, 0x47, 0xca, 0x6c, 0x04, 0x64, 0x0a,
-	0xb4, 0x39, 0xb3, 0x40, 0x57, 0xf3, 0x05, 0x3a, 0x7f, 0xc0, 0xa5, 0x22, 0x6a, 0x2e, 0x86, 0x74,
-	0xb6, 0x61, 0x3d, 0x53, 0x70, 0xc7, 0x1e, 0x3f, 0x14, 0xc4, 0x53, 0x54, 0xdc, 0x55, 0x92, 0xbd,
-	0x3d, 0x43, 0xf7, 0x60, 0x2d, 0xad, 0x90, 0xbe, 0x2a, 0x9c, 0x35, 0x89, 0x90, 0x49, 0x39, 0xf5,
-	0x93, 0xca, 0x99, 0x27, 0x10, 0x56, 0x09, 0x81, 0xc8, 0x92, 0x19, 0xc8, 0x91, 0x19, 0xe7, 0xaf,
-	0x06, 0xd8, 0x69, 0x80, 0x2e, 0xd8, 0x18, 0xe4, 0xfc, 0x52, 0x29, 0xfa, 0xe5, 0x16, 0xd4, 0x71,
-	0xe8, 0xf5, 0x03, 0xac, 0x71, 0x6b, 0x2a, 0xdc, 0x2a, 0x99, 0xc2, 0xed, 0x23, 0xb0, 0x27, 0x54,
-	0x32, 0x89, 0xc1, 0x3b, 0x33, 0xb9, 0x64, 0x16, 0x14, 0x2e, 0xa4, 0x9c, 0x92, 0x39, 0x5f, 0x55,
-	0x26, 0x65, 0x4e, 0x21, 0xf6, 0x3c, 0xc9, 0xec, 0xe7, 0x50, 0xd7, 0xb7, 0x50, 0x14, 0x57, 0xa5,
-	0xb4, 0x1f, 0x94, 0x1d, 0xab, 0x6c, 0xd3, 0xad, 0x8c, 0x19, 0x3f, 0x09, 0x79, 0x74, 0xec, 0xda,
-	0x6c, 0x22, 0x69, 0xf5, 0x60, 0xbd, 0xa8, 0x80, 0xd6, 0xc1, 0x3c, 0xc2, 0xc7, 0xda, 0xc6, 0xe2,
-	0xa7, 0x48, 0xff, 0xaf, 0x05, 0x76, 0x74, 0xd5, 0xbf, 0x79, 0x62, 0x3e, 0x1d, 0x52, 0x57, 0x69,
-	0xff, 0xb0, 0xf2, 0xb1, 0xe1, 0xfc, 0xde, 0x80, 0xf5, 0xbd, 0x88, 0x8e, 0x4f, 0x9d, 0x4a, 0x1d,
-	0xa8, 0x67, 0x78, 0x71, 0x12, 0xbd, 0x39, 0xd9, 0xbc, 0xa4, 0x7a, 0x15, 0x6a, 0x7e, 0x44, 0xc7,
-	0x3d, 0x2f, 0x08, 0x64, 0x60, 0x09, 0x8a, 0x18, 0xd1, 0xf1, 0x4e, 0x10, 0x08, 0x26, 0xb2, 0x87,
-	0xd9, 0x20, 0x22, 0xfd, 0xd3, 0x27, 0xf9, 0x39, 0x4c, 0xe4, 0x6b, 0x03, 0xde, 0x2b, 0xac, 0x7d,
-	0x1e, 0xff, 0xff, 0x24, 0x8f, 0x4a, 0xe5, 0xfe, 0x39, 0x1d, 0x4e, 0x16, 0x8d, 0x9e, 0xac, 0xb0,
-	0xf2, 0xdb, 0x43, 0x91, 0x55, 0xf6, 0x23, 0x7a, 0x20, 0xf9, 0xe3, 0xc5, 0xdd, 0xf8, 0x0f, 0x06,
-	0xdc, 0x98, 0xb1, 0xc7, 0x79, 0x6e, 0x5e, 0x6c, 0x86, 0x2b, 0xf3, 0x9a, 0x61, 0xb3, 0xd0, 0x0c,
-	0x3b, 0x7f, 0xae, 0x40, 0xa3, 0xcb, 0x69, 0xe4, 0x1d, 0xe0, 0x5d, 0x1a, 0x0e, 0xc9, 0x81, 0x48,
-	0xb5, 0x09, 0xc7, 0x36, 0xe4, 0x35, 0x52, 0x16, 0x7d, 0x0b, 0xea, 0xde, 0x60, 0x80, 0x19, 0x13,
-	0x2d, 0x87, 0xce, 0x20, 0x96, 0x6b, 0x2b, 0xd9, 0x33, 0x21, 0x42, 0xdf, 0x81, 0x0d, 0x86, 0x07,
-	0x11, 0xe6, 0xbd, 0x89, 0xa6, 0x46, 0xdd, 0x9a, 0xfa, 0xb0, 0x93, 0x68, 0x0b, 0x52, 0x1e, 0x33,
-	0xdc, 0xed, 0x7e, 0xaa, 0x91, 0xa7, 0x47, 0x82, 0x12, 0xf5, 0xe3, 0xc1, 0x11, 0xe6, 0xd9, 0x94,
-	0x0e, 0x4a, 0x24, 0x41, 0x7b, 0x0d, 0xac, 0x88, 0x52, 0x2e, 0xf3, 0xb0, 0xac, 0xbf, 0x96, 0x5b,
-	0x13, 0x02, 0x91, 0x6a, 0xf4, 0xaa, 0x9d, 0x9d, 0xe7, 0xba, 0xee, 0xea, 0x91, 0xe8, 0x2b, 0x3b,
-	0x3b, 0xcf, 0x3f, 0x09, 0xfd, 0x31, 0x25, 0x21, 0x97, 0x49, 0xd9, 0x72, 0xb3, 0x22, 0x71, 0x3d,
-	0xa6, 0x2c, 0xd1, 0x13, 0x94, 0x41, 0x26, 0x64, 0xcb, 0xb5, 0xb5, 0xec, 0xe5, 0xf1, 0x18, 0x3b,
-	0xff, 0x34, 0x61, 0x5d, 0xf1, 0x9e, 0xa7, 0xb4, 0x9f, 0xc0, 0xe3, 0x3a, 0x58, 0x83, 0x20, 0x16,
-	0x2d, 0x84, 0xc6, 0x86, 0xe5, 0x4e, 0x04, 0xc2, 0x22, 0xd9, 0xd2, 0x11, 0xe1, 0x21, 0x79, 0xab,
-	0x2d, 0xb7, 0x36, 0xa9, 0x1d, 0x52, 0x9c, 0xad, 0x72, 0xe6, 0x54, 0x95, 0xf3, 0x3d, 0xee, 0xe9,
-	0xd2, 0x53, 0x95, 0xa5, 0xc7, 0x12, 0x12, 0x55, 0x75, 0xa6, 0x8a, 0xc9, 0x52, 0x49, 0x31, 0xc9,
-	0x54, 0xd7, 0xe5, 0x7c, 0x75, 0xcd, 0x83, 0x77, 0xa5, 0x98, 0x24, 0x9e, 0xc0, 0x6a, 0x62, 0x98,
-	0x81, 0xc4, 0x88, 0xb4, 0x5e, 0x49, 0x6b, 0x23, 0x93, 0x5c, 0x16, 0x4c, 0x6e, 0x83, 0xe5, 0xb0,
-	0x55, 0xac, 0xc6, 0xd6, 0x99, 0xaa, 0x71, 0x81, 0x09, 0xc2, 0x59, 0x98, 0x60, 0xb6, 0xb2, 0xda,
-	0xf9, 0xca, 0xfa, 0x29, 0xac, 0xff, 0x34, 0xc6, 0xd1, 0xf1, 0x53, 0xda, 0x67, 0x8b, 0xf9, 0xb8,
-	0x05, 0x35, 0xed, 0xa8, 0x24, 0x09, 0xa7, 0x63, 0xe7, 0x1f, 0x06, 0x34, 0x64, 0xd8, 0xbf, 0xf4,
-	0xd8, 0x51, 0xf2, 0xa2, 0x92, 0x78, 0xd9, 0xc8, 0x7b, 0xf9, 0x8c, 0x3d, 0x44, 0xc9, 0x73, 0x80,
-	0x59, 0xf6, 0x1c, 0x50, 0xc2, 0x4d, 0xaa, 0xa5, 0xdc, 0xa4, 0xd0, 0x94, 0x2c, 0x4d, 0x35, 0x25,
-	0xdf, 0x18, 0xb0, 0x91, 0xb1, 0xd1, 0x79, 0x52, 0x58, 0xce, 0xb2, 0x95, 0xa2, 0x65, 0x1f, 0xe6,
-	0x53, 0xbb, 0x59, 0xe6, 0xea, 0x4c, 0x6a, 0x4f, 0x6c, 0x9c, 0x4b, 0xef, 0xcf, 0x60, 0x4d, 0x94,
-	0xd7, 0x8b, 0x71, 0xe7, 0xdf, 0x0d, 0x58, 0x79, 0x4a, 0xfb, 0xd2, 0x91, 0x59, 0x0c, 0x19, 0xf9,
-	0xa7, 0xa6, 0x75, 0x30, 0x7d, 0x32, 0xd2, 0xf9, 0x58, 0xfc, 0x14, 0x31, 0xc6, 0xb8, 0x17, 0xf1,
-	0xc9, 0x63, 0x99, 0x20, 0x5f, 0x42, 0x22, 0xdf, 0x5b, 0xae, 0x42, 0x0d, 0x87, 0xbe, 0xfa, 0xa8,
-	0x19, 0x2e, 0x0e, 0x7d, 0xf9, 0xe9, 0x62, 0x9a, 0x96, 0x2b, 0xb0, 0x34, 0xa6, 0x93, 0x07, 0x2e,
-	0x35, 0x70, 0xae, 0x00, 0x7a, 0x8c, 0xf9, 0x53, 0xda, 0x17, 0x5e, 0x49, 0xcc, 0xe3, 0xfc, 0xad,
-	0x22, 0x1b, 0x8a, 0x89, 0xf8, 0x3c, 0x0e, 0x76, 0xa0, 0xa1, 0x0a, 0xd0, 0x17, 0xb4, 0xdf, 0x0b,
-	0xe3, 0xc4, 0x28, 0xb6, 0x14, 0x3e, 0xa5, 0xfd, 0x17, 0xf1, 0x08, 0x7d, 0x00, 0x97, 0x49, 0xd8,
-	0x1b, 0xeb, 0x9a, 0x98, 0x6a, 0x2a, 0x2b, 0xad, 0x93, 0x30, 0xa9, 0x96, 0x5a, 0xfd, 0x2e, 0xac,
-	0xe1, 0xf0, 0x55, 0x8c, 0x63, 0x9c, 0xaa, 0x2a, 0x9b, 0x35, 0xb4, 0x58, 0xeb, 0x89, 0xda, 0xe7,
-	0xb1, 0xa3, 0x1e, 0x0b, 0x28, 0x67, 0x3a, 0x27, 0x5a, 0x42, 0xd2, 0x15, 0x02, 0xf4, 0x31, 0x58,
-	0x62, 0xba, 0x82, 0x96, 0x6a, 0x0c, 0xae, 0x95, 0x41, 0x4b, 0xfb, 0xdb, 0xad, 0x7d, 0xa1, 0x7e,
-	0x30, 0x11, 0x20, 0x9a, 0x2a, 0xfb, 0x84, 0x1d, 0xe9, 0x4a, 0x03, 0x4a, 0xb4, 0x47, 0xd8, 0xd1,
-	0xf6, 0x57, 0x00, 0x20, 0x11, 0xb9, 0x4b, 0x69, 0xe4, 0xa3, 0x40, 0x9a, 0x79, 0x97, 0x8e, 0xc6,
-	0x34, 0xc4, 0x21, 0x97, 0xd1, 0xcb, 0xd0, 0x56, 0x7e, 0x33, 0x3d, 0x98, 0x56, 0xd4, 0x6e, 0x69,
-	0xbd, 0x5f, 0xaa, 0x5f, 0x50, 0x76, 0x2e, 0xa1, 0x57, 0x92, 0x5c, 0x8b, 0x21, 0x61, 0x9c, 0x0c,
-	0xd8, 0xee, 0xa1, 0x17, 0x86, 0x38, 0x40, 0xdb, 0x33, 0x9e, 0xa2, 0xca, 0x94, 0x93, 0x3d, 0x6f,
-	0x97, 0xee, 0xd9, 0xe5, 0x11, 0x09, 0x0f, 0x12, 0x5c, 0x38, 0x97, 0xd0, 0x4b, 0xb0, 0x33, 0xef,
-	0x01, 0xe8, 0x6e, 0x99, 0x19, 0xa7, 0x1f, 0x0c, 0x5a, 0x27, 0x01, 0xc8, 0xb9, 0x84, 0x86, 0xd0,
-	0xc8, 0x3d, 0x58, 0xa1, 0xf6, 0x49, 0x9c, 0x3e, 0xfb, 0x4a, 0xd4, 0xfa, 0xf6, 0x02, 0x9a, 0xe9,
-	0xe9, 0x7f, 0xa9, 0x0c, 0x36, 0xf5, 0xe2, 0x73, 0x7f, 0xc6, 0x22, 0xb3, 0xde, 0xa6, 0x5a, 0x0f,
-	0x16, 0x9f, 0x90, 0x6e, 0xee, 0x4f, 0x2e, 0xa9, 0xc0, 0x75, 0x6f, 0x7e, 0xe3, 0xa2, 0x76, 0x6b,
-	0x2f, 0xda, 0xe1, 0x38, 0x97, 0xd0, 0x3e, 0x58, 0x69, 0x8f, 0x81, 0xde, 0x2f, 0x9b, 0x58, 0x6c,
-	0x41, 0x16, 0x70, 0x4e, 0x8e, 0xc3, 0x97, 0x3b, 0xa7, 0xac, 0x85, 0x28, 0x77, 0x4e, 0x69, 0x43,
-	0xe0, 0x5c, 0x42, 0xbf, 0x9a, 0xbc, 0x5a, 0xe6, 0x98, 0x33, 0x7a, 0x70, 0xd2, 0xf5, 0xcb, 0x88,
-	0x7c, 0xeb, 0xbb, 0xa7, 0x98, 0x91, 0x01, 0x07, 0xea, 0x1e, 0xd2, 0x37, 0x8a, 0xc1, 0xc4, 0x91,
-	0x27, 0x08, 0x7f, 0xc9, 0xe6, 0x3a, 0x96, 0xa6, 0x55, 0x67, 0x6e, 0x7e, 0xc2, 0x8c, 0x74, 0xf3,
-	0x1e, 0xc0, 0x63, 0xcc, 0x9f, 0x63, 0x1e, 0x91, 0x01, 0x2b, 0x86, 0xd5, 0x24, 0x61, 0x68, 0x85,
-	0x64, 0xab, 0x7b, 0x73, 0xf5, 0xd2, 0x0d, 0xfa, 0x60, 0xef, 0x1e, 0xe2, 0xc1, 0xd1, 0x13, 0xec,
-	0x05, 0xfc, 0x10, 0x95, 0xcf, 0xcc, 0x68, 0xcc, 0xc0, 0x5e, 0x99, 0x62, 0xb2, 0xc7, 0xf6, 0x37,
-	0xcb, 0xfa, 0x1f, 0xcc, 0x17, 0xd4, 0xc7, 0xff, 0xfd, 0xb9, 0x70, 0x1f, 0xac, 0xb4, 0x47, 0x28,
-	0x0f, 0xb5, 0x62, 0x0b, 0x31, 0x2f, 0xd4, 0x3e, 0x07, 0x2b, 0x65, 0x5b, 0xe5, 0x2b, 0x16, 0x09,
-	0x6b, 0xeb, 0xce, 0x1c, 0xad, 0xf4, 0xb4, 0x2f, 0xa0, 0x96, 0xb0, 0x23, 0x74, 0x7b, 0x56, 0x5e,
-	0xc8, 0xae, 0x3c, 0xe7, 0xac, 0xbf, 0x00, 0x3b, 0x43, 0x1d, 0xca, 0x2b, 0xc1, 0x34, 0xe5, 0x68,
-	0xdd, 0x9b, 0xab, 0xf7, 0xbf, 0x11, 0x90, 0x0f, 0xbf, 0xf7, 0xf9, 0xf6, 0x01, 0xe1, 0x87, 0x71,
-	0x5f, 0x58, 0xf6, 0xbe, 0xd2, 0xfc, 0x80, 0x50, 0xfd, 0xeb, 0x7e, 0x72, 0xca, 0xfb, 0x72, 0xa5,
-	0xfb, 0xd2, 0x4e, 0xe3, 0x7e, 0x7f, 0x59, 0x0e, 0x3f, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x34, 0xde, 0x9f, 0x9a, 0x80, 0x20, 0x00, 0x00,
+	// 2220 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4f, 0x8f, 0x1b, 0x49,
+	0x15, 0x4f, 0xbb, 0x3d, 0x33, 0xee, 0xd7, 0xf6, 0xfc, 0xa9, 0x24, 0xe0, 0x38, 0x09, 0x99, 0x74,
+	0x36, 0x89, 0x17, 0x69, 0x27, 0x61, 0x96, 0x45, 0x0b, 0x02, 0xa4, 0xc9, 0xcc, 0x26, 0x71, 0xb2,
+	0x89, 0x86, 0x76, 0xb4, 0x12, 0x2b, 0x24, 0xd3, 0x76, 0x97, 0x67, 0x6a, 0xa7, 0xdd, 0xe5, 0x74,
+	0x55, 0x27, 0x99, 0x20, 0x21, 0x2e, 0x7b, 0x60, 0xb5, 0x12, 0x02, 0x21, 0xf8, 0x02, 0x9c, 0xf6,
+	0xc2, 0x9d, 0x0b, 0x5f, 0x80, 0x13, 0x5f, 0x86, 0x0b, 0x07, 0x54, 0x7f, 0xba, 0xdd, 0xdd, 0x6e,
+	0x8f, 0x9d, 0x99, 0xe1, 0x02, 0x37, 0xd7, 0xeb, 0x57, 0xf5, 0xaa, 0xde, 0xfb, 0xd5, 0xfb, 0xbd,
+	0x57, 0x86, 0x0d, 0x12, 0xfa, 0xf8, 0x4d, 0x6f, 0x40, 0x69, 0xe4, 0x6f, 0x8d, 0x23, 0xca, 0x29,
+	0x42, 0x23, 0x12, 0xbc, 0x8a, 0x99, 0x1a, 0x6d, 0xc9, 0xef, 0xad, 0xfa, 0x80, 0x8e, 0x46, 0x34,
+	0x54, 0xb2, 0xd6, 0x2a, 0x09, 0x39, 0x8e, 0x42, 0x2f, 0xd0, 0xe3, 0x7a, 0x76, 0x86, 0xf3, 0xd7,
+	0x2a, 0x58, 0x1d, 0x31, 0xab, 0x13, 0x0e, 0x29, 0x72, 0xa0, 0x3e, 0xa0, 0x41, 0x80, 0x07, 0x9c,
+	0xd0, 0xb0, 0xb3, 0xd7, 0x34, 0x36, 0x8d, 0xb6, 0xe9, 0xe6, 0x64, 0xa8, 0x09, 0x2b, 0x43, 0x82,
+	0x03, 0xbf, 0xb3, 0xd7, 0xac, 0xc8, 0xcf, 0xc9, 0x10, 0x5d, 0x07, 0x50, 0x1b, 0x0c, 0xbd, 0x11,
+	0x6e, 0x9a, 0x9b, 0x46, 0xdb, 0x72, 0x2d, 0x29, 0x79, 0xee, 0x8d, 0xb0, 0x98, 0x28, 0x07, 0x9d,
+	0xbd, 0x66, 0x55, 0x4d, 0xd4, 0x43, 0xf4, 0x00, 0x6c, 0x7e, 0x3c, 0xc6, 0xbd, 0xb1, 0x17, 0x79,
+	0x23, 0xd6, 0x5c, 0xda, 0x34, 0xdb, 0xf6, 0xf6, 0xcd, 0xad, 0xdc, 0xd1, 0xf4, 0x99, 0x9e, 0xe2,
+	0xe3, 0xcf, 0xbc, 0x20, 0xc6, 0xfb, 0x1e, 0x89, 0x5c, 0x10, 0xb3, 0xf6, 0xe5, 0x24, 0xb4, 0x07,
+	0x75, 0x65, 0x5c, 0x2f, 0xb2, 0xbc, 0xe8, 0x22, 0xb6, 0x9c, 0xa6, 0x57, 0xb9, 0xa9, 0x57, 0xc1,
+	0x7e, 0x2f, 0xa2, 0xaf, 0x59, 0x73, 0x45, 0x6e, 0xd4, 0xd6, 0x32, 0x97, 0xbe, 0x66, 0xe2, 0x94,
+	0x9c, 0x72, 0x2f, 0x50, 0x0a, 0x35, 0xa9, 0x60, 0x49, 0x89, 0xfc, 0xfc, 0x11, 0x2c, 0x31, 0xee,
+	0x71, 0xdc, 0xb4, 0x36, 0x8d, 0xf6, 0xea, 0xf6, 0x8d, 0xd2, 0x0d, 0x48, 0x8f, 0x77, 0x85, 0x9a,
+	0xab, 0xb4, 0xd1, 0x47, 0xf0, 0x6d, 0xb5, 0x7d, 0x39, 0xec, 0x0d, 0x3d, 0x12, 0xf4, 0x22, 0xec,
+	0x31, 0x1a, 0x36, 0x41, 0x3a, 0xf2, 0x12, 0x49, 0xe7, 0x3c, 0xf4, 0x48, 0xe0, 0xca, 0x6f, 0xc8,
+	0x81, 0x06, 0x61, 0x3d, 0x2f, 0xe6, 0xb4, 0x27, 0xbf, 0x37, 0xed, 0x4d, 0xa3, 0x5d, 0x73, 0x6d,
+	0xc2, 0x76, 0x62, 0x4e, 0xa5, 0x19, 0xf4, 0x0c, 0x36, 0x62, 0x86, 0xa3, 0x5e, 0xce, 0x3d, 0xf5,
+	0x45, 0xdd, 0xb3, 0x26, 0xe6, 0x76, 0x26, 0x2e, 0x72, 0xbe, 0x34, 0x00, 0x1e, 0xca, 0x88, 0xcb,
+	0xd5, 0x7f, 0x9c, 0x04, 0x9d, 0x84, 0x43, 0x2a, 0x01, 0x63, 0x6f, 0x5f, 0xdf, 0x9a, 0x46, 0xe5,
+	0x56, 0x8a, 0x32, 0x8d, 0x09, 0xf1, 0x53, 0x60, 0xc2, 0xc7, 0x01, 0xe6, 0xd8, 0x97, 0x60, 0xaa,
+	0xb9, 0xc9, 0x10, 0xdd, 0x00, 0x7b, 0x10, 0x61, 0xe1, 0x0b, 0x4e, 0x34, 0x9a, 0xaa, 0x2e, 0x28,
+	0xd1, 0x0b, 0x32, 0xc2, 0xce, 0x97, 0x55, 0xa8, 0x77, 0xf1, 0xc1, 0x08, 0x87, 0x5c, 0xed, 0x64,
+	0x11, 0xf0, 0x6e, 0x82, 0x3d, 0xf6, 0x22, 0x4e, 0xb4, 0x8a, 0x02, 0x70, 0x56, 0x84, 0xae, 0x81,
+	0xc5, 0xf4, 0xaa, 0x7b, 0xd2, 0xaa, 0xe9, 0x4e, 0x04, 0xe8, 0x0a, 0xd4, 0xc2, 0x78, 0xa4, 0x42,
+	0xaf, 0x41, 0x1c, 0xc6, 0x23, 0x19, 0xf8, 0x0c, 0xbc, 0x97, 0xf2, 0xf0, 0x6e, 0xc2, 0x4a, 0x3f,
+	0x26, 0xf2, 0xc6, 0x2c, 0xab, 0x2f, 0x7a, 0x88, 0xbe, 0x05, 0xcb, 0x21, 0xf5, 0x71, 0x67, 0x4f,
+	0x03, 0x4d, 0x8f, 0xd0, 0x2d, 0x68, 0x28, 0xa7, 0xbe, 0xc2, 0x11, 0x23, 0x34, 0xd4, 0x30, 0x53,
+	0xd8, 0xfc, 0x4c, 0xc9, 0x4e, 0x8b, 0xb4, 0x1b, 0x60, 0x4f, 0xa3, 0x0b, 0x86, 0x13, 0x4c, 0xdd,
+	0x81, 0x35, 0x65, 0x7c, 0x48, 0x02, 0xdc, 0x3b, 0xc2, 0xc7, 0xac, 0x69, 0x6f, 0x9a, 0x6d, 0xcb,
+	0x55, 0x7b, 0x7a, 0x48, 0x02, 0xfc, 0x14, 0x1f, 0xb3, 0x6c, 0xec, 0xea, 0x27, 0xc6, 0xae, 0x51,
+	0x8c, 0x1d, 0xba, 0x0d, 0xab, 0x0c, 0x47, 0xc4, 0x0b, 0xc8, 0x5b, 0xdc, 0x63, 0xe4, 0x2d, 0x6e,
+	0xae, 0x4a, 0x9d, 0x46, 0x2a, 0xed, 0x92, 0xb7, 0x58, 0xb8, 0xe1, 0x75, 0x44, 0x38, 0xee, 0x1d,
+	0x7a, 0xa1, 0x4f, 0x87, 0xc3, 0xe6, 0x9a, 0xb4, 0x53, 0x97, 0xc2, 0xc7, 0x4a, 0xe6, 0xfc, 0xd9,
+	0x80, 0x8b, 0x2e, 0x3e, 0x20, 0x8c, 0xe3, 0xe8, 0x39, 0xf5, 0xb1, 0x8b, 0x5f, 0xc6, 0x98, 0x71,
+	0x74, 0x1f, 0xaa, 0x7d, 0x8f, 0x61, 0x0d, 0xc9, 0x6b, 0xa5, 0xde, 0x79, 0xc6, 0x0e, 0x1e, 0x78,
+	0x0c, 0xbb, 0x52, 0x13, 0xfd, 0x00, 0x56, 0x3c, 0xdf, 0x8f, 0x30, 0x63, 0xcd, 0xca, 0x09, 0x93,
+	0x76, 0x94, 0x8e, 0x9b, 0x28, 0x67, 0xa2, 0x68, 0x66, 0xa3, 0xe8, 0xfc, 0xce, 0x80, 0x4b, 0xf9,
+	0x9d, 0xb1, 0x31, 0x0d, 0x19, 0x46, 0x1f, 0xc2, 0xb2, 0x88, 0x45, 0xcc, 0xf4, 0xe6, 0xae, 0x96,
+	0xda, 0xe9, 0x4a, 0x15, 0x57, 0xab, 0x8a, 0x24, 0x49, 0x42, 0xc2, 0x93, 0x0b, 0xac, 0x76, 0x78,
+	0xb3, 0x78, 0xd3, 0x74, 0xaa, 0xef, 0x84, 0x84, 0xab, 0xfb, 0xea, 0x02, 0x49, 0x7f, 0x3b, 0x3f,
+	0x87, 0x4b, 0x8f, 0x30, 0xcf, 0x60, 0x42, 0xfb, 0x6a, 0x91, 0xab, 0x93, 0xcf, 0xee, 0x95, 0x42,
+	0x76, 0x77, 0xfe, 0x62, 0xc0, 0xe5, 0xc2, 0xda, 0x67, 0x39, 0x6d, 0x0a, 0xee, 0xca, 0x59, 0xc0,
+	0x6d, 0x16, 0xc1, 0xed, 0xfc, 0xc6, 0x80, 0xab, 0x8f, 0x30, 0xcf, 0x26, 0x8e, 0x73, 0xf6, 0x04,
+	0xfa, 0x0e, 0x40, 0x9a, 0x30, 0x58, 0xd3, 0xdc, 0x34, 0xdb, 0xa6, 0x9b, 0x91, 0x38, 0xbf, 0x35,
+	0x60, 0x63, 0xca, 0x7e, 0x3e, 0xef, 0x18, 0xc5, 0xbc, 0xf3, 0xdf, 0x72, 0xc7, 0x1f, 0x0c, 0xb8,
+	0x56, 0xee, 0x8e, 0xb3, 0x04, 0xef, 0x27, 0x6a, 0x12, 0x16, 0x28, 0x15, 0x34, 0x73, 0xbb, 0x8c,
+	0x0f, 0xa6, 0x6d, 0xea, 0x49, 0xce, 0xd7, 0x26, 0xa0, 0x5d, 0x99, 0x2c, 0xe4, 0xc7, 0x77, 0x09,
+	0xcd, 0xa9, 0x8b, 0x93, 0x42, 0x09, 0x52, 0x3d, 0x8f, 0x12, 0x64, 0xe9, 0x54, 0x25, 0xc8, 0x35,
+	0xb0, 0x44, 0xd6, 0x64, 0xdc, 0x1b, 0x8d, 0x25, 0x5f, 0x54, 0xdd, 0x89, 0x60, 0x9a, 0xf0, 0x57,
+	0x16, 0x24, 0xfc, 0xda, 0xa9, 0x09, 0xff, 0x0d, 0x5c, 0x4c, 0x2e, 0xb6, 0xa4, 0xef, 0x77, 0x08,
+	0x47, 0xfe, 0x2a, 0x54, 0x8a, 0x57, 0x61, 0x4e, 0x50, 0x9c, 0x7f, 0x55, 0x60, 0xa3, 0x93, 0x70,
+	0xce, 0xbe, 0xc7, 0x0f, 0x65, 0xcd, 0x70, 0xf2, 0x4d, 0x99, 0x8d, 0x80, 0x0c, 0x41, 0x9b, 0x33,
+	0x09, 0xba, 0x9a, 0x27, 0xe8, 0xfc, 0x06, 0x97, 0x8a, 0xa8, 0x39, 0x9f, 0xa2, 0xb3, 0x0d, 0xeb,
+	0x19, 0xc2, 0x1d, 0x7b, 0xfc, 0x50, 0x14, 0x9e, 0x82, 0x71, 0x57, 0x49, 0xf6, 0xf4, 0x0c, 0xdd,
+	0x85, 0xb5, 0x94, 0x21, 0x7d, 0x45, 0x9c, 0x35, 0x89, 0x90, 0x09, 0x9d, 0xfa, 0x09, 0x73, 0xe6,
+	0x0b, 0x08, 0xab, 0xa4, 0x80, 0xc8, 0x16, 0x33, 0x90, 0x2b, 0x66, 0x9c, 0xbf, 0x19, 0x60, 0xa7,
+	0x17, 0x74, 0xc1, 0xc6, 0x20, 0x17, 0x97, 0x4a, 0x31, 0x2e, 0x37, 0xa1, 0x8e, 0x43, 0xaf, 0x1f,
+	0x60, 0x8d, 0x5b, 0x53, 0xe1, 0x56, 0xc9, 0x14, 0x6e, 0x1f, 0x82, 0x3d, 0x29, 0x25, 0x93, 0x3b,
+	0x78, 0x7b, 0x66, 0x2d, 0x99, 0x05, 0x85, 0x0b, 0x69, 0x4d, 0xc9, 0x9c, 0xaf, 0x2a, 0x13, 0x9a,
+	0x93, 0x1f, 0xcf, 0x94, 0xcc, 0x7e, 0x01, 0x75, 0x7d, 0x0a, 0x55, 0xe2, 0xaa, 0x94, 0xf6, 0xc3,
+	0xb2, 0x6d, 0x95, 0x19, 0xdd, 0xca, 0xb8, 0xf1, 0x93, 0x90, 0x47, 0xc7, 0xae, 0xcd, 0x26, 0x92,
+	0x56, 0x0f, 0xd6, 0x8b, 0x0a, 0x68, 0x1d, 0xcc, 0x23, 0x7c, 0xac, 0x7d, 0x2c, 0x7e, 0x8a, 0xf4,
+	0xff, 0x4a, 0x60, 0x47, 0xb3, 0xfe, 0x8d, 0x13, 0xf3, 0xe9, 0x90, 0xba, 0x4a, 0xfb, 0x47, 0x95,
+	0x8f, 0x0d, 0xe7, 0x8f, 0x06, 0xac, 0xef, 0x45, 0x74, 0xfc, 0xce, 0xa9, 0xd4, 0x81, 0x7a, 0xa6,
+	0x2e, 0x4e, 0x6e, 0x6f, 0x4e, 0x36, 0x2f, 0xa9, 0x5e, 0x81, 0x9a, 0x1f, 0xd1, 0x71, 0xcf, 0x0b,
+	0x82, 0x66, 0x55, 0x97, 0x88, 0x11, 0x1d, 0xef, 0x04, 0x81, 0xa8, 0x44, 0xf6, 0x30, 0x1b, 0x44,
+	0xa4, 0xff, 0xee, 0x49, 0x7e, 0x4e, 0x25, 0xf2, 0xb5, 0x01, 0x97, 0x0b, 0x6b, 0x9f, 0x25, 0xfe,
+	0x3f, 0xcd, 0xa3, 0x52, 0x85, 0x7f, 0x4e, 0x87, 0x93, 0x45, 0xa3, 0x27, 0x19, 0x56, 0x7e, 0x7b,
+	0x20, 0xb2, 0xca, 0x7e, 0x44, 0x0f, 0x64, 0xfd, 0x78, 0x7e, 0x27, 0xfe, 0x93, 0x01, 0xd7, 0x67,
+	0xd8, 0x38, 0xcb, 0xc9, 0x8b, 0xcd, 0x70, 0x65, 0x5e, 0x33, 0x6c, 0x16, 0x9a, 0x61, 0xe7, 0xf7,
+	0x26, 0x34, 0xba, 0x9c, 0x46, 0xde, 0x01, 0xde, 0xa5, 0xe1, 0x90, 0x1c, 0x88, 0x54, 0x9b, 0xd4,
+	0xd8, 0x86, 0x3c, 0x46, 0x32, 0x14, 0xd6, 0xbc, 0xc1, 0x00, 0x33, 0x26, 0x5a, 0x0e, 0x9d, 0x41,
+	0x2c, 0xd7, 0x56, 0xb2, 0xa7, 0x42, 0x84, 0xbe, 0x0b, 0x1b, 0x0c, 0x0f, 0x22, 0xcc, 0x7b, 0x13,
+	0x4d, 0x8d, 0xba, 0x35, 0xf5, 0x61, 0x27, 0xd1, 0x16, 0x45, 0x79, 0xcc, 0x70, 0xb7, 0xfb, 0xa9,
+	0x46, 0x9e, 0x1e, 0x89, 0x92, 0xa8, 0x1f, 0x0f, 0x8e, 0x30, 0xcf, 0xa6, 0x74, 0x50, 0x22, 0x09,
+	0xda, 0xab, 0x60, 0x45, 0x94, 0x72, 0x99, 0x87, 0x25, 0xff, 0x5a, 0x6e, 0x4d, 0x08, 0x44, 0xaa,
+	0xd1, 0xab, 0x76, 0x76, 0x9e, 0x69, 0xde, 0xd5, 0x23, 0xd1, 0x57, 0x76, 0x76, 0x9e, 0x7d, 0x12,
+	0xfa, 0x63, 0x4a, 0x42, 0x2e, 0x93, 0xb2, 0xe5, 0x66, 0x45, 0xe2, 0x78, 0x4c, 0x79, 0xa2, 0x27,
+	0x4a, 0x06, 0x99, 0x90, 0x2d, 0xd7, 0xd6, 0xb2, 0x17, 0xc7, 0x63, 0xac, 0x3d, 0x40, 0xe3, 0x50,
+	0xef, 0x0d, 0x52, 0x0f, 0x08, 0x99, 0xdc, 0xdc, 0x6d, 0x58, 0x1d, 0xd0, 0x90, 0x7b, 0x24, 0xc4,
+	0x91, 0x52, 0xb2, 0xa5, 0x52, 0x23, 0x95, 0x4a, 0xb5, 0x16, 0xd4, 0x06, 0x01, 0x51, 0x99, 0xb8,
+	0xae, 0x8e, 0x90, 0x8c, 0x9d, 0x7f, 0x9b, 0xb0, 0xae, 0xaa, 0xab, 0x27, 0xb4, 0x9f, 0x80, 0xf0,
+	0x1a, 0x58, 0x83, 0x20, 0x66, 0x1c, 0x47, 0x1a, 0x81, 0x96, 0x3b, 0x11, 0x08, 0xbf, 0x67, 0x09,
+	0x2a, 0xc2, 0x43, 0xf2, 0x46, 0xc7, 0x67, 0x6d, 0xc2, 0x50, 0x52, 0x9c, 0xe5, 0x52, 0x73, 0x8a,
+	0x4b, 0x7d, 0x8f, 0x7b, 0x9a, 0xe0, 0xaa, 0x92, 0xe0, 0x2c, 0x21, 0x51, 0xdc, 0x36, 0x45, 0x59,
+	0x4b, 0x25, 0x94, 0x95, 0xe1, 0xf0, 0xe5, 0x3c, 0x87, 0xe7, 0xaf, 0xc8, 0x4a, 0x31, 0x15, 0x3d,
+	0x86, 0xd5, 0xc4, 0xfd, 0x03, 0x89, 0x44, 0x19, 0xa3, 0x92, 0x06, 0x4a, 0xa6, 0xd2, 0x2c, 0x64,
+	0xdd, 0x06, 0xcb, 0x0e, 0xa7, 0x38, 0xdf, 0x3a, 0x15, 0xe7, 0x17, 0xea, 0x4d, 0x38, 0x4d, 0xbd,
+	0x99, 0xe5, 0x6f, 0x7b, 0xea, 0x31, 0x22, 0xa9, 0x82, 0xea, 0xb9, 0x2a, 0xc8, 0xf9, 0x14, 0xd6,
+	0x7f, 0x16, 0xe3, 0xe8, 0xf8, 0x09, 0xed, 0xb3, 0xc5, 0xa2, 0xdf, 0x82, 0x9a, 0x0e, 0x61, 0x42,
+	0x02, 0xe9, 0xd8, 0xf9, 0xa7, 0x01, 0x0d, 0x99, 0x76, 0x5e, 0x78, 0xec, 0x28, 0x79, 0xd1, 0x49,
+	0xe2, 0x6f, 0xe4, 0xe3, 0x7f, 0xca, 0x1e, 0xa6, 0xe4, 0x39, 0xc2, 0x2c, 0x7b, 0x8e, 0x28, 0xa9,
+	0x8d, 0xaa, 0xa5, 0xb5, 0x51, 0xa1, 0x29, 0x5a, 0x9a, 0x6a, 0x8a, 0xbe, 0x31, 0x60, 0x23, 0xe3,
+	0xa3, 0xb3, 0xa4, 0xd0, 0x9c, 0x67, 0x2b, 0x45, 0xcf, 0x3e, 0xc8, 0x53, 0x8b, 0x59, 0x06, 0x82,
+	0x0c, 0xb5, 0x24, 0x3e, 0xce, 0xd1, 0xcb, 0x53, 0x58, 0x13, 0xf4, 0x7e, 0x3e, 0xe1, 0xfc, 0x87,
+	0x01, 0x2b, 0x4f, 0x68, 0x5f, 0x06, 0x32, 0x8b, 0x2e, 0x23, 0x8f, 0xae, 0x75, 0x30, 0x7d, 0x32,
+	0xd2, 0x7c, 0x20, 0x7e, 0x8a, 0xdb, 0xc7, 0xb8, 0x17, 0xf1, 0xc9, 0x63, 0x9d, 0x28, 0xfe, 0x84,
+	0x44, 0xbe, 0xf7, 0x5c, 0x81, 0x1a, 0x0e, 0x7d, 0xf5, 0x51, 0x57, 0xd8, 0x38, 0xf4, 0xe5, 0xa7,
+	0xf3, 0x69, 0x9a, 0x2e, 0xc1, 0xd2, 0x98, 0x4e, 0x1e, 0xd8, 0xd4, 0xc0, 0xb9, 0x04, 0xe8, 0x11,
+	0xe6, 0x4f, 0x68, 0x5f, 0x44, 0x25, 0x71, 0x8f, 0xf3, 0xf7, 0x0a, 0x5c, 0xcc, 0x89, 0xcf, 0x12,
+	0x60, 0x07, 0x1a, 0x8a, 0x00, 0xbf, 0xa0, 0xfd, 0x5e, 0x18, 0x27, 0x4e, 0xb1, 0xa5, 0xf0, 0x09,
+	0xed, 0x3f, 0x8f, 0x47, 0xe8, 0x03, 0xb8, 0x48, 0xc2, 0xde, 0x58, 0x73, 0x72, 0xaa, 0xa9, 0xbc,
+	0xb4, 0x4e, 0xc2, 0x84, 0xad, 0xb5, 0xfa, 0x1d, 0x58, 0xc3, 0xe1, 0xcb, 0x18, 0xc7, 0x38, 0x55,
+	0x55, 0x3e, 0x6b, 0x68, 0xb1, 0xd6, 0x13, 0xdc, 0xeb, 0xb1, 0xa3, 0x1e, 0x0b, 0x28, 0x67, 0x3a,
+	0x5b, 0x5a, 0x42, 0xd2, 0x15, 0x02, 0xf4, 0x31, 0x58, 0x62, 0xba, 0x82, 0x96, 0x6a, 0x4c, 0xae,
+	0x96, 0x41, 0x4b, 0xc7, 0xdb, 0xad, 0x7d, 0xa1, 0x7e, 0x30, 0x71, 0x41, 0x74, 0xa9, 0xee, 0x13,
+	0x76, 0xa4, 0x99, 0x0e, 0x94, 0x68, 0x8f, 0xb0, 0xa3, 0xed, 0xaf, 0x00, 0x40, 0x22, 0x72, 0x97,
+	0xd2, 0xc8, 0x47, 0x81, 0x74, 0xf3, 0x2e, 0x1d, 0x8d, 0x69, 0x88, 0x43, 0x2e, 0x6f, 0x2f, 0x43,
+	0x5b, 0x79, 0x63, 0x7a, 0x30, 0xad, 0xa8, 0xc3, 0xd2, 0x7a, 0xaf, 0x54, 0xbf, 0xa0, 0xec, 0x5c,
+	0x40, 0x2f, 0x65, 0x71, 0x2f, 0x86, 0x84, 0x71, 0x32, 0x60, 0xbb, 0x87, 0x5e, 0x18, 0xe2, 0x00,
+	0x6d, 0xcf, 0x78, 0x0a, 0x2b, 0x53, 0x4e, 0x6c, 0xde, 0x2a, 0xb5, 0xd9, 0xe5, 0x11, 0x09, 0x0f,
+	0x12, 0x5c, 0x38, 0x17, 0xd0, 0x0b, 0xb0, 0x33, 0xef, 0x11, 0xe8, 0x4e, 0x99, 0x1b, 0xa7, 0x1f,
+	0x2c, 0x5a, 0x27, 0x01, 0xc8, 0xb9, 0x80, 0x86, 0xd0, 0xc8, 0x3d, 0x98, 0xa1, 0xf6, 0x49, 0x3d,
+	0x45, 0xf6, 0x95, 0xaa, 0xf5, 0xfe, 0x02, 0x9a, 0xe9, 0xee, 0x7f, 0xa5, 0x1c, 0x36, 0xf5, 0xe2,
+	0x74, 0x6f, 0xc6, 0x22, 0xb3, 0xde, 0xc6, 0x5a, 0xf7, 0x17, 0x9f, 0x90, 0x1a, 0xf7, 0x27, 0x87,
+	0x54, 0xe0, 0xba, 0x3b, 0xbf, 0x71, 0x52, 0xd6, 0xda, 0x8b, 0x76, 0x58, 0xce, 0x05, 0xb4, 0x0f,
+	0x56, 0xda, 0xe3, 0xa0, 0xf7, 0xca, 0x26, 0x16, 0x5b, 0xa0, 0x05, 0x82, 0x93, 0xeb, 0x21, 0xca,
+	0x83, 0x53, 0xd6, 0xc2, 0xb4, 0xde, 0x5f, 0x40, 0x33, 0xdd, 0xf9, 0xaf, 0xe1, 0x72, 0x69, 0xe5,
+	0x8e, 0xee, 0x9f, 0x74, 0xfc, 0xb2, 0x46, 0xa2, 0xf5, 0xbd, 0x77, 0x98, 0x91, 0x01, 0x07, 0xea,
+	0x1e, 0xd2, 0xd7, 0xaa, 0xb6, 0x89, 0x23, 0x8f, 0x13, 0x1a, 0x96, 0x18, 0xd7, 0x77, 0x69, 0x5a,
+	0x75, 0xa6, 0xf1, 0x13, 0x66, 0xa4, 0xc6, 0x7b, 0x00, 0x8f, 0x30, 0x7f, 0x86, 0x79, 0x44, 0x06,
+	0xac, 0x78, 0xad, 0x26, 0x09, 0x43, 0x2b, 0x24, 0xa6, 0xee, 0xce, 0xd5, 0x4b, 0x0d, 0xf4, 0xc1,
+	0xde, 0x3d, 0xc4, 0x83, 0xa3, 0xc7, 0xd8, 0x0b, 0xf8, 0x21, 0x2a, 0x9f, 0x99, 0xd1, 0x98, 0x81,
+	0xbd, 0x32, 0xc5, 0xc4, 0xc6, 0xf6, 0x37, 0xcb, 0xfa, 0x1f, 0x54, 0xf1, 0xc4, 0xff, 0xbf, 0x9f,
+	0x0b, 0xf7, 0xc1, 0x4a, 0xbb, 0x87, 0xf2, 0xab, 0x56, 0x6c, 0x2e, 0xe6, 0x5d, 0xb5, 0xcf, 0xc1,
+	0x4a, 0xab, 0xad, 0xf2, 0x15, 0x8b, 0x05, 0x6b, 0xeb, 0xf6, 0x1c, 0xad, 0x74, 0xb7, 0xcf, 0xa1,
+	0x96, 0x54, 0x47, 0xe8, 0xd6, 0xac, 0xbc, 0x90, 0x5d, 0x79, 0xce, 0x5e, 0x7f, 0x09, 0x76, 0xa6,
+	0x74, 0x28, 0x67, 0x82, 0xe9, 0x92, 0xa3, 0x75, 0x77, 0xae, 0xde, 0xff, 0xc7, 0x85, 0x7c, 0xf0,
+	0xfd, 0xcf, 0xb7, 0x0f, 0x08, 0x3f, 0x8c, 0xfb, 0xc2, 0xb3, 0xf7, 0x94, 0xe6, 0x07, 0x84, 0xea,
+	0x5f, 0xf7, 0x92, 0x5d, 0xde, 0x93, 0x2b, 0xdd, 0x93, 0x7e, 0x1a, 0xf7, 0xfb, 0xcb, 0x72, 0xf8,
+	0xe1, 0x7f, 0x06, 0x00, 0x27, 0x02, 0xc6, 0xb9, 0x00, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1151,6 +1151,55 @@ func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 	return nil
 }
 
+// FieldValidData records the validity of each row of a nullable field,
+// a row is null if its valid_data is false.
+type FieldValidData struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	ValidData            []bool   `protobuf:"varint,2,rep,packed,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldValidData) Reset()         { *m = FieldValidData{} }
+func (m *FieldValidData) String() string { return proto.CompactTextString(m) }
+func (*FieldValidData) ProtoMessage()    {}
+func (*FieldValidData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{17}
+}
+
+func (m *FieldValidData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldValidData.Unmarshal(m, b)
+}
+func (m *FieldValidData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldValidData.Marshal(b, m, deterministic)
+}
+func (m *FieldValidData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldValidData.Merge(m, src)
+}
+func (m *FieldValidData) XXX_Size() int {
+	return xxx_messageInfo_FieldValidData.Size(m)
+}
+func (m *FieldValidData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldValidData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldValidData proto.InternalMessageInfo
+
+func (m *FieldValidData) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldValidData) GetValidData() []bool {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 type InsertRequest struct {
 	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ShardName      string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
@@ -1168,6 +1217,7 @@ type InsertRequest struct {
 	FieldsData           []*schemapb.FieldData `protobuf:"bytes,13,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
 	NumRows              uint64                `protobuf:"varint,14,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
 	Version              InsertDataVersion     `protobuf:"varint,15,opt,name=version,proto3,enum=milvus.proto.internal.InsertDataVersion" json:"version,omitempty"`
+	ValidData            []*FieldValidData     `protobuf:"bytes,16,rep,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1177,7 +1227,7 @@ func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
 func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
 func (*InsertRequest) ProtoMessage()    {}
 func (*InsertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{17}
+	return fileDescriptor_41f4a519b878ee3b, []int{18}
 }
 
 func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
@@ -1303,6 +1353,13 @@ func (m *InsertRequest) GetVersion() InsertDataVersion {
 	return InsertDataVersion_RowBased
 }
 
+func (m *InsertRequest) GetValidData() []*FieldValidData {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 type SearchRequest struct {
 	Base         *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ReqID        int64             `protobuf:"varint,2,opt,name=reqID,proto3" json:"reqID,omitempty"`
@@ -1330,7 +1387,7 @@ func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{18}
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1486,7 +1543,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -1612,7 +1669,7 @@ func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -1728,7 +1785,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1827,7 +1884,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1948,7 +2005,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -2023,7 +2080,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -2071,7 +2128,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2127,7 +2184,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2190,7 +2247,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2253,7 +2310,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2319,7 +2376,7 @@ func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
 func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
 func (*CredentialInfo) ProtoMessage()    {}
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
@@ -2387,7 +2444,7 @@ func (m *ListPolicyRequest) Reset()         { *m = ListPolicyRequest{} }
 func (m *ListPolicyRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyRequest) ProtoMessage()    {}
 func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *ListPolicyRequest) XXX_Unmarshal(b []byte) error {
@@ -2429,7 +2486,7 @@ func (m *ListPolicyResponse) Reset()         { *m = ListPolicyResponse{} }
 func (m *ListPolicyResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPolicyResponse) ProtoMessage()    {}
 func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{31}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *ListPolicyResponse) XXX_Unmarshal(b []byte) error {
@@ -2483,7 +2540,7 @@ func (m *ShowConfigurationsRequest) Reset()         { *m = ShowConfigurationsReq
 func (m *ShowConfigurationsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShowConfigurationsRequest) ProtoMessage()    {}
 func (*ShowConfigurationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{32}
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
 }
 
 func (m *ShowConfigurationsRequest) XXX_Unmarshal(b []byte) error {
@@ -2530,7 +2587,7 @@ func (m *ShowConfigurationsResponse) Reset()         { *m = ShowConfigurationsRe
 func (m *ShowConfigurationsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShowConfigurationsResponse) ProtoMessage()    {}
 func (*ShowConfigurationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{33}
+	return fileDescriptor_41f4a519b878ee3b, []int{34}
 }
 
 func (m *ShowConfigurationsResponse) XXX_Unmarshal(b []byte) error {
@@ -2577,7 +2634,7 @@ func (m *Rate) Reset()         { *m = Rate{} }
 func (m *Rate) String() string { return proto.CompactTextString(m) }
 func (*Rate) ProtoMessage()    {}
 func (*Rate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{34}
+	return fileDescriptor_41f4a519b878ee3b, []int{35}
 }
 
 func (m *Rate) XXX_Unmarshal(b []byte) error {
@@ -2632,6 +2689,7 @@ func init() {
 	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.internal.DropAliasRequest")
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.internal.AlterAliasRequest")
 	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.internal.CreateIndexRequest")
+	proto.RegisterType((*FieldValidData)(nil), "milvus.proto.internal.FieldValidData")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.internal.InsertRequest")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.internal.SearchRequest")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
@@ -2655,143 +2713,145 @@ func init() {
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 2194 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x5f, 0x8a, 0x92, 0x25, 0x3d, 0xc9, 0x0a, 0x3d, 0x71, 0xb2, 0x8c, 0x93, 0x6c, 0x1c, 0xf6,
-	0xcb, 0x4d, 0xba, 0x49, 0xea, 0xdd, 0x4d, 0x0a, 0xb4, 0xe8, 0x22, 0xb6, 0xb2, 0x81, 0x11, 0x3b,
-	0x75, 0xe8, 0x20, 0x40, 0x7b, 0x21, 0x46, 0xe2, 0x58, 0x9a, 0x86, 0xe4, 0x30, 0x33, 0x43, 0x3b,
-	0xca, 0xa9, 0x87, 0x9e, 0xba, 0x68, 0x6f, 0xbd, 0x14, 0x68, 0xcf, 0x45, 0x81, 0x02, 0xbd, 0xed,
-	0xb1, 0x40, 0x4f, 0x3d, 0xf5, 0xd4, 0xbf, 0xa6, 0xe8, 0xa1, 0x98, 0x19, 0x92, 0xfa, 0xb0, 0xe2,
-	0xd8, 0x0e, 0x76, 0x37, 0x05, 0xf6, 0xc6, 0x79, 0xef, 0xcd, 0xd7, 0xef, 0xfd, 0xde, 0xe3, 0x7b,
-	0x24, 0x74, 0x68, 0x22, 0x09, 0x4f, 0x70, 0x74, 0x2b, 0xe5, 0x4c, 0x32, 0x74, 0x21, 0xa6, 0xd1,
-	0x41, 0x26, 0xcc, 0xe8, 0x56, 0xa1, 0x5c, 0x69, 0xf7, 0x59, 0x1c, 0xb3, 0xc4, 0x88, 0x57, 0xda,
-	0xa2, 0x3f, 0x24, 0x31, 0x36, 0x23, 0xef, 0x32, 0x5c, 0x7a, 0x48, 0xe4, 0x53, 0x1a, 0x93, 0xa7,
-	0xb4, 0xff, 0x7c, 0x73, 0x88, 0x93, 0x84, 0x44, 0x3e, 0x79, 0x91, 0x11, 0x21, 0xbd, 0xab, 0x70,
-	0xf9, 0x21, 0x91, 0x7b, 0x12, 0x4b, 0x2a, 0x24, 0xed, 0x8b, 0x19, 0xf5, 0x05, 0x38, 0xff, 0x90,
-	0xc8, 0x6e, 0x38, 0x23, 0x7e, 0x06, 0x8d, 0xc7, 0x2c, 0x24, 0x5b, 0xc9, 0x3e, 0x43, 0x77, 0xa1,
-	0x8e, 0xc3, 0x90, 0x13, 0x21, 0x5c, 0x6b, 0xd5, 0x5a, 0x6b, 0xad, 0x5f, 0xb9, 0x35, 0x75, 0xc6,
-	0xfc, 0x64, 0xf7, 0x8d, 0x8d, 0x5f, 0x18, 0x23, 0x04, 0x55, 0xce, 0x22, 0xe2, 0x56, 0x56, 0xad,
-	0xb5, 0xa6, 0xaf, 0x9f, 0xbd, 0x5f, 0x02, 0x6c, 0x25, 0x54, 0xee, 0x62, 0x8e, 0x63, 0x81, 0x2e,
-	0xc2, 0x42, 0xa2, 0x76, 0xe9, 0xea, 0x85, 0x6d, 0x3f, 0x1f, 0xa1, 0x2e, 0xb4, 0x85, 0xc4, 0x5c,
-	0x06, 0xa9, 0xb6, 0x73, 0x2b, 0xab, 0xf6, 0x5a, 0x6b, 0xfd, 0xfa, 0xdc, 0x6d, 0x1f, 0x91, 0xd1,
-	0x33, 0x1c, 0x65, 0x64, 0x17, 0x53, 0xee, 0xb7, 0xf4, 0x34, 0xb3, 0xba, 0xf7, 0x73, 0x80, 0x3d,
-	0xc9, 0x69, 0x32, 0xd8, 0xa6, 0x42, 0xaa, 0xbd, 0x0e, 0x94, 0x9d, 0xba, 0x84, 0xbd, 0xd6, 0xf4,
-	0xf3, 0x11, 0xfa, 0x08, 0x16, 0x84, 0xc4, 0x32, 0x13, 0xfa, 0x9c, 0xad, 0xf5, 0xcb, 0x73, 0x77,
-	0xd9, 0xd3, 0x26, 0x7e, 0x6e, 0xea, 0x7d, 0x0a, 0xad, 0x02, 0xee, 0x1d, 0x31, 0x40, 0x77, 0xa0,
-	0xda, 0xc3, 0x82, 0x1c, 0x0b, 0xcf, 0x8e, 0x18, 0x6c, 0x60, 0x41, 0x7c, 0x6d, 0xe9, 0xfd, 0xb5,
-	0x02, 0xcb, 0x53, 0x6e, 0xc9, 0x81, 0x3f, 0xfd, 0x52, 0x0a, 0xe6, 0xb0, 0xb7, 0xd5, 0xd5, 0xc7,
-	0xb7, 0x7d, 0xfd, 0x8c, 0x3c, 0x68, 0xf7, 0x59, 0x14, 0x91, 0xbe, 0xa4, 0x2c, 0xd9, 0xea, 0xba,
-	0xb6, 0xd6, 0x4d, 0xc9, 0x94, 0x4d, 0x8a, 0xb9, 0xa4, 0x66, 0x28, 0xdc, 0xea, 0xaa, 0xad, 0x6c,
-	0x26, 0x65, 0xe8, 0xfb, 0xe0, 0x48, 0x8e, 0x0f, 0x48, 0x14, 0x48, 0x1a, 0x13, 0x21, 0x71, 0x9c,
-	0xba, 0xb5, 0x55, 0x6b, 0xad, 0xea, 0x9f, 0x33, 0xf2, 0xa7, 0x85, 0x18, 0xdd, 0x86, 0xf3, 0x83,
-	0x0c, 0x73, 0x9c, 0x48, 0x42, 0x26, 0xac, 0x17, 0xb4, 0x35, 0x2a, 0x55, 0xe3, 0x09, 0x37, 0x61,
-	0x49, 0x99, 0xb1, 0x4c, 0x4e, 0x98, 0xd7, 0xb5, 0xb9, 0x93, 0x2b, 0x4a, 0x63, 0xef, 0x0b, 0x0b,
-	0x2e, 0xcc, 0xe0, 0x25, 0x52, 0x96, 0x08, 0x72, 0x06, 0xc0, 0xce, 0xe2, 0x71, 0x74, 0x0f, 0x6a,
-	0xea, 0x49, 0xb8, 0xf6, 0x49, 0xb9, 0x68, 0xec, 0xbd, 0xdf, 0xd8, 0xf0, 0xfe, 0x26, 0x27, 0x58,
-	0x92, 0xcd, 0x12, 0xfd, 0xb3, 0x3b, 0xfb, 0x7d, 0xa8, 0x87, 0xbd, 0x20, 0xc1, 0x71, 0x11, 0x56,
-	0x0b, 0x61, 0xef, 0x31, 0x8e, 0x09, 0xfa, 0x2e, 0x74, 0xc6, 0xde, 0x55, 0x12, 0xed, 0xf3, 0xa6,
-	0x3f, 0x23, 0x45, 0xdf, 0x86, 0xc5, 0xd2, 0xc3, 0xda, 0xac, 0xaa, 0xcd, 0xa6, 0x85, 0x25, 0xa7,
-	0x6a, 0xc7, 0x70, 0x6a, 0x61, 0x0e, 0xa7, 0x56, 0xa1, 0x35, 0xc1, 0x1f, 0xed, 0x4d, 0xdb, 0x9f,
-	0x14, 0xa9, 0x30, 0x34, 0xb9, 0xcb, 0x6d, 0xac, 0x5a, 0x6b, 0x6d, 0x3f, 0x1f, 0xa1, 0x3b, 0x70,
-	0xfe, 0x80, 0x72, 0x99, 0xe1, 0x28, 0xcf, 0x44, 0xea, 0x1c, 0xc2, 0x6d, 0xea, 0x58, 0x9d, 0xa7,
-	0x42, 0xeb, 0xb0, 0x9c, 0x0e, 0x47, 0x82, 0xf6, 0x67, 0xa6, 0x80, 0x9e, 0x32, 0x57, 0xe7, 0xfd,
-	0xc3, 0x82, 0x0b, 0x5d, 0xce, 0xd2, 0x77, 0xc2, 0x15, 0x05, 0xc8, 0xd5, 0x63, 0x40, 0xae, 0x1d,
-	0x05, 0xd9, 0xfb, 0x6d, 0x05, 0x2e, 0x1a, 0x46, 0xed, 0x16, 0xc0, 0x7e, 0x09, 0xb7, 0xf8, 0x1e,
-	0x9c, 0x1b, 0xef, 0x6a, 0x0c, 0xe6, 0x5f, 0xe3, 0x3b, 0xd0, 0x29, 0x1d, 0x6c, 0xec, 0xbe, 0x5a,
-	0x4a, 0x79, 0x9f, 0x57, 0x60, 0x59, 0x39, 0xf5, 0x1b, 0x34, 0x14, 0x1a, 0x7f, 0xb2, 0x00, 0x19,
-	0x76, 0xdc, 0x8f, 0x28, 0x16, 0x5f, 0x27, 0x16, 0xcb, 0x50, 0xc3, 0xea, 0x0c, 0x39, 0x04, 0x66,
-	0xe0, 0x09, 0x70, 0x94, 0xb7, 0xbe, 0xac, 0xd3, 0x95, 0x9b, 0xda, 0x93, 0x9b, 0xfe, 0xd1, 0x82,
-	0xa5, 0xfb, 0x91, 0x24, 0xfc, 0x1d, 0x05, 0xe5, 0xef, 0x95, 0xc2, 0x6b, 0x5b, 0x49, 0x48, 0x5e,
-	0x7e, 0x9d, 0x07, 0xbc, 0x0a, 0xb0, 0x4f, 0x49, 0x14, 0x4e, 0xb2, 0xb7, 0xa9, 0x25, 0x6f, 0xc5,
-	0x5c, 0x17, 0xea, 0x7a, 0x91, 0x92, 0xb5, 0xc5, 0x50, 0x55, 0x7b, 0xe4, 0xa5, 0xe4, 0xb8, 0xa8,
-	0xf6, 0x1a, 0x27, 0xae, 0xf6, 0xf4, 0xb4, 0xbc, 0xda, 0xfb, 0x57, 0x15, 0x16, 0xb7, 0x12, 0x41,
-	0xb8, 0x3c, 0x3b, 0x78, 0x57, 0xa0, 0x29, 0x86, 0x98, 0xeb, 0x8b, 0xe6, 0xf0, 0x8d, 0x05, 0x93,
-	0xd0, 0xda, 0x6f, 0x82, 0xb6, 0x7a, 0xc2, 0xe4, 0x50, 0x3b, 0x2e, 0x39, 0x2c, 0x1c, 0x03, 0x71,
-	0xfd, 0xcd, 0xc9, 0xa1, 0x71, 0xf4, 0xed, 0xab, 0x2e, 0x48, 0x06, 0x31, 0x49, 0xe4, 0x56, 0xd7,
-	0x6d, 0x6a, 0xfd, 0x58, 0x80, 0x3e, 0x00, 0x28, 0x2b, 0x31, 0xf3, 0x1e, 0xad, 0xfa, 0x13, 0x12,
-	0xf5, 0xee, 0xe6, 0xec, 0x50, 0xd5, 0x8a, 0x2d, 0x5d, 0x2b, 0xe6, 0x23, 0xf4, 0x31, 0x34, 0x38,
-	0x3b, 0x0c, 0x42, 0x2c, 0xb1, 0xdb, 0xd6, 0xce, 0xbb, 0x34, 0x17, 0xec, 0x8d, 0x88, 0xf5, 0xfc,
-	0x3a, 0x67, 0x87, 0x5d, 0x2c, 0x31, 0xfa, 0x14, 0x5a, 0x9a, 0x01, 0xc2, 0x4c, 0x5c, 0xd4, 0x13,
-	0x3f, 0x98, 0x9e, 0x98, 0xb7, 0x39, 0x9f, 0x29, 0x3b, 0x35, 0xc9, 0x37, 0xd4, 0x14, 0x7a, 0x81,
-	0x4b, 0xd0, 0x48, 0xb2, 0x38, 0xe0, 0xec, 0x50, 0xb8, 0x1d, 0x5d, 0x37, 0xd6, 0x93, 0x2c, 0xf6,
-	0xd9, 0xa1, 0x40, 0x1b, 0x50, 0x3f, 0x20, 0x5c, 0x50, 0x96, 0xb8, 0xe7, 0x56, 0xad, 0xb5, 0xce,
-	0xfa, 0xda, 0xad, 0xb9, 0x6d, 0xd5, 0x2d, 0xc3, 0x18, 0xb5, 0xdc, 0x33, 0x63, 0xef, 0x17, 0x13,
-	0xbd, 0x7f, 0x57, 0x61, 0x71, 0x8f, 0x60, 0xde, 0x1f, 0x9e, 0x9d, 0x50, 0xcb, 0x50, 0xe3, 0xe4,
-	0x45, 0x59, 0x9c, 0x9b, 0x41, 0xe9, 0x5f, 0xfb, 0x18, 0xff, 0x56, 0x4f, 0x50, 0xb1, 0xd7, 0xe6,
-	0x54, 0xec, 0x0e, 0xd8, 0xa1, 0x88, 0x34, 0x75, 0x9a, 0xbe, 0x7a, 0x54, 0x75, 0x76, 0x1a, 0xe1,
-	0x3e, 0x19, 0xb2, 0x28, 0x24, 0x3c, 0x18, 0x70, 0x96, 0x99, 0x3a, 0xbb, 0xed, 0x3b, 0x13, 0x8a,
-	0x87, 0x4a, 0x8e, 0xee, 0x41, 0x23, 0x14, 0x51, 0x20, 0x47, 0x29, 0xd1, 0xfc, 0xe9, 0xbc, 0xe6,
-	0x9a, 0x5d, 0x11, 0x3d, 0x1d, 0xa5, 0xc4, 0xaf, 0x87, 0xe6, 0x01, 0xdd, 0x81, 0x65, 0x41, 0x38,
-	0xc5, 0x11, 0x7d, 0x45, 0xc2, 0x80, 0xbc, 0x4c, 0x79, 0x90, 0x46, 0x38, 0xd1, 0x24, 0x6b, 0xfb,
-	0x68, 0xac, 0x7b, 0xf0, 0x32, 0xe5, 0xbb, 0x11, 0x4e, 0xd0, 0x1a, 0x38, 0x2c, 0x93, 0x69, 0x26,
-	0x83, 0x9c, 0x06, 0x34, 0xd4, 0x9c, 0xb3, 0xfd, 0x8e, 0x91, 0x6b, 0xaf, 0x8b, 0xad, 0x70, 0x6e,
-	0x17, 0xd2, 0x3a, 0x55, 0x17, 0xd2, 0x3e, 0x5d, 0x17, 0xb2, 0x38, 0xbf, 0x0b, 0x41, 0x1d, 0xa8,
-	0x24, 0x2f, 0x34, 0xd7, 0x6c, 0xbf, 0x92, 0xbc, 0x50, 0x8e, 0x94, 0x2c, 0x7d, 0xae, 0x39, 0x66,
-	0xfb, 0xfa, 0x59, 0x05, 0x51, 0x4c, 0x24, 0xa7, 0x7d, 0x05, 0x8b, 0xeb, 0x68, 0x3f, 0x4c, 0x48,
-	0xbc, 0xff, 0xda, 0x63, 0x5a, 0x89, 0x2c, 0x92, 0xe2, 0xab, 0xea, 0x60, 0x4a, 0x2e, 0xda, 0x93,
-	0x5c, 0xbc, 0x06, 0x2d, 0x73, 0x38, 0xe3, 0xf3, 0xea, 0xec, 0x79, 0x95, 0x81, 0x8a, 0xb2, 0x17,
-	0x19, 0xe1, 0x94, 0x88, 0x3c, 0xed, 0x43, 0x92, 0xc5, 0x4f, 0x8c, 0x04, 0x9d, 0x87, 0x9a, 0x64,
-	0x69, 0xf0, 0xbc, 0x48, 0x57, 0x92, 0xa5, 0x8f, 0xd0, 0x4f, 0x60, 0x45, 0x10, 0x1c, 0x91, 0x30,
-	0x28, 0xd3, 0x8b, 0x08, 0x84, 0xbe, 0x36, 0x09, 0xdd, 0xba, 0x76, 0xb3, 0x6b, 0x2c, 0xf6, 0x4a,
-	0x83, 0xbd, 0x5c, 0xaf, 0xbc, 0xd8, 0x37, 0x65, 0xfb, 0xd4, 0xb4, 0x86, 0xae, 0xec, 0xd1, 0x58,
-	0x55, 0x4e, 0xf8, 0x11, 0xb8, 0x83, 0x88, 0xf5, 0x70, 0x14, 0x1c, 0xd9, 0x55, 0xb7, 0x10, 0xb6,
-	0x7f, 0xd1, 0xe8, 0xf7, 0x66, 0xb6, 0x54, 0xd7, 0x13, 0x11, 0xed, 0x93, 0x30, 0xe8, 0x45, 0xac,
-	0xe7, 0x82, 0xa6, 0x2b, 0x18, 0x91, 0xca, 0x57, 0x8a, 0xa6, 0xb9, 0x81, 0x82, 0xa1, 0xcf, 0xb2,
-	0x44, 0x6a, 0xf2, 0xd9, 0x7e, 0xc7, 0xc8, 0x1f, 0x67, 0xf1, 0xa6, 0x92, 0xa2, 0x6f, 0xc1, 0x62,
-	0x6e, 0xc9, 0xf6, 0xf7, 0x05, 0x91, 0x9a, 0x75, 0xb6, 0xdf, 0x36, 0xc2, 0x9f, 0x69, 0x99, 0xf7,
-	0x37, 0x1b, 0xce, 0xf9, 0x0a, 0x5d, 0x72, 0x40, 0xfe, 0x9f, 0xf2, 0xca, 0xeb, 0xe2, 0x7b, 0xe1,
-	0x54, 0xf1, 0x5d, 0x3f, 0x71, 0x7c, 0x37, 0x4e, 0x15, 0xdf, 0xcd, 0xd3, 0xc5, 0x37, 0xbc, 0x26,
-	0xbe, 0x97, 0xa1, 0x16, 0xd1, 0x98, 0x16, 0x0e, 0x36, 0x03, 0xef, 0xcf, 0x53, 0x2e, 0x7b, 0x07,
-	0x62, 0xf6, 0x06, 0xd8, 0x34, 0x34, 0x05, 0x64, 0x6b, 0xdd, 0x9d, 0xfb, 0xc6, 0xdc, 0xea, 0x0a,
-	0x5f, 0x19, 0xcd, 0xbe, 0x65, 0x6b, 0xa7, 0x7e, 0xcb, 0xfe, 0x14, 0x2e, 0x1f, 0x8d, 0x64, 0x9e,
-	0xc3, 0x11, 0xba, 0x0b, 0xda, 0xa3, 0x97, 0x66, 0x43, 0xb9, 0xc0, 0x2b, 0x44, 0x3f, 0x84, 0xe5,
-	0x89, 0x58, 0x1e, 0x4f, 0xac, 0x9b, 0xce, 0x7e, 0xac, 0x1b, 0x4f, 0x39, 0x2e, 0x9a, 0x1b, 0xc7,
-	0x45, 0xb3, 0xf7, 0x4f, 0x1b, 0x16, 0xbb, 0x24, 0x22, 0x92, 0x7c, 0x53, 0x04, 0xbe, 0xb6, 0x08,
-	0xfc, 0x01, 0x20, 0x9a, 0xc8, 0xbb, 0x1f, 0x07, 0x29, 0xa7, 0x31, 0xe6, 0xa3, 0xe0, 0x39, 0x19,
-	0x15, 0x69, 0xd2, 0xd1, 0x9a, 0x5d, 0xa3, 0x78, 0x44, 0x46, 0xe2, 0x8d, 0x45, 0xe1, 0x64, 0x15,
-	0x66, 0xc2, 0xa6, 0xac, 0xc2, 0x7e, 0x0c, 0xed, 0xa9, 0x2d, 0xda, 0x6f, 0x20, 0x6c, 0x2b, 0x1d,
-	0xef, 0xeb, 0xfd, 0xc7, 0x82, 0xe6, 0x36, 0xc3, 0xa1, 0xee, 0x87, 0xce, 0xe8, 0xc6, 0xb2, 0xd4,
-	0xad, 0xcc, 0x96, 0xba, 0x57, 0x60, 0xdc, 0xd2, 0xe4, 0x8e, 0x9c, 0xe8, 0x71, 0x26, 0x7a, 0x95,
-	0xea, 0x74, 0xaf, 0x72, 0x0d, 0x5a, 0x54, 0x1d, 0x28, 0x48, 0xb1, 0x1c, 0x9a, 0x4c, 0xd9, 0xf4,
-	0x41, 0x8b, 0x76, 0x95, 0x44, 0x35, 0x33, 0x85, 0x81, 0x6e, 0x66, 0x16, 0x4e, 0xdc, 0xcc, 0xe4,
-	0x8b, 0xe8, 0x66, 0xe6, 0xd7, 0x16, 0x80, 0xbe, 0xb8, 0xca, 0x07, 0x47, 0x17, 0xb5, 0xce, 0xb2,
-	0xa8, 0x4a, 0xe1, 0xda, 0x53, 0x24, 0xc2, 0x72, 0x1c, 0x54, 0x22, 0x07, 0x07, 0x29, 0xaf, 0x19,
-	0x55, 0x1e, 0x50, 0xc2, 0xfb, 0x9d, 0x05, 0xa0, 0xb3, 0x82, 0x39, 0xc6, 0x2c, 0xfd, 0xac, 0xe3,
-	0xdb, 0xbc, 0xca, 0x34, 0x74, 0x1b, 0x05, 0x74, 0xc7, 0x7c, 0x47, 0x9d, 0xa8, 0xcb, 0x8b, 0xcb,
-	0xe7, 0xe8, 0xea, 0x67, 0xef, 0xf7, 0x16, 0xb4, 0xf3, 0xd3, 0x99, 0x23, 0x4d, 0x79, 0xd9, 0x9a,
-	0xf5, 0xb2, 0x2e, 0x6e, 0x62, 0xc6, 0x47, 0x81, 0xa0, 0xaf, 0x48, 0x7e, 0x20, 0x30, 0xa2, 0x3d,
-	0xfa, 0x8a, 0x4c, 0x91, 0xd7, 0x9e, 0x26, 0xef, 0x4d, 0x58, 0xe2, 0xa4, 0x4f, 0x12, 0x19, 0x8d,
-	0x82, 0x98, 0x85, 0x74, 0x9f, 0x92, 0x50, 0xb3, 0xa1, 0xe1, 0x3b, 0x85, 0x62, 0x27, 0x97, 0x7b,
-	0xbf, 0xb2, 0xa0, 0xb5, 0x23, 0x06, 0xbb, 0x4c, 0xe8, 0x20, 0x43, 0xd7, 0xa1, 0x9d, 0x27, 0x36,
-	0x13, 0xe1, 0x96, 0x66, 0x58, 0xab, 0x3f, 0xfe, 0x16, 0xa9, 0x52, 0x7b, 0x2c, 0x06, 0x39, 0x4c,
-	0x6d, 0xdf, 0x0c, 0xd0, 0x0a, 0x34, 0x62, 0x31, 0xd0, 0xb5, 0x78, 0x4e, 0xcb, 0x72, 0xac, 0xee,
-	0x3a, 0x7e, 0x85, 0x55, 0xf5, 0x2b, 0x6c, 0x2c, 0xf0, 0xbe, 0xb0, 0x00, 0xe5, 0xdf, 0x3a, 0xdf,
-	0xea, 0xd7, 0x84, 0xf6, 0xf2, 0xe4, 0xf7, 0xd4, 0x8a, 0xe6, 0xf8, 0x94, 0x6c, 0x26, 0x29, 0xd8,
-	0x47, 0x92, 0xc2, 0x4d, 0x58, 0x0a, 0xc9, 0x3e, 0xce, 0xa2, 0xc9, 0xb7, 0xae, 0x39, 0xb2, 0x93,
-	0x2b, 0xa6, 0xbe, 0xed, 0x77, 0x36, 0x39, 0x09, 0x49, 0x22, 0x29, 0x8e, 0xf4, 0x2f, 0xa7, 0x15,
-	0x68, 0x64, 0x42, 0x31, 0xa1, 0xc4, 0xae, 0x1c, 0xa3, 0x0f, 0x01, 0x91, 0xa4, 0xcf, 0x47, 0xa9,
-	0x22, 0x71, 0x8a, 0x85, 0x38, 0x64, 0x3c, 0xcc, 0x13, 0xf5, 0x52, 0xa9, 0xd9, 0xcd, 0x15, 0xaa,
-	0x69, 0x95, 0x24, 0xc1, 0x89, 0x2c, 0xf2, 0xb5, 0x19, 0x29, 0xd7, 0x53, 0x11, 0x88, 0x2c, 0x25,
-	0x3c, 0x77, 0x6b, 0x9d, 0x8a, 0x3d, 0x35, 0x54, 0xa9, 0x5c, 0x0c, 0xf1, 0xfa, 0x27, 0x77, 0xc7,
-	0xcb, 0x9b, 0x14, 0xdd, 0x31, 0xe2, 0x62, 0x6d, 0xef, 0x01, 0x2c, 0x6d, 0x53, 0x21, 0x77, 0x59,
-	0x44, 0xfb, 0xa3, 0x33, 0xbf, 0x71, 0xbc, 0xcf, 0x2d, 0x40, 0x93, 0xeb, 0xe4, 0x7f, 0x36, 0xc6,
-	0x15, 0x83, 0x75, 0xf2, 0x8a, 0xe1, 0x3a, 0xb4, 0x53, 0xbd, 0x4c, 0x40, 0x93, 0x7d, 0x56, 0x78,
-	0xaf, 0x65, 0x64, 0x0a, 0x5b, 0x81, 0xae, 0x02, 0x28, 0x30, 0x03, 0xce, 0x22, 0x62, 0x9c, 0xd7,
-	0xf4, 0x9b, 0x4a, 0xe2, 0x2b, 0x81, 0x37, 0x80, 0x4b, 0x7b, 0x43, 0x76, 0xb8, 0xc9, 0x92, 0x7d,
-	0x3a, 0xc8, 0x38, 0x56, 0x84, 0x7e, 0x8b, 0x2f, 0x66, 0x2e, 0xd4, 0x53, 0x2c, 0x55, 0x58, 0xe7,
-	0x3e, 0x2a, 0x86, 0xde, 0x1f, 0x2c, 0x58, 0x99, 0xb7, 0xd3, 0xdb, 0x5c, 0xff, 0x21, 0x2c, 0xf6,
-	0xcd, 0x72, 0x66, 0xb5, 0x93, 0xff, 0x3a, 0x9c, 0x9e, 0xe7, 0x3d, 0x80, 0xaa, 0x8f, 0x25, 0x41,
-	0xb7, 0xa1, 0xc2, 0xa5, 0x3e, 0x41, 0x67, 0xfd, 0xda, 0x6b, 0x92, 0x95, 0x32, 0xd4, 0xdd, 0x70,
-	0x85, 0x4b, 0xd4, 0x06, 0x8b, 0xeb, 0x9b, 0x5a, 0xbe, 0xc5, 0x6f, 0xac, 0xc3, 0xd2, 0x91, 0x4f,
-	0x0c, 0xa8, 0x0d, 0x0d, 0x9f, 0x1d, 0x2a, 0x8c, 0x42, 0xe7, 0x3d, 0x74, 0x0e, 0x5a, 0x9b, 0x2c,
-	0xca, 0xe2, 0xc4, 0x08, 0xac, 0x1b, 0x7f, 0xb1, 0xa0, 0x51, 0x2c, 0x89, 0x96, 0x60, 0xb1, 0xdb,
-	0xdd, 0x1e, 0xff, 0xaf, 0x70, 0xde, 0x43, 0x0e, 0xb4, 0xbb, 0xdd, 0xed, 0xf2, 0x6b, 0xb7, 0x63,
-	0xa9, 0x05, 0xbb, 0xdd, 0x6d, 0x9d, 0x33, 0x9d, 0x4a, 0x3e, 0xfa, 0x2c, 0xca, 0xc4, 0xd0, 0xb1,
-	0xcb, 0x05, 0xe2, 0x14, 0x9b, 0x05, 0xaa, 0x68, 0x11, 0x9a, 0xdd, 0x9d, 0x6d, 0x73, 0x2e, 0xa7,
-	0x96, 0x0f, 0x4d, 0xd9, 0xe4, 0x2c, 0xa8, 0xf3, 0x74, 0x77, 0xb6, 0x37, 0xb2, 0xe8, 0xb9, 0x7a,
-	0xfd, 0x3a, 0x75, 0xad, 0x7f, 0xb2, 0x6d, 0x7a, 0x2d, 0xa7, 0xa1, 0x97, 0x7f, 0xb2, 0xad, 0xba,
-	0xbf, 0x91, 0xd3, 0xdc, 0xb8, 0xf7, 0x8b, 0x4f, 0x06, 0x54, 0x0e, 0xb3, 0x9e, 0x02, 0xf5, 0xb6,
-	0xc1, 0xe7, 0x43, 0xca, 0xf2, 0xa7, 0xdb, 0x05, 0x46, 0xb7, 0x35, 0x64, 0xe5, 0x30, 0xed, 0xf5,
-	0x16, 0xb4, 0xe4, 0xa3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xad, 0xe9, 0xa6, 0xf9, 0x00, 0x1f,
-	0x00, 0x00,
+	// 2239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6f, 0xdc, 0xc8,
+	0xd1, 0x5e, 0x0e, 0xe7, 0xb3, 0x66, 0x34, 0xa6, 0xda, 0xb2, 0x97, 0x96, 0xed, 0xb5, 0xcc, 0xf7,
+	0xdd, 0x44, 0xb1, 0xb3, 0xb6, 0xa3, 0xdd, 0xb5, 0x03, 0x24, 0xc8, 0xc2, 0xd2, 0x78, 0x0d, 0xc1,
+	0x92, 0x23, 0x53, 0x86, 0x81, 0xe4, 0x42, 0xf4, 0x0c, 0x5b, 0x33, 0x1d, 0xf3, 0x4b, 0xdd, 0x4d,
+	0xc9, 0xe3, 0x53, 0x0e, 0x39, 0x65, 0x91, 0x9c, 0x92, 0x4b, 0x80, 0xe4, 0x1c, 0x04, 0x08, 0x90,
+	0xdb, 0x1e, 0x03, 0xe4, 0x94, 0x1f, 0x90, 0x5f, 0x13, 0xe4, 0x10, 0x74, 0x37, 0xc9, 0xe1, 0x8c,
+	0x46, 0xb2, 0x24, 0x63, 0x77, 0x1d, 0x60, 0x6f, 0xec, 0xaa, 0xea, 0xea, 0xea, 0xaa, 0xa7, 0x8a,
+	0x55, 0x24, 0x74, 0x69, 0x24, 0x08, 0x8b, 0x70, 0x70, 0x27, 0x61, 0xb1, 0x88, 0xd1, 0xa5, 0x90,
+	0x06, 0x07, 0x29, 0xd7, 0xab, 0x3b, 0x39, 0x73, 0xb9, 0x33, 0x88, 0xc3, 0x30, 0x8e, 0x34, 0x79,
+	0xb9, 0xc3, 0x07, 0x23, 0x12, 0x62, 0xbd, 0x72, 0xae, 0xc2, 0x95, 0xc7, 0x44, 0x3c, 0xa7, 0x21,
+	0x79, 0x4e, 0x07, 0x2f, 0x37, 0x46, 0x38, 0x8a, 0x48, 0xe0, 0x92, 0xfd, 0x94, 0x70, 0xe1, 0x5c,
+	0x87, 0xab, 0x8f, 0x89, 0xd8, 0x15, 0x58, 0x50, 0x2e, 0xe8, 0x80, 0xcf, 0xb0, 0x2f, 0xc1, 0xc5,
+	0xc7, 0x44, 0xf4, 0xfc, 0x19, 0xf2, 0x0b, 0x68, 0x3e, 0x8d, 0x7d, 0xb2, 0x19, 0xed, 0xc5, 0xe8,
+	0x3e, 0x34, 0xb0, 0xef, 0x33, 0xc2, 0xb9, 0x6d, 0xac, 0x18, 0xab, 0xed, 0xb5, 0x6b, 0x77, 0xa6,
+	0x6c, 0xcc, 0x2c, 0x7b, 0xa8, 0x65, 0xdc, 0x5c, 0x18, 0x21, 0xa8, 0xb2, 0x38, 0x20, 0x76, 0x65,
+	0xc5, 0x58, 0x6d, 0xb9, 0xea, 0xd9, 0xf9, 0x05, 0xc0, 0x66, 0x44, 0xc5, 0x0e, 0x66, 0x38, 0xe4,
+	0xe8, 0x32, 0xd4, 0x23, 0x79, 0x4a, 0x4f, 0x29, 0x36, 0xdd, 0x6c, 0x85, 0x7a, 0xd0, 0xe1, 0x02,
+	0x33, 0xe1, 0x25, 0x4a, 0xce, 0xae, 0xac, 0x98, 0xab, 0xed, 0xb5, 0x9b, 0x73, 0x8f, 0x7d, 0x42,
+	0xc6, 0x2f, 0x70, 0x90, 0x92, 0x1d, 0x4c, 0x99, 0xdb, 0x56, 0xdb, 0xb4, 0x76, 0xe7, 0x67, 0x00,
+	0xbb, 0x82, 0xd1, 0x68, 0xb8, 0x45, 0xb9, 0x90, 0x67, 0x1d, 0x48, 0x39, 0x79, 0x09, 0x73, 0xb5,
+	0xe5, 0x66, 0x2b, 0xf4, 0x31, 0xd4, 0xb9, 0xc0, 0x22, 0xe5, 0xca, 0xce, 0xf6, 0xda, 0xd5, 0xb9,
+	0xa7, 0xec, 0x2a, 0x11, 0x37, 0x13, 0x75, 0x3e, 0x83, 0x76, 0xee, 0xee, 0x6d, 0x3e, 0x44, 0xf7,
+	0xa0, 0xda, 0xc7, 0x9c, 0x9c, 0xe8, 0x9e, 0x6d, 0x3e, 0x5c, 0xc7, 0x9c, 0xb8, 0x4a, 0xd2, 0xf9,
+	0x6b, 0x05, 0x96, 0xa6, 0xc2, 0x92, 0x39, 0xfe, 0xec, 0xaa, 0xa4, 0x9b, 0xfd, 0xfe, 0x66, 0x4f,
+	0x99, 0x6f, 0xba, 0xea, 0x19, 0x39, 0xd0, 0x19, 0xc4, 0x41, 0x40, 0x06, 0x82, 0xc6, 0xd1, 0x66,
+	0xcf, 0x36, 0x15, 0x6f, 0x8a, 0x26, 0x65, 0x12, 0xcc, 0x04, 0xd5, 0x4b, 0x6e, 0x57, 0x57, 0x4c,
+	0x29, 0x53, 0xa6, 0xa1, 0xef, 0x81, 0x25, 0x18, 0x3e, 0x20, 0x81, 0x27, 0x68, 0x48, 0xb8, 0xc0,
+	0x61, 0x62, 0xd7, 0x56, 0x8c, 0xd5, 0xaa, 0x7b, 0x41, 0xd3, 0x9f, 0xe7, 0x64, 0x74, 0x17, 0x2e,
+	0x0e, 0x53, 0xcc, 0x70, 0x24, 0x08, 0x29, 0x49, 0xd7, 0x95, 0x34, 0x2a, 0x58, 0x93, 0x0d, 0xb7,
+	0x61, 0x51, 0x8a, 0xc5, 0xa9, 0x28, 0x89, 0x37, 0x94, 0xb8, 0x95, 0x31, 0x0a, 0x61, 0xe7, 0x4b,
+	0x03, 0x2e, 0xcd, 0xf8, 0x8b, 0x27, 0x71, 0xc4, 0xc9, 0x39, 0x1c, 0x76, 0x9e, 0x88, 0xa3, 0x07,
+	0x50, 0x93, 0x4f, 0xdc, 0x36, 0x4f, 0x8b, 0x45, 0x2d, 0xef, 0xfc, 0xda, 0x84, 0xf7, 0x37, 0x18,
+	0xc1, 0x82, 0x6c, 0x14, 0xde, 0x3f, 0x7f, 0xb0, 0xdf, 0x87, 0x86, 0xdf, 0xf7, 0x22, 0x1c, 0xe6,
+	0x69, 0x55, 0xf7, 0xfb, 0x4f, 0x71, 0x48, 0xd0, 0x77, 0xa0, 0x3b, 0x89, 0xae, 0xa4, 0xa8, 0x98,
+	0xb7, 0xdc, 0x19, 0x2a, 0xfa, 0x7f, 0x58, 0x28, 0x22, 0xac, 0xc4, 0xaa, 0x4a, 0x6c, 0x9a, 0x58,
+	0x60, 0xaa, 0x76, 0x02, 0xa6, 0xea, 0x73, 0x30, 0xb5, 0x02, 0xed, 0x12, 0x7e, 0x54, 0x34, 0x4d,
+	0xb7, 0x4c, 0x92, 0x69, 0xa8, 0x6b, 0x97, 0xdd, 0x5c, 0x31, 0x56, 0x3b, 0x6e, 0xb6, 0x42, 0xf7,
+	0xe0, 0xe2, 0x01, 0x65, 0x22, 0xc5, 0x41, 0x56, 0x89, 0xa4, 0x1d, 0xdc, 0x6e, 0xa9, 0x5c, 0x9d,
+	0xc7, 0x42, 0x6b, 0xb0, 0x94, 0x8c, 0xc6, 0x9c, 0x0e, 0x66, 0xb6, 0x80, 0xda, 0x32, 0x97, 0xe7,
+	0xfc, 0xc3, 0x80, 0x4b, 0x3d, 0x16, 0x27, 0xef, 0x44, 0x28, 0x72, 0x27, 0x57, 0x4f, 0x70, 0x72,
+	0xed, 0xa8, 0x93, 0x9d, 0xdf, 0x54, 0xe0, 0xb2, 0x46, 0xd4, 0x4e, 0xee, 0xd8, 0xaf, 0xe0, 0x16,
+	0xdf, 0x85, 0x0b, 0x93, 0x53, 0xb5, 0xc0, 0xfc, 0x6b, 0x7c, 0x08, 0xdd, 0x22, 0xc0, 0x5a, 0xee,
+	0xeb, 0x85, 0x94, 0xf3, 0x45, 0x05, 0x96, 0x64, 0x50, 0xbf, 0xf5, 0x86, 0xf4, 0xc6, 0x9f, 0x0c,
+	0x40, 0x1a, 0x1d, 0x0f, 0x03, 0x8a, 0xf9, 0x37, 0xe9, 0x8b, 0x25, 0xa8, 0x61, 0x69, 0x43, 0xe6,
+	0x02, 0xbd, 0x70, 0x38, 0x58, 0x32, 0x5a, 0x5f, 0x95, 0x75, 0xc5, 0xa1, 0x66, 0xf9, 0xd0, 0x3f,
+	0x1a, 0xb0, 0xf8, 0x30, 0x10, 0x84, 0xbd, 0xa3, 0x4e, 0xf9, 0x7b, 0x25, 0x8f, 0xda, 0x66, 0xe4,
+	0x93, 0x57, 0xdf, 0xa4, 0x81, 0xd7, 0x01, 0xf6, 0x28, 0x09, 0xfc, 0x32, 0x7a, 0x5b, 0x8a, 0xf2,
+	0x56, 0xc8, 0xb5, 0xa1, 0xa1, 0x94, 0x14, 0xa8, 0xcd, 0x97, 0xb2, 0xdb, 0x23, 0xaf, 0x04, 0xc3,
+	0x79, 0xb7, 0xd7, 0x3c, 0x75, 0xb7, 0xa7, 0xb6, 0x65, 0xdd, 0xde, 0x26, 0x74, 0x3f, 0x97, 0x0a,
+	0x5f, 0xe0, 0x80, 0xfa, 0x3d, 0x2c, 0x70, 0xf9, 0x44, 0x63, 0xfa, 0xc4, 0xeb, 0x00, 0x07, 0x52,
+	0xcc, 0xf3, 0xb1, 0xc0, 0xaa, 0xbb, 0x6c, 0xba, 0xad, 0x83, 0x7c, 0xa3, 0xf3, 0xbb, 0x1a, 0x2c,
+	0x6c, 0x46, 0x9c, 0x30, 0x71, 0xfe, 0x38, 0x5c, 0x83, 0x16, 0x1f, 0x61, 0xa6, 0x7c, 0x96, 0x45,
+	0x62, 0x42, 0x28, 0x47, 0xc9, 0x7c, 0x53, 0x94, 0xaa, 0xa7, 0xac, 0x33, 0xb5, 0x93, 0xea, 0x4c,
+	0xfd, 0x84, 0x68, 0x35, 0xde, 0x5c, 0x67, 0x9a, 0x47, 0x5f, 0xe4, 0xf2, 0x82, 0x64, 0x18, 0x92,
+	0x48, 0x6c, 0xf6, 0xec, 0x96, 0xe2, 0x4f, 0x08, 0xe8, 0x03, 0x80, 0xa2, 0xa9, 0xd3, 0xaf, 0xe4,
+	0xaa, 0x5b, 0xa2, 0xc8, 0x36, 0x80, 0xc5, 0x87, 0xb2, 0xed, 0x6c, 0xab, 0xb6, 0x33, 0x5b, 0xa1,
+	0x4f, 0xa0, 0xc9, 0xe2, 0x43, 0x1d, 0x97, 0x8e, 0xc2, 0xc1, 0x95, 0xb9, 0xce, 0x5e, 0x0f, 0xe2,
+	0xbe, 0xdb, 0x60, 0xf1, 0xa1, 0x8a, 0xf4, 0x67, 0xd0, 0x56, 0xa1, 0xe5, 0x7a, 0xe3, 0x82, 0xda,
+	0xf8, 0xc1, 0xf4, 0xc6, 0x6c, 0x62, 0x52, 0x18, 0x91, 0x9b, 0x5c, 0x8d, 0x72, 0xae, 0x14, 0x5c,
+	0x81, 0x66, 0x94, 0x86, 0x1e, 0x8b, 0x0f, 0xb9, 0xdd, 0x55, 0x2d, 0x68, 0x23, 0x4a, 0x43, 0x37,
+	0x3e, 0xe4, 0x68, 0x1d, 0x1a, 0x07, 0x84, 0x71, 0x1a, 0x47, 0xf6, 0x85, 0x15, 0x63, 0xb5, 0xbb,
+	0xb6, 0x7a, 0x67, 0xee, 0x84, 0x76, 0x47, 0x23, 0x46, 0xaa, 0x7b, 0xa1, 0xe5, 0xdd, 0x7c, 0x23,
+	0xea, 0x4d, 0xe1, 0xcd, 0x52, 0xe6, 0x7d, 0x78, 0x8c, 0x9a, 0x69, 0x10, 0x97, 0x61, 0xf9, 0xaf,
+	0x2a, 0x2c, 0xec, 0x12, 0xcc, 0x06, 0xa3, 0xf3, 0xc3, 0x72, 0x09, 0x6a, 0x8c, 0xec, 0x17, 0xd3,
+	0x82, 0x5e, 0x14, 0x28, 0x31, 0x4f, 0x40, 0x49, 0xf5, 0x14, 0x23, 0x44, 0x6d, 0xce, 0x08, 0x61,
+	0x81, 0xe9, 0xf3, 0x40, 0x01, 0xb0, 0xe5, 0xca, 0x47, 0xd9, 0xf8, 0x27, 0x01, 0x1e, 0x90, 0x51,
+	0x1c, 0xf8, 0x84, 0x79, 0x43, 0x16, 0xa7, 0xba, 0xf1, 0xef, 0xb8, 0x56, 0x89, 0xf1, 0x58, 0xd2,
+	0xd1, 0x03, 0x68, 0xfa, 0x3c, 0xf0, 0xc4, 0x38, 0x21, 0x0a, 0x85, 0xdd, 0x63, 0xae, 0xd9, 0xe3,
+	0xc1, 0xf3, 0x71, 0x42, 0xdc, 0x86, 0xaf, 0x1f, 0xd0, 0x3d, 0x58, 0xe2, 0x84, 0x51, 0x1c, 0xd0,
+	0xd7, 0xc4, 0xf7, 0xc8, 0xab, 0x84, 0x79, 0x49, 0x80, 0x23, 0x05, 0xd5, 0x8e, 0x8b, 0x26, 0xbc,
+	0x47, 0xaf, 0x12, 0xb6, 0x13, 0xe0, 0x08, 0xad, 0x82, 0x15, 0xa7, 0x22, 0x49, 0x85, 0x97, 0x81,
+	0x89, 0xfa, 0x0a, 0xb9, 0xa6, 0xdb, 0xd5, 0x74, 0x15, 0x1a, 0xbe, 0xe9, 0xcf, 0x1d, 0x8b, 0xda,
+	0x67, 0x1a, 0x8b, 0x3a, 0x67, 0x1b, 0x8b, 0x16, 0xe6, 0x8f, 0x45, 0xa8, 0x0b, 0x95, 0x68, 0x5f,
+	0x21, 0xd6, 0x74, 0x2b, 0xd1, 0xbe, 0x0c, 0xa4, 0x88, 0x93, 0x97, 0x0a, 0xa9, 0xa6, 0xab, 0x9e,
+	0x65, 0x2a, 0x86, 0x44, 0x30, 0x3a, 0x90, 0x6e, 0xb1, 0x2d, 0x15, 0x87, 0x12, 0xc5, 0xf9, 0x8f,
+	0x39, 0x81, 0x15, 0x4f, 0x03, 0xc1, 0xbf, 0xae, 0x91, 0xaa, 0xc0, 0xa2, 0x59, 0xc6, 0xe2, 0x0d,
+	0x68, 0x6b, 0xe3, 0x74, 0xcc, 0xab, 0xb3, 0xf6, 0x4a, 0x01, 0x99, 0xab, 0xfb, 0x29, 0x61, 0x94,
+	0xf0, 0xec, 0x3d, 0x04, 0x51, 0x1a, 0x3e, 0xd3, 0x14, 0x74, 0x11, 0x6a, 0x22, 0x4e, 0xbc, 0x97,
+	0x79, 0xd1, 0x13, 0x71, 0xf2, 0x04, 0xfd, 0x18, 0x96, 0x39, 0xc1, 0x01, 0xf1, 0xbd, 0xa2, 0x48,
+	0x71, 0x8f, 0xab, 0x6b, 0x13, 0xdf, 0x6e, 0xa8, 0x30, 0xdb, 0x5a, 0x62, 0xb7, 0x10, 0xd8, 0xcd,
+	0xf8, 0x32, 0x8a, 0x03, 0x3d, 0x47, 0x4c, 0x6d, 0x6b, 0xaa, 0x51, 0x03, 0x4d, 0x58, 0xc5, 0x86,
+	0x1f, 0x82, 0x3d, 0x0c, 0xe2, 0x3e, 0x0e, 0xbc, 0x23, 0xa7, 0xaa, 0x99, 0xc6, 0x74, 0x2f, 0x6b,
+	0xfe, 0xee, 0xcc, 0x91, 0xf2, 0x7a, 0x3c, 0xa0, 0x03, 0xe2, 0x7b, 0xfd, 0x20, 0xee, 0xdb, 0xa0,
+	0xe0, 0x0a, 0x9a, 0x24, 0xab, 0x9e, 0x84, 0x69, 0x26, 0x20, 0xdd, 0x30, 0x88, 0xd3, 0x48, 0x28,
+	0xf0, 0x99, 0x6e, 0x57, 0xd3, 0x9f, 0xa6, 0xe1, 0x86, 0xa4, 0xa2, 0xff, 0x83, 0x85, 0x4c, 0x32,
+	0xde, 0xdb, 0xe3, 0x44, 0x28, 0xd4, 0x99, 0x6e, 0x47, 0x13, 0x7f, 0xaa, 0x68, 0xce, 0xdf, 0x4c,
+	0xb8, 0xe0, 0x4a, 0xef, 0x92, 0x03, 0xf2, 0xbf, 0x54, 0x57, 0x8e, 0xcb, 0xef, 0xfa, 0x99, 0xf2,
+	0xbb, 0x71, 0xea, 0xfc, 0x6e, 0x9e, 0x29, 0xbf, 0x5b, 0x67, 0xcb, 0x6f, 0x38, 0x26, 0xbf, 0x97,
+	0xa0, 0x16, 0xd0, 0x90, 0xe6, 0x01, 0xd6, 0x0b, 0xe7, 0xcf, 0x53, 0x21, 0x7b, 0x07, 0x72, 0xf6,
+	0x16, 0x98, 0xd4, 0xd7, 0x1d, 0x6d, 0x7b, 0xcd, 0x9e, 0xfb, 0xde, 0xdd, 0xec, 0x71, 0x57, 0x0a,
+	0xcd, 0xbe, 0xab, 0x6b, 0x67, 0x7e, 0x57, 0xff, 0x04, 0xae, 0x1e, 0xcd, 0x64, 0x96, 0xb9, 0xc3,
+	0xb7, 0xeb, 0x2a, 0xa2, 0x57, 0x66, 0x53, 0x39, 0xf7, 0x97, 0x8f, 0x7e, 0x00, 0x4b, 0xa5, 0x5c,
+	0x9e, 0x6c, 0x6c, 0xe8, 0x4f, 0x0d, 0x13, 0xde, 0x64, 0xcb, 0x49, 0xd9, 0xdc, 0x3c, 0x29, 0x9b,
+	0x9d, 0x7f, 0x9a, 0xb0, 0xd0, 0x23, 0x01, 0x11, 0xe4, 0xdb, 0x56, 0xf2, 0xd8, 0x56, 0xf2, 0xfb,
+	0x80, 0x68, 0x24, 0xee, 0x7f, 0xe2, 0x25, 0x8c, 0x86, 0x98, 0x8d, 0xbd, 0x97, 0x64, 0x9c, 0x97,
+	0x49, 0x4b, 0x71, 0x76, 0x34, 0xe3, 0x09, 0x19, 0xf3, 0x37, 0xb6, 0x96, 0xe5, 0x5e, 0x4e, 0xa7,
+	0x4d, 0xd1, 0xcb, 0xfd, 0x08, 0x3a, 0x53, 0x47, 0x74, 0xde, 0x00, 0xd8, 0x76, 0x32, 0x39, 0xd7,
+	0xf9, 0xb7, 0x01, 0xad, 0xad, 0x18, 0xfb, 0x6a, 0x40, 0x3b, 0x67, 0x18, 0x8b, 0x86, 0xb9, 0x32,
+	0xdb, 0x30, 0x5f, 0x83, 0xc9, 0x8c, 0x95, 0x05, 0xb2, 0x34, 0x74, 0x95, 0x46, 0x99, 0xea, 0xf4,
+	0x28, 0x73, 0x03, 0xda, 0x54, 0x1a, 0xe4, 0x25, 0x58, 0x8c, 0x74, 0xa5, 0x6c, 0xb9, 0xa0, 0x48,
+	0x3b, 0x92, 0x22, 0xa7, 0xab, 0x5c, 0x40, 0x4d, 0x57, 0xf5, 0x53, 0x4f, 0x57, 0x99, 0x12, 0x35,
+	0x5d, 0xfd, 0xca, 0x00, 0x50, 0x17, 0x97, 0xf5, 0xe0, 0xa8, 0x52, 0xe3, 0x3c, 0x4a, 0x65, 0x09,
+	0x57, 0x91, 0x22, 0x01, 0x16, 0x93, 0xa4, 0xe2, 0x99, 0x73, 0x90, 0x8c, 0x9a, 0x66, 0x65, 0x09,
+	0xc5, 0x9d, 0xdf, 0x1a, 0x00, 0xaa, 0x2a, 0x68, 0x33, 0x66, 0xe1, 0x67, 0x9c, 0x3c, 0x77, 0x56,
+	0xa6, 0x5d, 0xb7, 0x9e, 0xbb, 0xee, 0x84, 0x0f, 0xbb, 0xa5, 0xee, 0x3e, 0xbf, 0x7c, 0xe6, 0x5d,
+	0xf5, 0xec, 0xfc, 0xde, 0x80, 0x4e, 0x66, 0x9d, 0x36, 0x69, 0x2a, 0xca, 0xc6, 0x6c, 0x94, 0x55,
+	0x73, 0x13, 0xc6, 0x6c, 0xec, 0x71, 0xfa, 0x9a, 0x64, 0x06, 0x81, 0x26, 0xed, 0xd2, 0xd7, 0x64,
+	0x0a, 0xbc, 0xe6, 0x34, 0x78, 0x6f, 0xc3, 0x22, 0x23, 0x03, 0x12, 0x89, 0x60, 0xec, 0x85, 0xb1,
+	0x4f, 0xf7, 0x28, 0xf1, 0x15, 0x1a, 0x9a, 0xae, 0x95, 0x33, 0xb6, 0x33, 0xba, 0xf3, 0x4b, 0x03,
+	0xda, 0xdb, 0x7c, 0xb8, 0x13, 0x73, 0x95, 0x64, 0xe8, 0x26, 0x74, 0xb2, 0xc2, 0xa6, 0x33, 0xdc,
+	0x50, 0x08, 0x6b, 0x0f, 0x26, 0x1f, 0x47, 0x65, 0x69, 0x0f, 0xf9, 0x30, 0x73, 0x53, 0xc7, 0xd5,
+	0x0b, 0xb4, 0x0c, 0xcd, 0x90, 0x0f, 0x55, 0x2f, 0x9e, 0xc1, 0xb2, 0x58, 0xcb, 0xbb, 0x4e, 0x5e,
+	0x61, 0x55, 0xf5, 0x0a, 0x9b, 0x10, 0x9c, 0x2f, 0x0d, 0x40, 0xd9, 0xc7, 0xd7, 0xb7, 0xfa, 0x57,
+	0xa2, 0xa2, 0x5c, 0xfe, 0xc0, 0x5b, 0x51, 0x18, 0x9f, 0xa2, 0xcd, 0x14, 0x05, 0xf3, 0x48, 0x51,
+	0xb8, 0x0d, 0x8b, 0x3e, 0xd9, 0xc3, 0x69, 0x50, 0x7e, 0xeb, 0x6a, 0x93, 0xad, 0x8c, 0x31, 0xf5,
+	0xb3, 0xa1, 0xbb, 0xc1, 0x88, 0x4f, 0x22, 0x41, 0x71, 0xa0, 0xfe, 0x81, 0x2d, 0x43, 0x33, 0xe5,
+	0x12, 0x09, 0x85, 0xef, 0x8a, 0x35, 0xfa, 0x08, 0x10, 0x89, 0x06, 0x6c, 0x9c, 0x48, 0x10, 0x27,
+	0x98, 0xf3, 0xc3, 0x98, 0xf9, 0x59, 0xa1, 0x5e, 0x2c, 0x38, 0x3b, 0x19, 0x43, 0x8e, 0xbe, 0x82,
+	0x44, 0x38, 0x12, 0x79, 0xbd, 0xd6, 0x2b, 0x19, 0x7a, 0xca, 0x3d, 0x9e, 0x26, 0x84, 0x65, 0x61,
+	0x6d, 0x50, 0xbe, 0x2b, 0x97, 0xb2, 0x94, 0xf3, 0x11, 0x5e, 0xfb, 0xf4, 0xfe, 0x44, 0xbd, 0x2e,
+	0xd1, 0x5d, 0x4d, 0xce, 0x75, 0x3b, 0x8f, 0x60, 0x71, 0x8b, 0x72, 0xb1, 0x13, 0x07, 0x74, 0x30,
+	0x3e, 0xf7, 0x1b, 0xc7, 0xf9, 0xc2, 0x00, 0x54, 0xd6, 0x93, 0xfd, 0x6a, 0x99, 0x74, 0x0c, 0xc6,
+	0xe9, 0x3b, 0x86, 0x9b, 0xd0, 0x49, 0x94, 0x1a, 0x8f, 0x46, 0x7b, 0x71, 0x1e, 0xbd, 0xb6, 0xa6,
+	0x49, 0xdf, 0x72, 0x74, 0x1d, 0x40, 0x3a, 0xd3, 0x63, 0x71, 0x40, 0x74, 0xf0, 0x5a, 0x6e, 0x4b,
+	0x52, 0x5c, 0x49, 0x70, 0x86, 0x70, 0x65, 0x77, 0x14, 0x1f, 0x6e, 0xc4, 0xd1, 0x1e, 0x1d, 0xa6,
+	0x0c, 0x4b, 0x40, 0xbf, 0xc5, 0x27, 0x3c, 0x1b, 0x1a, 0x09, 0x16, 0x32, 0xad, 0xb3, 0x18, 0xe5,
+	0x4b, 0xe7, 0x0f, 0x06, 0x2c, 0xcf, 0x3b, 0xe9, 0x6d, 0xae, 0xff, 0x18, 0x16, 0x06, 0x5a, 0x9d,
+	0xd6, 0x76, 0xfa, 0x7f, 0x99, 0xd3, 0xfb, 0x9c, 0x47, 0x50, 0x75, 0xb1, 0x20, 0xe8, 0x2e, 0x54,
+	0x98, 0x50, 0x16, 0x74, 0xd7, 0x6e, 0x1c, 0x53, 0xac, 0xa4, 0xa0, 0x9a, 0x86, 0x2b, 0x4c, 0xa0,
+	0x0e, 0x18, 0x4c, 0xdd, 0xd4, 0x70, 0x0d, 0x76, 0x6b, 0x0d, 0x16, 0x8f, 0x7c, 0xa8, 0x40, 0x1d,
+	0x68, 0xba, 0xf1, 0xa1, 0xf4, 0x91, 0x6f, 0xbd, 0x87, 0x2e, 0x40, 0x7b, 0x23, 0x0e, 0xd2, 0x30,
+	0xd2, 0x04, 0xe3, 0xd6, 0x5f, 0x0c, 0x68, 0xe6, 0x2a, 0xd1, 0x22, 0x2c, 0xf4, 0x7a, 0x5b, 0x93,
+	0x1f, 0x28, 0xd6, 0x7b, 0xc8, 0x82, 0x4e, 0xaf, 0xb7, 0x55, 0x7c, 0x7e, 0xb7, 0x0c, 0xa9, 0xb0,
+	0xd7, 0xdb, 0x52, 0x35, 0xd3, 0xaa, 0x64, 0xab, 0xcf, 0x83, 0x94, 0x8f, 0x2c, 0xb3, 0x50, 0x10,
+	0x26, 0x58, 0x2b, 0xa8, 0xa2, 0x05, 0x68, 0xf5, 0xb6, 0xb7, 0xb4, 0x5d, 0x56, 0x2d, 0x5b, 0xea,
+	0xb6, 0xc9, 0xaa, 0x4b, 0x7b, 0x7a, 0xdb, 0x5b, 0xeb, 0x69, 0xf0, 0x52, 0xbe, 0x7e, 0xad, 0x86,
+	0xe2, 0x3f, 0xdb, 0xd2, 0xb3, 0x96, 0xd5, 0x54, 0xea, 0x9f, 0x6d, 0xc9, 0xe9, 0x6f, 0x6c, 0xb5,
+	0xd6, 0x1f, 0xfc, 0xfc, 0xd3, 0x21, 0x15, 0xa3, 0xb4, 0x2f, 0x9d, 0x7a, 0x57, 0xfb, 0xe7, 0x23,
+	0x1a, 0x67, 0x4f, 0x77, 0x73, 0x1f, 0xdd, 0x55, 0x2e, 0x2b, 0x96, 0x49, 0xbf, 0x5f, 0x57, 0x94,
+	0x8f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x93, 0xe8, 0x4d, 0x5d, 0x91, 0x1f, 0x00, 0x00,
 }
@@ -116,6 +116,34 @@ func (ArithOpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{1}
 }
 
+type NullExpr_NullOp int32
+
+const (
+	NullExpr_Invalid   NullExpr_NullOp = 0
+	NullExpr_IsNull    NullExpr_NullOp = 1
+	NullExpr_IsNotNull NullExpr_NullOp = 2
+)
+
+var NullExpr_NullOp_name = map[int32]string{
+	0: "Invalid",
+	1: "IsNull",
+	2: "IsNotNull",
+}
+
+var NullExpr_NullOp_value = map[string]int32{
+	"Invalid":   0,
+	"IsNull":    1,
+	"IsNotNull": 2,
+}
+
+func (x NullExpr_NullOp) String() string {
+	return proto.EnumName(NullExpr_NullOp_name, int32(x))
+}
+
+func (NullExpr_NullOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{9, 0}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -138,7 +166,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -166,7 +194,7 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{11, 0}
 }
 
 type GenericValue struct {
@@ -711,6 +739,53 @@ func (m *TermExpr) GetValues() []*GenericValue {
 	return nil
 }
 
+type NullExpr struct {
+	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   NullExpr_NullOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.NullExpr_NullOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NullExpr) Reset()         { *m = NullExpr{} }
+func (m *NullExpr) String() string { return proto.CompactTextString(m) }
+func (*NullExpr) ProtoMessage()    {}
+func (*NullExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
+}
+
+func (m *NullExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullExpr.Unmarshal(m, b)
+}
+func (m *NullExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullExpr.Marshal(b, m, deterministic)
+}
+func (m *NullExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullExpr.Merge(m, src)
+}
+func (m *NullExpr) XXX_Size() int {
+	return xxx_messageInfo_NullExpr.Size(m)
+}
+func (m *NullExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullExpr proto.InternalMessageInfo
+
+func (m *NullExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *NullExpr) GetOp() NullExpr_NullOp {
+	if m != nil {
+		return m.Op
+	}
+	return NullExpr_Invalid
+}
+
 type UnaryExpr struct {
 	Op                   UnaryExpr_UnaryOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.plan.UnaryExpr_UnaryOp" json:"op,omitempty"`
 	Child                *Expr             `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
@@ -723,7 +798,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -771,7 +846,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -826,7 +901,7 @@ func (m *BinaryArithOp) Reset()         { *m = BinaryArithOp{} }
 func (m *BinaryArithOp) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOp) ProtoMessage()    {}
 func (*BinaryArithOp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *BinaryArithOp) XXX_Unmarshal(b []byte) error {
@@ -869,19 +944,20 @@ func (m *BinaryArithOp) GetRightOperand() *GenericValue {
 }
 
 type BinaryArithExpr struct {
-	Left                 *Expr       `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
-	Right                *Expr       `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
-	Op                   ArithOpType `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.ArithOpType" json:"op,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Left                 *Expr             `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right                *Expr             `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	Op                   ArithOpType       `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.ArithOpType" json:"op,omitempty"`
+	DataType             schemapb.DataType `protobuf:"varint,4,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *BinaryArithExpr) Reset()         { *m = BinaryArithExpr{} }
 func (m *BinaryArithExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithExpr) ProtoMessage()    {}
 func (*BinaryArithExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{12}
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
 }
 
 func (m *BinaryArithExpr) XXX_Unmarshal(b []byte) error {
@@ -923,6 +999,78 @@ func (m *BinaryArithExpr) GetOp() ArithOpType {
 	return ArithOpType_Unknown
 }
 
+func (m *BinaryArithExpr) GetDataType() schemapb.DataType {
+	if m != nil {
+		return m.DataType
+	}
+	return schemapb.DataType_None
+}
+
+// ArithCompareExpr compares two arithmetic expressions built from columns and
+// values, such as `price * quantity > 100` or `a + b < c`.
+type ArithCompareExpr struct {
+	Left                 *Expr             `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right                *Expr             `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	Op                   OpType            `protobuf:"varint,3,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
+	DataType             schemapb.DataType `protobuf:"varint,4,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ArithCompareExpr) Reset()         { *m = ArithCompareExpr{} }
+func (m *ArithCompareExpr) String() string { return proto.CompactTextString(m) }
+func (*ArithCompareExpr) ProtoMessage()    {}
+func (*ArithCompareExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
+}
+
+func (m *ArithCompareExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArithCompareExpr.Unmarshal(m, b)
+}
+func (m *ArithCompareExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArithCompareExpr.Marshal(b, m, deterministic)
+}
+func (m *ArithCompareExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArithCompareExpr.Merge(m, src)
+}
+func (m *ArithCompareExpr) XXX_Size() int {
+	return xxx_messageInfo_ArithCompareExpr.Size(m)
+}
+func (m *ArithCompareExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArithCompareExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArithCompareExpr proto.InternalMessageInfo
+
+func (m *ArithCompareExpr) GetLeft() *Expr {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *ArithCompareExpr) GetRight() *Expr {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+func (m *ArithCompareExpr) GetOp() OpType {
+	if m != nil {
+		return m.Op
+	}
+	return OpType_Invalid
+}
+
+func (m *ArithCompareExpr) GetDataType() schemapb.DataType {
+	if m != nil {
+		return m.DataType
+	}
+	return schemapb.DataType_None
+}
+
 type BinaryArithOpEvalRangeExpr struct {
 	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	ArithOp              ArithOpType   `protobuf:"varint,2,opt,name=arith_op,json=arithOp,proto3,enum=milvus.proto.plan.ArithOpType" json:"arith_op,omitempty"`
@@ -938,7 +1086,7 @@ func (m *BinaryArithOpEvalRangeExpr) Reset()         { *m = BinaryArithOpEvalRan
 func (m *BinaryArithOpEvalRangeExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryArithOpEvalRangeExpr) ProtoMessage()    {}
 func (*BinaryArithOpEvalRangeExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{13}
+	return fileDescriptor_2d655ab2f7683c23, []int{15}
 }
 
 func (m *BinaryArithOpEvalRangeExpr) XXX_Unmarshal(b []byte) error {
@@ -1006,6 +1154,8 @@ type Expr struct {
 	//	*Expr_BinaryArithExpr
 	//	*Expr_ValueExpr
 	//	*Expr_ColumnExpr
+	//	*Expr_NullExpr
+	//	*Expr_ArithCompareExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -1016,7 +1166,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{14}
+	return fileDescriptor_2d655ab2f7683c23, []int{16}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -1081,6 +1231,14 @@ type Expr_ColumnExpr struct {
 	ColumnExpr *ColumnExpr `protobuf:"bytes,10,opt,name=column_expr,json=columnExpr,proto3,oneof"`
 }
 
+type Expr_NullExpr struct {
+	NullExpr *NullExpr `protobuf:"bytes,11,opt,name=null_expr,json=nullExpr,proto3,oneof"`
+}
+
+type Expr_ArithCompareExpr struct {
+	ArithCompareExpr *ArithCompareExpr `protobuf:"bytes,12,opt,name=arith_compare_expr,json=arithCompareExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -1101,6 +1259,10 @@ func (*Expr_ValueExpr) isExpr_Expr() {}
 
 func (*Expr_ColumnExpr) isExpr_Expr() {}
 
+func (*Expr_NullExpr) isExpr_Expr() {}
+
+func (*Expr_ArithCompareExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -1178,6 +1340,20 @@ func (m *Expr) GetColumnExpr() *ColumnExpr {
 	return nil
 }
 
+func (m *Expr) GetNullExpr() *NullExpr {
+	if x, ok := m.GetExpr().(*Expr_NullExpr); ok {
+		return x.NullExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetArithCompareExpr() *ArithCompareExpr {
+	if x, ok := m.GetExpr().(*Expr_ArithCompareExpr); ok {
+		return x.ArithCompareExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1191,6 +1367,8 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_BinaryArithExpr)(nil),
 		(*Expr_ValueExpr)(nil),
 		(*Expr_ColumnExpr)(nil),
+		(*Expr_NullExpr)(nil),
+		(*Expr_ArithCompareExpr)(nil),
 	}
 }
 
@@ -1209,7 +1387,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{15}
+	return fileDescriptor_2d655ab2f7683c23, []int{17}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -1280,7 +1458,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{16}
+	return fileDescriptor_2d655ab2f7683c23, []int{18}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -1356,6 +1534,7 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
 	proto.RegisterEnum("milvus.proto.plan.ArithOpType", ArithOpType_name, ArithOpType_value)
+	proto.RegisterEnum("milvus.proto.plan.NullExpr_NullOp", NullExpr_NullOp_name, NullExpr_NullOp_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
@@ -1367,10 +1546,12 @@ func init() {
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
+	proto.RegisterType((*NullExpr)(nil), "milvus.proto.plan.NullExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
 	proto.RegisterType((*BinaryArithOp)(nil), "milvus.proto.plan.BinaryArithOp")
 	proto.RegisterType((*BinaryArithExpr)(nil), "milvus.proto.plan.BinaryArithExpr")
+	proto.RegisterType((*ArithCompareExpr)(nil), "milvus.proto.plan.ArithCompareExpr")
 	proto.RegisterType((*BinaryArithOpEvalRangeExpr)(nil), "milvus.proto.plan.BinaryArithOpEvalRangeExpr")
 	proto.RegisterType((*Expr)(nil), "milvus.proto.plan.Expr")
 	proto.RegisterType((*VectorANNS)(nil), "milvus.proto.plan.VectorANNS")
@@ -1380,93 +1561,100 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x72, 0xdc, 0x44,
-	0x10, 0x5e, 0xad, 0x76, 0xbd, 0x52, 0xef, 0x7a, 0xad, 0xe8, 0x42, 0x7e, 0x48, 0x6c, 0x44, 0x8a,
-	0x98, 0x50, 0xb1, 0x2b, 0x24, 0x24, 0x95, 0x50, 0x01, 0xff, 0x05, 0x7b, 0x8b, 0xc4, 0x36, 0x8a,
-	0xe3, 0x03, 0x17, 0xd5, 0xac, 0x34, 0xf6, 0x4e, 0x45, 0xab, 0x51, 0x46, 0xa3, 0x4d, 0xf6, 0xcc,
-	0x8d, 0x1b, 0x0f, 0xc0, 0x15, 0xee, 0xdc, 0xe0, 0xc2, 0x0b, 0x70, 0xe0, 0xc8, 0x9d, 0xb7, 0xe0,
-	0x44, 0x4d, 0x8f, 0xf6, 0x2f, 0xb5, 0x1b, 0xaf, 0x8b, 0x54, 0x71, 0x1b, 0xf5, 0x74, 0x7f, 0xd3,
-	0xfd, 0x4d, 0x77, 0x4f, 0x0b, 0x20, 0x8d, 0x49, 0xb2, 0x96, 0x0a, 0x2e, 0xb9, 0x7b, 0xa1, 0xcb,
-	0xe2, 0x5e, 0x9e, 0xe9, 0xaf, 0x35, 0xb5, 0x71, 0xb9, 0x91, 0x85, 0x1d, 0xda, 0x25, 0x5a, 0xe4,
-	0xfd, 0x60, 0x40, 0x63, 0x97, 0x26, 0x54, 0xb0, 0xf0, 0x98, 0xc4, 0x39, 0x75, 0xaf, 0x80, 0xd5,
-	0xe6, 0x3c, 0x0e, 0x7a, 0x24, 0xbe, 0x68, 0xac, 0x18, 0xab, 0xd6, 0x5e, 0xc9, 0xaf, 0x29, 0xc9,
-	0x31, 0x89, 0xdd, 0xab, 0x60, 0xb3, 0x44, 0xde, 0xbb, 0x8b, 0xbb, 0xe5, 0x15, 0x63, 0xd5, 0xdc,
-	0x2b, 0xf9, 0x16, 0x8a, 0x8a, 0xed, 0x93, 0x98, 0x13, 0x89, 0xdb, 0xe6, 0x8a, 0xb1, 0x6a, 0xa8,
-	0x6d, 0x14, 0xa9, 0xed, 0x65, 0x80, 0x4c, 0x0a, 0x96, 0x9c, 0xe2, 0x7e, 0x65, 0xc5, 0x58, 0xb5,
-	0xf7, 0x4a, 0xbe, 0xad, 0x65, 0xc7, 0x24, 0xde, 0xaa, 0x82, 0xd9, 0x23, 0xb1, 0xf7, 0xbd, 0x01,
-	0xf6, 0x37, 0x39, 0x15, 0xfd, 0x56, 0x72, 0xc2, 0x5d, 0x17, 0x2a, 0x92, 0xa7, 0x2f, 0xd0, 0x19,
-	0xd3, 0xc7, 0xb5, 0xbb, 0x0c, 0xf5, 0x2e, 0x95, 0x82, 0x85, 0x81, 0xec, 0xa7, 0x14, 0x8f, 0xb2,
-	0x7d, 0xd0, 0xa2, 0xa3, 0x7e, 0x4a, 0xdd, 0x0f, 0x61, 0x31, 0xa3, 0x44, 0x84, 0x9d, 0x20, 0x25,
-	0x82, 0x74, 0x33, 0x7d, 0x9a, 0xdf, 0xd0, 0xc2, 0x43, 0x94, 0x29, 0x25, 0xc1, 0xf3, 0x24, 0x0a,
-	0x22, 0x1a, 0xb2, 0x2e, 0x89, 0x2f, 0x56, 0xf1, 0x88, 0x06, 0x0a, 0x77, 0xb4, 0xcc, 0xfb, 0xc9,
-	0x00, 0xd8, 0xe6, 0x71, 0xde, 0x4d, 0xd0, 0x9b, 0x4b, 0x60, 0x9d, 0x30, 0x1a, 0x47, 0x01, 0x8b,
-	0x0a, 0x8f, 0x6a, 0xf8, 0xdd, 0x8a, 0xdc, 0x87, 0x60, 0x47, 0x44, 0x12, 0xed, 0x92, 0x22, 0xa7,
-	0xf9, 0xe9, 0xd5, 0xb5, 0x09, 0xfe, 0x0b, 0xe6, 0x77, 0x88, 0x24, 0xca, 0x4b, 0xdf, 0x8a, 0x8a,
-	0x95, 0x7b, 0x1d, 0x9a, 0x2c, 0x0b, 0x52, 0xc1, 0xba, 0x44, 0xf4, 0x83, 0x17, 0xb4, 0x8f, 0x31,
-	0x59, 0x7e, 0x83, 0x65, 0x87, 0x5a, 0xf8, 0x35, 0xed, 0xbb, 0x57, 0xc0, 0x66, 0x59, 0x40, 0x72,
-	0xc9, 0x5b, 0x3b, 0x18, 0x91, 0xe5, 0x5b, 0x2c, 0xdb, 0xc4, 0x6f, 0xef, 0xcb, 0x81, 0x9f, 0x8f,
-	0x5f, 0xa7, 0xc2, 0xbd, 0x0d, 0x15, 0x96, 0x9c, 0x70, 0xf4, 0xb1, 0xfe, 0xa6, 0x1f, 0x98, 0x20,
-	0xa3, 0xa0, 0x7c, 0x54, 0xf5, 0xb6, 0xc0, 0xc6, 0x14, 0x40, 0xfb, 0xcf, 0xa0, 0xda, 0x53, 0x1f,
-	0x05, 0xc0, 0xf2, 0x14, 0x80, 0xf1, 0xb4, 0xf1, 0xb5, 0xb6, 0xf7, 0x8b, 0x01, 0xcd, 0xe7, 0x09,
-	0x11, 0x7d, 0x9f, 0x24, 0xa7, 0x1a, 0xe9, 0x0b, 0xa8, 0x87, 0x78, 0x54, 0x30, 0xbf, 0x43, 0x10,
-	0x8e, 0x18, 0xff, 0x18, 0xca, 0x3c, 0x2d, 0xf8, 0xbc, 0x34, 0xc5, 0xec, 0x20, 0x45, 0x2e, 0xcb,
-	0x3c, 0x1d, 0x39, 0x6d, 0x9e, 0xcb, 0xe9, 0x9f, 0xcb, 0xb0, 0xb4, 0xc5, 0xde, 0xad, 0xd7, 0x37,
-	0x60, 0x29, 0xe6, 0xaf, 0xa8, 0x08, 0x58, 0x12, 0xc6, 0x79, 0xc6, 0x7a, 0x3a, 0x25, 0x2c, 0xbf,
-	0x89, 0xe2, 0xd6, 0x40, 0xaa, 0x14, 0xf3, 0x34, 0x9d, 0x50, 0xd4, 0x57, 0xdf, 0x44, 0xf1, 0x48,
-	0x71, 0x03, 0xea, 0x1a, 0x51, 0x87, 0x58, 0x99, 0x2f, 0x44, 0x40, 0x1b, 0x5d, 0xda, 0x1b, 0x50,
-	0xd7, 0x47, 0x69, 0x84, 0xea, 0x9c, 0x08, 0x68, 0x83, 0x6b, 0xef, 0x0f, 0x03, 0xea, 0xdb, 0xbc,
-	0x9b, 0x12, 0xa1, 0x59, 0xda, 0x05, 0x27, 0xa6, 0x27, 0x32, 0x38, 0x37, 0x55, 0x4d, 0x65, 0x36,
-	0x56, 0x56, 0x2d, 0xb8, 0x20, 0xd8, 0x69, 0x67, 0x12, 0xa9, 0x3c, 0x0f, 0xd2, 0x12, 0xda, 0x6d,
-	0xbf, 0x99, 0x2f, 0xe6, 0x1c, 0xf9, 0xe2, 0x7d, 0x67, 0x80, 0x75, 0x44, 0x45, 0xf7, 0x9d, 0xdc,
-	0xf8, 0x7d, 0x58, 0x40, 0x5e, 0xb3, 0x8b, 0xe5, 0x15, 0x73, 0x1e, 0x62, 0x0b, 0x75, 0xd5, 0x82,
-	0x6d, 0xac, 0x19, 0x74, 0xe3, 0x2e, 0xba, 0x6f, 0xa0, 0xfb, 0xd7, 0xa7, 0x40, 0x0c, 0x35, 0xf5,
-	0xea, 0x20, 0xc5, 0xcc, 0xbf, 0x05, 0xd5, 0xb0, 0xc3, 0xe2, 0xa8, 0xe0, 0xec, 0xbd, 0x29, 0x86,
-	0xca, 0xc6, 0xd7, 0x5a, 0xde, 0x32, 0xd4, 0x0a, 0x6b, 0xb7, 0x0e, 0xb5, 0x56, 0xd2, 0x23, 0x31,
-	0x8b, 0x9c, 0x92, 0x5b, 0x03, 0x73, 0x9f, 0x4b, 0xc7, 0xf0, 0xfe, 0x32, 0x00, 0x74, 0x49, 0xa0,
-	0x53, 0xf7, 0xc6, 0x9c, 0xfa, 0x68, 0x0a, 0xf6, 0x48, 0xb5, 0x58, 0x16, 0x6e, 0x7d, 0x02, 0x15,
-	0x75, 0xd1, 0x67, 0x79, 0x85, 0x4a, 0x2a, 0x06, 0xbc, 0xcb, 0xa2, 0x7a, 0x67, 0xc7, 0x80, 0x5a,
-	0xde, 0x3d, 0xb0, 0x06, 0x67, 0x4d, 0x06, 0xd1, 0x04, 0x78, 0xc2, 0x4f, 0x59, 0x48, 0xe2, 0xcd,
-	0x24, 0x72, 0x0c, 0x77, 0x11, 0xec, 0xe2, 0xfb, 0x40, 0x38, 0x65, 0xef, 0x4f, 0x03, 0x16, 0xb5,
-	0xe1, 0xa6, 0x60, 0xb2, 0x73, 0x90, 0xfe, 0xe7, 0x9b, 0x7f, 0x00, 0x16, 0x51, 0x50, 0xc1, 0xb0,
-	0x4f, 0x5d, 0x9b, 0x62, 0x5c, 0x9c, 0x86, 0xc9, 0x57, 0x23, 0xc5, 0xd1, 0x3b, 0xb0, 0xa8, 0xf3,
-	0x9e, 0xa7, 0x54, 0x90, 0x24, 0x9a, 0xb7, 0x73, 0x35, 0xd0, 0xea, 0x40, 0x1b, 0x79, 0x3f, 0x1a,
-	0x83, 0x06, 0x86, 0x87, 0xe0, 0x95, 0x0d, 0xa8, 0x37, 0xce, 0x45, 0x7d, 0x79, 0x1e, 0xea, 0xdd,
-	0xb5, 0xb1, 0x12, 0x3b, 0x2b, 0x54, 0x55, 0x67, 0xbf, 0x97, 0xe1, 0xf2, 0x04, 0xe5, 0x8f, 0x7b,
-	0x24, 0x7e, 0x77, 0xbd, 0xf6, 0xff, 0xe6, 0xbf, 0x68, 0x39, 0x95, 0x73, 0x3d, 0x51, 0xd5, 0x73,
-	0x3d, 0x51, 0xff, 0x54, 0xa1, 0x82, 0x5c, 0x3d, 0x04, 0x5b, 0x52, 0xd1, 0x0d, 0xe8, 0xeb, 0x54,
-	0x14, 0x4c, 0x5d, 0x99, 0x82, 0x31, 0xe8, 0x6a, 0x6a, 0xfe, 0x92, 0x83, 0x0e, 0xf7, 0x08, 0x20,
-	0x57, 0x97, 0xa0, 0x8d, 0xf5, 0x55, 0xbf, 0xff, 0xb6, 0x16, 0xa3, 0xa6, 0xb3, 0x7c, 0xd8, 0x04,
-	0x36, 0xa0, 0xde, 0x66, 0x23, 0x7b, 0x73, 0xe6, 0x35, 0x8d, 0xba, 0xc1, 0x5e, 0xc9, 0x87, 0xf6,
-	0xa8, 0x8d, 0x6c, 0x43, 0x23, 0xd4, 0xaf, 0x87, 0x86, 0xd0, 0x6f, 0xd8, 0xb5, 0xa9, 0x37, 0x3d,
-	0x7c, 0x64, 0xf6, 0x4a, 0x7e, 0x3d, 0x1c, 0x7b, 0x73, 0x9e, 0x82, 0xa3, 0xa3, 0x10, 0x2a, 0x81,
-	0x34, 0x90, 0x26, 0xf3, 0x83, 0x59, 0xb1, 0x0c, 0x53, 0x6d, 0xaf, 0xe4, 0x37, 0xf3, 0xc9, 0x87,
-	0xfe, 0x10, 0x2e, 0x14, 0x51, 0x8d, 0xe1, 0x2d, 0x20, 0x9e, 0x37, 0x33, 0xb6, 0x71, 0xc0, 0xa5,
-	0xf6, 0x1b, 0xa3, 0x83, 0x84, 0xe5, 0x02, 0x71, 0x90, 0x95, 0x01, 0xed, 0x91, 0x78, 0x1c, 0xbf,
-	0x86, 0xf8, 0xb7, 0x66, 0xe2, 0x4f, 0x2b, 0x93, 0xbd, 0x92, 0x7f, 0xb9, 0x3d, 0xbb, 0x88, 0x46,
-	0x71, 0xe8, 0x53, 0xf1, 0x1c, 0xeb, 0x8c, 0x38, 0x86, 0xed, 0x62, 0x14, 0xc7, 0xa8, 0x83, 0x3c,
-	0x02, 0xc0, 0xe4, 0xd3, 0x50, 0xf6, 0xcc, 0x74, 0x19, 0x0e, 0x8d, 0x2a, 0x5d, 0x7a, 0xc3, 0x09,
-	0x72, 0x63, 0x58, 0xd5, 0x68, 0x0f, 0x67, 0x54, 0xf5, 0x20, 0x5d, 0xc2, 0xe1, 0xd7, 0xd6, 0x02,
-	0x54, 0x94, 0xa9, 0xf7, 0xb7, 0x01, 0x70, 0x4c, 0x43, 0xc9, 0xc5, 0xe6, 0xfe, 0xfe, 0xb3, 0x62,
-	0x0a, 0xd6, 0xde, 0xea, 0x5f, 0x14, 0x35, 0x05, 0xeb, 0x80, 0x26, 0xe6, 0xf3, 0xf2, 0xe4, 0x7c,
-	0x7e, 0x1f, 0x20, 0x15, 0x34, 0x62, 0x21, 0x91, 0x34, 0x3b, 0xeb, 0x91, 0x19, 0x53, 0x75, 0x3f,
-	0x07, 0x78, 0xa9, 0x7e, 0x47, 0x74, 0x7b, 0xaa, 0xcc, 0x24, 0x62, 0xf8, 0xcf, 0xe2, 0xdb, 0x2f,
-	0x87, 0xbf, 0x2f, 0x37, 0x60, 0x29, 0x8d, 0x49, 0x48, 0x3b, 0x3c, 0x8e, 0xa8, 0x08, 0x24, 0x39,
-	0xc5, 0x6c, 0xb5, 0xfd, 0xe6, 0x98, 0xf8, 0x88, 0x9c, 0x7a, 0xbf, 0x1a, 0x60, 0x1d, 0xc6, 0x24,
-	0xd9, 0xe7, 0x11, 0x8e, 0x6a, 0x3d, 0x8c, 0x38, 0x20, 0x49, 0x92, 0xbd, 0xa5, 0x25, 0x8e, 0x78,
-	0x51, 0xe4, 0x69, 0x9b, 0xcd, 0x24, 0xc9, 0xdc, 0x07, 0x13, 0xd1, 0xbe, 0xbd, 0xaf, 0x2b, 0xd3,
-	0xb1, 0x78, 0x57, 0xc1, 0xe1, 0xb9, 0x4c, 0x73, 0x19, 0x0c, 0xa8, 0x54, 0x74, 0x99, 0xab, 0xa6,
-	0xdf, 0xd4, 0xf2, 0xaf, 0x34, 0xa3, 0x99, 0xba, 0xa1, 0x84, 0x47, 0xf4, 0xe6, 0x6f, 0x06, 0x2c,
-	0xe8, 0x26, 0x37, 0xf9, 0x14, 0x2f, 0x41, 0x7d, 0x57, 0x50, 0x22, 0xa9, 0x38, 0xea, 0x90, 0xc4,
-	0x31, 0x5c, 0x07, 0x1a, 0x85, 0xe0, 0xf1, 0xcb, 0x9c, 0xc4, 0x4e, 0xd9, 0x6d, 0x80, 0xf5, 0x84,
-	0x66, 0x19, 0xee, 0x9b, 0xf8, 0x56, 0xd3, 0x2c, 0xd3, 0x9b, 0x15, 0xd7, 0x86, 0xaa, 0x5e, 0x56,
-	0x95, 0xde, 0x3e, 0x97, 0xfa, 0x6b, 0x41, 0x01, 0x1f, 0x0a, 0x7a, 0xc2, 0x5e, 0x3f, 0x25, 0x32,
-	0xec, 0x38, 0x35, 0x05, 0x7c, 0xc8, 0x33, 0x39, 0x94, 0x58, 0xca, 0x56, 0x2f, 0x6d, 0xb5, 0xc4,
-	0x42, 0x71, 0xc0, 0x5d, 0x80, 0x72, 0x2b, 0x71, 0xea, 0x4a, 0xb4, 0xcf, 0x65, 0x2b, 0x71, 0x1a,
-	0x37, 0x77, 0xa1, 0x3e, 0xf6, 0x36, 0xa8, 0x00, 0x9e, 0x27, 0x2f, 0x12, 0xfe, 0x2a, 0xd1, 0x03,
-	0xd1, 0x66, 0xa4, 0x86, 0x88, 0x1a, 0x98, 0xcf, 0xf2, 0xb6, 0x53, 0x56, 0x8b, 0xa7, 0x79, 0xec,
-	0x98, 0x6a, 0xb1, 0xc3, 0x7a, 0x4e, 0x05, 0x25, 0x3c, 0x72, 0xaa, 0x5b, 0x77, 0xbe, 0xbd, 0x7d,
-	0xca, 0x64, 0x27, 0x6f, 0xaf, 0x85, 0xbc, 0xbb, 0xae, 0xa9, 0xbe, 0xc5, 0x78, 0xb1, 0x5a, 0x67,
-	0x89, 0xa4, 0x22, 0x21, 0xf1, 0x3a, 0xb2, 0xbf, 0xae, 0xd8, 0x4f, 0xdb, 0xed, 0x05, 0xfc, 0xba,
-	0xf3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0x23, 0x5b, 0x87, 0xb6, 0x0f, 0x00, 0x00,
+	// 1514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4b, 0x73, 0xdb, 0x46,
+	0x12, 0x26, 0xf8, 0x04, 0x9a, 0x14, 0x05, 0xe3, 0xb2, 0x7e, 0xac, 0x2d, 0x2d, 0xec, 0x5a, 0x6b,
+	0xbd, 0x65, 0x69, 0xfd, 0x58, 0xbb, 0xec, 0x2d, 0xef, 0xea, 0xe5, 0x95, 0x58, 0x6b, 0x53, 0x5a,
+	0x58, 0xd6, 0x21, 0x17, 0xd4, 0x10, 0x18, 0x89, 0x28, 0x0f, 0x67, 0x60, 0x60, 0x40, 0x5b, 0xe7,
+	0xdc, 0x92, 0x53, 0xfe, 0x44, 0x72, 0x4d, 0xe5, 0x96, 0x5c, 0xf2, 0x07, 0x72, 0xc8, 0x25, 0x55,
+	0xc9, 0x39, 0x7f, 0x24, 0x35, 0x3d, 0xe0, 0x4b, 0x45, 0x5a, 0x54, 0x59, 0xa9, 0xdc, 0x66, 0x7a,
+	0xfa, 0xf9, 0x4d, 0x77, 0x4f, 0x0f, 0x40, 0xcc, 0x08, 0x5f, 0x8d, 0x13, 0x21, 0x85, 0x73, 0xa9,
+	0x17, 0xb1, 0x7e, 0x96, 0xea, 0xdd, 0xaa, 0x3a, 0xb8, 0xda, 0x48, 0x83, 0x2e, 0xed, 0x11, 0x4d,
+	0x72, 0xbf, 0x30, 0xa0, 0xb1, 0x43, 0x39, 0x4d, 0xa2, 0xe0, 0x90, 0xb0, 0x8c, 0x3a, 0xd7, 0xc0,
+	0xec, 0x08, 0xc1, 0xfc, 0x3e, 0x61, 0x97, 0x8d, 0x65, 0x63, 0xc5, 0xdc, 0x2d, 0x78, 0x35, 0x45,
+	0x39, 0x24, 0xcc, 0xb9, 0x0e, 0x56, 0xc4, 0xe5, 0xa3, 0x87, 0x78, 0x5a, 0x5c, 0x36, 0x56, 0x4a,
+	0xbb, 0x05, 0xcf, 0x44, 0x52, 0x7e, 0x7c, 0xc4, 0x04, 0x91, 0x78, 0x5c, 0x5a, 0x36, 0x56, 0x0c,
+	0x75, 0x8c, 0x24, 0x75, 0xbc, 0x04, 0x90, 0xca, 0x24, 0xe2, 0xc7, 0x78, 0x5e, 0x5e, 0x36, 0x56,
+	0xac, 0xdd, 0x82, 0x67, 0x69, 0xda, 0x21, 0x61, 0x9b, 0x15, 0x28, 0xf5, 0x09, 0x73, 0x3f, 0x33,
+	0xc0, 0xfa, 0x7f, 0x46, 0x93, 0x93, 0x16, 0x3f, 0x12, 0x8e, 0x03, 0x65, 0x29, 0xe2, 0x37, 0xe8,
+	0x4c, 0xc9, 0xc3, 0xb5, 0xb3, 0x04, 0xf5, 0x1e, 0x95, 0x49, 0x14, 0xf8, 0xf2, 0x24, 0xa6, 0x68,
+	0xca, 0xf2, 0x40, 0x93, 0x0e, 0x4e, 0x62, 0xea, 0xdc, 0x84, 0x85, 0x94, 0x92, 0x24, 0xe8, 0xfa,
+	0x31, 0x49, 0x48, 0x2f, 0xd5, 0xd6, 0xbc, 0x86, 0x26, 0xee, 0x23, 0x4d, 0x31, 0x25, 0x22, 0xe3,
+	0xa1, 0x1f, 0xd2, 0x20, 0xea, 0x11, 0x76, 0xb9, 0x82, 0x26, 0x1a, 0x48, 0xdc, 0xd6, 0x34, 0xf7,
+	0x4b, 0x03, 0x60, 0x4b, 0xb0, 0xac, 0xc7, 0xd1, 0x9b, 0x2b, 0x60, 0x1e, 0x45, 0x94, 0x85, 0x7e,
+	0x14, 0xe6, 0x1e, 0xd5, 0x70, 0xdf, 0x0a, 0x9d, 0xa7, 0x60, 0x85, 0x44, 0x12, 0xed, 0x92, 0x02,
+	0xa7, 0x79, 0xff, 0xfa, 0xea, 0x04, 0xfe, 0x39, 0xf2, 0xdb, 0x44, 0x12, 0xe5, 0xa5, 0x67, 0x86,
+	0xf9, 0xca, 0xb9, 0x05, 0xcd, 0x28, 0xf5, 0xe3, 0x24, 0xea, 0x91, 0xe4, 0xc4, 0x7f, 0x43, 0x4f,
+	0x30, 0x26, 0xd3, 0x6b, 0x44, 0xe9, 0xbe, 0x26, 0xfe, 0x8f, 0x9e, 0x38, 0xd7, 0xc0, 0x8a, 0x52,
+	0x9f, 0x64, 0x52, 0xb4, 0xb6, 0x31, 0x22, 0xd3, 0x33, 0xa3, 0x74, 0x03, 0xf7, 0xee, 0x7f, 0x06,
+	0x7e, 0x3e, 0x7f, 0x1f, 0x27, 0xce, 0x3d, 0x28, 0x47, 0xfc, 0x48, 0xa0, 0x8f, 0xf5, 0xd3, 0x7e,
+	0x60, 0x82, 0x8c, 0x82, 0xf2, 0x90, 0xd5, 0xdd, 0x04, 0x0b, 0x53, 0x00, 0xe5, 0xff, 0x09, 0x95,
+	0xbe, 0xda, 0xe4, 0x0a, 0x96, 0xa6, 0x28, 0x18, 0x4f, 0x1b, 0x4f, 0x73, 0xbb, 0xdf, 0x18, 0xd0,
+	0x7c, 0xcd, 0x49, 0x72, 0xe2, 0x11, 0x7e, 0xac, 0x35, 0xfd, 0x1b, 0xea, 0x01, 0x9a, 0xf2, 0xe7,
+	0x77, 0x08, 0x82, 0x11, 0xe2, 0x7f, 0x83, 0xa2, 0x88, 0x73, 0x3c, 0xaf, 0x4c, 0x11, 0xdb, 0x8b,
+	0x11, 0xcb, 0xa2, 0x88, 0x47, 0x4e, 0x97, 0xce, 0xe5, 0xf4, 0x57, 0x45, 0x58, 0xdc, 0x8c, 0x2e,
+	0xd6, 0xeb, 0xdb, 0xb0, 0xc8, 0xc4, 0x3b, 0x9a, 0xf8, 0x11, 0x0f, 0x58, 0x96, 0x46, 0x7d, 0x9d,
+	0x12, 0xa6, 0xd7, 0x44, 0x72, 0x6b, 0x40, 0x55, 0x8c, 0x59, 0x1c, 0x4f, 0x30, 0xea, 0xab, 0x6f,
+	0x22, 0x79, 0xc4, 0xb8, 0x0e, 0x75, 0xad, 0x51, 0x87, 0x58, 0x9e, 0x2f, 0x44, 0x40, 0x19, 0x5d,
+	0xda, 0xeb, 0x50, 0xd7, 0xa6, 0xb4, 0x86, 0xca, 0x9c, 0x1a, 0x50, 0x06, 0xd7, 0xee, 0x0f, 0x06,
+	0xd4, 0xb7, 0x44, 0x2f, 0x26, 0x89, 0x46, 0x69, 0x07, 0x6c, 0x46, 0x8f, 0xa4, 0x7f, 0x6e, 0xa8,
+	0x9a, 0x4a, 0x6c, 0xac, 0xac, 0x5a, 0x70, 0x29, 0x89, 0x8e, 0xbb, 0x93, 0x9a, 0x8a, 0xf3, 0x68,
+	0x5a, 0x44, 0xb9, 0xad, 0xd3, 0xf9, 0x52, 0x9a, 0x23, 0x5f, 0xdc, 0x4f, 0x0d, 0x30, 0x0f, 0x68,
+	0xd2, 0xbb, 0x90, 0x1b, 0x7f, 0x0c, 0x55, 0xc4, 0x35, 0xbd, 0x5c, 0x5c, 0x2e, 0xcd, 0x03, 0x6c,
+	0xce, 0xee, 0x7e, 0x6d, 0x80, 0xd9, 0xce, 0x18, 0xbb, 0x10, 0x2f, 0xee, 0x8f, 0x55, 0x8b, 0x3b,
+	0x45, 0x6c, 0x60, 0x08, 0x17, 0x7b, 0x31, 0xc2, 0xf0, 0x0f, 0xa8, 0xea, 0x9d, 0x53, 0x87, 0x5a,
+	0x8b, 0xf7, 0x09, 0x8b, 0x42, 0xbb, 0xe0, 0x00, 0x54, 0x5b, 0xa9, 0x3a, 0xb0, 0x0d, 0x67, 0x01,
+	0xac, 0x56, 0xda, 0x16, 0x12, 0xb7, 0x45, 0xf5, 0x6a, 0x58, 0x58, 0xe6, 0xe8, 0xf3, 0x43, 0xb4,
+	0x69, 0xa0, 0xcd, 0x5b, 0x53, 0x6c, 0x0e, 0x39, 0xf5, 0x4a, 0x5b, 0x75, 0xee, 0x42, 0x25, 0xe8,
+	0x46, 0x2c, 0xcc, 0xaf, 0xf9, 0x4f, 0x53, 0x04, 0x95, 0x8c, 0xa7, 0xb9, 0xdc, 0x25, 0xa8, 0xe5,
+	0xd2, 0x93, 0x5e, 0xd6, 0xa0, 0xd4, 0x16, 0xd2, 0x36, 0xdc, 0x9f, 0x0d, 0x00, 0x5d, 0xc5, 0xe8,
+	0xd4, 0xa3, 0x31, 0xa7, 0xfe, 0x3a, 0x45, 0xf7, 0x88, 0x35, 0x5f, 0xe6, 0x6e, 0xfd, 0x1d, 0xca,
+	0x2a, 0x37, 0xcf, 0xf2, 0x0a, 0x99, 0x54, 0x0c, 0x98, 0x7e, 0x79, 0xc3, 0x99, 0x1d, 0x03, 0x72,
+	0xb9, 0x8f, 0xc0, 0x1c, 0xd8, 0x9a, 0x0c, 0xa2, 0x09, 0xf0, 0x42, 0x1c, 0x47, 0x01, 0x61, 0x1b,
+	0x3c, 0xd4, 0x70, 0xe7, 0xfb, 0xbd, 0xc4, 0x2e, 0xba, 0x3f, 0x1a, 0xb0, 0xa0, 0x05, 0x37, 0x92,
+	0x48, 0x76, 0xf7, 0xe2, 0x8f, 0x4e, 0x93, 0x27, 0x60, 0x12, 0xa5, 0xca, 0x1f, 0x26, 0xcb, 0x8d,
+	0x29, 0xc2, 0xb9, 0x35, 0xac, 0x97, 0x1a, 0xc9, 0x4d, 0x6f, 0xc3, 0x82, 0x2e, 0x55, 0x11, 0xd3,
+	0x84, 0xf0, 0x70, 0xde, 0x66, 0xdb, 0x40, 0xa9, 0x3d, 0x2d, 0xe4, 0xfe, 0x62, 0x0c, 0x7a, 0x2e,
+	0x1a, 0xc1, 0x2b, 0x1b, 0x40, 0x6f, 0x9c, 0x0b, 0xfa, 0xe2, 0x3c, 0xd0, 0x3b, 0xab, 0x63, 0x5d,
+	0xe1, 0xac, 0x50, 0x55, 0x1a, 0x4c, 0x3c, 0xe6, 0xe5, 0x73, 0x3d, 0xe6, 0xee, 0x4f, 0x06, 0xd8,
+	0xa8, 0x6f, 0xbc, 0x55, 0xfe, 0x9e, 0xc1, 0xcd, 0xdf, 0xf2, 0x3e, 0x2a, 0xae, 0xef, 0x8b, 0x70,
+	0x75, 0x22, 0x0d, 0x9f, 0xf7, 0x09, 0xbb, 0xb8, 0x27, 0xf3, 0x8f, 0xce, 0xc9, 0x1c, 0xc6, 0xf2,
+	0xb9, 0x26, 0x8d, 0xca, 0xb9, 0x26, 0x8d, 0xcf, 0x6b, 0x50, 0x46, 0xac, 0x9e, 0x82, 0x25, 0x69,
+	0xd2, 0xf3, 0xe9, 0xfb, 0x38, 0xc9, 0x91, 0xba, 0x36, 0x45, 0xc7, 0xe0, 0x71, 0x52, 0x63, 0xb4,
+	0x1c, 0x3c, 0x54, 0xcf, 0x00, 0x32, 0x75, 0x09, 0x5a, 0x58, 0x67, 0xc8, 0x9f, 0x3f, 0xd4, 0x76,
+	0xd5, 0x90, 0x9d, 0x0d, 0x1b, 0xe3, 0x3a, 0xd4, 0x3b, 0xd1, 0x48, 0xbe, 0x34, 0xf3, 0x9a, 0x46,
+	0x1d, 0x72, 0xb7, 0xe0, 0x41, 0x67, 0xd4, 0x5a, 0xb7, 0xa0, 0x11, 0xe8, 0xcc, 0xd6, 0x2a, 0xf4,
+	0x28, 0x72, 0x63, 0xea, 0x4d, 0x0f, 0x0b, 0x60, 0xb7, 0xe0, 0xd5, 0x83, 0xb1, 0x7a, 0x78, 0x09,
+	0xb6, 0x8e, 0x22, 0x51, 0x09, 0xa4, 0x15, 0x69, 0x30, 0xff, 0x32, 0x2b, 0x96, 0x61, 0xaa, 0xed,
+	0x16, 0xbc, 0x66, 0x36, 0x39, 0xaf, 0xed, 0xc3, 0xa5, 0x3c, 0xaa, 0x31, 0x7d, 0x55, 0xd4, 0xe7,
+	0xce, 0x8c, 0x6d, 0x5c, 0xe1, 0x62, 0xe7, 0xd4, 0x04, 0x28, 0x61, 0x29, 0xd7, 0x38, 0xc8, 0x4a,
+	0x9f, 0xf6, 0x09, 0x1b, 0xd7, 0x5f, 0x43, 0xfd, 0x77, 0x67, 0xea, 0x9f, 0x56, 0x26, 0xbb, 0x05,
+	0xef, 0x6a, 0x67, 0x76, 0x11, 0x8d, 0xe2, 0xd0, 0x56, 0xd1, 0x8e, 0x79, 0x46, 0x1c, 0xc3, 0x16,
+	0x3a, 0x8a, 0x63, 0xd4, 0x55, 0x9f, 0x01, 0x60, 0xf2, 0x69, 0x55, 0xd6, 0xcc, 0x74, 0x19, 0xce,
+	0xfe, 0x2a, 0x5d, 0xfa, 0xc3, 0x8f, 0xc0, 0xfa, 0xb0, 0xaa, 0x51, 0x1e, 0xce, 0xa8, 0xea, 0x41,
+	0xba, 0x04, 0xa3, 0xaf, 0xc8, 0x53, 0xb0, 0x78, 0xc6, 0x98, 0x96, 0xaf, 0xcf, 0xcc, 0xf5, 0xc1,
+	0x64, 0xa2, 0x72, 0x9d, 0x0f, 0xc6, 0xa1, 0x57, 0xe0, 0x68, 0x1c, 0x26, 0x12, 0xae, 0x81, 0x4a,
+	0x6e, 0xce, 0xea, 0x0e, 0x93, 0x59, 0x67, 0x93, 0x53, 0xb4, 0xcd, 0x2a, 0x94, 0x95, 0x1a, 0xf7,
+	0x57, 0x03, 0xe0, 0x90, 0x06, 0x52, 0x24, 0x1b, 0xed, 0xf6, 0xab, 0xfc, 0x77, 0xa5, 0xe1, 0xd3,
+	0x5f, 0x5f, 0xf5, 0xbb, 0xd2, 0x08, 0x4f, 0xfc, 0xfb, 0x8a, 0x93, 0xff, 0xbe, 0xc7, 0x00, 0x71,
+	0x42, 0xc3, 0x28, 0x20, 0x92, 0xa6, 0x67, 0x4d, 0x02, 0x63, 0xac, 0xce, 0xbf, 0x00, 0xde, 0xaa,
+	0x6f, 0xae, 0xee, 0x97, 0xe5, 0x99, 0x37, 0x33, 0xfc, 0x0b, 0x7b, 0xd6, 0xdb, 0xe1, 0xb7, 0xf8,
+	0x36, 0x2c, 0xc6, 0x8c, 0x04, 0xb4, 0x2b, 0x58, 0x48, 0x13, 0x5f, 0x92, 0x63, 0x2c, 0x1f, 0xcb,
+	0x6b, 0x8e, 0x91, 0x0f, 0xc8, 0xb1, 0xfb, 0xad, 0x01, 0xe6, 0x3e, 0x23, 0xbc, 0x2d, 0x42, 0xfc,
+	0x02, 0xf4, 0x31, 0x62, 0x9f, 0x70, 0x9e, 0x7e, 0xa0, 0x47, 0x8f, 0x70, 0x51, 0xb7, 0xa9, 0x65,
+	0x36, 0x38, 0x4f, 0x9d, 0x27, 0x13, 0xd1, 0x7e, 0xf8, 0x7d, 0x52, 0xa2, 0x63, 0xf1, 0xae, 0x80,
+	0x2d, 0x32, 0x19, 0x67, 0xd2, 0x1f, 0x40, 0xa9, 0xe0, 0x2a, 0xad, 0x94, 0xbc, 0xa6, 0xa6, 0xff,
+	0x57, 0x23, 0x9a, 0xaa, 0x1b, 0xe2, 0x22, 0xa4, 0x77, 0xbe, 0x33, 0xa0, 0xaa, 0xbb, 0xee, 0xe4,
+	0xbc, 0xb4, 0x08, 0xf5, 0x9d, 0x84, 0x12, 0x49, 0x93, 0x83, 0x2e, 0xe1, 0xb6, 0xe1, 0xd8, 0xd0,
+	0xc8, 0x09, 0xcf, 0xdf, 0x66, 0x84, 0xd9, 0x45, 0xa7, 0x01, 0xe6, 0x0b, 0x9a, 0xa6, 0x78, 0x5e,
+	0xc2, 0x81, 0x8a, 0xa6, 0xa9, 0x3e, 0x2c, 0x3b, 0x16, 0x54, 0xf4, 0xb2, 0xa2, 0xf8, 0xda, 0x42,
+	0xea, 0x5d, 0x55, 0x29, 0xde, 0x4f, 0xe8, 0x51, 0xf4, 0xfe, 0x25, 0x91, 0x41, 0xd7, 0xae, 0x29,
+	0xc5, 0xfb, 0x22, 0x95, 0x43, 0x8a, 0xa9, 0x64, 0xf5, 0xd2, 0x52, 0x4b, 0xac, 0x5c, 0x1b, 0x9c,
+	0x2a, 0x14, 0x5b, 0xdc, 0xae, 0x2b, 0x52, 0x5b, 0xc8, 0x16, 0xb7, 0x1b, 0x77, 0x76, 0xa0, 0x3e,
+	0xf6, 0x58, 0xa9, 0x00, 0x5e, 0xf3, 0x37, 0x5c, 0xbc, 0xe3, 0x7a, 0x6a, 0xdd, 0x08, 0xd5, 0xa4,
+	0x57, 0x83, 0xd2, 0xab, 0xac, 0x63, 0x17, 0xd5, 0xe2, 0x65, 0xc6, 0xec, 0x92, 0x5a, 0x6c, 0x47,
+	0x7d, 0xbb, 0x8c, 0x14, 0x11, 0xda, 0x95, 0xcd, 0x07, 0x9f, 0xdc, 0x3b, 0x8e, 0x64, 0x37, 0xeb,
+	0xac, 0x06, 0xa2, 0xb7, 0xa6, 0xa1, 0xbe, 0x1b, 0x89, 0x7c, 0xb5, 0x16, 0x71, 0x49, 0x13, 0x4e,
+	0xd8, 0x1a, 0xa2, 0xbf, 0xa6, 0xd0, 0x8f, 0x3b, 0x9d, 0x2a, 0xee, 0x1e, 0xfc, 0x16, 0x00, 0x00,
+	0xff, 0xff, 0x1b, 0xea, 0x74, 0x28, 0x0e, 0x12, 0x00, 0x00,
 }
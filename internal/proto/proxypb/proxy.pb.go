@@ -9,6 +9,8 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	commonpb "github.com/milvus-io/milvus-proto/go-api/commonpb"
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	schemapb "github.com/milvus-io/milvus-proto/go-api/schemapb"
+	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -298,54 +300,803 @@ func (m *SetRatesRequest) GetRates() []*internalpb.Rate {
 	return nil
 }
 
+type ValidateExprRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValidateExprRequest) Reset()         { *m = ValidateExprRequest{} }
+func (m *ValidateExprRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateExprRequest) ProtoMessage()    {}
+func (*ValidateExprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{5}
+}
+
+func (m *ValidateExprRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateExprRequest.Unmarshal(m, b)
+}
+func (m *ValidateExprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateExprRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateExprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateExprRequest.Merge(m, src)
+}
+func (m *ValidateExprRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateExprRequest.Size(m)
+}
+func (m *ValidateExprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateExprRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateExprRequest proto.InternalMessageInfo
+
+func (m *ValidateExprRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ValidateExprRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ValidateExprRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ValidateExprRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+type ExprError struct {
+	// position of the syntax error, line starts from 1 and column starts from 0,
+	// line is 0 if the error is not a syntax error, e.g. a field not in the schema.
+	Line                 int32    `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Column               int32    `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExprError) Reset()         { *m = ExprError{} }
+func (m *ExprError) String() string { return proto.CompactTextString(m) }
+func (*ExprError) ProtoMessage()    {}
+func (*ExprError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{6}
+}
+
+func (m *ExprError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExprError.Unmarshal(m, b)
+}
+func (m *ExprError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExprError.Marshal(b, m, deterministic)
+}
+func (m *ExprError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExprError.Merge(m, src)
+}
+func (m *ExprError) XXX_Size() int {
+	return xxx_messageInfo_ExprError.Size(m)
+}
+func (m *ExprError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExprError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExprError proto.InternalMessageInfo
+
+func (m *ExprError) GetLine() int32 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *ExprError) GetColumn() int32 {
+	if m != nil {
+		return m.Column
+	}
+	return 0
+}
+
+func (m *ExprError) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *ExprError) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ValidateExprResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Valid                bool             `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors               []*ExprError     `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ValidateExprResponse) Reset()         { *m = ValidateExprResponse{} }
+func (m *ValidateExprResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateExprResponse) ProtoMessage()    {}
+func (*ValidateExprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{7}
+}
+
+func (m *ValidateExprResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateExprResponse.Unmarshal(m, b)
+}
+func (m *ValidateExprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateExprResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateExprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateExprResponse.Merge(m, src)
+}
+func (m *ValidateExprResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateExprResponse.Size(m)
+}
+func (m *ValidateExprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateExprResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateExprResponse proto.InternalMessageInfo
+
+func (m *ValidateExprResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ValidateExprResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateExprResponse) GetErrors() []*ExprError {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type ManualCompactionWithScopeRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// compact all the partitions if empty
+	PartitionNames []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// compact all the segments of the partitions if empty
+	SegmentIDs []int64                     `protobuf:"varint,5,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	Mode       datapb.ManualCompactionMode `protobuf:"varint,6,opt,name=mode,proto3,enum=milvus.proto.data.ManualCompactionMode" json:"mode,omitempty"`
+	// max size of the output segments in MB, dataCoord.segment.maxSize is used if not set
+	MaxSegmentSize int64 `protobuf:"varint,7,opt,name=max_segment_size,json=maxSegmentSize,proto3" json:"max_segment_size,omitempty"`
+	// the deleted rows and old versions after timetravel are kept, the retention duration applies if not set
+	Timetravel           uint64   `protobuf:"varint,8,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManualCompactionWithScopeRequest) Reset()         { *m = ManualCompactionWithScopeRequest{} }
+func (m *ManualCompactionWithScopeRequest) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionWithScopeRequest) ProtoMessage()    {}
+func (*ManualCompactionWithScopeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{8}
+}
+
+func (m *ManualCompactionWithScopeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionWithScopeRequest.Unmarshal(m, b)
+}
+func (m *ManualCompactionWithScopeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionWithScopeRequest.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionWithScopeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionWithScopeRequest.Merge(m, src)
+}
+func (m *ManualCompactionWithScopeRequest) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionWithScopeRequest.Size(m)
+}
+func (m *ManualCompactionWithScopeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionWithScopeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionWithScopeRequest proto.InternalMessageInfo
+
+func (m *ManualCompactionWithScopeRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ManualCompactionWithScopeRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ManualCompactionWithScopeRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ManualCompactionWithScopeRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ManualCompactionWithScopeRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+func (m *ManualCompactionWithScopeRequest) GetMode() datapb.ManualCompactionMode {
+	if m != nil {
+		return m.Mode
+	}
+	return datapb.ManualCompactionMode_DefaultCompaction
+}
+
+func (m *ManualCompactionWithScopeRequest) GetMaxSegmentSize() int64 {
+	if m != nil {
+		return m.MaxSegmentSize
+	}
+	return 0
+}
+
+func (m *ManualCompactionWithScopeRequest) GetTimetravel() uint64 {
+	if m != nil {
+		return m.Timetravel
+	}
+	return 0
+}
+
+type CancelCompactionRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CompactionID   int64             `protobuf:"varint,4,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	// cancel all the plans of the compaction if not set
+	PlanID               int64    `protobuf:"varint,5,opt,name=planID,proto3" json:"planID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelCompactionRequest) Reset()         { *m = CancelCompactionRequest{} }
+func (m *CancelCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelCompactionRequest) ProtoMessage()    {}
+func (*CancelCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{9}
+}
+
+func (m *CancelCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelCompactionRequest.Unmarshal(m, b)
+}
+func (m *CancelCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelCompactionRequest.Merge(m, src)
+}
+func (m *CancelCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelCompactionRequest.Size(m)
+}
+func (m *CancelCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelCompactionRequest proto.InternalMessageInfo
+
+func (m *CancelCompactionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelCompactionRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CancelCompactionRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CancelCompactionRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+func (m *CancelCompactionRequest) GetPlanID() int64 {
+	if m != nil {
+		return m.PlanID
+	}
+	return 0
+}
+
+type GetCompactionPlanStatesRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CompactionID         int64             `protobuf:"varint,4,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCompactionPlanStatesRequest) Reset()         { *m = GetCompactionPlanStatesRequest{} }
+func (m *GetCompactionPlanStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPlanStatesRequest) ProtoMessage()    {}
+func (*GetCompactionPlanStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{10}
+}
+
+func (m *GetCompactionPlanStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPlanStatesRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionPlanStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPlanStatesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPlanStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPlanStatesRequest.Merge(m, src)
+}
+func (m *GetCompactionPlanStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPlanStatesRequest.Size(m)
+}
+func (m *GetCompactionPlanStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPlanStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPlanStatesRequest proto.InternalMessageInfo
+
+func (m *GetCompactionPlanStatesRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCompactionPlanStatesRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetCompactionPlanStatesRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetCompactionPlanStatesRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type ExportRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// export all the partitions if empty
+	PartitionNames []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// the rows inserted and not deleted until the timestamp are exported, the current time is used if not set
+	Timestamp uint64              `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Format    datapb.ExportFormat `protobuf:"varint,6,opt,name=format,proto3,enum=milvus.proto.data.ExportFormat" json:"format,omitempty"`
+	// the files are written under the prefix of the object storage
+	TargetPrefix         string   `protobuf:"bytes,7,opt,name=target_prefix,json=targetPrefix,proto3" json:"target_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{11}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExportRequest) GetFormat() datapb.ExportFormat {
+	if m != nil {
+		return m.Format
+	}
+	return datapb.ExportFormat_ExportParquet
+}
+
+func (m *ExportRequest) GetTargetPrefix() string {
+	if m != nil {
+		return m.TargetPrefix
+	}
+	return ""
+}
+
+type GetExportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	JobID                int64             `protobuf:"varint,4,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetExportStateRequest) Reset()         { *m = GetExportStateRequest{} }
+func (m *GetExportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExportStateRequest) ProtoMessage()    {}
+func (*GetExportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{12}
+}
+
+func (m *GetExportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExportStateRequest.Unmarshal(m, b)
+}
+func (m *GetExportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExportStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExportStateRequest.Merge(m, src)
+}
+func (m *GetExportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExportStateRequest.Size(m)
+}
+func (m *GetExportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExportStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExportStateRequest proto.InternalMessageInfo
+
+func (m *GetExportStateRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetExportStateRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetExportStateRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *GetExportStateRequest) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+type CancelExportRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	JobID                int64             `protobuf:"varint,4,opt,name=jobID,proto3" json:"jobID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CancelExportRequest) Reset()         { *m = CancelExportRequest{} }
+func (m *CancelExportRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelExportRequest) ProtoMessage()    {}
+func (*CancelExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{13}
+}
+
+func (m *CancelExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelExportRequest.Unmarshal(m, b)
+}
+func (m *CancelExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelExportRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelExportRequest.Merge(m, src)
+}
+func (m *CancelExportRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelExportRequest.Size(m)
+}
+func (m *CancelExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelExportRequest proto.InternalMessageInfo
+
+func (m *CancelExportRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CancelExportRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CancelExportRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CancelExportRequest) GetJobID() int64 {
+	if m != nil {
+		return m.JobID
+	}
+	return 0
+}
+
+type AddCollectionFieldRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// the field id is assigned by rootcoord, the rows inserted before take the default value of the field, or null if it has none
+	Field                *schemapb.FieldSchema `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AddCollectionFieldRequest) Reset()         { *m = AddCollectionFieldRequest{} }
+func (m *AddCollectionFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCollectionFieldRequest) ProtoMessage()    {}
+func (*AddCollectionFieldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{14}
+}
+
+func (m *AddCollectionFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCollectionFieldRequest.Unmarshal(m, b)
+}
+func (m *AddCollectionFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCollectionFieldRequest.Marshal(b, m, deterministic)
+}
+func (m *AddCollectionFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCollectionFieldRequest.Merge(m, src)
+}
+func (m *AddCollectionFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCollectionFieldRequest.Size(m)
+}
+func (m *AddCollectionFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCollectionFieldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCollectionFieldRequest proto.InternalMessageInfo
+
+func (m *AddCollectionFieldRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AddCollectionFieldRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *AddCollectionFieldRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *AddCollectionFieldRequest) GetField() *schemapb.FieldSchema {
+	if m != nil {
+		return m.Field
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
 	proto.RegisterType((*UpdateCredCacheRequest)(nil), "milvus.proto.proxy.UpdateCredCacheRequest")
 	proto.RegisterType((*RefreshPolicyInfoCacheRequest)(nil), "milvus.proto.proxy.RefreshPolicyInfoCacheRequest")
 	proto.RegisterType((*SetRatesRequest)(nil), "milvus.proto.proxy.SetRatesRequest")
+	proto.RegisterType((*ValidateExprRequest)(nil), "milvus.proto.proxy.ValidateExprRequest")
+	proto.RegisterType((*ExprError)(nil), "milvus.proto.proxy.ExprError")
+	proto.RegisterType((*ValidateExprResponse)(nil), "milvus.proto.proxy.ValidateExprResponse")
+	proto.RegisterType((*ManualCompactionWithScopeRequest)(nil), "milvus.proto.proxy.ManualCompactionWithScopeRequest")
+	proto.RegisterType((*CancelCompactionRequest)(nil), "milvus.proto.proxy.CancelCompactionRequest")
+	proto.RegisterType((*GetCompactionPlanStatesRequest)(nil), "milvus.proto.proxy.GetCompactionPlanStatesRequest")
+	proto.RegisterType((*ExportRequest)(nil), "milvus.proto.proxy.ExportRequest")
+	proto.RegisterType((*GetExportStateRequest)(nil), "milvus.proto.proxy.GetExportStateRequest")
+	proto.RegisterType((*CancelExportRequest)(nil), "milvus.proto.proxy.CancelExportRequest")
+	proto.RegisterType((*AddCollectionFieldRequest)(nil), "milvus.proto.proxy.AddCollectionFieldRequest")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xdd, 0x6e, 0xda, 0x30,
-	0x18, 0x6d, 0xda, 0x42, 0xbb, 0x0f, 0x54, 0x24, 0xab, 0x63, 0x2c, 0x5d, 0x27, 0x94, 0x4e, 0x2b,
-	0xaa, 0x34, 0x58, 0xd9, 0x9e, 0xa0, 0x54, 0x42, 0x68, 0xa2, 0xaa, 0xc2, 0x76, 0xb3, 0x9b, 0xc9,
-	0x49, 0xbe, 0x82, 0x51, 0x62, 0xa7, 0xb1, 0x61, 0xe3, 0x6a, 0xd2, 0xde, 0x68, 0x77, 0x7b, 0xbc,
-	0x29, 0x3f, 0x04, 0xc2, 0x42, 0xa3, 0xad, 0xda, 0x1d, 0xc7, 0x3e, 0xf6, 0x39, 0x27, 0xfe, 0x0e,
-	0x50, 0xf1, 0x03, 0xf1, 0x6d, 0xd1, 0xf6, 0x03, 0xa1, 0x04, 0x21, 0x1e, 0x73, 0xe7, 0x33, 0x19,
-	0xa3, 0x76, 0xb4, 0xa3, 0x57, 0x6d, 0xe1, 0x79, 0x82, 0xc7, 0x6b, 0xfa, 0x11, 0xe3, 0x0a, 0x03,
-	0x4e, 0xdd, 0x04, 0x57, 0xd7, 0x4f, 0x18, 0xbf, 0x34, 0x78, 0x39, 0xe0, 0x73, 0xea, 0x32, 0x87,
-	0x2a, 0xec, 0x09, 0xd7, 0x1d, 0xa2, 0xa2, 0x3d, 0x6a, 0x4f, 0xd0, 0xc4, 0xfb, 0x19, 0x4a, 0x45,
-	0xde, 0xc2, 0xbe, 0x45, 0x25, 0x36, 0xb4, 0xa6, 0xd6, 0xaa, 0x74, 0x5f, 0xb4, 0x33, 0x8a, 0x89,
-	0xd4, 0x50, 0x8e, 0xaf, 0xa8, 0x44, 0x33, 0x62, 0x92, 0x67, 0x70, 0xe0, 0x58, 0x5f, 0x38, 0xf5,
-	0xb0, 0xb1, 0xdb, 0xd4, 0x5a, 0x4f, 0xcc, 0xb2, 0x63, 0xdd, 0x50, 0x0f, 0xc9, 0x39, 0xd4, 0x6c,
-	0xe1, 0xba, 0x68, 0x2b, 0x26, 0x78, 0x4c, 0xd8, 0x8b, 0x08, 0x47, 0xab, 0xe5, 0x88, 0x68, 0x40,
-	0x75, 0xb5, 0x32, 0xb8, 0x6e, 0xec, 0x37, 0xb5, 0xd6, 0x9e, 0x99, 0x59, 0x33, 0xa6, 0xa0, 0xaf,
-	0x39, 0x0f, 0xd0, 0x79, 0xa4, 0x6b, 0x1d, 0x0e, 0x67, 0x32, 0xfc, 0x52, 0xa9, 0xed, 0x14, 0x1b,
-	0x3f, 0x34, 0xa8, 0x7f, 0xf2, 0xff, 0xbf, 0x50, 0xb8, 0xe7, 0x53, 0x29, 0xbf, 0x8a, 0xc0, 0x49,
-	0x3e, 0x4d, 0x8a, 0x8d, 0xef, 0x70, 0x6a, 0xe2, 0x5d, 0x80, 0x72, 0x72, 0x2b, 0x5c, 0x66, 0x2f,
-	0x06, 0xfc, 0x4e, 0x3c, 0xd2, 0x4a, 0x1d, 0xca, 0xc2, 0xff, 0xb8, 0xf0, 0x63, 0x23, 0x25, 0x33,
-	0x41, 0xe4, 0x18, 0x4a, 0xc2, 0xff, 0x80, 0x8b, 0xc4, 0x43, 0x0c, 0x8c, 0x39, 0xd4, 0x46, 0xa8,
-	0x4c, 0xaa, 0x50, 0xfe, 0xbb, 0xe4, 0x25, 0x94, 0x82, 0xf0, 0x86, 0xc6, 0x6e, 0x73, 0xaf, 0x55,
-	0xe9, 0x9e, 0x64, 0x8f, 0xa4, 0xc3, 0x1a, 0xaa, 0x98, 0x31, 0xb3, 0xfb, 0xf3, 0x00, 0x4a, 0xb7,
-	0xe1, 0x68, 0x13, 0x17, 0x48, 0x1f, 0x55, 0x4f, 0x78, 0xbe, 0xe0, 0xc8, 0xd5, 0x48, 0x85, 0xfb,
-	0xa4, 0x9d, 0xbd, 0x23, 0x01, 0x7f, 0x12, 0x13, 0xd3, 0xfa, 0xab, 0x5c, 0xfe, 0x06, 0xd9, 0xd8,
-	0x21, 0xf7, 0x70, 0xdc, 0xc7, 0x08, 0x32, 0xa9, 0x98, 0x2d, 0x7b, 0x13, 0xca, 0x39, 0xba, 0xa4,
-	0xbb, 0xc5, 0x73, 0x1e, 0x79, 0xa9, 0x79, 0x96, 0xab, 0x39, 0x52, 0x01, 0xe3, 0x63, 0x13, 0xa5,
-	0x2f, 0xb8, 0x44, 0x63, 0x87, 0x04, 0x70, 0x9a, 0xad, 0x63, 0x3c, 0xee, 0x69, 0x29, 0x37, 0xb5,
-	0xe3, 0xff, 0x82, 0x87, 0x1b, 0xac, 0x9f, 0xe4, 0x3e, 0x4b, 0x68, 0x75, 0x16, 0xc6, 0xa4, 0x50,
-	0xed, 0xa3, 0xba, 0x76, 0x96, 0xf1, 0x2e, 0xb6, 0xc7, 0x4b, 0x49, 0x7f, 0x19, 0x6b, 0x0a, 0xcf,
-	0xb3, 0x5d, 0x45, 0xae, 0x18, 0x75, 0xe3, 0x48, 0xed, 0x82, 0x48, 0x1b, 0x8d, 0x2b, 0x8a, 0x63,
-	0xc1, 0xd3, 0x55, 0x55, 0xd7, 0x75, 0x2e, 0xf2, 0x74, 0xf2, 0x5b, 0x5d, 0xa4, 0x31, 0x85, 0x7a,
-	0x7e, 0x15, 0xc9, 0x65, 0x9e, 0xc8, 0x83, 0xb5, 0x2d, 0xd2, 0x72, 0xa0, 0xd6, 0x47, 0x15, 0xcd,
-	0xff, 0x10, 0x55, 0xc0, 0x6c, 0x49, 0x5e, 0x6f, 0x1b, 0xf8, 0x84, 0xb0, 0xbc, 0xf9, 0xbc, 0x90,
-	0x97, 0xbe, 0xd0, 0x0d, 0x1c, 0x2e, 0xbb, 0x4d, 0xce, 0xf2, 0x32, 0x6c, 0x34, 0xbf, 0xc0, 0xf5,
-	0xd5, 0xfb, 0xcf, 0xdd, 0x31, 0x53, 0x93, 0x99, 0x15, 0xee, 0x74, 0x62, 0xea, 0x1b, 0x26, 0x92,
-	0x5f, 0x9d, 0xe5, 0x50, 0x75, 0xa2, 0xd3, 0x9d, 0x48, 0xc2, 0xb7, 0xac, 0x72, 0x04, 0xdf, 0xfd,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xbe, 0x61, 0x31, 0xe4, 0x06, 0x00, 0x00,
+	// 1245 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x0e, 0xad, 0x0f, 0xdb, 0x63, 0xc5, 0x16, 0x36, 0x4e, 0xc2, 0x30, 0x1f, 0xaf, 0x42, 0xbf,
+	0xa8, 0x95, 0x14, 0x96, 0x1b, 0x25, 0x6d, 0x81, 0x14, 0x08, 0xd0, 0xc8, 0x8e, 0x61, 0x14, 0x0a,
+	0x0c, 0xaa, 0x4e, 0x81, 0x1e, 0x6a, 0xac, 0xc8, 0xb1, 0x45, 0x97, 0xe4, 0x32, 0xbb, 0x2b, 0x57,
+	0xce, 0xa5, 0x40, 0xfa, 0x27, 0xfa, 0x0b, 0x8a, 0xa2, 0xb7, 0xdc, 0x7a, 0xed, 0x35, 0xc7, 0x1e,
+	0xdb, 0x1f, 0xd1, 0x9f, 0xd0, 0x82, 0x4b, 0x8a, 0xfa, 0x30, 0x65, 0xa1, 0x31, 0x5a, 0xa1, 0x3e,
+	0x71, 0x66, 0x9f, 0xdd, 0x79, 0x66, 0x76, 0x3c, 0xfb, 0x08, 0x96, 0x42, 0xce, 0x7a, 0xa7, 0xb5,
+	0x90, 0x33, 0xc9, 0x08, 0xf1, 0x5d, 0xef, 0xa4, 0x2b, 0x62, 0xab, 0xa6, 0x56, 0x8c, 0x92, 0xcd,
+	0x7c, 0x9f, 0x05, 0xb1, 0xcf, 0x58, 0x76, 0x03, 0x89, 0x3c, 0xa0, 0x5e, 0x62, 0x97, 0x86, 0x77,
+	0x18, 0x65, 0x87, 0x4a, 0x7a, 0x60, 0x33, 0xc6, 0x9d, 0xfe, 0xba, 0xb0, 0x3b, 0xe8, 0xd3, 0xd8,
+	0x32, 0x7f, 0xd6, 0xe0, 0xce, 0x6e, 0x70, 0x42, 0x3d, 0xd7, 0xa1, 0x12, 0x1b, 0xcc, 0xf3, 0x9a,
+	0x28, 0x69, 0x83, 0xda, 0x1d, 0xb4, 0xf0, 0x65, 0x17, 0x85, 0x24, 0x1f, 0x40, 0xbe, 0x4d, 0x05,
+	0xea, 0x5a, 0x45, 0xab, 0x2e, 0xd5, 0x6f, 0xd5, 0x46, 0x18, 0x25, 0x54, 0x9a, 0xe2, 0xe8, 0x29,
+	0x15, 0x68, 0x29, 0x24, 0xb9, 0x0e, 0xf3, 0x4e, 0xfb, 0x20, 0xa0, 0x3e, 0xea, 0x73, 0x15, 0xad,
+	0xba, 0x68, 0x15, 0x9d, 0xf6, 0x73, 0xea, 0x23, 0x59, 0x87, 0x15, 0x9b, 0x79, 0x1e, 0xda, 0xd2,
+	0x65, 0x41, 0x0c, 0xc8, 0x29, 0xc0, 0xf2, 0xc0, 0xad, 0x80, 0x26, 0x94, 0x06, 0x9e, 0xdd, 0x2d,
+	0x3d, 0x5f, 0xd1, 0xaa, 0x39, 0x6b, 0xc4, 0x67, 0x1e, 0x83, 0x31, 0xc4, 0x9c, 0xa3, 0x73, 0x41,
+	0xd6, 0x06, 0x2c, 0x74, 0x05, 0xf2, 0x21, 0xda, 0xa9, 0x6d, 0xbe, 0xd6, 0xe0, 0xda, 0x7e, 0xf8,
+	0xcf, 0x07, 0x8a, 0xd6, 0x42, 0x2a, 0xc4, 0x37, 0x8c, 0x3b, 0x49, 0x69, 0x52, 0xdb, 0xfc, 0x16,
+	0x6e, 0x5b, 0x78, 0xc8, 0x51, 0x74, 0xf6, 0x98, 0xe7, 0xda, 0xa7, 0xbb, 0xc1, 0x21, 0xbb, 0x20,
+	0x95, 0x6b, 0x50, 0x64, 0xe1, 0xe7, 0xa7, 0x61, 0x4c, 0xa4, 0x60, 0x25, 0x16, 0x59, 0x85, 0x02,
+	0x0b, 0x3f, 0xc3, 0xd3, 0x84, 0x43, 0x6c, 0x98, 0x27, 0xb0, 0xd2, 0x42, 0x69, 0x51, 0x89, 0xe2,
+	0xdd, 0x43, 0x3e, 0x80, 0x02, 0x8f, 0x4e, 0xd0, 0xe7, 0x2a, 0xb9, 0xea, 0x52, 0xfd, 0xe6, 0xe8,
+	0x96, 0xb4, 0x99, 0xa3, 0x28, 0x56, 0x8c, 0x34, 0x7f, 0xd0, 0xe0, 0xca, 0x8b, 0xe4, 0xa2, 0xb7,
+	0x7b, 0x21, 0x9f, 0x65, 0x67, 0x12, 0xc8, 0x63, 0x2f, 0xe4, 0xaa, 0x23, 0x17, 0x2d, 0xf5, 0xfd,
+	0x78, 0xfe, 0xed, 0x93, 0x7c, 0xb9, 0xac, 0xe7, 0x4c, 0x84, 0xc5, 0x88, 0xdf, 0x36, 0xe7, 0x8c,
+	0x47, 0x48, 0xcf, 0x0d, 0x62, 0x76, 0x05, 0x4b, 0x7d, 0x47, 0xf5, 0xb6, 0x99, 0xd7, 0xf5, 0x83,
+	0x7e, 0xbd, 0x63, 0x2b, 0xaa, 0xb7, 0x64, 0x5f, 0x63, 0xd0, 0xaf, 0xb7, 0x32, 0x22, 0x34, 0x47,
+	0x2a, 0x58, 0x90, 0x44, 0x4b, 0x2c, 0xf3, 0x7b, 0x0d, 0x56, 0x47, 0xeb, 0x21, 0x42, 0x16, 0x08,
+	0x24, 0x0f, 0xa1, 0x28, 0x24, 0x95, 0x5d, 0x91, 0x94, 0xe4, 0x66, 0x66, 0x49, 0x5a, 0x0a, 0x62,
+	0x25, 0xd0, 0x28, 0xb6, 0xfa, 0x2f, 0x52, 0x94, 0x16, 0xac, 0xd8, 0x20, 0x1f, 0x42, 0x11, 0xa3,
+	0x34, 0x84, 0x9e, 0x53, 0xf7, 0x74, 0xbb, 0x76, 0x76, 0x12, 0xd5, 0xd2, 0x64, 0xad, 0x04, 0x6c,
+	0xfe, 0x31, 0x07, 0x95, 0x26, 0x0d, 0xba, 0xd4, 0x6b, 0x30, 0x3f, 0xa4, 0xaa, 0x6e, 0x5f, 0xb8,
+	0xb2, 0xd3, 0xb2, 0x59, 0x38, 0xd3, 0x89, 0xb2, 0x0e, 0x2b, 0x21, 0xe5, 0xd2, 0x4d, 0x71, 0x42,
+	0xcf, 0x57, 0x72, 0x11, 0x30, 0x75, 0x47, 0x38, 0x41, 0xee, 0x00, 0x08, 0x3c, 0xf2, 0x31, 0x90,
+	0xbb, 0x5b, 0x42, 0x2f, 0x54, 0x72, 0xd5, 0x9c, 0x35, 0xe4, 0x21, 0x9f, 0x40, 0xde, 0x67, 0x0e,
+	0xea, 0xc5, 0x8a, 0x56, 0x5d, 0xae, 0xaf, 0x8f, 0x92, 0x8f, 0xa6, 0x6d, 0x6d, 0x3c, 0xff, 0x26,
+	0x73, 0xd0, 0x52, 0x9b, 0x48, 0x15, 0xca, 0x3e, 0xed, 0x1d, 0x24, 0xc7, 0x1d, 0x08, 0xf7, 0x15,
+	0xea, 0xf3, 0x6a, 0xb6, 0x2d, 0xfb, 0xb4, 0xd7, 0x8a, 0xdd, 0x2d, 0xf7, 0x15, 0x46, 0x34, 0xa4,
+	0xeb, 0xa3, 0xe4, 0xf4, 0x04, 0x3d, 0x7d, 0xa1, 0xa2, 0x55, 0xf3, 0xd6, 0x90, 0x27, 0xee, 0xb9,
+	0x79, 0x3d, 0x67, 0xfe, 0xaa, 0xc1, 0xf5, 0x06, 0x0d, 0x6c, 0x1c, 0x8a, 0x38, 0xf3, 0xd1, 0xdd,
+	0x27, 0x32, 0x3c, 0xba, 0x07, 0xbe, 0xa8, 0xb1, 0x43, 0x8f, 0x46, 0xab, 0x05, 0xb5, 0x9a, 0x58,
+	0x83, 0xa4, 0x7e, 0xd1, 0xe0, 0xce, 0x0e, 0xca, 0x41, 0x46, 0x7b, 0x1e, 0x0d, 0x5a, 0xf2, 0x62,
+	0x93, 0xe7, 0x5f, 0xc9, 0x6d, 0x90, 0xc3, 0x9b, 0x39, 0xb8, 0xbc, 0xdd, 0x0b, 0x19, 0x97, 0xff,
+	0x89, 0xbe, 0xbf, 0x05, 0x8b, 0x51, 0x7b, 0x09, 0x49, 0xfd, 0x50, 0x5d, 0x4b, 0xde, 0x1a, 0x38,
+	0xc8, 0xc7, 0x50, 0x3c, 0x64, 0xdc, 0xa7, 0x32, 0xe9, 0xfb, 0xff, 0x65, 0xf4, 0x7d, 0x9c, 0xec,
+	0x33, 0x05, 0xb3, 0x12, 0x38, 0x59, 0x83, 0xcb, 0x92, 0xf2, 0x23, 0x94, 0x07, 0x21, 0xc7, 0x43,
+	0xb7, 0xa7, 0xda, 0x7d, 0xd1, 0x2a, 0xc5, 0xce, 0x3d, 0xe5, 0x1b, 0x0c, 0xd0, 0x9f, 0x34, 0xb8,
+	0xba, 0x83, 0x32, 0x3e, 0x49, 0xdd, 0xf7, 0x2c, 0x6b, 0xb7, 0x0a, 0x85, 0x63, 0xd6, 0x4e, 0xef,
+	0x39, 0x36, 0x06, 0x64, 0x7f, 0xd4, 0xe0, 0x4a, 0xfc, 0x9f, 0x37, 0xfb, 0x6b, 0x9e, 0x42, 0xf5,
+	0x77, 0x0d, 0x6e, 0x7c, 0xea, 0x38, 0x8d, 0x74, 0xd3, 0x33, 0x17, 0x3d, 0x67, 0x96, 0x84, 0x3f,
+	0x82, 0xc2, 0x61, 0xc4, 0x41, 0x11, 0x5e, 0xaa, 0x57, 0x46, 0x83, 0x26, 0x1a, 0x55, 0xb1, 0x6c,
+	0xa9, 0x6f, 0x2b, 0x86, 0x3f, 0x26, 0x6f, 0x9f, 0xac, 0x2c, 0x68, 0x65, 0x4d, 0xff, 0xb3, 0xff,
+	0xa7, 0xd5, 0xdf, 0xcc, 0x43, 0x61, 0x2f, 0x7a, 0x90, 0x88, 0x07, 0x24, 0x19, 0x1b, 0x2c, 0xc0,
+	0x20, 0xee, 0x20, 0x41, 0x6a, 0xa3, 0x87, 0x27, 0xc6, 0x59, 0x60, 0x52, 0x0f, 0xe3, 0xff, 0x99,
+	0xf8, 0x31, 0xb0, 0x79, 0x89, 0xbc, 0x84, 0xd5, 0x1d, 0x54, 0xa6, 0x2b, 0xa4, 0x6b, 0x8b, 0x46,
+	0x87, 0x06, 0x01, 0x7a, 0xa4, 0x3e, 0x41, 0xd3, 0x64, 0x81, 0xfb, 0x31, 0xd7, 0x32, 0x63, 0xb6,
+	0x24, 0x77, 0x83, 0xa3, 0xfe, 0xfb, 0x6e, 0x5e, 0x22, 0x1c, 0x6e, 0x8f, 0xca, 0xf5, 0xb8, 0xa4,
+	0xa9, 0x68, 0x27, 0xf5, 0xac, 0x77, 0xfa, 0x7c, 0x85, 0x6f, 0x9c, 0x27, 0x13, 0xcc, 0x4b, 0x84,
+	0x42, 0x69, 0x07, 0xe5, 0x96, 0xd3, 0x4f, 0xef, 0xfe, 0xe4, 0xf4, 0x52, 0xd0, 0xdf, 0x4c, 0xeb,
+	0x18, 0x6e, 0x8c, 0x6a, 0x79, 0x0c, 0xa4, 0x4b, 0xbd, 0x38, 0xa5, 0xda, 0x94, 0x94, 0xc6, 0x14,
+	0xf9, 0xb4, 0x74, 0xda, 0x70, 0x75, 0x3f, 0xcc, 0x8a, 0x73, 0x3f, 0x2b, 0xce, 0x7e, 0xf8, 0x2e,
+	0x31, 0x8e, 0xe1, 0x5a, 0xb6, 0x54, 0x27, 0x0f, 0xb2, 0x82, 0x9c, 0x2b, 0xeb, 0xa7, 0xc5, 0x72,
+	0x60, 0x65, 0x27, 0x9a, 0xa4, 0xac, 0x77, 0xda, 0x44, 0xc9, 0x5d, 0x5b, 0x90, 0xf7, 0x26, 0x35,
+	0x7c, 0x02, 0xe8, 0x9f, 0xbc, 0x3e, 0x15, 0x97, 0xde, 0xd0, 0x73, 0x58, 0xe8, 0x6b, 0x7f, 0xb2,
+	0x96, 0x95, 0xc3, 0xd8, 0x2f, 0x83, 0x29, 0xac, 0xeb, 0xbf, 0x15, 0xa1, 0xdc, 0x54, 0x80, 0xed,
+	0x9e, 0x6c, 0x21, 0x3f, 0x71, 0x6d, 0x24, 0x36, 0x94, 0x86, 0x75, 0x2d, 0x59, 0xcf, 0x0a, 0x94,
+	0xf1, 0x4b, 0xc0, 0xa8, 0x4e, 0x07, 0xa6, 0x99, 0xbc, 0xd6, 0xe0, 0xc6, 0x44, 0x89, 0x4a, 0x1e,
+	0x65, 0x9d, 0x34, 0x4d, 0xd1, 0x1a, 0x1b, 0x99, 0x85, 0x1c, 0xdf, 0x36, 0x44, 0xe2, 0x2b, 0x28,
+	0x8f, 0x8b, 0x36, 0xf2, 0x7e, 0x56, 0xe8, 0x09, 0xd2, 0x6e, 0x5a, 0x53, 0x7c, 0xa7, 0xc1, 0xf5,
+	0x09, 0x02, 0x2a, 0x7b, 0x44, 0x9c, 0xaf, 0xb6, 0x8c, 0x7a, 0xc6, 0x7b, 0x3f, 0x71, 0x4b, 0x9a,
+	0xe5, 0x1e, 0x14, 0xe3, 0xa7, 0x91, 0xdc, 0x9d, 0xf0, 0xf3, 0x61, 0xf0, 0x6c, 0x1a, 0x77, 0x27,
+	0x4a, 0x8a, 0xa1, 0x13, 0x3b, 0xb0, 0x3c, 0xaa, 0x0f, 0xc8, 0xbd, 0x09, 0xd9, 0x9c, 0xd5, 0x10,
+	0xc6, 0xbd, 0xec, 0x24, 0x46, 0x90, 0x69, 0xa4, 0x17, 0x50, 0x1a, 0x7e, 0xdc, 0xb3, 0x7b, 0x31,
+	0xe3, 0xf9, 0x9f, 0x3e, 0x7e, 0xc8, 0xd9, 0x97, 0x98, 0x6c, 0x64, 0x9d, 0x3e, 0xf1, 0xc5, 0x9e,
+	0x12, 0xe3, 0xe9, 0xa3, 0x2f, 0xeb, 0x47, 0xae, 0xec, 0x74, 0xdb, 0xd1, 0xca, 0x66, 0x0c, 0xdd,
+	0x70, 0x59, 0xf2, 0xb5, 0xd9, 0x9f, 0xd8, 0x9b, 0x6a, 0xf7, 0xa6, 0x0a, 0x16, 0xb6, 0xdb, 0x45,
+	0x65, 0x3e, 0xfc, 0x6b, 0x00, 0xfa, 0x9a, 0xb4, 0xcb, 0x81, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -715,3 +1466,327 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
 }
+
+// MilvusExtServiceClient is the client API for MilvusExtService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MilvusExtServiceClient interface {
+	ValidateExpr(ctx context.Context, in *ValidateExprRequest, opts ...grpc.CallOption) (*ValidateExprResponse, error)
+	ManualCompactionWithScope(ctx context.Context, in *ManualCompactionWithScopeRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetCompactionPlanStates(ctx context.Context, in *GetCompactionPlanStatesRequest, opts ...grpc.CallOption) (*datapb.GetCompactionPlanStatesResponse, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*datapb.ExportResponse, error)
+	GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*datapb.GetExportStateResponse, error)
+	CancelExport(ctx context.Context, in *CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddCollectionField(ctx context.Context, in *AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type milvusExtServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMilvusExtServiceClient(cc *grpc.ClientConn) MilvusExtServiceClient {
+	return &milvusExtServiceClient{cc}
+}
+
+func (c *milvusExtServiceClient) ValidateExpr(ctx context.Context, in *ValidateExprRequest, opts ...grpc.CallOption) (*ValidateExprResponse, error) {
+	out := new(ValidateExprResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ValidateExpr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) ManualCompactionWithScope(ctx context.Context, in *ManualCompactionWithScopeRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	out := new(milvuspb.ManualCompactionResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/ManualCompactionWithScope", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) CancelCompaction(ctx context.Context, in *CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/CancelCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetCompactionPlanStates(ctx context.Context, in *GetCompactionPlanStatesRequest, opts ...grpc.CallOption) (*datapb.GetCompactionPlanStatesResponse, error) {
+	out := new(datapb.GetCompactionPlanStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetCompactionPlanStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*datapb.ExportResponse, error) {
+	out := new(datapb.ExportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) GetExportState(ctx context.Context, in *GetExportStateRequest, opts ...grpc.CallOption) (*datapb.GetExportStateResponse, error) {
+	out := new(datapb.GetExportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/GetExportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) CancelExport(ctx context.Context, in *CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/CancelExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusExtServiceClient) AddCollectionField(ctx context.Context, in *AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.MilvusExtService/AddCollectionField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MilvusExtServiceServer is the server API for MilvusExtService service.
+type MilvusExtServiceServer interface {
+	ValidateExpr(context.Context, *ValidateExprRequest) (*ValidateExprResponse, error)
+	ManualCompactionWithScope(context.Context, *ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error)
+	CancelCompaction(context.Context, *CancelCompactionRequest) (*commonpb.Status, error)
+	GetCompactionPlanStates(context.Context, *GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error)
+	Export(context.Context, *ExportRequest) (*datapb.ExportResponse, error)
+	GetExportState(context.Context, *GetExportStateRequest) (*datapb.GetExportStateResponse, error)
+	CancelExport(context.Context, *CancelExportRequest) (*commonpb.Status, error)
+	AddCollectionField(context.Context, *AddCollectionFieldRequest) (*commonpb.Status, error)
+}
+
+// UnimplementedMilvusExtServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedMilvusExtServiceServer struct {
+}
+
+func (*UnimplementedMilvusExtServiceServer) ValidateExpr(ctx context.Context, req *ValidateExprRequest) (*ValidateExprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateExpr not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) ManualCompactionWithScope(ctx context.Context, req *ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManualCompactionWithScope not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) CancelCompaction(ctx context.Context, req *CancelCompactionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCompaction not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetCompactionPlanStates(ctx context.Context, req *GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionPlanStates not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) Export(ctx context.Context, req *ExportRequest) (*datapb.ExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) GetExportState(ctx context.Context, req *GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExportState not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) CancelExport(ctx context.Context, req *CancelExportRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExport not implemented")
+}
+func (*UnimplementedMilvusExtServiceServer) AddCollectionField(ctx context.Context, req *AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionField not implemented")
+}
+
+func RegisterMilvusExtServiceServer(s *grpc.Server, srv MilvusExtServiceServer) {
+	s.RegisterService(&_MilvusExtService_serviceDesc, srv)
+}
+
+func _MilvusExtService_ValidateExpr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateExprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).ValidateExpr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ValidateExpr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).ValidateExpr(ctx, req.(*ValidateExprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_ManualCompactionWithScope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManualCompactionWithScopeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).ManualCompactionWithScope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/ManualCompactionWithScope",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).ManualCompactionWithScope(ctx, req.(*ManualCompactionWithScopeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_CancelCompaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCompactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).CancelCompaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/CancelCompaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).CancelCompaction(ctx, req.(*CancelCompactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetCompactionPlanStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompactionPlanStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetCompactionPlanStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetCompactionPlanStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetCompactionPlanStates(ctx, req.(*GetCompactionPlanStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).Export(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_GetExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).GetExportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/GetExportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).GetExportState(ctx, req.(*GetExportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_CancelExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).CancelExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/CancelExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).CancelExport(ctx, req.(*CancelExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusExtService_AddCollectionField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusExtServiceServer).AddCollectionField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.MilvusExtService/AddCollectionField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusExtServiceServer).AddCollectionField(ctx, req.(*AddCollectionFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MilvusExtService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "milvus.proto.proxy.MilvusExtService",
+	HandlerType: (*MilvusExtServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValidateExpr",
+			Handler:    _MilvusExtService_ValidateExpr_Handler,
+		},
+		{
+			MethodName: "ManualCompactionWithScope",
+			Handler:    _MilvusExtService_ManualCompactionWithScope_Handler,
+		},
+		{
+			MethodName: "CancelCompaction",
+			Handler:    _MilvusExtService_CancelCompaction_Handler,
+		},
+		{
+			MethodName: "GetCompactionPlanStates",
+			Handler:    _MilvusExtService_GetCompactionPlanStates_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _MilvusExtService_Export_Handler,
+		},
+		{
+			MethodName: "GetExportState",
+			Handler:    _MilvusExtService_GetExportState_Handler,
+		},
+		{
+			MethodName: "CancelExport",
+			Handler:    _MilvusExtService_CancelExport_Handler,
+		},
+		{
+			MethodName: "AddCollectionField",
+			Handler:    _MilvusExtService_AddCollectionField_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proxy.proto",
+}
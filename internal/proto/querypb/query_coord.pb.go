@@ -215,7 +215,7 @@ func (SyncType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_aab7cc9a69ed26e8, []int{5}
 }
 
-//--------------------QueryCoord grpc request and response proto------------------
+// --------------------QueryCoord grpc request and response proto------------------
 type ShowCollectionsRequest struct {
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	// Not useful for now
@@ -1187,7 +1187,7 @@ func (m *ShardLeadersList) GetNodeAddrs() []string {
 	return nil
 }
 
-//-----------------query node grpc request and response proto----------------
+// -----------------query node grpc request and response proto----------------
 type LoadMetaInfo struct {
 	LoadType             LoadType `protobuf:"varint,1,opt,name=load_type,json=loadType,proto3,enum=milvus.proto.query.LoadType" json:"load_type,omitempty"`
 	CollectionID         int64    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -2173,7 +2173,7 @@ func (m *ReplicaSegmentsInfo) GetVersions() []int64 {
 	return nil
 }
 
-//----------------request auto triggered by QueryCoord-----------------
+// ----------------request auto triggered by QueryCoord-----------------
 type HandoffSegmentsRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	SegmentInfos         []*SegmentInfo    `protobuf:"bytes,2,rep,name=segmentInfos,proto3" json:"segmentInfos,omitempty"`
@@ -2870,7 +2870,7 @@ func (m *UnsubscribeChannelInfo) GetCollectionChannels() []*UnsubscribeChannels
 	return nil
 }
 
-//---- synchronize messages proto between QueryCoord and QueryNode -----
+// ---- synchronize messages proto between QueryCoord and QueryNode -----
 type SegmentChangeInfo struct {
 	OnlineNodeID         int64          `protobuf:"varint,1,opt,name=online_nodeID,json=onlineNodeID,proto3" json:"online_nodeID,omitempty"`
 	OnlineSegments       []*SegmentInfo `protobuf:"bytes,2,rep,name=online_segments,json=onlineSegments,proto3" json:"online_segments,omitempty"`
@@ -3533,14 +3533,16 @@ func (m *Replica) GetNodes() []int64 {
 }
 
 type SyncAction struct {
-	Type                 SyncType `protobuf:"varint,1,opt,name=type,proto3,enum=milvus.proto.query.SyncType" json:"type,omitempty"`
-	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	SegmentID            int64    `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	NodeID               int64    `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	Version              int64    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Type        SyncType `protobuf:"varint,1,opt,name=type,proto3,enum=milvus.proto.query.SyncType" json:"type,omitempty"`
+	PartitionID int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	SegmentID   int64    `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NodeID      int64    `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Version     int64    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// value range of the clustering key field, used by the shard leader to prune segments
+	ClusteringKeyRange   *datapb.FieldStats `protobuf:"bytes,6,opt,name=clustering_key_range,json=clusteringKeyRange,proto3" json:"clustering_key_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *SyncAction) Reset()         { *m = SyncAction{} }
@@ -3603,6 +3605,13 @@ func (m *SyncAction) GetVersion() int64 {
 	return 0
 }
 
+func (m *SyncAction) GetClusteringKeyRange() *datapb.FieldStats {
+	if m != nil {
+		return m.ClusteringKeyRange
+	}
+	return nil
+}
+
 type SyncDistributionRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -3666,6 +3675,63 @@ func (m *SyncDistributionRequest) GetActions() []*SyncAction {
 	return nil
 }
 
+// UpdateSchemaRequest pushes the schema of a collection with fields appended to the loaded collection,
+// the timestamp of base is the time fields are added.
+type UpdateSchemaRequest struct {
+	Base                 *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *UpdateSchemaRequest) Reset()         { *m = UpdateSchemaRequest{} }
+func (m *UpdateSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateSchemaRequest) ProtoMessage()    {}
+func (*UpdateSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab7cc9a69ed26e8, []int{49}
+}
+
+func (m *UpdateSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateSchemaRequest.Unmarshal(m, b)
+}
+func (m *UpdateSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateSchemaRequest.Merge(m, src)
+}
+func (m *UpdateSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateSchemaRequest.Size(m)
+}
+func (m *UpdateSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateSchemaRequest proto.InternalMessageInfo
+
+func (m *UpdateSchemaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UpdateSchemaRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *UpdateSchemaRequest) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.query.DataScope", DataScope_name, DataScope_value)
 	proto.RegisterEnum("milvus.proto.query.PartitionState", PartitionState_name, PartitionState_value)
@@ -3729,246 +3795,251 @@ func init() {
 	proto.RegisterType((*Replica)(nil), "milvus.proto.query.Replica")
 	proto.RegisterType((*SyncAction)(nil), "milvus.proto.query.SyncAction")
 	proto.RegisterType((*SyncDistributionRequest)(nil), "milvus.proto.query.SyncDistributionRequest")
+	proto.RegisterType((*UpdateSchemaRequest)(nil), "milvus.proto.query.UpdateSchemaRequest")
 }
 
 func init() { proto.RegisterFile("query_coord.proto", fileDescriptor_aab7cc9a69ed26e8) }
 
 var fileDescriptor_aab7cc9a69ed26e8 = []byte{
-	// 3736 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x49, 0x6c, 0x24, 0x59,
-	0x56, 0x15, 0xb9, 0xd8, 0x99, 0x2f, 0x17, 0x87, 0xbf, 0xab, 0x5c, 0x39, 0x39, 0xd5, 0xd5, 0xee,
-	0xa8, 0xae, 0x6e, 0xe3, 0x9e, 0xb6, 0x7b, 0x5c, 0x33, 0x4d, 0x0d, 0x33, 0xa3, 0xa1, 0xca, 0x9e,
-	0x72, 0x9b, 0xee, 0xf2, 0x98, 0xc8, 0xaa, 0x02, 0xb5, 0x9a, 0xc9, 0x89, 0xcc, 0xf8, 0x99, 0x0e,
-	0x55, 0x2c, 0x59, 0x11, 0x91, 0xae, 0x76, 0x73, 0xe5, 0x32, 0x23, 0x40, 0x82, 0x03, 0x27, 0xc4,
-	0x09, 0x24, 0x90, 0x68, 0xc4, 0x01, 0x6e, 0x1c, 0x90, 0x90, 0xe0, 0x86, 0xb8, 0x71, 0xe4, 0x8a,
-	0x04, 0x12, 0x12, 0xd2, 0x1c, 0x38, 0x20, 0xa1, 0xbf, 0xc5, 0xfa, 0xc3, 0x19, 0x65, 0x77, 0xf5,
-	0x82, 0xe6, 0x96, 0xf1, 0xfe, 0xf2, 0xde, 0x7f, 0xfb, 0x7b, 0xff, 0x27, 0xac, 0x3e, 0x9b, 0x63,
-	0xff, 0x6c, 0x38, 0xf6, 0x3c, 0xdf, 0xdc, 0x9e, 0xf9, 0x5e, 0xe8, 0x21, 0xe4, 0x58, 0xf6, 0xe9,
-	0x3c, 0x60, 0x5f, 0xdb, 0x74, 0xbc, 0xdf, 0x1e, 0x7b, 0x8e, 0xe3, 0xb9, 0x0c, 0xd6, 0x6f, 0x27,
-	0x67, 0xf4, 0xbb, 0x96, 0x1b, 0x62, 0xdf, 0x35, 0x6c, 0x31, 0x1a, 0x8c, 0x4f, 0xb0, 0x63, 0xf0,
-	0x2f, 0xd5, 0x34, 0x42, 0x23, 0xb9, 0xbf, 0xf6, 0x3b, 0x0a, 0xac, 0x0f, 0x4e, 0xbc, 0xe7, 0x7b,
-	0x9e, 0x6d, 0xe3, 0x71, 0x68, 0x79, 0x6e, 0xa0, 0xe3, 0x67, 0x73, 0x1c, 0x84, 0xe8, 0x1d, 0xa8,
-	0x8d, 0x8c, 0x00, 0xf7, 0x94, 0x0d, 0x65, 0xb3, 0xb5, 0x7b, 0x63, 0x3b, 0x45, 0x09, 0x27, 0xe1,
-	0x61, 0x30, 0xbd, 0x6f, 0x04, 0x58, 0xa7, 0x33, 0x11, 0x82, 0x9a, 0x39, 0x3a, 0xdc, 0xef, 0x55,
-	0x36, 0x94, 0xcd, 0xaa, 0x4e, 0x7f, 0xa3, 0xd7, 0xa1, 0x33, 0x8e, 0xf6, 0x3e, 0xdc, 0x0f, 0x7a,
-	0xd5, 0x8d, 0xea, 0x66, 0x55, 0x4f, 0x03, 0xb5, 0x7f, 0x53, 0xe0, 0x7a, 0x8e, 0x8c, 0x60, 0xe6,
-	0xb9, 0x01, 0x46, 0x77, 0x60, 0x29, 0x08, 0x8d, 0x70, 0x1e, 0x70, 0x4a, 0xbe, 0x2e, 0xa5, 0x64,
-	0x40, 0xa7, 0xe8, 0x7c, 0x6a, 0x1e, 0x6d, 0x45, 0x82, 0x16, 0x7d, 0x13, 0xae, 0x5a, 0xee, 0x43,
-	0xec, 0x78, 0xfe, 0xd9, 0x70, 0x86, 0xfd, 0x31, 0x76, 0x43, 0x63, 0x8a, 0x05, 0x8d, 0x6b, 0x62,
-	0xec, 0x38, 0x1e, 0x42, 0xef, 0xc2, 0x75, 0x26, 0xa5, 0x00, 0xfb, 0xa7, 0xd6, 0x18, 0x0f, 0x8d,
-	0x53, 0xc3, 0xb2, 0x8d, 0x91, 0x8d, 0x7b, 0xb5, 0x8d, 0xea, 0x66, 0x43, 0xbf, 0x46, 0x87, 0x07,
-	0x6c, 0xf4, 0x9e, 0x18, 0xd4, 0xfe, 0x4c, 0x81, 0x6b, 0xe4, 0x84, 0xc7, 0x86, 0x1f, 0x5a, 0x2f,
-	0x81, 0xcf, 0x1a, 0xb4, 0x93, 0x67, 0xeb, 0x55, 0xe9, 0x58, 0x0a, 0x46, 0xe6, 0xcc, 0x04, 0x7a,
-	0xc2, 0x93, 0x1a, 0x3d, 0x66, 0x0a, 0xa6, 0xfd, 0x29, 0x57, 0x88, 0x24, 0x9d, 0x97, 0x11, 0x44,
-	0x16, 0x67, 0x25, 0x8f, 0xf3, 0x02, 0x62, 0xd0, 0x7e, 0x56, 0x85, 0x6b, 0x1f, 0x78, 0x86, 0x19,
-	0x2b, 0xcc, 0xe7, 0xcf, 0xce, 0xef, 0xc3, 0x12, 0xb3, 0xae, 0x5e, 0x8d, 0xe2, 0xba, 0x9d, 0xc6,
-	0xc5, 0x2d, 0x2f, 0xa6, 0x70, 0x40, 0x01, 0x3a, 0x5f, 0x84, 0x6e, 0x43, 0xd7, 0xc7, 0x33, 0xdb,
-	0x1a, 0x1b, 0x43, 0x77, 0xee, 0x8c, 0xb0, 0xdf, 0xab, 0x6f, 0x28, 0x9b, 0x75, 0xbd, 0xc3, 0xa1,
-	0x47, 0x14, 0x88, 0x7e, 0x02, 0x9d, 0x89, 0x85, 0x6d, 0x73, 0x68, 0xb9, 0x26, 0xfe, 0xf8, 0x70,
-	0xbf, 0xb7, 0xb4, 0x51, 0xdd, 0x6c, 0xed, 0x7e, 0x77, 0x3b, 0xef, 0x19, 0xb6, 0xa5, 0x1c, 0xd9,
-	0x7e, 0x40, 0x96, 0x1f, 0xb2, 0xd5, 0x3f, 0x74, 0x43, 0xff, 0x4c, 0x6f, 0x4f, 0x12, 0xa0, 0xfe,
-	0x0f, 0x60, 0x35, 0x37, 0x05, 0xa9, 0x50, 0x7d, 0x8a, 0xcf, 0x28, 0x17, 0xab, 0x3a, 0xf9, 0x89,
-	0xae, 0x42, 0xfd, 0xd4, 0xb0, 0xe7, 0x98, 0xf3, 0x89, 0x7d, 0xfc, 0x4a, 0xe5, 0xae, 0xa2, 0xfd,
-	0xb1, 0x02, 0x3d, 0x1d, 0xdb, 0xd8, 0x08, 0xf0, 0x17, 0x29, 0x8f, 0x75, 0x58, 0x72, 0x3d, 0x13,
-	0x1f, 0xee, 0x53, 0x79, 0x54, 0x75, 0xfe, 0xa5, 0xfd, 0x8f, 0x02, 0x57, 0x0f, 0x70, 0x48, 0x14,
-	0xd3, 0x0a, 0x42, 0x6b, 0x1c, 0x59, 0xde, 0xf7, 0xa1, 0xea, 0xe3, 0x67, 0x9c, 0xb2, 0xb7, 0xd2,
-	0x94, 0x45, 0x7e, 0x54, 0xb6, 0x52, 0x27, 0xeb, 0xd0, 0x6b, 0xd0, 0x36, 0x1d, 0x7b, 0x38, 0x3e,
-	0x31, 0x5c, 0x17, 0xdb, 0x4c, 0xb5, 0x9b, 0x7a, 0xcb, 0x74, 0xec, 0x3d, 0x0e, 0x42, 0x37, 0x01,
-	0x02, 0x3c, 0x75, 0xb0, 0x1b, 0xc6, 0xae, 0x2f, 0x01, 0x41, 0x5b, 0xb0, 0x3a, 0xf1, 0x3d, 0x67,
-	0x18, 0x9c, 0x18, 0xbe, 0x39, 0xb4, 0xb1, 0x61, 0x62, 0x9f, 0x52, 0xdf, 0xd0, 0x57, 0xc8, 0xc0,
-	0x80, 0xc0, 0x3f, 0xa0, 0x60, 0x74, 0x07, 0xea, 0xc1, 0xd8, 0x9b, 0x61, 0xaa, 0x26, 0xdd, 0xdd,
-	0x57, 0x64, 0x0a, 0xb0, 0x6f, 0x84, 0xc6, 0x80, 0x4c, 0xd2, 0xd9, 0x5c, 0xed, 0xaf, 0xb8, 0x9d,
-	0x7c, 0xc9, 0xdd, 0x4e, 0xc2, 0x96, 0xea, 0x9f, 0x8d, 0x2d, 0x2d, 0x95, 0xb2, 0xa5, 0xe5, 0xf3,
-	0x6d, 0x29, 0xc7, 0xb5, 0x97, 0x6f, 0x4b, 0x7f, 0x1f, 0xdb, 0xd2, 0x97, 0x5d, 0x66, 0xb1, 0xbd,
-	0xd5, 0x53, 0xf6, 0xf6, 0x17, 0x0a, 0x7c, 0xed, 0x00, 0x87, 0x11, 0xf9, 0xc4, 0x7c, 0xf0, 0x97,
-	0x34, 0xdc, 0x7d, 0xaa, 0x40, 0x5f, 0x46, 0xeb, 0x65, 0x42, 0xde, 0x87, 0xb0, 0x1e, 0xe1, 0x18,
-	0x9a, 0x38, 0x18, 0xfb, 0xd6, 0x8c, 0x8a, 0x91, 0x7a, 0x88, 0xd6, 0xee, 0x2d, 0x99, 0xba, 0x65,
-	0x29, 0xb8, 0x16, 0x6d, 0xb1, 0x9f, 0xd8, 0x41, 0xfb, 0x3d, 0x05, 0xae, 0x11, 0x8f, 0xc4, 0x5d,
-	0x88, 0x3b, 0xf1, 0x2e, 0xce, 0xd7, 0xb4, 0x73, 0xaa, 0xe4, 0x9c, 0x53, 0x09, 0x1e, 0xd3, 0xfc,
-	0x31, 0x4b, 0xcf, 0x65, 0x78, 0xf7, 0x6d, 0xa8, 0x5b, 0xee, 0xc4, 0x13, 0xac, 0x7a, 0x55, 0xc6,
-	0xaa, 0x24, 0x32, 0x36, 0x5b, 0x73, 0x19, 0x15, 0xb1, 0xb7, 0xbc, 0x84, 0xba, 0x65, 0x8f, 0x5d,
-	0x91, 0x1c, 0xfb, 0x77, 0x15, 0xb8, 0x9e, 0x43, 0x78, 0x99, 0x73, 0x7f, 0x0f, 0x96, 0x68, 0x0c,
-	0x10, 0x07, 0x7f, 0x5d, 0x7a, 0xf0, 0x04, 0xba, 0x0f, 0xac, 0x20, 0xd4, 0xf9, 0x1a, 0xcd, 0x03,
-	0x35, 0x3b, 0x46, 0xa2, 0x13, 0x8f, 0x4c, 0x43, 0xd7, 0x70, 0x18, 0x03, 0x9a, 0x7a, 0x8b, 0xc3,
-	0x8e, 0x0c, 0x07, 0xa3, 0xaf, 0x41, 0x83, 0x98, 0xec, 0xd0, 0x32, 0x85, 0xf8, 0x97, 0xa9, 0x09,
-	0x9b, 0x01, 0x7a, 0x05, 0x80, 0x0e, 0x19, 0xa6, 0xe9, 0xb3, 0xc0, 0xd5, 0xd4, 0x9b, 0x04, 0x72,
-	0x8f, 0x00, 0xb4, 0x3f, 0x50, 0xa0, 0x4d, 0x1c, 0xe4, 0x43, 0x1c, 0x1a, 0x44, 0x0e, 0xe8, 0x3b,
-	0xd0, 0xb4, 0x3d, 0xc3, 0x1c, 0x86, 0x67, 0x33, 0x86, 0xaa, 0x9b, 0xe5, 0x75, 0xec, 0x55, 0x1f,
-	0x9d, 0xcd, 0xb0, 0xde, 0xb0, 0xf9, 0xaf, 0x32, 0xfc, 0xce, 0x99, 0x72, 0x55, 0x62, 0xca, 0xff,
-	0x58, 0x87, 0xf5, 0xdf, 0x30, 0xc2, 0xf1, 0xc9, 0xbe, 0x23, 0xe2, 0xef, 0xc5, 0x95, 0x20, 0xf6,
-	0x6d, 0x95, 0xa4, 0x6f, 0xfb, 0xcc, 0x7c, 0x67, 0xa4, 0xe7, 0x75, 0x99, 0x9e, 0x93, 0x32, 0x6d,
-	0xfb, 0x09, 0x17, 0x55, 0x42, 0xcf, 0x13, 0x61, 0x72, 0xe9, 0x22, 0x61, 0x72, 0x0f, 0x3a, 0xf8,
-	0xe3, 0xb1, 0x3d, 0x27, 0x32, 0xa7, 0xd8, 0x59, 0xfc, 0xbb, 0x29, 0xc1, 0x9e, 0x34, 0xb2, 0x36,
-	0x5f, 0x74, 0xc8, 0x69, 0x60, 0xa2, 0x76, 0x70, 0x68, 0xf4, 0x1a, 0x94, 0x8c, 0x8d, 0x22, 0x51,
-	0x0b, 0xfd, 0x60, 0xe2, 0x26, 0x5f, 0xe8, 0x06, 0x34, 0x79, 0x50, 0x3e, 0xdc, 0xef, 0x35, 0x29,
-	0xfb, 0x62, 0x00, 0x32, 0xa0, 0xc3, 0x3d, 0x10, 0xa7, 0x10, 0x28, 0x85, 0xdf, 0x93, 0x21, 0x90,
-	0x0b, 0x3b, 0x49, 0x79, 0xc0, 0x43, 0x74, 0x90, 0x00, 0x91, 0xd2, 0xd0, 0x9b, 0x4c, 0x6c, 0xcb,
-	0xc5, 0x47, 0x4c, 0xc2, 0x2d, 0x4a, 0x44, 0x1a, 0x88, 0x7a, 0xb0, 0x7c, 0x8a, 0xfd, 0xc0, 0xf2,
-	0xdc, 0x5e, 0x9b, 0x8e, 0x8b, 0xcf, 0xfe, 0x10, 0x56, 0x73, 0x28, 0x24, 0x21, 0xfe, 0x5b, 0xc9,
-	0x10, 0xbf, 0x98, 0xc7, 0x89, 0x14, 0xe0, 0xcf, 0x15, 0xb8, 0xf6, 0xd8, 0x0d, 0xe6, 0xa3, 0xe8,
-	0x6c, 0x5f, 0x8c, 0x1e, 0x67, 0x3d, 0x48, 0x2d, 0xe7, 0x41, 0xb4, 0x9f, 0xd6, 0x61, 0x85, 0x9f,
-	0x82, 0x88, 0x9b, 0xba, 0x82, 0x1b, 0xd0, 0x8c, 0x82, 0x08, 0x67, 0x48, 0x0c, 0x40, 0x1b, 0xd0,
-	0x4a, 0x18, 0x02, 0xa7, 0x2a, 0x09, 0x2a, 0x45, 0x9a, 0x48, 0x09, 0x6a, 0x89, 0x94, 0xe0, 0x15,
-	0x80, 0x89, 0x3d, 0x0f, 0x4e, 0x86, 0xa1, 0xe5, 0x60, 0x9e, 0x92, 0x34, 0x29, 0xe4, 0x91, 0xe5,
-	0x60, 0x74, 0x0f, 0xda, 0x23, 0xcb, 0xb5, 0xbd, 0xe9, 0x70, 0x66, 0x84, 0x27, 0x01, 0x2f, 0xa3,
-	0x64, 0x62, 0xa1, 0x09, 0xdc, 0x7d, 0x3a, 0x57, 0x6f, 0xb1, 0x35, 0xc7, 0x64, 0x09, 0xba, 0x09,
-	0x2d, 0x77, 0xee, 0x0c, 0xbd, 0xc9, 0xd0, 0xf7, 0x9e, 0x13, 0xe3, 0xa1, 0x28, 0xdc, 0xb9, 0xf3,
-	0xa3, 0x89, 0xee, 0x3d, 0x27, 0x4e, 0xbc, 0x49, 0xdc, 0x79, 0x60, 0x7b, 0xd3, 0xa0, 0xd7, 0x28,
-	0xb5, 0x7f, 0xbc, 0x80, 0xac, 0x36, 0xb1, 0x1d, 0x1a, 0x74, 0x75, 0xb3, 0xdc, 0xea, 0x68, 0x01,
-	0x7a, 0x03, 0xba, 0x63, 0xcf, 0x99, 0x19, 0x94, 0x43, 0x0f, 0x7c, 0xcf, 0xa1, 0x96, 0x53, 0xd5,
-	0x33, 0x50, 0xb4, 0x07, 0x2d, 0x9a, 0xfc, 0x72, 0xf3, 0x6a, 0x51, 0x3c, 0x9a, 0xcc, 0xbc, 0x12,
-	0x79, 0x2c, 0x51, 0x50, 0xb0, 0xc4, 0xcf, 0x80, 0x68, 0x86, 0xb0, 0xd2, 0xc0, 0xfa, 0x04, 0x73,
-	0x0b, 0x69, 0x71, 0xd8, 0xc0, 0xfa, 0x04, 0x93, 0x8c, 0xdc, 0x72, 0x03, 0xec, 0x87, 0xa2, 0x3e,
-	0xea, 0x75, 0xa8, 0xfa, 0x74, 0x18, 0x94, 0x2b, 0x36, 0x3a, 0x84, 0x6e, 0x10, 0x1a, 0x7e, 0x38,
-	0x9c, 0x79, 0x01, 0x55, 0x80, 0x5e, 0x97, 0xea, 0xb6, 0x56, 0x50, 0x8d, 0x3d, 0x0c, 0xa6, 0xc7,
-	0x7c, 0xa6, 0xde, 0xa1, 0x2b, 0xc5, 0xa7, 0xf6, 0x5f, 0x15, 0xe8, 0xa6, 0x69, 0x26, 0x46, 0xcc,
-	0xb2, 0x73, 0xa1, 0x88, 0xe2, 0x93, 0x9c, 0x00, 0xbb, 0xc6, 0xc8, 0xc6, 0xac, 0x14, 0xa0, 0x7a,
-	0xd8, 0xd0, 0x5b, 0x0c, 0x46, 0x37, 0x20, 0xfa, 0xc4, 0x38, 0x45, 0x95, 0xbf, 0x4a, 0xa9, 0x6f,
-	0x52, 0x08, 0x0d, 0x9e, 0x3d, 0x58, 0x16, 0x55, 0x04, 0xd3, 0x42, 0xf1, 0x49, 0x46, 0x46, 0x73,
-	0x8b, 0x62, 0x65, 0x5a, 0x28, 0x3e, 0xd1, 0x3e, 0xb4, 0xd9, 0x96, 0x33, 0xc3, 0x37, 0x1c, 0xa1,
-	0x83, 0xaf, 0x49, 0xed, 0xf8, 0x7d, 0x7c, 0xf6, 0x84, 0xb8, 0x84, 0x63, 0xc3, 0xf2, 0x75, 0x26,
-	0xb3, 0x63, 0xba, 0x0a, 0x6d, 0x82, 0xca, 0x76, 0x99, 0x58, 0x36, 0xe6, 0xda, 0xbc, 0x4c, 0x23,
-	0x74, 0x97, 0xc2, 0x1f, 0x58, 0x36, 0x66, 0x0a, 0x1b, 0x1d, 0x81, 0x4a, 0xa9, 0xc1, 0xf4, 0x95,
-	0x42, 0xa8, 0x8c, 0x6e, 0x41, 0x87, 0x0d, 0x0b, 0x4f, 0xc7, 0xdc, 0x31, 0xa3, 0xf1, 0x09, 0x83,
-	0xd1, 0x24, 0x61, 0xee, 0x30, 0x8d, 0x07, 0x76, 0x1c, 0x77, 0xee, 0x10, 0x7d, 0xd7, 0xfe, 0xb0,
-	0x06, 0x6b, 0xc4, 0xec, 0xb9, 0x07, 0xb8, 0x44, 0xb8, 0x7d, 0x05, 0xc0, 0x0c, 0xc2, 0x61, 0xca,
-	0x55, 0x35, 0xcd, 0x20, 0xe4, 0xce, 0xf8, 0x3b, 0x22, 0x5a, 0x56, 0x8b, 0x13, 0xe8, 0x8c, 0x1b,
-	0xca, 0x47, 0xcc, 0x0b, 0x35, 0x69, 0x6e, 0x41, 0x27, 0xf0, 0xe6, 0xfe, 0x18, 0x0f, 0x53, 0xa5,
-	0x4e, 0x9b, 0x01, 0x8f, 0xe4, 0xce, 0x74, 0x49, 0xda, 0x2c, 0x4a, 0x44, 0xcd, 0xe5, 0xcb, 0x45,
-	0xcd, 0x46, 0x36, 0x6a, 0xbe, 0x0f, 0x2b, 0xd4, 0x13, 0x44, 0x56, 0x24, 0x1c, 0x48, 0x19, 0x33,
-	0xea, 0xd2, 0xa5, 0xe2, 0x33, 0x48, 0x46, 0x3e, 0x48, 0x45, 0x3e, 0xc2, 0x0c, 0x17, 0x63, 0x73,
-	0x18, 0xfa, 0x86, 0x1b, 0x4c, 0xb0, 0x4f, 0x23, 0x67, 0x43, 0x6f, 0x13, 0xe0, 0x23, 0x0e, 0xd3,
-	0xfe, 0xb9, 0x02, 0xeb, 0xbc, 0x80, 0xbd, 0xbc, 0x5e, 0x14, 0x85, 0x2f, 0xe1, 0xff, 0xab, 0xe7,
-	0x94, 0x84, 0xb5, 0x12, 0xa9, 0x59, 0x5d, 0x92, 0x9a, 0xa5, 0xcb, 0xa2, 0xa5, 0x5c, 0x59, 0x14,
-	0xf5, 0x61, 0x96, 0xcb, 0xf7, 0x61, 0x48, 0xc1, 0x4f, 0x73, 0x75, 0x2a, 0xbb, 0xa6, 0xce, 0x3e,
-	0xca, 0x31, 0xf4, 0x3f, 0x14, 0xe8, 0x0c, 0xb0, 0xe1, 0x8f, 0x4f, 0x04, 0x1f, 0xdf, 0x4d, 0xf6,
-	0xad, 0x5e, 0x2f, 0x10, 0x71, 0x6a, 0xc9, 0x57, 0xa7, 0x61, 0xf5, 0x9f, 0x0a, 0xb4, 0x7f, 0x9d,
-	0x0c, 0x89, 0xc3, 0xde, 0x4d, 0x1e, 0xf6, 0x8d, 0x82, 0xc3, 0xea, 0x38, 0xf4, 0x2d, 0x7c, 0x8a,
-	0xbf, 0x72, 0xc7, 0xfd, 0x27, 0x05, 0xfa, 0x83, 0x33, 0x77, 0xac, 0x33, 0x5b, 0xbe, 0xbc, 0xc5,
-	0xdc, 0x82, 0xce, 0x69, 0x2a, 0x6b, 0xab, 0x50, 0x85, 0x6b, 0x9f, 0x26, 0x0b, 0x3f, 0x1d, 0x54,
-	0xd1, 0x2e, 0xe3, 0x87, 0x15, 0xae, 0xf5, 0x4d, 0x19, 0xd5, 0x19, 0xe2, 0xa8, 0x6b, 0x5a, 0xf1,
-	0xd3, 0x40, 0xed, 0xf7, 0x15, 0x58, 0x93, 0x4c, 0x44, 0xd7, 0x61, 0x99, 0x17, 0x99, 0x3c, 0x06,
-	0x33, 0x1b, 0x36, 0x89, 0x78, 0xe2, 0x36, 0x89, 0x65, 0xe6, 0x53, 0x41, 0x13, 0xbd, 0x0a, 0xad,
-	0xa8, 0x1a, 0x30, 0x73, 0xf2, 0x31, 0x03, 0xd4, 0x87, 0x06, 0x77, 0x4e, 0xa2, 0xcc, 0x8a, 0xbe,
-	0xb5, 0xbf, 0x53, 0x60, 0xfd, 0x3d, 0xc3, 0x35, 0xbd, 0xc9, 0xe4, 0xf2, 0x6c, 0xdd, 0x83, 0x54,
-	0x11, 0x51, 0xb6, 0x3d, 0x91, 0xae, 0x3c, 0xde, 0x82, 0x55, 0x9f, 0x79, 0x46, 0x33, 0xcd, 0xf7,
-	0xaa, 0xae, 0x8a, 0x81, 0x88, 0x9f, 0x7f, 0x59, 0x01, 0x44, 0x82, 0xc1, 0x7d, 0xc3, 0x36, 0xdc,
-	0x31, 0xbe, 0x38, 0xe9, 0xb7, 0xa1, 0x9b, 0x0a, 0x61, 0xd1, 0x5d, 0x58, 0x32, 0x86, 0x05, 0xe8,
-	0x7d, 0xe8, 0x8e, 0x18, 0xaa, 0xa1, 0x8f, 0x8d, 0xc0, 0x73, 0xa9, 0x73, 0xed, 0xca, 0x3b, 0x11,
-	0x8f, 0x7c, 0x6b, 0x3a, 0xc5, 0xfe, 0x9e, 0xe7, 0x9a, 0x3c, 0x17, 0x1b, 0x09, 0x32, 0xc9, 0x52,
-	0x22, 0xb8, 0x38, 0x9e, 0x0b, 0xd1, 0x40, 0x14, 0xd0, 0x29, 0x2b, 0x02, 0x6c, 0xd8, 0x31, 0x23,
-	0x62, 0x6f, 0xac, 0xb2, 0x81, 0x41, 0x71, 0x23, 0x4a, 0x12, 0x5f, 0xb5, 0xbf, 0x51, 0x00, 0x45,
-	0xf5, 0x12, 0xad, 0x0c, 0xa9, 0xf6, 0x65, 0x97, 0x2a, 0x92, 0xa0, 0x70, 0x03, 0x9a, 0xa6, 0x58,
-	0xc9, 0xcd, 0x25, 0x06, 0x50, 0x1f, 0x4d, 0x89, 0x1e, 0x92, 0x60, 0x8c, 0x4d, 0x51, 0x8f, 0x30,
-	0xe0, 0x07, 0x14, 0x96, 0x0e, 0xcf, 0xb5, 0x6c, 0x78, 0x4e, 0xf6, 0x59, 0xea, 0xa9, 0x3e, 0x8b,
-	0xf6, 0x69, 0x05, 0x54, 0xea, 0xee, 0xf6, 0xe2, 0x62, 0xbf, 0x14, 0xd1, 0xb7, 0xa0, 0xc3, 0x6f,
-	0x8b, 0x53, 0x84, 0xb7, 0x9f, 0x25, 0x36, 0x43, 0xef, 0xc0, 0x55, 0x36, 0xc9, 0xc7, 0xc1, 0xdc,
-	0x8e, 0x53, 0x71, 0x96, 0xcc, 0xa2, 0x67, 0xcc, 0xcf, 0x92, 0x21, 0xb1, 0xe2, 0x31, 0xac, 0x4f,
-	0x6d, 0x6f, 0x64, 0xd8, 0xc3, 0xb4, 0x78, 0x98, 0x0c, 0x4b, 0x68, 0xfc, 0x55, 0xb6, 0x7c, 0x90,
-	0x94, 0x61, 0x80, 0x0e, 0x48, 0x59, 0x8f, 0x9f, 0xc6, 0x59, 0x7e, 0xbd, 0x74, 0x96, 0xdf, 0x26,
-	0x0b, 0xa3, 0x24, 0xff, 0x4f, 0x14, 0x58, 0xc9, 0xb4, 0x4a, 0xb3, 0x25, 0xa5, 0x92, 0x2f, 0x29,
-	0xef, 0x42, 0x9d, 0xd4, 0x59, 0xcc, 0x19, 0x76, 0xe5, 0xe5, 0x4e, 0x7a, 0x57, 0x9d, 0x2d, 0x40,
-	0x3b, 0xb0, 0x26, 0xb9, 0x9a, 0xe4, 0x3a, 0x80, 0xf2, 0x37, 0x93, 0xda, 0xcf, 0x6b, 0xd0, 0x4a,
-	0xf0, 0x63, 0x41, 0x35, 0x5c, 0xa6, 0xf7, 0x95, 0x39, 0x5e, 0x35, 0x7f, 0xbc, 0x82, 0x8b, 0x2f,
-	0xa2, 0x77, 0x0e, 0x76, 0x58, 0xf2, 0xcf, 0x2b, 0x11, 0x07, 0x3b, 0x34, 0xf5, 0x4f, 0x66, 0xf5,
-	0x4b, 0xa9, 0xac, 0x3e, 0x53, 0xf7, 0x2c, 0x9f, 0x53, 0xf7, 0x34, 0xd2, 0x75, 0x4f, 0xca, 0x8e,
-	0x9a, 0x59, 0x3b, 0x2a, 0x5b, 0xa0, 0xbe, 0x03, 0x6b, 0x63, 0x1f, 0x1b, 0x21, 0x36, 0xef, 0x9f,
-	0xed, 0x45, 0x43, 0x3c, 0x33, 0x92, 0x0d, 0xa1, 0x07, 0x71, 0xcf, 0x88, 0x49, 0xb9, 0x4d, 0xa5,
-	0x2c, 0x2f, 0xab, 0xb8, 0x6c, 0x98, 0x90, 0x85, 0x7b, 0xa6, 0x5f, 0xd9, 0xd2, 0xb8, 0x73, 0xa1,
-	0xd2, 0xf8, 0x55, 0x68, 0x89, 0xd0, 0x4a, 0xcc, 0xbd, 0xcb, 0x3c, 0x9f, 0xf0, 0x05, 0x66, 0x90,
-	0x72, 0x06, 0x2b, 0xe9, 0xa6, 0x6b, 0xb6, 0x28, 0x55, 0xf3, 0x45, 0xe9, 0x75, 0x58, 0xb6, 0x82,
-	0xe1, 0xc4, 0x78, 0x8a, 0x7b, 0xab, 0x74, 0x74, 0xc9, 0x0a, 0x1e, 0x18, 0x4f, 0xb1, 0xf6, 0x2f,
-	0x55, 0xe8, 0xc6, 0x55, 0x4c, 0x69, 0x37, 0x52, 0xe6, 0x7a, 0xfe, 0x08, 0xd4, 0x38, 0x50, 0x53,
-	0x0e, 0x9f, 0x5b, 0x88, 0x65, 0x6f, 0x32, 0x56, 0x66, 0x19, 0x7b, 0x4d, 0xf5, 0x8a, 0x6b, 0x2f,
-	0xd4, 0x2b, 0xbe, 0xe4, 0x35, 0xe1, 0x1d, 0xb8, 0x16, 0x05, 0xe0, 0xd4, 0xb1, 0x59, 0x96, 0x7f,
-	0x55, 0x0c, 0x1e, 0x27, 0x8f, 0x5f, 0xe0, 0x02, 0x96, 0x8b, 0x5c, 0x40, 0x56, 0x05, 0x1a, 0x39,
-	0x15, 0xc8, 0xdf, 0x56, 0x36, 0x25, 0xb7, 0x95, 0xda, 0x63, 0x58, 0xa3, 0x6d, 0xc0, 0x60, 0xec,
-	0x5b, 0x23, 0x1c, 0xe5, 0xac, 0x65, 0xc4, 0xda, 0x87, 0x46, 0x26, 0xed, 0x8d, 0xbe, 0xb5, 0x9f,
-	0x29, 0xb0, 0x9e, 0xdf, 0x97, 0x6a, 0x4c, 0xec, 0x48, 0x94, 0x94, 0x23, 0xf9, 0x4d, 0x58, 0x8b,
-	0xb7, 0x4f, 0x27, 0xd4, 0x05, 0x29, 0xa3, 0x84, 0x70, 0x1d, 0xc5, 0x7b, 0x08, 0x98, 0xf6, 0x73,
-	0x25, 0xea, 0xa6, 0x12, 0xd8, 0x94, 0xf6, 0x98, 0x49, 0x70, 0xf3, 0x5c, 0xdb, 0x72, 0xa3, 0xaa,
-	0x9b, 0x9f, 0x91, 0x01, 0x79, 0xd5, 0xfd, 0x1e, 0xac, 0xf0, 0x49, 0x51, 0x8c, 0x2a, 0x99, 0x95,
-	0x75, 0xd9, 0xba, 0x28, 0x3a, 0xdd, 0x86, 0x2e, 0x6f, 0xfe, 0x0a, 0x7c, 0x55, 0x59, 0x4b, 0xf8,
-	0xd7, 0x40, 0x15, 0xd3, 0x5e, 0x34, 0x2a, 0xae, 0xf0, 0x85, 0x51, 0x76, 0xf7, 0x53, 0x05, 0x7a,
-	0xe9, 0x18, 0x99, 0x38, 0xfe, 0x8b, 0xe7, 0x78, 0xdf, 0x4d, 0x5f, 0x9b, 0xdd, 0x3e, 0x87, 0x9e,
-	0x18, 0x8f, 0xb8, 0x3c, 0x3b, 0xa2, 0x57, 0xa0, 0xa4, 0x34, 0xd9, 0xb7, 0x82, 0xd0, 0xb7, 0x46,
-	0xf3, 0x4b, 0xbd, 0xdf, 0xd0, 0xfe, 0xb6, 0x02, 0x5f, 0x97, 0x6e, 0x78, 0x99, 0x0b, 0xb2, 0xa2,
-	0x4e, 0xc0, 0x7d, 0x68, 0x64, 0x4a, 0x98, 0x37, 0xce, 0x39, 0x3c, 0x6f, 0x6a, 0xb1, 0xe6, 0x8a,
-	0x58, 0x47, 0xf6, 0x88, 0x74, 0xba, 0x56, 0xbc, 0x07, 0x57, 0xda, 0xd4, 0x1e, 0x62, 0x1d, 0xba,
-	0x07, 0x6d, 0x56, 0x1e, 0x0e, 0x4f, 0x2d, 0xfc, 0x5c, 0xdc, 0xeb, 0xdc, 0x94, 0xfa, 0x35, 0x3a,
-	0xef, 0x89, 0x85, 0x9f, 0xeb, 0x2d, 0x3b, 0xfa, 0x1d, 0x68, 0xff, 0x5d, 0x05, 0x88, 0xc7, 0x48,
-	0x6d, 0x1a, 0x1b, 0x0c, 0xb7, 0x80, 0x04, 0x84, 0x04, 0xe2, 0x74, 0xee, 0x27, 0x3e, 0x91, 0x1e,
-	0xb7, 0x67, 0x4d, 0x2b, 0x08, 0x39, 0x5f, 0x76, 0xce, 0xa7, 0x45, 0xb0, 0x88, 0x88, 0x8c, 0x5d,
-	0x9b, 0x88, 0xda, 0x8b, 0x40, 0xd0, 0xdb, 0x80, 0xa6, 0xbe, 0xf7, 0xdc, 0x72, 0xa7, 0xc9, 0x8c,
-	0x9d, 0x25, 0xf6, 0xab, 0x7c, 0x24, 0x91, 0xb2, 0xff, 0x18, 0xd4, 0xcc, 0x74, 0xc1, 0x92, 0x3b,
-	0x0b, 0xc8, 0x38, 0x48, 0xed, 0xc5, 0x6f, 0x70, 0x56, 0xd2, 0x18, 0x82, 0xfe, 0x10, 0xd4, 0x2c,
-	0xbd, 0x92, 0x3b, 0x98, 0x6f, 0xa7, 0xef, 0x60, 0xce, 0x33, 0x53, 0xb2, 0x4d, 0xe2, 0x12, 0xa6,
-	0x3f, 0x81, 0xab, 0x32, 0x4a, 0x24, 0x48, 0xee, 0xa6, 0x91, 0x94, 0xc9, 0x69, 0x13, 0x97, 0x3d,
-	0x3f, 0x88, 0xd2, 0x45, 0xca, 0xe6, 0x22, 0x0f, 0x9c, 0x68, 0xca, 0x55, 0x52, 0x4d, 0x39, 0xed,
-	0x8f, 0x14, 0x40, 0x79, 0xed, 0x46, 0x5d, 0xa8, 0x44, 0x9b, 0x54, 0x0e, 0xf7, 0x33, 0xda, 0x54,
-	0xc9, 0x69, 0xd3, 0x0d, 0x68, 0x46, 0x11, 0x91, 0xbb, 0xbf, 0x18, 0x90, 0xd4, 0xb5, 0x5a, 0x5a,
-	0xd7, 0x12, 0x84, 0xd5, 0xd3, 0x84, 0x9d, 0x00, 0xca, 0x5b, 0x4c, 0x72, 0x27, 0x25, 0xbd, 0xd3,
-	0x22, 0x0a, 0x13, 0x98, 0xaa, 0x69, 0x4c, 0xff, 0x5e, 0x01, 0x14, 0xc7, 0xfc, 0xe8, 0x22, 0xaa,
-	0x4c, 0xa0, 0xdc, 0x81, 0xb5, 0x7c, 0x46, 0x20, 0xd2, 0x20, 0x94, 0xcb, 0x07, 0x64, 0xb1, 0xbb,
-	0x2a, 0x7b, 0x69, 0xf4, 0x6e, 0xe4, 0xe3, 0x58, 0x82, 0x73, 0xb3, 0x28, 0xc1, 0xc9, 0xb8, 0xb9,
-	0xdf, 0xca, 0xbe, 0x50, 0x62, 0x46, 0x73, 0x57, 0xea, 0x8f, 0x72, 0x47, 0x7e, 0xf9, 0xcf, 0x93,
-	0xfe, 0xb5, 0x02, 0xab, 0x11, 0x37, 0x5e, 0x88, 0xd3, 0x8b, 0x2f, 0xfe, 0x5e, 0x32, 0x6b, 0x3f,
-	0x92, 0xb3, 0xf6, 0x97, 0xcf, 0xcd, 0x61, 0x3f, 0x3f, 0xce, 0x0e, 0x60, 0x99, 0xb7, 0xcf, 0x72,
-	0xb6, 0x5b, 0xa6, 0x4a, 0xbc, 0x0a, 0x75, 0xe2, 0x2a, 0x44, 0x3f, 0x89, 0x7d, 0x68, 0x7f, 0xad,
-	0x00, 0x0c, 0xce, 0xdc, 0xf1, 0x3d, 0x66, 0x42, 0xef, 0x40, 0x6d, 0xd1, 0x03, 0x0d, 0x32, 0x9b,
-	0x26, 0xdd, 0x74, 0x66, 0x09, 0xa9, 0xa5, 0x0a, 0xdc, 0x6a, 0xb6, 0xc0, 0x2d, 0x2a, 0x4d, 0x8b,
-	0xdd, 0xc6, 0x3f, 0x28, 0x70, 0x9d, 0x10, 0xf1, 0x99, 0xe4, 0x22, 0xa5, 0x58, 0x97, 0x70, 0x49,
-	0xd5, 0xb4, 0x4b, 0xba, 0x0b, 0xcb, 0xac, 0xc6, 0x14, 0x79, 0xc1, 0xcd, 0x22, 0x96, 0x31, 0x06,
-	0xeb, 0x62, 0xfa, 0xd6, 0xaf, 0x42, 0x33, 0xea, 0xf5, 0xa2, 0x16, 0x2c, 0x3f, 0x76, 0xdf, 0x77,
-	0xbd, 0xe7, 0xae, 0x7a, 0x05, 0x2d, 0x43, 0xf5, 0x9e, 0x6d, 0xab, 0x0a, 0xea, 0x40, 0x73, 0x10,
-	0xfa, 0xd8, 0x70, 0x2c, 0x77, 0xaa, 0x56, 0x50, 0x17, 0xe0, 0x3d, 0x2b, 0x08, 0x3d, 0xdf, 0x1a,
-	0x1b, 0xb6, 0x5a, 0xdd, 0xfa, 0x04, 0xba, 0xe9, 0x4a, 0x0a, 0xb5, 0xa1, 0x71, 0xe4, 0x85, 0x3f,
-	0xfc, 0xd8, 0x0a, 0x42, 0xf5, 0x0a, 0x99, 0x7f, 0xe4, 0x85, 0xc7, 0x3e, 0x0e, 0xb0, 0x1b, 0xaa,
-	0x0a, 0x02, 0x58, 0xfa, 0x91, 0xbb, 0x6f, 0x05, 0x4f, 0xd5, 0x0a, 0x5a, 0xe3, 0x4d, 0x12, 0xc3,
-	0x3e, 0xe4, 0xe5, 0x89, 0x5a, 0x25, 0xcb, 0xa3, 0xaf, 0x1a, 0x52, 0xa1, 0x1d, 0x4d, 0x39, 0x38,
-	0x7e, 0xac, 0xd6, 0x51, 0x13, 0xea, 0xec, 0xe7, 0xd2, 0x96, 0x09, 0x6a, 0xb6, 0xc3, 0x47, 0xf6,
-	0x64, 0x87, 0x88, 0x40, 0xea, 0x15, 0x72, 0x32, 0xde, 0x62, 0x55, 0x15, 0xb4, 0x02, 0xad, 0x44,
-	0xc3, 0x52, 0xad, 0x10, 0xc0, 0x81, 0x3f, 0x1b, 0x73, 0xe9, 0x31, 0x12, 0x48, 0x2e, 0xbd, 0x4f,
-	0x38, 0x51, 0xdb, 0xba, 0x0f, 0x0d, 0x51, 0xe2, 0x91, 0xa9, 0x9c, 0x45, 0xe4, 0x53, 0xbd, 0x82,
-	0x56, 0xa1, 0x93, 0x7a, 0x81, 0xa9, 0x2a, 0x08, 0x41, 0x37, 0xfd, 0xc0, 0x59, 0xad, 0x6c, 0xed,
-	0x02, 0xc4, 0xa6, 0x4e, 0xc8, 0x39, 0x74, 0x4f, 0x0d, 0xdb, 0x32, 0x19, 0x6d, 0x64, 0x88, 0x70,
-	0x97, 0x72, 0x87, 0xb5, 0xea, 0xd4, 0xca, 0xd6, 0xab, 0xd0, 0x10, 0x5a, 0x4e, 0xe0, 0x3a, 0x76,
-	0xbc, 0x53, 0xcc, 0x24, 0x33, 0xc0, 0xa1, 0xaa, 0xec, 0xfe, 0x6f, 0x07, 0x80, 0x35, 0xe5, 0x3c,
-	0xcf, 0x37, 0x91, 0x0d, 0xe8, 0x00, 0x87, 0x7b, 0x9e, 0x33, 0xf3, 0x5c, 0xd1, 0x2c, 0x08, 0xd0,
-	0x76, 0x5a, 0x15, 0xf8, 0x47, 0x7e, 0x22, 0x3f, 0x7d, 0xff, 0x75, 0xe9, 0xfc, 0xcc, 0x64, 0xed,
-	0x0a, 0x72, 0x28, 0xb6, 0x47, 0x96, 0x83, 0x1f, 0x59, 0xe3, 0xa7, 0x51, 0x27, 0xaf, 0xf8, 0x75,
-	0x72, 0x66, 0xaa, 0xc0, 0x77, 0x4b, 0x8a, 0x6f, 0x10, 0xfa, 0x96, 0x3b, 0x15, 0xa9, 0xb8, 0x76,
-	0x05, 0x3d, 0xcb, 0xbc, 0x8d, 0x16, 0x08, 0x77, 0xcb, 0x3c, 0x87, 0xbe, 0x18, 0x4a, 0x1b, 0x56,
-	0x32, 0xff, 0xf5, 0x40, 0x5b, 0xf2, 0xe7, 0x6e, 0xb2, 0xff, 0xa5, 0xf4, 0xdf, 0x2a, 0x35, 0x37,
-	0xc2, 0x66, 0x41, 0x37, 0xfd, 0x7f, 0x06, 0xf4, 0x4b, 0x45, 0x1b, 0xe4, 0x1e, 0xdc, 0xf6, 0xb7,
-	0xca, 0x4c, 0x8d, 0x50, 0x7d, 0xc8, 0x14, 0x74, 0x11, 0x2a, 0xe9, 0xcb, 0xe2, 0xfe, 0x79, 0x55,
-	0x90, 0x76, 0x05, 0xfd, 0x04, 0x56, 0x73, 0xcf, 0x82, 0xd1, 0x37, 0xe4, 0xb7, 0x35, 0xf2, 0xd7,
-	0xc3, 0x8b, 0x30, 0x7c, 0x98, 0x35, 0xaf, 0x62, 0xea, 0x73, 0xaf, 0xfc, 0xcb, 0x53, 0x9f, 0xd8,
-	0xfe, 0x3c, 0xea, 0x5f, 0x18, 0xc3, 0x9c, 0x9a, 0x4d, 0xb6, 0x35, 0xfc, 0xb6, 0x0c, 0x45, 0xe1,
-	0xdb, 0xe4, 0xfe, 0x76, 0xd9, 0xe9, 0x49, 0xed, 0x4a, 0x3f, 0x7f, 0x95, 0x33, 0x4d, 0xfa, 0x64,
-	0x57, 0xae, 0x5d, 0xf2, 0xd7, 0xb4, 0xda, 0x15, 0xf4, 0x28, 0xe5, 0x5e, 0xd1, 0x1b, 0x45, 0xc2,
-	0x49, 0x5f, 0x18, 0x2d, 0xe2, 0xdb, 0x6f, 0x03, 0x62, 0xb6, 0xe3, 0x4e, 0xac, 0xe9, 0xdc, 0x37,
-	0x98, 0x62, 0x15, 0xb9, 0x9b, 0xfc, 0x54, 0x81, 0xe6, 0x9b, 0x2f, 0xb0, 0x22, 0x3a, 0xd2, 0x10,
-	0xe0, 0x00, 0x87, 0x0f, 0x71, 0xe8, 0x5b, 0xe3, 0x20, 0x7b, 0xa2, 0xd8, 0xa3, 0xf2, 0x09, 0x02,
-	0xd5, 0x9b, 0x0b, 0xe7, 0x45, 0x08, 0x46, 0xd0, 0x3a, 0xc0, 0x21, 0xcf, 0xab, 0x02, 0x54, 0xb8,
-	0x52, 0xcc, 0x10, 0x28, 0x36, 0x17, 0x4f, 0x4c, 0xba, 0xb3, 0xcc, 0x53, 0x60, 0x54, 0x28, 0xd8,
-	0xfc, 0x03, 0x65, 0xb9, 0x3b, 0x2b, 0x78, 0x5b, 0xcc, 0x4e, 0xb4, 0x77, 0x82, 0xc7, 0x4f, 0xdf,
-	0xc3, 0x86, 0x1d, 0x9e, 0x14, 0x9c, 0x28, 0x31, 0xe3, 0xfc, 0x13, 0xa5, 0x26, 0x0a, 0x1c, 0xbb,
-	0x9f, 0x76, 0xa1, 0x49, 0xe3, 0x1f, 0x09, 0xd6, 0xbf, 0x08, 0x7f, 0x9f, 0x71, 0xf8, 0xfb, 0x08,
-	0x56, 0x32, 0x2f, 0x57, 0xe5, 0xfa, 0x22, 0x7f, 0xde, 0x5a, 0xc2, 0x8b, 0xa7, 0xdf, 0x8e, 0xca,
-	0x1d, 0x92, 0xf4, 0x7d, 0xe9, 0xa2, 0xbd, 0x9f, 0xb0, 0x47, 0xdf, 0x51, 0xdf, 0xf4, 0xcd, 0xc2,
-	0xca, 0x2b, 0x7d, 0xdf, 0xfe, 0xc5, 0x47, 0x87, 0x97, 0x1f, 0x3d, 0x3f, 0x82, 0x95, 0xcc, 0xab,
-	0x27, 0xb9, 0x54, 0xe5, 0x4f, 0xa3, 0x16, 0xed, 0xfe, 0x39, 0x86, 0x19, 0x13, 0xd6, 0x24, 0x0f,
-	0x52, 0xd0, 0x76, 0x51, 0xe5, 0x23, 0x7f, 0xb9, 0xb2, 0xf8, 0x40, 0x9d, 0x94, 0x29, 0xa1, 0xcd,
-	0x22, 0x22, 0xb3, 0xff, 0xbd, 0xeb, 0x7f, 0xa3, 0xdc, 0x1f, 0xf5, 0xa2, 0x03, 0x0d, 0x60, 0x89,
-	0xbd, 0x85, 0x42, 0xaf, 0xc9, 0xfb, 0x7f, 0x89, 0x77, 0x52, 0xfd, 0x45, 0xaf, 0xa9, 0x82, 0xb9,
-	0x1d, 0x06, 0x74, 0xd3, 0x3a, 0xf5, 0x90, 0x48, 0xfa, 0x88, 0x2f, 0xf9, 0x80, 0xa9, 0xbf, 0xf8,
-	0xcd, 0x92, 0xd8, 0xf4, 0xff, 0x77, 0x2c, 0xfe, 0x18, 0xd6, 0x24, 0xb7, 0x02, 0xa8, 0x28, 0xe7,
-	0x2a, 0xb8, 0x8f, 0xe8, 0xef, 0x94, 0x9e, 0x1f, 0x61, 0xfe, 0x31, 0xa8, 0xd9, 0x8e, 0x02, 0x7a,
-	0xab, 0x48, 0x9f, 0x65, 0x38, 0xcf, 0x57, 0xe6, 0xfb, 0xdf, 0xfa, 0x70, 0x77, 0x6a, 0x85, 0x27,
-	0xf3, 0x11, 0x19, 0xd9, 0x61, 0x53, 0xdf, 0xb6, 0x3c, 0xfe, 0x6b, 0x47, 0xf0, 0x7f, 0x87, 0xae,
-	0xde, 0xa1, 0xa8, 0x66, 0xa3, 0xd1, 0x12, 0xfd, 0xbc, 0xf3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x37, 0x34, 0x97, 0xee, 0xf6, 0x3f, 0x00, 0x00,
+	// 3804 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4d, 0x6c, 0x1c, 0x59,
+	0x5a, 0xa9, 0xfe, 0xb1, 0xbb, 0xbf, 0xfe, 0x71, 0xf9, 0xd9, 0x71, 0x7a, 0x7b, 0x93, 0x8c, 0xa7,
+	0x32, 0x99, 0x31, 0xce, 0x8e, 0x33, 0xeb, 0xec, 0x0e, 0x59, 0x76, 0x57, 0x4b, 0x62, 0x6f, 0x3c,
+	0x26, 0x93, 0x8c, 0x29, 0x27, 0x01, 0x8d, 0x86, 0xed, 0x29, 0x77, 0xbd, 0xb6, 0x4b, 0xa9, 0xae,
+	0xea, 0xd4, 0xab, 0x76, 0xe2, 0xe1, 0x84, 0x04, 0x87, 0x5d, 0x01, 0x12, 0x1c, 0x38, 0x21, 0x4e,
+	0x20, 0x01, 0x62, 0x39, 0xc1, 0x8d, 0x03, 0x12, 0x12, 0xdc, 0x10, 0x37, 0x8e, 0x7b, 0x45, 0x02,
+	0x09, 0x09, 0x69, 0x0f, 0xdc, 0xd0, 0xfb, 0xab, 0xdf, 0x57, 0xee, 0x8a, 0x9d, 0xcc, 0x0f, 0xda,
+	0x5b, 0xd7, 0xf7, 0x7e, 0xbe, 0xef, 0x7d, 0xff, 0xdf, 0xf7, 0x5e, 0xc3, 0xe2, 0xb3, 0x29, 0x0e,
+	0x4e, 0x06, 0x43, 0xdf, 0x0f, 0xec, 0x8d, 0x49, 0xe0, 0x87, 0x3e, 0x42, 0x63, 0xc7, 0x3d, 0x9e,
+	0x12, 0xfe, 0xb5, 0xc1, 0xc6, 0xfb, 0xed, 0xa1, 0x3f, 0x1e, 0xfb, 0x1e, 0x87, 0xf5, 0xdb, 0xc9,
+	0x19, 0xfd, 0xae, 0xe3, 0x85, 0x38, 0xf0, 0x2c, 0x57, 0x8e, 0x92, 0xe1, 0x11, 0x1e, 0x5b, 0xe2,
+	0x4b, 0xb7, 0xad, 0xd0, 0x4a, 0xee, 0x6f, 0xfc, 0xae, 0x06, 0x2b, 0xfb, 0x47, 0xfe, 0xf3, 0x2d,
+	0xdf, 0x75, 0xf1, 0x30, 0x74, 0x7c, 0x8f, 0x98, 0xf8, 0xd9, 0x14, 0x93, 0x10, 0xbd, 0x07, 0xb5,
+	0x03, 0x8b, 0xe0, 0x9e, 0xb6, 0xaa, 0xad, 0xb5, 0x36, 0x2f, 0x6f, 0xa4, 0x28, 0x11, 0x24, 0x3c,
+	0x20, 0x87, 0x77, 0x2d, 0x82, 0x4d, 0x36, 0x13, 0x21, 0xa8, 0xd9, 0x07, 0xbb, 0xdb, 0xbd, 0xca,
+	0xaa, 0xb6, 0x56, 0x35, 0xd9, 0x6f, 0xf4, 0x16, 0x74, 0x86, 0xd1, 0xde, 0xbb, 0xdb, 0xa4, 0x57,
+	0x5d, 0xad, 0xae, 0x55, 0xcd, 0x34, 0xd0, 0xf8, 0x99, 0x06, 0x97, 0x72, 0x64, 0x90, 0x89, 0xef,
+	0x11, 0x8c, 0x6e, 0xc1, 0x1c, 0x09, 0xad, 0x70, 0x4a, 0x04, 0x25, 0x5f, 0x57, 0x52, 0xb2, 0xcf,
+	0xa6, 0x98, 0x62, 0x6a, 0x1e, 0x6d, 0x45, 0x81, 0x16, 0x7d, 0x13, 0x96, 0x1d, 0xef, 0x01, 0x1e,
+	0xfb, 0xc1, 0xc9, 0x60, 0x82, 0x83, 0x21, 0xf6, 0x42, 0xeb, 0x10, 0x4b, 0x1a, 0x97, 0xe4, 0xd8,
+	0x5e, 0x3c, 0x84, 0xde, 0x87, 0x4b, 0x5c, 0x4a, 0x04, 0x07, 0xc7, 0xce, 0x10, 0x0f, 0xac, 0x63,
+	0xcb, 0x71, 0xad, 0x03, 0x17, 0xf7, 0x6a, 0xab, 0xd5, 0xb5, 0x86, 0x79, 0x91, 0x0d, 0xef, 0xf3,
+	0xd1, 0x3b, 0x72, 0xd0, 0xf8, 0x0b, 0x0d, 0x2e, 0xd2, 0x13, 0xee, 0x59, 0x41, 0xe8, 0xbc, 0x06,
+	0x3e, 0x1b, 0xd0, 0x4e, 0x9e, 0xad, 0x57, 0x65, 0x63, 0x29, 0x18, 0x9d, 0x33, 0x91, 0xe8, 0x29,
+	0x4f, 0x6a, 0xec, 0x98, 0x29, 0x98, 0xf1, 0xe7, 0x42, 0x21, 0x92, 0x74, 0x9e, 0x47, 0x10, 0x59,
+	0x9c, 0x95, 0x3c, 0xce, 0x33, 0x88, 0xc1, 0xf8, 0x49, 0x15, 0x2e, 0x7e, 0xe8, 0x5b, 0x76, 0xac,
+	0x30, 0x9f, 0x3f, 0x3b, 0xbf, 0x0f, 0x73, 0xdc, 0xba, 0x7a, 0x35, 0x86, 0xeb, 0x7a, 0x1a, 0x17,
+	0x1f, 0xdb, 0x88, 0x29, 0xdc, 0x67, 0x00, 0x53, 0x2c, 0x42, 0xd7, 0xa1, 0x1b, 0xe0, 0x89, 0xeb,
+	0x0c, 0xad, 0x81, 0x37, 0x1d, 0x1f, 0xe0, 0xa0, 0x57, 0x5f, 0xd5, 0xd6, 0xea, 0x66, 0x47, 0x40,
+	0x1f, 0x32, 0x20, 0xfa, 0x14, 0x3a, 0x23, 0x07, 0xbb, 0xf6, 0xc0, 0xf1, 0x6c, 0xfc, 0x62, 0x77,
+	0xbb, 0x37, 0xb7, 0x5a, 0x5d, 0x6b, 0x6d, 0x7e, 0x77, 0x23, 0xef, 0x19, 0x36, 0x94, 0x1c, 0xd9,
+	0xb8, 0x47, 0x97, 0xef, 0xf2, 0xd5, 0x3f, 0xf4, 0xc2, 0xe0, 0xc4, 0x6c, 0x8f, 0x12, 0xa0, 0xfe,
+	0x0f, 0x60, 0x31, 0x37, 0x05, 0xe9, 0x50, 0x7d, 0x8a, 0x4f, 0x18, 0x17, 0xab, 0x26, 0xfd, 0x89,
+	0x96, 0xa1, 0x7e, 0x6c, 0xb9, 0x53, 0x2c, 0xf8, 0xc4, 0x3f, 0x7e, 0xa5, 0x72, 0x5b, 0x33, 0xfe,
+	0x54, 0x83, 0x9e, 0x89, 0x5d, 0x6c, 0x11, 0xfc, 0x45, 0xca, 0x63, 0x05, 0xe6, 0x3c, 0xdf, 0xc6,
+	0xbb, 0xdb, 0x4c, 0x1e, 0x55, 0x53, 0x7c, 0x19, 0xff, 0xab, 0xc1, 0xf2, 0x0e, 0x0e, 0xa9, 0x62,
+	0x3a, 0x24, 0x74, 0x86, 0x91, 0xe5, 0x7d, 0x1f, 0xaa, 0x01, 0x7e, 0x26, 0x28, 0xbb, 0x91, 0xa6,
+	0x2c, 0xf2, 0xa3, 0xaa, 0x95, 0x26, 0x5d, 0x87, 0xde, 0x84, 0xb6, 0x3d, 0x76, 0x07, 0xc3, 0x23,
+	0xcb, 0xf3, 0xb0, 0xcb, 0x55, 0xbb, 0x69, 0xb6, 0xec, 0xb1, 0xbb, 0x25, 0x40, 0xe8, 0x2a, 0x00,
+	0xc1, 0x87, 0x63, 0xec, 0x85, 0xb1, 0xeb, 0x4b, 0x40, 0xd0, 0x3a, 0x2c, 0x8e, 0x02, 0x7f, 0x3c,
+	0x20, 0x47, 0x56, 0x60, 0x0f, 0x5c, 0x6c, 0xd9, 0x38, 0x60, 0xd4, 0x37, 0xcc, 0x05, 0x3a, 0xb0,
+	0x4f, 0xe1, 0x1f, 0x32, 0x30, 0xba, 0x05, 0x75, 0x32, 0xf4, 0x27, 0x98, 0xa9, 0x49, 0x77, 0xf3,
+	0x8a, 0x4a, 0x01, 0xb6, 0xad, 0xd0, 0xda, 0xa7, 0x93, 0x4c, 0x3e, 0xd7, 0xf8, 0x5b, 0x61, 0x27,
+	0x5f, 0x72, 0xb7, 0x93, 0xb0, 0xa5, 0xfa, 0xab, 0xb1, 0xa5, 0xb9, 0x52, 0xb6, 0x34, 0x7f, 0xba,
+	0x2d, 0xe5, 0xb8, 0xf6, 0xfa, 0x6d, 0xe9, 0x1f, 0x63, 0x5b, 0xfa, 0xb2, 0xcb, 0x2c, 0xb6, 0xb7,
+	0x7a, 0xca, 0xde, 0xfe, 0x4a, 0x83, 0xaf, 0xed, 0xe0, 0x30, 0x22, 0x9f, 0x9a, 0x0f, 0xfe, 0x92,
+	0x86, 0xbb, 0x9f, 0x6a, 0xd0, 0x57, 0xd1, 0x7a, 0x9e, 0x90, 0xf7, 0x31, 0xac, 0x44, 0x38, 0x06,
+	0x36, 0x26, 0xc3, 0xc0, 0x99, 0xd0, 0xdf, 0xdc, 0x43, 0xb4, 0x36, 0xaf, 0xa9, 0xd4, 0x2d, 0x4b,
+	0xc1, 0xc5, 0x68, 0x8b, 0xed, 0xc4, 0x0e, 0xc6, 0x1f, 0x68, 0x70, 0x91, 0x7a, 0x24, 0xe1, 0x42,
+	0xbc, 0x91, 0x7f, 0x76, 0xbe, 0xa6, 0x9d, 0x53, 0x25, 0xe7, 0x9c, 0x4a, 0xf0, 0x98, 0xe5, 0x8f,
+	0x59, 0x7a, 0xce, 0xc3, 0xbb, 0x6f, 0x43, 0xdd, 0xf1, 0x46, 0xbe, 0x64, 0xd5, 0x1b, 0x2a, 0x56,
+	0x25, 0x91, 0xf1, 0xd9, 0x86, 0xc7, 0xa9, 0x88, 0xbd, 0xe5, 0x39, 0xd4, 0x2d, 0x7b, 0xec, 0x8a,
+	0xe2, 0xd8, 0xbf, 0xaf, 0xc1, 0xa5, 0x1c, 0xc2, 0xf3, 0x9c, 0xfb, 0x7b, 0x30, 0xc7, 0x62, 0x80,
+	0x3c, 0xf8, 0x5b, 0xca, 0x83, 0x27, 0xd0, 0x7d, 0xe8, 0x90, 0xd0, 0x14, 0x6b, 0x0c, 0x1f, 0xf4,
+	0xec, 0x18, 0x8d, 0x4e, 0x22, 0x32, 0x0d, 0x3c, 0x6b, 0xcc, 0x19, 0xd0, 0x34, 0x5b, 0x02, 0xf6,
+	0xd0, 0x1a, 0x63, 0xf4, 0x35, 0x68, 0x50, 0x93, 0x1d, 0x38, 0xb6, 0x14, 0xff, 0x3c, 0x33, 0x61,
+	0x9b, 0xa0, 0x2b, 0x00, 0x6c, 0xc8, 0xb2, 0xed, 0x80, 0x07, 0xae, 0xa6, 0xd9, 0xa4, 0x90, 0x3b,
+	0x14, 0x60, 0xfc, 0x91, 0x06, 0x6d, 0xea, 0x20, 0x1f, 0xe0, 0xd0, 0xa2, 0x72, 0x40, 0xdf, 0x81,
+	0xa6, 0xeb, 0x5b, 0xf6, 0x20, 0x3c, 0x99, 0x70, 0x54, 0xdd, 0xcd, 0xcb, 0xaa, 0x23, 0xd0, 0x45,
+	0x8f, 0x4e, 0x26, 0xd8, 0x6c, 0xb8, 0xe2, 0x57, 0x19, 0x7e, 0xe7, 0x4c, 0xb9, 0xaa, 0x30, 0xe5,
+	0x7f, 0xae, 0xc3, 0xca, 0x6f, 0x58, 0xe1, 0xf0, 0x68, 0x7b, 0x2c, 0xe3, 0xef, 0xd9, 0x95, 0x20,
+	0xf6, 0x6d, 0x95, 0xa4, 0x6f, 0x7b, 0x65, 0xbe, 0x33, 0xd2, 0xf3, 0xba, 0x4a, 0xcf, 0x69, 0x99,
+	0xb6, 0xf1, 0x44, 0x88, 0x2a, 0xa1, 0xe7, 0x89, 0x30, 0x39, 0x77, 0x96, 0x30, 0xb9, 0x05, 0x1d,
+	0xfc, 0x62, 0xe8, 0x4e, 0xa9, 0xcc, 0x19, 0x76, 0x1e, 0xff, 0xae, 0x2a, 0xb0, 0x27, 0x8d, 0xac,
+	0x2d, 0x16, 0xed, 0x0a, 0x1a, 0xb8, 0xa8, 0xc7, 0x38, 0xb4, 0x7a, 0x0d, 0x46, 0xc6, 0x6a, 0x91,
+	0xa8, 0xa5, 0x7e, 0x70, 0x71, 0xd3, 0x2f, 0x74, 0x19, 0x9a, 0x22, 0x28, 0xef, 0x6e, 0xf7, 0x9a,
+	0x8c, 0x7d, 0x31, 0x00, 0x59, 0xd0, 0x11, 0x1e, 0x48, 0x50, 0x08, 0x8c, 0xc2, 0xef, 0xa9, 0x10,
+	0xa8, 0x85, 0x9d, 0xa4, 0x9c, 0x88, 0x10, 0x4d, 0x12, 0x20, 0x5a, 0x1a, 0xfa, 0xa3, 0x91, 0xeb,
+	0x78, 0xf8, 0x21, 0x97, 0x70, 0x8b, 0x11, 0x91, 0x06, 0xa2, 0x1e, 0xcc, 0x1f, 0xe3, 0x80, 0x38,
+	0xbe, 0xd7, 0x6b, 0xb3, 0x71, 0xf9, 0xd9, 0x1f, 0xc0, 0x62, 0x0e, 0x85, 0x22, 0xc4, 0x7f, 0x2b,
+	0x19, 0xe2, 0x67, 0xf3, 0x38, 0x91, 0x02, 0xfc, 0xa5, 0x06, 0x17, 0x1f, 0x7b, 0x64, 0x7a, 0x10,
+	0x9d, 0xed, 0x8b, 0xd1, 0xe3, 0xac, 0x07, 0xa9, 0xe5, 0x3c, 0x88, 0xf1, 0xe3, 0x3a, 0x2c, 0x88,
+	0x53, 0x50, 0x71, 0x33, 0x57, 0x70, 0x19, 0x9a, 0x51, 0x10, 0x11, 0x0c, 0x89, 0x01, 0x68, 0x15,
+	0x5a, 0x09, 0x43, 0x10, 0x54, 0x25, 0x41, 0xa5, 0x48, 0x93, 0x29, 0x41, 0x2d, 0x91, 0x12, 0x5c,
+	0x01, 0x18, 0xb9, 0x53, 0x72, 0x34, 0x08, 0x9d, 0x31, 0x16, 0x29, 0x49, 0x93, 0x41, 0x1e, 0x39,
+	0x63, 0x8c, 0xee, 0x40, 0xfb, 0xc0, 0xf1, 0x5c, 0xff, 0x70, 0x30, 0xb1, 0xc2, 0x23, 0x22, 0xca,
+	0x28, 0x95, 0x58, 0x58, 0x02, 0x77, 0x97, 0xcd, 0x35, 0x5b, 0x7c, 0xcd, 0x1e, 0x5d, 0x82, 0xae,
+	0x42, 0xcb, 0x9b, 0x8e, 0x07, 0xfe, 0x68, 0x10, 0xf8, 0xcf, 0xa9, 0xf1, 0x30, 0x14, 0xde, 0x74,
+	0xfc, 0xd1, 0xc8, 0xf4, 0x9f, 0x53, 0x27, 0xde, 0xa4, 0xee, 0x9c, 0xb8, 0xfe, 0x21, 0xe9, 0x35,
+	0x4a, 0xed, 0x1f, 0x2f, 0xa0, 0xab, 0x6d, 0xec, 0x86, 0x16, 0x5b, 0xdd, 0x2c, 0xb7, 0x3a, 0x5a,
+	0x80, 0xde, 0x86, 0xee, 0xd0, 0x1f, 0x4f, 0x2c, 0xc6, 0xa1, 0x7b, 0x81, 0x3f, 0x66, 0x96, 0x53,
+	0x35, 0x33, 0x50, 0xb4, 0x05, 0x2d, 0x96, 0xfc, 0x0a, 0xf3, 0x6a, 0x31, 0x3c, 0x86, 0xca, 0xbc,
+	0x12, 0x79, 0x2c, 0x55, 0x50, 0x70, 0xe4, 0x4f, 0x42, 0x35, 0x43, 0x5a, 0x29, 0x71, 0x3e, 0xc3,
+	0xc2, 0x42, 0x5a, 0x02, 0xb6, 0xef, 0x7c, 0x86, 0x69, 0x46, 0xee, 0x78, 0x04, 0x07, 0xa1, 0xac,
+	0x8f, 0x7a, 0x1d, 0xa6, 0x3e, 0x1d, 0x0e, 0x15, 0x8a, 0x8d, 0x76, 0xa1, 0x4b, 0x42, 0x2b, 0x08,
+	0x07, 0x13, 0x9f, 0x30, 0x05, 0xe8, 0x75, 0x57, 0xb5, 0x3c, 0x45, 0x51, 0x35, 0xf6, 0x80, 0x1c,
+	0xee, 0x89, 0x99, 0x66, 0x87, 0xad, 0x94, 0x9f, 0xc6, 0x7f, 0x57, 0xa0, 0x9b, 0xa6, 0x99, 0x1a,
+	0x31, 0xcf, 0xce, 0xa5, 0x22, 0xca, 0x4f, 0x7a, 0x02, 0xec, 0xd1, 0xc6, 0x0c, 0x2f, 0x05, 0x98,
+	0x1e, 0x36, 0xcc, 0x16, 0x87, 0xb1, 0x0d, 0xa8, 0x3e, 0x71, 0x4e, 0x31, 0xe5, 0xaf, 0x32, 0xea,
+	0x9b, 0x0c, 0xc2, 0x82, 0x67, 0x0f, 0xe6, 0x65, 0x15, 0xc1, 0xb5, 0x50, 0x7e, 0xd2, 0x91, 0x83,
+	0xa9, 0xc3, 0xb0, 0x72, 0x2d, 0x94, 0x9f, 0x68, 0x1b, 0xda, 0x7c, 0xcb, 0x89, 0x15, 0x58, 0x63,
+	0xa9, 0x83, 0x6f, 0x2a, 0xed, 0xf8, 0x3e, 0x3e, 0x79, 0x42, 0x5d, 0xc2, 0x9e, 0xe5, 0x04, 0x26,
+	0x97, 0xd9, 0x1e, 0x5b, 0x85, 0xd6, 0x40, 0xe7, 0xbb, 0x8c, 0x1c, 0x17, 0x0b, 0x6d, 0x9e, 0x67,
+	0x11, 0xba, 0xcb, 0xe0, 0xf7, 0x1c, 0x17, 0x73, 0x85, 0x8d, 0x8e, 0xc0, 0xa4, 0xd4, 0xe0, 0xfa,
+	0xca, 0x20, 0x4c, 0x46, 0xd7, 0xa0, 0xc3, 0x87, 0xa5, 0xa7, 0xe3, 0xee, 0x98, 0xd3, 0xf8, 0x84,
+	0xc3, 0x58, 0x92, 0x30, 0x1d, 0x73, 0x8d, 0x07, 0x7e, 0x1c, 0x6f, 0x3a, 0xa6, 0xfa, 0x6e, 0xfc,
+	0x71, 0x0d, 0x96, 0xa8, 0xd9, 0x0b, 0x0f, 0x70, 0x8e, 0x70, 0x7b, 0x05, 0xc0, 0x26, 0xe1, 0x20,
+	0xe5, 0xaa, 0x9a, 0x36, 0x09, 0x85, 0x33, 0xfe, 0x8e, 0x8c, 0x96, 0xd5, 0xe2, 0x04, 0x3a, 0xe3,
+	0x86, 0xf2, 0x11, 0xf3, 0x4c, 0x4d, 0x9a, 0x6b, 0xd0, 0x21, 0xfe, 0x34, 0x18, 0xe2, 0x41, 0xaa,
+	0xd4, 0x69, 0x73, 0xe0, 0x43, 0xb5, 0x33, 0x9d, 0x53, 0x36, 0x8b, 0x12, 0x51, 0x73, 0xfe, 0x7c,
+	0x51, 0xb3, 0x91, 0x8d, 0x9a, 0xf7, 0x61, 0x81, 0x79, 0x82, 0xc8, 0x8a, 0xa4, 0x03, 0x29, 0x63,
+	0x46, 0x5d, 0xb6, 0x54, 0x7e, 0x92, 0x64, 0xe4, 0x83, 0x54, 0xe4, 0xa3, 0xcc, 0xf0, 0x30, 0xb6,
+	0x07, 0x61, 0x60, 0x79, 0x64, 0x84, 0x03, 0x16, 0x39, 0x1b, 0x66, 0x9b, 0x02, 0x1f, 0x09, 0x98,
+	0xf1, 0xaf, 0x15, 0x58, 0x11, 0x05, 0xec, 0xf9, 0xf5, 0xa2, 0x28, 0x7c, 0x49, 0xff, 0x5f, 0x3d,
+	0xa5, 0x24, 0xac, 0x95, 0x48, 0xcd, 0xea, 0x8a, 0xd4, 0x2c, 0x5d, 0x16, 0xcd, 0xe5, 0xca, 0xa2,
+	0xa8, 0x0f, 0x33, 0x5f, 0xbe, 0x0f, 0x43, 0x0b, 0x7e, 0x96, 0xab, 0x33, 0xd9, 0x35, 0x4d, 0xfe,
+	0x51, 0x8e, 0xa1, 0xff, 0xa9, 0x41, 0x67, 0x1f, 0x5b, 0xc1, 0xf0, 0x48, 0xf2, 0xf1, 0xfd, 0x64,
+	0xdf, 0xea, 0xad, 0x02, 0x11, 0xa7, 0x96, 0x7c, 0x75, 0x1a, 0x56, 0xff, 0xa5, 0x41, 0xfb, 0xd7,
+	0xe9, 0x90, 0x3c, 0xec, 0xed, 0xe4, 0x61, 0xdf, 0x2e, 0x38, 0xac, 0x89, 0xc3, 0xc0, 0xc1, 0xc7,
+	0xf8, 0x2b, 0x77, 0xdc, 0x7f, 0xd1, 0xa0, 0xbf, 0x7f, 0xe2, 0x0d, 0x4d, 0x6e, 0xcb, 0xe7, 0xb7,
+	0x98, 0x6b, 0xd0, 0x39, 0x4e, 0x65, 0x6d, 0x15, 0xa6, 0x70, 0xed, 0xe3, 0x64, 0xe1, 0x67, 0x82,
+	0x2e, 0xdb, 0x65, 0xe2, 0xb0, 0xd2, 0xb5, 0xbe, 0xa3, 0xa2, 0x3a, 0x43, 0x1c, 0x73, 0x4d, 0x0b,
+	0x41, 0x1a, 0x68, 0xfc, 0xa1, 0x06, 0x4b, 0x8a, 0x89, 0xe8, 0x12, 0xcc, 0x8b, 0x22, 0xb3, 0xa7,
+	0x25, 0x6c, 0xd8, 0xa6, 0xe2, 0x89, 0xdb, 0x24, 0x8e, 0x9d, 0x4f, 0x05, 0x6d, 0xf4, 0x06, 0xb4,
+	0xa2, 0x6a, 0xc0, 0xce, 0xc9, 0xc7, 0x26, 0xa8, 0x0f, 0x0d, 0xe1, 0x9c, 0x64, 0x99, 0x15, 0x7d,
+	0x1b, 0xff, 0xa0, 0xc1, 0xca, 0x07, 0x96, 0x67, 0xfb, 0xa3, 0xd1, 0xf9, 0xd9, 0xba, 0x05, 0xa9,
+	0x22, 0xa2, 0x6c, 0x7b, 0x22, 0xb5, 0x08, 0xdd, 0x80, 0xc5, 0x80, 0x7b, 0x46, 0x3b, 0xcd, 0xf7,
+	0xaa, 0xa9, 0xcb, 0x81, 0x88, 0x9f, 0x7f, 0x53, 0x01, 0x44, 0x83, 0xc1, 0x5d, 0xcb, 0xb5, 0xbc,
+	0x21, 0x3e, 0x3b, 0xe9, 0xd7, 0xa1, 0x9b, 0x0a, 0x61, 0xd1, 0x5d, 0x58, 0x32, 0x86, 0x11, 0x74,
+	0x1f, 0xba, 0x07, 0x1c, 0xd5, 0x20, 0xc0, 0x16, 0xf1, 0x3d, 0xe6, 0x5c, 0xbb, 0xea, 0x4e, 0xc4,
+	0xa3, 0xc0, 0x39, 0x3c, 0xc4, 0xc1, 0x96, 0xef, 0xd9, 0x22, 0x17, 0x3b, 0x90, 0x64, 0xd2, 0xa5,
+	0x54, 0x70, 0x71, 0x3c, 0x97, 0xa2, 0x81, 0x28, 0xa0, 0x33, 0x56, 0x10, 0x6c, 0xb9, 0x31, 0x23,
+	0x62, 0x6f, 0xac, 0xf3, 0x81, 0xfd, 0xe2, 0x46, 0x94, 0x22, 0xbe, 0x1a, 0x7f, 0xa7, 0x01, 0x8a,
+	0xea, 0x25, 0x56, 0x19, 0x32, 0xed, 0xcb, 0x2e, 0xd5, 0xf2, 0x4b, 0x69, 0x6c, 0xb5, 0xe5, 0x4a,
+	0x61, 0x2e, 0x31, 0x80, 0xf9, 0x68, 0x46, 0xf4, 0x80, 0x06, 0x63, 0x6c, 0xcb, 0x7a, 0x84, 0x03,
+	0x3f, 0x64, 0xb0, 0x74, 0x78, 0xae, 0x65, 0xc3, 0x73, 0xb2, 0xcf, 0x52, 0x4f, 0xf5, 0x59, 0x8c,
+	0x9f, 0x56, 0x40, 0x67, 0xee, 0x6e, 0x2b, 0x2e, 0xf6, 0x4b, 0x11, 0x7d, 0x0d, 0x3a, 0xe2, 0xb6,
+	0x38, 0x45, 0x78, 0xfb, 0x59, 0x62, 0x33, 0xf4, 0x1e, 0x2c, 0xf3, 0x49, 0x01, 0x26, 0x53, 0x37,
+	0x4e, 0xc5, 0x79, 0x32, 0x8b, 0x9e, 0x71, 0x3f, 0x4b, 0x87, 0xe4, 0x8a, 0xc7, 0xb0, 0x72, 0xe8,
+	0xfa, 0x07, 0x96, 0x3b, 0x48, 0x8b, 0x87, 0xcb, 0xb0, 0x84, 0xc6, 0x2f, 0xf3, 0xe5, 0xfb, 0x49,
+	0x19, 0x12, 0xb4, 0x43, 0xcb, 0x7a, 0xfc, 0x34, 0xce, 0xf2, 0xeb, 0xa5, 0xb3, 0xfc, 0x36, 0x5d,
+	0x28, 0xbf, 0x8c, 0x3f, 0xd3, 0x60, 0x21, 0xd3, 0x2a, 0xcd, 0x96, 0x94, 0x5a, 0xbe, 0xa4, 0xbc,
+	0x0d, 0x75, 0x42, 0xe7, 0x32, 0x26, 0x75, 0xd5, 0xe5, 0x4e, 0x7a, 0x57, 0x93, 0x2f, 0x40, 0x37,
+	0x61, 0x49, 0x71, 0x35, 0x29, 0x74, 0x00, 0xe5, 0x6f, 0x26, 0x8d, 0x9f, 0xd7, 0xa0, 0x95, 0xe0,
+	0xc7, 0x8c, 0x6a, 0xb8, 0x4c, 0xef, 0x2b, 0x73, 0xbc, 0x6a, 0xfe, 0x78, 0x05, 0x17, 0x5f, 0x54,
+	0xef, 0xc6, 0x78, 0xcc, 0x93, 0x7f, 0x51, 0x89, 0x8c, 0xf1, 0x98, 0xa5, 0xfe, 0xc9, 0xac, 0x7e,
+	0x2e, 0x95, 0xd5, 0x67, 0xea, 0x9e, 0xf9, 0x53, 0xea, 0x9e, 0x46, 0xba, 0xee, 0x49, 0xd9, 0x51,
+	0x33, 0x6b, 0x47, 0x65, 0x0b, 0xd4, 0xf7, 0x60, 0x69, 0x18, 0x60, 0x2b, 0xc4, 0xf6, 0xdd, 0x93,
+	0xad, 0x68, 0x48, 0x64, 0x46, 0xaa, 0x21, 0x74, 0x2f, 0xee, 0x19, 0x71, 0x29, 0xb7, 0x99, 0x94,
+	0xd5, 0x65, 0x95, 0x90, 0x0d, 0x17, 0x72, 0x9b, 0x24, 0xbe, 0xb2, 0xa5, 0x71, 0xe7, 0x4c, 0xa5,
+	0xf1, 0x1b, 0xd0, 0x92, 0xa1, 0x95, 0x9a, 0x7b, 0x97, 0x7b, 0x3e, 0x01, 0xa2, 0x21, 0x2b, 0xe9,
+	0x0c, 0x16, 0xd2, 0x4d, 0xd7, 0x6c, 0x51, 0xaa, 0xe7, 0x8b, 0xd2, 0x4b, 0x30, 0xef, 0x90, 0xc1,
+	0xc8, 0x7a, 0x8a, 0x7b, 0x8b, 0x6c, 0x74, 0xce, 0x21, 0xf7, 0xac, 0xa7, 0xd8, 0xf8, 0xb7, 0x2a,
+	0x74, 0xe3, 0x2a, 0xa6, 0xb4, 0x1b, 0x29, 0x73, 0x3d, 0xff, 0x10, 0xf4, 0x38, 0x50, 0x33, 0x0e,
+	0x9f, 0x5a, 0x88, 0x65, 0x6f, 0x32, 0x16, 0x26, 0x69, 0x40, 0xba, 0x57, 0x5c, 0x7b, 0xa9, 0x5e,
+	0xf1, 0x39, 0xaf, 0x09, 0x6f, 0xc1, 0xc5, 0x28, 0x00, 0xa7, 0x8e, 0xcd, 0xb3, 0xfc, 0x65, 0x39,
+	0xb8, 0x97, 0x3c, 0x7e, 0x81, 0x0b, 0x98, 0x2f, 0x72, 0x01, 0x59, 0x15, 0x68, 0xe4, 0x54, 0x20,
+	0x7f, 0x5b, 0xd9, 0x54, 0xdc, 0x56, 0x1a, 0x8f, 0x61, 0x89, 0xb5, 0x01, 0xe9, 0xf5, 0xcf, 0x01,
+	0x8e, 0x72, 0xd6, 0x32, 0x62, 0xed, 0x43, 0x23, 0x93, 0xf6, 0x46, 0xdf, 0xc6, 0x4f, 0x34, 0x58,
+	0xc9, 0xef, 0xcb, 0x34, 0x26, 0x76, 0x24, 0x5a, 0xca, 0x91, 0xfc, 0x26, 0x2c, 0xc5, 0xdb, 0xa7,
+	0x13, 0xea, 0x82, 0x94, 0x51, 0x41, 0xb8, 0x89, 0xe2, 0x3d, 0x24, 0xcc, 0xf8, 0xb9, 0x16, 0x75,
+	0x53, 0x29, 0xec, 0x90, 0xf5, 0x98, 0x69, 0x70, 0xf3, 0x3d, 0xd7, 0xf1, 0xf0, 0x20, 0x45, 0x4e,
+	0x9b, 0x03, 0x45, 0xd5, 0xfd, 0x01, 0x2c, 0x88, 0x49, 0x51, 0x8c, 0x2a, 0x99, 0x95, 0x75, 0xf9,
+	0xba, 0x28, 0x3a, 0x5d, 0x87, 0xae, 0x68, 0xfe, 0x4a, 0x7c, 0x55, 0x55, 0x4b, 0xf8, 0xd7, 0x40,
+	0x97, 0xd3, 0x5e, 0x36, 0x2a, 0x2e, 0x88, 0x85, 0x51, 0x76, 0xf7, 0x63, 0x0d, 0x7a, 0xe9, 0x18,
+	0x99, 0x38, 0xfe, 0xcb, 0xe7, 0x78, 0xdf, 0x4d, 0x5f, 0x9b, 0x5d, 0x3f, 0x85, 0x9e, 0x18, 0x8f,
+	0xbc, 0x3c, 0x7b, 0xc8, 0xae, 0x40, 0x69, 0x69, 0xb2, 0xed, 0x90, 0x30, 0x70, 0x0e, 0xa6, 0xe7,
+	0x7a, 0xbf, 0x61, 0xfc, 0x7d, 0x05, 0xbe, 0xae, 0xdc, 0xf0, 0x3c, 0x17, 0x64, 0x45, 0x9d, 0x80,
+	0xbb, 0xd0, 0xc8, 0x94, 0x30, 0x6f, 0x9f, 0x72, 0x78, 0xd1, 0xd4, 0xe2, 0xcd, 0x15, 0xb9, 0x8e,
+	0xee, 0x11, 0xe9, 0x74, 0xad, 0x78, 0x0f, 0xa1, 0xb4, 0xa9, 0x3d, 0xe4, 0x3a, 0xda, 0x5e, 0xe6,
+	0xe5, 0xe1, 0xe0, 0xd8, 0xc1, 0xcf, 0xe5, 0xbd, 0xce, 0x55, 0xa5, 0x5f, 0x63, 0xf3, 0x9e, 0x38,
+	0xf8, 0xb9, 0xd9, 0x72, 0xa3, 0xdf, 0xc4, 0xf8, 0x9f, 0x2a, 0x40, 0x3c, 0x46, 0x6b, 0xd3, 0xd8,
+	0x60, 0x84, 0x05, 0x24, 0x20, 0x34, 0x10, 0xa7, 0x73, 0x3f, 0xf9, 0x89, 0xcc, 0xb8, 0x3d, 0x6b,
+	0x3b, 0x24, 0x14, 0x7c, 0xb9, 0x79, 0x3a, 0x2d, 0x92, 0x45, 0x54, 0x64, 0xfc, 0xda, 0xa4, 0x45,
+	0x62, 0x08, 0x7a, 0x17, 0xd0, 0x61, 0xe0, 0x3f, 0x77, 0xbc, 0xc3, 0x64, 0xc6, 0xce, 0x13, 0xfb,
+	0x45, 0x31, 0x92, 0x48, 0xd9, 0x7f, 0x04, 0x7a, 0x66, 0xba, 0x64, 0xc9, 0xad, 0x19, 0x64, 0xec,
+	0xa4, 0xf6, 0x12, 0x37, 0x38, 0x0b, 0x69, 0x0c, 0xa4, 0x3f, 0x00, 0x3d, 0x4b, 0xaf, 0xe2, 0x0e,
+	0xe6, 0xdb, 0xe9, 0x3b, 0x98, 0xd3, 0xcc, 0x94, 0x6e, 0x93, 0xb8, 0x84, 0xe9, 0x8f, 0x60, 0x59,
+	0x45, 0x89, 0x02, 0xc9, 0xed, 0x34, 0x92, 0x32, 0x39, 0x6d, 0x8c, 0xc7, 0xf8, 0x01, 0xb4, 0x12,
+	0x14, 0x14, 0x7a, 0xe0, 0x44, 0x53, 0xae, 0x92, 0x6a, 0xca, 0x19, 0x7f, 0xa2, 0x01, 0xca, 0x6b,
+	0x37, 0xea, 0x42, 0x25, 0xda, 0xa4, 0xb2, 0xbb, 0x9d, 0xd1, 0xa6, 0x4a, 0x4e, 0x9b, 0x2e, 0x43,
+	0x33, 0x8a, 0x88, 0xc2, 0xfd, 0xc5, 0x80, 0xa4, 0xae, 0xd5, 0xd2, 0xba, 0x96, 0x20, 0xac, 0x9e,
+	0x26, 0xec, 0x08, 0x50, 0xde, 0x62, 0x92, 0x3b, 0x69, 0xe9, 0x9d, 0x66, 0x51, 0x98, 0xc0, 0x54,
+	0x4d, 0x63, 0xfa, 0x8f, 0x0a, 0xa0, 0x38, 0xe6, 0x47, 0x17, 0x51, 0x65, 0x02, 0xe5, 0x4d, 0x58,
+	0xca, 0x67, 0x04, 0x32, 0x0d, 0x42, 0xb9, 0x7c, 0x40, 0x15, 0xbb, 0xab, 0xaa, 0x97, 0x46, 0xef,
+	0x47, 0x3e, 0x8e, 0x27, 0x38, 0x57, 0x8b, 0x12, 0x9c, 0x8c, 0x9b, 0xfb, 0xad, 0xec, 0x0b, 0x25,
+	0x6e, 0x34, 0xb7, 0x95, 0xfe, 0x28, 0x77, 0xe4, 0xd7, 0xff, 0x3c, 0xe9, 0xdf, 0x2b, 0xb0, 0x18,
+	0x71, 0xe3, 0xa5, 0x38, 0x3d, 0xfb, 0xe2, 0xef, 0x35, 0xb3, 0xf6, 0x13, 0x35, 0x6b, 0x7f, 0xf9,
+	0xd4, 0x1c, 0xf6, 0xf3, 0xe3, 0xec, 0x3e, 0xcc, 0x8b, 0xf6, 0x59, 0xce, 0x76, 0xcb, 0x54, 0x89,
+	0xcb, 0x50, 0xa7, 0xae, 0x42, 0xf6, 0x93, 0xf8, 0x87, 0xf1, 0x3b, 0x15, 0x00, 0xda, 0x5e, 0xbc,
+	0xc3, 0x4d, 0xe8, 0x3d, 0xa8, 0xcd, 0x7a, 0xa0, 0x41, 0x67, 0xb3, 0xa4, 0x9b, 0xcd, 0x2c, 0x21,
+	0xb5, 0x54, 0x81, 0x5b, 0xcd, 0x16, 0xb8, 0x45, 0xa5, 0x69, 0xa1, 0xdb, 0x40, 0x1f, 0xc1, 0xf2,
+	0xd0, 0x9d, 0x92, 0x10, 0x07, 0x34, 0x78, 0x3c, 0xc5, 0x27, 0x83, 0x80, 0x26, 0x2c, 0xe2, 0xc1,
+	0xc3, 0x95, 0xa2, 0x1b, 0x51, 0x2a, 0x6c, 0x9a, 0x62, 0x46, 0x4b, 0xef, 0xe3, 0x13, 0x93, 0x2e,
+	0x34, 0xfe, 0x89, 0xbe, 0x2d, 0x3f, 0xf1, 0x86, 0xaf, 0x24, 0xb9, 0x29, 0x25, 0x8b, 0x84, 0x8f,
+	0xab, 0xa6, 0x7d, 0xdc, 0x6d, 0x98, 0xe7, 0x45, 0xab, 0x4c, 0x34, 0xae, 0x16, 0xc9, 0x80, 0x4b,
+	0xcc, 0x94, 0xd3, 0x8d, 0xbf, 0xd6, 0x60, 0xe9, 0xf1, 0xc4, 0xb6, 0x42, 0x2c, 0x6a, 0x9a, 0xd7,
+	0x7a, 0x82, 0xb8, 0xce, 0xaa, 0x9e, 0xa1, 0xce, 0x5a, 0xff, 0x55, 0x68, 0x46, 0x9d, 0x6e, 0xd4,
+	0x82, 0xf9, 0xc7, 0xde, 0x7d, 0xcf, 0x7f, 0xee, 0xe9, 0x17, 0xd0, 0x3c, 0x54, 0xef, 0xb8, 0xae,
+	0xae, 0xa1, 0x0e, 0x34, 0xf7, 0xc3, 0x00, 0x5b, 0x63, 0xc7, 0x3b, 0xd4, 0x2b, 0xa8, 0x0b, 0xf0,
+	0x81, 0x43, 0x42, 0x3f, 0x70, 0x86, 0x96, 0xab, 0x57, 0xd7, 0x3f, 0x83, 0x6e, 0xba, 0x8e, 0x44,
+	0x6d, 0x68, 0x3c, 0xf4, 0xc3, 0x1f, 0xbe, 0x70, 0x48, 0xa8, 0x5f, 0xa0, 0xf3, 0x1f, 0xfa, 0xe1,
+	0x5e, 0x80, 0x09, 0xf6, 0x42, 0x5d, 0x43, 0x00, 0x73, 0x1f, 0x79, 0xdb, 0x0e, 0x79, 0xaa, 0x57,
+	0xd0, 0x92, 0x68, 0x11, 0x59, 0xee, 0xae, 0x28, 0xce, 0xf4, 0x2a, 0x5d, 0x1e, 0x7d, 0xd5, 0x90,
+	0x0e, 0xed, 0x68, 0xca, 0xce, 0xde, 0x63, 0xbd, 0x8e, 0x9a, 0x50, 0xe7, 0x3f, 0xe7, 0xd6, 0x6d,
+	0xd0, 0xb3, 0xfd, 0x4d, 0xba, 0x27, 0x3f, 0x44, 0x04, 0xd2, 0x2f, 0xd0, 0x93, 0x89, 0x06, 0xb3,
+	0xae, 0xa1, 0x05, 0x68, 0x25, 0xda, 0xb5, 0x7a, 0x85, 0x02, 0x76, 0x82, 0xc9, 0x50, 0x08, 0x8a,
+	0x93, 0x40, 0x2b, 0x89, 0x6d, 0xca, 0x89, 0xda, 0xfa, 0x5d, 0x68, 0xc8, 0x02, 0x97, 0x4e, 0x15,
+	0x2c, 0xa2, 0x9f, 0xfa, 0x05, 0xb4, 0x08, 0x9d, 0xd4, 0xfb, 0x53, 0x5d, 0x43, 0x08, 0xba, 0xe9,
+	0xe7, 0xdd, 0x7a, 0x65, 0x7d, 0x13, 0x20, 0x76, 0x74, 0x94, 0x9c, 0x5d, 0xef, 0xd8, 0x72, 0x1d,
+	0x9b, 0xd3, 0x46, 0x87, 0x28, 0x77, 0x19, 0x77, 0x78, 0xa3, 0x52, 0xaf, 0xac, 0xbf, 0x01, 0x0d,
+	0x69, 0xe3, 0x14, 0x6e, 0xe2, 0xb1, 0x7f, 0x8c, 0xb9, 0x64, 0xf6, 0x71, 0xa8, 0x6b, 0x9b, 0x3f,
+	0xeb, 0x02, 0xf0, 0x96, 0xa4, 0xef, 0x07, 0x36, 0x72, 0x01, 0xed, 0xe0, 0x90, 0xb6, 0x5b, 0x7c,
+	0x4f, 0xb6, 0x4a, 0x08, 0xda, 0x48, 0x2b, 0x84, 0xf8, 0xc8, 0x4f, 0x14, 0xa7, 0xef, 0xbf, 0xa5,
+	0x9c, 0x9f, 0x99, 0x6c, 0x5c, 0x40, 0x63, 0x86, 0x8d, 0x3e, 0xd8, 0x78, 0xe4, 0x0c, 0x9f, 0x46,
+	0x7d, 0xcc, 0xe2, 0xb7, 0xd9, 0x99, 0xa9, 0x12, 0xdf, 0x35, 0x25, 0xbe, 0xfd, 0x90, 0x7a, 0x06,
+	0x59, 0x88, 0x18, 0x17, 0xd0, 0xb3, 0xcc, 0xcb, 0x70, 0x89, 0x70, 0xb3, 0xcc, 0x63, 0xf0, 0xb3,
+	0xa1, 0x74, 0x61, 0x21, 0xf3, 0x4f, 0x17, 0xb4, 0xae, 0x7e, 0xec, 0xa7, 0xfa, 0x57, 0x4e, 0xff,
+	0x46, 0xa9, 0xb9, 0x11, 0x36, 0x07, 0xba, 0xe9, 0x7f, 0x73, 0xa0, 0x5f, 0x2a, 0xda, 0x20, 0xf7,
+	0xdc, 0xb8, 0xbf, 0x5e, 0x66, 0x6a, 0x84, 0xea, 0x63, 0xae, 0xa0, 0xb3, 0x50, 0x29, 0xdf, 0x55,
+	0xf7, 0x4f, 0xab, 0x01, 0x8d, 0x0b, 0xe8, 0x53, 0x58, 0xcc, 0x3d, 0x8a, 0x46, 0xdf, 0x50, 0xdf,
+	0x55, 0xa9, 0xdf, 0x4e, 0xcf, 0xc2, 0xf0, 0x71, 0xd6, 0xbc, 0x8a, 0xa9, 0xcf, 0xfd, 0xc7, 0xa1,
+	0x3c, 0xf5, 0x89, 0xed, 0x4f, 0xa3, 0xfe, 0xa5, 0x31, 0x4c, 0x99, 0xd9, 0x64, 0x1b, 0xe3, 0xef,
+	0xaa, 0x50, 0x14, 0xbe, 0xcc, 0xee, 0x6f, 0x94, 0x9d, 0x9e, 0xd4, 0xae, 0xf4, 0xe3, 0x5f, 0x35,
+	0xd3, 0x94, 0x0f, 0x96, 0xfb, 0xeb, 0x65, 0xa6, 0x46, 0xa8, 0x1e, 0xa5, 0xdc, 0x2b, 0x7a, 0xbb,
+	0x48, 0x38, 0xe9, 0xeb, 0xb2, 0x59, 0x7c, 0xfb, 0x6d, 0x40, 0xdc, 0x76, 0xbc, 0x91, 0x73, 0x38,
+	0x0d, 0x2c, 0xae, 0x58, 0x45, 0xee, 0x26, 0x3f, 0x55, 0xa2, 0xf9, 0xe6, 0x4b, 0xac, 0x88, 0x8e,
+	0x34, 0x00, 0xd8, 0xc1, 0xe1, 0x03, 0x1c, 0x06, 0xce, 0x90, 0x64, 0x4f, 0x14, 0x7b, 0x54, 0x31,
+	0x41, 0xa2, 0x7a, 0x67, 0xe6, 0xbc, 0x08, 0xc1, 0x01, 0xb4, 0x76, 0x70, 0x28, 0xb2, 0x4a, 0x82,
+	0x0a, 0x57, 0xca, 0x19, 0x12, 0xc5, 0xda, 0xec, 0x89, 0x49, 0x77, 0x96, 0x79, 0x08, 0x8d, 0x0a,
+	0x05, 0x9b, 0x7f, 0x9e, 0xdd, 0xbf, 0x51, 0x6a, 0x6e, 0xf2, 0x44, 0x5b, 0x47, 0x78, 0xf8, 0xf4,
+	0x03, 0x6c, 0xb9, 0xe1, 0x51, 0xc1, 0x89, 0x12, 0x33, 0x4e, 0x3f, 0x51, 0x6a, 0x62, 0x84, 0xe3,
+	0x53, 0x58, 0xe1, 0x89, 0x56, 0x36, 0xbd, 0x41, 0xea, 0x4e, 0x67, 0x3e, 0x29, 0x9b, 0xa1, 0x75,
+	0x9b, 0xbf, 0xb7, 0x00, 0x4d, 0x16, 0x61, 0x69, 0x3a, 0xf0, 0x8b, 0x00, 0xfb, 0x8a, 0x03, 0xec,
+	0x27, 0xb0, 0x90, 0x79, 0x19, 0xac, 0xd6, 0x48, 0xf5, 0xf3, 0xe1, 0x12, 0x71, 0x22, 0xfd, 0x36,
+	0x57, 0xed, 0xf2, 0x94, 0xef, 0x77, 0x67, 0xed, 0xfd, 0x84, 0x3f, 0xaa, 0x8f, 0xfa, 0xd2, 0xef,
+	0x14, 0x56, 0xb6, 0xe9, 0xf7, 0x0c, 0x5f, 0x7c, 0xfc, 0x79, 0xfd, 0xf1, 0xf9, 0x13, 0x58, 0xc8,
+	0xbc, 0x2a, 0x53, 0x4b, 0x55, 0xfd, 0xf4, 0x6c, 0xd6, 0xee, 0x9f, 0x63, 0x20, 0xb3, 0x61, 0x49,
+	0xf1, 0xe0, 0x07, 0x6d, 0x14, 0x15, 0x82, 0xea, 0x97, 0x41, 0xb3, 0x0f, 0xd4, 0x49, 0x99, 0x12,
+	0x5a, 0x2b, 0x22, 0x32, 0xfb, 0xdf, 0xc6, 0xfe, 0x37, 0xca, 0xfd, 0x11, 0x32, 0x3a, 0xd0, 0x3e,
+	0xcc, 0xf1, 0xb7, 0x66, 0xe8, 0x4d, 0xe5, 0x19, 0x92, 0xef, 0xd0, 0xfa, 0xb3, 0x5e, 0xab, 0x91,
+	0xa9, 0x1b, 0x12, 0xb6, 0x69, 0x9d, 0x79, 0x48, 0xa4, 0x7c, 0x24, 0x99, 0x7c, 0x20, 0xd6, 0x9f,
+	0xfd, 0x26, 0x4c, 0x6e, 0xfa, 0xff, 0x3b, 0xda, 0xbf, 0x80, 0x25, 0xc5, 0xad, 0x0b, 0x2a, 0xca,
+	0xea, 0x0a, 0xee, 0x7b, 0xfa, 0x37, 0x4b, 0xcf, 0x8f, 0x30, 0xff, 0x08, 0xf4, 0x6c, 0x83, 0x05,
+	0xdd, 0x28, 0xd2, 0x67, 0x15, 0xce, 0xd9, 0x7e, 0x31, 0x19, 0x65, 0x5f, 0x55, 0x1c, 0xbe, 0xfb,
+	0xad, 0x8f, 0x37, 0x0f, 0x9d, 0xf0, 0x68, 0x7a, 0x40, 0x47, 0x6e, 0xf2, 0xa9, 0xef, 0x3a, 0xbe,
+	0xf8, 0x75, 0x53, 0xca, 0xf5, 0x26, 0x5b, 0x7d, 0x93, 0xa1, 0x99, 0x1c, 0x1c, 0xcc, 0xb1, 0xcf,
+	0x5b, 0xff, 0x37, 0x00, 0x08, 0x54, 0xd1, 0x17, 0xae, 0x41, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4002,6 +4073,7 @@ type QueryCoordClient interface {
 	GetReplicas(ctx context.Context, in *milvuspb.GetReplicasRequest, opts ...grpc.CallOption) (*milvuspb.GetReplicasResponse, error)
 	GetShardLeaders(ctx context.Context, in *GetShardLeadersRequest, opts ...grpc.CallOption) (*GetShardLeadersResponse, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	UpdateCollectionSchema(ctx context.Context, in *UpdateSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryCoordClient struct {
@@ -4165,6 +4237,15 @@ func (c *queryCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHe
 	return out, nil
 }
 
+func (c *queryCoordClient) UpdateCollectionSchema(ctx context.Context, in *UpdateSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryCoord/UpdateCollectionSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryCoordServer is the server API for QueryCoord service.
 type QueryCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -4186,6 +4267,7 @@ type QueryCoordServer interface {
 	GetReplicas(context.Context, *milvuspb.GetReplicasRequest) (*milvuspb.GetReplicasResponse, error)
 	GetShardLeaders(context.Context, *GetShardLeadersRequest) (*GetShardLeadersResponse, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	UpdateCollectionSchema(context.Context, *UpdateSchemaRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryCoordServer can be embedded to have forward compatible implementations.
@@ -4243,6 +4325,9 @@ func (*UnimplementedQueryCoordServer) GetShardLeaders(ctx context.Context, req *
 func (*UnimplementedQueryCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
 }
+func (*UnimplementedQueryCoordServer) UpdateCollectionSchema(ctx context.Context, req *UpdateSchemaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectionSchema not implemented")
+}
 
 func RegisterQueryCoordServer(s *grpc.Server, srv QueryCoordServer) {
 	s.RegisterService(&_QueryCoord_serviceDesc, srv)
@@ -4554,6 +4639,24 @@ func _QueryCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryCoord_UpdateCollectionSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryCoordServer).UpdateCollectionSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryCoord/UpdateCollectionSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryCoordServer).UpdateCollectionSchema(ctx, req.(*UpdateSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryCoord",
 	HandlerType: (*QueryCoordServer)(nil),
@@ -4626,6 +4729,10 @@ var _QueryCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckHealth",
 			Handler:    _QueryCoord_CheckHealth_Handler,
 		},
+		{
+			MethodName: "UpdateCollectionSchema",
+			Handler:    _QueryCoord_UpdateCollectionSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
@@ -4654,6 +4761,7 @@ type QueryNodeClient interface {
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 	GetDataDistribution(ctx context.Context, in *GetDataDistributionRequest, opts ...grpc.CallOption) (*GetDataDistributionResponse, error)
 	SyncDistribution(ctx context.Context, in *SyncDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateSchema(ctx context.Context, in *UpdateSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type queryNodeClient struct {
@@ -4826,6 +4934,15 @@ func (c *queryNodeClient) SyncDistribution(ctx context.Context, in *SyncDistribu
 	return out, nil
 }
 
+func (c *queryNodeClient) UpdateSchema(ctx context.Context, in *UpdateSchemaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.query.QueryNode/UpdateSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryNodeServer is the server API for QueryNode service.
 type QueryNodeServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -4847,6 +4964,7 @@ type QueryNodeServer interface {
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	GetDataDistribution(context.Context, *GetDataDistributionRequest) (*GetDataDistributionResponse, error)
 	SyncDistribution(context.Context, *SyncDistributionRequest) (*commonpb.Status, error)
+	UpdateSchema(context.Context, *UpdateSchemaRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedQueryNodeServer can be embedded to have forward compatible implementations.
@@ -4907,6 +5025,9 @@ func (*UnimplementedQueryNodeServer) GetDataDistribution(ctx context.Context, re
 func (*UnimplementedQueryNodeServer) SyncDistribution(ctx context.Context, req *SyncDistributionRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncDistribution not implemented")
 }
+func (*UnimplementedQueryNodeServer) UpdateSchema(ctx context.Context, req *UpdateSchemaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchema not implemented")
+}
 
 func RegisterQueryNodeServer(s *grpc.Server, srv QueryNodeServer) {
 	s.RegisterService(&_QueryNode_serviceDesc, srv)
@@ -5236,6 +5357,24 @@ func _QueryNode_SyncDistribution_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QueryNode_UpdateSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryNodeServer).UpdateSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.query.QueryNode/UpdateSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryNodeServer).UpdateSchema(ctx, req.(*UpdateSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _QueryNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.query.QueryNode",
 	HandlerType: (*QueryNodeServer)(nil),
@@ -5312,6 +5451,10 @@ var _QueryNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SyncDistribution",
 			Handler:    _QueryNode_SyncDistribution_Handler,
 		},
+		{
+			MethodName: "UpdateSchema",
+			Handler:    _QueryNode_UpdateSchema_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "query_coord.proto",
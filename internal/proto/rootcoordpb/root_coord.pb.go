@@ -674,103 +674,104 @@ func init() {
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 1535 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x6b, 0x93, 0xda, 0x36,
-	0x17, 0x0e, 0x90, 0xbd, 0x70, 0x60, 0x61, 0xa3, 0xc9, 0x85, 0x97, 0xe4, 0x7d, 0x43, 0x48, 0xde,
-	0x86, 0xdc, 0xd8, 0x74, 0x33, 0x93, 0xa6, 0xf9, 0x96, 0x85, 0xcc, 0x86, 0x69, 0x77, 0xb2, 0x35,
-	0x49, 0x27, 0xbd, 0xec, 0x50, 0x61, 0x2b, 0xe0, 0x59, 0x63, 0x11, 0x49, 0xec, 0x65, 0xfa, 0xa9,
-	0x33, 0xfd, 0xde, 0xff, 0xd4, 0xfe, 0x94, 0xfe, 0x90, 0x76, 0x64, 0xd9, 0xc2, 0x36, 0x36, 0xeb,
-	0x4d, 0xf2, 0x0d, 0x49, 0x8f, 0x9f, 0xe7, 0xe8, 0x1c, 0x9d, 0x73, 0x24, 0x60, 0x93, 0x51, 0x2a,
-	0x06, 0x26, 0xa5, 0xcc, 0x6a, 0x4f, 0x19, 0x15, 0x14, 0x5d, 0x9d, 0xd8, 0xce, 0xd1, 0x8c, 0xab,
-	0x51, 0x5b, 0x2e, 0x7b, 0xab, 0xf5, 0xb2, 0x49, 0x27, 0x13, 0xea, 0xaa, 0xf9, 0x7a, 0x39, 0x8c,
-	0xaa, 0x57, 0x6c, 0x57, 0x10, 0xe6, 0x62, 0xc7, 0x1f, 0x97, 0xa6, 0x8c, 0x9e, 0x9c, 0xfa, 0x83,
-	0x2a, 0x11, 0xa6, 0x35, 0x98, 0x10, 0x81, 0xd5, 0x44, 0x73, 0x00, 0x57, 0x5e, 0x38, 0x0e, 0x35,
-	0xdf, 0xd8, 0x13, 0xc2, 0x05, 0x9e, 0x4c, 0x0d, 0xf2, 0x61, 0x46, 0xb8, 0x40, 0x8f, 0xe1, 0xe2,
-	0x10, 0x73, 0x52, 0xcb, 0x35, 0x72, 0xad, 0xd2, 0xf6, 0x8d, 0x76, 0xc4, 0x12, 0x5f, 0x7e, 0x8f,
-	0x8f, 0x76, 0x30, 0x27, 0x86, 0x87, 0x44, 0x97, 0x61, 0xc5, 0xa4, 0x33, 0x57, 0xd4, 0x0a, 0x8d,
-	0x5c, 0x6b, 0xc3, 0x50, 0x83, 0xe6, 0x6f, 0x39, 0xb8, 0x1a, 0x57, 0xe0, 0x53, 0xea, 0x72, 0x82,
-	0x9e, 0xc0, 0x2a, 0x17, 0x58, 0xcc, 0xb8, 0x2f, 0x72, 0x3d, 0x51, 0xa4, 0xef, 0x41, 0x0c, 0x1f,
-	0x8a, 0x6e, 0x40, 0x51, 0x04, 0x4c, 0xb5, 0x7c, 0x23, 0xd7, 0xba, 0x68, 0xcc, 0x27, 0x52, 0x6c,
-	0x78, 0x07, 0x15, 0xcf, 0x84, 0x5e, 0xf7, 0x33, 0xec, 0x2e, 0x1f, 0x66, 0x76, 0xa0, 0xaa, 0x99,
-	0x3f, 0x65, 0x57, 0x15, 0xc8, 0xf7, 0xba, 0x1e, 0x75, 0xc1, 0xc8, 0xf7, 0xba, 0x29, 0xfb, 0xf8,
-	0x33, 0x0f, 0xe5, 0xde, 0x64, 0x4a, 0x99, 0x30, 0x08, 0x9f, 0x39, 0xe2, 0xe3, 0xb4, 0xae, 0xc1,
-	0x9a, 0xc0, 0xfc, 0x70, 0x60, 0x5b, 0xbe, 0xe0, 0xaa, 0x1c, 0xf6, 0x2c, 0x74, 0x13, 0x4a, 0x16,
-	0x16, 0xd8, 0xa5, 0x16, 0x91, 0x8b, 0x05, 0x6f, 0x11, 0x82, 0xa9, 0x9e, 0x85, 0x9e, 0xc2, 0x8a,
-	0xe4, 0x20, 0xb5, 0x8b, 0x8d, 0x5c, 0xab, 0xb2, 0xdd, 0x48, 0x54, 0x53, 0x06, 0x4a, 0x4d, 0x62,
-	0x28, 0x38, 0xaa, 0xc3, 0x3a, 0x27, 0xa3, 0x09, 0x71, 0x05, 0xaf, 0xad, 0x34, 0x0a, 0xad, 0x82,
-	0xa1, 0xc7, 0xe8, 0x3f, 0xb0, 0x8e, 0x67, 0x82, 0x0e, 0x6c, 0x8b, 0xd7, 0x56, 0xbd, 0xb5, 0x35,
-	0x39, 0xee, 0x59, 0x1c, 0x5d, 0x87, 0x22, 0xa3, 0xc7, 0x03, 0xe5, 0x88, 0x35, 0xcf, 0x9a, 0x75,
-	0x46, 0x8f, 0x3b, 0x72, 0x8c, 0xbe, 0x82, 0x15, 0xdb, 0x7d, 0x4f, 0x79, 0x6d, 0xbd, 0x51, 0x68,
-	0x95, 0xb6, 0x6f, 0x25, 0xda, 0xf2, 0x0d, 0x39, 0xfd, 0x1e, 0x3b, 0x33, 0xb2, 0x8f, 0x6d, 0x66,
-	0x28, 0x7c, 0xf3, 0x8f, 0x1c, 0x5c, 0xeb, 0x12, 0x6e, 0x32, 0x7b, 0x48, 0xfa, 0xbe, 0x15, 0x1f,
-	0x7f, 0x2c, 0x9a, 0x50, 0x36, 0xa9, 0xe3, 0x10, 0x53, 0xd8, 0xd4, 0xd5, 0x21, 0x8c, 0xcc, 0xa1,
-	0xff, 0x01, 0xf8, 0xdb, 0xed, 0x75, 0x79, 0xad, 0xe0, 0x6d, 0x32, 0x34, 0xd3, 0x9c, 0x41, 0xd5,
-	0x37, 0x44, 0x12, 0xf7, 0xdc, 0xf7, 0x74, 0x81, 0x36, 0x97, 0x40, 0xdb, 0x80, 0xd2, 0x14, 0x33,
-	0x61, 0x47, 0x94, 0xc3, 0x53, 0x32, 0x57, 0xb4, 0x8c, 0x1f, 0xce, 0xf9, 0x44, 0xf3, 0xef, 0x3c,
-	0x94, 0x7d, 0x5d, 0xa9, 0xc9, 0x51, 0x17, 0x8a, 0x72, 0x4f, 0x03, 0xe9, 0x27, 0xdf, 0x05, 0x77,
-	0xdb, 0xc9, 0x15, 0xa8, 0x1d, 0x33, 0xd8, 0x58, 0x1f, 0x06, 0xa6, 0x77, 0xa1, 0x64, 0xbb, 0x16,
-	0x39, 0x19, 0xa8, 0xf0, 0xe4, 0xbd, 0xf0, 0xdc, 0x8e, 0xf2, 0xc8, 0x2a, 0xd4, 0xd6, 0xda, 0x16,
-	0x39, 0xf1, 0x38, 0xc0, 0x0e, 0x7e, 0x72, 0x44, 0xe0, 0x12, 0x39, 0x11, 0x0c, 0x0f, 0xc2, 0x5c,
-	0x05, 0x8f, 0xeb, 0xeb, 0x33, 0x6c, 0xf2, 0x08, 0xda, 0x2f, 0xe5, 0xd7, 0x9a, 0x9b, 0xbf, 0x74,
-	0x05, 0x3b, 0x35, 0xaa, 0x24, 0x3a, 0x5b, 0xff, 0x05, 0x2e, 0x27, 0x01, 0xd1, 0x26, 0x14, 0x0e,
-	0xc9, 0xa9, 0xef, 0x76, 0xf9, 0x13, 0x6d, 0xc3, 0xca, 0x91, 0x3c, 0x4a, 0x9e, 0x9f, 0x17, 0xce,
-	0x86, 0xb7, 0xa1, 0xf9, 0x4e, 0x14, 0xf4, 0x79, 0xfe, 0x59, 0xae, 0xf9, 0x57, 0x1e, 0x6a, 0x8b,
-	0xc7, 0xed, 0x53, 0x6a, 0x45, 0x96, 0x23, 0x37, 0x82, 0x0d, 0x3f, 0xd0, 0x11, 0xd7, 0xed, 0xa4,
-	0xb9, 0x2e, 0xcd, 0xc2, 0x88, 0x4f, 0x95, 0x0f, 0xcb, 0x3c, 0x34, 0x55, 0x27, 0x70, 0x69, 0x01,
-	0x92, 0xe0, 0xbd, 0xe7, 0x51, 0xef, 0xdd, 0xc9, 0x12, 0xc2, 0xb0, 0x17, 0x2d, 0xb8, 0xbc, 0x4b,
-	0x44, 0x87, 0x11, 0x8b, 0xb8, 0xc2, 0xc6, 0xce, 0xc7, 0x27, 0x6c, 0x1d, 0xd6, 0x67, 0x5c, 0xf6,
-	0xc7, 0x89, 0x32, 0xa6, 0x68, 0xe8, 0x71, 0xf3, 0xf7, 0x1c, 0x5c, 0x89, 0xc9, 0x7c, 0x4a, 0xa0,
-	0x96, 0x48, 0xc9, 0xb5, 0x29, 0xe6, 0xfc, 0x98, 0x32, 0x55, 0x68, 0x8b, 0x86, 0x1e, 0x6f, 0xff,
-	0x73, 0x13, 0x8a, 0x06, 0xa5, 0xa2, 0x23, 0x5d, 0x82, 0x1c, 0x40, 0xd2, 0x26, 0x3a, 0x99, 0x52,
-	0x97, 0xb8, 0xaa, 0xb0, 0x72, 0xd4, 0x8e, 0x1a, 0xe0, 0x0f, 0x16, 0x81, 0xbe, 0xa3, 0xea, 0x77,
-	0x12, 0xf1, 0x31, 0x70, 0xf3, 0x02, 0x9a, 0x78, 0x6a, 0xb2, 0x57, 0xbf, 0xb1, 0xcd, 0xc3, 0xce,
-	0x18, 0xbb, 0x2e, 0x71, 0xd0, 0xe3, 0xe8, 0xd7, 0xfa, 0x86, 0xb1, 0x08, 0x0d, 0xf4, 0x6e, 0x27,
-	0xea, 0xf5, 0x05, 0xb3, 0xdd, 0x51, 0xe0, 0xd5, 0xe6, 0x05, 0xf4, 0xc1, 0x8b, 0xab, 0x54, 0xb7,
-	0xb9, 0xb0, 0x4d, 0x1e, 0x08, 0x6e, 0xa7, 0x0b, 0x2e, 0x80, 0xcf, 0x29, 0x39, 0x80, 0xcd, 0x0e,
-	0x23, 0x58, 0x90, 0x8e, 0x4e, 0x18, 0xf4, 0x30, 0xd9, 0x3b, 0x31, 0x58, 0x20, 0xb4, 0x2c, 0xf8,
-	0xcd, 0x0b, 0xe8, 0x27, 0xa8, 0x74, 0x19, 0x9d, 0x86, 0xe8, 0xef, 0x27, 0xd2, 0x47, 0x41, 0x19,
-	0xc9, 0x07, 0xb0, 0xf1, 0x0a, 0xf3, 0x10, 0xf7, 0xbd, 0x44, 0xee, 0x08, 0x26, 0xa0, 0xbe, 0x95,
-	0x08, 0xdd, 0xa1, 0xd4, 0x09, 0xb9, 0xe7, 0x18, 0x50, 0x50, 0x0c, 0x42, 0x2a, 0xc9, 0xc7, 0x6d,
-	0x11, 0x18, 0x48, 0x6d, 0x65, 0xc6, 0x6b, 0xe1, 0xb7, 0x50, 0x52, 0x0e, 0x7f, 0xe1, 0xd8, 0x98,
-	0xa3, 0xbb, 0x4b, 0x42, 0xe2, 0x21, 0x32, 0x3a, 0xec, 0x3b, 0x28, 0x4a, 0x47, 0x2b, 0xd2, 0xff,
-	0xa7, 0x06, 0xe2, 0x3c, 0x94, 0x7d, 0x80, 0x17, 0x8e, 0x20, 0x4c, 0x71, 0x7e, 0x91, 0xc8, 0x39,
-	0x07, 0x64, 0x24, 0x75, 0xa1, 0xda, 0x1f, 0xcb, 0xcb, 0x4d, 0xe0, 0x1a, 0x8e, 0x1e, 0x24, 0x1f,
-	0xe8, 0x28, 0x2a, 0xa0, 0x7f, 0x98, 0x0d, 0xac, 0xdd, 0x7d, 0x20, 0x6f, 0xae, 0x82, 0xb0, 0x50,
-	0x90, 0x1f, 0xa4, 0xef, 0xe4, 0xdc, 0xe7, 0xf4, 0x00, 0xaa, 0x2a, 0x56, 0xfb, 0xc1, 0x7d, 0x24,
-	0x85, 0x3e, 0x86, 0xca, 0x48, 0xff, 0x03, 0x6c, 0xc8, 0xa8, 0xcd, 0xc9, 0xef, 0xa5, 0x46, 0xf6,
-	0xbc, 0xd4, 0x07, 0x50, 0x7e, 0x85, 0xf9, 0x9c, 0xb9, 0x95, 0x96, 0x60, 0x0b, 0xc4, 0x99, 0xf2,
-	0xeb, 0x10, 0x2a, 0x32, 0x28, 0xfa, 0x63, 0x9e, 0x52, 0x1d, 0xa2, 0xa0, 0x40, 0xe2, 0x41, 0x26,
-	0xac, 0x16, 0x23, 0x50, 0x96, 0x6b, 0x41, 0x57, 0x4f, 0xd9, 0x4b, 0x18, 0x12, 0x08, 0xdd, 0xcb,
-	0x80, 0x0c, 0x55, 0xf1, 0x4a, 0xf4, 0x89, 0x87, 0x1e, 0xa5, 0x35, 0xf8, 0xc4, 0xc7, 0x66, 0xbd,
-	0x9d, 0x15, 0xae, 0x25, 0x7f, 0x86, 0x35, 0xff, 0xe1, 0x15, 0x4f, 0xc0, 0xd8, 0xc7, 0xfa, 0xcd,
-	0x57, 0xbf, 0x7b, 0x26, 0x4e, 0xb3, 0x63, 0xb8, 0xf2, 0x76, 0x6a, 0xc9, 0xe2, 0xaf, 0x5a, 0x4c,
-	0xd0, 0xe4, 0xe2, 0xc7, 0x4c, 0xf7, 0xa5, 0x18, 0x6e, 0x8f, 0x8f, 0xce, 0x3a, 0x66, 0x0c, 0xfe,
-	0xdb, 0x73, 0x8f, 0xb0, 0x63, 0x5b, 0x91, 0x1e, 0xb3, 0x47, 0x04, 0xee, 0x60, 0x73, 0x4c, 0xe2,
-	0x2d, 0x50, 0xbd, 0xe2, 0xa3, 0x9f, 0x68, 0x70, 0xc6, 0xa3, 0xfd, 0x2b, 0x20, 0x55, 0x10, 0xdc,
-	0xf7, 0xf6, 0x68, 0xc6, 0xb0, 0x3a, 0x7f, 0x69, 0xcd, 0x7d, 0x11, 0x1a, 0xc8, 0x7c, 0x79, 0x8e,
-	0x2f, 0x42, 0x7d, 0x17, 0x76, 0x89, 0xd8, 0x23, 0x82, 0xd9, 0x66, 0x5a, 0xd5, 0x9c, 0x03, 0x52,
-	0x82, 0x96, 0x80, 0xd3, 0x02, 0x7d, 0x58, 0x55, 0x6f, 0x4f, 0xd4, 0x4c, 0xfc, 0x28, 0x78, 0x39,
-	0x2f, 0xbb, 0x2d, 0xe8, 0xd7, 0x75, 0x28, 0x5d, 0x77, 0x89, 0x08, 0xbd, 0x69, 0x53, 0xd2, 0x35,
-	0x0a, 0x5a, 0x9e, 0xae, 0x71, 0xac, 0x16, 0x73, 0xa1, 0xfa, 0xad, 0xcd, 0xfd, 0xc5, 0x37, 0x98,
-	0x1f, 0xa6, 0xf5, 0x80, 0x18, 0x6a, 0x79, 0x0f, 0x58, 0x00, 0x87, 0x3c, 0x56, 0x36, 0x88, 0x5c,
-	0xf0, 0xfd, 0x96, 0x7a, 0x2d, 0x0f, 0xff, 0xe9, 0x70, 0xd6, 0x21, 0x7b, 0xa7, 0xef, 0x57, 0xfa,
-	0x1a, 0x1d, 0xef, 0xbb, 0xf3, 0xb4, 0xd1, 0x10, 0x79, 0xe3, 0xcf, 0xc0, 0xec, 0x67, 0xe5, 0xe7,
-	0x66, 0x1e, 0xc0, 0x66, 0x97, 0x38, 0x24, 0xc2, 0xfc, 0x30, 0xe5, 0x0a, 0x13, 0x85, 0x65, 0xcc,
-	0xbc, 0x31, 0x6c, 0xc8, 0x30, 0xc8, 0xef, 0xde, 0x72, 0xc2, 0x78, 0x4a, 0xbf, 0x8a, 0x60, 0x02,
-	0xea, 0xfb, 0x59, 0xa0, 0xa1, 0x33, 0xb4, 0x11, 0x79, 0xc2, 0xc4, 0xf7, 0x31, 0x0f, 0x6a, 0xd2,
-	0x83, 0xaa, 0xfe, 0x28, 0x23, 0x3a, 0x74, 0x86, 0x40, 0x85, 0xdb, 0xa0, 0x0e, 0x49, 0x49, 0xeb,
-	0x39, 0x20, 0xa3, 0xbb, 0x5e, 0xc3, 0xba, 0x6c, 0xdd, 0x1e, 0xe5, 0x9d, 0xd4, 0xce, 0x7e, 0x0e,
-	0xc2, 0x03, 0xa8, 0xbe, 0x9e, 0x12, 0x86, 0x05, 0x91, 0xfe, 0xf2, 0x78, 0x93, 0x33, 0x2b, 0x86,
-	0xca, 0x7c, 0x2b, 0x87, 0x3e, 0x91, 0x15, 0x7c, 0x89, 0x13, 0xe6, 0x80, 0xe5, 0xb5, 0x2d, 0x8c,
-	0x0b, 0x17, 0x4f, 0x35, 0x2f, 0x0d, 0x5b, 0x2a, 0xe0, 0x59, 0x9e, 0x41, 0x40, 0xe1, 0xc2, 0xaf,
-	0x22, 0x7f, 0xeb, 0xfb, 0xcc, 0x3e, 0xb2, 0x1d, 0x32, 0x22, 0x29, 0x19, 0x10, 0x87, 0x65, 0x74,
-	0xd1, 0x10, 0x4a, 0x4a, 0x78, 0x97, 0x61, 0x57, 0xa0, 0x65, 0xa6, 0x79, 0x88, 0x80, 0xb6, 0x75,
-	0x36, 0x50, 0x6f, 0xc2, 0x04, 0x90, 0x69, 0xb1, 0x4f, 0x1d, 0xdb, 0x3c, 0x8d, 0x5f, 0x76, 0x74,
-	0x69, 0x98, 0x43, 0x52, 0x2e, 0x3b, 0x89, 0x48, 0x2d, 0x32, 0x84, 0x52, 0x67, 0x4c, 0xcc, 0xc3,
-	0x57, 0x04, 0x3b, 0x62, 0x9c, 0xf6, 0x4e, 0x99, 0x23, 0x96, 0x6f, 0x24, 0x02, 0x0c, 0x34, 0x76,
-	0x9e, 0xfd, 0xf8, 0x74, 0x64, 0x8b, 0xf1, 0x6c, 0x28, 0xdd, 0xb8, 0xa5, 0xa0, 0x8f, 0x6c, 0xea,
-	0xff, 0xda, 0x0a, 0x0c, 0xdc, 0xf2, 0xa8, 0xb6, 0x74, 0x92, 0x4e, 0x87, 0xc3, 0x55, 0x6f, 0xea,
-	0xc9, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0x82, 0xd4, 0x6e, 0x4c, 0x18, 0x00, 0x00,
+	// 1541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x73, 0x13, 0x37,
+	0x14, 0xc6, 0x36, 0xb9, 0x1d, 0x3b, 0x71, 0xd0, 0x70, 0x71, 0x0d, 0x6d, 0x8d, 0xb9, 0x39, 0x90,
+	0x38, 0x34, 0xcc, 0x50, 0xca, 0x5b, 0x62, 0xd3, 0xe0, 0x69, 0x33, 0xa4, 0x1b, 0xe8, 0xd0, 0x4b,
+	0xc6, 0x95, 0x77, 0x85, 0xad, 0xc9, 0x7a, 0x65, 0x56, 0x72, 0x2e, 0xd3, 0xa7, 0xce, 0xf4, 0xbd,
+	0xff, 0xa9, 0xfd, 0x29, 0xfc, 0x91, 0x8e, 0x56, 0x7b, 0xb5, 0x57, 0xce, 0x06, 0x78, 0xb3, 0xa4,
+	0x6f, 0xbf, 0xef, 0xe8, 0x9c, 0xa3, 0x73, 0x24, 0xc3, 0xaa, 0xcb, 0x98, 0xe8, 0x9a, 0x8c, 0xb9,
+	0x56, 0x73, 0xe4, 0x32, 0xc1, 0xd0, 0xf5, 0x21, 0xb5, 0x8f, 0xc7, 0x5c, 0x8d, 0x9a, 0x72, 0xd9,
+	0x5b, 0xad, 0x96, 0x4c, 0x36, 0x1c, 0x32, 0x47, 0xcd, 0x57, 0x4b, 0x71, 0x54, 0x75, 0x85, 0x3a,
+	0x82, 0xb8, 0x0e, 0xb6, 0xfd, 0x71, 0x71, 0xe4, 0xb2, 0xd3, 0x33, 0x7f, 0x50, 0x26, 0xc2, 0xb4,
+	0xba, 0x43, 0x22, 0xb0, 0x9a, 0xa8, 0x77, 0xe1, 0xda, 0xb6, 0x6d, 0x33, 0xf3, 0x35, 0x1d, 0x12,
+	0x2e, 0xf0, 0x70, 0x64, 0x90, 0xf7, 0x63, 0xc2, 0x05, 0x7a, 0x0c, 0x97, 0x7b, 0x98, 0x93, 0x4a,
+	0xae, 0x96, 0x6b, 0x14, 0xb7, 0x6e, 0x35, 0x13, 0x96, 0xf8, 0xf2, 0x7b, 0xbc, 0xbf, 0x83, 0x39,
+	0x31, 0x3c, 0x24, 0xba, 0x0a, 0x73, 0x26, 0x1b, 0x3b, 0xa2, 0x52, 0xa8, 0xe5, 0x1a, 0xcb, 0x86,
+	0x1a, 0xd4, 0xff, 0xca, 0xc1, 0xf5, 0x49, 0x05, 0x3e, 0x62, 0x0e, 0x27, 0xe8, 0x09, 0xcc, 0x73,
+	0x81, 0xc5, 0x98, 0xfb, 0x22, 0x37, 0x53, 0x45, 0x0e, 0x3c, 0x88, 0xe1, 0x43, 0xd1, 0x2d, 0x58,
+	0x12, 0x01, 0x53, 0x25, 0x5f, 0xcb, 0x35, 0x2e, 0x1b, 0xd1, 0x84, 0xc6, 0x86, 0xb7, 0xb0, 0xe2,
+	0x99, 0xd0, 0x69, 0x7f, 0x86, 0xdd, 0xe5, 0xe3, 0xcc, 0x36, 0x94, 0x43, 0xe6, 0x4f, 0xd9, 0xd5,
+	0x0a, 0xe4, 0x3b, 0x6d, 0x8f, 0xba, 0x60, 0xe4, 0x3b, 0x6d, 0xcd, 0x3e, 0xfe, 0xcd, 0x43, 0xa9,
+	0x33, 0x1c, 0x31, 0x57, 0x18, 0x84, 0x8f, 0x6d, 0xf1, 0x71, 0x5a, 0x37, 0x60, 0x41, 0x60, 0x7e,
+	0xd4, 0xa5, 0x96, 0x2f, 0x38, 0x2f, 0x87, 0x1d, 0x0b, 0x7d, 0x0d, 0x45, 0x0b, 0x0b, 0xec, 0x30,
+	0x8b, 0xc8, 0xc5, 0x82, 0xb7, 0x08, 0xc1, 0x54, 0xc7, 0x42, 0x4f, 0x61, 0x4e, 0x72, 0x90, 0xca,
+	0xe5, 0x5a, 0xae, 0xb1, 0xb2, 0x55, 0x4b, 0x55, 0x53, 0x06, 0x4a, 0x4d, 0x62, 0x28, 0x38, 0xaa,
+	0xc2, 0x22, 0x27, 0xfd, 0x21, 0x71, 0x04, 0xaf, 0xcc, 0xd5, 0x0a, 0x8d, 0x82, 0x11, 0x8e, 0xd1,
+	0x17, 0xb0, 0x88, 0xc7, 0x82, 0x75, 0xa9, 0xc5, 0x2b, 0xf3, 0xde, 0xda, 0x82, 0x1c, 0x77, 0x2c,
+	0x8e, 0x6e, 0xc2, 0x92, 0xcb, 0x4e, 0xba, 0xca, 0x11, 0x0b, 0x9e, 0x35, 0x8b, 0x2e, 0x3b, 0x69,
+	0xc9, 0x31, 0xfa, 0x16, 0xe6, 0xa8, 0xf3, 0x8e, 0xf1, 0xca, 0x62, 0xad, 0xd0, 0x28, 0x6e, 0xdd,
+	0x4e, 0xb5, 0xe5, 0x07, 0x72, 0xf6, 0x33, 0xb6, 0xc7, 0x64, 0x1f, 0x53, 0xd7, 0x50, 0xf8, 0xfa,
+	0x3f, 0x39, 0xb8, 0xd1, 0x26, 0xdc, 0x74, 0x69, 0x8f, 0x1c, 0xf8, 0x56, 0x7c, 0x7c, 0x5a, 0xd4,
+	0xa1, 0x64, 0x32, 0xdb, 0x26, 0xa6, 0xa0, 0xcc, 0x09, 0x43, 0x98, 0x98, 0x43, 0x5f, 0x01, 0xf8,
+	0xdb, 0xed, 0xb4, 0x79, 0xa5, 0xe0, 0x6d, 0x32, 0x36, 0x53, 0x1f, 0x43, 0xd9, 0x37, 0x44, 0x12,
+	0x77, 0x9c, 0x77, 0x6c, 0x8a, 0x36, 0x97, 0x42, 0x5b, 0x83, 0xe2, 0x08, 0xbb, 0x82, 0x26, 0x94,
+	0xe3, 0x53, 0xf2, 0xac, 0x84, 0x32, 0x7e, 0x38, 0xa3, 0x89, 0xfa, 0x87, 0x3c, 0x94, 0x7c, 0x5d,
+	0xa9, 0xc9, 0x51, 0x1b, 0x96, 0xe4, 0x9e, 0xba, 0xd2, 0x4f, 0xbe, 0x0b, 0x1e, 0x34, 0xd3, 0x2b,
+	0x50, 0x73, 0xc2, 0x60, 0x63, 0xb1, 0x17, 0x98, 0xde, 0x86, 0x22, 0x75, 0x2c, 0x72, 0xda, 0x55,
+	0xe1, 0xc9, 0x7b, 0xe1, 0xb9, 0x93, 0xe4, 0x91, 0x55, 0xa8, 0x19, 0x6a, 0x5b, 0xe4, 0xd4, 0xe3,
+	0x00, 0x1a, 0xfc, 0xe4, 0x88, 0xc0, 0x15, 0x72, 0x2a, 0x5c, 0xdc, 0x8d, 0x73, 0x15, 0x3c, 0xae,
+	0xef, 0xce, 0xb1, 0xc9, 0x23, 0x68, 0xbe, 0x90, 0x5f, 0x87, 0xdc, 0xfc, 0x85, 0x23, 0xdc, 0x33,
+	0xa3, 0x4c, 0x92, 0xb3, 0xd5, 0x3f, 0xe0, 0x6a, 0x1a, 0x10, 0xad, 0x42, 0xe1, 0x88, 0x9c, 0xf9,
+	0x6e, 0x97, 0x3f, 0xd1, 0x16, 0xcc, 0x1d, 0xcb, 0x54, 0xaa, 0xe4, 0xd3, 0x72, 0xc3, 0xdb, 0x50,
+	0xb4, 0x13, 0x05, 0x7d, 0x9e, 0x7f, 0x96, 0xab, 0xff, 0x97, 0x87, 0xca, 0x74, 0xba, 0x7d, 0x4a,
+	0xad, 0xc8, 0x92, 0x72, 0x7d, 0x58, 0xf6, 0x03, 0x9d, 0x70, 0xdd, 0x8e, 0xce, 0x75, 0x3a, 0x0b,
+	0x13, 0x3e, 0x55, 0x3e, 0x2c, 0xf1, 0xd8, 0x54, 0x95, 0xc0, 0x95, 0x29, 0x48, 0x8a, 0xf7, 0x9e,
+	0x27, 0xbd, 0x77, 0x37, 0x4b, 0x08, 0xe3, 0x5e, 0xb4, 0xe0, 0xea, 0x2e, 0x11, 0x2d, 0x97, 0x58,
+	0xc4, 0x11, 0x14, 0xdb, 0x1f, 0x7f, 0x60, 0xab, 0xb0, 0x38, 0xe6, 0xb2, 0x3f, 0x0e, 0x95, 0x31,
+	0x4b, 0x46, 0x38, 0xae, 0xff, 0x9d, 0x83, 0x6b, 0x13, 0x32, 0x9f, 0x12, 0xa8, 0x19, 0x52, 0x72,
+	0x6d, 0x84, 0x39, 0x3f, 0x61, 0xae, 0x2a, 0xb4, 0x4b, 0x46, 0x38, 0xde, 0xfa, 0x50, 0x83, 0x25,
+	0x83, 0x31, 0xd1, 0x92, 0x2e, 0x41, 0x36, 0x20, 0x69, 0x13, 0x1b, 0x8e, 0x98, 0x43, 0x1c, 0x55,
+	0x58, 0x39, 0x6a, 0x26, 0x0d, 0xf0, 0x07, 0xd3, 0x40, 0xdf, 0x51, 0xd5, 0xbb, 0xa9, 0xf8, 0x09,
+	0x70, 0xfd, 0x12, 0x1a, 0x7a, 0x6a, 0xb2, 0x57, 0xbf, 0xa6, 0xe6, 0x51, 0x6b, 0x80, 0x1d, 0x87,
+	0xd8, 0xe8, 0x71, 0xf2, 0xeb, 0xf0, 0x86, 0x31, 0x0d, 0x0d, 0xf4, 0xee, 0xa4, 0xea, 0x1d, 0x08,
+	0x97, 0x3a, 0xfd, 0xc0, 0xab, 0xf5, 0x4b, 0xe8, 0xbd, 0x17, 0x57, 0xa9, 0x4e, 0xb9, 0xa0, 0x26,
+	0x0f, 0x04, 0xb7, 0xf4, 0x82, 0x53, 0xe0, 0x0b, 0x4a, 0x76, 0x61, 0xb5, 0xe5, 0x12, 0x2c, 0x48,
+	0x2b, 0x3c, 0x30, 0x68, 0x3d, 0xdd, 0x3b, 0x13, 0xb0, 0x40, 0x68, 0x56, 0xf0, 0xeb, 0x97, 0xd0,
+	0x6f, 0xb0, 0xd2, 0x76, 0xd9, 0x28, 0x46, 0xff, 0x30, 0x95, 0x3e, 0x09, 0xca, 0x48, 0xde, 0x85,
+	0xe5, 0x97, 0x98, 0xc7, 0xb8, 0xd7, 0x52, 0xb9, 0x13, 0x98, 0x80, 0xfa, 0x76, 0x2a, 0x74, 0x87,
+	0x31, 0x3b, 0xe6, 0x9e, 0x13, 0x40, 0x41, 0x31, 0x88, 0xa9, 0xa4, 0xa7, 0xdb, 0x34, 0x30, 0x90,
+	0xda, 0xcc, 0x8c, 0x0f, 0x85, 0xdf, 0x40, 0x51, 0x39, 0x7c, 0xdb, 0xa6, 0x98, 0xa3, 0x07, 0x33,
+	0x42, 0xe2, 0x21, 0x32, 0x3a, 0xec, 0x27, 0x58, 0x92, 0x8e, 0x56, 0xa4, 0xf7, 0xb4, 0x81, 0xb8,
+	0x08, 0xe5, 0x01, 0xc0, 0xb6, 0x2d, 0x88, 0xab, 0x38, 0xef, 0xa7, 0x72, 0x46, 0x80, 0x8c, 0xa4,
+	0x0e, 0x94, 0x0f, 0x06, 0xec, 0x24, 0x72, 0x0d, 0x47, 0x8f, 0xd2, 0x13, 0x3a, 0x89, 0x0a, 0xe8,
+	0xd7, 0xb3, 0x81, 0x43, 0x77, 0x1f, 0xca, 0x9b, 0xab, 0x20, 0x6e, 0x2c, 0xc8, 0x8f, 0xf4, 0x3b,
+	0xb9, 0x70, 0x9e, 0x1e, 0x42, 0x59, 0xc5, 0x6a, 0x3f, 0xb8, 0x8f, 0x68, 0xe8, 0x27, 0x50, 0x19,
+	0xe9, 0x7f, 0x81, 0x65, 0x19, 0xb5, 0x88, 0x7c, 0x4d, 0x1b, 0xd9, 0x8b, 0x52, 0x1f, 0x42, 0xe9,
+	0x25, 0xe6, 0x11, 0x73, 0x43, 0x77, 0xc0, 0xa6, 0x88, 0x33, 0x9d, 0xaf, 0x23, 0x58, 0x91, 0x41,
+	0x09, 0x3f, 0xe6, 0x9a, 0xea, 0x90, 0x04, 0x05, 0x12, 0x8f, 0x32, 0x61, 0x43, 0x31, 0x02, 0x25,
+	0xb9, 0x16, 0x74, 0x75, 0xcd, 0x5e, 0xe2, 0x90, 0x40, 0x68, 0x2d, 0x03, 0x32, 0x56, 0xc5, 0x57,
+	0x92, 0x4f, 0x3c, 0xb4, 0xa1, 0x6b, 0xf0, 0xa9, 0x8f, 0xcd, 0x6a, 0x33, 0x2b, 0x3c, 0x94, 0xfc,
+	0x1d, 0x16, 0xfc, 0x87, 0x17, 0xba, 0x3f, 0xf3, 0xe3, 0xf0, 0xcd, 0x57, 0x7d, 0x70, 0x2e, 0x2e,
+	0x64, 0xc7, 0x70, 0xed, 0xcd, 0xc8, 0x92, 0xc5, 0x5f, 0xb5, 0x98, 0xa0, 0xc9, 0xa1, 0x35, 0x4d,
+	0x5f, 0x9a, 0xc0, 0xed, 0xf1, 0xfe, 0x79, 0x69, 0xe6, 0xc2, 0x97, 0x1d, 0xe7, 0x18, 0xdb, 0xd4,
+	0x4a, 0xf4, 0x98, 0x3d, 0x22, 0x70, 0x0b, 0x9b, 0x03, 0x32, 0xd9, 0x02, 0xd5, 0x2b, 0x3e, 0xf9,
+	0x49, 0x08, 0xce, 0x98, 0xda, 0x7f, 0x02, 0x52, 0x05, 0xc1, 0x79, 0x47, 0xfb, 0x63, 0x17, 0xab,
+	0xfc, 0xd3, 0x35, 0xf7, 0x69, 0x68, 0x20, 0xf3, 0xcd, 0x05, 0xbe, 0x88, 0xf5, 0x5d, 0xd8, 0x25,
+	0x62, 0x8f, 0x08, 0x97, 0x9a, 0xba, 0xaa, 0x19, 0x01, 0x34, 0x41, 0x4b, 0xc1, 0x85, 0x02, 0x07,
+	0x30, 0xaf, 0xde, 0x9e, 0xa8, 0x9e, 0xfa, 0x51, 0xf0, 0x72, 0x9e, 0x75, 0x5b, 0x08, 0x30, 0xf1,
+	0xe3, 0xba, 0x4b, 0x44, 0xec, 0x4d, 0xab, 0x39, 0xae, 0x49, 0xd0, 0xec, 0xe3, 0x3a, 0x89, 0x0d,
+	0xc5, 0x1c, 0x28, 0xff, 0x48, 0xb9, 0xbf, 0xf8, 0x1a, 0xf3, 0x23, 0x5d, 0x0f, 0x98, 0x40, 0xcd,
+	0xee, 0x01, 0x53, 0xe0, 0x98, 0xc7, 0x4a, 0x06, 0x91, 0x0b, 0xbe, 0xdf, 0xb4, 0xd7, 0xf2, 0xf8,
+	0x9f, 0x0e, 0xe7, 0x25, 0xd9, 0xdb, 0xf0, 0x7e, 0x15, 0x5e, 0xa3, 0xd1, 0x3d, 0x4d, 0xc2, 0x44,
+	0x10, 0x79, 0xe3, 0xcf, 0xc0, 0xec, 0x9f, 0xca, 0xcf, 0xcd, 0xdc, 0x85, 0xd5, 0x36, 0xb1, 0x49,
+	0x82, 0x79, 0x5d, 0x73, 0x85, 0x49, 0xc2, 0x32, 0x9e, 0xbc, 0x01, 0x2c, 0xcb, 0x30, 0xc8, 0xef,
+	0xde, 0x70, 0xe2, 0x72, 0x4d, 0xbf, 0x4a, 0x60, 0x02, 0xea, 0x87, 0x59, 0xa0, 0xb1, 0x1c, 0x5a,
+	0x4e, 0x3c, 0x61, 0xd0, 0xba, 0x2e, 0xa8, 0x69, 0x0f, 0xaa, 0xea, 0x46, 0x46, 0x74, 0x2c, 0x87,
+	0x40, 0x85, 0xdb, 0x60, 0x36, 0xd1, 0x1c, 0xeb, 0x08, 0x90, 0xd1, 0x5d, 0xaf, 0x60, 0x51, 0xb6,
+	0x6e, 0x8f, 0xf2, 0xae, 0xb6, 0xb3, 0x5f, 0x80, 0xf0, 0x10, 0xca, 0xaf, 0x46, 0xc4, 0xc5, 0x82,
+	0x48, 0x7f, 0x79, 0xbc, 0xe9, 0x27, 0x6b, 0x02, 0x95, 0xf9, 0x56, 0x0e, 0x07, 0x44, 0x56, 0xf0,
+	0x19, 0x4e, 0x88, 0x00, 0xb3, 0x6b, 0x5b, 0x1c, 0x17, 0x2f, 0x9e, 0x6a, 0x5e, 0x1a, 0x36, 0x53,
+	0xc0, 0xb3, 0x3c, 0x83, 0x80, 0xc2, 0xc5, 0x5f, 0x45, 0xfe, 0xd6, 0xf7, 0x5d, 0x7a, 0x4c, 0x6d,
+	0xd2, 0x27, 0x9a, 0x13, 0x30, 0x09, 0xcb, 0xe8, 0xa2, 0x1e, 0x14, 0x95, 0xf0, 0xae, 0x8b, 0x1d,
+	0x81, 0x66, 0x99, 0xe6, 0x21, 0x02, 0xda, 0xc6, 0xf9, 0xc0, 0x70, 0x13, 0x26, 0x80, 0x3c, 0x16,
+	0xfb, 0xcc, 0xa6, 0xe6, 0x19, 0x6a, 0x68, 0x4a, 0x43, 0x04, 0xd1, 0x5c, 0x76, 0x52, 0x91, 0xa1,
+	0x48, 0x0f, 0x8a, 0xad, 0x01, 0x31, 0x8f, 0x5e, 0x12, 0x6c, 0x8b, 0x81, 0xee, 0x9d, 0x12, 0x21,
+	0x66, 0x6f, 0x24, 0x01, 0x8c, 0x69, 0xa0, 0x6d, 0xcb, 0x8a, 0x6e, 0x05, 0xdf, 0x53, 0x62, 0x5b,
+	0x68, 0x23, 0xed, 0x46, 0x30, 0x8d, 0xcb, 0x16, 0x90, 0x9d, 0x67, 0xbf, 0x3e, 0xed, 0x53, 0x31,
+	0x18, 0xf7, 0xe4, 0xca, 0xa6, 0x82, 0x6e, 0x50, 0xe6, 0xff, 0xda, 0x0c, 0x9c, 0xb0, 0xe9, 0x7d,
+	0xbd, 0x19, 0x16, 0x82, 0x51, 0xaf, 0x37, 0xef, 0x4d, 0x3d, 0xf9, 0x7f, 0x00, 0x4e, 0xb1, 0xd2,
+	0x6d, 0xb0, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -878,6 +879,7 @@ type RootCoordClient interface {
 	SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest, opts ...grpc.CallOption) (*internalpb.ListPolicyResponse, error)
 	CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error)
+	AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type rootCoordClient struct {
@@ -1257,6 +1259,15 @@ func (c *rootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHea
 	return out, nil
 }
 
+func (c *rootCoordClient) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AddCollectionField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
@@ -1352,6 +1363,7 @@ type RootCoordServer interface {
 	SelectGrant(context.Context, *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 	ListPolicy(context.Context, *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
 	CheckHealth(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+	AddCollectionField(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -1481,6 +1493,9 @@ func (*UnimplementedRootCoordServer) ListPolicy(ctx context.Context, req *intern
 func (*UnimplementedRootCoordServer) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckHealth not implemented")
 }
+func (*UnimplementedRootCoordServer) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionField not implemented")
+}
 
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
@@ -2224,6 +2239,24 @@ func _RootCoord_CheckHealth_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_AddCollectionField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(proxypb.AddCollectionFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddCollectionField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AddCollectionField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddCollectionField(ctx, req.(*proxypb.AddCollectionFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -2392,6 +2425,10 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckHealth",
 			Handler:    _RootCoord_CheckHealth_Handler,
 		},
+		{
+			MethodName: "AddCollectionField",
+			Handler:    _RootCoord_AddCollectionField_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
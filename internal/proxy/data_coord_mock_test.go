@@ -40,6 +40,13 @@ type DataCoordMock struct {
 	statisticsChannel      string
 	timeTickChannel        string
 	checkHealthFunc        func(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+
+	manualCompactionWithScopeFunc func(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error)
+	cancelCompactionFunc          func(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error)
+	getCompactionPlanStatesFunc   func(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error)
+	exportFunc                    func(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error)
+	getExportStateFunc            func(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error)
+	cancelExportFunc              func(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error)
 }
 
 func (coord *DataCoordMock) updateState(state commonpb.StateCode) {
@@ -244,6 +251,52 @@ func (coord *DataCoordMock) GetCompactionStateWithPlans(ctx context.Context, req
 	return &milvuspb.GetCompactionPlansResponse{}, nil
 }
 
+func (coord *DataCoordMock) ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	if coord.manualCompactionWithScopeFunc != nil {
+		return coord.manualCompactionWithScopeFunc(ctx, req)
+	}
+	return &milvuspb.ManualCompactionResponse{}, nil
+}
+
+func (coord *DataCoordMock) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	if coord.cancelCompactionFunc != nil {
+		return coord.cancelCompactionFunc(ctx, req)
+	}
+	return &commonpb.Status{}, nil
+}
+
+func (coord *DataCoordMock) GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	if coord.getCompactionPlanStatesFunc != nil {
+		return coord.getCompactionPlanStatesFunc(ctx, req)
+	}
+	return &datapb.GetCompactionPlanStatesResponse{}, nil
+}
+
+func (coord *DataCoordMock) Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+	if coord.exportFunc != nil {
+		return coord.exportFunc(ctx, req)
+	}
+	return &datapb.ExportResponse{}, nil
+}
+
+func (coord *DataCoordMock) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	if coord.getExportStateFunc != nil {
+		return coord.getExportStateFunc(ctx, req)
+	}
+	return &datapb.GetExportStateResponse{}, nil
+}
+
+func (coord *DataCoordMock) CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+	if coord.cancelExportFunc != nil {
+		return coord.cancelExportFunc(ctx, req)
+	}
+	return &commonpb.Status{}, nil
+}
+
+func (coord *DataCoordMock) ReportExport(ctx context.Context, req *datapb.ExportTaskResult) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
 func (coord *DataCoordMock) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	return &datapb.WatchChannelsResponse{}, nil
 }
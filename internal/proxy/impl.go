@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
@@ -3329,6 +3330,331 @@ func (node *Proxy) ManualCompaction(ctx context.Context, req *milvuspb.ManualCom
 	return resp, err
 }
 
+// ManualCompactionWithScope invokes compaction limited to the given partitions or segments of a collection
+func (node *Proxy) ManualCompactionWithScope(ctx context.Context, req *proxypb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ManualCompactionWithScope")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("collection", req.GetCollectionName()),
+		zap.Strings("partitions", req.GetPartitionNames()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()),
+		zap.String("mode", req.GetMode().String()))
+
+	log.Info("received ManualCompactionWithScope request")
+	resp := &milvuspb.ManualCompactionResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	partitionIDs := make([]UniqueID, 0, len(req.GetPartitionNames()))
+	for _, partitionName := range req.GetPartitionNames() {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, req.GetCollectionName(), partitionName)
+		if err != nil {
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+
+	resp, err = node.dataCoord.ManualCompactionWithScope(ctx, &datapb.ManualCompactionWithScopeRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID:   collectionID,
+		PartitionIDs:   partitionIDs,
+		SegmentIDs:     req.GetSegmentIDs(),
+		Mode:           req.GetMode(),
+		MaxSegmentSize: req.GetMaxSegmentSize(),
+		Timetravel:     req.GetTimetravel(),
+	})
+	log.Info("received ManualCompactionWithScope response",
+		zap.Any("resp", resp),
+		zap.Error(err))
+	return resp, err
+}
+
+// CancelCompaction cancels the plans of a compaction which are not completed yet
+func (node *Proxy) CancelCompaction(ctx context.Context, req *proxypb.CancelCompactionRequest) (*commonpb.Status, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CancelCompaction")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("collection", req.GetCollectionName()),
+		zap.Int64("compactionID", req.GetCompactionID()),
+		zap.Int64("planID", req.GetPlanID()))
+
+	log.Info("received CancelCompaction request")
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	status, err := node.dataCoord.CancelCompaction(ctx, &datapb.CancelCompactionRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CompactionID: req.GetCompactionID(),
+		PlanID:       req.GetPlanID(),
+		CollectionID: collectionID,
+	})
+	log.Info("received CancelCompaction response",
+		zap.Any("status", status),
+		zap.Error(err))
+	return status, err
+}
+
+// GetCompactionPlanStates gets the state of each plan of a compaction, including the cancelled ones
+func (node *Proxy) GetCompactionPlanStates(ctx context.Context, req *proxypb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetCompactionPlanStates")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("collection", req.GetCollectionName()),
+		zap.Int64("compactionID", req.GetCompactionID()))
+
+	log.Debug("received GetCompactionPlanStates request")
+	if !node.checkHealthy() {
+		return &datapb.GetCompactionPlanStatesResponse{Status: unhealthyStatus()}, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		return &datapb.GetCompactionPlanStatesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	resp, err := node.dataCoord.GetCompactionPlanStates(ctx, &datapb.GetCompactionPlanStatesRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CompactionID: req.GetCompactionID(),
+		CollectionID: collectionID,
+	})
+	log.Debug("received GetCompactionPlanStates response",
+		zap.Any("resp", resp),
+		zap.Error(err))
+	return resp, err
+}
+
+// Export starts a job which exports the flushed segments of a collection to Parquet or JSON files
+func (node *Proxy) Export(ctx context.Context, req *proxypb.ExportRequest) (*datapb.ExportResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Export")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("collection", req.GetCollectionName()),
+		zap.Strings("partitions", req.GetPartitionNames()),
+		zap.Uint64("timestamp", req.GetTimestamp()),
+		zap.String("format", req.GetFormat().String()),
+		zap.String("targetPrefix", req.GetTargetPrefix()))
+
+	log.Info("received Export request")
+	resp := &datapb.ExportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	partitionIDs := make([]UniqueID, 0, len(req.GetPartitionNames()))
+	for _, partitionName := range req.GetPartitionNames() {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, req.GetCollectionName(), partitionName)
+		if err != nil {
+			resp.Status.Reason = err.Error()
+			return resp, nil
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+
+	resp, err = node.dataCoord.Export(ctx, &datapb.ExportRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+		PartitionIDs: partitionIDs,
+		Timestamp:    req.GetTimestamp(),
+		Format:       req.GetFormat(),
+		TargetPrefix: req.GetTargetPrefix(),
+	})
+	log.Info("received Export response",
+		zap.Any("resp", resp),
+		zap.Error(err))
+	return resp, err
+}
+
+// GetExportState gets the state and progress of an export job
+func (node *Proxy) GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetExportState")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("collection", req.GetCollectionName()),
+		zap.Int64("jobID", req.GetJobID()))
+
+	log.Debug("received GetExportState request")
+	if !node.checkHealthy() {
+		return &datapb.GetExportStateResponse{Status: unhealthyStatus()}, nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		return &datapb.GetExportStateResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	resp, err := node.dataCoord.GetExportState(ctx, &datapb.GetExportStateRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		JobID:        req.GetJobID(),
+		CollectionID: collectionID,
+	})
+	log.Debug("received GetExportState response",
+		zap.String("state", resp.GetState().String()),
+		zap.Int64("progress", resp.GetProgress()),
+		zap.Error(err))
+	return resp, err
+}
+
+// CancelExport cancels an export job which is not finished yet
+func (node *Proxy) CancelExport(ctx context.Context, req *proxypb.CancelExportRequest) (*commonpb.Status, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CancelExport")
+	defer sp.Finish()
+
+	log := log.Ctx(ctx).With(
+		zap.String("collection", req.GetCollectionName()),
+		zap.Int64("jobID", req.GetJobID()))
+
+	log.Info("received CancelExport request")
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, req.GetCollectionName())
+	if err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	status, err := node.dataCoord.CancelExport(ctx, &datapb.CancelExportRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		JobID:        req.GetJobID(),
+		CollectionID: collectionID,
+	})
+	log.Info("received CancelExport response",
+		zap.Any("status", status),
+		zap.Error(err))
+	return status, err
+}
+
+// AddCollectionField appends a nullable or defaulted scalar field to the collection
+func (node *Proxy) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AddCollectionField")
+	defer sp.Finish()
+	method := "AddCollectionField"
+	tr := timerecord.NewTimeRecorder(method)
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel).Inc()
+
+	t := &addCollectionFieldTask{
+		ctx:                       ctx,
+		Condition:                 NewTaskCondition(ctx),
+		AddCollectionFieldRequest: request,
+		rootCoord:                 node.rootCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("field", request.GetField().GetName()))
+
+	log.Debug(
+		rpcReceived(method))
+
+	if err := node.sched.ddQueue.Enqueue(t); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.Uint64("BeginTs", t.BeginTs()),
+		zap.Uint64("EndTs", t.EndTs()))
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.Uint64("BeginTs", t.BeginTs()),
+			zap.Uint64("EndTs", t.EndTs()))
+
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.Uint64("BeginTs", t.BeginTs()),
+		zap.Uint64("EndTs", t.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return t.result, nil
+}
+
 // GetCompactionStateWithPlans returns the compactions states with the given plan ID
 func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-GetCompactionStateWithPlans")
@@ -4146,6 +4472,59 @@ func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesReques
 	return resp, nil
 }
 
+// ValidateExpr checks whether the expression is valid against the schema of the collection without executing it.
+func (node *Proxy) ValidateExpr(ctx context.Context, request *proxypb.ValidateExprRequest) (*proxypb.ValidateExprResponse, error) {
+	if !node.checkHealthy() {
+		return &proxypb.ValidateExprResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetCollectionName())
+	if err != nil {
+		return &proxypb.ValidateExprResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return &proxypb.ValidateExprResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	resp := &proxypb.ValidateExprResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		Valid: true,
+	}
+	if _, err := planparserv2.ParseExpr(helper, request.GetExpr()); err != nil {
+		resp.Valid = false
+		var syntaxErrs planparserv2.SyntaxErrors
+		if !errors.As(err, &syntaxErrs) {
+			resp.Errors = append(resp.Errors, &proxypb.ExprError{Reason: err.Error()})
+			return resp, nil
+		}
+		for _, syntaxErr := range syntaxErrs {
+			resp.Errors = append(resp.Errors, &proxypb.ExprError{
+				Line:   int32(syntaxErr.Line),
+				Column: int32(syntaxErr.Column),
+				Token:  syntaxErr.Token,
+				Reason: syntaxErr.Message,
+			})
+		}
+	}
+	return resp, nil
+}
+
 func (node *Proxy) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if !node.checkHealthy() {
 		reason := errorutil.UnHealthReason("proxy", node.session.ServerID, "proxy is unhealthy")
@@ -8,6 +8,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -104,3 +105,70 @@ func TestProxy_CheckHealth(t *testing.T) {
 		assert.Equal(t, 4, len(resp.Reasons))
 	})
 }
+
+func TestProxy_ValidateExpr(t *testing.T) {
+	paramtable.Init()
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	mockCache := newMockCache()
+	mockCache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		if collectionName != "test" {
+			return nil, errors.New("collection not found")
+		}
+		return &schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			},
+		}, nil
+	})
+	globalMetaCache = mockCache
+
+	node := &Proxy{}
+	node.stateCode.Store(commonpb.StateCode_Healthy)
+	ctx := context.Background()
+
+	t.Run("valid", func(t *testing.T) {
+		resp, err := node.ValidateExpr(ctx, &proxypb.ValidateExprRequest{CollectionName: "test", Expr: "age > 10 and pk in [1, 2]"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.True(t, resp.GetValid())
+		assert.Empty(t, resp.GetErrors())
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		resp, err := node.ValidateExpr(ctx, &proxypb.ValidateExprRequest{CollectionName: "test", Expr: "age > 10 and and"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.False(t, resp.GetValid())
+		assert.Equal(t, 2, len(resp.GetErrors()))
+		assert.Equal(t, int32(1), resp.GetErrors()[0].GetLine())
+		assert.Equal(t, int32(13), resp.GetErrors()[0].GetColumn())
+		assert.Equal(t, "and", resp.GetErrors()[0].GetToken())
+	})
+
+	t.Run("field not in schema", func(t *testing.T) {
+		resp, err := node.ValidateExpr(ctx, &proxypb.ValidateExprRequest{CollectionName: "test", Expr: "height > 10"})
+		assert.NoError(t, err)
+		assert.False(t, resp.GetValid())
+		assert.Equal(t, 1, len(resp.GetErrors()))
+		assert.Equal(t, int32(0), resp.GetErrors()[0].GetLine())
+		assert.NotEmpty(t, resp.GetErrors()[0].GetReason())
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		resp, err := node.ValidateExpr(ctx, &proxypb.ValidateExprRequest{CollectionName: "not_exist", Expr: "age > 10"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("not healthy", func(t *testing.T) {
+		node := &Proxy{}
+		node.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := node.ValidateExpr(ctx, &proxypb.ValidateExprRequest{CollectionName: "test", Expr: "age > 10"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+}
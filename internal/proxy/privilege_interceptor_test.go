@@ -9,6 +9,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -109,6 +110,18 @@ func TestPrivilegeInterceptor(t *testing.T) {
 			CollectionName: "col1",
 		})
 		assert.Nil(t, err)
+
+		// requests of MilvusExtService are checked in the same way
+		_, err = PrivilegeInterceptor(ctx, &proxypb.ValidateExprRequest{
+			CollectionName: "col1",
+			Expr:           "pk > 1",
+		})
+		assert.NotNil(t, err)
+		_, err = PrivilegeInterceptor(GetContext(context.Background(), "fooo:123456"), &proxypb.ValidateExprRequest{
+			CollectionName: "col1",
+			Expr:           "pk > 1",
+		})
+		assert.Nil(t, err)
 	})
 
 }
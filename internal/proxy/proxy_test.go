@@ -57,6 +57,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
@@ -3763,6 +3764,240 @@ func Test_ManualCompaction(t *testing.T) {
 	})
 }
 
+func Test_ManualCompactionWithScope(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 1, nil
+	})
+	mockCache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		if partitionName != "part" {
+			return 0, errors.New("partition not found")
+		}
+		return 2, nil
+	})
+	globalMetaCache = mockCache
+
+	t.Run("test manual compaction with scope", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		datacoord.manualCompactionWithScopeFunc = func(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error) {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			assert.Equal(t, []int64{2}, req.GetPartitionIDs())
+			assert.Equal(t, uint64(100), req.GetTimetravel())
+			return &milvuspb.ManualCompactionResponse{Status: &commonpb.Status{}, CompactionID: 3}, nil
+		}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := proxy.ManualCompactionWithScope(context.TODO(), &proxypb.ManualCompactionWithScopeRequest{
+			CollectionName: "coll",
+			PartitionNames: []string{"part"},
+			Timetravel:     100,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), resp.GetCompactionID())
+	})
+	t.Run("test manual compaction with scope with unknown names", func(t *testing.T) {
+		proxy := &Proxy{dataCoord: &DataCoordMock{}}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := proxy.ManualCompactionWithScope(context.TODO(), &proxypb.ManualCompactionWithScopeRequest{CollectionName: "other"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		resp, err = proxy.ManualCompactionWithScope(context.TODO(), &proxypb.ManualCompactionWithScopeRequest{
+			CollectionName: "coll",
+			PartitionNames: []string{"other"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+	t.Run("test manual compaction with scope with unhealthy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := proxy.ManualCompactionWithScope(context.TODO(), nil)
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
+func Test_CancelCompaction(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 1, nil
+	})
+	globalMetaCache = mockCache
+
+	t.Run("test cancel compaction", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		datacoord.cancelCompactionFunc = func(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			assert.Equal(t, int64(2), req.GetCompactionID())
+			return &commonpb.Status{}, nil
+		}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+		status, err := proxy.CancelCompaction(context.TODO(), &proxypb.CancelCompactionRequest{CollectionName: "coll", CompactionID: 2})
+		assert.EqualValues(t, &commonpb.Status{}, status)
+		assert.Nil(t, err)
+
+		status, err = proxy.CancelCompaction(context.TODO(), &proxypb.CancelCompactionRequest{CollectionName: "other", CompactionID: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+	t.Run("test cancel compaction with unhealthy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(commonpb.StateCode_Abnormal)
+		status, err := proxy.CancelCompaction(context.TODO(), nil)
+		assert.EqualValues(t, unhealthyStatus(), status)
+		assert.Nil(t, err)
+	})
+}
+
+func Test_GetCompactionPlanStates(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		return 1, nil
+	})
+	globalMetaCache = mockCache
+
+	t.Run("test get compaction plan states", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		datacoord.getCompactionPlanStatesFunc = func(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error) {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			assert.Equal(t, int64(2), req.GetCompactionID())
+			return &datapb.GetCompactionPlanStatesResponse{
+				Status: &commonpb.Status{},
+				Plans:  []*datapb.CompactionPlanInfo{{PlanID: 3, State: datapb.CompactionPlanState_CompactionPlanCancelled}},
+			}, nil
+		}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := proxy.GetCompactionPlanStates(context.TODO(), &proxypb.GetCompactionPlanStatesRequest{CollectionName: "coll", CompactionID: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, datapb.CompactionPlanState_CompactionPlanCancelled, resp.GetPlans()[0].GetState())
+	})
+	t.Run("test get compaction plan states with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{dataCoord: &DataCoordMock{}}
+		proxy.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := proxy.GetCompactionPlanStates(context.TODO(), nil)
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+		assert.Nil(t, err)
+	})
+}
+
+func Test_Export(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	mockCache := newMockCache()
+	mockCache.setGetIDFunc(func(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+		if collectionName != "coll" {
+			return 0, errors.New("collection not found")
+		}
+		return 1, nil
+	})
+	mockCache.setGetPartitionIDFunc(func(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+		if partitionName != "part" {
+			return 0, errors.New("partition not found")
+		}
+		return 2, nil
+	})
+	globalMetaCache = mockCache
+
+	t.Run("test export", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		datacoord.exportFunc = func(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error) {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			assert.Equal(t, []int64{2}, req.GetPartitionIDs())
+			assert.Equal(t, uint64(100), req.GetTimestamp())
+			assert.Equal(t, datapb.ExportFormat_ExportJSON, req.GetFormat())
+			assert.Equal(t, "prefix", req.GetTargetPrefix())
+			return &datapb.ExportResponse{Status: &commonpb.Status{}, JobID: 3}, nil
+		}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := proxy.Export(context.TODO(), &proxypb.ExportRequest{
+			CollectionName: "coll",
+			PartitionNames: []string{"part"},
+			Timestamp:      100,
+			Format:         datapb.ExportFormat_ExportJSON,
+			TargetPrefix:   "prefix",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(3), resp.GetJobID())
+	})
+	t.Run("test export with unknown names", func(t *testing.T) {
+		proxy := &Proxy{dataCoord: &DataCoordMock{}}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := proxy.Export(context.TODO(), &proxypb.ExportRequest{CollectionName: "other"})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		resp, err = proxy.Export(context.TODO(), &proxypb.ExportRequest{
+			CollectionName: "coll",
+			PartitionNames: []string{"other"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+	t.Run("test get export state", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		datacoord.getExportStateFunc = func(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error) {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			assert.Equal(t, int64(3), req.GetJobID())
+			return &datapb.GetExportStateResponse{Status: &commonpb.Status{}, State: datapb.ExportState_ExportCompleted}, nil
+		}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := proxy.GetExportState(context.TODO(), &proxypb.GetExportStateRequest{CollectionName: "coll", JobID: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, datapb.ExportState_ExportCompleted, resp.GetState())
+
+		resp, err = proxy.GetExportState(context.TODO(), &proxypb.GetExportStateRequest{CollectionName: "other", JobID: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+	t.Run("test cancel export", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		datacoord.cancelExportFunc = func(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error) {
+			assert.Equal(t, int64(1), req.GetCollectionID())
+			assert.Equal(t, int64(3), req.GetJobID())
+			return &commonpb.Status{}, nil
+		}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(commonpb.StateCode_Healthy)
+		status, err := proxy.CancelExport(context.TODO(), &proxypb.CancelExportRequest{CollectionName: "coll", JobID: 3})
+		assert.Nil(t, err)
+		assert.EqualValues(t, &commonpb.Status{}, status)
+
+		status, err = proxy.CancelExport(context.TODO(), &proxypb.CancelExportRequest{CollectionName: "other", JobID: 3})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+	t.Run("test export with unhealthy", func(t *testing.T) {
+		proxy := &Proxy{dataCoord: &DataCoordMock{}}
+		proxy.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := proxy.Export(context.TODO(), nil)
+		assert.Nil(t, err)
+		assert.EqualValues(t, unhealthyStatus(), resp.GetStatus())
+		stateResp, err := proxy.GetExportState(context.TODO(), nil)
+		assert.Nil(t, err)
+		assert.EqualValues(t, unhealthyStatus(), stateResp.GetStatus())
+		status, err := proxy.CancelExport(context.TODO(), nil)
+		assert.Nil(t, err)
+		assert.EqualValues(t, unhealthyStatus(), status)
+	})
+}
+
 func Test_GetCompactionStateWithPlans(t *testing.T) {
 	t.Run("test get compaction state with plans", func(t *testing.T) {
 		datacoord := &DataCoordMock{}
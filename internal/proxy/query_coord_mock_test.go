@@ -87,6 +87,10 @@ func (coord *QueryCoordMock) CheckHealth(ctx context.Context, req *milvuspb.Chec
 	return &milvuspb.CheckHealthResponse{IsHealthy: true}, nil
 }
 
+func (coord *QueryCoordMock) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (coord *QueryCoordMock) updateState(state commonpb.StateCode) {
 	coord.state.Store(state)
 }
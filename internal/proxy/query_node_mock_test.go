@@ -131,3 +131,6 @@ func (m *QueryNodeMock) GetDataDistribution(context.Context, *querypb.GetDataDis
 func (m *QueryNodeMock) SyncDistribution(context.Context, *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
+func (m *QueryNodeMock) UpdateSchema(context.Context, *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
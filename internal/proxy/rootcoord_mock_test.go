@@ -110,6 +110,8 @@ type RootCoordMock struct {
 	lastTs          typeutil.Timestamp
 	lastTsMtx       sync.Mutex
 	checkHealthFunc func(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+
+	addCollectionFieldFunc func(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)
 }
 
 func (coord *RootCoordMock) CreateAlias(ctx context.Context, req *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
@@ -1125,6 +1127,13 @@ func (coord *RootCoordMock) AlterCollection(ctx context.Context, request *milvus
 	return &commonpb.Status{}, nil
 }
 
+func (coord *RootCoordMock) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if coord.addCollectionFieldFunc != nil {
+		return coord.addCollectionFieldFunc(ctx, req)
+	}
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (coord *RootCoordMock) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if coord.checkHealthFunc != nil {
 		return coord.checkHealthFunc(ctx, req)
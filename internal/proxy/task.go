@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mq/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/commonpbutil"
@@ -70,6 +71,7 @@ const (
 	DropAliasTaskName          = "DropAliasTask"
 	AlterAliasTaskName         = "AlterAliasTask"
 	AlterCollectionTaskName    = "AlterCollectionTask"
+	AddCollectionFieldTaskName = "AddCollectionFieldTask"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
@@ -197,6 +199,18 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		if err := validateFieldName(field.Name); err != nil {
 			return err
 		}
+		// validate nullable type parameter
+		if err := typeutil.ValidateNullableField(field); err != nil {
+			return err
+		}
+		// validate default value type parameter
+		if err := typeutil.ValidateDefaultValue(field); err != nil {
+			return err
+		}
+		// validate bloom filter type parameters
+		if err := validateBloomFilterParams(field); err != nil {
+			return err
+		}
 		// validate vector field type parameters
 		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
 			err = validateDimension(field)
@@ -218,6 +232,10 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := validateClusteringKey(cct.schema); err != nil {
+		return err
+	}
+
 	cct.CreateCollectionRequest.Schema, err = proto.Marshal(cct.schema)
 	if err != nil {
 		return err
@@ -721,6 +739,75 @@ func (act *alterCollectionTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type addCollectionFieldTask struct {
+	Condition
+	*proxypb.AddCollectionFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (t *addCollectionFieldTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *addCollectionFieldTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *addCollectionFieldTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *addCollectionFieldTask) Name() string {
+	return AddCollectionFieldTaskName
+}
+
+func (t *addCollectionFieldTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *addCollectionFieldTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *addCollectionFieldTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *addCollectionFieldTask) OnEnqueue() error {
+	t.Base = commonpbutil.NewMsgBase()
+	return nil
+}
+
+func (t *addCollectionFieldTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_AlterCollection
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	if t.GetField() == nil {
+		return errors.New("field to add should not be empty")
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.GetCollectionName())
+	if err != nil {
+		return err
+	}
+	return typeutil.ValidateAddedField(schema, t.GetField(), Params.ProxyCfg.MaxNameLength)
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.rootCoord.AddCollectionField(ctx, t.AddCollectionFieldRequest)
+	return err
+}
+
+func (t *addCollectionFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type createPartitionTask struct {
 	Condition
 	*milvuspb.CreatePartitionRequest
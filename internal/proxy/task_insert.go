@@ -107,7 +107,7 @@ func (it *insertTask) OnEnqueue() error {
 func (it *insertTask) checkLengthOfFieldsData() error {
 	neededFieldsNum := 0
 	for _, field := range it.schema.Fields {
-		if !field.AutoID {
+		if !field.AutoID && !typeutil.IsFieldNullable(field) && !hasDefaultValue(field) {
 			neededFieldsNum++
 		}
 	}
@@ -169,6 +169,38 @@ func (it *insertTask) checkPrimaryFieldData() error {
 	return nil
 }
 
+// fillMissingFieldsData appends a column for every field not provided by user, the column is filled with
+// the default value of the field if declared, otherwise with null values if the field is nullable.
+func (it *insertTask) fillMissingFieldsData() error {
+	provided := make(map[string]bool)
+	for _, fieldData := range it.GetFieldsData() {
+		provided[fieldData.GetFieldName()] = true
+	}
+
+	numRows := int(it.NRows())
+	for _, field := range it.schema.GetFields() {
+		if provided[field.GetName()] {
+			continue
+		}
+		if !hasDefaultValue(field) && !typeutil.IsFieldNullable(field) {
+			continue
+		}
+		fieldData, validData, err := genMissingFieldData(field, numRows)
+		if err != nil {
+			return err
+		}
+		it.FieldsData = append(it.FieldsData, fieldData)
+		if validData != nil {
+			it.ValidData = append(it.ValidData, &internalpb.FieldValidData{
+				FieldID:   field.GetFieldID(),
+				ValidData: validData,
+			})
+		}
+	}
+
+	return nil
+}
+
 func (it *insertTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(it.ctx, "Proxy-Insert-PreExecute")
 	defer sp.Finish()
@@ -239,6 +271,14 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// fill the fields omitted by user with default values or null values
+	err = it.fillMissingFieldsData()
+	if err != nil {
+		log.Error("fill missing field data failed",
+			zap.Error(err))
+		return err
+	}
+
 	// set field ID to insert field data
 	err = fillFieldIDBySchema(it.GetFieldsData(), collSchema)
 	if err != nil {
@@ -334,6 +374,12 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 			Version:        internalpb.InsertDataVersion_ColumnBased,
 		}
 		insertReq.FieldsData = make([]*schemapb.FieldData, len(it.GetFieldsData()))
+		for _, validData := range it.GetValidData() {
+			insertReq.ValidData = append(insertReq.ValidData, &internalpb.FieldValidData{
+				FieldID:   validData.GetFieldID(),
+				ValidData: make([]bool, 0),
+			})
+		}
 
 		insertMsg := &msgstream.InsertMsg{
 			BaseMsg: msgstream.BaseMsg{
@@ -372,6 +418,9 @@ func (it *insertTask) assignSegmentID(channelNames []string) (*msgstream.MsgPack
 			}
 
 			typeutil.AppendFieldData(insertMsg.FieldsData, it.GetFieldsData(), int64(offset))
+			for i, validData := range it.GetValidData() {
+				insertMsg.ValidData[i].ValidData = append(insertMsg.ValidData[i].ValidData, validData.GetValidData()[offset])
+			}
 			insertMsg.HashValues = append(insertMsg.HashValues, it.HashValues[offset])
 			insertMsg.Timestamps = append(insertMsg.Timestamps, it.Timestamps[offset])
 			insertMsg.RowIDs = append(insertMsg.RowIDs, it.RowIDs[offset])
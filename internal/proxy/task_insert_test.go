@@ -5,6 +5,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/stretchr/testify/assert"
 )
@@ -346,3 +347,48 @@ func TestInsertTask_CheckAligned(t *testing.T) {
 	err = case2.CheckAligned()
 	assert.NoError(t, err)
 }
+
+func TestInsertTask_fillMissingFieldsData(t *testing.T) {
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{
+					FieldID: 101, Name: "nullable", DataType: schemapb.DataType_Double,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+				},
+				{
+					FieldID: 102, Name: "default", DataType: schemapb.DataType_Int32,
+					TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "7"}},
+				},
+			},
+		},
+		BaseInsertTask: BaseInsertTask{
+			InsertRequest: internalpb.InsertRequest{
+				FieldsData: []*schemapb.FieldData{
+					newScalarFieldData(&schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64}, "pk", 2),
+				},
+				RowIDs:     []int64{1, 2},
+				Timestamps: []uint64{1, 1},
+				NumRows:    2,
+				Version:    internalpb.InsertDataVersion_ColumnBased,
+			},
+		},
+	}
+
+	// nullable field and field with default value can be omitted
+	assert.NoError(t, it.checkLengthOfFieldsData())
+	assert.NoError(t, it.fillMissingFieldsData())
+	assert.Equal(t, 3, len(it.GetFieldsData()))
+	assert.Equal(t, "nullable", it.GetFieldsData()[1].GetFieldName())
+	assert.Equal(t, "default", it.GetFieldsData()[2].GetFieldName())
+	assert.Equal(t, []int32{7, 7}, it.GetFieldsData()[2].GetScalars().GetIntData().GetData())
+	assert.Equal(t, []*internalpb.FieldValidData{{FieldID: 101, ValidData: []bool{false, false}}}, it.GetValidData())
+	assert.NoError(t, fillFieldIDBySchema(it.GetFieldsData(), it.schema))
+	assert.NoError(t, it.CheckAligned())
+
+	// fields provided by user are not filled again
+	assert.NoError(t, it.fillMissingFieldsData())
+	assert.Equal(t, 3, len(it.GetFieldsData()))
+	assert.Equal(t, 1, len(it.GetValidData()))
+}
@@ -357,6 +357,17 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		}
 	}
 
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
+	if err != nil {
+		return err
+	}
+	for _, res := range t.toReduceResults {
+		res.FieldsData, err = fillMissingOutputFields(schema, t.OutputFieldsId, res.GetFieldsData(), typeutil.GetSizeOfIDs(res.GetIds()))
+		if err != nil {
+			return err
+		}
+	}
+
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(0.0)
 	tr.CtxRecord(ctx, "reduceResultStart")
 	t.result, err = reduceRetrieveResults(ctx, t.toReduceResults, t.queryParams)
@@ -380,10 +391,6 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return nil
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, t.request.CollectionName)
-	if err != nil {
-		return err
-	}
 	for i := 0; i < len(t.result.FieldsData); i++ {
 		if t.OutputFieldsId[i] == common.TimeStampField {
 			t.result.FieldsData = append(t.result.FieldsData[:i], t.result.FieldsData[(i+1):]...)
@@ -446,6 +446,13 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	metrics.ProxyDecodeResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10),
 		metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
+	for _, r := range validSearchResults {
+		r.FieldsData, err = fillMissingOutputFields(t.schema, t.OutputFieldsId, r.GetFieldsData(), typeutil.GetSizeOfIDs(r.GetIds()))
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(validSearchResults) <= 0 {
 		log.Ctx(ctx).Warn("search result is empty")
 
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/stretchr/testify/mock"
@@ -1911,6 +1912,82 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.PostExecute(ctx))
 }
 
+func TestAddCollectionField_all(t *testing.T) {
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	collectionName := "TestAddCollectionField_all" + funcutil.GenRandomStr()
+
+	cache := newMockCache()
+	cache.setGetSchemaFunc(func(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+		return &schemapb.CollectionSchema{
+			Name: collectionName,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			},
+		}, nil
+	})
+	globalMetaCache = cache
+
+	var received *proxypb.AddCollectionFieldRequest
+	rc.addCollectionFieldFunc = func(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+		received = req
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+
+	newTask := func(field *schemapb.FieldSchema) *addCollectionFieldTask {
+		task := &addCollectionFieldTask{
+			Condition: NewTaskCondition(ctx),
+			AddCollectionFieldRequest: &proxypb.AddCollectionFieldRequest{
+				CollectionName: collectionName,
+				Field:          field,
+			},
+			ctx:       ctx,
+			rootCoord: rc,
+		}
+		assert.NoError(t, task.OnEnqueue())
+		return task
+	}
+
+	task := newTask(&schemapb.FieldSchema{
+		Name:       "age",
+		DataType:   schemapb.DataType_Int32,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	})
+	assert.NotNil(t, task.TraceCtx())
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_AlterCollection, task.Type())
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.GetErrorCode())
+	assert.Equal(t, "age", received.GetField().GetName())
+
+	// empty field
+	task = newTask(nil)
+	assert.Error(t, task.PreExecute(ctx))
+
+	// duplicated name
+	task = newTask(&schemapb.FieldSchema{
+		Name:       "pk",
+		DataType:   schemapb.DataType_Int32,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	})
+	assert.Error(t, task.PreExecute(ctx))
+
+	// neither nullable nor defaulted
+	task = newTask(&schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int32})
+	assert.Error(t, task.PreExecute(ctx))
+}
+
 func Test_createIndexTask_getIndexedField(t *testing.T) {
 	collectionName := "test"
 	fieldName := "test"
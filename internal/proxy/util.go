@@ -33,7 +33,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
@@ -50,8 +52,6 @@ const (
 	// maximum length of variable-length strings
 	maxVarCharLengthKey = "max_length"
 
-	defaultMaxVarCharLength = 65535
-
 	// DefaultIndexType name of default index type for scalar field
 	DefaultIndexType = "STL_SORT"
 
@@ -166,34 +166,7 @@ func validatePartitionTag(partitionTag string, strictCheck bool) error {
 }
 
 func validateFieldName(fieldName string) error {
-	fieldName = strings.TrimSpace(fieldName)
-
-	if fieldName == "" {
-		return errors.New("field name should not be empty")
-	}
-
-	invalidMsg := "Invalid field name: " + fieldName + ". "
-	if int64(len(fieldName)) > Params.ProxyCfg.MaxNameLength {
-		msg := invalidMsg + "The length of a field name must be less than " +
-			strconv.FormatInt(Params.ProxyCfg.MaxNameLength, 10) + " characters."
-		return errors.New(msg)
-	}
-
-	firstChar := fieldName[0]
-	if firstChar != '_' && !isAlpha(firstChar) {
-		msg := invalidMsg + "The first character of a field name must be an underscore or letter."
-		return errors.New(msg)
-	}
-
-	fieldNameSize := len(fieldName)
-	for i := 1; i < fieldNameSize; i++ {
-		c := fieldName[i]
-		if c != '_' && !isAlpha(c) && !isNumber(c) {
-			msg := invalidMsg + "Field name cannot only contain numbers, letters, and underscores."
-			return errors.New(msg)
-		}
-	}
-	return nil
+	return typeutil.ValidateFieldName(fieldName, Params.ProxyCfg.MaxNameLength)
 }
 
 func validateDimension(field *schemapb.FieldSchema) error {
@@ -224,26 +197,61 @@ func validateDimension(field *schemapb.FieldSchema) error {
 }
 
 func validateMaxLengthPerRow(collectionName string, field *schemapb.FieldSchema) error {
-	exist := false
+	return typeutil.ValidateMaxLength(collectionName, field)
+}
+
+// validateBloomFilterParams checks the bloom filter type params, which only work on the primary key field.
+func validateBloomFilterParams(field *schemapb.FieldSchema) error {
 	for _, param := range field.TypeParams {
-		if param.Key != maxVarCharLengthKey {
-			return fmt.Errorf("type param key(max_length) should be specified for varChar field, not %s", param.Key)
+		if param.Key != common.BloomFilterCapacityKey && param.Key != common.BloomFilterFPRKey {
+			continue
 		}
-
-		maxLengthPerRow, err := strconv.ParseInt(param.Value, 10, 64)
-		if err != nil {
-			return err
+		if !field.IsPrimaryKey {
+			return fmt.Errorf("type param(%s) only works on the primary field, field %s is not", param.Key, field.Name)
 		}
-		if maxLengthPerRow > defaultMaxVarCharLength || maxLengthPerRow <= 0 {
-			return fmt.Errorf("the maximum length specified for a VarChar shoule be in (0, 65535]")
+		switch param.Key {
+		case common.BloomFilterCapacityKey:
+			capacity, err := strconv.ParseInt(param.Value, 10, 64)
+			if err != nil || capacity <= 0 {
+				return fmt.Errorf("invalid value %s of type param(%s), should be a positive integer", param.Value, param.Key)
+			}
+		case common.BloomFilterFPRKey:
+			fpr, err := strconv.ParseFloat(param.Value, 64)
+			if err != nil || fpr <= 0 || fpr >= 1 {
+				return fmt.Errorf("invalid value %s of type param(%s), should be in (0, 1)", param.Value, param.Key)
+			}
 		}
-		exist = true
-	}
-	// if not exist type params max_length, return error
-	if !exist {
-		return fmt.Errorf("type param(max_length) should be specified for varChar field of collection %s", collectionName)
 	}
+	return nil
+}
 
+// validateClusteringKey checks the clustering key type param, a collection can have at most one clustering key,
+// which must be a numeric or varchar scalar field.
+func validateClusteringKey(schema *schemapb.CollectionSchema) error {
+	var clusteringKey *schemapb.FieldSchema
+	for _, field := range schema.Fields {
+		for _, param := range field.TypeParams {
+			if param.Key != common.ClusteringKey {
+				continue
+			}
+			if _, err := strconv.ParseBool(param.Value); err != nil {
+				return fmt.Errorf("invalid value %s of type param(%s) for field %s", param.Value, param.Key, field.Name)
+			}
+		}
+		if !typeutil.IsClusteringKeyField(field) {
+			continue
+		}
+		if clusteringKey != nil {
+			return fmt.Errorf("there are more than one clustering key, field name = %s, %s", clusteringKey.Name, field.Name)
+		}
+		switch field.DataType {
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+			schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+		default:
+			return fmt.Errorf("clustering key field %s should be numeric or varchar, not %s", field.Name, field.DataType.String())
+		}
+		clusteringKey = field
+	}
 	return nil
 }
 
@@ -535,6 +543,67 @@ func autoGenPrimaryFieldData(fieldSchema *schemapb.FieldSchema, data interface{}
 	return &fieldData, nil
 }
 
+// hasDefaultValue returns true if the field declares a default value.
+func hasDefaultValue(field *schemapb.FieldSchema) bool {
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() == common.DefaultValueKey {
+			return true
+		}
+	}
+	return false
+}
+
+// genMissingFieldData generates a column of numRows rows for a field which doesn't exist in the stored data,
+// the column is filled with the default value of the field if declared, otherwise with null values.
+// The validity of the rows is returned along with the column, it's nil if the field has a default value.
+func genMissingFieldData(fieldSchema *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, []bool, error) {
+	data, err := storage.GenDefaultFieldData(fieldSchema, numRows)
+	if err != nil {
+		return nil, nil, err
+	}
+	fieldData, err := storage.TransferFieldDataToSchemaFieldData(fieldSchema.GetFieldID(), data)
+	if err != nil {
+		return nil, nil, err
+	}
+	fieldData.Type = fieldSchema.GetDataType()
+	fieldData.FieldName = fieldSchema.GetName()
+	return fieldData, data.GetValidData(), nil
+}
+
+// fillMissingOutputFields fills the output fields absent from the result of a querynode which loaded the collection
+// before the fields were added, so that all results share the same layout as the output fields.
+func fillMissingOutputFields(schema *schemapb.CollectionSchema, outputFieldIDs []int64, fieldsData []*schemapb.FieldData, numRows int) ([]*schemapb.FieldData, error) {
+	if numRows == 0 || len(fieldsData) == len(outputFieldIDs) {
+		return fieldsData, nil
+	}
+
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	fieldID2Data := make(map[int64]*schemapb.FieldData, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		fieldID2Data[fieldData.GetFieldId()] = fieldData
+	}
+	ret := make([]*schemapb.FieldData, 0, len(outputFieldIDs))
+	for _, fieldID := range outputFieldIDs {
+		if fieldData, ok := fieldID2Data[fieldID]; ok {
+			ret = append(ret, fieldData)
+			continue
+		}
+		field, err := helper.GetFieldFromID(fieldID)
+		if err != nil {
+			return nil, err
+		}
+		fieldData, _, err := genMissingFieldData(field, numRows)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, fieldData)
+	}
+	return ret, nil
+}
+
 // fillFieldIDBySchema set fieldID to fieldData according FieldSchemas
 func fillFieldIDBySchema(columns []*schemapb.FieldData, schema *schemapb.CollectionSchema) error {
 	if len(columns) != len(schema.GetFields()) {
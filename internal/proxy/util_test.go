@@ -30,11 +30,13 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -594,6 +596,129 @@ func TestFillFieldIDBySchema(t *testing.T) {
 	assert.Equal(t, int64(1), columns[0].FieldId)
 }
 
+func TestValidateBloomFilterParams(t *testing.T) {
+	bloomFilterParams := func(capacity, fpr string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: common.BloomFilterCapacityKey, Value: capacity}, {Key: common.BloomFilterFPRKey, Value: fpr}}
+	}
+
+	assert.NoError(t, validateBloomFilterParams(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64}))
+	assert.NoError(t, validateBloomFilterParams(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, IsPrimaryKey: true,
+		TypeParams: bloomFilterParams("1000", "0.001")}))
+
+	// not primary key
+	assert.Error(t, validateBloomFilterParams(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64,
+		TypeParams: bloomFilterParams("1000", "0.001")}))
+	// invalid values
+	assert.Error(t, validateBloomFilterParams(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, IsPrimaryKey: true,
+		TypeParams: bloomFilterParams("0", "0.001")}))
+	assert.Error(t, validateBloomFilterParams(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, IsPrimaryKey: true,
+		TypeParams: bloomFilterParams("1000", "1")}))
+
+	// bloom filter params are allowed for varchar field
+	assert.NoError(t, validateMaxLengthPerRow("coll", &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_VarChar, IsPrimaryKey: true,
+		TypeParams: append(bloomFilterParams("1000", "0.001"), &commonpb.KeyValuePair{Key: maxVarCharLengthKey, Value: "10"})}))
+}
+
+func TestValidateClusteringKey(t *testing.T) {
+	clusteringParams := []*commonpb.KeyValuePair{{Key: common.ClusteringKey, Value: "true"}}
+	schema := func(fields ...*schemapb.FieldSchema) *schemapb.CollectionSchema {
+		return &schemapb.CollectionSchema{Name: "coll", Fields: fields}
+	}
+
+	assert.NoError(t, validateClusteringKey(schema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64})))
+	assert.NoError(t, validateClusteringKey(schema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Double, TypeParams: clusteringParams})))
+	assert.NoError(t, validateClusteringKey(schema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.ClusteringKey, Value: "false"}}})))
+
+	// invalid value
+	assert.Error(t, validateClusteringKey(schema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.ClusteringKey, Value: "yes"}}})))
+	// unsupported types
+	assert.Error(t, validateClusteringKey(schema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Bool, TypeParams: clusteringParams})))
+	assert.Error(t, validateClusteringKey(schema(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector, TypeParams: clusteringParams})))
+	// more than one clustering key
+	assert.Error(t, validateClusteringKey(schema(
+		&schemapb.FieldSchema{Name: "f1", DataType: schemapb.DataType_Int64, TypeParams: clusteringParams},
+		&schemapb.FieldSchema{Name: "f2", DataType: schemapb.DataType_Int32, TypeParams: clusteringParams})))
+
+	// clustering key param is allowed for varchar field
+	assert.NoError(t, validateMaxLengthPerRow("coll", &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_VarChar,
+		TypeParams: append(clusteringParams, &commonpb.KeyValuePair{Key: maxVarCharLengthKey, Value: "10"})}))
+}
+
+func TestGenMissingFieldData(t *testing.T) {
+	dataTypes := []schemapb.DataType{
+		schemapb.DataType_Bool,
+		schemapb.DataType_Int8,
+		schemapb.DataType_Int16,
+		schemapb.DataType_Int32,
+		schemapb.DataType_Int64,
+		schemapb.DataType_Float,
+		schemapb.DataType_Double,
+		schemapb.DataType_VarChar,
+	}
+	nullableParams := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	for _, dataType := range dataTypes {
+		fieldData, validData, err := genMissingFieldData(&schemapb.FieldSchema{FieldID: 100, Name: "f", DataType: dataType, TypeParams: nullableParams}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, dataType, fieldData.GetType())
+		assert.Equal(t, "f", fieldData.GetFieldName())
+		assert.Equal(t, int64(100), fieldData.GetFieldId())
+		assert.Equal(t, []bool{false, false, false}, validData)
+		rows, err := funcutil.GetNumRowOfFieldData(fieldData)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), rows)
+	}
+
+	fieldData, validData, err := genMissingFieldData(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int16,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "2"}}}, 2)
+	assert.NoError(t, err)
+	assert.Nil(t, validData)
+	assert.Equal(t, []int32{2, 2}, fieldData.GetScalars().GetIntData().GetData())
+
+	// neither nullable nor has default value
+	_, _, err = genMissingFieldData(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64}, 3)
+	assert.Error(t, err)
+	_, _, err = genMissingFieldData(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector, TypeParams: nullableParams}, 3)
+	assert.Error(t, err)
+}
+
+func TestFillMissingOutputFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64, TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "7"}}},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}},
+			{FieldID: 103, Name: "score", DataType: schemapb.DataType_Double},
+		},
+	}
+	pkData := &schemapb.FieldData{
+		FieldId: 100,
+		Type:    schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+		}},
+	}
+
+	fieldsData, err := fillMissingOutputFields(schema, []int64{100}, []*schemapb.FieldData{pkData}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []*schemapb.FieldData{pkData}, fieldsData)
+
+	fieldsData, err = fillMissingOutputFields(schema, []int64{101, 100, 102}, []*schemapb.FieldData{pkData}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(fieldsData))
+	assert.Equal(t, int64(101), fieldsData[0].GetFieldId())
+	assert.Equal(t, []int64{7, 7}, fieldsData[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, pkData, fieldsData[1])
+	assert.Equal(t, int64(102), fieldsData[2].GetFieldId())
+	assert.Equal(t, 2, len(fieldsData[2].GetScalars().GetStringData().GetData()))
+
+	_, err = fillMissingOutputFields(schema, []int64{100, 103}, []*schemapb.FieldData{pkData}, 2)
+	assert.Error(t, err)
+	_, err = fillMissingOutputFields(schema, []int64{100, 104}, []*schemapb.FieldData{pkData}, 2)
+	assert.Error(t, err)
+}
+
 func TestValidateUsername(t *testing.T) {
 	// only spaces
 	res := ValidateUsername(" ")
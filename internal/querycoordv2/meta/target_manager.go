@@ -164,6 +164,8 @@ func (mgr *TargetManager) PullNextTarget(broker Broker, collectionID int64, part
 				Binlogs:       binlog.GetFieldBinlogs(),
 				Statslogs:     binlog.GetStatslogs(),
 				Deltalogs:     binlog.GetDeltalogs(),
+
+				ClusteringKeyRange: binlog.GetClusteringKeyRange(),
 			}
 		}
 
@@ -105,17 +105,23 @@ func (o *LeaderObserver) findNeedLoadedSegments(leaderView *meta.LeaderView, dis
 	dists = utils.FindMaxVersionSegments(dists)
 	for _, s := range dists {
 		version, ok := leaderView.Segments[s.GetID()]
-		existInCurrentTarget := o.target.GetHistoricalSegment(s.CollectionID, s.GetID(), meta.CurrentTarget) != nil
-		existInNextTarget := o.target.GetHistoricalSegment(s.CollectionID, s.GetID(), meta.NextTarget) != nil
-		if ok && version.GetVersion() >= s.Version || (!existInCurrentTarget && !existInNextTarget) {
+		currentTarget := o.target.GetHistoricalSegment(s.CollectionID, s.GetID(), meta.CurrentTarget)
+		nextTarget := o.target.GetHistoricalSegment(s.CollectionID, s.GetID(), meta.NextTarget)
+		if ok && version.GetVersion() >= s.Version || (currentTarget == nil && nextTarget == nil) {
 			continue
 		}
+		target := currentTarget
+		if target == nil {
+			target = nextTarget
+		}
 		ret = append(ret, &querypb.SyncAction{
 			Type:        querypb.SyncType_Set,
 			PartitionID: s.GetPartitionID(),
 			SegmentID:   s.GetID(),
 			NodeID:      s.Node,
 			Version:     s.Version,
+			// the shard leader skips the segment if its clustering key range mismatches the filter
+			ClusteringKeyRange: target.GetClusteringKeyRange(),
 		})
 	}
 	return ret
@@ -724,3 +724,53 @@ func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthReque
 
 	return &milvuspb.CheckHealthResponse{IsHealthy: true, Reasons: errReasons}, nil
 }
+
+// UpdateCollectionSchema pushes the altered schema of a loaded collection to all query nodes serving it,
+// so they start accepting and back-filling the newly added fields.
+func (s *Server) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	log.Info("update collection schema request received")
+	if s.status.Load() != commonpb.StateCode_Healthy {
+		msg := "failed to update collection schema"
+		log.Warn(msg, zap.Error(ErrNotHealthy))
+		return utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, msg, ErrNotHealthy), nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		log.Info("collection not loaded, skip updating schema")
+		return successStatus, nil
+	}
+
+	nodes := typeutil.NewUniqueSet()
+	for _, replica := range s.meta.ReplicaManager.GetByCollection(req.GetCollectionID()) {
+		nodes.Insert(replica.GetNodes()...)
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+	for _, node := range nodes.Collect() {
+		node := node
+		if s.nodeMgr.Get(node) == nil {
+			continue
+		}
+		group.Go(func() error {
+			status, err := s.cluster.UpdateSchema(ctx, node, req)
+			if err != nil {
+				return err
+			}
+			if status.GetErrorCode() != commonpb.ErrorCode_Success {
+				return fmt.Errorf("failed to update schema on node %d, reason: %s", node, status.GetReason())
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		msg := "failed to update collection schema"
+		log.Warn(msg, zap.Error(err))
+		return utils.WrapStatus(commonpb.ErrorCode_UnexpectedError, msg, err), nil
+	}
+
+	return successStatus, nil
+}
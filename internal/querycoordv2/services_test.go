@@ -19,11 +19,13 @@ package querycoordv2
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -913,6 +915,50 @@ func (suite *ServiceSuite) TestGetShardLeaders() {
 	suite.Contains(resp.Status.Reason, ErrNotHealthy.Error())
 }
 
+func (suite *ServiceSuite) TestUpdateCollectionSchema() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	collection := suite.collections[0]
+	req := &querypb.UpdateSchemaRequest{
+		Base:         &commonpb.MsgBase{},
+		CollectionID: collection,
+		Schema:       &schemapb.CollectionSchema{},
+	}
+	nodes := typeutil.NewUniqueSet()
+	for _, replica := range suite.meta.ReplicaManager.GetByCollection(collection) {
+		nodes.Insert(replica.GetNodes()...)
+	}
+
+	// Test pushing schema to every node of the collection
+	for node := range nodes {
+		suite.cluster.EXPECT().UpdateSchema(mock.Anything, node, req).Return(successStatus, nil).Once()
+	}
+	status, err := server.UpdateCollectionSchema(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.ErrorCode)
+
+	// Test node failure
+	for node := range nodes {
+		suite.cluster.EXPECT().UpdateSchema(mock.Anything, node, req).Return(nil, errors.New("mock error")).Once()
+	}
+	status, err = server.UpdateCollectionSchema(ctx, req)
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_UnexpectedError, status.ErrorCode)
+
+	// Test collection not loaded
+	status, err = server.UpdateCollectionSchema(ctx, &querypb.UpdateSchemaRequest{CollectionID: 1000})
+	suite.NoError(err)
+	suite.Equal(commonpb.ErrorCode_Success, status.ErrorCode)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	status, err = server.UpdateCollectionSchema(ctx, req)
+	suite.NoError(err)
+	suite.Contains(status.Reason, ErrNotHealthy.Error())
+}
+
 func (suite *ServiceSuite) loadAll() {
 	ctx := context.Background()
 	for _, collection := range suite.collections {
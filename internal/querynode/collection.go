@@ -49,7 +49,9 @@ type Collection struct {
 	collectionPtr C.CCollection
 	id            UniqueID
 	partitionIDs  []UniqueID
-	schema        *schemapb.CollectionSchema
+
+	schemaMu sync.RWMutex // guards schema
+	schema   *schemapb.CollectionSchema
 
 	// TODO, remove delta channels
 	channelMu      sync.RWMutex
@@ -73,9 +75,50 @@ func (c *Collection) ID() UniqueID {
 
 // Schema returns the schema of collection
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
 	return c.schema
 }
 
+// updateSchema replaces the schema of collection with the one which has fields appended, and returns the appended fields.
+// It shall be called with mu locked, so that no plan is created during the update.
+func (c *Collection) updateSchema(schema *schemapb.CollectionSchema) ([]*schemapb.FieldSchema, error) {
+	oldSchema := c.Schema()
+	existed := make(map[FieldID]struct{}, len(oldSchema.GetFields()))
+	for _, field := range oldSchema.GetFields() {
+		existed[field.GetFieldID()] = struct{}{}
+	}
+	var addedFields []*schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if _, ok := existed[field.GetFieldID()]; !ok {
+			addedFields = append(addedFields, field)
+		}
+	}
+	if len(addedFields) == 0 {
+		return nil, nil
+	}
+	if len(schema.GetFields()) != len(oldSchema.GetFields())+len(addedFields) {
+		return nil, fmt.Errorf("fields of collection %d can only be appended", c.id)
+	}
+
+	/*
+		CStatus
+		UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+	*/
+	cSchemaBlob := C.CString(proto.MarshalTextString(schema))
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+	status := C.UpdateCollectionSchema(c.collectionPtr, cSchemaBlob)
+	if err := HandleCStatus(&status, "UpdateCollectionSchema failed"); err != nil {
+		return nil, err
+	}
+
+	c.schemaMu.Lock()
+	c.schema = schema
+	c.schemaMu.Unlock()
+	log.Info("update collection schema", zap.Int64("collectionID", c.id), zap.Int("addedFields", len(addedFields)))
+	return addedFields, nil
+}
+
 // getPartitionIDs return partitionIDs of collection
 func (c *Collection) getPartitionIDs() []UniqueID {
 	dst := make([]UniqueID, len(c.partitionIDs))
@@ -368,7 +411,7 @@ func (c *Collection) getLoadType() loadType {
 
 // getFieldType get the field type according to the field id.
 func (c *Collection) getFieldType(fieldID FieldID) (schemapb.DataType, error) {
-	helper, err := typeutil.CreateSchemaHelper(c.schema)
+	helper, err := typeutil.CreateSchemaHelper(c.Schema())
 	if err != nil {
 		return schemapb.DataType_None, err
 	}
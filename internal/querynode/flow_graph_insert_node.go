@@ -57,6 +57,64 @@ type insertData struct {
 	insertRecords    map[UniqueID][]*schemapb.FieldData
 	insertOffset     map[UniqueID]int64
 	insertPKs        map[UniqueID][]primaryKey // pks
+	insertValidData  map[UniqueID]map[FieldID][]bool
+}
+
+// mergeValidData appends the validity of nullable fields in insertMsg to the segment,
+// it must be called before the row ids of insertMsg are appended.
+func (iData *insertData) mergeValidData(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg) {
+	msgValidData := make(map[FieldID][]bool)
+	for _, validData := range insertMsg.GetValidData() {
+		msgValidData[validData.GetFieldID()] = validData.GetValidData()
+	}
+	rowsBefore := len(iData.insertIDs[insertMsg.SegmentID])
+	for _, field := range schema.GetFields() {
+		if !typeutil.IsFieldNullable(field) {
+			continue
+		}
+		segValidData, ok := iData.insertValidData[insertMsg.SegmentID]
+		if !ok {
+			segValidData = make(map[FieldID][]bool)
+			iData.insertValidData[insertMsg.SegmentID] = segValidData
+		}
+		segValidData[field.GetFieldID()] = storage.MergeValidData(segValidData[field.GetFieldID()], rowsBefore,
+			msgValidData[field.GetFieldID()], len(insertMsg.RowIDs))
+	}
+}
+
+// fillAddedFields appends the columns of the fields absent from the column based insertMsg, which were added
+// to the collection after the rows were inserted. The rows take the default value of the fields or null.
+func fillAddedFields(schema *schemapb.CollectionSchema, insertMsg *msgstream.InsertMsg) error {
+	if insertMsg.IsRowBased() {
+		return nil
+	}
+	present := make(map[FieldID]struct{}, len(insertMsg.GetFieldsData()))
+	for _, fieldData := range insertMsg.GetFieldsData() {
+		present[fieldData.GetFieldId()] = struct{}{}
+	}
+	for _, field := range schema.GetFields() {
+		if _, ok := present[field.GetFieldID()]; ok || field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		data, err := storage.GenDefaultFieldData(field, len(insertMsg.GetRowIDs()))
+		if err != nil {
+			return err
+		}
+		record, err := storage.TransferInsertDataToInsertRecord(&storage.InsertData{
+			Data: map[FieldID]storage.FieldData{field.GetFieldID(): data},
+		})
+		if err != nil {
+			return err
+		}
+		insertMsg.FieldsData = append(insertMsg.FieldsData, record.GetFieldsData()...)
+		if validData := data.GetValidData(); len(validData) > 0 {
+			insertMsg.ValidData = append(insertMsg.ValidData, &internalpb.FieldValidData{
+				FieldID:   field.GetFieldID(),
+				ValidData: validData,
+			})
+		}
+	}
+	return nil
 }
 
 // deleteData stores the valid delete data
@@ -95,6 +153,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		insertRecords:    make(map[UniqueID][]*schemapb.FieldData),
 		insertOffset:     make(map[UniqueID]int64),
 		insertPKs:        make(map[UniqueID][]primaryKey),
+		insertValidData:  make(map[UniqueID]map[FieldID][]bool),
 	}
 
 	var spans []opentracing.Span
@@ -153,7 +212,13 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
-		insertRecord, err := storage.TransferInsertMsgToInsertRecord(collection.schema, insertMsg)
+		if err := fillAddedFields(collection.Schema(), insertMsg); err != nil {
+			// occurs only when the added field is neither nullable nor has default value, this should not happen
+			err = fmt.Errorf("failed to fill added fields of insertMsg, err = %s", err)
+			log.Error(err.Error(), zap.Int64("collectionID", iNode.collectionID), zap.String("vchannel", iNode.vchannel))
+			panic(err)
+		}
+		insertRecord, err := storage.TransferInsertMsgToInsertRecord(collection.Schema(), insertMsg)
 		if err != nil {
 			// occurs only when schema doesn't have dim param, this should not happen
 			err = fmt.Errorf("failed to transfer msgStream.insertMsg to storage.InsertRecord, err = %s", err)
@@ -161,6 +226,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			panic(err)
 		}
 
+		iData.mergeValidData(collection.Schema(), insertMsg)
 		iData.insertIDs[insertMsg.SegmentID] = append(iData.insertIDs[insertMsg.SegmentID], insertMsg.RowIDs...)
 		iData.insertTimestamps[insertMsg.SegmentID] = append(iData.insertTimestamps[insertMsg.SegmentID], insertMsg.Timestamps...)
 		if _, ok := iData.insertRecords[insertMsg.SegmentID]; !ok {
@@ -394,6 +460,14 @@ func (iNode *insertNode) insert(iData *insertData, segmentID UniqueID) error {
 		NumRows:    int64(len(ids)),
 	}
 
+	err = targetSegment.segmentInsertValidData(offsets, len(ids), iData.insertValidData[segmentID])
+	if err != nil {
+		if errors.Is(err, ErrSegmentUnhealthy) {
+			log.Warn("segment removed before insert")
+			return nil
+		}
+		return fmt.Errorf("segmentInsertValidData failed, segmentID = %d, err = %s", segmentID, err)
+	}
 	err = targetSegment.segmentInsert(offsets, ids, timestamps, insertRecord)
 	if err != nil {
 		if errors.Is(err, ErrSegmentUnhealthy) {
@@ -460,7 +534,7 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, metaReplica ReplicaInterface) ([]p
 		return nil, err
 	}
 
-	return getPKs(msg, collection.schema)
+	return getPKs(msg, collection.Schema())
 }
 
 func getPKs(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) ([]primaryKey, error) {
@@ -223,6 +223,72 @@ func TestFlowGraphInsertNode_processDeleteMessages(t *testing.T) {
 	})
 }
 
+func TestFlowGraphInsertNode_mergeValidData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}},
+		},
+	}
+	iData := &insertData{
+		insertIDs:       make(map[UniqueID][]int64),
+		insertValidData: make(map[UniqueID]map[FieldID][]bool),
+	}
+	appendMsg := func(rowIDs []int64, validData []*internalpb.FieldValidData) {
+		msg := &msgstream.InsertMsg{
+			InsertRequest: internalpb.InsertRequest{SegmentID: defaultSegmentID, RowIDs: rowIDs, ValidData: validData},
+		}
+		iData.mergeValidData(schema, msg)
+		iData.insertIDs[defaultSegmentID] = append(iData.insertIDs[defaultSegmentID], rowIDs...)
+	}
+
+	// all valid, no validity is kept
+	appendMsg([]int64{1, 2}, nil)
+	assert.Nil(t, iData.insertValidData[defaultSegmentID][101])
+	appendMsg([]int64{3, 4}, []*internalpb.FieldValidData{{FieldID: 101, ValidData: []bool{false, true}}})
+	appendMsg([]int64{5}, nil)
+	assert.Equal(t, []bool{true, true, false, true, true}, iData.insertValidData[defaultSegmentID][101])
+	_, ok := iData.insertValidData[defaultSegmentID][100]
+	assert.False(t, ok)
+}
+
+func TestFlowGraphInsertNode_fillAddedFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Int64,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "7"}}},
+		},
+	}
+	msg := &msgstream.InsertMsg{
+		InsertRequest: internalpb.InsertRequest{
+			RowIDs:  []int64{1, 2},
+			Version: internalpb.InsertDataVersion_ColumnBased,
+			FieldsData: []*schemapb.FieldData{
+				{FieldId: 100, Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{Data: []int64{1, 2}}}}}},
+			},
+		},
+	}
+
+	err := fillAddedFields(schema, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(msg.GetFieldsData()))
+	assert.Equal(t, int64(101), msg.GetFieldsData()[1].GetFieldId())
+	assert.Equal(t, []int32{0, 0}, msg.GetFieldsData()[1].GetScalars().GetIntData().GetData())
+	assert.Equal(t, []int64{7, 7}, msg.GetFieldsData()[2].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []*internalpb.FieldValidData{{FieldID: 101, ValidData: []bool{false, false}}}, msg.GetValidData())
+
+	// fields carried by the msg are kept
+	err = fillAddedFields(schema, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(msg.GetFieldsData()))
+}
+
 func TestFlowGraphInsertNode_operate(t *testing.T) {
 	schema := genTestCollectionSchema()
 
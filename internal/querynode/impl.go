@@ -1380,6 +1380,7 @@ func (node *QueryNode) SyncDistribution(ctx context.Context, req *querypb.SyncDi
 				Scope:      querypb.DataScope_Historical,
 			}, true)
 		case querypb.SyncType_Set:
+			shardCluster.SetClusteringKeyRange(action.GetSegmentID(), action.GetClusteringKeyRange())
 			shardCluster.SyncSegments([]*querypb.ReplicaSegmentsInfo{
 				{
 					NodeId:      action.GetNodeID(),
@@ -1402,3 +1403,42 @@ func (node *QueryNode) SyncDistribution(ctx context.Context, req *querypb.SyncDi
 		Reason:    "",
 	}, nil
 }
+
+// UpdateSchema appends the fields added to a loaded collection, the rows already in the segments
+// take the default value of the added fields or null.
+func (node *QueryNode) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	// check node healthy
+	code := node.stateCode.Load().(commonpb.StateCode)
+	if code != commonpb.StateCode_Healthy {
+		err := fmt.Errorf("query node %d is not ready", paramtable.GetNodeID())
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}
+		return status, nil
+	}
+	// check target matches
+	if req.GetBase().GetTargetID() != paramtable.GetNodeID() {
+		status := &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_NodeIDNotMatch,
+			Reason:    common.WrapNodeIDNotMatchMsg(req.GetBase().GetTargetID(), paramtable.GetNodeID()),
+		}
+		return status, nil
+	}
+
+	if !node.metaReplica.hasCollection(req.GetCollectionID()) {
+		log.Info("collection not loaded, skip updating schema")
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+	}
+	err := node.metaReplica.updateCollectionSchema(req.GetCollectionID(), req.GetSchema())
+	if err != nil {
+		log.Warn("failed to update collection schema", zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	log.Info("update collection schema done", zap.Int("fields", len(req.GetSchema().GetFields())))
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
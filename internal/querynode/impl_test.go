@@ -28,8 +28,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -1080,3 +1082,59 @@ func TestGetDataDistribution(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_NodeIDNotMatch, resp.GetStatus().GetErrorCode())
 	})
 }
+
+func TestUpdateSchema(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	node, err := genSimpleQueryNode(ctx)
+	require.NoError(t, err)
+	defer node.Stop()
+
+	col, err := node.metaReplica.getCollectionByID(defaultCollectionID)
+	require.NoError(t, err)
+	schema := proto.Clone(col.Schema()).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:    1000,
+		Name:       "added",
+		DataType:   schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	})
+
+	t.Run("Target not match", func(t *testing.T) {
+		resp, err := node.UpdateSchema(ctx, &querypb.UpdateSchemaRequest{
+			Base:         &commonpb.MsgBase{TargetID: -1},
+			CollectionID: defaultCollectionID,
+			Schema:       schema,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NodeIDNotMatch, resp.GetErrorCode())
+	})
+
+	t.Run("Collection not loaded", func(t *testing.T) {
+		resp, err := node.UpdateSchema(ctx, &querypb.UpdateSchemaRequest{
+			Base:         &commonpb.MsgBase{TargetID: node.session.ServerID},
+			CollectionID: defaultCollectionID + 1,
+			Schema:       schema,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("Normal", func(t *testing.T) {
+		resp, err := node.UpdateSchema(ctx, &querypb.UpdateSchemaRequest{
+			Base:         &commonpb.MsgBase{TargetID: node.session.ServerID},
+			CollectionID: defaultCollectionID,
+			Schema:       schema,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Len(t, col.Schema().GetFields(), len(schema.GetFields()))
+	})
+
+	t.Run("QueryNode not healthy", func(t *testing.T) {
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		resp, err := node.UpdateSchema(ctx, &querypb.UpdateSchemaRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetErrorCode())
+	})
+}
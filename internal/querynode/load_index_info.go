@@ -44,8 +44,9 @@ type LoadIndexInfo struct {
 	cLoadIndexInfo C.CLoadIndexInfo
 }
 
-// newLoadIndexInfo returns a new LoadIndexInfo and error
-func newLoadIndexInfo() (*LoadIndexInfo, error) {
+// newLoadIndexInfo returns a new LoadIndexInfo and error, @encryptionKeys are the data keys exported by
+// the EncryptedChunkManager, which are used to decrypt the disk index files, it's empty if encryption is disabled.
+func newLoadIndexInfo(encryptionKeys string) (*LoadIndexInfo, error) {
 	var cLoadIndexInfo C.CLoadIndexInfo
 
 	// TODO::xige-16 support embedded milvus
@@ -57,6 +58,7 @@ func newLoadIndexInfo() (*LoadIndexInfo, error) {
 	cRootPath := C.CString(Params.MinioCfg.RootPath.GetValue())
 	cStorageType := C.CString(storageType)
 	cIamEndPoint := C.CString(Params.MinioCfg.IAMEndpoint.GetValue())
+	cEncryptionKeys := C.CString(encryptionKeys)
 	defer C.free(unsafe.Pointer(cAddress))
 	defer C.free(unsafe.Pointer(cBucketName))
 	defer C.free(unsafe.Pointer(cAccessKey))
@@ -64,6 +66,7 @@ func newLoadIndexInfo() (*LoadIndexInfo, error) {
 	defer C.free(unsafe.Pointer(cRootPath))
 	defer C.free(unsafe.Pointer(cStorageType))
 	defer C.free(unsafe.Pointer(cIamEndPoint))
+	defer C.free(unsafe.Pointer(cEncryptionKeys))
 	storageConfig := C.CStorageConfig{
 		address:          cAddress,
 		bucket_name:      cBucketName,
@@ -74,6 +77,7 @@ func newLoadIndexInfo() (*LoadIndexInfo, error) {
 		iam_endpoint:     cIamEndPoint,
 		useSSL:           C.bool(Params.MinioCfg.UseSSL.GetAsBool()),
 		useIAM:           C.bool(Params.MinioCfg.UseIAM.GetAsBool()),
+		encryption_keys:  cEncryptionKeys,
 	}
 
 	status := C.NewLoadIndexInfo(&cLoadIndexInfo, storageConfig)
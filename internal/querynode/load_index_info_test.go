@@ -46,7 +46,7 @@ func TestLoadIndexInfo(t *testing.T) {
 	indexPaths := make([]string, 0)
 	indexPaths = append(indexPaths, "IVF")
 
-	loadIndexInfo, err := newLoadIndexInfo()
+	loadIndexInfo, err := newLoadIndexInfo("")
 	assert.Nil(t, err)
 
 	indexInfo := &querypb.FieldIndexInfo{
@@ -43,6 +43,11 @@ func (l *loadSegmentsTask) PreExecute(ctx context.Context) error {
 	// init meta
 	collectionID := l.req.GetCollectionID()
 	l.node.metaReplica.addCollection(collectionID, l.req.GetSchema())
+	// the collection may have been loaded before fields were added
+	err = l.node.metaReplica.updateCollectionSchema(collectionID, l.req.GetSchema())
+	if err != nil {
+		return err
+	}
 	for _, partitionID := range l.req.GetLoadMeta().GetPartitionIDs() {
 		err = l.node.metaReplica.addPartition(collectionID, partitionID)
 		if err != nil {
@@ -67,6 +67,8 @@ type ReplicaInterface interface {
 	addCollection(collectionID UniqueID, schema *schemapb.CollectionSchema) *Collection
 	// removeCollection removes the collection from collectionReplica
 	removeCollection(collectionID UniqueID) error
+	// updateCollectionSchema appends the fields added to the collection to the collection and its segments
+	updateCollectionSchema(collectionID UniqueID, schema *schemapb.CollectionSchema) error
 	// getCollectionByID gets the collection which id is collectionID
 	getCollectionByID(collectionID UniqueID) (*Collection, error)
 	// hasCollection checks if collectionReplica has the collection which id is collectionID
@@ -210,6 +212,37 @@ func (replica *metaReplica) addCollection(collectionID UniqueID, schema *schemap
 	return newC
 }
 
+// updateCollectionSchema appends the fields added to the collection to the collection and its segments,
+// the rows existing in the segments take the default value of the added fields or null.
+func (replica *metaReplica) updateCollectionSchema(collectionID UniqueID, schema *schemapb.CollectionSchema) error {
+	replica.mu.RLock()
+	defer replica.mu.RUnlock()
+
+	collection, err := replica.getCollectionByIDPrivate(collectionID)
+	if err != nil {
+		return err
+	}
+	// no plan is created with the new schema until all segments are updated
+	collection.mu.Lock()
+	defer collection.mu.Unlock()
+
+	addedFields, err := collection.updateSchema(schema)
+	if err != nil || len(addedFields) == 0 {
+		return err
+	}
+	for _, segments := range []map[UniqueID]*Segment{replica.growingSegments, replica.sealedSegments} {
+		for _, segment := range segments {
+			if segment.collectionID != collectionID {
+				continue
+			}
+			if err := segment.addFields(collection); err != nil && !errors.Is(err, ErrSegmentUnhealthy) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // removeCollection removes the collection from collectionReplica
 func (replica *metaReplica) removeCollection(collectionID UniqueID) error {
 	replica.mu.Lock()
@@ -646,10 +679,16 @@ func (replica *metaReplica) setSegment(segment *Segment) error {
 		return fmt.Errorf("nil segment when setSegment")
 	}
 
-	_, err := replica.getCollectionByIDPrivate(segment.collectionID)
+	collection, err := replica.getCollectionByIDPrivate(segment.collectionID)
 	if err != nil {
 		return err
 	}
+	// fields may be added to the collection while the segment is loading
+	collection.mu.Lock()
+	defer collection.mu.Unlock()
+	if err := segment.addFields(collection); err != nil {
+		return err
+	}
 
 	return replica.addSegmentPrivate(segment)
 }
@@ -22,7 +22,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
@@ -61,6 +64,42 @@ func TestMetaReplica_collection(t *testing.T) {
 		assert.Equal(t, defaultCollectionID, targetCollection.ID())
 	})
 
+	t.Run("test updateCollectionSchema", func(t *testing.T) {
+		replica, err := genSimpleReplicaWithGrowingSegment()
+		assert.NoError(t, err)
+		defer replica.freeAll()
+
+		col, err := replica.getCollectionByID(defaultCollectionID)
+		assert.NoError(t, err)
+		schema := proto.Clone(col.Schema()).(*schemapb.CollectionSchema)
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+			FieldID:    1000,
+			Name:       "added",
+			DataType:   schemapb.DataType_Int64,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "7"}},
+		})
+		err = replica.updateCollectionSchema(defaultCollectionID, schema)
+		assert.NoError(t, err)
+		assert.Len(t, col.Schema().GetFields(), len(schema.GetFields()))
+
+		seg, err := replica.getSegmentByID(defaultSegmentID, segmentTypeGrowing)
+		assert.NoError(t, err)
+		assert.Len(t, seg.getSchema().GetFields(), len(schema.GetFields()))
+
+		// updating with the same schema is a no-op
+		err = replica.updateCollectionSchema(defaultCollectionID, schema)
+		assert.NoError(t, err)
+
+		// fields can only be appended
+		schema = proto.Clone(schema).(*schemapb.CollectionSchema)
+		schema.Fields = append(schema.Fields[1:], &schemapb.FieldSchema{FieldID: 1001, Name: "added2", DataType: schemapb.DataType_Int64})
+		err = replica.updateCollectionSchema(defaultCollectionID, schema)
+		assert.Error(t, err)
+
+		err = replica.updateCollectionSchema(defaultCollectionID+1, schema)
+		assert.Error(t, err)
+	})
+
 	t.Run("test hasCollection", func(t *testing.T) {
 		replica, err := genSimpleReplica()
 		assert.NoError(t, err)
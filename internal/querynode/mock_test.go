@@ -279,7 +279,7 @@ func genVectorFieldSchema(param vecFieldParam) *schemapb.FieldSchema {
 func genIndexBinarySet() ([][]byte, error) {
 	typeParams, indexParams := genIndexParams(IndexFaissIVFPQ, L2)
 
-	index, err := indexcgowrapper.NewCgoIndex(schemapb.DataType_FloatVector, typeParams, indexParams, genStorageConfig())
+	index, err := indexcgowrapper.NewCgoIndex(schemapb.DataType_FloatVector, typeParams, indexParams, genStorageConfig(), "")
 	if err != nil {
 		return nil, err
 	}
@@ -376,7 +376,7 @@ func generateAndSaveIndex(segmentID UniqueID, msgLength int, indexType, metricTy
 		})
 	}
 
-	index, err := indexcgowrapper.NewCgoIndex(schemapb.DataType_FloatVector, typeParams, indexParams, genStorageConfig())
+	index, err := indexcgowrapper.NewCgoIndex(schemapb.DataType_FloatVector, typeParams, indexParams, genStorageConfig(), "")
 	if err != nil {
 		return nil, err
 	}
@@ -29,7 +29,10 @@ import (
 	"fmt"
 	"unsafe"
 
+	"github.com/golang/protobuf/proto"
+
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
@@ -58,10 +61,40 @@ func createSearchPlan(col *Collection, dsl string) (*SearchPlan, error) {
 	return newPlan, nil
 }
 
+// pruneUnknownOutputFields removes the output fields unknown to the loaded collection from the serialized plan,
+// which happens when fields are added to the collection after it was loaded. Proxy fills the pruned fields
+// with their default values or nulls.
+func pruneUnknownOutputFields(col *Collection, expr []byte) ([]byte, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, plan); err != nil {
+		return nil, err
+	}
+
+	known := make(map[int64]struct{}, len(col.Schema().GetFields()))
+	for _, field := range col.Schema().GetFields() {
+		known[field.GetFieldID()] = struct{}{}
+	}
+	outputFieldIDs := make([]int64, 0, len(plan.GetOutputFieldIds()))
+	for _, fieldID := range plan.GetOutputFieldIds() {
+		if _, ok := known[fieldID]; ok {
+			outputFieldIDs = append(outputFieldIDs, fieldID)
+		}
+	}
+	if len(outputFieldIDs) == len(plan.GetOutputFieldIds()) {
+		return expr, nil
+	}
+	plan.OutputFieldIds = outputFieldIDs
+	return proto.Marshal(plan)
+}
+
 func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 	if col.collectionPtr == nil {
 		return nil, errors.New("nil collection ptr, collectionID = " + fmt.Sprintln(col.id))
 	}
+	expr, err := pruneUnknownOutputFields(col, expr)
+	if err != nil {
+		return nil, err
+	}
 	var cPlan C.CSearchPlan
 	status := C.CreateSearchPlanByExpr(col.collectionPtr, unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
 
@@ -96,17 +129,20 @@ type searchRequest struct {
 	timestamp         Timestamp
 	msgID             UniqueID
 	searchFieldID     UniqueID
+	predicates        *planpb.Expr // used to prune sealed segments by field stats
 }
 
 func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeholderGrp []byte) (*searchRequest, error) {
 	var err error
 	var plan *SearchPlan
+	var predicates *planpb.Expr
 	if req.Req.GetDslType() == commonpb.DslType_BoolExprV1 {
 		expr := req.Req.SerializedExprPlan
 		plan, err = createSearchPlanByExpr(collection, expr)
 		if err != nil {
 			return nil, err
 		}
+		predicates = getPlanPredicates(expr)
 	} else {
 		dsl := req.Req.GetDsl()
 		plan, err = createSearchPlan(collection, dsl)
@@ -143,6 +179,7 @@ func newSearchRequest(collection *Collection, req *querypb.SearchRequest, placeh
 		timestamp:         req.Req.GetTravelTimestamp(),
 		msgID:             req.GetReq().GetBase().GetMsgID(),
 		searchFieldID:     int64(fieldID),
+		predicates:        predicates,
 	}
 
 	return ret, nil
@@ -181,17 +218,22 @@ func parseSearchRequest(plan *SearchPlan, searchRequestBlob []byte) (*searchRequ
 type RetrievePlan struct {
 	cRetrievePlan C.CRetrievePlan
 	Timestamp     Timestamp
-	msgID         UniqueID // only used to debug.
+	msgID         UniqueID     // only used to debug.
+	predicates    *planpb.Expr // used to prune sealed segments by field stats
 }
 
 func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp, msgID UniqueID) (*RetrievePlan, error) {
 	col.mu.RLock()
 	defer col.mu.RUnlock()
 
+	expr, err := pruneUnknownOutputFields(col, expr)
+	if err != nil {
+		return nil, err
+	}
 	var cPlan C.CRetrievePlan
 	status := C.CreateRetrievePlanByExpr(col.collectionPtr, unsafe.Pointer(&expr[0]), (C.int64_t)(len(expr)), &cPlan)
 
-	err := HandleCStatus(&status, "Create retrieve plan by expr failed")
+	err = HandleCStatus(&status, "Create retrieve plan by expr failed")
 	if err != nil {
 		return nil, err
 	}
@@ -200,6 +242,7 @@ func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp,
 		cRetrievePlan: cPlan,
 		Timestamp:     timestamp,
 		msgID:         msgID,
+		predicates:    getPlanPredicates(expr),
 	}
 	return newPlan, nil
 }
@@ -252,6 +252,12 @@ func (node *QueryNode) Init() error {
 			initError = err
 			return
 		}
+		node.vectorStorage, err = storage.NewCachedChunkManagerWithParam(node.vectorStorage, Params, typeutil.QueryNodeRole, "")
+		if err != nil {
+			log.Error("QueryNode init local storage cache failed", zap.Error(err))
+			initError = err
+			return
+		}
 
 		node.etcdKV = etcdkv.NewEtcdKV(node.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue())
 		log.Info("queryNode try to connect etcd success", zap.Any("MetaRootPath", Params.EtcdCfg.MetaRootPath))
@@ -345,6 +351,10 @@ func (node *QueryNode) Stop() error {
 		node.queryShardService.close()
 	}
 
+	if cached, ok := storage.GetCachedChunkManager(node.vectorStorage); ok {
+		cached.Close()
+	}
+
 	node.session.Revoke(time.Second)
 	node.wg.Wait()
 	return nil
@@ -60,7 +60,7 @@ func retrieveHistorical(ctx context.Context, replica ReplicaInterface, plan *Ret
 		return retrieveResults, retrieveSegmentIDs, retrievePartIDs, err
 	}
 
-	retrieveResults, err = retrieveOnSegments(ctx, replica, segmentTypeSealed, collID, plan, retrieveSegmentIDs, vcm)
+	retrieveResults, err = retrieveOnSegments(ctx, replica, segmentTypeSealed, collID, plan, pruneSealedSegments(replica, plan.predicates, retrieveSegmentIDs), vcm)
 	return retrieveResults, retrievePartIDs, retrieveSegmentIDs, err
 }
 
@@ -95,7 +95,7 @@ func searchHistorical(ctx context.Context, replica ReplicaInterface, searchReq *
 	if err != nil {
 		return searchResults, searchSegmentIDs, searchPartIDs, err
 	}
-	searchResults, err = searchSegments(ctx, replica, segmentTypeSealed, searchReq, searchSegmentIDs)
+	searchResults, err = searchSegments(ctx, replica, segmentTypeSealed, searchReq, pruneSealedSegments(replica, searchReq.predicates, searchSegmentIDs))
 	return searchResults, searchPartIDs, searchSegmentIDs, err
 }
 
@@ -39,7 +39,6 @@ import (
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
-	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus/internal/metrics"
 	"github.com/milvus-io/milvus/internal/util/timerecord"
 
@@ -83,7 +82,8 @@ type Segment struct {
 	partitionID   UniqueID
 	collectionID  UniqueID
 	version       UniqueID
-	startPosition *internalpb.MsgPosition // for growing segment release
+	startPosition *internalpb.MsgPosition    // for growing segment release
+	schema        *schemapb.CollectionSchema // schema of the collection when the segment is created or updated, protected by mut
 
 	vChannelID   Channel
 	lastMemSize  int64
@@ -99,8 +99,11 @@ type Segment struct {
 
 	statLock sync.Mutex
 	// only used by sealed segments
-	currentStat  *storage.PkStatistics
-	historyStats []*storage.PkStatistics
+	currentStat       *storage.PkStatistics
+	historyStats      []*storage.PkStatistics
+	bloomFilterParams storage.BloomFilterParams
+	// zone maps of scalar fields, only used by sealed segments
+	fieldStats map[UniqueID]*storage.FieldStats
 }
 
 // ID returns the identity number.
@@ -116,6 +119,13 @@ func (s *Segment) getIDBinlogRowSizes() []int64 {
 	return s.idBinlogRowSizes
 }
 
+// getSchema returns the schema of the collection when the segment is created or updated.
+func (s *Segment) getSchema() *schemapb.CollectionSchema {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	return s.schema
+}
+
 func (s *Segment) setRecentlyModified(modify bool) {
 	s.recentlyModified.Store(modify)
 }
@@ -209,10 +219,12 @@ func newSegment(collection *Collection,
 		version:           version,
 		startPosition:     startPosition,
 		vChannelID:        vChannelID,
+		schema:            collection.Schema(),
 		indexedFieldInfos: typeutil.NewConcurrentMap[int64, *IndexedFieldInfo](),
 		recentlyModified:  atomic.NewBool(false),
 		destroyed:         atomic.NewBool(false),
 		historyStats:      []*storage.PkStatistics{},
+		bloomFilterParams: storage.GetBloomFilterParams(collection.Schema()),
 	}
 
 	return segment, nil
@@ -239,6 +251,7 @@ func deleteSegment(segment *Segment) {
 
 	segment.currentStat = nil
 	segment.historyStats = nil
+	segment.fieldStats = nil
 
 	log.Info("delete segment from memory",
 		zap.Int64("collectionID", segment.collectionID),
@@ -590,19 +603,9 @@ func (s *Segment) updateBloomFilter(pks []primaryKey) {
 	s.statLock.Lock()
 	defer s.statLock.Unlock()
 	s.InitCurrentStat()
-	buf := make([]byte, 8)
 	for _, pk := range pks {
-		s.currentStat.UpdateMinMax(pk)
-		switch pk.Type() {
-		case schemapb.DataType_Int64:
-			int64Value := pk.(*int64PrimaryKey).Value
-			common.Endian.PutUint64(buf, uint64(int64Value))
-			s.currentStat.PkFilter.Add(buf)
-		case schemapb.DataType_VarChar:
-			stringValue := pk.(*varCharPrimaryKey).Value
-			s.currentStat.PkFilter.AddString(stringValue)
-		default:
-			log.Error("failed to update bloomfilter", zap.Any("PK type", pk.Type()))
+		if err := s.currentStat.AddPK(pk); err != nil {
+			log.Error("failed to update bloomfilter", zap.Any("PK type", pk.Type()), zap.Error(err))
 			panic("failed to update bloomfilter")
 		}
 	}
@@ -610,22 +613,35 @@ func (s *Segment) updateBloomFilter(pks []primaryKey) {
 
 func (s *Segment) InitCurrentStat() {
 	if s.currentStat == nil {
-		s.currentStat = &storage.PkStatistics{
-			PkFilter: bloom.NewWithEstimates(storage.BloomFilterSize, storage.MaxBloomFalsePositive),
-		}
+		s.currentStat = storage.NewPkStatistics(s.bloomFilterParams)
 	}
 }
 
+func (s *Segment) setFieldStats(fieldStats map[UniqueID]*storage.FieldStats) {
+	s.statLock.Lock()
+	defer s.statLock.Unlock()
+	s.fieldStats = fieldStats
+}
+
+func (s *Segment) getFieldStats() map[UniqueID]*storage.FieldStats {
+	s.statLock.Lock()
+	defer s.statLock.Unlock()
+	return s.fieldStats
+}
+
 // check if PK exists is current
 func (s *Segment) isPKExist(pk primaryKey) bool {
 	s.statLock.Lock()
-	defer s.statLock.Unlock()
 	if s.currentStat != nil && s.currentStat.PkExist(pk) {
+		s.statLock.Unlock()
 		return true
 	}
+	historyStats := s.historyStats
+	s.statLock.Unlock()
 
-	// for sealed, if one of the stats shows it exist, then we have to check it
-	for _, historyStat := range s.historyStats {
+	// for sealed, if one of the stats shows it exist, then we have to check it.
+	// history stats are not modified but may load their bloom filters lazily, so they are tested outside the lock
+	for _, historyStat := range historyStats {
 		if historyStat.PkExist(pk) {
 			return true
 		}
@@ -718,6 +734,114 @@ func (s *Segment) segmentInsert(offset int64, entityIDs []UniqueID, timestamps [
 	return nil
 }
 
+// addFields appends the fields added to the collection after the segment was created, the rows existing
+// in the segment take the default value of the fields or null. It shall be called with the mu of collection locked.
+func (s *Segment) addFields(collection *Collection) error {
+	/*
+		CStatus
+		AddField(CSegmentInterface c_segment,
+		         CCollection c_collection,
+		         int64_t field_id,
+		         const void* default_value_blob,
+		         int64_t blob_size);
+	*/
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if !s.healthy() {
+		return fmt.Errorf("%w(segmentID=%d)", ErrSegmentUnhealthy, s.segmentID)
+	}
+
+	existed := make(map[FieldID]struct{}, len(s.schema.GetFields()))
+	for _, field := range s.schema.GetFields() {
+		existed[field.GetFieldID()] = struct{}{}
+	}
+	schema := collection.Schema()
+	for _, field := range schema.GetFields() {
+		if _, ok := existed[field.GetFieldID()]; ok {
+			continue
+		}
+		defaultValue, err := genDefaultValueBlob(field)
+		if err != nil {
+			return err
+		}
+		var cDefaultValue unsafe.Pointer
+		if len(defaultValue) > 0 {
+			cDefaultValue = unsafe.Pointer(&defaultValue[0])
+		}
+		status := C.AddField(s.segmentPtr, collection.collectionPtr, C.int64_t(field.GetFieldID()),
+			cDefaultValue, C.int64_t(len(defaultValue)))
+		if err := HandleCStatus(&status, "AddField failed"); err != nil {
+			return err
+		}
+		log.Info("add field to segment",
+			zap.Int64("collectionID", s.collectionID),
+			zap.Int64("segmentID", s.segmentID),
+			zap.Int64("fieldID", field.GetFieldID()))
+	}
+	s.schema = schema
+	return nil
+}
+
+// genDefaultValueBlob returns the serialized single row field data of the default value of field,
+// or nil if the field has no default value.
+func genDefaultValueBlob(field *schemapb.FieldSchema) ([]byte, error) {
+	_, ok, err := typeutil.GetDefaultValue(field)
+	if err != nil || !ok {
+		return nil, err
+	}
+	data, err := storage.GenDefaultFieldData(field, 1)
+	if err != nil {
+		return nil, err
+	}
+	record, err := storage.TransferInsertDataToInsertRecord(&storage.InsertData{
+		Data: map[FieldID]storage.FieldData{field.GetFieldID(): data},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(record.GetFieldsData()[0])
+}
+
+// segmentInsertValidData fills the validity of nullable fields for the rows reserved by segmentPreInsert,
+// it must be called before segmentInsert of the same rows. Fields without validity are all valid.
+func (s *Segment) segmentInsertValidData(offset int64, numOfRows int, validData map[FieldID][]bool) error {
+	/*
+		CStatus
+		InsertValidData(CSegmentInterface c_segment,
+		                int64_t reserved_offset,
+		                int64_t size,
+		                int64_t field_id,
+		                const bool* valid_data);
+	*/
+	if s.getType() != segmentTypeGrowing {
+		return fmt.Errorf("unexpected segmentType when segmentInsertValidData, segmentType = %s", s.segmentType.String())
+	}
+
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	if !s.healthy() {
+		return fmt.Errorf("%w(segmentID=%d)", ErrSegmentUnhealthy, s.segmentID)
+	}
+
+	for fieldID, valid := range validData {
+		if len(valid) == 0 {
+			continue
+		}
+		if len(valid) != numOfRows {
+			return fmt.Errorf("length of valid data %d mismatch the rows %d of field %d", len(valid), numOfRows, fieldID)
+		}
+		status := C.InsertValidData(s.segmentPtr,
+			C.int64_t(offset),
+			C.int64_t(numOfRows),
+			C.int64_t(fieldID),
+			(*C.bool)(unsafe.Pointer(&valid[0])))
+		if err := HandleCStatus(&status, "InsertValidData failed"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Segment) segmentDelete(offset int64, entityIDs []primaryKey, timestamps []Timestamp) error {
 	/*
 		CStatus
@@ -828,6 +952,37 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int64, data *sche
 	return nil
 }
 
+func (s *Segment) segmentLoadFieldValidData(fieldID int64, rowCount int64, validData []bool) error {
+	/*
+		CStatus
+		LoadFieldValidData(CSegmentInterface c_segment, int64_t field_id, const bool* valid_data, int64_t row_count);
+	*/
+	if s.getType() != segmentTypeSealed {
+		errMsg := fmt.Sprintln("segmentLoadFieldValidData failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
+		return errors.New(errMsg)
+	}
+	if int64(len(validData)) != rowCount {
+		return fmt.Errorf("length of valid data %d mismatch the row count %d of field %d", len(validData), rowCount, fieldID)
+	}
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	if !s.healthy() {
+		return fmt.Errorf("%w(segmentID=%d)", ErrSegmentUnhealthy, s.segmentID)
+	}
+
+	status := C.LoadFieldValidData(s.segmentPtr, C.int64_t(fieldID), (*C.bool)(unsafe.Pointer(&validData[0])), C.int64_t(rowCount))
+	if err := HandleCStatus(&status, "LoadFieldValidData failed"); err != nil {
+		return err
+	}
+
+	log.Info("load field valid data done",
+		zap.Int64("fieldID", fieldID),
+		zap.Int64("row count", rowCount),
+		zap.Int64("segmentID", s.ID()))
+
+	return nil
+}
+
 func (s *Segment) segmentLoadDeletedRecord(primaryKeys []primaryKey, timestamps []Timestamp, rowCount int64) error {
 	s.mut.RLock()
 	defer s.mut.RUnlock()
@@ -893,8 +1048,8 @@ func (s *Segment) segmentLoadDeletedRecord(primaryKeys []primaryKey, timestamps
 	return nil
 }
 
-func (s *Segment) segmentLoadIndexData(bytesIndex [][]byte, indexInfo *querypb.FieldIndexInfo, fieldType schemapb.DataType) error {
-	loadIndexInfo, err := newLoadIndexInfo()
+func (s *Segment) segmentLoadIndexData(bytesIndex [][]byte, indexInfo *querypb.FieldIndexInfo, fieldType schemapb.DataType, encryptionKeys string) error {
+	loadIndexInfo, err := newLoadIndexInfo(encryptionKeys)
 	defer deleteLoadIndexInfo(loadIndexInfo)
 	if err != nil {
 		return err
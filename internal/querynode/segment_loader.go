@@ -24,6 +24,7 @@ import (
 	"path"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"time"
 
@@ -152,7 +153,9 @@ func (loader *segmentLoader) LoadSegment(ctx context.Context, req *querypb.LoadS
 			return nil, err
 		}
 
+		collection.mu.RLock()
 		segment, err := newSegment(collection, segmentID, partitionID, collectionID, vChannelID, segmentType, req.GetVersion(), info.StartPosition)
+		collection.mu.RUnlock()
 		if err != nil {
 			log.Error("load segment failed when create new segment",
 				zap.Int64("partitionID", partitionID),
@@ -272,9 +275,15 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 		if err := loader.loadIndexedFieldData(ctx, segment, indexedFieldInfos); err != nil {
 			return err
 		}
+		if err := loader.loadIndexedFieldValidData(ctx, segment, indexedFieldInfos, loadInfo); err != nil {
+			return err
+		}
 		if err := loader.loadSealedSegmentFields(ctx, segment, fieldBinlogs, loadInfo); err != nil {
 			return err
 		}
+		if err := loader.loadMissingFields(segment, loadInfo); err != nil {
+			return err
+		}
 	} else {
 		if err := loader.loadGrowingSegmentFields(ctx, segment, loadInfo.BinlogPaths); err != nil {
 			return err
@@ -292,6 +301,15 @@ func (loader *segmentLoader) loadFiles(ctx context.Context, segment *Segment,
 		}
 	}
 
+	if segment.getType() == segmentTypeSealed {
+		log.Info("loading field stats...", zap.Int64("segmentID", segmentID))
+		fieldStatsBinlogs := loader.filterFieldStatsBinlogs(loadInfo.Statslogs, pkFieldID)
+		err = loader.loadSegmentFieldStats(ctx, segment, fieldStatsBinlogs, loadInfo.GetNumOfRows())
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Info("loading delta...", zap.Int64("segmentID", segmentID))
 	err = loader.loadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
 	return err
@@ -319,7 +337,8 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 
 	// change all field bin log loading into concurrent
 	loadFutures := make([]*concurrency.Future, 0, len(fieldBinlogs))
-	for _, fieldBinlog := range fieldBinlogs {
+	// columnar logs are shared by all fields, load each of them once
+	for _, fieldBinlog := range storage.GroupColumnarBinlogs(fieldBinlogs) {
 		futures := loader.loadFieldBinlogsAsync(ctx, fieldBinlog)
 		loadFutures = append(loadFutures, futures...)
 	}
@@ -352,6 +371,9 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 		if !ok {
 			return errors.New("cannot get timestamps from insert data")
 		}
+		if err := storage.FillMissingFields(segment.getSchema(), insertData); err != nil {
+			return err
+		}
 		utss := make([]uint64, tsData.RowNum())
 		for i := 0; i < tsData.RowNum(); i++ {
 			utss[i] = uint64(tsData.GetRow(i).(int64))
@@ -372,11 +394,12 @@ func (loader *segmentLoader) loadGrowingSegmentFields(ctx context.Context, segme
 
 func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segment *Segment, fields []*datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
 	runningGroup, groupCtx := errgroup.WithContext(ctx)
+	columnarFutures := loader.loadColumnarBinlogsAsync(groupCtx, fields)
 	for _, field := range fields {
 		fieldBinLog := field
 		runningGroup.Go(func() error {
 			// reload data from dml channel
-			return loader.loadSealedField(groupCtx, segment, fieldBinLog, loadInfo)
+			return loader.loadSealedField(groupCtx, segment, fieldBinLog, loadInfo, columnarFutures)
 		})
 	}
 	err := runningGroup.Wait()
@@ -393,17 +416,82 @@ func (loader *segmentLoader) loadSealedSegmentFields(ctx context.Context, segmen
 	return nil
 }
 
+// loadColumnarBinlogsAsync loads the columnar logs of @fields, which are shared by the fields, once.
+func (loader *segmentLoader) loadColumnarBinlogsAsync(ctx context.Context, fields []*datapb.FieldBinlog) map[string]*concurrency.Future {
+	futures := make(map[string]*concurrency.Future)
+	for _, field := range storage.GroupColumnarBinlogs(fields) {
+		if field.GetFieldID() != common.ColumnarLogFieldID {
+			continue
+		}
+		for i, future := range loader.loadFieldBinlogsAsync(ctx, field) {
+			futures[field.GetBinlogs()[i].GetLogPath()] = future
+		}
+	}
+	return futures
+}
+
 // async load field of sealed segment
-func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo) error {
+func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo,
+	columnarFutures map[string]*concurrency.Future) error {
+	insertData, err := loader.readSealedField(ctx, segment, field, loadInfo, columnarFutures)
+	if err != nil {
+		return err
+	}
+	return loader.loadSealedSegments(segment, insertData)
+}
+
+// loadIndexedFieldValidData loads the validity of nullable fields whose raw data is replaced by index,
+// the index doesn't keep which rows are null so the validity is read from the binlogs.
+func (loader *segmentLoader) loadIndexedFieldValidData(ctx context.Context, segment *Segment, indexedFieldInfos map[int64]*IndexedFieldInfo,
+	loadInfo *querypb.SegmentLoadInfo) error {
+	fields := make([]*datapb.FieldBinlog, 0)
+	for _, field := range segment.getSchema().GetFields() {
+		if fieldInfo, ok := indexedFieldInfos[field.GetFieldID()]; ok && typeutil.IsFieldNullable(field) {
+			fields = append(fields, fieldInfo.fieldBinlog)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	columnarFutures := loader.loadColumnarBinlogsAsync(ctx, fields)
+	for _, field := range fields {
+		insertData, err := loader.readSealedField(ctx, segment, field, loadInfo, columnarFutures)
+		if err != nil {
+			return err
+		}
+		validData := insertData.Data[field.GetFieldID()].GetValidData()
+		if len(validData) == 0 {
+			continue
+		}
+		if err := segment.segmentLoadFieldValidData(field.GetFieldID(), loadInfo.GetNumOfRows(), validData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSealedField reads the binlogs of a field of sealed segment into insert data
+func (loader *segmentLoader) readSealedField(ctx context.Context, segment *Segment, field *datapb.FieldBinlog, loadInfo *querypb.SegmentLoadInfo,
+	columnarFutures map[string]*concurrency.Future) (*storage.InsertData, error) {
 	iCodec := storage.InsertCodec{}
 
+	rowBinlogs := &datapb.FieldBinlog{FieldID: field.GetFieldID()}
+	var futures []*concurrency.Future
+	for _, binlog := range field.GetBinlogs() {
+		if future, ok := columnarFutures[binlog.GetLogPath()]; ok {
+			futures = append(futures, future)
+		} else {
+			rowBinlogs.Binlogs = append(rowBinlogs.Binlogs, binlog)
+		}
+	}
 	// Avoid consuming too much memory if no CPU worker ready,
 	// acquire a CPU worker before load field binlogs
-	futures := loader.loadFieldBinlogsAsync(ctx, field)
+	futures = append(futures, loader.loadFieldBinlogsAsync(ctx, rowBinlogs)...)
 
 	err := concurrency.AwaitAll(futures...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	blobs := make([]*storage.Blob, len(futures))
@@ -411,18 +499,72 @@ func (loader *segmentLoader) loadSealedField(ctx context.Context, segment *Segme
 		blob := future.Value().(*storage.Blob)
 		blobs[index] = blob
 	}
+	// keep the flush order of binlogs of both formats
+	sort.Sort(storage.BlobList(blobs))
 
 	insertData := storage.InsertData{
 		Data: make(map[int64]storage.FieldData),
 	}
-	_, _, _, err = iCodec.DeserializeInto(blobs, int(loadInfo.GetNumOfRows()), &insertData)
+	_, _, _, err = iCodec.DeserializeFieldsInto(blobs, int(loadInfo.GetNumOfRows()), &insertData, field.GetFieldID())
 
 	if err != nil {
 		log.Warn("failed to load sealed field", zap.Int64("SegmentId", segment.segmentID), zap.Error(err))
-		return err
+		return nil, err
+	}
+
+	if err := loader.fillMissingRows(segment, &insertData, int(loadInfo.GetNumOfRows())); err != nil {
+		return nil, err
+	}
+
+	return &insertData, nil
+}
+
+// fillMissingRows completes the fields added to the collection while the segment was growing,
+// the leading rows written before the field existed take the default value of the field or null.
+func (loader *segmentLoader) fillMissingRows(segment *Segment, insertData *storage.InsertData, numRows int) error {
+	var err error
+	for _, field := range segment.getSchema().GetFields() {
+		data, ok := insertData.Data[field.GetFieldID()]
+		if !ok || data.RowNum() >= numRows {
+			continue
+		}
+		insertData.Data[field.GetFieldID()], err = storage.FillMissingRows(field, data, numRows)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return loader.loadSealedSegments(segment, &insertData)
+// loadMissingFields loads the fields added to the collection after the segment was flushed,
+// all rows of the segment take the default value of the field or null.
+func (loader *segmentLoader) loadMissingFields(segment *Segment, loadInfo *querypb.SegmentLoadInfo) error {
+	loaded := make(map[int64]struct{}, len(loadInfo.GetBinlogPaths()))
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		loaded[fieldBinlog.GetFieldID()] = struct{}{}
+	}
+	insertData := &storage.InsertData{
+		Data: make(map[int64]storage.FieldData),
+	}
+	for _, field := range segment.getSchema().GetFields() {
+		if _, ok := loaded[field.GetFieldID()]; ok || field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		data, err := storage.GenDefaultFieldData(field, int(loadInfo.GetNumOfRows()))
+		if err != nil {
+			return err
+		}
+		insertData.Data[field.GetFieldID()] = data
+	}
+	if len(insertData.Data) == 0 {
+		return nil
+	}
+
+	log.Info("fill fields missing from binlogs",
+		zap.Int64("collection", segment.collectionID),
+		zap.Int64("segment", segment.segmentID),
+		zap.Int("len(field)", len(insertData.Data)))
+	return loader.loadSealedSegments(segment, insertData)
 }
 
 // Load binlogs concurrently into memory from KV storage asyncly
@@ -513,7 +655,15 @@ func (loader *segmentLoader) loadFieldIndexData(ctx context.Context, segment *Se
 	indexParams := funcutil.KeyValuePair2Map(indexInfo.IndexParams)
 	// load on disk index
 	if indexParams["index_type"] == indexparamcheck.IndexDISKANN {
-		return segment.segmentLoadIndexData(nil, indexInfo, fieldType)
+		// segcore reads the disk index files directly, hand over the data keys to decrypt them
+		var encryptionKeys string
+		if ecm, ok := storage.GetEncryptedChunkManager(loader.cm); ok {
+			encryptionKeys, err = ecm.ExportDataKeys(ctx, segment.collectionID)
+			if err != nil {
+				return err
+			}
+		}
+		return segment.segmentLoadIndexData(nil, indexInfo, fieldType, encryptionKeys)
 	}
 	// load in memory index
 	for _, p := range indexInfo.IndexFilePaths {
@@ -561,7 +711,7 @@ func (loader *segmentLoader) loadFieldIndexData(ctx context.Context, segment *Se
 		indexBuffer = append(indexBuffer, bs)
 	}
 
-	return segment.segmentLoadIndexData(indexBuffer, indexInfo, fieldType)
+	return segment.segmentLoadIndexData(indexBuffer, indexInfo, fieldType, "")
 }
 
 func (loader *segmentLoader) loadGrowingSegments(segment *Segment,
@@ -609,6 +759,14 @@ func (loader *segmentLoader) loadGrowingSegments(segment *Segment,
 	segment.updateBloomFilter(pks)
 
 	// 3. do insert
+	validData := make(map[FieldID][]bool)
+	for fieldID, fieldData := range insertData.Data {
+		validData[fieldID] = fieldData.GetValidData()
+	}
+	err = segment.segmentInsertValidData(offset, numOfRecords, validData)
+	if err != nil {
+		return err
+	}
 	err = segment.segmentInsert(offset, ids, timestamps, insertRecord)
 	if err != nil {
 		return err
@@ -636,6 +794,11 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 			// TODO: return or continue?
 			return err
 		}
+		if validData := insertData.Data[fieldID].GetValidData(); len(validData) > 0 {
+			if err := segment.segmentLoadFieldValidData(fieldID, numRows, validData); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -647,31 +810,74 @@ func (loader *segmentLoader) loadSegmentBloomFilter(ctx context.Context, segment
 	}
 
 	startTs := time.Now()
+	// bloom filters of binary stats logs are loaded lazily, only the pk range is read here
+	for _, path := range binlogPaths {
+		pkStat, err := storage.LoadPkStatistics(ctx, loader.cm, path)
+		if err != nil {
+			log.Warn("failed to load pk stats", zap.String("path", path), zap.Error(err))
+			return err
+		}
+		segment.historyStats = append(segment.historyStats, pkStat)
+	}
+	log.Info("Successfully load pk stats", zap.Any("time", time.Since(startTs)), zap.Int64("segment", segment.segmentID), zap.Int("statsLogs", len(binlogPaths)))
+	return nil
+}
+
+// filterFieldStatsBinlogs returns the field stats binlogs, which are the stats binlogs of the user fields except the primary key
+func (loader *segmentLoader) filterFieldStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) []string {
+	result := make([]string, 0)
+	for _, fieldBinlog := range fieldBinlogs {
+		if fieldBinlog.FieldID == pkFieldID || fieldBinlog.FieldID < common.StartOfUserFieldID {
+			continue
+		}
+		for _, binlog := range fieldBinlog.Binlogs {
+			result = append(result, binlog.GetLogPath())
+		}
+	}
+	return result
+}
+
+// loadSegmentFieldStats loads the zone maps of the segment, the stats not covering all @numRows rows
+// are dropped since they could prune the segment by mistake, e.g. the segments flushed by old versions.
+func (loader *segmentLoader) loadSegmentFieldStats(ctx context.Context, segment *Segment, binlogPaths []string, numRows int64) error {
+	if len(binlogPaths) == 0 {
+		return nil
+	}
+
 	values, err := loader.cm.MultiRead(ctx, binlogPaths)
 	if err != nil {
 		return err
 	}
-	blobs := make([]*storage.Blob, 0)
+	blobs := make([]*storage.Blob, 0, len(values))
 	for i := 0; i < len(values); i++ {
 		blobs = append(blobs, &storage.Blob{Value: values[i]})
 	}
 
-	stats, err := storage.DeserializeStats(blobs)
+	stats, err := storage.DeserializeFieldStats(blobs)
 	if err != nil {
-		log.Warn("failed to deserialize stats", zap.Error(err))
+		log.Warn("failed to deserialize field stats", zap.Error(err))
 		return err
 	}
-	var size uint
+	fieldStats := make(map[UniqueID]*storage.FieldStats)
 	for _, stat := range stats {
-		pkStat := &storage.PkStatistics{
-			PkFilter: stat.BF,
-			MinPK:    stat.MinPk,
-			MaxPK:    stat.MaxPk,
+		merged, ok := fieldStats[stat.FieldID]
+		if !ok {
+			merged = storage.NewFieldStats(stat.FieldID, stat.Type)
+			fieldStats[stat.FieldID] = merged
+		}
+		merged.Merge(stat)
+	}
+	for fieldID, stat := range fieldStats {
+		if stat.RowCount != numRows {
+			log.Warn("field stats don't cover all the rows of segment, skip it",
+				zap.Int64("segmentID", segment.segmentID),
+				zap.Int64("fieldID", fieldID),
+				zap.Int64("statsRows", stat.RowCount),
+				zap.Int64("segmentRows", numRows))
+			delete(fieldStats, fieldID)
 		}
-		size += stat.BF.Cap()
-		segment.historyStats = append(segment.historyStats, pkStat)
 	}
-	log.Info("Successfully load pk stats", zap.Any("time", time.Since(startTs)), zap.Int64("segment", segment.segmentID), zap.Uint("size", size))
+	segment.setFieldStats(fieldStats)
 	return nil
 }
 
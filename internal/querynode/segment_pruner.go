@@ -0,0 +1,239 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"math"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// getPlanPredicates returns the filter of the serialized plan, nil if the plan has no filter or is malformed.
+func getPlanPredicates(expr []byte) *planpb.Expr {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(expr, plan); err != nil {
+		return nil
+	}
+	if plan.GetVectorAnns() != nil {
+		return plan.GetVectorAnns().GetPredicates()
+	}
+	return plan.GetPredicates()
+}
+
+// pruneSealedSegments removes the sealed segments whose field stats show that no row can satisfy @predicates.
+func pruneSealedSegments(replica ReplicaInterface, predicates *planpb.Expr, segIDs []UniqueID) []UniqueID {
+	if predicates == nil {
+		return segIDs
+	}
+	result := make([]UniqueID, 0, len(segIDs))
+	for _, segID := range segIDs {
+		seg, err := replica.getSegmentByID(segID, segmentTypeSealed)
+		if err == nil && skipByFieldStats(predicates, seg.getFieldStats()) {
+			continue
+		}
+		result = append(result, segID)
+	}
+	if len(result) < len(segIDs) {
+		log.Debug("prune sealed segments by field stats",
+			zap.Int("segments", len(segIDs)),
+			zap.Int("pruned", len(segIDs)-len(result)))
+	}
+	return result
+}
+
+// skipByFieldStats returns true if no row of a segment with zone maps @fieldStats can satisfy @expr.
+// Null values never satisfy a comparison, so the ranges only cover the non-null values.
+func skipByFieldStats(expr *planpb.Expr, fieldStats map[UniqueID]*storage.FieldStats) bool {
+	if expr == nil || len(fieldStats) == 0 {
+		return false
+	}
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return skipByFieldStats(e.BinaryExpr.GetLeft(), fieldStats) || skipByFieldStats(e.BinaryExpr.GetRight(), fieldStats)
+		case planpb.BinaryExpr_LogicalOr:
+			return skipByFieldStats(e.BinaryExpr.GetLeft(), fieldStats) && skipByFieldStats(e.BinaryExpr.GetRight(), fieldStats)
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		stats, ok := fieldStats[e.UnaryRangeExpr.GetColumnInfo().GetFieldId()]
+		if !ok {
+			return false
+		}
+		return skipUnaryRange(stats, e.UnaryRangeExpr.GetOp(), e.UnaryRangeExpr.GetValue())
+	case *planpb.Expr_BinaryRangeExpr:
+		stats, ok := fieldStats[e.BinaryRangeExpr.GetColumnInfo().GetFieldId()]
+		if !ok {
+			return false
+		}
+		lowerOp, upperOp := planpb.OpType_GreaterThan, planpb.OpType_LessThan
+		if e.BinaryRangeExpr.GetLowerInclusive() {
+			lowerOp = planpb.OpType_GreaterEqual
+		}
+		if e.BinaryRangeExpr.GetUpperInclusive() {
+			upperOp = planpb.OpType_LessEqual
+		}
+		return skipUnaryRange(stats, lowerOp, e.BinaryRangeExpr.GetLowerValue()) ||
+			skipUnaryRange(stats, upperOp, e.BinaryRangeExpr.GetUpperValue())
+	case *planpb.Expr_TermExpr:
+		stats, ok := fieldStats[e.TermExpr.GetColumnInfo().GetFieldId()]
+		if !ok {
+			return false
+		}
+		for _, value := range e.TermExpr.GetValues() {
+			if !skipUnaryRange(stats, planpb.OpType_Equal, value) {
+				return false
+			}
+		}
+		return true
+	case *planpb.Expr_NullExpr:
+		stats, ok := fieldStats[e.NullExpr.GetColumnInfo().GetFieldId()]
+		if !ok {
+			return false
+		}
+		switch e.NullExpr.GetOp() {
+		case planpb.NullExpr_IsNull:
+			return stats.NullCount == 0
+		case planpb.NullExpr_IsNotNull:
+			return stats.NullCount == stats.RowCount
+		}
+	}
+	return false
+}
+
+// skipUnaryRange returns true if no value of @stats can satisfy `field @op @value`.
+func skipUnaryRange(stats *storage.FieldStats, op planpb.OpType, value *planpb.GenericValue) bool {
+	if stats.RowCount == 0 {
+		return false
+	}
+	if !stats.HasRange() {
+		// all the rows are null
+		return op != planpb.OpType_Invalid
+	}
+
+	if op == planpb.OpType_PrefixMatch {
+		prefix, ok := value.GetVal().(*planpb.GenericValue_StringVal)
+		if !ok || !isStringType(stats.Type) {
+			return false
+		}
+		return stats.StringMax < prefix.StringVal ||
+			(stats.StringMin > prefix.StringVal && !strings.HasPrefix(stats.StringMin, prefix.StringVal))
+	}
+
+	minCmp, ok := compareBound(stats, value, false)
+	if !ok {
+		return false
+	}
+	maxCmp, ok := compareBound(stats, value, true)
+	if !ok {
+		return false
+	}
+	switch op {
+	case planpb.OpType_GreaterThan:
+		return maxCmp <= 0
+	case planpb.OpType_GreaterEqual:
+		return maxCmp < 0
+	case planpb.OpType_LessThan:
+		return minCmp >= 0
+	case planpb.OpType_LessEqual:
+		return minCmp > 0
+	case planpb.OpType_Equal:
+		return minCmp > 0 || maxCmp < 0
+	case planpb.OpType_NotEqual:
+		return minCmp == 0 && maxCmp == 0
+	}
+	return false
+}
+
+func isStringType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_VarChar || dataType == schemapb.DataType_String
+}
+
+// compareBound compares the min or max of @stats with @value, returns false if they are not comparable.
+func compareBound(stats *storage.FieldStats, value *planpb.GenericValue, useMax bool) (int, bool) {
+	switch stats.Type {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		bound := stats.IntMin
+		if useMax {
+			bound = stats.IntMax
+		}
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return compare(bound, v.Int64Val), true
+		case *planpb.GenericValue_BoolVal:
+			if v.BoolVal {
+				return compare(bound, 1), true
+			}
+			return compare(bound, 0), true
+		}
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		bound := stats.FloatMin
+		if useMax {
+			bound = stats.FloatMax
+		}
+		// the range is widened to the max float on inf or nan values
+		if bound >= math.MaxFloat64 {
+			bound = math.Inf(1)
+		} else if bound <= -math.MaxFloat64 {
+			bound = math.Inf(-1)
+		}
+		var v float64
+		switch val := value.GetVal().(type) {
+		case *planpb.GenericValue_FloatVal:
+			v = val.FloatVal
+		case *planpb.GenericValue_Int64Val:
+			v = float64(val.Int64Val)
+		default:
+			return 0, false
+		}
+		if math.IsNaN(v) {
+			return 0, false
+		}
+		// segcore compares float fields with the value casted to float
+		if stats.Type == schemapb.DataType_Float {
+			v = float64(float32(v))
+		}
+		return compare(bound, v), true
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		bound := stats.StringMin
+		if useMax {
+			bound = stats.StringMax
+		}
+		if v, ok := value.GetVal().(*planpb.GenericValue_StringVal); ok {
+			return strings.Compare(bound, v.StringVal), true
+		}
+	}
+	return 0, false
+}
+
+func compare[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func genUnaryRangeExpr(fieldID int64, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{FieldId: fieldID},
+				Op:         op,
+				Value:      value,
+			},
+		},
+	}
+}
+
+func genLogicalExpr(op planpb.BinaryExpr_BinaryOp, left, right *planpb.Expr) *planpb.Expr {
+	return &planpb.Expr{
+		Expr: &planpb.Expr_BinaryExpr{
+			BinaryExpr: &planpb.BinaryExpr{Op: op, Left: left, Right: right},
+		},
+	}
+}
+
+func int64Value(v int64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+}
+
+func floatValue(v float64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: v}}
+}
+
+func stringValue(v string) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}
+}
+
+func genPrunerFieldStats(t *testing.T) map[UniqueID]*storage.FieldStats {
+	intStats := storage.NewFieldStats(100, schemapb.DataType_Int64)
+	require.NoError(t, intStats.Update(&storage.Int64FieldData{Data: []int64{10, 0, 20}, ValidData: []bool{true, false, true}}))
+	floatStats := storage.NewFieldStats(101, schemapb.DataType_Float)
+	require.NoError(t, floatStats.Update(&storage.FloatFieldData{Data: []float32{0.1, 0.5}}))
+	stringStats := storage.NewFieldStats(102, schemapb.DataType_VarChar)
+	require.NoError(t, stringStats.Update(&storage.StringFieldData{Data: []string{"bar", "foo"}}))
+	nullStats := storage.NewFieldStats(103, schemapb.DataType_Int64)
+	require.NoError(t, nullStats.Update(&storage.Int64FieldData{Data: []int64{0}, ValidData: []bool{false}}))
+	return map[UniqueID]*storage.FieldStats{
+		100: intStats,
+		101: floatStats,
+		102: stringStats,
+		103: nullStats,
+	}
+}
+
+func TestSegmentPruner_skipByFieldStats(t *testing.T) {
+	fieldStats := genPrunerFieldStats(t)
+
+	cases := []struct {
+		name string
+		expr *planpb.Expr
+		skip bool
+	}{
+		{"gt max", genUnaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(20)), true},
+		{"ge max", genUnaryRangeExpr(100, planpb.OpType_GreaterEqual, int64Value(20)), false},
+		{"lt min", genUnaryRangeExpr(100, planpb.OpType_LessThan, int64Value(10)), true},
+		{"le min", genUnaryRangeExpr(100, planpb.OpType_LessEqual, int64Value(10)), false},
+		{"eq out of range", genUnaryRangeExpr(100, planpb.OpType_Equal, int64Value(30)), true},
+		{"eq in range", genUnaryRangeExpr(100, planpb.OpType_Equal, int64Value(15)), false},
+		{"ne", genUnaryRangeExpr(100, planpb.OpType_NotEqual, int64Value(15)), false},
+		{"int field with float value", genUnaryRangeExpr(100, planpb.OpType_GreaterThan, floatValue(30.5)), false},
+		{"float casted to float32", genUnaryRangeExpr(101, planpb.OpType_Equal, floatValue(0.1)), false},
+		{"float out of range", genUnaryRangeExpr(101, planpb.OpType_GreaterThan, floatValue(0.5)), true},
+		{"string out of range", genUnaryRangeExpr(102, planpb.OpType_Equal, stringValue("zoo")), true},
+		{"prefix in range", genUnaryRangeExpr(102, planpb.OpType_PrefixMatch, stringValue("fo")), false},
+		{"prefix of min", genUnaryRangeExpr(102, planpb.OpType_PrefixMatch, stringValue("b")), false},
+		{"prefix out of range", genUnaryRangeExpr(102, planpb.OpType_PrefixMatch, stringValue("go")), true},
+		{"all null", genUnaryRangeExpr(103, planpb.OpType_Equal, int64Value(0)), true},
+		{"no stats", genUnaryRangeExpr(104, planpb.OpType_Equal, int64Value(0)), false},
+		{"and", genLogicalExpr(planpb.BinaryExpr_LogicalAnd,
+			genUnaryRangeExpr(100, planpb.OpType_Equal, int64Value(15)),
+			genUnaryRangeExpr(102, planpb.OpType_Equal, stringValue("zoo"))), true},
+		{"or", genLogicalExpr(planpb.BinaryExpr_LogicalOr,
+			genUnaryRangeExpr(100, planpb.OpType_Equal, int64Value(15)),
+			genUnaryRangeExpr(102, planpb.OpType_Equal, stringValue("zoo"))), false},
+		{"binary range", &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+			ColumnInfo:     &planpb.ColumnInfo{FieldId: 100},
+			LowerInclusive: false,
+			UpperInclusive: true,
+			LowerValue:     int64Value(20),
+			UpperValue:     int64Value(30),
+		}}}, true},
+		{"term", &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: 100},
+			Values:     []*planpb.GenericValue{int64Value(1), int64Value(21)},
+		}}}, true},
+		{"is null", &planpb.Expr{Expr: &planpb.Expr_NullExpr{NullExpr: &planpb.NullExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: 102},
+			Op:         planpb.NullExpr_IsNull,
+		}}}, true},
+		{"is not null", &planpb.Expr{Expr: &planpb.Expr_NullExpr{NullExpr: &planpb.NullExpr{
+			ColumnInfo: &planpb.ColumnInfo{FieldId: 103},
+			Op:         planpb.NullExpr_IsNotNull,
+		}}}, true},
+		{"not", &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{
+			Op:    planpb.UnaryExpr_Not,
+			Child: genUnaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(20)),
+		}}}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.skip, skipByFieldStats(c.expr, fieldStats))
+		})
+	}
+
+	assert.False(t, skipByFieldStats(nil, fieldStats))
+	assert.False(t, skipByFieldStats(genUnaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(20)), nil))
+}
+
+func TestSegmentPruner_getPlanPredicates(t *testing.T) {
+	predicates := genUnaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(20))
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{Predicates: predicates},
+		},
+	}
+	expr, err := proto.Marshal(plan)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(predicates, getPlanPredicates(expr)))
+
+	plan = &planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{Predicates: predicates},
+	}
+	expr, err = proto.Marshal(plan)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(predicates, getPlanPredicates(expr)))
+
+	assert.Nil(t, getPlanPredicates([]byte{1, 2, 3}))
+}
+
+func TestSegmentPruner_pruneSealedSegments(t *testing.T) {
+	replica, err := genSimpleReplicaWithSealSegment(context.Background())
+	require.NoError(t, err)
+	seg, err := replica.getSegmentByID(defaultSegmentID, segmentTypeSealed)
+	require.NoError(t, err)
+
+	predicates := genUnaryRangeExpr(100, planpb.OpType_GreaterThan, int64Value(20))
+	segIDs := []UniqueID{defaultSegmentID}
+	assert.Equal(t, segIDs, pruneSealedSegments(replica, nil, segIDs))
+	assert.Equal(t, segIDs, pruneSealedSegments(replica, predicates, segIDs))
+
+	seg.setFieldStats(genPrunerFieldStats(t))
+	assert.Empty(t, pruneSealedSegments(replica, predicates, segIDs))
+	assert.Equal(t, segIDs, pruneSealedSegments(replica, genUnaryRangeExpr(100, planpb.OpType_Equal, int64Value(15)), segIDs))
+}
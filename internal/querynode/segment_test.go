@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -213,6 +214,55 @@ func TestSegment_retrieve(t *testing.T) {
 	})
 }
 
+func TestSegment_retrieveArithCompare(t *testing.T) {
+	collectionID := UniqueID(0)
+	schema := genTestCollectionSchema()
+
+	collection := newCollection(collectionID, schema)
+	defer deleteCollection(collection)
+
+	segment, err := newSegment(collection, defaultSegmentID, defaultPartitionID, collectionID, "", segmentTypeGrowing, defaultSegmentVersion, defaultSegmentStartPosition)
+	require.NoError(t, err)
+	defer deleteSegment(segment)
+
+	insertMsg, err := genSimpleInsertMsg(schema, defaultMsgLength)
+	require.NoError(t, err)
+	insertRecord := &segcorepb.InsertRecord{
+		FieldsData: insertMsg.FieldsData,
+		NumRows:    int64(insertMsg.NumRows),
+	}
+	offset, err := segment.segmentPreInsert(defaultMsgLength)
+	require.NoError(t, err)
+	err = segment.segmentInsert(offset, insertMsg.RowIDs, insertMsg.Timestamps, insertRecord)
+	require.NoError(t, err)
+
+	// both int32Field and int64Field hold the row number
+	testCases := []struct {
+		expr string
+		ids  []int64
+	}{
+		{`int32Field + int64Field > 10 and int32Field * int64Field < 50`, []int64{6, 7}},
+		{`(int32Field + 1) * 2 == int64Field + 4`, []int64{2}},
+		{`int64Field / 2.0 > int32Field - 2`, []int64{0, 1, 2, 3}},
+		// division by zero on the first row satisfies neither side of the not
+		{`not (int64Field % int32Field != 0) and int64Field < 3`, []int64{1, 2}},
+	}
+	for _, tc := range testCases {
+		planNode, err := planparserv2.CreateRetrievePlan(schema, tc.expr)
+		require.NoError(t, err, tc.expr)
+		planNode.OutputFieldIds = []FieldID{simpleInt64Field.id}
+		planExpr, err := proto.Marshal(planNode)
+		require.NoError(t, err)
+		plan, err := createRetrievePlanByExpr(collection, planExpr, 100, 100)
+		require.NoError(t, err, tc.expr)
+
+		res, err := segment.retrieve(plan)
+		plan.delete()
+		assert.NoError(t, err, tc.expr)
+		assert.ElementsMatch(t, tc.ids, res.GetIds().GetIntId().GetData(), tc.expr)
+	}
+}
+
 func TestSegment_getDeletedCount(t *testing.T) {
 	collectionID := UniqueID(0)
 	schema := genTestCollectionSchema()
@@ -30,8 +30,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
@@ -146,6 +148,8 @@ type ShardCluster struct {
 	nodes    map[int64]*shardNode // online nodes
 	segments SegmentsStatus       // shard segments
 
+	keyRanges sync.Map // segment id to the clustering key range of the segment, *storage.FieldStats
+
 	mutVersion     sync.RWMutex
 	versions       sync.Map             // version id to version
 	currentVersion *ShardClusterVersion // current serving segment state version
@@ -436,6 +440,7 @@ func (sc *ShardCluster) removeSegment(evt shardSegmentInfo) {
 	}
 
 	delete(sc.segments, evt.segmentID)
+	sc.keyRanges.Delete(evt.segmentID)
 	sc.healthCheck()
 }
 
@@ -602,6 +607,52 @@ func (sc *ShardCluster) segmentAllocations(partitionIDs []int64) (map[int64][]in
 	return sc.currentVersion.GetAllocation(partitionIDs), sc.currentVersion.versionID
 }
 
+// SetClusteringKeyRange records the clustering key range of a segment, which is used to skip the segment
+// when no row in the range can satisfy the filter of a search or query.
+func (sc *ShardCluster) SetClusteringKeyRange(segmentID int64, keyRange *datapb.FieldStats) {
+	if keyRange == nil {
+		sc.keyRanges.Delete(segmentID)
+		return
+	}
+	sc.keyRanges.Store(segmentID, storage.NewFieldStatsFromProto(keyRange))
+}
+
+// pruneSegmentAllocations removes the segments whose clustering key range shows that no row can satisfy
+// the filter of the serialized plan, the nodes without remaining segments are removed as well.
+func (sc *ShardCluster) pruneSegmentAllocations(segAllocs map[int64][]int64, serializedPlan []byte) map[int64][]int64 {
+	predicates := getPlanPredicates(serializedPlan)
+	if predicates == nil {
+		return segAllocs
+	}
+
+	var total, pruned int
+	result := make(map[int64][]int64, len(segAllocs))
+	for nodeID, segmentIDs := range segAllocs {
+		remains := make([]int64, 0, len(segmentIDs))
+		for _, segmentID := range segmentIDs {
+			total++
+			if keyRange, ok := sc.keyRanges.Load(segmentID); ok {
+				stats := keyRange.(*storage.FieldStats)
+				if skipByFieldStats(predicates, map[UniqueID]*storage.FieldStats{stats.FieldID: stats}) {
+					pruned++
+					continue
+				}
+			}
+			remains = append(remains, segmentID)
+		}
+		if len(remains) > 0 {
+			result[nodeID] = remains
+		}
+	}
+	if pruned > 0 {
+		log.Debug("prune segments by clustering key range",
+			zap.String("channel", sc.vchannelName),
+			zap.Int("segments", total),
+			zap.Int("pruned", pruned))
+	}
+	return result
+}
+
 // finishUsage decreases the inUse count of provided segments
 func (sc *ShardCluster) finishUsage(versionID int64) {
 	v, ok := sc.versions.Load(versionID)
@@ -769,6 +820,7 @@ func (sc *ShardCluster) ReleaseSegments(ctx context.Context, req *querypb.Releas
 				// otherwise, segment is on another node, do nothing
 				if force || info.nodeID == req.NodeID {
 					delete(sc.segments, segmentID)
+					sc.keyRanges.Delete(segmentID)
 				}
 			}
 		}
@@ -921,6 +973,7 @@ func (sc *ShardCluster) Search(ctx context.Context, req *querypb.SearchRequest,
 	// get node allocation and maintains the inUse reference count
 	segAllocs, versionID := sc.segmentAllocations(req.GetReq().GetPartitionIDs())
 	defer sc.finishUsage(versionID)
+	segAllocs = sc.pruneSegmentAllocations(segAllocs, req.GetReq().GetSerializedExprPlan())
 
 	log.Debug("cluster segment distribution", zap.Int("len", len(segAllocs)), zap.Int64s("partitionIDs", req.GetReq().GetPartitionIDs()))
 	for nodeID, segmentIDs := range segAllocs {
@@ -1014,6 +1067,7 @@ func (sc *ShardCluster) Query(ctx context.Context, req *querypb.QueryRequest, wi
 	// get node allocation and maintains the inUse reference count
 	segAllocs, versionID := sc.segmentAllocations(req.GetReq().GetPartitionIDs())
 	defer sc.finishUsage(versionID)
+	segAllocs = sc.pruneSegmentAllocations(segAllocs, req.GetReq().GetSerializedExprPlan())
 
 	// concurrent visiting nodes
 	var wg sync.WaitGroup
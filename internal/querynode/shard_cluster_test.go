@@ -22,10 +22,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -2180,3 +2184,38 @@ func (suite *ShardClusterSuite) TestReleaseSegments() {
 func TestShardClusterSuite(t *testing.T) {
 	suite.Run(t, new(ShardClusterSuite))
 }
+
+func TestShardCluster_pruneSegmentAllocations(t *testing.T) {
+	sc := &ShardCluster{vchannelName: "dml_1"}
+	keyRange := func(min, max int64) *storage.FieldStats {
+		stats := storage.NewFieldStats(100, schemapb.DataType_Int64)
+		require.NoError(t, stats.Update(&storage.Int64FieldData{Data: []int64{min, max}}))
+		return stats
+	}
+	sc.SetClusteringKeyRange(1, keyRange(0, 9).ToProto())
+	sc.SetClusteringKeyRange(2, keyRange(10, 19).ToProto())
+	sc.SetClusteringKeyRange(3, keyRange(20, 29).ToProto())
+	sc.SetClusteringKeyRange(4, nil)
+
+	segAllocs := map[int64][]int64{
+		1: {1, 2},
+		2: {3, 4},
+	}
+	plan, err := proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{
+			Predicates: genUnaryRangeExpr(100, planpb.OpType_GreaterEqual, int64Value(15)),
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, segAllocs, sc.pruneSegmentAllocations(segAllocs, nil))
+	assert.Equal(t, map[int64][]int64{1: {2}, 2: {3, 4}}, sc.pruneSegmentAllocations(segAllocs, plan))
+
+	plan, err = proto.Marshal(&planpb.PlanNode{
+		Node: &planpb.PlanNode_Predicates{
+			Predicates: genUnaryRangeExpr(100, planpb.OpType_LessThan, int64Value(5)),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64][]int64{1: {1}, 2: {4}}, sc.pruneSegmentAllocations(segAllocs, plan))
+}
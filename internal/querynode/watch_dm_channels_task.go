@@ -82,6 +82,10 @@ func (w *watchDmChannelsTask) Execute(ctx context.Context) (err error) {
 
 	// init collection meta
 	coll := w.node.metaReplica.addCollection(collectionID, w.req.Schema)
+	// the collection may have been loaded before fields were added
+	if err = w.node.metaReplica.updateCollectionSchema(collectionID, w.req.Schema); err != nil {
+		return err
+	}
 
 	// filter out the already exist channels
 	vChannels = coll.AddChannels(vChannels, VPChannels)
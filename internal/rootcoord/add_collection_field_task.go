@@ -0,0 +1,108 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+type addCollectionFieldTask struct {
+	baseTask
+	Req *proxypb.AddCollectionFieldRequest
+}
+
+func (t *addCollectionFieldTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" {
+		return errors.New("add collection field failed, collection name is empty")
+	}
+	if t.Req.GetField() == nil {
+		return errors.New("add collection field failed, field is empty")
+	}
+	return nil
+}
+
+// addField appends the field to the collection with the next field id and bumps the schema version,
+// the field id carried by the request is ignored.
+func addField(coll *model.Collection, field *schemapb.FieldSchema) error {
+	schema := &schemapb.CollectionSchema{
+		Name:   coll.Name,
+		Fields: model.MarshalFieldModels(coll.Fields),
+	}
+	if err := typeutil.ValidateAddedField(schema, field, Params.ProxyCfg.MaxNameLength); err != nil {
+		return err
+	}
+
+	var maxFieldID int64 = StartOfUserFieldID - 1
+	for _, f := range coll.Fields {
+		if f.FieldID > maxFieldID {
+			maxFieldID = f.FieldID
+		}
+	}
+	newField := model.UnmarshalFieldModel(field)
+	newField.FieldID = maxFieldID + 1
+	coll.Fields = append(coll.Fields, newField)
+	coll.SchemaVersion++
+	return nil
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetCollectionName(), t.ts)
+	if err != nil {
+		log.Warn("get collection failed during adding collection field",
+			zap.String("collectionName", t.Req.GetCollectionName()), zap.Uint64("ts", t.ts))
+		return err
+	}
+
+	newColl := oldColl.Clone()
+	if err := addField(newColl, t.Req.GetField()); err != nil {
+		return err
+	}
+	log.Info("add field to collection", zap.String("collectionName", oldColl.Name),
+		zap.String("fieldName", t.Req.GetField().GetName()), zap.Int32("schemaVersion", newColl.SchemaVersion))
+
+	ts := t.GetTs()
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: t.core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       ts,
+	})
+
+	// query nodes must know the field before proxies start to insert it
+	redoTask.AddSyncStep(&broadcastAlteredSchemaStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: oldColl.CollectionID,
+		ts:           ts,
+	})
+
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		collectionNames: []string{oldColl.Name},
+		collectionID:    oldColl.CollectionID,
+		ts:              ts,
+	})
+
+	// DataCoord takes the altered schema along with the unchanged properties
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: t.core},
+		req: &milvuspb.AlterCollectionRequest{
+			Base:           t.Req.GetBase(),
+			DbName:         t.Req.GetDbName(),
+			CollectionName: oldColl.Name,
+			CollectionID:   oldColl.CollectionID,
+			Properties:     newColl.Properties,
+		},
+		core: t.core,
+	})
+
+	return redoTask.Execute(ctx)
+}
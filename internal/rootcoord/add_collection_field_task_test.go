@@ -0,0 +1,171 @@
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+func Test_addCollectionFieldTask_Prepare(t *testing.T) {
+	t.Run("empty collection name", func(t *testing.T) {
+		task := &addCollectionFieldTask{Req: &proxypb.AddCollectionFieldRequest{Field: &schemapb.FieldSchema{Name: "age"}}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("empty field", func(t *testing.T) {
+		task := &addCollectionFieldTask{Req: &proxypb.AddCollectionFieldRequest{CollectionName: "cn"}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &addCollectionFieldTask{Req: &proxypb.AddCollectionFieldRequest{
+			CollectionName: "cn",
+			Field:          &schemapb.FieldSchema{Name: "age"},
+		}}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_addCollectionFieldTask_Execute(t *testing.T) {
+	Params.InitOnce()
+	properties := []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}}
+	field := &schemapb.FieldSchema{
+		Name:       "age",
+		DataType:   schemapb.DataType_Int32,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}},
+	}
+
+	t.Run("failed to get collection", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return nil, errors.New("err")
+		}
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.AddCollectionFieldRequest{CollectionName: "cn", Field: field},
+		}
+
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1, Name: "cn"}, nil
+		}
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: baseTask{core: core},
+			Req: &proxypb.AddCollectionFieldRequest{
+				CollectionName: "cn",
+				Field:          &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64},
+			},
+		}
+
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("add field successfully", func(t *testing.T) {
+		oldColl := &model.Collection{
+			CollectionID: int64(1),
+			Name:         "cn",
+			Fields: []*model.Field{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+			Properties: properties,
+		}
+		var altered *model.Collection
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return oldColl, nil
+		}
+		meta.AlterCollectionFunc = func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts Timestamp) error {
+			altered = newColl
+			return nil
+		}
+
+		broker := newMockBroker()
+		var broadcastReq *milvuspb.AlterCollectionRequest
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			broadcastReq = req
+			return nil
+		}
+		var broadcastSchema bool
+		broker.BroadcastAlteredSchemaFunc = func(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+			broadcastSchema = true
+			return nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta), withBroker(broker))
+		task := &addCollectionFieldTask{
+			baseTask: baseTask{core: core},
+			Req:      &proxypb.AddCollectionFieldRequest{CollectionName: "cn", Field: field},
+		}
+
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, 3, len(altered.Fields))
+		assert.Equal(t, int64(102), altered.Fields[2].FieldID)
+		assert.Equal(t, "age", altered.Fields[2].Name)
+		assert.Equal(t, int32(1), altered.SchemaVersion)
+		assert.Equal(t, properties, altered.Properties)
+		assert.Equal(t, 2, len(oldColl.Fields))
+		assert.True(t, broadcastSchema)
+		assert.Equal(t, int64(1), broadcastReq.GetCollectionID())
+		assert.Equal(t, properties, broadcastReq.GetProperties())
+	})
+}
+
+func Test_addField(t *testing.T) {
+	Params.InitOnce()
+	coll := &model.Collection{
+		Name: "cn",
+		Fields: []*model.Field{
+			{FieldID: 0, Name: common.RowIDFieldName},
+			{FieldID: 1, Name: common.TimeStampFieldName},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true},
+		},
+	}
+	nullable := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+
+	err := addField(coll, &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64, TypeParams: nullable})
+	assert.Error(t, err)
+
+	err = addField(coll, &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: nullable})
+	assert.Error(t, err)
+
+	// default value longer than max_length
+	err = addField(coll, &schemapb.FieldSchema{Name: "name", DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "2"}, {Key: common.DefaultValueKey, Value: "abc"}}})
+	assert.Error(t, err)
+	assert.Equal(t, int32(0), coll.SchemaVersion)
+
+	// the field id of the request is ignored
+	err = addField(coll, &schemapb.FieldSchema{FieldID: 1, Name: "age", DataType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(101), coll.Fields[3].FieldID)
+	assert.Equal(t, int32(1), coll.SchemaVersion)
+
+	err = addField(coll, &schemapb.FieldSchema{FieldID: 105, Name: "name", DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "16"}, {Key: common.NullableKey, Value: "true"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(102), coll.Fields[4].FieldID)
+	assert.Equal(t, int32(2), coll.SchemaVersion)
+}
@@ -50,6 +50,7 @@ type Broker interface {
 	DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error)
 
 	BroadcastAlteredCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	BroadcastAlteredSchema(ctx context.Context, collectionID UniqueID, ts Timestamp) error
 }
 
 type ServerBroker struct {
@@ -272,6 +273,39 @@ func (b *ServerBroker) BroadcastAlteredCollection(ctx context.Context, req *milv
 	return nil
 }
 
+// BroadcastAlteredSchema pushes the schema with fields added at ts to the query nodes serving the collection.
+func (b *ServerBroker) BroadcastAlteredSchema(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	log.Info("broadcasting altered schema", zap.Int64("collection id", collectionID), zap.Uint64("ts", ts))
+
+	colMeta, err := b.s.meta.GetCollectionByID(ctx, collectionID, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.s.queryCoord.UpdateCollectionSchema(ctx, &querypb.UpdateSchemaRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithTimeStamp(ts),
+			commonpbutil.WithSourceID(b.s.session.ServerID),
+		),
+		CollectionID: collectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name:        colMeta.Name,
+			Description: colMeta.Description,
+			AutoID:      colMeta.AutoID,
+			Fields:      model.MarshalFieldModels(colMeta.Fields),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+		return fmt.Errorf("failed to broadcast altered schema, code: %s, reason: %s", resp.GetErrorCode(), resp.GetReason())
+	}
+	log.Info("done to broadcast altered schema", zap.Int64("collection id", collectionID))
+	return nil
+}
+
 func (b *ServerBroker) DescribeIndex(ctx context.Context, colID UniqueID) (*indexpb.DescribeIndexResponse, error) {
 	return b.s.indexCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
 		CollectionID: colID,
@@ -8,8 +8,10 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 
 	"github.com/milvus-io/milvus-proto/go-api/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -356,3 +358,63 @@ func TestServerBroker_BroadcastAlteredCollection(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestServerBroker_BroadcastAlteredSchema(t *testing.T) {
+	collMeta := &model.Collection{
+		CollectionID: 1,
+		Name:         "cn",
+		Fields: []*model.Field{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+		},
+	}
+	validMeta := &mockMetaTable{
+		GetCollectionByIDFunc: func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+			return collMeta, nil
+		},
+	}
+
+	t.Run("get meta fail", func(t *testing.T) {
+		c := newTestCore(withValidQueryCoord())
+		c.meta = &mockMetaTable{
+			GetCollectionByIDFunc: func(ctx context.Context, collectionID UniqueID, ts Timestamp) (*model.Collection, error) {
+				return nil, errors.New("err")
+			},
+		}
+		b := newServerBroker(c)
+		err := b.BroadcastAlteredSchema(context.Background(), 1, 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withInvalidQueryCoord())
+		c.meta = validMeta
+		b := newServerBroker(c)
+		err := b.BroadcastAlteredSchema(context.Background(), 1, 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("non success error code on execute", func(t *testing.T) {
+		c := newTestCore(withFailedQueryCoord())
+		c.meta = validMeta
+		b := newServerBroker(c)
+		err := b.BroadcastAlteredSchema(context.Background(), 1, 100)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		c := newTestCore(withValidQueryCoord())
+		c.meta = validMeta
+		var req *querypb.UpdateSchemaRequest
+		c.queryCoord.(*mockQueryCoord).UpdateSchemaFunc = func(ctx context.Context, in *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+			req = in
+			return succStatus(), nil
+		}
+		b := newServerBroker(c)
+		err := b.BroadcastAlteredSchema(context.Background(), 1, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), req.GetCollectionID())
+		assert.Equal(t, uint64(100), req.GetBase().GetTimestamp())
+		assert.Len(t, req.GetSchema().GetFields(), 2)
+	})
+}
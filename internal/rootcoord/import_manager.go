@@ -49,6 +49,9 @@ const (
 	delimiter       = "/"
 )
 
+// errImportTaskFailed is returned when a sub-task of a failed import task reports its state
+var errImportTaskFailed = errors.New("trying to update an already failed import task")
+
 // checkPendingTasksInterval is the default interval to check and send out pending tasks,
 // default 60*1000 milliseconds (1 minute).
 var checkPendingTasksInterval = 60 * 1000
@@ -70,12 +73,13 @@ type importManager struct {
 	busyNodes map[int64]int64 // Set of all current working DataNode IDs and related task create timestamp.
 
 	// TODO: Make pendingTask a map to improve look up performance.
-	pendingTasks  []*datapb.ImportTaskInfo         // pending tasks
-	workingTasks  map[int64]*datapb.ImportTaskInfo // in-progress tasks
-	pendingLock   sync.RWMutex                     // lock pending task list
-	workingLock   sync.RWMutex                     // lock working task map
-	busyNodesLock sync.RWMutex                     // lock for working nodes.
-	lastReqID     int64                            // for generating a unique ID for import request
+	pendingTasks      []*datapb.ImportTaskInfo           // pending tasks
+	workingTasks      map[int64]*datapb.ImportTaskInfo   // in-progress tasks
+	sourceCredentials map[int64][]*commonpb.KeyValuePair // credentials of import sources of unfinished tasks, never persisted
+	pendingLock       sync.RWMutex                       // lock pending task list
+	workingLock       sync.RWMutex                       // lock working task map
+	busyNodesLock     sync.RWMutex                       // lock for working nodes.
+	lastReqID         int64                              // for generating a unique ID for import request
 
 	startOnce sync.Once
 
@@ -102,6 +106,7 @@ func newImportManager(ctx context.Context, client kv.TxnKV,
 		taskStore:                 client,
 		pendingTasks:              make([]*datapb.ImportTaskInfo, 0, MaxPendingCount), // currently task queue max size is 32
 		workingTasks:              make(map[int64]*datapb.ImportTaskInfo),
+		sourceCredentials:         make(map[int64][]*commonpb.KeyValuePair),
 		busyNodes:                 make(map[int64]int64),
 		pendingLock:               sync.RWMutex{},
 		workingLock:               sync.RWMutex{},
@@ -196,61 +201,62 @@ func (m *importManager) cleanupLoop(wg *sync.WaitGroup) {
 	}
 }
 
-// sendOutTasks pushes all pending tasks to DataCoord, gets DataCoord response and re-add these tasks as working tasks.
+// sendOutTasks pushes pending sub-tasks to DataCoord, so that they are executed by idle DataNodes in parallel.
+// The pending sub-tasks of working tasks, e.g. the failed ones to retry, go first. A pending task becomes a working
+// task once any of its sub-tasks is sent out.
 func (m *importManager) sendOutTasks(ctx context.Context) error {
 	m.pendingLock.Lock()
 	m.busyNodesLock.Lock()
 	defer m.pendingLock.Unlock()
 	defer m.busyNodesLock.Unlock()
 
-	// Trigger Import() action to DataCoord.
-	for len(m.pendingTasks) > 0 {
-		log.Debug("try to send out pending tasks", zap.Int("task_number", len(m.pendingTasks)))
-		task := m.pendingTasks[0]
-		// TODO: Use ImportTaskInfo directly.
-		it := &datapb.ImportTask{
-			CollectionId: task.GetCollectionId(),
-			PartitionId:  task.GetPartitionId(),
-			ChannelNames: task.GetChannelNames(),
-			TaskId:       task.GetId(),
-			Files:        task.GetFiles(),
-			Infos:        task.GetInfos(),
-		}
-
-		// Get all busy dataNodes for reference.
-		var busyNodeList []int64
-		for k := range m.busyNodes {
-			busyNodeList = append(busyNodeList, k)
+	// Send out pending sub-tasks of working tasks.
+	rejected, err := func() (bool, error) {
+		m.workingLock.Lock()
+		defer m.workingLock.Unlock()
+		taskIDs := make([]int64, 0)
+		for taskID, task := range m.workingTasks {
+			if task.GetState().GetStateCode() == commonpb.ImportState_ImportStarted {
+				taskIDs = append(taskIDs, taskID)
+			}
 		}
-
-		// Send import task to dataCoord, which will then distribute the import task to dataNode.
-		resp, err := m.callImportService(ctx, &datapb.ImportTaskRequest{
-			ImportTask:   it,
-			WorkingNodes: busyNodeList,
+		sort.Slice(taskIDs, func(i, j int) bool {
+			return taskIDs[i] < taskIDs[j]
 		})
-		if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
-			log.Warn("import task is rejected",
-				zap.Int64("task ID", it.GetTaskId()),
-				zap.Any("error code", resp.GetStatus().GetErrorCode()),
-				zap.String("cause", resp.GetStatus().GetReason()))
-			break
+		for _, taskID := range taskIDs {
+			task, sent, rejected := m.sendOutSubTasks(ctx, m.workingTasks[taskID])
+			if sent > 0 {
+				if err := m.persistTaskInfo(task); err != nil {
+					log.Error("failed to update import task",
+						zap.Int64("task ID", task.GetId()),
+						zap.Error(err))
+					return true, err
+				}
+				m.workingTasks[taskID] = task
+			}
+			if rejected {
+				return true, nil
+			}
 		}
-		if err != nil {
-			log.Error("import task get error", zap.Error(err))
+		return false, nil
+	}()
+	if err != nil || rejected {
+		return err
+	}
+
+	// Trigger Import() action to DataCoord.
+	for len(m.pendingTasks) > 0 {
+		log.Debug("try to send out pending tasks", zap.Int("task_number", len(m.pendingTasks)))
+		task, sent, rejected := m.sendOutSubTasks(ctx, m.pendingTasks[0])
+		if sent == 0 && rejected {
 			break
 		}
 
-		// Successfully assigned dataNode for the import task. Add task to working task list and update task store.
-		task.DatanodeId = resp.GetDatanodeId()
-		log.Debug("import task successfully assigned to dataNode",
-			zap.Int64("task ID", it.GetTaskId()),
-			zap.Int64("dataNode ID", task.GetDatanodeId()))
-		// Add new working dataNode to busyNodes.
-		m.busyNodes[resp.GetDatanodeId()] = task.GetCreateTs()
-		err = func() error {
+		// Successfully assigned dataNodes for the import task. Add task to working task list and update task store.
+		err := func() error {
 			m.workingLock.Lock()
 			defer m.workingLock.Unlock()
-			log.Debug("import task added as working task", zap.Int64("task ID", it.TaskId))
+			log.Debug("import task added as working task", zap.Int64("task ID", task.GetId()))
 			task.State.StateCode = commonpb.ImportState_ImportStarted
 			task.StartTs = time.Now().Unix()
 			// first update the import task into meta store and then put it into working tasks
@@ -268,11 +274,103 @@ func (m *importManager) sendOutTasks(ctx context.Context) error {
 		}
 		// Remove this task from head of pending list.
 		m.pendingTasks = append(m.pendingTasks[:0], m.pendingTasks[1:]...)
+		if rejected {
+			break
+		}
 	}
 
 	return nil
 }
 
+// sendOutSubTasks sends the pending sub-tasks of an import task to DataCoord. A sub-task is assigned to an idle
+// DataNode which has not failed it before, or to any idle DataNode if there is no such one. It returns a copy of the
+// task in which the sub-tasks sent out are started, the number of sub-tasks sent out, and whether a sub-task is
+// rejected by DataCoord. The caller should hold the pendingLock and the busyNodesLock.
+func (m *importManager) sendOutSubTasks(ctx context.Context, task *datapb.ImportTaskInfo) (*datapb.ImportTaskInfo, int, bool) {
+	subTasks := getImportSubTasks(task)
+	// the credentials of import source are passed to dataNode along with the sub-tasks, but not persisted
+	infos := importTaskOptions(task.GetInfos())
+	infos = append(infos, m.sourceCredentials[task.GetId()]...)
+
+	sent := 0
+	rejected := false
+	var datanodeID int64
+	for _, subTask := range subTasks {
+		if subTask.State != commonpb.ImportState_ImportPending {
+			continue
+		}
+		// TODO: Use ImportTaskInfo directly.
+		it := &datapb.ImportTask{
+			CollectionId: task.GetCollectionId(),
+			PartitionId:  task.GetPartitionId(),
+			ChannelNames: task.GetChannelNames(),
+			TaskId:       subTask.GetId(),
+			Files:        subTask.GetFiles(),
+			Infos:        infos,
+			ChunkIndex:   subTask.GetChunkIndex(),
+			ChunkCount:   subTask.GetChunkCount(),
+		}
+
+		// Get all busy dataNodes for reference.
+		var busyNodeList []int64
+		for k := range m.busyNodes {
+			busyNodeList = append(busyNodeList, k)
+		}
+
+		// Send import task to dataCoord, which will then distribute the import task to dataNode.
+		var ok bool
+		datanodeID, ok = m.assignDataNode(ctx, it, append(busyNodeList, subTask.FailedNodes...))
+		if !ok && len(subTask.FailedNodes) > 0 {
+			datanodeID, ok = m.assignDataNode(ctx, it, busyNodeList)
+		}
+		if !ok {
+			rejected = true
+			break
+		}
+
+		log.Debug("import sub-task successfully assigned to dataNode",
+			zap.Int64("task ID", task.GetId()),
+			zap.Int64("sub-task ID", subTask.GetId()),
+			zap.Int64("dataNode ID", datanodeID))
+		subTask.State = commonpb.ImportState_ImportStarted
+		subTask.DatanodeId = datanodeID
+		subTask.Attempts++
+		// Add new working dataNode to busyNodes.
+		m.busyNodes[datanodeID] = task.GetCreateTs()
+		sent++
+	}
+	if sent == 0 {
+		return task, 0, rejected
+	}
+
+	sentTask := cloneImportTaskInfo(task)
+	sentTask.State = proto.Clone(task.GetState()).(*datapb.ImportTaskState)
+	sentTask.DatanodeId = datanodeID
+	setImportSubTasks(sentTask, subTasks)
+	return sentTask, sent, rejected
+}
+
+// assignDataNode sends an import task to DataCoord, which assigns the task to an idle DataNode out of the working
+// nodes. It returns the ID of the DataNode, or false if the task is rejected.
+func (m *importManager) assignDataNode(ctx context.Context, it *datapb.ImportTask, workingNodes []int64) (int64, bool) {
+	resp, err := m.callImportService(ctx, &datapb.ImportTaskRequest{
+		ImportTask:   it,
+		WorkingNodes: workingNodes,
+	})
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		log.Warn("import task is rejected",
+			zap.Int64("task ID", it.GetTaskId()),
+			zap.Any("error code", resp.GetStatus().GetErrorCode()),
+			zap.String("cause", resp.GetStatus().GetReason()))
+		return 0, false
+	}
+	if err != nil {
+		log.Error("import task get error", zap.Error(err))
+		return 0, false
+	}
+	return resp.GetDatanodeId(), true
+}
+
 // flipTaskState checks every import task and flips their import state if eligible.
 func (m *importManager) flipTaskState(ctx context.Context) error {
 	var importTasks []*datapb.ImportTaskInfo
@@ -392,20 +490,14 @@ func (m *importManager) isRowbased(files []string) (bool, error) {
 	isRowBased := false
 	for _, filePath := range files {
 		_, fileType := importutil.GetFileNameAndExt(filePath)
-		if fileType == importutil.JSONFileExt {
+		if importutil.IsRowBasedFileType(fileType) {
 			isRowBased = true
 		} else if isRowBased {
-			log.Error("row-based data file type must be JSON, mixed file types is not allowed", zap.Strings("files", files))
-			return isRowBased, fmt.Errorf("row-based data file type must be JSON, file type '%s' is not allowed", fileType)
+			log.Error("row-based data file type must be JSON, Parquet or CSV, mixed file types is not allowed", zap.Strings("files", files))
+			return isRowBased, fmt.Errorf("row-based data file type must be JSON, Parquet or CSV, file type '%s' is not allowed", fileType)
 		}
 	}
 
-	// for row_based, we only allow one file so that each invocation only generate a task
-	if isRowBased && len(files) > 1 {
-		log.Error("row-based import, only allow one JSON file each time", zap.Strings("files", files))
-		return isRowBased, fmt.Errorf("row-based import, only allow one JSON file each time")
-	}
-
 	return isRowBased, nil
 }
 
@@ -452,96 +544,62 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 			return err
 		}
 
-		taskCount := 1
-		if isRowBased {
-			taskCount = len(req.Files)
-		}
+		// credentials of the import source are never persisted with the tasks
+		infos, credentials := importutil.SplitSourceCredentials(req.GetOptions())
 
-		// task queue size has a limit, return error if import request contains too many data files, and skip entire job
-		if capacity-length < taskCount {
-			err := fmt.Errorf("import task queue max size is %v, currently there are %v tasks is pending. Not able to execute this request with %v tasks", capacity, length, taskCount)
+		// task queue size has a limit, return error if the queue is full, and skip entire job
+		if capacity-length < 1 {
+			err := fmt.Errorf("import task queue max size is %v, currently there are %v tasks is pending. Not able to execute this request", capacity, length)
 			log.Error(err.Error())
 			return err
 		}
 
-		// convert import request to import tasks
-		if isRowBased {
-			// For row-based importing, each file makes a task.
-			taskList := make([]int64, len(req.Files))
-			for i := 0; i < len(req.Files); i++ {
-				tID, _, err := m.idAllocator(1)
-				if err != nil {
-					log.Error("failed to allocate ID for import task", zap.Error(err))
-					return err
-				}
-				newTask := &datapb.ImportTaskInfo{
-					Id:           tID,
-					CollectionId: cID,
-					PartitionId:  pID,
-					ChannelNames: req.ChannelNames,
-					Files:        []string{req.GetFiles()[i]},
-					CreateTs:     time.Now().Unix(),
-					State: &datapb.ImportTaskState{
-						StateCode: commonpb.ImportState_ImportPending,
-					},
-					Infos: req.Options,
-				}
+		// convert import request to an import task. The files are split into chunks of rows, each chunk makes a
+		// sub-task, so that the data is imported by several dataNodes in parallel. For row-based importing, each file
+		// is split alone. For column-based, all files are split together.
+		tID, _, err := m.idAllocator(1)
+		if err != nil {
+			log.Error("failed to allocate ID for import task", zap.Error(err))
+			return err
+		}
+		subTasks, err := m.newImportSubTasks(tID, req.GetFiles(), isRowBased, importutil.IsBackup(infos))
+		if err != nil {
+			return err
+		}
+		newTask := &datapb.ImportTaskInfo{
+			Id:           tID,
+			CollectionId: cID,
+			PartitionId:  pID,
+			ChannelNames: req.ChannelNames,
+			Files:        req.GetFiles(),
+			CreateTs:     time.Now().Unix(),
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportPending,
+			},
+			Infos:    infos,
+			SubTasks: subTasks,
+		}
 
-				// Here no need to check error returned by setCollectionPartitionName(),
-				// since here we always return task list to client no matter something missed.
-				// We make the method setCollectionPartitionName() returns error
-				// because we need to make sure coverage all the code branch in unittest case.
-				_ = m.setCollectionPartitionName(cID, pID, newTask)
-				resp.Tasks = append(resp.Tasks, newTask.GetId())
-				taskList[i] = newTask.GetId()
-				log.Info("new task created as pending task",
-					zap.Int64("task ID", newTask.GetId()))
-				if err := m.persistTaskInfo(newTask); err != nil {
-					log.Error("failed to update import task",
-						zap.Int64("task ID", newTask.GetId()),
-						zap.Error(err))
-					return err
-				}
-				m.pendingTasks = append(m.pendingTasks, newTask)
-			}
-			log.Info("row-based import request processed", zap.Any("task IDs", taskList))
-		} else {
-			// TODO: Merge duplicated code :(
-			// for column-based, all files is a task
-			tID, _, err := m.idAllocator(1)
-			if err != nil {
-				return err
-			}
-			newTask := &datapb.ImportTaskInfo{
-				Id:           tID,
-				CollectionId: cID,
-				PartitionId:  pID,
-				ChannelNames: req.ChannelNames,
-				Files:        req.GetFiles(),
-				CreateTs:     time.Now().Unix(),
-				State: &datapb.ImportTaskState{
-					StateCode: commonpb.ImportState_ImportPending,
-				},
-				Infos: req.Options,
-			}
-			// Here no need to check error returned by setCollectionPartitionName(),
-			// since here we always return task list to client no matter something missed.
-			// We make the method setCollectionPartitionName() returns error
-			// because we need to make sure coverage all the code branch in unittest case.
-			_ = m.setCollectionPartitionName(cID, pID, newTask)
-			resp.Tasks = append(resp.Tasks, newTask.GetId())
-			log.Info("new task created as pending task",
-				zap.Int64("task ID", newTask.GetId()))
-			if err := m.persistTaskInfo(newTask); err != nil {
-				log.Error("failed to update import task",
-					zap.Int64("task ID", newTask.GetId()),
-					zap.Error(err))
-				return err
-			}
-			m.pendingTasks = append(m.pendingTasks, newTask)
-			log.Info("column-based import request processed",
-				zap.Int64("task ID", newTask.GetId()))
+		// Here no need to check error returned by setCollectionPartitionName(),
+		// since here we always return task list to client no matter something missed.
+		// We make the method setCollectionPartitionName() returns error
+		// because we need to make sure coverage all the code branch in unittest case.
+		_ = m.setCollectionPartitionName(cID, pID, newTask)
+		resp.Tasks = append(resp.Tasks, newTask.GetId())
+		log.Info("new task created as pending task",
+			zap.Int64("task ID", newTask.GetId()))
+		if err := m.persistTaskInfo(newTask); err != nil {
+			log.Error("failed to update import task",
+				zap.Int64("task ID", newTask.GetId()),
+				zap.Error(err))
+			return err
 		}
+		m.pendingTasks = append(m.pendingTasks, newTask)
+		m.setSourceCredentials(newTask.GetId(), credentials)
+		log.Info("import request processed",
+			zap.Int64("task ID", newTask.GetId()),
+			zap.Bool("row-based", isRowBased),
+			zap.Int("sub-task count", len(subTasks)))
 		return nil
 	}()
 	if err != nil {
@@ -553,6 +611,25 @@ func (m *importManager) importJob(ctx context.Context, req *milvuspb.ImportReque
 	return resp
 }
 
+// setSourceCredentials keeps the credentials of the import source of a pending task in memory until the task
+// finishes, since a failed sub-task can be sent out again. The caller should hold the pendingLock.
+func (m *importManager) setSourceCredentials(taskID int64, credentials []*commonpb.KeyValuePair) {
+	if len(credentials) == 0 {
+		return
+	}
+	if m.sourceCredentials == nil {
+		m.sourceCredentials = make(map[int64][]*commonpb.KeyValuePair)
+	}
+	m.sourceCredentials[taskID] = credentials
+}
+
+// releaseSourceCredentials drops the credentials of the import source of a finished task.
+func (m *importManager) releaseSourceCredentials(taskID int64) {
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+	delete(m.sourceCredentials, taskID)
+}
+
 // updateTaskInfo updates the task's state in in-memory working tasks list and in task store, given ImportResult
 // result. It returns the ImportTaskInfo of the given task.
 func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.ImportTaskInfo, error) {
@@ -561,49 +638,63 @@ func (m *importManager) updateTaskInfo(ir *rootcoordpb.ImportResult) (*datapb.Im
 	}
 	log.Debug("import manager update task import result", zap.Int64("taskID", ir.GetTaskId()))
 
-	found := false
-	var v *datapb.ImportTaskInfo
 	m.workingLock.Lock()
 	defer m.workingLock.Unlock()
-	ok := false
-	var toPersistImportTaskInfo *datapb.ImportTaskInfo
-	if v, ok = m.workingTasks[ir.GetTaskId()]; ok {
-		// If the task has already been marked failed. Prevent further state updating and return an error.
-		if v.GetState().GetStateCode() == commonpb.ImportState_ImportFailed ||
-			v.GetState().GetStateCode() == commonpb.ImportState_ImportFailedAndCleaned {
-			log.Warn("trying to update an already failed task which will end up being a no-op")
-			return nil, errors.New("trying to update an already failed task " + strconv.FormatInt(ir.GetTaskId(), 10))
-		}
-		found = true
-		// Meta persist should be done before memory objs change.
-		toPersistImportTaskInfo = cloneImportTaskInfo(v)
-		toPersistImportTaskInfo.State.StateCode = ir.GetState()
-		toPersistImportTaskInfo.State.Segments = ir.GetSegments()
-		toPersistImportTaskInfo.State.RowCount = ir.GetRowCount()
-		toPersistImportTaskInfo.State.RowIds = ir.GetAutoIds()
-		for _, kv := range ir.GetInfos() {
-			if kv.GetKey() == FailedReason {
-				toPersistImportTaskInfo.State.ErrorMessage = kv.GetValue()
-				break
-			}
-		}
-		// Update task in task store.
-		if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
-			log.Error("failed to update import task",
-				zap.Int64("task ID", v.GetId()),
-				zap.Error(err))
-			return nil, err
-		}
-		m.workingTasks[ir.GetTaskId()] = toPersistImportTaskInfo
-	}
-
-	if !found {
+	// The task ID of the import result is the ID of a sub-task, the first sub-task shares the ID of its task.
+	v, subTasks := m.findWorkingTask(ir.GetTaskId())
+	if v == nil {
 		log.Debug("import manager update task import result failed", zap.Int64("task ID", ir.GetTaskId()))
 		return nil, errors.New("failed to update import task, ID not found: " + strconv.FormatInt(ir.TaskId, 10))
 	}
+	// If the task has already been marked failed. Prevent further state updating and return an error.
+	if v.GetState().GetStateCode() == commonpb.ImportState_ImportFailed ||
+		v.GetState().GetStateCode() == commonpb.ImportState_ImportFailedAndCleaned {
+		log.Warn("trying to update an already failed task which will end up being a no-op")
+		return nil, fmt.Errorf("%w, ID: %d", errImportTaskFailed, ir.GetTaskId())
+	}
+	// Meta persist should be done before memory objs change.
+	toPersistImportTaskInfo := cloneImportTaskInfo(v)
+	failedReason := ""
+	for _, kv := range ir.GetInfos() {
+		if kv.GetKey() == FailedReason {
+			failedReason = kv.GetValue()
+		} else if kv.GetKey() == importutil.ErrorReportKey {
+			// the report of bad rows is persisted along with the task infos
+			toPersistImportTaskInfo.Infos = setKeyValue(toPersistImportTaskInfo.GetInfos(), kv)
+		}
+	}
+	updateImportSubTask(findImportSubTask(subTasks, ir.GetTaskId()), ir, failedReason)
+	mergeImportSubTasks(toPersistImportTaskInfo, subTasks)
+	if toPersistImportTaskInfo.GetState().GetStateCode() == commonpb.ImportState_ImportFailed {
+		skipImportSubTasks(subTasks)
+	}
+	setImportSubTasks(toPersistImportTaskInfo, subTasks)
+	// Update task in task store.
+	if err := m.persistTaskInfo(toPersistImportTaskInfo); err != nil {
+		log.Error("failed to update import task",
+			zap.Int64("task ID", v.GetId()),
+			zap.Error(err))
+		return nil, err
+	}
+	m.workingTasks[v.GetId()] = toPersistImportTaskInfo
 	return toPersistImportTaskInfo, nil
 }
 
+// findWorkingTask returns the working task which the sub-task belongs to, and the sub-tasks of the working task.
+// The caller should hold the workingLock.
+func (m *importManager) findWorkingTask(subTaskID int64) (*datapb.ImportTaskInfo, []*datapb.ImportSubTaskInfo) {
+	if v, ok := m.workingTasks[subTaskID]; ok {
+		return v, getImportSubTasks(v)
+	}
+	for _, v := range m.workingTasks {
+		subTasks := getImportSubTasks(v)
+		if findImportSubTask(subTasks, subTaskID) != nil {
+			return v, subTasks
+		}
+	}
+	return nil, nil
+}
+
 // setImportTaskState sets the task state of an import task. Changes to the import task state will be persisted.
 func (m *importManager) setImportTaskState(taskID int64, targetState commonpb.ImportState) error {
 	return m.setImportTaskStateAndReason(taskID, targetState, "")
@@ -715,6 +806,26 @@ func (m *importManager) copyTaskInfo(input *datapb.ImportTaskInfo, output *milvu
 		Key:   FailedReason,
 		Value: input.GetState().GetErrorMessage(),
 	})
+	output.Infos = append(output.Infos, &commonpb.KeyValuePair{
+		Key:   ProgressPercent,
+		Value: strconv.FormatInt(importProgressPercent(input), 10),
+	})
+	for _, kv := range input.GetInfos() {
+		if kv.GetKey() == importutil.ErrorReportKey {
+			output.Infos = append(output.Infos, kv)
+		}
+	}
+}
+
+// setKeyValue returns a copy of the key-value pairs in which the value of the key is replaced or appended
+func setKeyValue(kvs []*commonpb.KeyValuePair, kv *commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	result := make([]*commonpb.KeyValuePair, 0, len(kvs)+1)
+	for _, old := range kvs {
+		if old.GetKey() != kv.GetKey() {
+			result = append(result, old)
+		}
+	}
+	return append(result, kv)
 }
 
 // getTaskState looks for task with the given ID and returns its import state.
@@ -794,9 +905,16 @@ func (m *importManager) loadFromTaskStore(load2Mem bool) ([]*datapb.ImportTaskIn
 		}
 
 		if load2Mem {
+			// the credentials of import source are not persisted, such pending tasks cannot be sent out any more
+			lostCredentials := ti.GetState().GetStateCode() == commonpb.ImportState_ImportPending &&
+				importutil.SourceRequiresCredentials(ti.GetInfos())
+			if lostCredentials {
+				ti.State.ErrorMessage = "credentials of the import source are not persisted"
+			}
 			// Put pending tasks back to pending task list.
-			if ti.GetState().GetStateCode() == commonpb.ImportState_ImportPending {
+			if ti.GetState().GetStateCode() == commonpb.ImportState_ImportPending && !lostCredentials {
 				log.Info("task has been reloaded as a pending task", zap.Int64("task ID", ti.GetId()))
+				resetImportSubTasks(ti)
 				m.pendingLock.Lock()
 				m.pendingTasks = append(m.pendingTasks, ti)
 				m.pendingLock.Unlock()
@@ -880,10 +998,15 @@ func (m *importManager) expireOldTasksFromMem() {
 					zap.Float64("ImportTaskExpiration", Params.RootCoordCfg.ImportTaskExpiration))
 				taskID := v.GetId()
 				m.workingLock.Unlock()
-				// Remove DataNode from busy node list, so it can serve other tasks again.
+				// Remove DataNodes of the started sub-tasks from busy node list, so they can serve other tasks again.
 				m.busyNodesLock.Lock()
-				delete(m.busyNodes, v.GetDatanodeId())
+				for _, subTask := range getImportSubTasks(v) {
+					if subTask.State == commonpb.ImportState_ImportStarted {
+						delete(m.busyNodes, subTask.GetDatanodeId())
+					}
+				}
 				m.busyNodesLock.Unlock()
+				m.releaseSourceCredentials(taskID)
 
 				if err := m.setImportTaskStateAndReason(taskID, commonpb.ImportState_ImportFailed,
 					"the import task has timed out"); err != nil {
@@ -1069,6 +1192,7 @@ func cloneImportTaskInfo(taskInfo *datapb.ImportTaskInfo) *datapb.ImportTaskInfo
 		PartitionName:  taskInfo.GetPartitionName(),
 		Infos:          taskInfo.GetInfos(),
 		StartTs:        taskInfo.GetStartTs(),
+		SubTasks:       taskInfo.GetSubTasks(),
 	}
 	return cloned
 }
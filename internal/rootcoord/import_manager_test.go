@@ -19,6 +19,7 @@ package rootcoord
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"testing"
@@ -530,7 +531,17 @@ func TestImportManager_TestFlipTaskStateLoop(t *testing.T) {
 	wg.Wait()
 }
 
+// disableImportChunks makes each file of an import job one sub-task, it returns a function to restore the setting.
+func disableImportChunks() func() {
+	chunks := Params.RootCoordCfg.ImportChunksPerFile
+	Params.RootCoordCfg.ImportChunksPerFile = 1
+	return func() {
+		Params.RootCoordCfg.ImportChunksPerFile = chunks
+	}
+}
+
 func TestImportManager_ImportJob(t *testing.T) {
+	defer disableImportChunks()()
 	var countLock sync.RWMutex
 	var globalCount = typeutil.UniqueID(0)
 
@@ -564,10 +575,6 @@ func TestImportManager_ImportJob(t *testing.T) {
 	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
 	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 
-	// row-based import not allow multiple files
-	resp = mgr.importJob(context.TODO(), rowReq, colID, 0)
-	assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
-
 	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
 		return &datapb.ImportTaskResponse{
 			Status: &commonpb.Status{
@@ -616,6 +623,27 @@ func TestImportManager_ImportJob(t *testing.T) {
 	resp = mgr.importJob(context.TODO(), colReq, colID, 0)
 	assert.Equal(t, 0, len(mgr.pendingTasks))
 	assert.Equal(t, 1, len(mgr.workingTasks))
+	assert.Equal(t, 1, len(getImportSubTasks(mgr.workingTasks[resp.GetTasks()[0]])))
+
+	// row-based case with multiple files, one task is created and each file makes a sub-task
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, callMarkSegmentsDropped, nil, nil, nil, nil)
+	resp = mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.json", "f2.json", "f3.json"},
+	}, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(resp.GetTasks()))
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	assert.Equal(t, 1, len(mgr.workingTasks))
+	subTasks := getImportSubTasks(mgr.workingTasks[resp.GetTasks()[0]])
+	assert.Equal(t, 3, len(subTasks))
+	assert.Equal(t, resp.GetTasks()[0], subTasks[0].Id)
+	for i, subTask := range subTasks {
+		assert.Equal(t, []string{fmt.Sprintf("f%d.json", i+1)}, subTask.Files)
+		assert.Equal(t, commonpb.ImportState_ImportStarted, subTask.State)
+		assert.Equal(t, int64(1), subTask.Attempts)
+	}
 
 	count := 0
 	importServiceFunc = func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
@@ -656,7 +684,111 @@ func TestImportManager_ImportJob(t *testing.T) {
 	}
 }
 
+func TestImportManager_SourceCredentials(t *testing.T) {
+	defer disableImportChunks()()
+	var countLock sync.RWMutex
+	var globalCount = typeutil.UniqueID(0)
+
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	colID := int64(100)
+	mockKv := memkv.NewMemoryKV()
+
+	var sentInfos []*commonpb.KeyValuePair
+	rejected := true
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		if rejected {
+			return &datapb.ImportTaskResponse{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				},
+			}, nil
+		}
+		sentInfos = req.GetImportTask().GetInfos()
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+		}, nil
+	}
+
+	req := &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.json"},
+		Options: []*commonpb.KeyValuePair{
+			{Key: importutil.SourceType, Value: importutil.SourceTypeMinio},
+			{Key: importutil.SourceAddress, Value: "localhost:9000"},
+			{Key: importutil.Bucket, Value: "a"},
+			{Key: importutil.SourceAccessKeyID, Value: "id"},
+			{Key: importutil.SourceSecretAccessKey, Value: "secret"},
+		},
+	}
+
+	// the task is pending, the credentials are kept in memory only
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	resp := mgr.importJob(context.TODO(), req, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+	taskID := mgr.pendingTasks[0].GetId()
+	assert.Equal(t, 3, len(mgr.pendingTasks[0].GetInfos()))
+	assert.Equal(t, 2, len(mgr.sourceCredentials[taskID]))
+	value, err := mockKv.Load(BuildImportTaskKey(taskID))
+	assert.NoError(t, err)
+	assert.NotContains(t, value, "secret")
+
+	// the credentials are sent to dataNode along with the task, and kept until the task finishes
+	rejected = false
+	err = mgr.sendOutTasks(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	assert.Equal(t, 1, len(mgr.workingTasks))
+	assert.Equal(t, 5, len(sentInfos))
+	assert.Equal(t, 3, len(mgr.workingTasks[taskID].GetInfos()))
+	assert.Equal(t, 2, len(mgr.sourceCredentials[taskID]))
+	mgr.releaseSourceCredentials(taskID)
+	assert.Empty(t, mgr.sourceCredentials)
+	value, err = mockKv.Load(BuildImportTaskKey(taskID))
+	assert.NoError(t, err)
+	assert.NotContains(t, value, "secret")
+
+	// the pending task requiring credentials is marked failed after restart
+	rejected = true
+	mockKv = memkv.NewMemoryKV()
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	mgr.importJob(context.TODO(), req, colID, 0)
+	req.Options = []*commonpb.KeyValuePair{
+		{Key: importutil.SourceType, Value: importutil.SourceTypeMinio},
+		{Key: importutil.SourceAddress, Value: "s3.amazonaws.com"},
+		{Key: importutil.Bucket, Value: "a"},
+		{Key: importutil.SourceUseIAM, Value: "true"},
+	}
+	mgr.importJob(context.TODO(), req, colID, 0)
+	assert.Equal(t, 2, len(mgr.pendingTasks))
+	lostTaskID := mgr.pendingTasks[0].GetId()
+
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	_, err = mgr.loadFromTaskStore(true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(mgr.pendingTasks))
+	assert.NotEqual(t, lostTaskID, mgr.pendingTasks[0].GetId())
+	tasks, err := mgr.loadFromTaskStore(false)
+	assert.NoError(t, err)
+	for _, task := range tasks {
+		if task.GetId() == lostTaskID {
+			assert.Equal(t, commonpb.ImportState_ImportFailed, task.GetState().GetStateCode())
+			assert.Contains(t, task.GetState().GetErrorMessage(), "credentials")
+		}
+	}
+}
+
 func TestImportManager_AllDataNodesBusy(t *testing.T) {
+	defer disableImportChunks()()
 	var countLock sync.RWMutex
 	var globalCount = typeutil.UniqueID(0)
 
@@ -753,6 +885,7 @@ func TestImportManager_AllDataNodesBusy(t *testing.T) {
 }
 
 func TestImportManager_TaskState(t *testing.T) {
+	defer disableImportChunks()()
 	var countLock sync.RWMutex
 	var globalCount = typeutil.UniqueID(0)
 
@@ -847,17 +980,318 @@ func TestImportManager_TaskState(t *testing.T) {
 				Key:   "failed_reason",
 				Value: "some_reason",
 			},
+			importutil.RetryableInfo(),
 		},
 	}
+	// the failed task is retried until it runs out of attempts
+	for i := 1; i < Params.RootCoordCfg.ImportSubTaskMaxAttempts; i++ {
+		newTaskInfo, err := mgr.updateTaskInfo(info)
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ImportState_ImportStarted, newTaskInfo.GetState().GetStateCode())
+		assert.Equal(t, commonpb.ImportState_ImportPending, getImportSubTasks(newTaskInfo)[0].State)
+		err = mgr.sendOutTasks(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(i+1), getImportSubTasks(mgr.workingTasks[1])[0].Attempts)
+	}
 	newTaskInfo, err := mgr.updateTaskInfo(info)
 	assert.NoError(t, err)
 	assert.Equal(t, commonpb.ImportState_ImportFailed, newTaskInfo.GetState().GetStateCode())
+	assert.Equal(t, "some_reason", newTaskInfo.GetState().GetErrorMessage())
 
 	newTaskInfo, err = mgr.updateTaskInfo(info)
 	assert.Error(t, err)
 	assert.Nil(t, newTaskInfo)
 }
 
+func TestImportManager_SubTasks(t *testing.T) {
+	defer disableImportChunks()()
+	var countLock sync.RWMutex
+	var globalCount = typeutil.UniqueID(0)
+
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	colID := int64(100)
+	mockKv := memkv.NewMemoryKV()
+
+	// assign a sub-task to the first DataNode which is not in the working nodes
+	dnList := []int64{1, 2, 3}
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		for _, nodeID := range dnList {
+			working := false
+			for _, workingNode := range req.GetWorkingNodes() {
+				working = working || workingNode == nodeID
+			}
+			if !working {
+				return &datapb.ImportTaskResponse{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_Success,
+					},
+					DatanodeId: nodeID,
+				}, nil
+			}
+		}
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			},
+		}, nil
+	}
+	progressFunc := func(state *milvuspb.GetImportStateResponse) string {
+		for _, kv := range state.GetInfos() {
+			if kv.GetKey() == ProgressPercent {
+				return kv.GetValue()
+			}
+		}
+		return ""
+	}
+	releaseNodeFunc := func(mgr *importManager, nodeID int64) {
+		mgr.busyNodesLock.Lock()
+		defer mgr.busyNodesLock.Unlock()
+		delete(mgr.busyNodes, nodeID)
+	}
+
+	// 4 files make 4 sub-tasks, the first 3 sub-tasks are executed by 3 DataNodes in parallel
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	resp := mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.json", "f2.json", "f3.json", "f4.json"},
+	}, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	taskID := resp.GetTasks()[0]
+	assert.Equal(t, 0, len(mgr.pendingTasks))
+	subTasks := getImportSubTasks(mgr.workingTasks[taskID])
+	assert.Equal(t, 4, len(subTasks))
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, commonpb.ImportState_ImportStarted, subTasks[i].State)
+		assert.Equal(t, dnList[i], subTasks[i].DatanodeId)
+	}
+	assert.Equal(t, commonpb.ImportState_ImportPending, subTasks[3].State)
+	state := mgr.getTaskState(taskID)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, state.GetState())
+	assert.Equal(t, "0", progressFunc(state))
+
+	// the progress of a sub-task
+	ti, err := mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     subTasks[2].Id,
+		DatanodeId: 3,
+		State:      commonpb.ImportState_ImportStarted,
+		RowCount:   50,
+		Infos: []*commonpb.KeyValuePair{
+			{Key: importutil.ProcessedBytesKey, Value: "50"},
+			{Key: importutil.TotalBytesKey, Value: "100"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, ti.GetState().GetStateCode())
+	assert.Equal(t, int64(50), ti.GetState().GetRowCount())
+	assert.Equal(t, "12", progressFunc(mgr.getTaskState(taskID)))
+
+	// the first sub-task is persisted
+	ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     subTasks[0].Id,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportPersisted,
+		RowCount:   100,
+		Segments:   []int64{10},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, ti.GetState().GetStateCode())
+	assert.Equal(t, "37", progressFunc(mgr.getTaskState(taskID)))
+	releaseNodeFunc(mgr, 1)
+
+	// the second sub-task fails, it is retried on another DataNode
+	ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     subTasks[1].Id,
+		DatanodeId: 2,
+		State:      commonpb.ImportState_ImportFailed,
+		Segments:   []int64{20},
+		Infos:      []*commonpb.KeyValuePair{{Key: FailedReason, Value: "some_reason"}, importutil.RetryableInfo()},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, ti.GetState().GetStateCode())
+	assert.Equal(t, []int64{10}, ti.GetState().GetSegments())
+	releaseNodeFunc(mgr, 2)
+	err = mgr.sendOutTasks(context.TODO())
+	assert.NoError(t, err)
+	subTasks = getImportSubTasks(mgr.workingTasks[taskID])
+	assert.Equal(t, commonpb.ImportState_ImportStarted, subTasks[1].State)
+	assert.Equal(t, int64(1), subTasks[1].DatanodeId)
+	assert.Equal(t, int64(2), subTasks[1].Attempts)
+	assert.Equal(t, []int64{2}, subTasks[1].FailedNodes)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, subTasks[3].State)
+	assert.Equal(t, int64(2), subTasks[3].DatanodeId)
+
+	// the task is persisted once all sub-tasks are persisted
+	for i := 1; i < 4; i++ {
+		ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+			TaskId:     subTasks[i].Id,
+			DatanodeId: subTasks[i].DatanodeId,
+			State:      commonpb.ImportState_ImportPersisted,
+			RowCount:   100,
+			Segments:   []int64{int64(i+1) * 10},
+		})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, ti.GetState().GetStateCode())
+	assert.Equal(t, int64(400), ti.GetState().GetRowCount())
+	assert.Equal(t, []int64{10, 20, 30, 40}, ti.GetState().GetSegments())
+	assert.Equal(t, "100", progressFunc(mgr.getTaskState(taskID)))
+
+	// a sub-task failed by its data files fails the task at once, the pending sub-tasks are skipped and the
+	// running ones are not able to report
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	resp = mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.json", "f2.json", "f3.json", "f4.json"},
+	}, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	taskID = resp.GetTasks()[0]
+	subTasks = getImportSubTasks(mgr.workingTasks[taskID])
+	ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     subTasks[0].Id,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportFailed,
+		Infos:      []*commonpb.KeyValuePair{{Key: FailedReason, Value: "too many bad rows"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, ti.GetState().GetStateCode())
+	assert.Equal(t, "too many bad rows", ti.GetState().GetErrorMessage())
+	subTasks = getImportSubTasks(ti)
+	assert.Equal(t, int64(1), subTasks[0].Attempts)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, subTasks[1].State)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, subTasks[3].State)
+	_, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:     subTasks[1].Id,
+		DatanodeId: 2,
+		State:      commonpb.ImportState_ImportPersisted,
+		Segments:   []int64{2},
+	})
+	assert.ErrorIs(t, err, errImportTaskFailed)
+
+	// the task fails once a sub-task runs out of attempts, the segments of all sub-tasks are cleaned up with the task
+	defer func(attempts int) {
+		Params.RootCoordCfg.ImportSubTaskMaxAttempts = attempts
+	}(Params.RootCoordCfg.ImportSubTaskMaxAttempts)
+	Params.RootCoordCfg.ImportSubTaskMaxAttempts = 1
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	resp = mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.json", "f2.json"},
+	}, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	taskID = resp.GetTasks()[0]
+	subTasks = getImportSubTasks(mgr.workingTasks[taskID])
+	_, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:   subTasks[0].Id,
+		State:    commonpb.ImportState_ImportPersisted,
+		Segments: []int64{1},
+	})
+	assert.NoError(t, err)
+	ti, err = mgr.updateTaskInfo(&rootcoordpb.ImportResult{
+		TaskId:   subTasks[1].Id,
+		State:    commonpb.ImportState_ImportFailed,
+		Segments: []int64{2},
+		Infos:    []*commonpb.KeyValuePair{{Key: FailedReason, Value: "some_reason"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, ti.GetState().GetStateCode())
+	assert.Equal(t, "some_reason", ti.GetState().GetErrorMessage())
+	assert.Equal(t, []int64{1, 2}, ti.GetState().GetSegments())
+}
+
+func TestImportManager_ImportChunks(t *testing.T) {
+	defer func(chunks int) {
+		Params.RootCoordCfg.ImportChunksPerFile = chunks
+	}(Params.RootCoordCfg.ImportChunksPerFile)
+	Params.RootCoordCfg.ImportChunksPerFile = 2
+
+	var countLock sync.RWMutex
+	var globalCount = typeutil.UniqueID(0)
+
+	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
+		countLock.Lock()
+		defer countLock.Unlock()
+		globalCount++
+		return globalCount, 0, nil
+	}
+	Params.RootCoordCfg.ImportTaskSubPath = "test_import_task"
+	colID := int64(100)
+	mockKv := memkv.NewMemoryKV()
+
+	sentTasks := make([]*datapb.ImportTask, 0)
+	importServiceFunc := func(ctx context.Context, req *datapb.ImportTaskRequest) (*datapb.ImportTaskResponse, error) {
+		sentTasks = append(sentTasks, req.GetImportTask())
+		return &datapb.ImportTaskResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DatanodeId: int64(len(sentTasks)),
+		}, nil
+	}
+
+	// each row-based file is split into chunks
+	mgr := newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	resp := mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.json", "f2.csv"},
+	}, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	subTasks := getImportSubTasks(mgr.workingTasks[resp.GetTasks()[0]])
+	assert.Equal(t, 4, len(subTasks))
+	assert.Equal(t, 4, len(sentTasks))
+	for i, subTask := range subTasks {
+		assert.Equal(t, []string{[]string{"f1.json", "f2.csv"}[i/2]}, subTask.GetFiles())
+		assert.Equal(t, int64(i%2), subTask.GetChunkIndex())
+		assert.Equal(t, int64(2), subTask.GetChunkCount())
+		assert.Equal(t, subTask.GetId(), sentTasks[i].GetTaskId())
+		assert.Equal(t, subTask.GetFiles(), sentTasks[i].GetFiles())
+		assert.Equal(t, subTask.GetChunkIndex(), sentTasks[i].GetChunkIndex())
+		assert.Equal(t, subTask.GetChunkCount(), sentTasks[i].GetChunkCount())
+	}
+
+	// the numpy files of a column-based job are split together
+	sentTasks = sentTasks[:0]
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	resp = mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"f1.npy", "f2.npy"},
+	}, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	subTasks = getImportSubTasks(mgr.workingTasks[resp.GetTasks()[0]])
+	assert.Equal(t, 2, len(subTasks))
+	for i, subTask := range subTasks {
+		assert.Equal(t, []string{"f1.npy", "f2.npy"}, subTask.GetFiles())
+		assert.Equal(t, int64(i), subTask.GetChunkIndex())
+		assert.Equal(t, int64(2), subTask.GetChunkCount())
+		assert.Equal(t, int64(i), sentTasks[i].GetChunkIndex())
+	}
+
+	// a backup import is not split
+	sentTasks = sentTasks[:0]
+	mgr = newImportManager(context.TODO(), mockKv, idAlloc, importServiceFunc, nil, nil, nil, nil, nil)
+	resp = mgr.importJob(context.TODO(), &milvuspb.ImportRequest{
+		CollectionName: "c1",
+		PartitionName:  "p1",
+		Files:          []string{"insert_log", "delta_log"},
+		Options:        []*commonpb.KeyValuePair{{Key: importutil.BackupFlag, Value: "true"}},
+	}, colID, 0)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	subTasks = getImportSubTasks(mgr.workingTasks[resp.GetTasks()[0]])
+	assert.Equal(t, 1, len(subTasks))
+	assert.Equal(t, int64(0), subTasks[0].GetChunkCount())
+	assert.Equal(t, int64(0), sentTasks[0].GetChunkCount())
+}
+
 func TestImportManager_AllocFail(t *testing.T) {
 	var idAlloc = func(count uint32) (typeutil.UniqueID, typeutil.UniqueID, error) {
 		return 0, 0, errors.New("injected failure")
@@ -891,6 +1325,7 @@ func TestImportManager_AllocFail(t *testing.T) {
 }
 
 func TestImportManager_ListAllTasks(t *testing.T) {
+	defer disableImportChunks()()
 	var countLock sync.RWMutex
 	var globalCount = typeutil.UniqueID(0)
 
@@ -1117,7 +1552,7 @@ func TestImportManager_isRowbased(t *testing.T) {
 
 	files = []string{"1.json", "2.json"}
 	rb, err = mgr.isRowbased(files)
-	assert.NotNil(t, err)
+	assert.Nil(t, err)
 	assert.True(t, rb)
 
 	files = []string{"1.json", "2.npy"}
@@ -1129,6 +1564,31 @@ func TestImportManager_isRowbased(t *testing.T) {
 	rb, err = mgr.isRowbased(files)
 	assert.Nil(t, err)
 	assert.False(t, rb)
+
+	files = []string{"1.parquet"}
+	rb, err = mgr.isRowbased(files)
+	assert.Nil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.parquet", "2.json"}
+	rb, err = mgr.isRowbased(files)
+	assert.Nil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.parquet", "2.npy"}
+	rb, err = mgr.isRowbased(files)
+	assert.NotNil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.csv"}
+	rb, err = mgr.isRowbased(files)
+	assert.Nil(t, err)
+	assert.True(t, rb)
+
+	files = []string{"1.csv", "2.npy"}
+	rb, err = mgr.isRowbased(files)
+	assert.NotNil(t, err)
+	assert.True(t, rb)
 }
 
 func TestImportManager_checkIndexingDone(t *testing.T) {
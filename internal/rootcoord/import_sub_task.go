@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+
+	"go.uber.org/zap"
+)
+
+// ProgressPercent is the key of the percentage of data processed, in the infos of an import state response.
+const ProgressPercent = "progress_percent"
+
+// newImportSubTasks splits an import task into sub-tasks, so that the data is imported by several DataNodes in
+// parallel. Each row-based file is split into chunks of rows, each chunk makes a sub-task. The numpy files of a
+// column-based task are split into chunks together, since a row is made of all the files. A backup import is not
+// split. The first sub-task shares the ID of the task.
+func (m *importManager) newImportSubTasks(taskID int64, files []string, isRowBased bool, isBackup bool) ([]*datapb.ImportSubTaskInfo, error) {
+	chunkCount := int64(Params.RootCoordCfg.ImportChunksPerFile)
+	if chunkCount < 1 || isBackup {
+		chunkCount = 1
+	}
+	fileGroups := [][]string{files}
+	if isRowBased && !isBackup {
+		fileGroups = make([][]string, 0, len(files))
+		for _, file := range files {
+			fileGroups = append(fileGroups, []string{file})
+		}
+	}
+
+	subTasks := make([]*datapb.ImportSubTaskInfo, 0, int64(len(fileGroups))*chunkCount)
+	for _, fileGroup := range fileGroups {
+		for chunk := int64(0); chunk < chunkCount; chunk++ {
+			subTaskID := taskID
+			if len(subTasks) > 0 {
+				var err error
+				if subTaskID, _, err = m.idAllocator(1); err != nil {
+					log.Error("failed to allocate ID for import sub-task", zap.Error(err))
+					return nil, err
+				}
+			}
+			subTask := &datapb.ImportSubTaskInfo{
+				Id:    subTaskID,
+				Files: fileGroup,
+				State: commonpb.ImportState_ImportPending,
+			}
+			if chunkCount > 1 {
+				subTask.ChunkIndex = chunk
+				subTask.ChunkCount = chunkCount
+			}
+			subTasks = append(subTasks, subTask)
+		}
+	}
+	return subTasks, nil
+}
+
+// getImportSubTasks returns a copy of the sub-tasks of an import task, to be modified and set back by
+// setImportSubTasks. A task without sub-tasks, e.g. a task persisted by an older version, has one sub-task which
+// shares the ID and the state of the task.
+func getImportSubTasks(task *datapb.ImportTaskInfo) []*datapb.ImportSubTaskInfo {
+	if len(task.GetSubTasks()) > 0 {
+		subTasks := make([]*datapb.ImportSubTaskInfo, 0, len(task.GetSubTasks()))
+		for _, subTask := range task.GetSubTasks() {
+			subTasks = append(subTasks, proto.Clone(subTask).(*datapb.ImportSubTaskInfo))
+		}
+		return subTasks
+	}
+	return []*datapb.ImportSubTaskInfo{{
+		Id:         task.GetId(),
+		Files:      task.GetFiles(),
+		State:      task.GetState().GetStateCode(),
+		DatanodeId: task.GetDatanodeId(),
+		RowCount:   task.GetState().GetRowCount(),
+		Segments:   task.GetState().GetSegments(),
+		AutoIds:    task.GetState().GetRowIds(),
+	}}
+}
+
+// setImportSubTasks stores the sub-tasks into an import task.
+func setImportSubTasks(task *datapb.ImportTaskInfo, subTasks []*datapb.ImportSubTaskInfo) {
+	task.SubTasks = subTasks
+}
+
+// findImportSubTask returns the sub-task with the given ID, or nil if not found.
+func findImportSubTask(subTasks []*datapb.ImportSubTaskInfo, subTaskID int64) *datapb.ImportSubTaskInfo {
+	for _, subTask := range subTasks {
+		if subTask.Id == subTaskID {
+			return subTask
+		}
+	}
+	return nil
+}
+
+// resetImportSubTasks sets the sub-tasks of a pending task reloaded from task store back to pending, since a pending
+// task has never been sent out.
+func resetImportSubTasks(task *datapb.ImportTaskInfo) {
+	subTasks := getImportSubTasks(task)
+	reset := false
+	for _, subTask := range subTasks {
+		if subTask.State != commonpb.ImportState_ImportPending {
+			subTask.State = commonpb.ImportState_ImportPending
+			reset = true
+		}
+	}
+	if reset {
+		setImportSubTasks(task, subTasks)
+	}
+}
+
+// importTaskOptions returns the infos of an import task to send to DataNode, which are the options of the import
+// request, without the error report.
+func importTaskOptions(infos []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	options := make([]*commonpb.KeyValuePair, 0, len(infos))
+	for _, kv := range infos {
+		if kv.GetKey() != importutil.ErrorReportKey {
+			options = append(options, kv)
+		}
+	}
+	return options
+}
+
+// updateImportSubTask updates a sub-task by the import result reported by DataNode. A sub-task failed by the DataNode
+// or the services it depends on becomes pending again to be retried on another DataNode, until it runs out of
+// attempts. A sub-task failed by its data files, e.g. bad rows or a schema mismatch, fails at once since a retry
+// fails the same way.
+func updateImportSubTask(subTask *datapb.ImportSubTaskInfo, ir *rootcoordpb.ImportResult, failedReason string) {
+	if failedReason != "" {
+		subTask.ErrorMessage = failedReason
+	}
+	switch ir.GetState() {
+	case commonpb.ImportState_ImportStarted:
+		// the DataNode reports the progress of the sub-task, the progress of a finished attempt is ignored
+		if subTask.State != commonpb.ImportState_ImportStarted {
+			return
+		}
+		subTask.RowCount = ir.GetRowCount()
+		if processedBytes, totalBytes, ok := importutil.ParseProgressInfos(ir.GetInfos()); ok {
+			subTask.ProcessedBytes = processedBytes
+			subTask.TotalBytes = totalBytes
+		}
+	case commonpb.ImportState_ImportFailed:
+		if subTask.Attempts < int64(Params.RootCoordCfg.ImportSubTaskMaxAttempts) && importutil.IsRetryable(ir.GetInfos()) {
+			// the segments of the failed attempt are not kept, they are dropped by the caller
+			subTask.State = commonpb.ImportState_ImportPending
+			subTask.FailedNodes = append(subTask.FailedNodes, ir.GetDatanodeId())
+			subTask.RowCount = 0
+			subTask.Segments = nil
+			subTask.AutoIds = nil
+			subTask.ProcessedBytes = 0
+			return
+		}
+		subTask.State = commonpb.ImportState_ImportFailed
+		subTask.RowCount = ir.GetRowCount()
+		subTask.Segments = ir.GetSegments()
+		subTask.AutoIds = ir.GetAutoIds()
+	default:
+		subTask.State = ir.GetState()
+		subTask.RowCount = ir.GetRowCount()
+		subTask.Segments = ir.GetSegments()
+		subTask.AutoIds = ir.GetAutoIds()
+		if ir.GetState() == commonpb.ImportState_ImportPersisted {
+			subTask.ProcessedBytes = subTask.TotalBytes
+		}
+	}
+}
+
+// mergeImportSubTasks sets the state of an import task by its sub-tasks. The task fails once a sub-task fails, and it
+// is persisted once all the sub-tasks are persisted. The row count, segments and auto-IDs of the sub-tasks are
+// merged, so that the segments of a failed task can be cleaned up.
+func mergeImportSubTasks(task *datapb.ImportTaskInfo, subTasks []*datapb.ImportSubTaskInfo) {
+	state := &datapb.ImportTaskState{
+		Segments:     make([]int64, 0),
+		RowIds:       make([]int64, 0),
+		ErrorMessage: task.GetState().GetErrorMessage(),
+	}
+	persisted := 0
+	failedReasons := make([]string, 0)
+	for _, subTask := range subTasks {
+		state.RowCount += subTask.RowCount
+		state.Segments = append(state.Segments, subTask.Segments...)
+		state.RowIds = append(state.RowIds, subTask.AutoIds...)
+		if subTask.State == commonpb.ImportState_ImportPersisted {
+			persisted++
+		} else if subTask.State == commonpb.ImportState_ImportFailed {
+			failedReasons = append(failedReasons, subTask.ErrorMessage)
+		}
+	}
+
+	switch {
+	case len(failedReasons) > 0:
+		state.StateCode = commonpb.ImportState_ImportFailed
+		state.ErrorMessage = strings.Join(failedReasons, "; ")
+	case persisted == len(subTasks):
+		state.StateCode = commonpb.ImportState_ImportPersisted
+	case len(subTasks) == 1 && subTasks[0].State != commonpb.ImportState_ImportPending:
+		state.StateCode = subTasks[0].State
+	default:
+		state.StateCode = commonpb.ImportState_ImportStarted
+	}
+	task.State = state
+}
+
+// skipImportSubTasks fails the pending sub-tasks of a failed import task, so that they are never sent out.
+func skipImportSubTasks(subTasks []*datapb.ImportSubTaskInfo) {
+	for _, subTask := range subTasks {
+		if subTask.State == commonpb.ImportState_ImportPending {
+			subTask.State = commonpb.ImportState_ImportFailed
+			subTask.ErrorMessage = "skipped since another part of the import task failed"
+		}
+	}
+}
+
+// importProgressPercent returns the percentage of data processed by an import task. The progress of sub-tasks is
+// weighted by the size of their files if all the sizes are known, otherwise the sub-tasks are weighted equally.
+func importProgressPercent(task *datapb.ImportTaskInfo) int64 {
+	switch task.GetState().GetStateCode() {
+	case commonpb.ImportState_ImportPending:
+		return 0
+	case commonpb.ImportState_ImportPersisted, commonpb.ImportState_ImportCompleted:
+		return 100
+	}
+
+	subTasks := getImportSubTasks(task)
+	sizeKnown := true
+	var processedBytes, totalBytes int64
+	var fractions float64
+	for _, subTask := range subTasks {
+		done := subTask.State == commonpb.ImportState_ImportPersisted ||
+			subTask.State == commonpb.ImportState_ImportCompleted
+		if subTask.TotalBytes <= 0 {
+			sizeKnown = false
+		} else if done {
+			processedBytes += subTask.TotalBytes
+		} else {
+			processedBytes += subTask.ProcessedBytes
+		}
+		totalBytes += subTask.TotalBytes
+
+		if done {
+			fractions++
+		} else if subTask.TotalBytes > 0 {
+			fractions += float64(subTask.ProcessedBytes) / float64(subTask.TotalBytes)
+		}
+	}
+	if sizeKnown && totalBytes > 0 {
+		return processedBytes * 100 / totalBytes
+	}
+	return int64(fractions * 100 / float64(len(subTasks)))
+}
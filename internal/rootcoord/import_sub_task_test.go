@@ -0,0 +1,190 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportSubTask_getImportSubTasks(t *testing.T) {
+	// a task without sub-tasks has one sub-task sharing its ID and state
+	task := &datapb.ImportTaskInfo{
+		Id:         1,
+		DatanodeId: 2,
+		Files:      []string{"f1.npy", "f2.npy"},
+		State: &datapb.ImportTaskState{
+			StateCode: commonpb.ImportState_ImportPersisted,
+			Segments:  []int64{3},
+			RowCount:  10,
+		},
+	}
+	subTasks := getImportSubTasks(task)
+	assert.Equal(t, 1, len(subTasks))
+	assert.Equal(t, int64(1), subTasks[0].Id)
+	assert.Equal(t, int64(2), subTasks[0].DatanodeId)
+	assert.Equal(t, task.GetFiles(), subTasks[0].Files)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, subTasks[0].State)
+	assert.Equal(t, []int64{3}, subTasks[0].Segments)
+	assert.Equal(t, int64(10), subTasks[0].RowCount)
+
+	subTasks = []*datapb.ImportSubTaskInfo{
+		{Id: 1, Files: []string{"f1.json"}, State: commonpb.ImportState_ImportStarted},
+		{Id: 5, Files: []string{"f2.json"}, State: commonpb.ImportState_ImportPending},
+	}
+	setImportSubTasks(task, subTasks)
+	assert.Equal(t, subTasks, getImportSubTasks(task))
+
+	// the sub-tasks returned are copies
+	getImportSubTasks(task)[0].State = commonpb.ImportState_ImportFailed
+	assert.Equal(t, commonpb.ImportState_ImportStarted, task.GetSubTasks()[0].GetState())
+	assert.Equal(t, int64(5), findImportSubTask(getImportSubTasks(task), 5).Id)
+	assert.Nil(t, findImportSubTask(getImportSubTasks(task), 6))
+
+	// sub-tasks of a pending task are reset
+	resetImportSubTasks(task)
+	for _, subTask := range getImportSubTasks(task) {
+		assert.Equal(t, commonpb.ImportState_ImportPending, subTask.State)
+	}
+}
+
+func TestImportSubTask_importTaskOptions(t *testing.T) {
+	infos := []*commonpb.KeyValuePair{
+		{Key: importutil.Bucket, Value: "a"},
+		{Key: importutil.ErrorReportKey, Value: "{}"},
+	}
+	options := importTaskOptions(infos)
+	assert.Equal(t, 1, len(options))
+	assert.Equal(t, importutil.Bucket, options[0].GetKey())
+	assert.Equal(t, 2, len(infos))
+}
+
+func TestImportSubTask_mergeImportSubTasks(t *testing.T) {
+	task := &datapb.ImportTaskInfo{
+		Id: 1,
+		State: &datapb.ImportTaskState{
+			StateCode: commonpb.ImportState_ImportStarted,
+		},
+	}
+
+	// a single sub-task shares its state with the task
+	mergeImportSubTasks(task, []*datapb.ImportSubTaskInfo{{Id: 1, State: commonpb.ImportState_ImportCompleted}})
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, task.GetState().GetStateCode())
+	mergeImportSubTasks(task, []*datapb.ImportSubTaskInfo{{Id: 1, State: commonpb.ImportState_ImportPending}})
+	assert.Equal(t, commonpb.ImportState_ImportStarted, task.GetState().GetStateCode())
+
+	subTasks := []*datapb.ImportSubTaskInfo{
+		{Id: 1, State: commonpb.ImportState_ImportPersisted, RowCount: 10, Segments: []int64{1}, AutoIds: []int64{100, 110}},
+		{Id: 2, State: commonpb.ImportState_ImportStarted, RowCount: 5},
+	}
+	mergeImportSubTasks(task, subTasks)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, task.GetState().GetStateCode())
+	assert.Equal(t, int64(15), task.GetState().GetRowCount())
+	assert.Equal(t, []int64{1}, task.GetState().GetSegments())
+	assert.Equal(t, []int64{100, 110}, task.GetState().GetRowIds())
+
+	subTasks[1].State = commonpb.ImportState_ImportFailed
+	subTasks[1].ErrorMessage = "some_reason"
+	mergeImportSubTasks(task, subTasks)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, task.GetState().GetStateCode())
+	assert.Equal(t, "some_reason", task.GetState().GetErrorMessage())
+}
+
+func TestImportSubTask_updateImportSubTask(t *testing.T) {
+	defer func(attempts int) {
+		Params.RootCoordCfg.ImportSubTaskMaxAttempts = attempts
+	}(Params.RootCoordCfg.ImportSubTaskMaxAttempts)
+	Params.RootCoordCfg.ImportSubTaskMaxAttempts = 2
+
+	// a sub-task failed by the DataNode is retried
+	subTask := &datapb.ImportSubTaskInfo{Id: 1, State: commonpb.ImportState_ImportStarted, Attempts: 1}
+	updateImportSubTask(subTask, &rootcoordpb.ImportResult{
+		TaskId:     1,
+		DatanodeId: 3,
+		State:      commonpb.ImportState_ImportFailed,
+		Segments:   []int64{10},
+		Infos:      []*commonpb.KeyValuePair{importutil.RetryableInfo()},
+	}, "node failure")
+	assert.Equal(t, commonpb.ImportState_ImportPending, subTask.State)
+	assert.Equal(t, []int64{3}, subTask.FailedNodes)
+	assert.Empty(t, subTask.Segments)
+
+	// until it runs out of attempts
+	subTask.State = commonpb.ImportState_ImportStarted
+	subTask.Attempts = 2
+	updateImportSubTask(subTask, &rootcoordpb.ImportResult{
+		TaskId:   1,
+		State:    commonpb.ImportState_ImportFailed,
+		Segments: []int64{20},
+		Infos:    []*commonpb.KeyValuePair{importutil.RetryableInfo()},
+	}, "node failure")
+	assert.Equal(t, commonpb.ImportState_ImportFailed, subTask.State)
+	assert.Equal(t, []int64{20}, subTask.Segments)
+
+	// a sub-task failed by its data files is not retried
+	subTask = &datapb.ImportSubTaskInfo{Id: 2, State: commonpb.ImportState_ImportStarted, Attempts: 1}
+	updateImportSubTask(subTask, &rootcoordpb.ImportResult{
+		TaskId: 2,
+		State:  commonpb.ImportState_ImportFailed,
+	}, "too many bad rows")
+	assert.Equal(t, commonpb.ImportState_ImportFailed, subTask.State)
+	assert.Equal(t, "too many bad rows", subTask.ErrorMessage)
+}
+
+func TestImportSubTask_skipImportSubTasks(t *testing.T) {
+	subTasks := []*datapb.ImportSubTaskInfo{
+		{Id: 1, State: commonpb.ImportState_ImportFailed},
+		{Id: 2, State: commonpb.ImportState_ImportStarted},
+		{Id: 3, State: commonpb.ImportState_ImportPending},
+	}
+	skipImportSubTasks(subTasks)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, subTasks[0].State)
+	assert.Equal(t, commonpb.ImportState_ImportStarted, subTasks[1].State)
+	assert.Equal(t, commonpb.ImportState_ImportFailed, subTasks[2].State)
+	assert.NotEmpty(t, subTasks[2].ErrorMessage)
+}
+
+func TestImportSubTask_importProgressPercent(t *testing.T) {
+	task := &datapb.ImportTaskInfo{
+		Id: 1,
+		State: &datapb.ImportTaskState{
+			StateCode: commonpb.ImportState_ImportPending,
+		},
+	}
+	assert.Equal(t, int64(0), importProgressPercent(task))
+	task.State.StateCode = commonpb.ImportState_ImportCompleted
+	assert.Equal(t, int64(100), importProgressPercent(task))
+
+	// sub-tasks are weighted by the size of files
+	task.State.StateCode = commonpb.ImportState_ImportStarted
+	subTasks := []*datapb.ImportSubTaskInfo{
+		{Id: 1, State: commonpb.ImportState_ImportPersisted, ProcessedBytes: 100, TotalBytes: 100},
+		{Id: 2, State: commonpb.ImportState_ImportStarted, ProcessedBytes: 100, TotalBytes: 300},
+	}
+	setImportSubTasks(task, subTasks)
+	assert.Equal(t, int64(50), importProgressPercent(task))
+
+	// sub-tasks are weighted equally if any size is unknown
+	subTasks = append(subTasks, &datapb.ImportSubTaskInfo{Id: 3, State: commonpb.ImportState_ImportPending})
+	setImportSubTasks(task, subTasks)
+	assert.Equal(t, int64(44), importProgressPercent(task))
+}
@@ -212,6 +212,7 @@ type mockQueryCoord struct {
 	GetSegmentInfoFunc     func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error)
 	GetComponentStatesFunc func(ctx context.Context) (*milvuspb.ComponentStates, error)
 	ReleaseCollectionFunc  func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error)
+	UpdateSchemaFunc       func(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error)
 }
 
 func (m mockQueryCoord) GetSegmentInfo(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
@@ -226,6 +227,10 @@ func (m mockQueryCoord) ReleaseCollection(ctx context.Context, req *querypb.Rele
 	return m.ReleaseCollectionFunc(ctx, req)
 }
 
+func (m mockQueryCoord) UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return m.UpdateSchemaFunc(ctx, req)
+}
+
 func newMockQueryCoord() *mockQueryCoord {
 	return &mockQueryCoord{}
 }
@@ -434,6 +439,9 @@ func withInvalidQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return nil, errors.New("error mock ReleaseCollection")
 	}
+	qc.UpdateSchemaFunc = func(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+		return nil, errors.New("error mock UpdateCollectionSchema")
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return nil, errors.New("error mock GetSegmentInfo")
 	}
@@ -451,6 +459,9 @@ func withFailedQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "mock release collection error"), nil
 	}
+	qc.UpdateSchemaFunc = func(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "mock update collection schema error"), nil
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return &querypb.GetSegmentInfoResponse{
 			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "mock get segment info error"),
@@ -470,6 +481,9 @@ func withValidQueryCoord() Opt {
 	qc.ReleaseCollectionFunc = func(ctx context.Context, req *querypb.ReleaseCollectionRequest) (*commonpb.Status, error) {
 		return succStatus(), nil
 	}
+	qc.UpdateSchemaFunc = func(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+		return succStatus(), nil
+	}
 	qc.GetSegmentInfoFunc = func(ctx context.Context, req *querypb.GetSegmentInfoRequest) (*querypb.GetSegmentInfoResponse, error) {
 		return &querypb.GetSegmentInfoResponse{
 			Status: succStatus(),
@@ -827,6 +841,7 @@ type mockBroker struct {
 	GetSegmentIndexStateFunc func(ctx context.Context, collID UniqueID, indexName string, segIDs []UniqueID) ([]*indexpb.SegmentIndexState, error)
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
+	BroadcastAlteredSchemaFunc     func(ctx context.Context, collectionID UniqueID, ts Timestamp) error
 }
 
 func newMockBroker() *mockBroker {
@@ -861,6 +876,10 @@ func (b mockBroker) BroadcastAlteredCollection(ctx context.Context, req *milvusp
 	return b.BroadcastAlteredCollectionFunc(ctx, req)
 }
 
+func (b mockBroker) BroadcastAlteredSchema(ctx context.Context, collectionID UniqueID, ts Timestamp) error {
+	return b.BroadcastAlteredSchemaFunc(ctx, collectionID, ts)
+}
+
 func withBroker(b Broker) Opt {
 	return func(c *Core) {
 		c.broker = b
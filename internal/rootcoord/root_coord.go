@@ -59,6 +59,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/errorutil"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -1102,6 +1103,60 @@ func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollection
 	return succStatus(), nil
 }
 
+// AddCollectionField appends a nullable or defaulted scalar field to the collection
+func (c *Core) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+commonpb.StateCode_name[int32(code)]), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AddCollectionField")
+
+	log.Ctx(ctx).Info("received request to add collection field",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.String("field", in.GetField().GetName()))
+
+	t := &addCollectionFieldTask{
+		baseTask: baseTask{
+			ctx:  ctx,
+			core: c,
+			done: make(chan error, 1),
+		},
+		Req: in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to add collection field",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to add collection field",
+			zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("name", in.GetCollectionName()),
+			zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return failStatus(commonpb.ErrorCode_UnexpectedError, err.Error()), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AddCollectionField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to add collection field",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("name", in.GetCollectionName()),
+		zap.Uint64("ts", t.GetTs()))
+	return succStatus(), nil
+}
+
 // CreatePartition create partition
 func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
 	if code, ok := c.checkHealthy(); !ok {
@@ -1761,43 +1816,96 @@ func (c *Core) ReportImport(ctx context.Context, ir *rootcoordpb.ImportResult) (
 			ErrorCode: commonpb.ErrorCode_Success,
 		}, nil
 	}
+	// This method update a busy node to idle node
+	releaseNodeFunc := func() {
+		c.importManager.busyNodesLock.Lock()
+		defer c.importManager.busyNodesLock.Unlock()
+		delete(c.importManager.busyNodes, ir.GetDatanodeId())
+		log.Info("a DataNode is no longer busy after processing task",
+			zap.Int64("dataNode ID", ir.GetDatanodeId()),
+			zap.Int64("task ID", ir.GetTaskId()))
+	}
+
+	// This method drops the segments generated by a sub-task which is not going to be part of the import task
+	dropSegmentsFunc := func() {
+		if len(ir.GetSegments()) == 0 {
+			return
+		}
+		status, err := c.importManager.callMarkSegmentsDropped(ctx, ir.GetSegments())
+		if err != nil || status.GetErrorCode() != commonpb.ErrorCode_Success {
+			log.Error("failed to mark segments of a failed import sub-task dropped",
+				zap.Int64("task ID", ir.GetTaskId()),
+				zap.Int64s("segments", ir.GetSegments()),
+				zap.Error(err))
+		}
+	}
+
 	// Upon receiving ReportImport request, update the related task's state in task store.
 	ti, err := c.importManager.updateTaskInfo(ir)
 	if err != nil {
+		// The DataNode has finished the sub-task of an unknown or failed task, it is able to serve other tasks.
+		if ir.GetState() == commonpb.ImportState_ImportFailed || ir.GetState() == commonpb.ImportState_ImportPersisted {
+			releaseNodeFunc()
+			// A sibling sub-task which was running when the task failed, its segments are not cleaned up along with
+			// the task.
+			if errors.Is(err, errImportTaskFailed) {
+				dropSegmentsFunc()
+			}
+		}
 		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UpdateImportTaskFailure,
 			Reason:    err.Error(),
 		}, nil
 	}
+	// The credentials of import source are no longer needed once the task fails or all its sub-tasks are persisted.
+	if ti.GetState().GetStateCode() == commonpb.ImportState_ImportFailed ||
+		ti.GetState().GetStateCode() == commonpb.ImportState_ImportPersisted {
+		c.importManager.releaseSourceCredentials(ti.GetId())
+	}
 
 	// This method update a busy node to idle node, and send import task to idle node
 	resendTaskFunc := func() {
-		func() {
-			c.importManager.busyNodesLock.Lock()
-			defer c.importManager.busyNodesLock.Unlock()
-			delete(c.importManager.busyNodes, ir.GetDatanodeId())
-			log.Info("a DataNode is no longer busy after processing task",
-				zap.Int64("dataNode ID", ir.GetDatanodeId()),
-				zap.Int64("task ID", ir.GetTaskId()))
-
-		}()
+		releaseNodeFunc()
 		err := c.importManager.sendOutTasks(c.importManager.ctx)
 		if err != nil {
 			log.Error("fail to send out import task to datanodes")
 		}
 	}
 
-	// If task failed, send task to idle datanode
-	if ir.GetState() == commonpb.ImportState_ImportFailed {
+	if ir.GetState() == commonpb.ImportState_ImportStarted {
+		// The DataNode reports the progress of a sub-task, and it is still busy.
+		log.Debug("import task progress reported",
+			zap.Int64("task ID", ir.GetTaskId()),
+			zap.Int64("row count", ir.GetRowCount()))
+	} else if ir.GetState() == commonpb.ImportState_ImportFailed {
 		// When a DataNode failed importing, remove this DataNode from the busy node list and send out import tasks again.
+		// The failed sub-task is retried on another DataNode unless the task has failed, the segments of the failed
+		// attempt are dropped either way.
 		log.Info("an import task has failed, marking DataNode available and resending import task",
 			zap.Int64("task ID", ir.GetTaskId()))
+		dropSegmentsFunc()
 		resendTaskFunc()
 	} else if ir.GetState() != commonpb.ImportState_ImportPersisted {
 		log.Debug("unexpected import task state reported, return immediately (this should not happen)",
 			zap.Any("task ID", ir.GetTaskId()),
 			zap.Any("import state", ir.GetState()))
 		resendTaskFunc()
+	} else if importutil.IsValidateOnly(ti.GetInfos()) {
+		// A validate-only task generates no segment, it is completed once the files of all sub-tasks are validated.
+		resendTaskFunc()
+		if ti.GetState().GetStateCode() != commonpb.ImportState_ImportPersisted {
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			}, nil
+		}
+		if err := c.importManager.setImportTaskState(ti.GetId(), commonpb.ImportState_ImportCompleted); err != nil {
+			log.Error("failed to set validate-only import task as ImportState_ImportCompleted",
+				zap.Int64("task ID", ti.GetId()), zap.Error(err))
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			}, nil
+		}
 	} else {
 		// Here ir.GetState() == commonpb.ImportState_ImportPersisted
 		// When a DataNode finishes importing, remove this DataNode from the busy node list and send out import tasks again.
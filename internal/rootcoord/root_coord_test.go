@@ -24,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -791,6 +792,7 @@ func TestRootCoord_GetMetrics(t *testing.T) {
 }
 
 func TestCore_Import(t *testing.T) {
+	defer disableImportChunks()()
 	meta := newMockMetaTable()
 	meta.AddCollectionFunc = func(ctx context.Context, coll *model.Collection) error {
 		return nil
@@ -1044,6 +1046,7 @@ func TestCore_ListImportTasks(t *testing.T) {
 }
 
 func TestCore_ReportImport(t *testing.T) {
+	defer disableImportChunks()()
 	Params.RootCoordCfg.ImportTaskSubPath = "importtask"
 	var countLock sync.RWMutex
 	var globalCount = typeutil.UniqueID(0)
@@ -1202,6 +1205,123 @@ func TestCore_ReportImport(t *testing.T) {
 		err = c.importManager.setImportTaskState(100, commonpb.ImportState_ImportPending)
 		assert.NoError(t, err)
 	})
+
+	t.Run("report persisted validate-only import", func(t *testing.T) {
+		ctx := context.Background()
+		kv := memkv.NewMemoryKV()
+		ti := &datapb.ImportTaskInfo{
+			Id: 300,
+			State: &datapb.ImportTaskState{
+				StateCode: commonpb.ImportState_ImportPending,
+			},
+			Infos:    []*commonpb.KeyValuePair{{Key: importutil.ValidateOnly, Value: "true"}},
+			CreateTs: time.Now().Unix() - 100,
+		}
+		taskInfo, err := proto.Marshal(ti)
+		assert.NoError(t, err)
+		kv.Save(BuildImportTaskKey(300), string(taskInfo))
+
+		flushed := false
+		dc := newMockDataCoord()
+		dc.FlushFunc = func(ctx context.Context, req *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+			flushed = true
+			return &datapb.FlushResponse{Status: succStatus()}, nil
+		}
+		c := newTestCore(withHealthyCode(), withDataCoord(dc))
+		c.broker = newServerBroker(c)
+		c.importManager = newImportManager(ctx, kv, idAlloc, callImportServiceFn, callMarkSegmentsDropped, nil, nil, nil, nil)
+		c.importManager.loadFromTaskStore(true)
+		c.importManager.sendOutTasks(ctx)
+
+		report := `{"total_rows":10,"bad_rows":1}`
+		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
+			TaskId: 300,
+			State:  commonpb.ImportState_ImportPersisted,
+			Infos:  []*commonpb.KeyValuePair{{Key: importutil.ErrorReportKey, Value: report}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.False(t, flushed)
+
+		state := c.importManager.getTaskState(300)
+		assert.Equal(t, commonpb.ImportState_ImportCompleted, state.GetState())
+		value, err := funcutil.GetAttrByKeyFromRepeatedKV(importutil.ErrorReportKey, state.GetInfos())
+		assert.NoError(t, err)
+		assert.Equal(t, report, value)
+	})
+
+	t.Run("report failed sub-task", func(t *testing.T) {
+		ctx := context.Background()
+		var dropped []int64
+		markSegmentsDropped := func(ctx context.Context, segIDs []typeutil.UniqueID) (*commonpb.Status, error) {
+			dropped = append(dropped, segIDs...)
+			return &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			}, nil
+		}
+		c := newTestCore(withHealthyCode())
+		c.importManager = newImportManager(ctx, memkv.NewMemoryKV(), idAlloc, callImportServiceFn, markSegmentsDropped, nil, nil, nil, nil)
+		importResp := c.importManager.importJob(ctx, &milvuspb.ImportRequest{
+			CollectionName: "c1",
+			Files:          []string{"f1.json", "f2.json"},
+		}, 1, 2)
+		assert.Equal(t, commonpb.ErrorCode_Success, importResp.GetStatus().GetErrorCode())
+		taskID := importResp.GetTasks()[0]
+		subTasks := getImportSubTasks(c.importManager.workingTasks[taskID])
+		assert.Equal(t, 2, len(subTasks))
+
+		// the progress of a sub-task
+		resp, err := c.ReportImport(ctx, &rootcoordpb.ImportResult{
+			TaskId: subTasks[1].Id,
+			State:  commonpb.ImportState_ImportStarted,
+			Infos: []*commonpb.KeyValuePair{
+				{Key: importutil.ProcessedBytesKey, Value: "10"},
+				{Key: importutil.TotalBytesKey, Value: "100"},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, "5", func() string {
+			value, _ := funcutil.GetAttrByKeyFromRepeatedKV(ProgressPercent, c.importManager.getTaskState(taskID).GetInfos())
+			return value
+		}())
+
+		// the failed sub-task is sent out again, the segments of the failed attempt are dropped
+		resp, err = c.ReportImport(ctx, &rootcoordpb.ImportResult{
+			TaskId:   subTasks[1].Id,
+			State:    commonpb.ImportState_ImportFailed,
+			Segments: []int64{100},
+			Infos:    []*commonpb.KeyValuePair{importutil.RetryableInfo()},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, []int64{100}, dropped)
+		assert.Equal(t, commonpb.ImportState_ImportStarted, c.importManager.getTaskState(taskID).GetState())
+		subTasks = getImportSubTasks(c.importManager.workingTasks[taskID])
+		assert.Equal(t, commonpb.ImportState_ImportStarted, subTasks[1].State)
+		assert.Equal(t, int64(2), subTasks[1].Attempts)
+
+		// the sub-task fails by its data files, the segments of the last attempt are dropped
+		resp, err = c.ReportImport(ctx, &rootcoordpb.ImportResult{
+			TaskId:   subTasks[1].Id,
+			State:    commonpb.ImportState_ImportFailed,
+			Segments: []int64{101},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		assert.Equal(t, []int64{100, 101}, dropped)
+		assert.Equal(t, commonpb.ImportState_ImportFailed, c.importManager.getTaskState(taskID).GetState())
+
+		// the sibling sub-task finishes after the task failed, its segments are dropped
+		resp, err = c.ReportImport(ctx, &rootcoordpb.ImportResult{
+			TaskId:   subTasks[0].Id,
+			State:    commonpb.ImportState_ImportPersisted,
+			Segments: []int64{102},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UpdateImportTaskFailure, resp.GetErrorCode())
+		assert.Equal(t, []int64{100, 101, 102}, dropped)
+	})
 }
 
 func TestCore_Rbac(t *testing.T) {
@@ -1410,6 +1530,46 @@ func TestRootCoord_AlterCollection(t *testing.T) {
 	})
 }
 
+func TestRootCoord_AddCollectionField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
 func TestRootCoord_CheckHealth(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
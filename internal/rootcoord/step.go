@@ -374,3 +374,18 @@ func (b *BroadcastAlteredCollectionStep) Execute(ctx context.Context) ([]nestedS
 func (b *BroadcastAlteredCollectionStep) Desc() string {
 	return fmt.Sprintf("broadcast altered collection, collectionID: %d", b.req.CollectionID)
 }
+
+type broadcastAlteredSchemaStep struct {
+	baseStep
+	collectionID UniqueID
+	ts           Timestamp
+}
+
+func (s *broadcastAlteredSchemaStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	err := s.core.broker.BroadcastAlteredSchema(ctx, s.collectionID, s.ts)
+	return nil, err
+}
+
+func (s *broadcastAlteredSchemaStep) Desc() string {
+	return fmt.Sprintf("broadcast altered schema, collectionID: %d", s.collectionID)
+}
@@ -0,0 +1,367 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/errorutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+)
+
+// AzureChunkManager is responsible for read and write data stored in Azure Blob Storage.
+type AzureChunkManager struct {
+	client        *azblob.Client
+	containerName string
+	rootPath      string
+}
+
+var _ ChunkManager = (*AzureChunkManager)(nil)
+
+// newAzureChunkManagerWithConfig creates an AzureChunkManager, the fields of config are interpreted as:
+// address is the blob service endpoint, accessKeyID and secretAccessKeyID are the account name and key,
+// bucketName is the container, and useIAM means authorizing with the managed identity identified by clientID.
+func newAzureChunkManagerWithConfig(ctx context.Context, c *config) (*AzureChunkManager, error) {
+	endpoint := c.address
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net/", c.accessKeyID)
+	}
+
+	var client *azblob.Client
+	var err error
+	if c.useIAM {
+		opts := &azidentity.ManagedIdentityCredentialOptions{}
+		if c.clientID != "" {
+			opts.ID = azidentity.ClientID(c.clientID)
+		}
+		cred, credErr := azidentity.NewManagedIdentityCredential(opts)
+		if credErr != nil {
+			return nil, credErr
+		}
+		client, err = azblob.NewClient(endpoint, cred, nil)
+	} else {
+		cred, credErr := azblob.NewSharedKeyCredential(c.accessKeyID, c.secretAccessKeyID)
+		if credErr != nil {
+			return nil, credErr
+		}
+		client, err = azblob.NewClientWithSharedKeyCredential(endpoint, cred, nil)
+	}
+	// invalid formatted endpoint, don't need to retry
+	if err != nil {
+		return nil, err
+	}
+
+	checkContainerFn := func() error {
+		_, err := client.ServiceClient().NewContainerClient(c.bucketName).GetProperties(ctx, nil)
+		if err == nil {
+			return nil
+		}
+		if !bloberror.HasCode(err, bloberror.ContainerNotFound) {
+			log.Warn("failed to check azure container exist", zap.String("container", c.bucketName), zap.Error(err))
+			return err
+		}
+		if !c.createBucket {
+			return fmt.Errorf("container %s not Existed", c.bucketName)
+		}
+		log.Info("azure container not exist, create container.", zap.String("container", c.bucketName))
+		_, err = client.CreateContainer(ctx, c.bucketName, nil)
+		if err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+			log.Warn("failed to create azure container", zap.String("container", c.bucketName), zap.Error(err))
+			return err
+		}
+		return nil
+	}
+	err = retry.Do(ctx, checkContainerFn, retry.Attempts(CheckBucketRetryAttempts))
+	if err != nil {
+		return nil, err
+	}
+
+	acm := &AzureChunkManager{
+		client:        client,
+		containerName: c.bucketName,
+		rootPath:      strings.TrimLeft(c.rootPath, "/"),
+	}
+	log.Info("azure chunk manager init success.", zap.String("container", c.bucketName), zap.String("root", acm.RootPath()))
+	return acm, nil
+}
+
+// RootPath returns azure root path.
+func (acm *AzureChunkManager) RootPath() string {
+	return acm.rootPath
+}
+
+// Path returns the path of azure data if exists.
+func (acm *AzureChunkManager) Path(ctx context.Context, filePath string) (string, error) {
+	exist, err := acm.Exist(ctx, filePath)
+	if err != nil {
+		return "", err
+	}
+	if !exist {
+		return "", errors.New("azure file manage cannot be found with filePath:" + filePath)
+	}
+	return filePath, nil
+}
+
+// Reader returns a reader of the blob, the caller is responsible for closing it.
+func (acm *AzureChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	resp, err := acm.client.DownloadStream(ctx, acm.containerName, filePath, nil)
+	if err != nil {
+		log.Warn("failed to get blob", zap.String("path", filePath), zap.Error(err))
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, WrapErrNoSuchKey(filePath)
+		}
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+// Size returns the size of the blob.
+func (acm *AzureChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	props, err := acm.blobClient(filePath).GetProperties(ctx, nil)
+	if err != nil {
+		log.Warn("failed to get blob size", zap.String("path", filePath), zap.Error(err))
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return 0, WrapErrNoSuchKey(filePath)
+		}
+		return 0, err
+	}
+	if props.ContentLength == nil {
+		return 0, nil
+	}
+	return *props.ContentLength, nil
+}
+
+// Write writes the data to azure storage.
+func (acm *AzureChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	_, err := acm.client.UploadBuffer(ctx, acm.containerName, filePath, content, nil)
+	if err != nil {
+		log.Warn("failed to put blob", zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// MultiWrite saves multiple objects, the path is the key of @kvs.
+// The object value is the value of @kvs.
+func (acm *AzureChunkManager) MultiWrite(ctx context.Context, kvs map[string][]byte) error {
+	var el errorutil.ErrorList
+	for key, value := range kvs {
+		err := acm.Write(ctx, key, value)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// Exist checks whether chunk is saved to azure storage.
+func (acm *AzureChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	_, err := acm.blobClient(filePath).GetProperties(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return false, nil
+		}
+		log.Warn("failed to get blob properties", zap.String("path", filePath), zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+// Read reads the azure storage data if exists.
+func (acm *AzureChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	reader, err := acm.Reader(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		log.Warn("failed to read blob", zap.String("path", filePath), zap.Error(err))
+		return nil, err
+	}
+	return data, nil
+}
+
+func (acm *AzureChunkManager) MultiRead(ctx context.Context, keys []string) ([][]byte, error) {
+	var el errorutil.ErrorList
+	var objectsValues [][]byte
+	for _, key := range keys {
+		objectValue, err := acm.Read(ctx, key)
+		if err != nil {
+			el = append(el, err)
+		}
+		objectsValues = append(objectsValues, objectValue)
+	}
+
+	if len(el) == 0 {
+		return objectsValues, nil
+	}
+	return objectsValues, el
+}
+
+func (acm *AzureChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	objectsKeys, _, err := acm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	objectsValues, err := acm.MultiRead(ctx, objectsKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objectsKeys, objectsValues, nil
+}
+
+func (acm *AzureChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	return nil, errors.New("this method has not been implemented")
+}
+
+// ReadAt reads specific position data of azure storage if exists.
+func (acm *AzureChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	if length == 0 {
+		return []byte{}, nil
+	}
+
+	resp, err := acm.client.DownloadStream(ctx, acm.containerName, filePath, &azblob.DownloadStreamOptions{
+		Range: blob.HTTPRange{Offset: off, Count: length},
+	})
+	if err != nil {
+		log.Warn("failed to read blob range", zap.String("path", filePath), zap.Int64("offset", off), zap.Int64("length", length), zap.Error(err))
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, WrapErrNoSuchKey(filePath)
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Warn("failed to read blob range", zap.String("path", filePath), zap.Int64("offset", off), zap.Int64("length", length), zap.Error(err))
+		return nil, err
+	}
+	return data, nil
+}
+
+// Remove deletes an object with @key.
+func (acm *AzureChunkManager) Remove(ctx context.Context, filePath string) error {
+	_, err := acm.client.DeleteBlob(ctx, acm.containerName, filePath, nil)
+	// removing a missing object is not an error, same as minio
+	if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		log.Warn("failed to delete blob", zap.String("path", filePath), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// MultiRemove deletes a objects with @keys.
+func (acm *AzureChunkManager) MultiRemove(ctx context.Context, keys []string) error {
+	var el errorutil.ErrorList
+	for _, key := range keys {
+		err := acm.Remove(ctx, key)
+		if err != nil {
+			el = append(el, err)
+		}
+	}
+	if len(el) == 0 {
+		return nil
+	}
+	return el
+}
+
+// RemoveWithPrefix removes all objects with the same prefix @prefix from azure.
+func (acm *AzureChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	objectsKeys, _, err := acm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return err
+	}
+	return acm.MultiRemove(ctx, objectsKeys)
+}
+
+// ListWithPrefix returns objects with provided prefix, if `recursive`=false, only objects and
+// virtual directories at the same level of the prefix are returned, directories end with "/",
+// say azure has following objects: [a, ab, a/b, ab/c]
+// calling `ListWithPrefix` with `prefix` = a && `recursive` = false will only returns [a, ab, a/, ab/]
+func (acm *AzureChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	var objectsKeys []string
+	var modTimes []time.Time
+	appendItem := func(item *container.BlobItem) {
+		objectsKeys = append(objectsKeys, *item.Name)
+		if item.Properties != nil && item.Properties.LastModified != nil {
+			modTimes = append(modTimes, *item.Properties.LastModified)
+		} else {
+			modTimes = append(modTimes, time.Time{})
+		}
+	}
+
+	if recursive {
+		pager := acm.client.NewListBlobsFlatPager(acm.containerName, &azblob.ListBlobsFlatOptions{Prefix: &prefix})
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				log.Warn("failed to list with prefix", zap.String("prefix", prefix), zap.Error(err))
+				return nil, nil, err
+			}
+			for _, item := range page.Segment.BlobItems {
+				appendItem(item)
+			}
+		}
+		return objectsKeys, modTimes, nil
+	}
+
+	pager := acm.client.ServiceClient().NewContainerClient(acm.containerName).
+		NewListBlobsHierarchyPager("/", &container.ListBlobsHierarchyOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			log.Warn("failed to list with prefix", zap.String("prefix", prefix), zap.Error(err))
+			return nil, nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			appendItem(item)
+		}
+		// virtual directories have no modification time
+		for _, dir := range page.Segment.BlobPrefixes {
+			objectsKeys = append(objectsKeys, *dir.Name)
+			modTimes = append(modTimes, time.Time{})
+		}
+	}
+	return objectsKeys, modTimes, nil
+}
+
+func (acm *AzureChunkManager) blobClient(filePath string) *blob.Client {
+	return acm.client.ServiceClient().NewContainerClient(acm.containerName).NewBlobClient(filePath)
+}
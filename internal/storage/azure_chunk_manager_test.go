@@ -0,0 +1,505 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newAzureChunkManager creates an AzureChunkManager against the Azurite emulator configured in milvus.yaml.
+func newAzureChunkManager(ctx context.Context, containerName string, rootPath string) (*AzureChunkManager, error) {
+	endpoint, _ := Params.Load("azure.endpoint")
+	accountName, _ := Params.Load("azure.accountName")
+	accountKey, _ := Params.Load("azure.accountKey")
+	c := newDefaultConfig()
+	for _, opt := range []Option{
+		RootPath(rootPath),
+		Address(endpoint),
+		AccessKeyID(accountName),
+		SecretAccessKeyID(accountKey),
+		BucketName(containerName),
+		UseIAM(false),
+		CreateBucket(true),
+	} {
+		opt(c)
+	}
+	return newAzureChunkManagerWithConfig(ctx, c)
+}
+
+// skipIfAzureUnavailable skips the test when the configured blob endpoint cannot be reached,
+// the azure tests require an Azurite emulator or a real storage account.
+func skipIfAzureUnavailable(t *testing.T) {
+	endpoint, _ := Params.Load("azure.endpoint")
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		t.Skipf("azure endpoint %q is not configured", endpoint)
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "443")
+		if u.Scheme == "http" {
+			host = net.JoinHostPort(u.Hostname(), "80")
+		}
+	}
+	conn, err := net.DialTimeout("tcp", host, time.Second)
+	if err != nil {
+		t.Skipf("azure endpoint %s is unavailable: %v", endpoint, err)
+	}
+	conn.Close()
+}
+
+func TestAzureCMFail(t *testing.T) {
+	ctx := context.Background()
+	c := newDefaultConfig()
+	Address("http://9.9.9.9:10000/devstoreaccount1")(c)
+	AccessKeyID("devstoreaccount1")(c)
+	SecretAccessKeyID("not base64 encoded")(c)
+	BucketName("test")(c)
+	CreateBucket(true)(c)
+
+	client, err := newAzureChunkManagerWithConfig(ctx, c)
+	assert.Error(t, err)
+	assert.Nil(t, client)
+
+	SecretAccessKeyID("a2V5")(c)
+	Address("invalid endpoint")(c)
+	client, err = newAzureChunkManagerWithConfig(ctx, c)
+	assert.Error(t, err)
+	assert.Nil(t, client)
+}
+
+func TestAzureCM(t *testing.T) {
+	Params.Init()
+	skipIfAzureUnavailable(t)
+
+	testContainer, err := Params.Load("azure.containerName")
+	require.NoError(t, err)
+
+	configRoot, err := Params.Load("azure.rootPath")
+	require.NoError(t, err)
+
+	testAzureRoot := path.Join(configRoot, "milvus-azure-ut-root")
+
+	t.Run("test load", func(t *testing.T) {
+		testLoadRoot := path.Join(testAzureRoot, "test_load")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testContainer, testLoadRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testLoadRoot)
+
+		assert.Equal(t, testLoadRoot, testCM.RootPath())
+
+		prepareTests := []struct {
+			key   string
+			value []byte
+		}{
+			{"abc", []byte("123")},
+			{"abcd", []byte("1234")},
+			{"key_1", []byte("111")},
+			{"key_2", []byte("222")},
+			{"key_3", []byte("333")},
+		}
+
+		for _, test := range prepareTests {
+			err = testCM.Write(ctx, path.Join(testLoadRoot, test.key), test.value)
+			require.NoError(t, err)
+		}
+
+		loadTests := []struct {
+			isvalid       bool
+			loadKey       string
+			expectedValue []byte
+
+			description string
+		}{
+			{true, "abc", []byte("123"), "load valid key abc"},
+			{true, "abcd", []byte("1234"), "load valid key abcd"},
+			{true, "key_1", []byte("111"), "load valid key key_1"},
+			{true, "key_2", []byte("222"), "load valid key key_2"},
+			{true, "key_3", []byte("333"), "load valid key key_3"},
+			{false, "key_not_exist", []byte(""), "load invalid key key_not_exist"},
+		}
+
+		for _, test := range loadTests {
+			t.Run(test.description, func(t *testing.T) {
+				if test.isvalid {
+					got, err := testCM.Read(ctx, path.Join(testLoadRoot, test.loadKey))
+					assert.NoError(t, err)
+					assert.Equal(t, test.expectedValue, got)
+				} else {
+					got, err := testCM.Read(ctx, path.Join(testLoadRoot, test.loadKey))
+					assert.Error(t, err)
+					assert.Empty(t, got)
+				}
+			})
+		}
+
+		loadWithPrefixTests := []struct {
+			isvalid       bool
+			prefix        string
+			expectedValue [][]byte
+
+			description string
+		}{
+			{true, "abc", [][]byte{[]byte("123"), []byte("1234")}, "load with valid prefix abc"},
+			{true, "key_", [][]byte{[]byte("111"), []byte("222"), []byte("333")}, "load with valid prefix key_"},
+			{true, "prefix", [][]byte{}, "load with valid but not exist prefix prefix"},
+		}
+
+		for _, test := range loadWithPrefixTests {
+			t.Run(test.description, func(t *testing.T) {
+				gotk, gotv, err := testCM.ReadWithPrefix(ctx, path.Join(testLoadRoot, test.prefix))
+				assert.NoError(t, err)
+				assert.Equal(t, len(test.expectedValue), len(gotk))
+				assert.Equal(t, len(test.expectedValue), len(gotv))
+				assert.ElementsMatch(t, test.expectedValue, gotv)
+			})
+		}
+
+		multiLoadTests := []struct {
+			isvalid   bool
+			multiKeys []string
+
+			expectedValue [][]byte
+			description   string
+		}{
+			{false, []string{"key_1", "key_not_exist"}, [][]byte{[]byte("111"), nil}, "multiload 1 exist 1 not"},
+			{true, []string{"abc", "key_3"}, [][]byte{[]byte("123"), []byte("333")}, "multiload 2 exist"},
+		}
+
+		for _, test := range multiLoadTests {
+			t.Run(test.description, func(t *testing.T) {
+				for i := range test.multiKeys {
+					test.multiKeys[i] = path.Join(testLoadRoot, test.multiKeys[i])
+				}
+				got, err := testCM.MultiRead(ctx, test.multiKeys)
+				if test.isvalid {
+					assert.NoError(t, err)
+				} else {
+					assert.Error(t, err)
+				}
+				assert.Equal(t, test.expectedValue, got)
+			})
+		}
+	})
+
+	t.Run("test MultiSave", func(t *testing.T) {
+		testMultiSaveRoot := path.Join(testAzureRoot, "test_multisave")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testContainer, testMultiSaveRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testMultiSaveRoot)
+
+		err = testCM.Write(ctx, path.Join(testMultiSaveRoot, "key_1"), []byte("111"))
+		assert.NoError(t, err)
+
+		kvs := map[string][]byte{
+			path.Join(testMultiSaveRoot, "key_1"): []byte("123"),
+			path.Join(testMultiSaveRoot, "key_2"): []byte("456"),
+		}
+
+		err = testCM.MultiWrite(ctx, kvs)
+		assert.NoError(t, err)
+
+		val, err := testCM.Read(ctx, path.Join(testMultiSaveRoot, "key_1"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("123"), val)
+	})
+
+	t.Run("test Remove", func(t *testing.T) {
+		testRemoveRoot := path.Join(testAzureRoot, "test_remove")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testContainer, testRemoveRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testRemoveRoot)
+
+		prepareTests := []struct {
+			k string
+			v []byte
+		}{
+			{"key_1", []byte("123")},
+			{"key_2", []byte("456")},
+			{"mkey_1", []byte("111")},
+			{"mkey_2", []byte("222")},
+			{"mkey_3", []byte("333")},
+			{"key_prefix_1", []byte("111")},
+			{"key_prefix_2", []byte("222")},
+			{"key_prefix_3", []byte("333")},
+		}
+
+		for _, test := range prepareTests {
+			k := path.Join(testRemoveRoot, test.k)
+			err = testCM.Write(ctx, k, test.v)
+			require.NoError(t, err)
+		}
+
+		removeTests := []struct {
+			removeKey         string
+			valueBeforeRemove []byte
+
+			description string
+		}{
+			{"key_1", []byte("123"), "remove key_1"},
+			{"key_2", []byte("456"), "remove key_2"},
+		}
+
+		for _, test := range removeTests {
+			t.Run(test.description, func(t *testing.T) {
+				k := path.Join(testRemoveRoot, test.removeKey)
+				v, err := testCM.Read(ctx, k)
+				require.NoError(t, err)
+				require.Equal(t, test.valueBeforeRemove, v)
+
+				err = testCM.Remove(ctx, k)
+				assert.NoError(t, err)
+
+				v, err = testCM.Read(ctx, k)
+				require.Error(t, err)
+				require.Empty(t, v)
+			})
+		}
+
+		multiRemoveTest := []string{
+			path.Join(testRemoveRoot, "mkey_1"),
+			path.Join(testRemoveRoot, "mkey_2"),
+			path.Join(testRemoveRoot, "mkey_3"),
+		}
+
+		lv, err := testCM.MultiRead(ctx, multiRemoveTest)
+		require.NoError(t, err)
+		require.ElementsMatch(t, [][]byte{[]byte("111"), []byte("222"), []byte("333")}, lv)
+
+		err = testCM.MultiRemove(ctx, multiRemoveTest)
+		assert.NoError(t, err)
+
+		for _, k := range multiRemoveTest {
+			v, err := testCM.Read(ctx, k)
+			assert.Error(t, err)
+			assert.Empty(t, v)
+		}
+
+		removeWithPrefixTest := []string{
+			path.Join(testRemoveRoot, "key_prefix_1"),
+			path.Join(testRemoveRoot, "key_prefix_2"),
+			path.Join(testRemoveRoot, "key_prefix_3"),
+		}
+		removePrefix := path.Join(testRemoveRoot, "key_prefix")
+
+		lv, err = testCM.MultiRead(ctx, removeWithPrefixTest)
+		require.NoError(t, err)
+		require.ElementsMatch(t, [][]byte{[]byte("111"), []byte("222"), []byte("333")}, lv)
+
+		err = testCM.RemoveWithPrefix(ctx, removePrefix)
+		assert.NoError(t, err)
+
+		for _, k := range removeWithPrefixTest {
+			v, err := testCM.Read(ctx, k)
+			assert.Error(t, err)
+			assert.Empty(t, v)
+		}
+	})
+
+	t.Run("test ReadAt", func(t *testing.T) {
+		testLoadPartialRoot := path.Join(testAzureRoot, "load_partial")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testContainer, testLoadPartialRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testLoadPartialRoot)
+
+		key := path.Join(testLoadPartialRoot, "TestAzureKV_LoadPartial_key")
+		value := []byte("TestAzureKV_LoadPartial_value")
+
+		err = testCM.Write(ctx, key, value)
+		assert.NoError(t, err)
+
+		var off, length int64
+		var partial []byte
+
+		off, length = 1, 1
+		partial, err = testCM.ReadAt(ctx, key, off, length)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, partial, value[off:off+length])
+
+		off, length = 0, int64(len(value))
+		partial, err = testCM.ReadAt(ctx, key, off, length)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, partial, value[off:off+length])
+
+		// error case
+		off, length = 5, -2
+		_, err = testCM.ReadAt(ctx, key, off, length)
+		assert.Error(t, err)
+
+		off, length = -1, 2
+		_, err = testCM.ReadAt(ctx, key, off, length)
+		assert.Error(t, err)
+
+		err = testCM.Remove(ctx, key)
+		assert.NoError(t, err)
+		off, length = 1, 1
+		_, err = testCM.ReadAt(ctx, key, off, length)
+		assert.Error(t, err)
+	})
+
+	t.Run("test Size/Exist/Path/Reader", func(t *testing.T) {
+		testStatRoot := path.Join(testAzureRoot, "stat")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testContainer, testStatRoot)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testStatRoot)
+
+		key := path.Join(testStatRoot, "TestAzureKV_Stat_key")
+		value := []byte("TestAzureKV_Stat_value")
+
+		exist, err := testCM.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.False(t, exist)
+
+		_, err = testCM.Size(ctx, key)
+		assert.Error(t, err)
+
+		_, err = testCM.Path(ctx, key)
+		assert.Error(t, err)
+
+		err = testCM.Write(ctx, key, value)
+		assert.NoError(t, err)
+
+		exist, err = testCM.Exist(ctx, key)
+		assert.NoError(t, err)
+		assert.True(t, exist)
+
+		size, err := testCM.Size(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(value)), size)
+
+		p, err := testCM.Path(ctx, key)
+		assert.NoError(t, err)
+		assert.Equal(t, key, p)
+
+		reader, err := testCM.Reader(ctx, key)
+		assert.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, value, content)
+		assert.NoError(t, reader.Close())
+	})
+
+	t.Run("test Mmap", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testContainer, testAzureRoot)
+		require.NoError(t, err)
+
+		r, err := testCM.Mmap(ctx, path.Join(testAzureRoot, "mmap"))
+		assert.Error(t, err)
+		assert.Nil(t, r)
+	})
+
+	t.Run("test Prefix", func(t *testing.T) {
+		testPrefix := path.Join(testAzureRoot, "prefix")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testContainer, testPrefix)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testPrefix)
+
+		value := []byte("a")
+		for _, key := range []string{
+			path.Join(testPrefix, "a", "b"),
+			path.Join(testPrefix, "a", "c"),
+			path.Join(testPrefix, "b", "b", "b"),
+			path.Join(testPrefix, "b", "a", "b"),
+			path.Join(testPrefix, "bc", "a", "b"),
+			path.Join(testPrefix, "bd"),
+		} {
+			err = testCM.Write(ctx, key, value)
+			assert.NoError(t, err)
+		}
+
+		pathPrefix := path.Join(testPrefix, "a")
+		r, m, err := testCM.ListWithPrefix(ctx, pathPrefix, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(r))
+		assert.Equal(t, 2, len(m))
+
+		dirs, mods, err := testCM.ListWithPrefix(ctx, testPrefix+"/", true)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, len(dirs))
+		assert.Equal(t, 6, len(mods))
+
+		dirs, mods, err = testCM.ListWithPrefix(ctx, path.Join(testPrefix, "b"), true)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, len(dirs))
+		assert.Equal(t, 4, len(mods))
+
+		// only the blobs and directories at the same level of the prefix are returned
+		dirs, mods, err = testCM.ListWithPrefix(ctx, path.Join(testPrefix, "b"), false)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{path.Join(testPrefix, "b") + "/", path.Join(testPrefix, "bc") + "/", path.Join(testPrefix, "bd")}, dirs)
+		assert.Equal(t, 3, len(mods))
+
+		err = testCM.RemoveWithPrefix(ctx, testPrefix)
+		assert.NoError(t, err)
+		r, m, err = testCM.ListWithPrefix(ctx, pathPrefix, true)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(r))
+		assert.Equal(t, 0, len(m))
+	})
+
+	t.Run("test NoSuchKey", func(t *testing.T) {
+		testPrefix := path.Join(testAzureRoot, "nokey")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		testCM, err := newAzureChunkManager(ctx, testContainer, testPrefix)
+		require.NoError(t, err)
+		defer testCM.RemoveWithPrefix(ctx, testPrefix)
+
+		key := "a"
+
+		_, err = testCM.Read(ctx, key)
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+
+		_, err = testCM.ReadAt(ctx, key, 100, 1)
+		assert.Error(t, err)
+		assert.True(t, errors.Is(err, ErrNoSuchKey))
+	})
+}
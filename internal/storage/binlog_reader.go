@@ -34,6 +34,9 @@ type BinlogReader struct {
 	buffer      *bytes.Buffer
 	eventReader *EventReader
 	isClose     bool
+
+	checksums  []uint32 // payload checksums of the events, nil if the binlog has no checksums
+	eventIndex int
 }
 
 // NextEventReader iters all events reader to read the binlog file.
@@ -49,10 +52,18 @@ func (reader *BinlogReader) NextEventReader() (*EventReader, error) {
 		reader.eventReader.Close()
 	}
 	var err error
-	reader.eventReader, err = newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer)
+	if reader.checksums != nil {
+		if reader.eventIndex >= len(reader.checksums) {
+			return nil, fmt.Errorf("%w, event %d has no checksum", ErrChecksumMismatch, reader.eventIndex)
+		}
+		reader.eventReader, err = newEventReaderWithChecksum(reader.descriptorEvent.PayloadDataType, reader.buffer, reader.checksums[reader.eventIndex])
+	} else {
+		reader.eventReader, err = newEventReader(reader.descriptorEvent.PayloadDataType, reader.buffer)
+	}
 	if err != nil {
 		return nil, err
 	}
+	reader.eventIndex++
 	return reader.eventReader, nil
 }
 
@@ -68,6 +79,14 @@ func (reader *BinlogReader) readDescriptorEvent() (*descriptorEvent, error) {
 		return nil, err
 	}
 	reader.descriptorEvent = *event
+	checksums, ok, err := event.GetEventChecksums()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		reader.checksums = make([]uint32, 0, len(checksums))
+		reader.checksums = append(reader.checksums, checksums...)
+	}
 	return &reader.descriptorEvent, nil
 }
 
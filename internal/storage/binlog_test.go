@@ -1496,6 +1496,10 @@ func (e *testEvent) SetOffset(offset int32) {
 
 }
 
+func (e *testEvent) GetPayloadChecksum() (uint32, error) {
+	return 0, nil
+}
+
 var _ EventWriter = (*testEvent)(nil)
 
 func TestWriterListError(t *testing.T) {
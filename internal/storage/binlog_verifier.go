@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// CorruptedLog describes a log file of a segment which is missing or fails the verification.
+type CorruptedLog struct {
+	SegmentID int64
+	Path      string
+	Missing   bool
+	Err       error // the reason of the verification failure, nil if the file is missing
+}
+
+func (l *CorruptedLog) String() string {
+	if l.Missing {
+		return fmt.Sprintf("segment %d: %s is missing", l.SegmentID, l.Path)
+	}
+	return fmt.Sprintf("segment %d: %s is corrupted, %v", l.SegmentID, l.Path, l.Err)
+}
+
+// VerifyBinlog checks the layout of the binlog @data without parsing the payloads, the payloads are
+// checked against their checksums if the binlog records them.
+func VerifyBinlog(data []byte) error {
+	if IsColumnarBinlog(data) {
+		return VerifyColumnarBinlog(data)
+	}
+	buffer := bytes.NewBuffer(data)
+	if _, err := readMagicNumber(buffer); err != nil {
+		return err
+	}
+	descriptor, err := ReadDescriptorEvent(buffer)
+	if err != nil {
+		return fmt.Errorf("failed to read descriptor event: %w", err)
+	}
+	if pos := int32(len(data) - buffer.Len()); descriptor.NextPosition != pos {
+		return fmt.Errorf("invalid descriptor event, next position: %d, actual: %d", descriptor.NextPosition, pos)
+	}
+	checksums, hasChecksums, err := descriptor.GetEventChecksums()
+	if err != nil {
+		return err
+	}
+
+	headerSize := (&eventHeader{}).GetMemoryUsageInBytes()
+	events := 0
+	for ; buffer.Len() > 0; events++ {
+		pos := int32(len(data) - buffer.Len())
+		header, err := readEventHeader(buffer)
+		if err != nil {
+			return fmt.Errorf("failed to read header of event %d: %w", events, err)
+		}
+		fixPartSize := getEventFixPartSize(header.TypeCode)
+		if header.TypeCode == DescriptorEventType || fixPartSize < 0 {
+			return fmt.Errorf("invalid type code %d of event %d", header.TypeCode, events)
+		}
+		payloadSize := header.EventLength - headerSize - fixPartSize
+		if payloadSize < 0 || int(payloadSize) > buffer.Len()-int(fixPartSize) {
+			return fmt.Errorf("invalid length %d of event %d, %d bytes left", header.EventLength, events, buffer.Len()+int(headerSize))
+		}
+		if header.NextPosition != pos+header.EventLength {
+			return fmt.Errorf("invalid next position %d of event %d, expected: %d", header.NextPosition, events, pos+header.EventLength)
+		}
+		buffer.Next(int(fixPartSize))
+		payload := buffer.Next(int(payloadSize))
+		if !hasChecksums {
+			continue
+		}
+		if events >= len(checksums) {
+			return fmt.Errorf("%w, event %d has no checksum", ErrChecksumMismatch, events)
+		}
+		if err := verifyPayloadChecksum(payload, checksums[events]); err != nil {
+			return fmt.Errorf("event %d: %w", events, err)
+		}
+	}
+	if hasChecksums && events != len(checksums) {
+		return fmt.Errorf("binlog is truncated, %d events expected, %d found", len(checksums), events)
+	}
+	return nil
+}
+
+// VerifyColumnarBinlog checks whether @data is a readable columnar log of segment format v2 by decoding all its columns.
+func VerifyColumnarBinlog(data []byte) (err error) {
+	// the parquet reader may panic on malformed pages
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("malformed columnar log: %v", r)
+		}
+	}()
+	_, _, _, err = deserializeColumnarInto(data, &InsertData{Data: make(map[FieldID]FieldData)}, nil)
+	return err
+}
+
+// VerifyStatsLog checks whether @data is a well formed stats log, in binary or json format.
+func VerifyStatsLog(data []byte) error {
+	if _, ok := parseBinaryPkStatsPrefix(data); ok {
+		_, err := decodeBinaryPkStats(data)
+		return err
+	}
+	if !json.Valid(data) {
+		return errors.New("invalid json stats log")
+	}
+	return nil
+}
+
+// VerifySegmentLogs reads the binlogs, statslogs and deltalogs of @segment and returns the missing or
+// corrupted ones. An error is returned if the files can't be read from @cm.
+func VerifySegmentLogs(ctx context.Context, cm ChunkManager, segment *datapb.SegmentInfo) ([]*CorruptedLog, error) {
+	var corrupted []*CorruptedLog
+	// columnar logs are shared by all fields of the segment, verify them once
+	visited := make(map[string]struct{})
+	verify := func(fieldBinlogs []*datapb.FieldBinlog, verifyFunc func([]byte) error) error {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				path := binlog.GetLogPath()
+				if _, ok := visited[path]; ok {
+					continue
+				}
+				visited[path] = struct{}{}
+				exist, err := cm.Exist(ctx, path)
+				if err != nil {
+					return err
+				}
+				if !exist {
+					corrupted = append(corrupted, &CorruptedLog{SegmentID: segment.GetID(), Path: path, Missing: true})
+					continue
+				}
+				data, err := cm.Read(ctx, path)
+				if err != nil {
+					return err
+				}
+				if err := verifyFunc(data); err != nil {
+					corrupted = append(corrupted, &CorruptedLog{SegmentID: segment.GetID(), Path: path, Err: err})
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := verify(segment.GetBinlogs(), VerifyBinlog); err != nil {
+		return nil, err
+	}
+	if err := verify(segment.GetStatslogs(), VerifyStatsLog); err != nil {
+		return nil, err
+	}
+	if err := verify(segment.GetDeltalogs(), VerifyBinlog); err != nil {
+		return nil, err
+	}
+	return corrupted, nil
+}
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func genVerifierDeltalog(t *testing.T) []byte {
+	deleteData := &DeleteData{}
+	for i := 0; i < 100; i++ {
+		deleteData.Append(NewInt64PrimaryKey(int64(i)), Timestamp(1000+i))
+	}
+	blob, err := NewDeleteCodec().Serialize(1, 1, 1, deleteData)
+	require.NoError(t, err)
+	return blob.Value
+}
+
+func TestBinlogChecksum(t *testing.T) {
+	data := genVerifierDeltalog(t)
+
+	reader, err := NewBinlogReader(data)
+	require.NoError(t, err)
+	checksums, ok, err := reader.GetEventChecksums()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1, len(checksums))
+	reader.Close()
+
+	// flip a byte of the payload at the end of the binlog
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-10] ^= 0xff
+	reader, err = NewBinlogReader(corrupted)
+	require.NoError(t, err)
+	_, err = reader.NextEventReader()
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	reader.Close()
+
+	_, _, _, err = NewDeleteCodec().Deserialize([]*Blob{{Value: corrupted}})
+	assert.Error(t, err)
+}
+
+func TestDescriptorEventData_GetEventChecksums(t *testing.T) {
+	data := newDescriptorEventData()
+	_, ok, err := data.GetEventChecksums()
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	data.AddExtra(eventChecksumsKey, []interface{}{float64(1), float64(2)})
+	checksums, ok, err := data.GetEventChecksums()
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []uint32{1, 2}, checksums)
+
+	data.AddExtra(eventChecksumsKey, []interface{}{float64(-1)})
+	_, _, err = data.GetEventChecksums()
+	assert.Error(t, err)
+
+	data.AddExtra(eventChecksumsKey, "1")
+	_, _, err = data.GetEventChecksums()
+	assert.Error(t, err)
+}
+
+func TestVerifyBinlog(t *testing.T) {
+	data := genVerifierDeltalog(t)
+	assert.NoError(t, VerifyBinlog(data))
+
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-10] ^= 0xff
+	assert.True(t, errors.Is(VerifyBinlog(corrupted), ErrChecksumMismatch))
+
+	assert.Error(t, VerifyBinlog(data[:len(data)-1]))
+	assert.Error(t, VerifyBinlog(data[:len(data)/2]))
+	assert.Error(t, VerifyBinlog(append(append([]byte{}, data...), 1, 2, 3)))
+	assert.Error(t, VerifyBinlog([]byte{1, 2, 3}))
+	assert.Error(t, VerifyBinlog(nil))
+}
+
+func TestVerifyStatsLog(t *testing.T) {
+	sw := &StatsWriter{}
+	_, err := sw.GenerateBinaryPrimaryKeyStats(100, schemapb.DataType_Int64, &Int64FieldData{Data: []int64{1, 2, 3}}, DefaultBloomFilterParams())
+	require.NoError(t, err)
+	binaryStats := sw.GetBuffer()
+	assert.NoError(t, VerifyStatsLog(binaryStats))
+	assert.Error(t, VerifyStatsLog(binaryStats[:len(binaryStats)-1]))
+
+	assert.NoError(t, VerifyStatsLog([]byte(`{"fieldID":100}`)))
+	assert.Error(t, VerifyStatsLog([]byte(`{"fieldID":`)))
+}
+
+func TestVerifySegmentLogs(t *testing.T) {
+	ctx := context.Background()
+	cm := NewLocalChunkManager(RootPath(t.TempDir()))
+
+	data := genVerifierDeltalog(t)
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-10] ^= 0xff
+	require.NoError(t, cm.Write(ctx, "delta_log/1", data))
+	require.NoError(t, cm.Write(ctx, "delta_log/2", corrupted))
+	require.NoError(t, cm.Write(ctx, "stats_log/1", []byte(`{"fieldID":100}`)))
+
+	segment := &datapb.SegmentInfo{
+		ID: 1,
+		Statslogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{
+			{LogPath: "stats_log/1"},
+		}}},
+		Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{
+			{LogPath: "delta_log/1"},
+			{LogPath: "delta_log/2"},
+			{LogPath: "delta_log/3"},
+		}}},
+	}
+	corruptedLogs, err := VerifySegmentLogs(ctx, cm, segment)
+	assert.NoError(t, err)
+	require.Equal(t, 2, len(corruptedLogs))
+	assert.Equal(t, "delta_log/2", corruptedLogs[0].Path)
+	assert.False(t, corruptedLogs[0].Missing)
+	assert.True(t, errors.Is(corruptedLogs[0].Err, ErrChecksumMismatch))
+	assert.Equal(t, "delta_log/3", corruptedLogs[1].Path)
+	assert.True(t, corruptedLogs[1].Missing)
+	assert.Equal(t, int64(1), corruptedLogs[1].SegmentID)
+
+	segment.Binlogs = []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+		{LogPath: "stats_log/1"},
+	}}}
+	corruptedLogs, err = VerifySegmentLogs(ctx, cm, segment)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(corruptedLogs))
+}
@@ -103,6 +103,20 @@ func (writer *baseBinlogWriter) Finish() error {
 		return fmt.Errorf("invalid start/end timestamp")
 	}
 
+	// events are finished first, the checksums of their payloads are recorded in the descriptor event
+	checksums := make([]uint32, 0, len(writer.eventWriters))
+	for _, w := range writer.eventWriters {
+		if err := w.Finish(); err != nil {
+			return err
+		}
+		checksum, err := w.GetPayloadChecksum()
+		if err != nil {
+			return err
+		}
+		checksums = append(checksums, checksum)
+	}
+	writer.descriptorEvent.AddExtra(eventChecksumsKey, checksums)
+
 	var offset int32
 	writer.buffer = new(bytes.Buffer)
 	if err := binary.Write(writer.buffer, common.Endian, MagicNumber); err != nil {
@@ -117,9 +131,6 @@ func (writer *baseBinlogWriter) Finish() error {
 	writer.length = 0
 	for _, w := range writer.eventWriters {
 		w.SetOffset(offset)
-		if err := w.Finish(); err != nil {
-			return err
-		}
 		if err := w.Write(writer.buffer); err != nil {
 			return err
 		}
@@ -190,6 +201,21 @@ func (writer *DeleteBinlogWriter) NextDeleteEventWriter() (*deleteEventWriter, e
 	return event, nil
 }
 
+// NextDeltaEventWriter returns an event writer to write delete data to an event of v2 deltalog,
+// the payload data type of the writer must be the primary key type.
+func (writer *DeleteBinlogWriter) NextDeltaEventWriter() (*deleteEventWriter, error) {
+	if writer.isClosed() {
+		return nil, fmt.Errorf("binlog has closed")
+	}
+	event, err := newDeltaEventWriter(writer.PayloadDataType)
+	if err != nil {
+		return nil, err
+	}
+	writer.AddExtra(deltaLogVersionKey, deltaLogVersion2)
+	writer.eventWriters = append(writer.eventWriters, event)
+	return event, nil
+}
+
 // DDLBinlogWriter is an object to write binlog file which saves ddl information.
 type DDLBinlogWriter struct {
 	baseBinlogWriter
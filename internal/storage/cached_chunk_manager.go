@@ -0,0 +1,463 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/cache"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+// cachedFile is a file of the remote storage cached on local disk.
+type cachedFile struct {
+	localPath string
+	size      int64
+}
+
+// localCache holds the files cached on local disk, it's shared by the CachedChunkManagers of the same
+// directory, so they share the capacity and don't remove the files of each other.
+type localCache struct {
+	local    *LocalChunkManager
+	rootPath string
+	capacity int64
+	policy   string
+	// refs is the number of the CachedChunkManagers using the cache, guarded by localCachesMu
+	refs int
+
+	files cache.Cache[string, *cachedFile]
+	group singleflight.Group
+	seq   atomic.Int64
+
+	mu sync.Mutex
+	// loading holds the ongoing downloads
+	loading map[string]*loadState
+}
+
+var (
+	localCachesMu sync.Mutex
+	localCaches   = make(map[string]*localCache)
+)
+
+// acquireLocalCache returns the local cache of @rootPath, which is created if it's not in use.
+func acquireLocalCache(rootPath string, capacity int64, policy string) (*localCache, error) {
+	localCachesMu.Lock()
+	defer localCachesMu.Unlock()
+	if lc, ok := localCaches[rootPath]; ok {
+		if lc.capacity != capacity || lc.policy != policy {
+			return nil, fmt.Errorf("local cache %s is in use with capacity %d and policy %s", rootPath, lc.capacity, lc.policy)
+		}
+		lc.refs++
+		return lc, nil
+	}
+
+	// the files of the previous run could not be tracked
+	if err := os.RemoveAll(rootPath); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(rootPath, os.ModePerm); err != nil {
+		return nil, err
+	}
+	lc := &localCache{
+		local:    NewLocalChunkManager(RootPath(rootPath)),
+		rootPath: rootPath,
+		capacity: capacity,
+		policy:   policy,
+		refs:     1,
+		loading:  make(map[string]*loadState),
+	}
+	lc.files = cache.NewCache(
+		cache.WithMaximumWeight(capacity, func(key string, f *cachedFile) int64 {
+			return f.size
+		}),
+		cache.WithPolicy[string, *cachedFile](policy),
+		cache.WithRemovalListener(lc.onRemoval),
+	)
+	localCaches[rootPath] = lc
+	metrics.StorageCacheSize.Set(0)
+	return lc, nil
+}
+
+// release removes all the cached files once the last CachedChunkManager using the cache is closed.
+func (lc *localCache) release() {
+	localCachesMu.Lock()
+	defer localCachesMu.Unlock()
+	lc.refs--
+	if lc.refs > 0 {
+		return
+	}
+	delete(localCaches, lc.rootPath)
+	lc.files.Close()
+	if err := os.RemoveAll(lc.rootPath); err != nil {
+		log.Warn("failed to remove local cache", zap.String("rootPath", lc.rootPath), zap.Error(err))
+	}
+}
+
+// CachedChunkManager caches the files read from the remote storage on local disk, the total size of the cached files
+// is limited by the disk capacity, and the files are evicted by the lru or lfu policy.
+// Concurrent reads of the same file are merged into one download, range reads of files not cached are passed
+// through to the remote storage. Writes and removes go to the remote storage directly and invalidate the cached files.
+type CachedChunkManager struct {
+	ChunkManager // the remote storage
+	cache        *localCache
+	// namespace tells the files of different remote storages sharing the local cache apart
+	namespace string
+	closeOnce sync.Once
+}
+
+// loadState is the state of an ongoing download, the file is not cached if it's invalidated during the download.
+type loadState struct {
+	invalidated bool
+}
+
+var _ ChunkManager = (*CachedChunkManager)(nil)
+
+// NewCachedChunkManager creates a CachedChunkManager which caches the files of @remote in the local directory
+// @rootPath, at most @capacity bytes are cached. CachedChunkManagers of the same @rootPath share the cached files
+// and the capacity, @namespace must be unique among them. The files left in @rootPath by the previous run are removed.
+func NewCachedChunkManager(remote ChunkManager, namespace string, rootPath string, capacity int64, policy string) (*CachedChunkManager, error) {
+	if capacity <= 0 {
+		return nil, fmt.Errorf("invalid cache capacity %d", capacity)
+	}
+	if policy != "lru" && policy != "lfu" {
+		return nil, fmt.Errorf("invalid cache policy %s", policy)
+	}
+	lc, err := acquireLocalCache(rootPath, capacity, policy)
+	if err != nil {
+		return nil, err
+	}
+	return &CachedChunkManager{
+		ChunkManager: remote,
+		cache:        lc,
+		namespace:    namespace,
+	}, nil
+}
+
+// NewCachedChunkManagerWithParam wraps @remote with a CachedChunkManager if the local storage cache is enabled,
+// the cached files of @role are put in a sub directory of localStorage.cache.path, which is shared by the
+// chunk managers of the node. If @remote is encrypted, the encrypted files are cached and decrypted on read.
+func NewCachedChunkManagerWithParam(remote ChunkManager, params *paramtable.ComponentParam, role string, namespace string) (ChunkManager, error) {
+	cfg := &params.LocalStorageCfg
+	if !cfg.CacheEnabled.GetAsBool() {
+		return remote, nil
+	}
+	rootPath := path.Join(cfg.CachePath.GetValue(), role, strconv.FormatInt(paramtable.GetNodeID(), 10))
+	capacity := cfg.CacheCapacity.GetAsInt64() * 1024 * 1024
+	if ecm, ok := remote.(*EncryptedChunkManager); ok {
+		ccm, err := NewCachedChunkManager(ecm.ChunkManager, namespace, rootPath, capacity, cfg.CachePolicy.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		ecm.ChunkManager = ccm
+		return ecm, nil
+	}
+	return NewCachedChunkManager(remote, namespace, rootPath, capacity, cfg.CachePolicy.GetValue())
+}
+
+// GetCachedChunkManager returns the CachedChunkManager of @cm, false is returned if the local cache is disabled.
+func GetCachedChunkManager(cm ChunkManager) (*CachedChunkManager, bool) {
+	if ecm, ok := cm.(*EncryptedChunkManager); ok {
+		cm = ecm.ChunkManager
+	}
+	ccm, ok := cm.(*CachedChunkManager)
+	return ccm, ok
+}
+
+// key returns the key of @filePath in the shared local cache.
+func (ccm *CachedChunkManager) key(filePath string) string {
+	return ccm.namespace + ":" + filePath
+}
+
+func (lc *localCache) onRemoval(key string, f *cachedFile) {
+	if err := os.Remove(f.localPath); err != nil && !os.IsNotExist(err) {
+		log.Warn("failed to remove cached file", zap.String("key", key), zap.String("localPath", f.localPath), zap.Error(err))
+	}
+	metrics.StorageCacheEvictions.Inc()
+	metrics.StorageCacheSize.Sub(float64(f.size))
+}
+
+// detachedContext keeps the values of the parent context but is never canceled,
+// a download shared by several readers must not fail because the first reader gives up.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+type loadResult struct {
+	file    *cachedFile
+	content []byte
+}
+
+// load returns the cached file of @filePath, the file is downloaded from the remote storage if it's not cached.
+// Files larger than the capacity are not cached, nil is returned with their contents.
+func (ccm *CachedChunkManager) load(ctx context.Context, filePath string) (*cachedFile, []byte, error) {
+	key := ccm.key(filePath)
+	if f, ok := ccm.cache.files.GetIfPresent(key); ok {
+		metrics.StorageCacheRequests.WithLabelValues(metrics.CacheHitLabel).Inc()
+		return f, nil, nil
+	}
+	metrics.StorageCacheRequests.WithLabelValues(metrics.CacheMissLabel).Inc()
+
+	ch := ccm.cache.group.DoChan(key, func() (interface{}, error) {
+		return ccm.download(detachedContext{ctx}, filePath)
+	})
+	// the readers give up waiting when their contexts are done, the download goes on for the others
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case r := <-ch:
+		if r.Err != nil {
+			return nil, nil, r.Err
+		}
+		result := r.Val.(loadResult)
+		return result.file, result.content, nil
+	}
+}
+
+func (ccm *CachedChunkManager) download(ctx context.Context, filePath string) (loadResult, error) {
+	lc, key := ccm.cache, ccm.key(filePath)
+	// the file may have been loaded since the miss
+	if f, ok := lc.files.GetIfPresent(key); ok {
+		return loadResult{file: f}, nil
+	}
+	state := &loadState{}
+	lc.mu.Lock()
+	lc.loading[key] = state
+	lc.mu.Unlock()
+
+	start := time.Now()
+	content, err := ccm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		lc.finishLoad(key, state, nil)
+		return loadResult{}, err
+	}
+	if int64(len(content)) > lc.capacity {
+		lc.finishLoad(key, state, nil)
+		return loadResult{content: content}, nil
+	}
+	// every load has its own local file, so the eviction of the previous one won't remove it
+	f := &cachedFile{
+		localPath: path.Join(lc.rootPath, strconv.FormatInt(lc.seq.Inc(), 10)),
+		size:      int64(len(content)),
+	}
+	if err := lc.local.Write(ctx, f.localPath, content); err != nil {
+		lc.finishLoad(key, state, nil)
+		return loadResult{}, err
+	}
+	if !lc.finishLoad(key, state, f) {
+		// the downloaded content may be outdated, it's returned only to the readers started before the invalidation
+		if err := os.Remove(f.localPath); err != nil {
+			log.Warn("failed to remove cached file", zap.String("filePath", filePath), zap.String("localPath", f.localPath), zap.Error(err))
+		}
+		return loadResult{content: content}, nil
+	}
+	metrics.StorageCacheLoadLatency.Observe(float64(time.Since(start).Milliseconds()))
+	return loadResult{file: f, content: content}, nil
+}
+
+// finishLoad puts @f into the cache unless @key is invalidated during the download,
+// it returns whether @f is cached.
+func (lc *localCache) finishLoad(key string, state *loadState, f *cachedFile) bool {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+	if lc.loading[key] == state {
+		delete(lc.loading, key)
+	}
+	if f == nil || state.invalidated {
+		return false
+	}
+	metrics.StorageCacheSize.Add(float64(f.size))
+	lc.files.Put(key, f)
+	return true
+}
+
+// invalidate removes the cached @filePath, and prevents the ongoing download of it from being cached.
+// Later reads don't join the ongoing download, which may get the outdated content.
+func (ccm *CachedChunkManager) invalidate(filePath string) {
+	lc, key := ccm.cache, ccm.key(filePath)
+	lc.mu.Lock()
+	if state, ok := lc.loading[key]; ok {
+		state.invalidated = true
+		delete(lc.loading, key)
+	}
+	lc.group.Forget(key)
+	lc.mu.Unlock()
+	lc.files.Invalidate(key)
+}
+
+// Read reads @filePath from the local cache, the file is downloaded if it's not cached.
+func (ccm *CachedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	f, content, err := ccm.load(ctx, filePath)
+	if err != nil || content != nil {
+		return content, err
+	}
+	content, err = os.ReadFile(f.localPath)
+	if err != nil {
+		// the file has just been evicted
+		log.Ctx(ctx).Debug("failed to read cached file, read from remote", zap.String("filePath", filePath), zap.Error(err))
+		return ccm.ChunkManager.Read(ctx, filePath)
+	}
+	return content, nil
+}
+
+// MultiRead reads @filePaths through the local cache.
+func (ccm *CachedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	results := make([][]byte, len(filePaths))
+	for i, filePath := range filePaths {
+		content, err := ccm.Read(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = content
+	}
+	return results, nil
+}
+
+// ReadWithPrefix reads the files with @prefix through the local cache.
+func (ccm *CachedChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, _, err := ccm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := ccm.MultiRead(ctx, filePaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	return filePaths, results, nil
+}
+
+// ReadAt reads the range of @filePath from the local cache, the range is read from the remote storage
+// if the file is not cached.
+func (ccm *CachedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	f, ok := ccm.cache.files.GetIfPresent(ccm.key(filePath))
+	if !ok {
+		metrics.StorageCacheRequests.WithLabelValues(metrics.CacheMissLabel).Inc()
+		return ccm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	metrics.StorageCacheRequests.WithLabelValues(metrics.CacheHitLabel).Inc()
+	p, err := ccm.cache.local.ReadAt(ctx, f.localPath, off, length)
+	if err != nil && os.IsNotExist(err) {
+		// the file has just been evicted
+		return ccm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	return p, err
+}
+
+// Reader returns a reader of the cached file of @filePath, the file is still readable after it's evicted.
+func (ccm *CachedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	f, content, err := ccm.load(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if content != nil {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	r, err := os.Open(f.localPath)
+	if err != nil && os.IsNotExist(err) {
+		return ccm.ChunkManager.Reader(ctx, filePath)
+	}
+	return r, err
+}
+
+// Mmap maps the cached file of @filePath, the mapping is still valid after the file is evicted.
+func (ccm *CachedChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	f, content, err := ccm.load(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if content != nil {
+		return nil, fmt.Errorf("file %s is larger than the cache capacity", filePath)
+	}
+	return ccm.cache.local.Mmap(ctx, f.localPath)
+}
+
+// Write writes @content to the remote storage, and invalidates the cached @filePath.
+func (ccm *CachedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	defer ccm.invalidate(filePath)
+	return ccm.ChunkManager.Write(ctx, filePath, content)
+}
+
+// MultiWrite writes @contents to the remote storage, and invalidates the cached files.
+func (ccm *CachedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	defer func() {
+		for filePath := range contents {
+			ccm.invalidate(filePath)
+		}
+	}()
+	return ccm.ChunkManager.MultiWrite(ctx, contents)
+}
+
+// Remove removes @filePath from the remote storage and the local cache.
+func (ccm *CachedChunkManager) Remove(ctx context.Context, filePath string) error {
+	defer ccm.invalidate(filePath)
+	return ccm.ChunkManager.Remove(ctx, filePath)
+}
+
+// MultiRemove removes @filePaths from the remote storage and the local cache.
+func (ccm *CachedChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	defer func() {
+		for _, filePath := range filePaths {
+			ccm.invalidate(filePath)
+		}
+	}()
+	return ccm.ChunkManager.MultiRemove(ctx, filePaths)
+}
+
+// RemoveWithPrefix removes the files with @prefix from the remote storage and the local cache.
+func (ccm *CachedChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	filePaths, _, err := ccm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, filePath := range filePaths {
+			ccm.invalidate(filePath)
+		}
+	}()
+	return ccm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+}
+
+// Close releases the local cache, the cached files are removed once all the CachedChunkManagers sharing it are closed.
+func (ccm *CachedChunkManager) Close() {
+	ccm.closeOnce.Do(ccm.cache.release)
+}
@@ -0,0 +1,338 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+// countingChunkManager counts the reads of the underlying ChunkManager.
+type countingChunkManager struct {
+	ChunkManager
+	reads atomic.Int64
+}
+
+func (cm *countingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	cm.reads.Inc()
+	// make concurrent reads overlap
+	time.Sleep(10 * time.Millisecond)
+	return cm.ChunkManager.Read(ctx, filePath)
+}
+
+func newTestCachedChunkManager(t *testing.T, capacity int64, policy string) (*CachedChunkManager, *countingChunkManager, string) {
+	remotePath := t.TempDir()
+	remote := &countingChunkManager{ChunkManager: NewLocalChunkManager(RootPath(remotePath))}
+	ccm, err := NewCachedChunkManager(remote, "", path.Join(t.TempDir(), "cache"), capacity, policy)
+	require.NoError(t, err)
+	t.Cleanup(ccm.Close)
+	return ccm, remote, remotePath
+}
+
+func TestCachedChunkManager_Read(t *testing.T) {
+	ctx := context.Background()
+	ccm, remote, remotePath := newTestCachedChunkManager(t, 100, "lru")
+
+	file := path.Join(remotePath, "a")
+	require.NoError(t, ccm.Write(ctx, file, []byte("0123456789")))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			content, err := ccm.Read(ctx, file)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("0123456789"), content)
+		}()
+	}
+	wg.Wait()
+	// concurrent reads are merged into one download
+	assert.Equal(t, int64(1), remote.reads.Load())
+
+	p, err := ccm.ReadAt(ctx, file, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("234"), p)
+	r, err := ccm.Mmap(ctx, file)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, r.Len())
+	r.Close()
+	reader, err := ccm.Reader(ctx, file)
+	assert.NoError(t, err)
+	reader.Close()
+	contents, err := ccm.MultiRead(ctx, []string{file, file})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(contents))
+	assert.Equal(t, int64(1), remote.reads.Load())
+
+	// writes invalidate the cached file
+	require.NoError(t, ccm.Write(ctx, file, []byte("abc")))
+	assert.Eventually(t, func() bool {
+		content, err := ccm.Read(ctx, file)
+		return err == nil && string(content) == "abc"
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, ccm.Remove(ctx, file))
+	assert.Eventually(t, func() bool {
+		_, err := ccm.Read(ctx, file)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	_, err = ccm.Read(ctx, path.Join(remotePath, "not_exist"))
+	assert.Error(t, err)
+}
+
+func TestCachedChunkManager_Evict(t *testing.T) {
+	ctx := context.Background()
+	ccm, remote, remotePath := newTestCachedChunkManager(t, 25, "lfu")
+
+	files := []string{path.Join(remotePath, "a"), path.Join(remotePath, "b"), path.Join(remotePath, "c")}
+	for _, file := range files {
+		require.NoError(t, ccm.Write(ctx, file, make([]byte, 10)))
+	}
+	_, err := ccm.Read(ctx, files[0])
+	require.NoError(t, err)
+	_, err = ccm.Read(ctx, files[0])
+	require.NoError(t, err)
+	_, err = ccm.Read(ctx, files[1])
+	require.NoError(t, err)
+	// b is evicted to make room for c
+	_, err = ccm.Read(ctx, files[2])
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), remote.reads.Load())
+
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(ccm.cache.rootPath)
+		return err == nil && len(entries) == 2
+	}, time.Second, 10*time.Millisecond)
+	_, err = ccm.Read(ctx, files[0])
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), remote.reads.Load())
+
+	// files larger than the capacity are not cached
+	large := path.Join(remotePath, "large")
+	require.NoError(t, ccm.Write(ctx, large, make([]byte, 30)))
+	content, err := ccm.Read(ctx, large)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, len(content))
+	_, err = ccm.Mmap(ctx, large)
+	assert.Error(t, err)
+	assert.Equal(t, int64(5), remote.reads.Load())
+}
+
+func TestCachedChunkManager_ReadAt(t *testing.T) {
+	ctx := context.Background()
+	ccm, remote, remotePath := newTestCachedChunkManager(t, 100, "lru")
+	file := path.Join(remotePath, "a")
+	require.NoError(t, ccm.Write(ctx, file, []byte("0123456789")))
+
+	// range reads of the files not cached are passed through
+	p, err := ccm.ReadAt(ctx, file, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("234"), p)
+	_, err = ccm.ReadAt(ctx, file, 5, 10)
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), remote.reads.Load())
+	_, ok := ccm.cache.files.GetIfPresent(ccm.key(file))
+	assert.False(t, ok)
+
+	_, err = ccm.Read(ctx, file)
+	require.NoError(t, err)
+	p, err = ccm.ReadAt(ctx, file, 7, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("789"), p)
+	_, err = ccm.ReadAt(ctx, file, 5, 10)
+	assert.Error(t, err)
+	assert.Equal(t, int64(1), remote.reads.Load())
+}
+
+func TestCachedChunkManager_Shared(t *testing.T) {
+	ctx := context.Background()
+	rootPath := path.Join(t.TempDir(), "cache")
+	remotePath1, remotePath2 := t.TempDir(), t.TempDir()
+	ccm1, err := NewCachedChunkManager(NewLocalChunkManager(RootPath(remotePath1)), "remote1", rootPath, 25, "lru")
+	require.NoError(t, err)
+	ccm2, err := NewCachedChunkManager(NewLocalChunkManager(RootPath(remotePath2)), "remote2", rootPath, 25, "lru")
+	require.NoError(t, err)
+	assert.Same(t, ccm1.cache, ccm2.cache)
+	_, err = NewCachedChunkManager(NewLocalChunkManager(RootPath(remotePath2)), "remote3", rootPath, 50, "lru")
+	assert.Error(t, err)
+
+	// the same path of different remote storages
+	require.NoError(t, os.WriteFile(path.Join(remotePath1, "a"), make([]byte, 10), os.ModePerm))
+	require.NoError(t, os.WriteFile(path.Join(remotePath2, "a"), make([]byte, 20), os.ModePerm))
+	content, err := ccm1.Read(ctx, path.Join(remotePath1, "a"))
+	require.NoError(t, err)
+	assert.Equal(t, 10, len(content))
+	content, err = ccm2.Read(ctx, path.Join(remotePath2, "a"))
+	require.NoError(t, err)
+	assert.Equal(t, 20, len(content))
+
+	// the capacity is shared, the file of the first one is evicted
+	assert.Eventually(t, func() bool {
+		_, ok := ccm1.cache.files.GetIfPresent(ccm1.key(path.Join(remotePath1, "a")))
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+
+	// the cached files are kept until the last one is closed
+	ccm1.Close()
+	ccm1.Close()
+	_, err = os.Stat(rootPath)
+	assert.NoError(t, err)
+	_, ok := ccm2.cache.files.GetIfPresent(ccm2.key(path.Join(remotePath2, "a")))
+	assert.True(t, ok)
+	ccm2.Close()
+	_, err = os.Stat(rootPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestCachedChunkManager_Encrypted(t *testing.T) {
+	ctx := context.Background()
+	keyFile := path.Join(t.TempDir(), "keys.json")
+	writeTestKeyFile(t, keyFile, "k1", "k1")
+	ccm, _, remotePath := newTestCachedChunkManager(t, 1000, "lru")
+	ecm := NewEncryptedChunkManager(ccm, NewLocalKMS(keyFile), false)
+
+	file := path.Join(remotePath, "a")
+	require.NoError(t, ecm.Write(ctx, file, []byte("0123456789")))
+	content, err := ecm.Read(ctx, file)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("0123456789"), content)
+
+	// the encrypted content is cached
+	f, ok := ccm.cache.files.GetIfPresent(ccm.key(file))
+	require.True(t, ok)
+	cached, err := os.ReadFile(f.localPath)
+	require.NoError(t, err)
+	assert.True(t, isEncrypted(cached))
+	p, err := ecm.ReadAt(ctx, file, 2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("234"), p)
+}
+
+// blockingChunkManager blocks the reads of the underlying ChunkManager until unblocked.
+type blockingChunkManager struct {
+	ChunkManager
+	started chan struct{}
+	unblock chan struct{}
+}
+
+func (cm *blockingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	content, err := cm.ChunkManager.Read(ctx, filePath)
+	cm.started <- struct{}{}
+	select {
+	case <-cm.unblock:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return content, err
+}
+
+func newBlockingCachedChunkManager(t *testing.T) (*CachedChunkManager, *blockingChunkManager, string) {
+	remotePath := t.TempDir()
+	remote := &blockingChunkManager{
+		ChunkManager: NewLocalChunkManager(RootPath(remotePath)),
+		started:      make(chan struct{}, 10),
+		unblock:      make(chan struct{}),
+	}
+	ccm, err := NewCachedChunkManager(remote, "", path.Join(t.TempDir(), "cache"), 100, "lru")
+	require.NoError(t, err)
+	t.Cleanup(ccm.Close)
+	return ccm, remote, remotePath
+}
+
+func TestCachedChunkManager_CanceledReader(t *testing.T) {
+	ccm, remote, remotePath := newBlockingCachedChunkManager(t)
+	file := path.Join(remotePath, "a")
+	require.NoError(t, ccm.Write(context.Background(), file, []byte("0123456789")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := ccm.Read(ctx, file)
+		errCh <- err
+	}()
+	<-remote.started
+
+	// the second reader shares the download, which goes on after the first reader gives up
+	contentCh := make(chan []byte, 1)
+	go func() {
+		content, err := ccm.Read(context.Background(), file)
+		assert.NoError(t, err)
+		contentCh <- content
+	}()
+	cancel()
+	assert.ErrorIs(t, <-errCh, context.Canceled)
+
+	close(remote.unblock)
+	assert.Equal(t, []byte("0123456789"), <-contentCh)
+	_, ok := ccm.cache.files.GetIfPresent(ccm.key(file))
+	assert.True(t, ok)
+}
+
+func TestCachedChunkManager_WriteDuringDownload(t *testing.T) {
+	ctx := context.Background()
+	ccm, remote, remotePath := newBlockingCachedChunkManager(t)
+	file := path.Join(remotePath, "a")
+	require.NoError(t, ccm.Write(ctx, file, []byte("old")))
+
+	contentCh := make(chan []byte, 1)
+	go func() {
+		content, err := ccm.Read(ctx, file)
+		assert.NoError(t, err)
+		contentCh <- content
+	}()
+	<-remote.started
+
+	// the file is rewritten while the old content is being downloaded
+	require.NoError(t, ccm.Write(ctx, file, []byte("new")))
+	close(remote.unblock)
+	assert.Equal(t, []byte("old"), <-contentCh)
+	_, ok := ccm.cache.files.GetIfPresent(ccm.key(file))
+	assert.False(t, ok)
+
+	content, err := ccm.Read(ctx, file)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new"), content)
+	// the outdated download is not reused
+	assert.Equal(t, 1, len(remote.started))
+}
+
+func TestNewCachedChunkManager(t *testing.T) {
+	remote := NewLocalChunkManager(RootPath(t.TempDir()))
+	_, err := NewCachedChunkManager(remote, "", t.TempDir(), 0, "lru")
+	assert.Error(t, err)
+	_, err = NewCachedChunkManager(remote, "", t.TempDir(), 100, "fifo")
+	assert.Error(t, err)
+
+	rootPath := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(rootPath, "stale"), []byte("a"), os.ModePerm))
+	ccm, err := NewCachedChunkManager(remote, "", rootPath, 100, "lru")
+	require.NoError(t, err)
+	entries, err := os.ReadDir(rootPath)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+	ccm.Close()
+}
@@ -0,0 +1,471 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/metadata"
+	"github.com/apache/arrow/go/v8/parquet/schema"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+// Segment format v2 writes all fields of a flush into one parquet file, a column per field,
+// instead of a binlog per field. The file is stored as the insert log of the reserved field
+// common.ColumnarLogFieldID, and every field binlog of the segment meta refers to it.
+
+// columnarMetaKey is the key of the parquet key value metadata describing the columns
+const columnarMetaKey = "milvus.columnar"
+
+var columnarMagic = []byte("PAR1")
+
+type columnarField struct {
+	FieldID  FieldID           `json:"fieldID"`
+	DataType schemapb.DataType `json:"dataType"`
+	Dim      int               `json:"dim,omitempty"`
+	Nullable bool              `json:"nullable,omitempty"`
+}
+
+type columnarMeta struct {
+	CollectionID UniqueID        `json:"collectionID"`
+	PartitionID  UniqueID        `json:"partitionID"`
+	SegmentID    UniqueID        `json:"segmentID"`
+	Fields       []columnarField `json:"fields"`
+}
+
+// IsColumnarBinlog returns whether @data is an insert log of segment format v2.
+// Binlogs start with the binlog magic number while columnar logs start with the parquet magic.
+func IsColumnarBinlog(data []byte) bool {
+	return bytes.HasPrefix(data, columnarMagic)
+}
+
+// IsColumnarLogPath returns whether the insert log @logPath is a columnar log of segment format v2.
+func IsColumnarLogPath(logPath string) bool {
+	return path.Base(path.Dir(logPath)) == strconv.Itoa(common.ColumnarLogFieldID)
+}
+
+// GroupColumnarBinlogs returns the field binlogs of row format in @fieldBinlogs, followed by a field binlog
+// of common.ColumnarLogFieldID holding each columnar log once, so that the shared files are read only once.
+func GroupColumnarBinlogs(fieldBinlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
+	ret := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	var columnar *datapb.FieldBinlog
+	visited := make(map[string]struct{})
+	for _, fieldBinlog := range fieldBinlogs {
+		var binlogs []*datapb.Binlog
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if !IsColumnarLogPath(binlog.GetLogPath()) {
+				binlogs = append(binlogs, binlog)
+				continue
+			}
+			if _, ok := visited[binlog.GetLogPath()]; ok {
+				continue
+			}
+			visited[binlog.GetLogPath()] = struct{}{}
+			if columnar == nil {
+				columnar = &datapb.FieldBinlog{FieldID: common.ColumnarLogFieldID}
+			}
+			columnar.Binlogs = append(columnar.Binlogs, binlog)
+		}
+		if len(binlogs) > 0 {
+			ret = append(ret, &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID(), Binlogs: binlogs})
+		}
+	}
+	if columnar != nil {
+		ret = append(ret, columnar)
+	}
+	return ret
+}
+
+// SerializeColumnar works like SerializeWithPkStats, but writes all fields of @data into one parquet file
+// of segment format v2. The only insert blob returned is keyed by common.ColumnarLogFieldID.
+func (insertCodec *InsertCodec) SerializeColumnar(partitionID UniqueID, segmentID UniqueID, data *InsertData) ([]*Blob, []*Blob, []*PrimaryKeyStats, error) {
+	timeFieldData, ok := data.Data[common.TimeStampField]
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("data doesn't contains timestamp field")
+	}
+	if timeFieldData.RowNum() <= 0 {
+		return nil, nil, nil, fmt.Errorf("there's no data in InsertData")
+	}
+	rowNum := int64(timeFieldData.RowNum())
+
+	dataSorter := &DataSorter{
+		InsertCodec: insertCodec,
+		InsertData:  data,
+	}
+	sort.Sort(dataSorter)
+
+	meta := columnarMeta{
+		CollectionID: insertCodec.Schema.ID,
+		PartitionID:  partitionID,
+		SegmentID:    segmentID,
+	}
+	nodes := make(schema.FieldList, 0, len(insertCodec.Schema.Schema.Fields))
+	statsBlobs := make([]*Blob, 0)
+	pkStats := make([]*PrimaryKeyStats, 0)
+	for _, field := range insertCodec.Schema.Schema.Fields {
+		singleData, ok := data.Data[field.FieldID]
+		if !ok {
+			return nil, nil, nil, fmt.Errorf("data of field %d is missing", field.FieldID)
+		}
+		if singleData.RowNum() != int(rowNum) {
+			return nil, nil, nil, fmt.Errorf("field %d has %d rows, expected %d", field.FieldID, singleData.RowNum(), rowNum)
+		}
+		column := columnarField{
+			FieldID:  field.FieldID,
+			DataType: field.DataType,
+			Nullable: len(singleData.GetValidData()) > 0,
+		}
+		switch singleData := singleData.(type) {
+		case *FloatVectorFieldData:
+			column.Dim = singleData.Dim
+		case *BinaryVectorFieldData:
+			column.Dim = singleData.Dim
+		}
+		node, err := column.node()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		nodes = append(nodes, node)
+		meta.Fields = append(meta.Fields, column)
+
+		if field.GetIsPrimaryKey() {
+			statsBlob, stats, err := insertCodec.serializePkStats(field, singleData)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			statsBlobs = append(statsBlobs, statsBlob)
+			pkStats = append(pkStats, stats)
+		}
+	}
+
+	sc, err := schema.NewGroupNode("segment", parquet.Repetitions.Required, nodes, -1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	metaValue, err := json.Marshal(meta)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	kvMeta := metadata.NewKeyValueMetadata()
+	if err = kvMeta.Append(columnarMetaKey, string(metaValue)); err != nil {
+		return nil, nil, nil, err
+	}
+
+	buffer := new(bytes.Buffer)
+	writer := file.NewParquetWriter(buffer, sc, file.WithWriteMetadata(kvMeta))
+	rgWriter := writer.AppendRowGroup()
+	for _, column := range meta.Fields {
+		cw, err := rgWriter.NextColumn()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if err = writeColumnarColumn(cw, data.Data[column.FieldID]); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to write field %d: %w", column.FieldID, err)
+		}
+	}
+	if err = rgWriter.Close(); err != nil {
+		return nil, nil, nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, nil, nil, err
+	}
+
+	blobs := []*Blob{{
+		Key:    fmt.Sprintf("%d", common.ColumnarLogFieldID),
+		Value:  buffer.Bytes(),
+		RowNum: rowNum,
+	}}
+	return blobs, statsBlobs, pkStats, nil
+}
+
+func (f *columnarField) node() (schema.Node, error) {
+	name := strconv.FormatInt(f.FieldID, 10)
+	repetition := parquet.Repetitions.Required
+	if f.Nullable {
+		repetition = parquet.Repetitions.Optional
+	}
+	switch f.DataType {
+	case schemapb.DataType_Bool:
+		return schema.NewBooleanNode(name, repetition, int32(f.FieldID)), nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return schema.NewInt32Node(name, repetition, int32(f.FieldID)), nil
+	case schemapb.DataType_Int64:
+		return schema.NewInt64Node(name, repetition, int32(f.FieldID)), nil
+	case schemapb.DataType_Float:
+		return schema.NewFloat32Node(name, repetition, int32(f.FieldID)), nil
+	case schemapb.DataType_Double:
+		return schema.NewFloat64Node(name, repetition, int32(f.FieldID)), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.StringLogicalType{}, parquet.Types.ByteArray, -1, int32(f.FieldID))
+	case schemapb.DataType_BinaryVector:
+		return schema.NewFixedLenByteArrayNode(name, repetition, int32(f.Dim/8), int32(f.FieldID)), nil
+	case schemapb.DataType_FloatVector:
+		return schema.NewFixedLenByteArrayNode(name, repetition, int32(f.Dim*4), int32(f.FieldID)), nil
+	default:
+		return nil, fmt.Errorf("undefined data type %d", f.DataType)
+	}
+}
+
+// nullableBatch returns the values of the valid rows of @data and the definition levels of all rows,
+// or @data itself if @validData is empty.
+func nullableBatch[T any](data []T, validData []bool) ([]T, []int16) {
+	if len(validData) == 0 {
+		return data, nil
+	}
+	values := make([]T, 0, len(data))
+	defLevels := make([]int16, len(data))
+	for i, valid := range validData {
+		if valid {
+			values = append(values, data[i])
+			defLevels[i] = 1
+		}
+	}
+	return values, defLevels
+}
+
+func toInt32s[T int8 | int16](data []T) []int32 {
+	ret := make([]int32, len(data))
+	for i, v := range data {
+		ret[i] = int32(v)
+	}
+	return ret
+}
+
+func toFixedLenByteArrays(data []byte, width int) []parquet.FixedLenByteArray {
+	ret := make([]parquet.FixedLenByteArray, len(data)/width)
+	for i := range ret {
+		ret[i] = data[i*width : (i+1)*width]
+	}
+	return ret
+}
+
+func writeColumnarColumn(cw file.ColumnChunkWriter, data FieldData) error {
+	var err error
+	switch data := data.(type) {
+	case *BoolFieldData:
+		values, defLevels := nullableBatch(data.Data, data.ValidData)
+		_, err = cw.(*file.BooleanColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *Int8FieldData:
+		values, defLevels := nullableBatch(toInt32s(data.Data), data.ValidData)
+		_, err = cw.(*file.Int32ColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *Int16FieldData:
+		values, defLevels := nullableBatch(toInt32s(data.Data), data.ValidData)
+		_, err = cw.(*file.Int32ColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *Int32FieldData:
+		values, defLevels := nullableBatch(data.Data, data.ValidData)
+		_, err = cw.(*file.Int32ColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *Int64FieldData:
+		values, defLevels := nullableBatch(data.Data, data.ValidData)
+		_, err = cw.(*file.Int64ColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *FloatFieldData:
+		values, defLevels := nullableBatch(data.Data, data.ValidData)
+		_, err = cw.(*file.Float32ColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *DoubleFieldData:
+		values, defLevels := nullableBatch(data.Data, data.ValidData)
+		_, err = cw.(*file.Float64ColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *StringFieldData:
+		strs := make([]parquet.ByteArray, len(data.Data))
+		for i, str := range data.Data {
+			strs[i] = parquet.ByteArray(str)
+		}
+		values, defLevels := nullableBatch(strs, data.ValidData)
+		_, err = cw.(*file.ByteArrayColumnChunkWriter).WriteBatch(values, defLevels, nil)
+	case *BinaryVectorFieldData:
+		values := toFixedLenByteArrays(data.Data, data.Dim/8)
+		_, err = cw.(*file.FixedLenByteArrayColumnChunkWriter).WriteBatch(values, nil, nil)
+	case *FloatVectorFieldData:
+		values := toFixedLenByteArrays(arrow.Float32Traits.CastToBytes(data.Data), data.Dim*4)
+		_, err = cw.(*file.FixedLenByteArrayColumnChunkWriter).WriteBatch(values, nil, nil)
+	default:
+		err = fmt.Errorf("unexpected field data %T", data)
+	}
+	return err
+}
+
+// readColumnarColumn reads the @column-th column of @reader, the validity of rows is returned if the column is nullable.
+func readColumnarColumn[T any, E interface {
+	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
+}](reader *file.Reader, column int, nullable bool) ([]T, []bool, error) {
+	numRows := reader.NumRows()
+	values := make([]T, numRows)
+	var validData []bool
+	if nullable {
+		validData = make([]bool, numRows)
+	}
+	rowsRead, err := readDataFromAllRowGroups[T, E](reader, values, validData, column, numRows)
+	if err != nil {
+		return nil, nil, err
+	}
+	if rowsRead != numRows {
+		return nil, nil, fmt.Errorf("expect %d rows, but got rowsRead = %d", numRows, rowsRead)
+	}
+	return values, validData, nil
+}
+
+func fromInt32s[T int8 | int16](data []int32) []T {
+	ret := make([]T, len(data))
+	for i, v := range data {
+		ret[i] = T(v)
+	}
+	return ret
+}
+
+func fromFixedLenByteArrays(values []parquet.FixedLenByteArray, width int) []byte {
+	ret := make([]byte, len(values)*width)
+	for i, v := range values {
+		copy(ret[i*width:(i+1)*width], v)
+	}
+	return ret
+}
+
+func readColumnarField(reader *file.Reader, column int, field columnarField) (FieldData, error) {
+	numRows := []int64{reader.NumRows()}
+	switch field.DataType {
+	case schemapb.DataType_Bool:
+		values, validData, err := readColumnarColumn[bool, *file.BooleanColumnChunkReader](reader, column, field.Nullable)
+		return &BoolFieldData{NumRows: numRows, Data: values, ValidData: validData}, err
+	case schemapb.DataType_Int8:
+		values, validData, err := readColumnarColumn[int32, *file.Int32ColumnChunkReader](reader, column, field.Nullable)
+		return &Int8FieldData{NumRows: numRows, Data: fromInt32s[int8](values), ValidData: validData}, err
+	case schemapb.DataType_Int16:
+		values, validData, err := readColumnarColumn[int32, *file.Int32ColumnChunkReader](reader, column, field.Nullable)
+		return &Int16FieldData{NumRows: numRows, Data: fromInt32s[int16](values), ValidData: validData}, err
+	case schemapb.DataType_Int32:
+		values, validData, err := readColumnarColumn[int32, *file.Int32ColumnChunkReader](reader, column, field.Nullable)
+		return &Int32FieldData{NumRows: numRows, Data: values, ValidData: validData}, err
+	case schemapb.DataType_Int64:
+		values, validData, err := readColumnarColumn[int64, *file.Int64ColumnChunkReader](reader, column, field.Nullable)
+		return &Int64FieldData{NumRows: numRows, Data: values, ValidData: validData}, err
+	case schemapb.DataType_Float:
+		values, validData, err := readColumnarColumn[float32, *file.Float32ColumnChunkReader](reader, column, field.Nullable)
+		return &FloatFieldData{NumRows: numRows, Data: values, ValidData: validData}, err
+	case schemapb.DataType_Double:
+		values, validData, err := readColumnarColumn[float64, *file.Float64ColumnChunkReader](reader, column, field.Nullable)
+		return &DoubleFieldData{NumRows: numRows, Data: values, ValidData: validData}, err
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		values, validData, err := readColumnarColumn[parquet.ByteArray, *file.ByteArrayColumnChunkReader](reader, column, field.Nullable)
+		if err != nil {
+			return nil, err
+		}
+		strs := make([]string, len(values))
+		for i, v := range values {
+			strs[i] = string(v)
+		}
+		return &StringFieldData{NumRows: numRows, Data: strs, ValidData: validData}, nil
+	case schemapb.DataType_BinaryVector:
+		values, _, err := readColumnarColumn[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](reader, column, false)
+		if err != nil {
+			return nil, err
+		}
+		return &BinaryVectorFieldData{NumRows: numRows, Data: fromFixedLenByteArrays(values, field.Dim/8), Dim: field.Dim}, nil
+	case schemapb.DataType_FloatVector:
+		values, _, err := readColumnarColumn[parquet.FixedLenByteArray, *file.FixedLenByteArrayColumnChunkReader](reader, column, false)
+		if err != nil {
+			return nil, err
+		}
+		vectors := make([]float32, len(values)*field.Dim)
+		copy(arrow.Float32Traits.CastToBytes(vectors), fromFixedLenByteArrays(values, field.Dim*4))
+		return &FloatVectorFieldData{NumRows: numRows, Data: vectors, Dim: field.Dim}, nil
+	default:
+		return nil, fmt.Errorf("undefined data type %d", field.DataType)
+	}
+}
+
+// fieldNumRows returns the address of NumRows of @data, the row numbers of the logs it's read from.
+func fieldNumRows(data FieldData) *[]int64 {
+	switch data := data.(type) {
+	case *BoolFieldData:
+		return &data.NumRows
+	case *Int8FieldData:
+		return &data.NumRows
+	case *Int16FieldData:
+		return &data.NumRows
+	case *Int32FieldData:
+		return &data.NumRows
+	case *Int64FieldData:
+		return &data.NumRows
+	case *FloatFieldData:
+		return &data.NumRows
+	case *DoubleFieldData:
+		return &data.NumRows
+	case *StringFieldData:
+		return &data.NumRows
+	case *BinaryVectorFieldData:
+		return &data.NumRows
+	case *FloatVectorFieldData:
+		return &data.NumRows
+	}
+	return nil
+}
+
+// deserializeColumnarInto appends the fields of the columnar log @data in @fields, or all fields if @fields is nil, to @insertData.
+func deserializeColumnarInto(data []byte, insertData *InsertData, fields map[FieldID]struct{}) (UniqueID, UniqueID, UniqueID, error) {
+	reader, err := file.NewParquetReader(bytes.NewReader(data))
+	if err != nil {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+	}
+	defer reader.Close()
+
+	metaValue := reader.MetaData().KeyValueMetadata().FindValue(columnarMetaKey)
+	if metaValue == nil {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, errors.New("columnar log has no field meta")
+	}
+	var meta columnarMeta
+	if err = json.Unmarshal([]byte(*metaValue), &meta); err != nil {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, fmt.Errorf("invalid field meta of columnar log: %w", err)
+	}
+	if len(meta.Fields) != reader.MetaData().Schema.NumColumns() {
+		return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, fmt.Errorf("columnar log has %d columns, but %d fields in meta",
+			reader.MetaData().Schema.NumColumns(), len(meta.Fields))
+	}
+
+	for column, field := range meta.Fields {
+		if _, ok := fields[field.FieldID]; fields != nil && !ok {
+			continue
+		}
+		fieldData, err := readColumnarField(reader, column, field)
+		if err != nil {
+			return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, fmt.Errorf("failed to read field %d: %w", field.FieldID, err)
+		}
+		if existing, ok := insertData.Data[field.FieldID]; ok {
+			// MergeFieldData accumulates the rows into NumRows[0], keep the rows of each log instead
+			numRows := fieldNumRows(existing)
+			logRows := append(append([]int64(nil), *numRows...), reader.NumRows())
+			*numRows = []int64{0}
+			MergeFieldData(insertData, field.FieldID, fieldData)
+			*numRows = logRows
+		} else {
+			insertData.Data[field.FieldID] = fieldData
+		}
+		if field.FieldID == common.TimeStampField {
+			insertData.Infos = append(insertData.Infos, BlobInfo{Length: int(reader.NumRows())})
+		}
+	}
+	return meta.CollectionID, meta.PartitionID, meta.SegmentID, nil
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+func genColumnarTestMeta() *etcdpb.CollectionMeta {
+	nullableParams := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	return &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: BoolField, Name: "field_bool", DataType: schemapb.DataType_Bool},
+				{FieldID: Int8Field, Name: "field_int8", DataType: schemapb.DataType_Int8},
+				{FieldID: Int16Field, Name: "field_int16", DataType: schemapb.DataType_Int16},
+				{FieldID: Int32Field, Name: "field_int32", DataType: schemapb.DataType_Int32, TypeParams: nullableParams},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: FloatField, Name: "field_float", DataType: schemapb.DataType_Float},
+				{FieldID: DoubleField, Name: "field_double", DataType: schemapb.DataType_Double},
+				{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_VarChar, TypeParams: nullableParams},
+				{FieldID: BinaryVectorField, Name: "field_binary_vector", DataType: schemapb.DataType_BinaryVector},
+				{FieldID: FloatVectorField, Name: "field_float_vector", DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}
+}
+
+func genColumnarTestData(start int64) *InsertData {
+	return &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:        &Int64FieldData{Data: []int64{start, start + 1}},
+			TimestampField:    &Int64FieldData{Data: []int64{start, start + 1}},
+			BoolField:         &BoolFieldData{Data: []bool{true, false}},
+			Int8Field:         &Int8FieldData{Data: []int8{-1, 2}},
+			Int16Field:        &Int16FieldData{Data: []int16{-3, 4}},
+			Int32Field:        &Int32FieldData{Data: []int32{0, 6}, ValidData: []bool{false, true}},
+			Int64Field:        &Int64FieldData{Data: []int64{start, start + 1}},
+			FloatField:        &FloatFieldData{Data: []float32{1.5, 2.5}},
+			DoubleField:       &DoubleFieldData{Data: []float64{3.5, 4.5}},
+			StringField:       &StringFieldData{Data: []string{"a", "b"}},
+			BinaryVectorField: &BinaryVectorFieldData{Data: []byte{1, 2}, Dim: 8},
+			FloatVectorField:  &FloatVectorFieldData{Data: []float32{1, 2, 3, 4}, Dim: 2},
+		},
+	}
+}
+
+func TestInsertCodec_SerializeColumnar(t *testing.T) {
+	codec := NewInsertCodec(genColumnarTestMeta())
+	blobs1, statsBlobs, pkStats, err := codec.SerializeColumnar(PartitionID, SegmentID, genColumnarTestData(1))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blobs1))
+	assert.Equal(t, "99", blobs1[0].Key)
+	assert.EqualValues(t, 2, blobs1[0].RowNum)
+	assert.True(t, IsColumnarBinlog(blobs1[0].Value))
+	require.Equal(t, 1, len(statsBlobs))
+	require.Equal(t, 1, len(pkStats))
+	assert.EqualValues(t, Int64Field, pkStats[0].FieldID)
+
+	blobs2, _, _, err := codec.SerializeColumnar(PartitionID, SegmentID, genColumnarTestData(3))
+	require.NoError(t, err)
+
+	collectionID, partitionID, segmentID, data, err := codec.DeserializeAll(append(blobs1, blobs2...))
+	require.NoError(t, err)
+	assert.EqualValues(t, CollectionID, collectionID)
+	assert.EqualValues(t, PartitionID, partitionID)
+	assert.EqualValues(t, SegmentID, segmentID)
+	assert.Equal(t, 12, len(data.Data))
+	assert.Equal(t, []int64{1, 2, 3, 4}, data.Data[RowIDField].(*Int64FieldData).Data)
+	assert.Equal(t, []int64{2, 2}, data.Data[TimestampField].(*Int64FieldData).NumRows)
+	assert.Equal(t, 2, len(data.Infos))
+	assert.Equal(t, []bool{true, false, true, false}, data.Data[BoolField].(*BoolFieldData).Data)
+	assert.Equal(t, []int8{-1, 2, -1, 2}, data.Data[Int8Field].(*Int8FieldData).Data)
+	assert.Equal(t, []int16{-3, 4, -3, 4}, data.Data[Int16Field].(*Int16FieldData).Data)
+	assert.Equal(t, []int32{0, 6, 0, 6}, data.Data[Int32Field].(*Int32FieldData).Data)
+	assert.Equal(t, []bool{false, true, false, true}, data.Data[Int32Field].GetValidData())
+	assert.Equal(t, []float32{1.5, 2.5, 1.5, 2.5}, data.Data[FloatField].(*FloatFieldData).Data)
+	assert.Equal(t, []float64{3.5, 4.5, 3.5, 4.5}, data.Data[DoubleField].(*DoubleFieldData).Data)
+	assert.Equal(t, []string{"a", "b", "a", "b"}, data.Data[StringField].(*StringFieldData).Data)
+	assert.Empty(t, data.Data[StringField].GetValidData())
+	assert.Equal(t, []byte{1, 2, 1, 2}, data.Data[BinaryVectorField].(*BinaryVectorFieldData).Data)
+	assert.Equal(t, 8, data.Data[BinaryVectorField].(*BinaryVectorFieldData).Dim)
+	assert.Equal(t, []float32{1, 2, 3, 4, 1, 2, 3, 4}, data.Data[FloatVectorField].(*FloatVectorFieldData).Data)
+	assert.Equal(t, 2, data.Data[FloatVectorField].(*FloatVectorFieldData).Dim)
+
+	projected := &InsertData{Data: make(map[FieldID]FieldData)}
+	_, _, _, err = codec.DeserializeFieldsInto(append(blobs1, blobs2...), 4, projected, Int64Field, FloatVectorField)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(projected.Data))
+	assert.Equal(t, []int64{1, 2, 3, 4}, projected.Data[Int64Field].(*Int64FieldData).Data)
+
+	_, _, _, err = codec.SerializeColumnar(PartitionID, SegmentID, &InsertData{Data: map[int64]FieldData{
+		TimestampField: &Int64FieldData{Data: []int64{1}},
+	}})
+	assert.Error(t, err)
+}
+
+func TestVerifyColumnarBinlog(t *testing.T) {
+	codec := NewInsertCodec(genColumnarTestMeta())
+	blobs, _, _, err := codec.SerializeColumnar(PartitionID, SegmentID, genColumnarTestData(1))
+	require.NoError(t, err)
+	data := blobs[0].Value
+	assert.NoError(t, VerifyBinlog(data))
+	assert.Error(t, VerifyBinlog(data[:len(data)-10]))
+	assert.Error(t, VerifyBinlog(data[:len(data)/2]))
+}
+
+func TestGroupColumnarBinlogs(t *testing.T) {
+	fieldBinlogs := []*datapb.FieldBinlog{
+		{FieldID: 0, Binlogs: []*datapb.Binlog{{LogPath: "insert_log/1/2/3/0/10"}, {LogPath: "insert_log/1/2/3/99/20"}}},
+		{FieldID: 1, Binlogs: []*datapb.Binlog{{LogPath: "insert_log/1/2/3/1/11"}, {LogPath: "insert_log/1/2/3/99/20"}}},
+		{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "insert_log/1/2/3/99/20"}, {LogPath: "insert_log/1/2/3/99/30"}}},
+	}
+	grouped := GroupColumnarBinlogs(fieldBinlogs)
+	require.Equal(t, 3, len(grouped))
+	assert.EqualValues(t, 0, grouped[0].GetFieldID())
+	assert.Equal(t, 1, len(grouped[0].GetBinlogs()))
+	assert.EqualValues(t, 1, grouped[1].GetFieldID())
+	assert.EqualValues(t, common.ColumnarLogFieldID, grouped[2].GetFieldID())
+	require.Equal(t, 2, len(grouped[2].GetBinlogs()))
+	assert.Equal(t, "insert_log/1/2/3/99/20", grouped[2].GetBinlogs()[0].GetLogPath())
+	assert.Equal(t, "insert_log/1/2/3/99/30", grouped[2].GetBinlogs()[1].GetLogPath())
+
+	assert.True(t, IsColumnarLogPath("files/insert_log/1/2/3/99/20"))
+	assert.False(t, IsColumnarLogPath("files/insert_log/1/2/3/100/20"))
+}
@@ -107,39 +107,48 @@ type FieldData interface {
 	GetMemorySize() int
 	RowNum() int
 	GetRow(i int) interface{}
+	GetValidData() []bool
 }
 
 type BoolFieldData struct {
-	NumRows []int64
-	Data    []bool
+	NumRows   []int64
+	Data      []bool
+	ValidData []bool
 }
 type Int8FieldData struct {
-	NumRows []int64
-	Data    []int8
+	NumRows   []int64
+	Data      []int8
+	ValidData []bool
 }
 type Int16FieldData struct {
-	NumRows []int64
-	Data    []int16
+	NumRows   []int64
+	Data      []int16
+	ValidData []bool
 }
 type Int32FieldData struct {
-	NumRows []int64
-	Data    []int32
+	NumRows   []int64
+	Data      []int32
+	ValidData []bool
 }
 type Int64FieldData struct {
-	NumRows []int64
-	Data    []int64
+	NumRows   []int64
+	Data      []int64
+	ValidData []bool
 }
 type FloatFieldData struct {
-	NumRows []int64
-	Data    []float32
+	NumRows   []int64
+	Data      []float32
+	ValidData []bool
 }
 type DoubleFieldData struct {
-	NumRows []int64
-	Data    []float64
+	NumRows   []int64
+	Data      []float64
+	ValidData []bool
 }
 type StringFieldData struct {
-	NumRows []int64
-	Data    []string
+	NumRows   []int64
+	Data      []string
+	ValidData []bool
 }
 type BinaryVectorFieldData struct {
 	NumRows []int64
@@ -180,6 +189,19 @@ func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim : (i+1)*data.Dim]
 }
 
+// GetValidData implements FieldData.GetValidData, the validity of each row of nullable field,
+// empty if all rows are valid
+func (data *BoolFieldData) GetValidData() []bool         { return data.ValidData }
+func (data *Int8FieldData) GetValidData() []bool         { return data.ValidData }
+func (data *Int16FieldData) GetValidData() []bool        { return data.ValidData }
+func (data *Int32FieldData) GetValidData() []bool        { return data.ValidData }
+func (data *Int64FieldData) GetValidData() []bool        { return data.ValidData }
+func (data *FloatFieldData) GetValidData() []bool        { return data.ValidData }
+func (data *DoubleFieldData) GetValidData() []bool       { return data.ValidData }
+func (data *StringFieldData) GetValidData() []bool       { return data.ValidData }
+func (data *BinaryVectorFieldData) GetValidData() []bool { return nil }
+func (data *FloatVectorFieldData) GetValidData() []bool  { return nil }
+
 // why not binary.Size(data) directly? binary.Size(data) return -1
 // binary.Size returns how many bytes Write would generate to encode the value v, which
 // must be a fixed-size value or a slice of fixed-size values, or a pointer to such data.
@@ -187,39 +209,39 @@ func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *BoolFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int8FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int16FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int32FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int64FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *FloatFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *DoubleFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *StringFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *BinaryVectorFieldData) GetMemorySize() int {
@@ -268,15 +290,22 @@ func NewInsertCodec(schema *etcdpb.CollectionMeta) *InsertCodec {
 // For each field, it will create a binlog writer, and write an event to the binlog.
 // It returns binlog buffer in the end.
 func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID UniqueID, data *InsertData) ([]*Blob, []*Blob, error) {
+	blobs, statsBlobs, _, err := insertCodec.SerializeWithPkStats(partitionID, segmentID, data)
+	return blobs, statsBlobs, err
+}
+
+// SerializeWithPkStats is Serialize which also returns the primary key stats in the stats blobs.
+func (insertCodec *InsertCodec) SerializeWithPkStats(partitionID UniqueID, segmentID UniqueID, data *InsertData) ([]*Blob, []*Blob, []*PrimaryKeyStats, error) {
 	blobs := make([]*Blob, 0)
 	statsBlobs := make([]*Blob, 0)
+	pkStats := make([]*PrimaryKeyStats, 0)
 	var writer *InsertBinlogWriter
 	timeFieldData, ok := data.Data[common.TimeStampField]
 	if !ok {
-		return nil, nil, fmt.Errorf("data doesn't contains timestamp field")
+		return nil, nil, nil, fmt.Errorf("data doesn't contains timestamp field")
 	}
 	if timeFieldData.RowNum() <= 0 {
-		return nil, nil, fmt.Errorf("there's no data in InsertData")
+		return nil, nil, nil, fmt.Errorf("there's no data in InsertData")
 	}
 	rowNum := int64(timeFieldData.RowNum())
 
@@ -305,82 +334,87 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 			case schemapb.DataType_BinaryVector:
 				eventWriter, err = writer.NextInsertEventWriter(singleData.(*BinaryVectorFieldData).Dim)
 			default:
-				return nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
+				return nil, nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
 			}
 		} else {
 			eventWriter, err = writer.NextInsertEventWriter()
 		}
 		if err != nil {
 			writer.Close()
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		eventWriter.SetEventTimestamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 		switch field.DataType {
 		case schemapb.DataType_Bool:
-			err = eventWriter.AddBoolToPayload(singleData.(*BoolFieldData).Data)
+			err = addScalarToPayload(eventWriter, singleData.(*BoolFieldData).Data, singleData.(*BoolFieldData).ValidData, eventWriter.AddBoolToPayload)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BoolFieldData).GetMemorySize()))
 		case schemapb.DataType_Int8:
-			err = eventWriter.AddInt8ToPayload(singleData.(*Int8FieldData).Data)
+			err = addScalarToPayload(eventWriter, singleData.(*Int8FieldData).Data, singleData.(*Int8FieldData).ValidData, eventWriter.AddInt8ToPayload)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int8FieldData).GetMemorySize()))
 		case schemapb.DataType_Int16:
-			err = eventWriter.AddInt16ToPayload(singleData.(*Int16FieldData).Data)
+			err = addScalarToPayload(eventWriter, singleData.(*Int16FieldData).Data, singleData.(*Int16FieldData).ValidData, eventWriter.AddInt16ToPayload)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int16FieldData).GetMemorySize()))
 		case schemapb.DataType_Int32:
-			err = eventWriter.AddInt32ToPayload(singleData.(*Int32FieldData).Data)
+			err = addScalarToPayload(eventWriter, singleData.(*Int32FieldData).Data, singleData.(*Int32FieldData).ValidData, eventWriter.AddInt32ToPayload)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int32FieldData).GetMemorySize()))
 		case schemapb.DataType_Int64:
-			err = eventWriter.AddInt64ToPayload(singleData.(*Int64FieldData).Data)
+			err = addScalarToPayload(eventWriter, singleData.(*Int64FieldData).Data, singleData.(*Int64FieldData).ValidData, eventWriter.AddInt64ToPayload)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*Int64FieldData).GetMemorySize()))
 		case schemapb.DataType_Float:
-			err = eventWriter.AddFloatToPayload(singleData.(*FloatFieldData).Data)
+			err = addScalarToPayload(eventWriter, singleData.(*FloatFieldData).Data, singleData.(*FloatFieldData).ValidData, eventWriter.AddFloatToPayload)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatFieldData).GetMemorySize()))
 		case schemapb.DataType_Double:
-			err = eventWriter.AddDoubleToPayload(singleData.(*DoubleFieldData).Data)
+			err = addScalarToPayload(eventWriter, singleData.(*DoubleFieldData).Data, singleData.(*DoubleFieldData).ValidData, eventWriter.AddDoubleToPayload)
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*DoubleFieldData).GetMemorySize()))
 		case schemapb.DataType_String, schemapb.DataType_VarChar:
-			for _, singleString := range singleData.(*StringFieldData).Data {
-				err = eventWriter.AddOneStringToPayload(singleString)
-				if err != nil {
-					eventWriter.Close()
-					writer.Close()
-					return nil, nil, err
+			err = addScalarToPayload(eventWriter, singleData.(*StringFieldData).Data, singleData.(*StringFieldData).ValidData, func(msgs []string) error {
+				for _, singleString := range msgs {
+					if err := eventWriter.AddOneStringToPayload(singleString); err != nil {
+						return err
+					}
 				}
+				return nil
+			})
+			if err != nil {
+				eventWriter.Close()
+				writer.Close()
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
 		case schemapb.DataType_BinaryVector:
@@ -388,7 +422,7 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*BinaryVectorFieldData).GetMemorySize()))
 		case schemapb.DataType_FloatVector:
@@ -396,14 +430,17 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 			if err != nil {
 				eventWriter.Close()
 				writer.Close()
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*FloatVectorFieldData).GetMemorySize()))
 		default:
-			return nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
+			return nil, nil, nil, fmt.Errorf("undefined data type %d", field.DataType)
 		}
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
+		}
+		if len(singleData.GetValidData()) > 0 {
+			writer.AddExtra(nullableKey, "true")
 		}
 		writer.SetEventTimeStamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 
@@ -411,14 +448,14 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		if err != nil {
 			eventWriter.Close()
 			writer.Close()
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 
 		buffer, err := writer.GetBuffer()
 		if err != nil {
 			eventWriter.Close()
 			writer.Close()
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		blobKey := fmt.Sprintf("%d", field.FieldID)
 		blobs = append(blobs, &Blob{
@@ -431,21 +468,66 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 
 		// stats fields
 		if field.GetIsPrimaryKey() {
-			statsWriter := &StatsWriter{}
-			err = statsWriter.GeneratePrimaryKeyStats(field.FieldID, field.DataType, singleData)
+			statsBlob, stats, err := insertCodec.serializePkStats(field, singleData)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, nil, err
 			}
-			statsBuffer := statsWriter.GetBuffer()
-			statsBlobs = append(statsBlobs, &Blob{
-				Key:    blobKey,
-				Value:  statsBuffer,
-				RowNum: rowNum,
-			})
+			statsBlobs = append(statsBlobs, statsBlob)
+			pkStats = append(pkStats, stats)
+		}
+	}
+
+	return blobs, statsBlobs, pkStats, nil
+}
+
+// serializePkStats generates the stats blob of the primary key field.
+func (insertCodec *InsertCodec) serializePkStats(field *schemapb.FieldSchema, data FieldData) (*Blob, *PrimaryKeyStats, error) {
+	statsWriter := &StatsWriter{}
+	stats, err := statsWriter.GenerateBinaryPrimaryKeyStats(field.FieldID, field.DataType, data, GetBloomFilterParams(insertCodec.Schema.Schema))
+	if err != nil {
+		return nil, nil, err
+	}
+	return &Blob{
+		Key:    fmt.Sprintf("%d", field.FieldID),
+		Value:  statsWriter.GetBuffer(),
+		RowNum: int64(data.RowNum()),
+	}, stats, nil
+}
+
+// SerializeFieldStats generates the field stats blobs of the scalar user fields in @data,
+// the primary key is skipped since its stats are serialized along with the insert binlogs.
+func (insertCodec *InsertCodec) SerializeFieldStats(data *InsertData) ([]*Blob, []*FieldStats, error) {
+	blobs := make([]*Blob, 0)
+	fieldStats := make([]*FieldStats, 0)
+	for _, field := range insertCodec.Schema.Schema.Fields {
+		if field.GetFieldID() < common.StartOfUserFieldID || field.GetIsPrimaryKey() || !SupportFieldStats(field.GetDataType()) {
+			continue
+		}
+		singleData, ok := data.Data[field.FieldID]
+		if !ok || singleData.RowNum() == 0 {
+			continue
+		}
+		statsWriter := &StatsWriter{}
+		stats, err := statsWriter.GenerateFieldStats(field.FieldID, field.DataType, singleData)
+		if err != nil {
+			return nil, nil, err
 		}
+		blobs = append(blobs, &Blob{
+			Key:    fmt.Sprintf("%d", field.FieldID),
+			Value:  statsWriter.GetBuffer(),
+			RowNum: int64(singleData.RowNum()),
+		})
+		fieldStats = append(fieldStats, stats)
 	}
+	return blobs, fieldStats, nil
+}
 
-	return blobs, statsBlobs, nil
+// addScalarToPayload adds scalar field data into payload by @add, or as nullable data if @validData is provided
+func addScalarToPayload[T any](writer PayloadWriterInterface, data []T, validData []bool, add func([]T) error) error {
+	if len(validData) > 0 {
+		return writer.AddNullableDataToPayload(data, validData)
+	}
+	return add(data)
 }
 
 func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
@@ -477,8 +559,40 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 	partitionID UniqueID,
 	segmentID UniqueID,
 	err error,
+) {
+	return insertCodec.deserializeInto(fieldBinlogs, rowNum, insertData, nil)
+}
+
+// DeserializeFieldsInto works like DeserializeInto, but only reads fields @fieldIDs,
+// the other columns of the columnar logs in @fieldBinlogs are not decoded.
+func (insertCodec *InsertCodec) DeserializeFieldsInto(fieldBinlogs []*Blob, rowNum int, insertData *InsertData, fieldIDs ...FieldID) (
+	collectionID UniqueID,
+	partitionID UniqueID,
+	segmentID UniqueID,
+	err error,
+) {
+	fields := make(map[FieldID]struct{}, len(fieldIDs))
+	for _, fieldID := range fieldIDs {
+		fields[fieldID] = struct{}{}
+	}
+	return insertCodec.deserializeInto(fieldBinlogs, rowNum, insertData, fields)
+}
+
+// deserializeInto reads the fields in @fields, or all fields if @fields is nil, of @fieldBinlogs into @insertData.
+func (insertCodec *InsertCodec) deserializeInto(fieldBinlogs []*Blob, rowNum int, insertData *InsertData, fields map[FieldID]struct{}) (
+	collectionID UniqueID,
+	partitionID UniqueID,
+	segmentID UniqueID,
+	err error,
 ) {
 	for _, blob := range fieldBinlogs {
+		if IsColumnarBinlog(blob.Value) {
+			collectionID, partitionID, segmentID, err = deserializeColumnarInto(blob.Value, insertData, fields)
+			if err != nil {
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+			}
+			continue
+		}
 		binlogReader, err := NewBinlogReader(blob.Value)
 		if err != nil {
 			return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
@@ -489,6 +603,11 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 
 		dataType := binlogReader.PayloadDataType
 		fieldID := binlogReader.FieldID
+		if _, ok := fields[fieldID]; fields != nil && !ok {
+			binlogReader.Close()
+			continue
+		}
+		nullable := binlogReader.Extras[nullableKey] == "true"
 		totalLength := 0
 		dim := 0
 
@@ -500,6 +619,10 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 			if eventReader == nil {
 				break
 			}
+			rowsBefore := 0
+			if fieldData, ok := insertData.Data[fieldID]; ok && !typeutil.IsVectorType(dataType) {
+				rowsBefore = fieldData.RowNum()
+			}
 			switch dataType {
 			case schemapb.DataType_Bool:
 				singleData, err := eventReader.GetBoolFromPayload()
@@ -732,6 +855,13 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 				binlogReader.Close()
 				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, fmt.Errorf("undefined data type %d", dataType)
 			}
+			if !typeutil.IsVectorType(dataType) {
+				if err = appendValidData(eventReader, insertData.Data[fieldID], rowsBefore, nullable); err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, err
+				}
+			}
 			eventReader.Close()
 		}
 
@@ -751,6 +881,25 @@ func (insertCodec *InsertCodec) DeserializeInto(fieldBinlogs []*Blob, rowNum int
 	return collectionID, partitionID, segmentID, nil
 }
 
+// appendValidData appends the validity of rows read by @eventReader to @fieldData,
+// which has @rowsBefore rows before reading.
+func appendValidData(eventReader *EventReader, fieldData FieldData, rowsBefore int, nullable bool) error {
+	validData := fieldData.GetValidData()
+	if !nullable && len(validData) == 0 {
+		return nil
+	}
+
+	var newValidData []bool
+	if nullable {
+		var err error
+		newValidData, err = eventReader.GetValidDataFromPayload()
+		if err != nil {
+			return err
+		}
+	}
+	return setValidData(fieldData, MergeValidData(validData, rowsBefore, newValidData, fieldData.RowNum()-rowsBefore))
+}
+
 // Deserialize transfer blob back to insert data.
 // From schema, it get all fields.
 // For each field, it will create a binlog reader, and read all event to the buffer.
@@ -832,24 +981,26 @@ func NewDeleteCodec() *DeleteCodec {
 	return &DeleteCodec{}
 }
 
-// Serialize transfer delete data to blob. .
-// For each delete message, it will save "pk,ts" string to binlog.
+// Serialize transfer delete data to blob.
+// The deletes are written as a v2 deltalog, whose payload stores primary keys and timestamps in two columns.
 func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
-	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
-	eventWriter, err := binlogWriter.NextDeleteEventWriter()
+	length := len(data.Pks)
+	if length != len(data.Tss) {
+		return nil, fmt.Errorf("the length of pks, and TimeStamps is not equal")
+	}
+	pkType := schemapb.DataType_Int64
+	if length > 0 {
+		pkType = data.Pks[0].Type()
+	}
+
+	binlogWriter := NewDeleteBinlogWriter(pkType, collectionID, partitionID, segmentID)
+	eventWriter, err := binlogWriter.NextDeltaEventWriter()
 	if err != nil {
 		binlogWriter.Close()
 		return nil, err
 	}
 	defer binlogWriter.Close()
 	defer eventWriter.Close()
-	if err != nil {
-		return nil, err
-	}
-	length := len(data.Pks)
-	if length != len(data.Tss) {
-		return nil, fmt.Errorf("the length of pks, and TimeStamps is not equal")
-	}
 
 	sizeTotal := 0
 	var startTs, endTs Timestamp
@@ -863,16 +1014,16 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 			endTs = ts
 		}
 
-		deleteLog := NewDeleteLog(data.Pks[i], ts)
-		serializedPayload, err := json.Marshal(deleteLog)
-		if err != nil {
+		if err := eventWriter.AddDeleteToPayload(data.Pks[i], ts); err != nil {
 			return nil, err
 		}
-		err = eventWriter.AddOneStringToPayload(string(serializedPayload))
-		if err != nil {
-			return nil, err
+		switch pk := data.Pks[i].(type) {
+		case *Int64PrimaryKey:
+			sizeTotal += binary.Size(pk.Value)
+		case *VarCharPrimaryKey:
+			sizeTotal += len(pk.Value)
 		}
-		sizeTotal += binary.Size(serializedPayload)
+		sizeTotal += binary.Size(ts)
 	}
 	eventWriter.SetEventTimestamp(startTs, endTs)
 	binlogWriter.SetEventTimeStamp(startTs, endTs)
@@ -897,7 +1048,8 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 	return blob, nil
 }
 
-// Deserialize deserializes the deltalog blobs into DeleteData
+// Deserialize deserializes the deltalog blobs into DeleteData.
+// Both v2 deltalogs and the deltalogs of JSON encoded DeleteLog strings are supported.
 func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID, segmentID UniqueID, data *DeleteData, err error) {
 	if len(blobs) == 0 {
 		return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("blobs is empty")
@@ -918,54 +1070,64 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 			return InvalidUniqueID, InvalidUniqueID, nil, err
 		}
 
-		stringArray, err := eventReader.GetStringFromPayload()
-		if err != nil {
-			eventReader.Close()
-			binlogReader.Close()
-			return InvalidUniqueID, InvalidUniqueID, nil, err
-		}
-		for i := 0; i < len(stringArray); i++ {
-			deleteLog := &DeleteLog{}
-			if err = json.Unmarshal([]byte(stringArray[i]), deleteLog); err != nil {
-				// compatible with versions that only support int64 type primary keys
-				// compatible with fmt.Sprintf("%d,%d", pk, ts)
-				// compatible error info (unmarshal err invalid character ',' after top-level value)
-				splits := strings.Split(stringArray[i], ",")
-				if len(splits) != 2 {
-					eventReader.Close()
-					binlogReader.Close()
-					return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("the format of delta log is incorrect, %v can not be split", stringArray[i])
-				}
-				pk, err := strconv.ParseInt(splits[0], 10, 64)
-				if err != nil {
-					eventReader.Close()
-					binlogReader.Close()
-					return InvalidUniqueID, InvalidUniqueID, nil, err
-				}
-				deleteLog.Pk = &Int64PrimaryKey{
-					Value: pk,
-				}
-				deleteLog.PkType = int64(schemapb.DataType_Int64)
-				deleteLog.Ts, err = strconv.ParseUint(splits[1], 10, 64)
-				if err != nil {
-					eventReader.Close()
-					binlogReader.Close()
-					return InvalidUniqueID, InvalidUniqueID, nil, err
-				}
-			}
-
-			result.Pks = append(result.Pks, deleteLog.Pk)
-			result.Tss = append(result.Tss, deleteLog.Ts)
+		var pks []PrimaryKey
+		var tss []Timestamp
+		if binlogReader.Extras[deltaLogVersionKey] == deltaLogVersion2 {
+			pks, tss, err = readDeltaPayload(eventReader.PayloadReaderInterface, binlogReader.PayloadDataType)
+		} else {
+			pks, tss, err = deserializeDeleteLogs(eventReader)
 		}
 		eventReader.Close()
 		binlogReader.Close()
-
+		if err != nil {
+			return InvalidUniqueID, InvalidUniqueID, nil, err
+		}
+		result.Pks = append(result.Pks, pks...)
+		result.Tss = append(result.Tss, tss...)
 	}
 	result.RowCount = int64(len(result.Pks))
 
 	return pid, sid, result, nil
 }
 
+// deserializeDeleteLogs reads the deletes of deltalogs written before v2, each delete is a JSON encoded DeleteLog string.
+func deserializeDeleteLogs(eventReader *EventReader) ([]PrimaryKey, []Timestamp, error) {
+	stringArray, err := eventReader.GetStringFromPayload()
+	if err != nil {
+		return nil, nil, err
+	}
+	pks := make([]PrimaryKey, 0, len(stringArray))
+	tss := make([]Timestamp, 0, len(stringArray))
+	for i := 0; i < len(stringArray); i++ {
+		deleteLog := &DeleteLog{}
+		if err = json.Unmarshal([]byte(stringArray[i]), deleteLog); err != nil {
+			// compatible with versions that only support int64 type primary keys
+			// compatible with fmt.Sprintf("%d,%d", pk, ts)
+			// compatible error info (unmarshal err invalid character ',' after top-level value)
+			splits := strings.Split(stringArray[i], ",")
+			if len(splits) != 2 {
+				return nil, nil, fmt.Errorf("the format of delta log is incorrect, %v can not be split", stringArray[i])
+			}
+			pk, err := strconv.ParseInt(splits[0], 10, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			deleteLog.Pk = &Int64PrimaryKey{
+				Value: pk,
+			}
+			deleteLog.PkType = int64(schemapb.DataType_Int64)
+			deleteLog.Ts, err = strconv.ParseUint(splits[1], 10, 64)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		pks = append(pks, deleteLog.Pk)
+		tss = append(tss, deleteLog.Ts)
+	}
+	return pks, tss, nil
+}
+
 // DataDefinitionCodec serializes and deserializes the data definition
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
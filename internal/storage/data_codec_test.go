@@ -18,10 +18,13 @@ package storage
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/stretchr/testify/assert"
@@ -257,16 +260,16 @@ func TestInsertCodec(t *testing.T) {
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}, []int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}, []int64{}},
-			BoolField:         &BoolFieldData{[]int64{}, []bool{}},
-			Int8Field:         &Int8FieldData{[]int64{}, []int8{}},
-			Int16Field:        &Int16FieldData{[]int64{}, []int16{}},
-			Int32Field:        &Int32FieldData{[]int64{}, []int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}, []int64{}},
-			FloatField:        &FloatFieldData{[]int64{}, []float32{}},
-			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}},
-			StringField:       &StringFieldData{[]int64{}, []string{}},
+			RowIDField:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			TimestampField:    &Int64FieldData{[]int64{}, []int64{}, nil},
+			BoolField:         &BoolFieldData{[]int64{}, []bool{}, nil},
+			Int8Field:         &Int8FieldData{[]int64{}, []int8{}, nil},
+			Int16Field:        &Int16FieldData{[]int64{}, []int16{}, nil},
+			Int32Field:        &Int32FieldData{[]int64{}, []int32{}, nil},
+			Int64Field:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			FloatField:        &FloatFieldData{[]int64{}, []float32{}, nil},
+			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}, nil},
+			StringField:       &StringFieldData{[]int64{}, []string{}, nil},
 			BinaryVectorField: &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]int64{}, []float32{}, 4},
 		},
@@ -334,6 +337,61 @@ func TestInsertCodec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestInsertCodecNullable(t *testing.T) {
+	nullableParams := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	schema := &etcdpb.CollectionMeta{
+		ID: CollectionID,
+		Schema: &schemapb.CollectionSchema{
+			Name: "schema",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+				{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: DoubleField, Name: "field_double", DataType: schemapb.DataType_Double, TypeParams: nullableParams},
+				{FieldID: StringField, Name: "field_string", DataType: schemapb.DataType_VarChar, TypeParams: nullableParams},
+			},
+		},
+	}
+	insertCodec := NewInsertCodec(schema)
+	insertData1 := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{Data: []int64{1, 2}},
+			TimestampField: &Int64FieldData{Data: []int64{1, 2}},
+			Int64Field:     &Int64FieldData{Data: []int64{1, 2}},
+			DoubleField:    &DoubleFieldData{Data: []float64{0, 2}, ValidData: []bool{false, true}},
+			StringField:    &StringFieldData{Data: []string{"1", "2"}},
+		},
+	}
+	insertData2 := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{Data: []int64{3, 4}},
+			TimestampField: &Int64FieldData{Data: []int64{3, 4}},
+			Int64Field:     &Int64FieldData{Data: []int64{3, 4}},
+			DoubleField:    &DoubleFieldData{Data: []float64{3, 4}},
+			StringField:    &StringFieldData{Data: []string{"3", ""}, ValidData: []bool{true, false}},
+		},
+	}
+
+	blobs1, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData1)
+	assert.Nil(t, err)
+	for _, blob := range blobs1 {
+		blob.Key = fmt.Sprintf("1/insert_log/2/3/4/5/%d", 99)
+	}
+	blobs2, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData2)
+	assert.Nil(t, err)
+	for _, blob := range blobs2 {
+		blob.Key = fmt.Sprintf("1/insert_log/2/3/4/5/%d", 100)
+	}
+
+	_, _, resultData, err := insertCodec.Deserialize(append(blobs1, blobs2...))
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{0, 2, 3, 4}, resultData.Data[DoubleField].(*DoubleFieldData).Data)
+	assert.Equal(t, []bool{false, true, true, true}, resultData.Data[DoubleField].GetValidData())
+	assert.Equal(t, []string{"1", "2", "3", ""}, resultData.Data[StringField].(*StringFieldData).Data)
+	assert.Equal(t, []bool{true, true, true, false}, resultData.Data[StringField].GetValidData())
+	assert.Empty(t, resultData.Data[Int64Field].GetValidData())
+}
+
 func TestDeleteCodec(t *testing.T) {
 	t.Run("int64 pk", func(t *testing.T) {
 		deleteCodec := NewDeleteCodec()
@@ -382,6 +440,51 @@ func TestDeleteCodec(t *testing.T) {
 	})
 }
 
+func TestDeleteCodecV2(t *testing.T) {
+	deleteCodec := NewDeleteCodec()
+	deleteData := &DeleteData{}
+	for i := 0; i < 100; i++ {
+		deleteData.Append(NewVarCharPrimaryKey(fmt.Sprintf("pk_%d", i)), Timestamp(1000+i))
+	}
+	blob, err := deleteCodec.Serialize(CollectionID, 1, 1, deleteData)
+	assert.Nil(t, err)
+
+	binlogReader, err := NewBinlogReader(blob.Value)
+	assert.Nil(t, err)
+	assert.Equal(t, deltaLogVersion2, binlogReader.Extras[deltaLogVersionKey])
+	binlogReader.Close()
+
+	_, _, data, err := deleteCodec.Deserialize([]*Blob{blob})
+	assert.Nil(t, err)
+	assert.Equal(t, deleteData, data)
+}
+
+func TestDeserializeJSONDeleteLog(t *testing.T) {
+	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, CollectionID, 1, 1)
+	eventWriter, err := binlogWriter.NextDeleteEventWriter()
+	assert.Nil(t, err)
+
+	pks := []PrimaryKey{NewVarCharPrimaryKey("a"), NewVarCharPrimaryKey("b")}
+	tss := []Timestamp{100, 200}
+	for i := range pks {
+		serialized, err := json.Marshal(NewDeleteLog(pks[i], tss[i]))
+		assert.Nil(t, err)
+		assert.Nil(t, eventWriter.AddOneStringToPayload(string(serialized)))
+	}
+	eventWriter.SetEventTimestamp(100, 200)
+	binlogWriter.SetEventTimeStamp(100, 200)
+	binlogWriter.AddExtra(originalSizeKey, "10")
+
+	assert.Nil(t, binlogWriter.Finish())
+	buffer, err := binlogWriter.GetBuffer()
+	assert.Nil(t, err)
+
+	_, _, deleteData, err := NewDeleteCodec().Deserialize([]*Blob{{Value: buffer}})
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, pks, deleteData.Pks)
+	assert.ElementsMatch(t, tss, deleteData.Tss)
+}
+
 func TestUpgradeDeleteLog(t *testing.T) {
 	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, CollectionID, 1, 1)
 	eventWriter, err := binlogWriter.NextDeleteEventWriter()
@@ -98,6 +98,9 @@ func (ds *DataSorter) Swap(i, j int) {
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
 		}
+		if validData := singleData.GetValidData(); len(validData) > 0 {
+			validData[i], validData[j] = validData[j], validData[i]
+		}
 	}
 }
 
@@ -0,0 +1,265 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/schema"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+)
+
+const (
+	deltaPkColumn = iota
+	deltaTsColumn
+)
+
+var errDeltaPayloadUnsupported = errors.New("delta payload only accepts <pk, ts> pairs")
+
+// DeltaPayloadWriter writes the payload of a v2 deltalog, a parquet file with a primary key
+// column and a timestamp column, instead of JSON encoded DeleteLog strings.
+type DeltaPayloadWriter struct {
+	pkType     schemapb.DataType
+	int64Pks   []int64
+	varcharPks []parquet.ByteArray
+	tss        []int64
+	buffer     *bytes.Buffer
+}
+
+var _ PayloadWriterInterface = (*DeltaPayloadWriter)(nil)
+
+// NewDeltaPayloadWriter creates a DeltaPayloadWriter, @pkType must be int64 or varchar.
+func NewDeltaPayloadWriter(pkType schemapb.DataType) (*DeltaPayloadWriter, error) {
+	if pkType != schemapb.DataType_Int64 && pkType != schemapb.DataType_VarChar {
+		return nil, fmt.Errorf("unsupported primary key type %s of delta payload", pkType.String())
+	}
+	return &DeltaPayloadWriter{pkType: pkType}, nil
+}
+
+// AddDeleteToPayload appends a deleted primary key and its timestamp.
+func (w *DeltaPayloadWriter) AddDeleteToPayload(pk PrimaryKey, ts Timestamp) error {
+	if w.buffer != nil {
+		return errors.New("can't add delete to a finished delta payload")
+	}
+	if pk.Type() != w.pkType {
+		return fmt.Errorf("primary key type %s mismatches with delta payload type %s", pk.Type().String(), w.pkType.String())
+	}
+	switch pk := pk.(type) {
+	case *Int64PrimaryKey:
+		w.int64Pks = append(w.int64Pks, pk.Value)
+	case *VarCharPrimaryKey:
+		w.varcharPks = append(w.varcharPks, parquet.ByteArray(pk.Value))
+	}
+	w.tss = append(w.tss, int64(ts))
+	return nil
+}
+
+func (w *DeltaPayloadWriter) schema() (*schema.GroupNode, error) {
+	var pkNode schema.Node
+	if w.pkType == schemapb.DataType_Int64 {
+		pkNode = schema.NewInt64Node("pk", parquet.Repetitions.Required, -1)
+	} else {
+		node, err := schema.NewPrimitiveNodeLogical("pk", parquet.Repetitions.Required,
+			schema.StringLogicalType{}, parquet.Types.ByteArray, -1, -1)
+		if err != nil {
+			return nil, err
+		}
+		pkNode = node
+	}
+	tsNode, err := schema.NewPrimitiveNodeLogical("ts", parquet.Repetitions.Required,
+		schema.NewIntLogicalType(64, false), parquet.Types.Int64, -1, -1)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewGroupNode("delta", parquet.Repetitions.Required, schema.FieldList{pkNode, tsNode}, -1)
+}
+
+// FinishPayloadWriter writes the pk and ts columns to the parquet buffer.
+func (w *DeltaPayloadWriter) FinishPayloadWriter() error {
+	if w.buffer != nil {
+		return nil
+	}
+	sc, err := w.schema()
+	if err != nil {
+		return err
+	}
+	buffer := new(bytes.Buffer)
+	writer := file.NewParquetWriter(buffer, sc)
+	rgWriter := writer.AppendRowGroup()
+
+	pkWriter, err := rgWriter.NextColumn()
+	if err != nil {
+		return err
+	}
+	switch cw := pkWriter.(type) {
+	case *file.Int64ColumnChunkWriter:
+		_, err = cw.WriteBatch(w.int64Pks, nil, nil)
+	case *file.ByteArrayColumnChunkWriter:
+		_, err = cw.WriteBatch(w.varcharPks, nil, nil)
+	default:
+		err = fmt.Errorf("unexpected column writer %T of primary keys", pkWriter)
+	}
+	if err != nil {
+		return err
+	}
+
+	tsWriter, err := rgWriter.NextColumn()
+	if err != nil {
+		return err
+	}
+	if _, err = tsWriter.(*file.Int64ColumnChunkWriter).WriteBatch(w.tss, nil, nil); err != nil {
+		return err
+	}
+	if err = rgWriter.Close(); err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	w.buffer = buffer
+	return nil
+}
+
+// GetPayloadBufferFromWriter returns the parquet buffer, FinishPayloadWriter must be called first.
+func (w *DeltaPayloadWriter) GetPayloadBufferFromWriter() ([]byte, error) {
+	if w.buffer == nil {
+		return nil, errors.New("delta payload is not finished")
+	}
+	return w.buffer.Bytes(), nil
+}
+
+// GetPayloadLengthFromWriter returns the number of deletes.
+func (w *DeltaPayloadWriter) GetPayloadLengthFromWriter() (int, error) {
+	return len(w.tss), nil
+}
+
+func (w *DeltaPayloadWriter) ReleasePayloadWriter() {
+	w.int64Pks, w.varcharPks, w.tss = nil, nil, nil
+}
+
+func (w *DeltaPayloadWriter) Close() {
+	w.ReleasePayloadWriter()
+}
+
+func (w *DeltaPayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddNullableDataToPayload(msgs interface{}, validData []bool) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddBoolToPayload(msgs []bool) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddByteToPayload(msgs []byte) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddInt8ToPayload(msgs []int8) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddInt16ToPayload(msgs []int16) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddInt32ToPayload(msgs []int32) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddInt64ToPayload(msgs []int64) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddFloatToPayload(msgs []float32) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddDoubleToPayload(msgs []float64) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddOneStringToPayload(msgs string) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
+	return errDeltaPayloadUnsupported
+}
+
+func (w *DeltaPayloadWriter) AddFloatVectorToPayload(binVec []float32, dim int) error {
+	return errDeltaPayloadUnsupported
+}
+
+// readDeltaPayload reads the pk and ts columns of a v2 deltalog payload.
+func readDeltaPayload(reader PayloadReaderInterface, pkType schemapb.DataType) ([]PrimaryKey, []Timestamp, error) {
+	r, ok := reader.(*PayloadReader)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected payload reader %T of delta payload", reader)
+	}
+
+	pks := make([]PrimaryKey, r.numRows)
+	switch pkType {
+	case schemapb.DataType_Int64:
+		values := make([]int64, r.numRows)
+		valuesRead, err := ReadDataFromAllRowGroups[int64, *file.Int64ColumnChunkReader](r.reader, values, deltaPkColumn, r.numRows)
+		if err != nil {
+			return nil, nil, err
+		}
+		if valuesRead != r.numRows {
+			return nil, nil, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+		}
+		for i, v := range values {
+			pks[i] = NewInt64PrimaryKey(v)
+		}
+	case schemapb.DataType_VarChar:
+		values := make([]parquet.ByteArray, r.numRows)
+		valuesRead, err := ReadDataFromAllRowGroups[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, values, deltaPkColumn, r.numRows)
+		if err != nil {
+			return nil, nil, err
+		}
+		if valuesRead != r.numRows {
+			return nil, nil, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+		}
+		for i, v := range values {
+			pks[i] = NewVarCharPrimaryKey(v.String())
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported primary key type %s of delta payload", pkType.String())
+	}
+
+	values := make([]int64, r.numRows)
+	valuesRead, err := ReadDataFromAllRowGroups[int64, *file.Int64ColumnChunkReader](r.reader, values, deltaTsColumn, r.numRows)
+	if err != nil {
+		return nil, nil, err
+	}
+	if valuesRead != r.numRows {
+		return nil, nil, fmt.Errorf("expect %d rows, but got valuesRead = %d", r.numRows, valuesRead)
+	}
+	tss := make([]Timestamp, r.numRows)
+	for i, v := range values {
+		tss[i] = Timestamp(v)
+	}
+	return pks, tss, nil
+}
@@ -0,0 +1,845 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+// Layout of an encrypted file, the content is split into chunks and every chunk is sealed separately,
+// so a range of the file could be decrypted without reading the whole file:
+//
+//	| magic(4) | version(1) | collectionID(8) | dataKeyID(8) | chunkSize(4) | nonce(12) | chunk 0 | chunk 1 | ... |
+//
+// Every chunk is | ciphertext(chunkSize, the last one may be shorter) | tag(16) |, the nonce of chunk i is
+// the file nonce xor i. The header, the chunk index and whether it is the last chunk are authenticated
+// as additional data, so chunks could not be reordered, truncated or moved to another file.
+// Segcore encrypts the disk index files it uploads in the same layout, see storage/Encryption.cpp.
+const (
+	encryptedFileMagic   = "MVSE"
+	encryptedFileVersion = byte(2)
+	encryptionNonceSize  = 12
+	encryptionTagSize    = 16
+	encryptedHeaderSize  = len(encryptedFileMagic) + 1 + 8 + 8 + 4 + encryptionNonceSize
+	encryptionChunkSize  = 64 << 10
+	dataKeySize          = 32
+)
+
+// ErrEncryptedMmap is returned by EncryptedChunkManager.Mmap, encrypted files could not be mapped directly.
+var ErrEncryptedMmap = errors.New("mmap is not supported on encrypted storage")
+
+// ErrNotEncrypted is returned in strict mode when a file without the encryption header is read.
+var ErrNotEncrypted = errors.New("file is not encrypted")
+
+type collectionIDCtxKey struct{}
+
+// WithCollectionID attaches @collectionID to @ctx, EncryptedChunkManager uses it to choose the data key
+// for files whose path does not contain the collection id, such as index files.
+func WithCollectionID(ctx context.Context, collectionID int64) context.Context {
+	return context.WithValue(ctx, collectionIDCtxKey{}, collectionID)
+}
+
+// wrappedDataKey is the persisted form of a data key, stored at
+// {rootPath}/encryption_keys/{collectionID}/{dataKeyID}.
+type wrappedDataKey struct {
+	MasterKeyID string `json:"master_key_id"`
+	WrappedKey  []byte `json:"wrapped_key"`
+}
+
+type dataKeyRef struct {
+	collectionID int64
+	keyID        int64
+}
+
+type dataKey struct {
+	ref  dataKeyRef
+	key  []byte
+	aead cipher.AEAD
+}
+
+// GetEncryptedChunkManager returns the EncryptedChunkManager of @cm, false is returned if encryption is disabled.
+// The EncryptedChunkManager is always the outermost one, the local cache is put under it.
+func GetEncryptedChunkManager(cm ChunkManager) (*EncryptedChunkManager, bool) {
+	ecm, ok := cm.(*EncryptedChunkManager)
+	return ecm, ok
+}
+
+// EncryptedChunkManager is a ChunkManager decorator which encrypts file content with AES-256-GCM.
+// Every collection owns a data key, which is wrapped by a master key of the KMS and persisted
+// next to the data. Rotating the master key only re-wraps data keys, the data is left untouched.
+// Files without the encryption header are returned as is, so existing data stays readable after
+// encryption is enabled, unless the strict mode is on.
+type EncryptedChunkManager struct {
+	ChunkManager
+	kms    KMS
+	strict bool
+
+	mu         sync.Mutex
+	activeKeys map[int64]*dataKey
+	keys       map[dataKeyRef]*dataKey
+}
+
+var _ ChunkManager = (*EncryptedChunkManager)(nil)
+
+// NewEncryptedChunkManager wraps @cm, data keys are wrapped by @kms.
+// Files without the encryption header are rejected if @strict is true.
+func NewEncryptedChunkManager(cm ChunkManager, kms KMS, strict bool) *EncryptedChunkManager {
+	return &EncryptedChunkManager{
+		ChunkManager: cm,
+		kms:          kms,
+		strict:       strict,
+		activeKeys:   make(map[int64]*dataKey),
+		keys:         make(map[dataKeyRef]*dataKey),
+	}
+}
+
+func (ecm *EncryptedChunkManager) keyRootPath() string {
+	return path.Join(ecm.RootPath(), common.EncryptionKeyPath)
+}
+
+func (ecm *EncryptedChunkManager) keyPath(ref dataKeyRef) string {
+	return path.Join(ecm.keyRootPath(), strconv.FormatInt(ref.collectionID, 10), strconv.FormatInt(ref.keyID, 10))
+}
+
+// collectionIDOf returns the collection id of @filePath, binlog paths are parsed and
+// other paths fall back to the collection id in @ctx. Files of unknown collection share
+// the data key of collection 0.
+func (ecm *EncryptedChunkManager) collectionIDOf(ctx context.Context, filePath string) int64 {
+	rel := strings.TrimPrefix(strings.TrimPrefix(filePath, ecm.RootPath()), "/")
+	parts := strings.Split(rel, "/")
+	if len(parts) > 1 {
+		switch parts[0] {
+		case common.SegmentInsertLogPath, common.SegmentStatslogPath, common.SegmentDeltaLogPath:
+			if id, err := strconv.ParseInt(parts[1], 10, 64); err == nil {
+				return id
+			}
+		}
+	}
+	if id, ok := ctx.Value(collectionIDCtxKey{}).(int64); ok {
+		return id
+	}
+	return 0
+}
+
+func (ecm *EncryptedChunkManager) loadDataKey(ctx context.Context, ref dataKeyRef) (*dataKey, error) {
+	content, err := ecm.ChunkManager.Read(ctx, ecm.keyPath(ref))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data key %d of collection %d: %w", ref.keyID, ref.collectionID, err)
+	}
+	var wrapped wrappedDataKey
+	if err := json.Unmarshal(content, &wrapped); err != nil {
+		return nil, fmt.Errorf("failed to parse data key %d of collection %d: %w", ref.keyID, ref.collectionID, err)
+	}
+	key, err := ecm.kms.UnwrapKey(ctx, wrapped.MasterKeyID, wrapped.WrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return &dataKey{ref: ref, key: key, aead: aead}, nil
+}
+
+func (ecm *EncryptedChunkManager) createDataKey(ctx context.Context, collectionID int64) (*dataKey, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	// key ids are random so that nodes creating a data key of the same collection concurrently never overwrite each other.
+	id, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return nil, err
+	}
+	ref := dataKeyRef{collectionID: collectionID, keyID: id.Int64() + 1}
+
+	masterKeyID, err := ecm.kms.ActiveKeyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := ecm.kms.WrapKey(ctx, masterKeyID, key)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(&wrappedDataKey{MasterKeyID: masterKeyID, WrappedKey: wrapped})
+	if err != nil {
+		return nil, err
+	}
+	if err := ecm.ChunkManager.Write(ctx, ecm.keyPath(ref), content); err != nil {
+		return nil, fmt.Errorf("failed to save data key of collection %d: %w", collectionID, err)
+	}
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("created data encryption key", zap.Int64("collectionID", collectionID),
+		zap.Int64("dataKeyID", ref.keyID), zap.String("masterKeyID", masterKeyID))
+	return &dataKey{ref: ref, key: key, aead: aead}, nil
+}
+
+// activeDataKey returns the data key used to encrypt new files of @collectionID,
+// an existing data key is reused and a new one is created if there is none.
+func (ecm *EncryptedChunkManager) activeDataKey(ctx context.Context, collectionID int64) (*dataKey, error) {
+	ecm.mu.Lock()
+	defer ecm.mu.Unlock()
+	if key, ok := ecm.activeKeys[collectionID]; ok {
+		return key, nil
+	}
+
+	prefix := path.Join(ecm.keyRootPath(), strconv.FormatInt(collectionID, 10)) + "/"
+	keyPaths, _, err := ecm.ChunkManager.ListWithPrefix(ctx, prefix, false)
+	if err != nil {
+		return nil, err
+	}
+	var key *dataKey
+	for _, keyPath := range keyPaths {
+		keyID, err := strconv.ParseInt(path.Base(keyPath), 10, 64)
+		if err != nil || (key != nil && keyID <= key.ref.keyID) {
+			continue
+		}
+		ref := dataKeyRef{collectionID: collectionID, keyID: keyID}
+		cached, ok := ecm.keys[ref]
+		if !ok {
+			if cached, err = ecm.loadDataKey(ctx, ref); err != nil {
+				return nil, err
+			}
+		}
+		key = cached
+	}
+	if key == nil {
+		if key, err = ecm.createDataKey(ctx, collectionID); err != nil {
+			return nil, err
+		}
+	}
+	ecm.activeKeys[collectionID] = key
+	ecm.keys[key.ref] = key
+	return key, nil
+}
+
+func (ecm *EncryptedChunkManager) getDataKey(ctx context.Context, ref dataKeyRef) (*dataKey, error) {
+	ecm.mu.Lock()
+	defer ecm.mu.Unlock()
+	if key, ok := ecm.keys[ref]; ok {
+		return key, nil
+	}
+	key, err := ecm.loadDataKey(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	ecm.keys[ref] = key
+	return key, nil
+}
+
+// exportedDataKeys is the form of the data keys handed to segcore, see ExportDataKeys.
+type exportedDataKeys struct {
+	CollectionID int64 `json:"collection_id"`
+	ActiveKeyID  int64 `json:"active_key_id"`
+	// Keys maps the data key ids to the hex encoded data keys
+	Keys   map[string]string `json:"keys"`
+	Strict bool              `json:"strict"`
+}
+
+// ExportDataKeys returns the data keys of @collectionID encoded in json, a data key is created if there is none.
+// Segcore reads and writes disk index files directly, it encrypts them with the active data key and decrypts
+// them with the data key named in their header.
+func (ecm *EncryptedChunkManager) ExportDataKeys(ctx context.Context, collectionID int64) (string, error) {
+	active, err := ecm.activeDataKey(ctx, collectionID)
+	if err != nil {
+		return "", err
+	}
+	prefix := path.Join(ecm.keyRootPath(), strconv.FormatInt(collectionID, 10)) + "/"
+	keyPaths, _, err := ecm.ChunkManager.ListWithPrefix(ctx, prefix, false)
+	if err != nil {
+		return "", err
+	}
+
+	exported := &exportedDataKeys{
+		CollectionID: collectionID,
+		ActiveKeyID:  active.ref.keyID,
+		Keys:         map[string]string{strconv.FormatInt(active.ref.keyID, 10): hex.EncodeToString(active.key)},
+		Strict:       ecm.strict,
+	}
+	for _, keyPath := range keyPaths {
+		keyID, err := strconv.ParseInt(path.Base(keyPath), 10, 64)
+		if err != nil {
+			continue
+		}
+		key, err := ecm.getDataKey(ctx, dataKeyRef{collectionID: collectionID, keyID: keyID})
+		if err != nil {
+			return "", err
+		}
+		exported.Keys[strconv.FormatInt(keyID, 10)] = hex.EncodeToString(key.key)
+	}
+	content, err := json.Marshal(exported)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// encryptedHeader is the parsed header of an encrypted file.
+type encryptedHeader struct {
+	version   byte
+	ref       dataKeyRef
+	chunkSize int64
+	nonce     []byte
+	// raw is the whole header, which is authenticated as additional data
+	raw []byte
+}
+
+func (h *encryptedHeader) size() int64 {
+	return int64(len(h.raw))
+}
+
+// parseEncryptedHeader parses the header at the beginning of @content,
+// false is returned if @content does not start with an encryption header.
+func parseEncryptedHeader(content []byte) (*encryptedHeader, bool, error) {
+	if len(content) <= len(encryptedFileMagic) || !bytes.HasPrefix(content, []byte(encryptedFileMagic)) {
+		return nil, false, nil
+	}
+	offset := len(encryptedFileMagic)
+	h := &encryptedHeader{version: content[offset]}
+	if h.version != encryptedFileVersion {
+		return nil, false, fmt.Errorf("unsupported encrypted file version %d", h.version)
+	}
+	if len(content) < encryptedHeaderSize {
+		return nil, false, nil
+	}
+	offset++
+	h.ref = dataKeyRef{
+		collectionID: int64(binary.LittleEndian.Uint64(content[offset:])),
+		keyID:        int64(binary.LittleEndian.Uint64(content[offset+8:])),
+	}
+	offset += 16
+	h.chunkSize = int64(binary.LittleEndian.Uint32(content[offset:]))
+	if h.chunkSize == 0 {
+		return nil, false, errors.New("invalid chunk size 0 of encrypted file")
+	}
+	offset += 4
+	h.nonce = content[offset:encryptedHeaderSize]
+	h.raw = content[:encryptedHeaderSize]
+	return h, true, nil
+}
+
+func isEncrypted(content []byte) bool {
+	_, ok, err := parseEncryptedHeader(content)
+	return ok && err == nil
+}
+
+// plainSize returns the size of the decrypted content of an encrypted file of @size bytes.
+func (h *encryptedHeader) plainSize(size int64) (int64, error) {
+	body := size - h.size()
+	sealedChunkSize := h.chunkSize + encryptionTagSize
+	chunks := (body + sealedChunkSize - 1) / sealedChunkSize
+	if chunks == 0 || body-chunks*encryptionTagSize < (chunks-1)*h.chunkSize {
+		return 0, errors.New("encrypted file is truncated")
+	}
+	return body - chunks*encryptionTagSize, nil
+}
+
+func chunkCount(plainSize int64, chunkSize int64) int64 {
+	if plainSize == 0 {
+		return 1
+	}
+	return (plainSize + chunkSize - 1) / chunkSize
+}
+
+func (h *encryptedHeader) chunkNonce(index int64) []byte {
+	nonce := make([]byte, len(h.nonce))
+	copy(nonce, h.nonce)
+	tail := nonce[len(nonce)-8:]
+	binary.BigEndian.PutUint64(tail, binary.BigEndian.Uint64(tail)^uint64(index))
+	return nonce
+}
+
+func (h *encryptedHeader) chunkAAD(index int64, last bool) []byte {
+	aad := make([]byte, len(h.raw)+9)
+	offset := copy(aad, h.raw)
+	binary.LittleEndian.PutUint64(aad[offset:], uint64(index))
+	if last {
+		aad[offset+8] = 1
+	}
+	return aad
+}
+
+// openChunks decrypts the consecutive sealed chunks in @sealed, the first of which is chunk @first.
+func (h *encryptedHeader) openChunks(aead cipher.AEAD, first int64, totalChunks int64, sealed []byte) ([]byte, error) {
+	sealedChunkSize := int(h.chunkSize) + encryptionTagSize
+	plain := make([]byte, 0, len(sealed))
+	for index := first; len(sealed) > 0; index++ {
+		n := sealedChunkSize
+		if n > len(sealed) {
+			n = len(sealed)
+		}
+		var err error
+		plain, err = aead.Open(plain, h.chunkNonce(index), sealed[:n], h.chunkAAD(index, index == totalChunks-1))
+		if err != nil {
+			return nil, err
+		}
+		sealed = sealed[n:]
+	}
+	return plain, nil
+}
+
+func (ecm *EncryptedChunkManager) encrypt(ctx context.Context, filePath string, content []byte) ([]byte, error) {
+	key, err := ecm.activeDataKey(ctx, ecm.collectionIDOf(ctx, filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, encryptedHeaderSize)
+	offset := copy(raw, encryptedFileMagic)
+	raw[offset] = encryptedFileVersion
+	offset++
+	binary.LittleEndian.PutUint64(raw[offset:], uint64(key.ref.collectionID))
+	offset += 8
+	binary.LittleEndian.PutUint64(raw[offset:], uint64(key.ref.keyID))
+	offset += 8
+	binary.LittleEndian.PutUint32(raw[offset:], encryptionChunkSize)
+	offset += 4
+	if _, err := io.ReadFull(rand.Reader, raw[offset:]); err != nil {
+		return nil, err
+	}
+	h := &encryptedHeader{version: encryptedFileVersion, ref: key.ref, chunkSize: encryptionChunkSize, nonce: raw[offset:], raw: raw}
+
+	size := int64(len(content))
+	chunks := chunkCount(size, h.chunkSize)
+	encrypted := make([]byte, 0, h.size()+size+chunks*encryptionTagSize)
+	encrypted = append(encrypted, raw...)
+	for index := int64(0); index < chunks; index++ {
+		start := index * h.chunkSize
+		end := start + h.chunkSize
+		if end > size {
+			end = size
+		}
+		encrypted = key.aead.Seal(encrypted, h.chunkNonce(index), content[start:end], h.chunkAAD(index, index == chunks-1))
+	}
+	return encrypted, nil
+}
+
+// checkPlain is called when @filePath has no encryption header, the file is rejected in strict mode.
+func (ecm *EncryptedChunkManager) checkPlain(filePath string) error {
+	if ecm.strict {
+		return fmt.Errorf("%w: %s", ErrNotEncrypted, filePath)
+	}
+	return nil
+}
+
+// readHeader reads the header of @filePath, false is returned if the file is not encrypted.
+func (ecm *EncryptedChunkManager) readHeader(ctx context.Context, filePath string, size int64) (*encryptedHeader, bool, error) {
+	n := int64(encryptedHeaderSize)
+	if n > size {
+		n = size
+	}
+	if n == 0 {
+		return nil, false, nil
+	}
+	prefix, err := ecm.ChunkManager.ReadAt(ctx, filePath, 0, n)
+	if err != nil {
+		return nil, false, err
+	}
+	h, ok, err := parseEncryptedHeader(prefix)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to parse header of %s: %w", filePath, err)
+	}
+	return h, ok, nil
+}
+
+func (ecm *EncryptedChunkManager) decrypt(ctx context.Context, filePath string, content []byte) ([]byte, error) {
+	h, ok, err := parseEncryptedHeader(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse header of %s: %w", filePath, err)
+	}
+	if !ok {
+		if err := ecm.checkPlain(filePath); err != nil {
+			return nil, err
+		}
+		return content, nil
+	}
+	plainSize, err := h.plainSize(int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w", filePath, err)
+	}
+	key, err := ecm.getDataKey(ctx, h.ref)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := h.openChunks(key.aead, 0, chunkCount(plainSize, h.chunkSize), content[h.size():])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w", filePath, err)
+	}
+	return plain, nil
+}
+
+// Write encrypts @content and writes it to @filePath.
+func (ecm *EncryptedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	encrypted, err := ecm.encrypt(ctx, filePath, content)
+	if err != nil {
+		return err
+	}
+	return ecm.ChunkManager.Write(ctx, filePath, encrypted)
+}
+
+// MultiWrite encrypts @contents and writes them.
+func (ecm *EncryptedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	encrypted := make(map[string][]byte, len(contents))
+	for filePath, content := range contents {
+		value, err := ecm.encrypt(ctx, filePath, content)
+		if err != nil {
+			return err
+		}
+		encrypted[filePath] = value
+	}
+	return ecm.ChunkManager.MultiWrite(ctx, encrypted)
+}
+
+// Read reads @filePath and returns the decrypted content.
+func (ecm *EncryptedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	content, err := ecm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return ecm.decrypt(ctx, filePath, content)
+}
+
+// MultiRead reads @filePaths and returns the decrypted contents.
+func (ecm *EncryptedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	contents, err := ecm.ChunkManager.MultiRead(ctx, filePaths)
+	if err != nil {
+		return nil, err
+	}
+	for i, content := range contents {
+		if contents[i], err = ecm.decrypt(ctx, filePaths[i], content); err != nil {
+			return nil, err
+		}
+	}
+	return contents, nil
+}
+
+// ReadWithPrefix reads files with same @prefix and returns the decrypted contents.
+func (ecm *EncryptedChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, contents, err := ecm.ChunkManager.ReadWithPrefix(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, content := range contents {
+		if contents[i], err = ecm.decrypt(ctx, filePaths[i], content); err != nil {
+			return nil, nil, err
+		}
+	}
+	return filePaths, contents, nil
+}
+
+// Reader returns a reader of the decrypted content of @filePath, chunks are decrypted while reading.
+func (ecm *EncryptedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	reader, err := ecm.ChunkManager.Reader(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	buffered := bufio.NewReaderSize(reader, encryptionChunkSize+encryptionTagSize)
+	// Peek returns what is available if the file is shorter than the header
+	prefix, _ := buffered.Peek(encryptedHeaderSize)
+	h, ok, err := parseEncryptedHeader(prefix)
+	if err != nil {
+		reader.Close()
+		return nil, fmt.Errorf("failed to parse header of %s: %w", filePath, err)
+	}
+	if !ok {
+		if err := ecm.checkPlain(filePath); err != nil {
+			reader.Close()
+			return nil, err
+		}
+		return &decryptingReader{reader: buffered, closer: reader}, nil
+	}
+
+	// the header bytes are owned by the buffer, keep a copy before discarding them
+	raw := make([]byte, h.size())
+	copy(raw, h.raw)
+	h, _, _ = parseEncryptedHeader(raw)
+	if _, err := buffered.Discard(int(h.size())); err != nil {
+		reader.Close()
+		return nil, err
+	}
+	key, err := ecm.getDataKey(ctx, h.ref)
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+	return &decryptingReader{
+		reader:   buffered,
+		closer:   reader,
+		filePath: filePath,
+		header:   h,
+		aead:     key.aead,
+		sealed:   make([]byte, h.chunkSize+encryptionTagSize),
+	}, nil
+}
+
+// decryptingReader decrypts the chunks of an encrypted file one by one,
+// the content is passed through if header is nil.
+type decryptingReader struct {
+	reader   *bufio.Reader
+	closer   io.Closer
+	filePath string
+	header   *encryptedHeader
+	aead     cipher.AEAD
+
+	index  int64
+	sealed []byte
+	plain  []byte
+	err    error
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	if r.header == nil {
+		return r.reader.Read(p)
+	}
+	for len(r.plain) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.err = r.readChunk()
+	}
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+func (r *decryptingReader) readChunk() error {
+	n, err := io.ReadFull(r.reader, r.sealed)
+	var last bool
+	switch err {
+	case nil:
+		_, err = r.reader.Peek(1)
+		last = err == io.EOF
+		if err != nil && err != io.EOF {
+			return err
+		}
+	case io.ErrUnexpectedEOF:
+		last = true
+	case io.EOF:
+		return fmt.Errorf("failed to decrypt %s: %w", r.filePath, io.ErrUnexpectedEOF)
+	default:
+		return err
+	}
+	plain, err := r.aead.Open(r.plain[:0], r.header.chunkNonce(r.index), r.sealed[:n], r.header.chunkAAD(r.index, last))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %w", r.filePath, err)
+	}
+	r.plain = plain
+	r.index++
+	if last {
+		return io.EOF
+	}
+	return nil
+}
+
+func (r *decryptingReader) Close() error {
+	return r.closer.Close()
+}
+
+// ReadAt reads the decrypted content of @filePath by offset @off, only the chunks covering the range are read.
+func (ecm *EncryptedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	size, err := ecm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	h, ok, err := ecm.readHeader(ctx, filePath, size)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := ecm.checkPlain(filePath); err != nil {
+			return nil, err
+		}
+		return ecm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	plainSize, err := h.plainSize(size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w", filePath, err)
+	}
+	if off+length > plainSize {
+		return nil, io.EOF
+	}
+	if length == 0 {
+		return []byte{}, nil
+	}
+	key, err := ecm.getDataKey(ctx, h.ref)
+	if err != nil {
+		return nil, err
+	}
+
+	sealedChunkSize := h.chunkSize + encryptionTagSize
+	first, last := off/h.chunkSize, (off+length-1)/h.chunkSize
+	start := h.size() + first*sealedChunkSize
+	end := h.size() + (last+1)*sealedChunkSize
+	if end > size {
+		end = size
+	}
+	sealed, err := ecm.ChunkManager.ReadAt(ctx, filePath, start, end-start)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := h.openChunks(key.aead, first, chunkCount(plainSize, h.chunkSize), sealed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w", filePath, err)
+	}
+	begin := off - first*h.chunkSize
+	return plain[begin : begin+length], nil
+}
+
+// Size returns the size of the decrypted content of @filePath.
+func (ecm *EncryptedChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	size, err := ecm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return 0, err
+	}
+	h, ok, err := ecm.readHeader(ctx, filePath, size)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		if err := ecm.checkPlain(filePath); err != nil {
+			return 0, err
+		}
+		return size, nil
+	}
+	plainSize, err := h.plainSize(size)
+	if err != nil {
+		return 0, fmt.Errorf("invalid encrypted file %s: %w", filePath, err)
+	}
+	return plainSize, nil
+}
+
+// Mmap is not supported since the file content is encrypted.
+func (ecm *EncryptedChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	return nil, ErrEncryptedMmap
+}
+
+// RotateDataKeys re-wraps all data keys which are not wrapped by the active master key,
+// the data files are not rewritten. It returns the number of re-wrapped data keys.
+// Retired master keys must be kept by the KMS until the rotation is done.
+func (ecm *EncryptedChunkManager) RotateDataKeys(ctx context.Context) (int, error) {
+	masterKeyID, err := ecm.kms.ActiveKeyID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	keyPaths, _, err := ecm.ChunkManager.ListWithPrefix(ctx, ecm.keyRootPath()+"/", true)
+	if err != nil {
+		return 0, err
+	}
+
+	rotated := 0
+	for _, keyPath := range keyPaths {
+		content, err := ecm.ChunkManager.Read(ctx, keyPath)
+		if err != nil {
+			return rotated, err
+		}
+		var wrapped wrappedDataKey
+		if err := json.Unmarshal(content, &wrapped); err != nil {
+			return rotated, fmt.Errorf("failed to parse data key %s: %w", keyPath, err)
+		}
+		if wrapped.MasterKeyID == masterKeyID {
+			continue
+		}
+		key, err := ecm.kms.UnwrapKey(ctx, wrapped.MasterKeyID, wrapped.WrappedKey)
+		if err != nil {
+			return rotated, err
+		}
+		rewrapped, err := ecm.kms.WrapKey(ctx, masterKeyID, key)
+		if err != nil {
+			return rotated, err
+		}
+		content, err = json.Marshal(&wrappedDataKey{MasterKeyID: masterKeyID, WrappedKey: rewrapped})
+		if err != nil {
+			return rotated, err
+		}
+		if err := ecm.ChunkManager.Write(ctx, keyPath, content); err != nil {
+			return rotated, err
+		}
+		log.Info("re-wrapped data encryption key", zap.String("path", keyPath),
+			zap.String("from", wrapped.MasterKeyID), zap.String("to", masterKeyID))
+		rotated++
+	}
+	return rotated, nil
+}
+
+// ListDataKeyCollections returns the ids of the collections owning data keys,
+// together with the last modified time of their data keys.
+func (ecm *EncryptedChunkManager) ListDataKeyCollections(ctx context.Context) (map[int64]time.Time, error) {
+	keyPaths, modTimes, err := ecm.ChunkManager.ListWithPrefix(ctx, ecm.keyRootPath()+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	collections := make(map[int64]time.Time)
+	for i, keyPath := range keyPaths {
+		collectionID, err := strconv.ParseInt(path.Base(path.Dir(keyPath)), 10, 64)
+		if err != nil {
+			continue
+		}
+		if modTimes[i].After(collections[collectionID]) {
+			collections[collectionID] = modTimes[i]
+		}
+	}
+	return collections, nil
+}
+
+// RemoveDataKeys removes the data keys of @collectionID, files of the collection could not be decrypted anymore,
+// so it must be called only after the collection is dropped and its files are garbage collected.
+func (ecm *EncryptedChunkManager) RemoveDataKeys(ctx context.Context, collectionID int64) error {
+	prefix := path.Join(ecm.keyRootPath(), strconv.FormatInt(collectionID, 10)) + "/"
+	if err := ecm.ChunkManager.RemoveWithPrefix(ctx, prefix); err != nil {
+		return err
+	}
+
+	ecm.mu.Lock()
+	defer ecm.mu.Unlock()
+	delete(ecm.activeKeys, collectionID)
+	for ref := range ecm.keys {
+		if ref.collectionID == collectionID {
+			delete(ecm.keys, ref)
+		}
+	}
+	log.Info("removed data encryption keys of dropped collection", zap.Int64("collectionID", collectionID))
+	return nil
+}
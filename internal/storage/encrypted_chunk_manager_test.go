@@ -0,0 +1,290 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/metautil"
+)
+
+func TestEncryptedCM(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	keyFile := filepath.Join(t.TempDir(), "keys.json")
+	writeTestKeyFile(t, keyFile, "k1", "k1")
+
+	localCM := NewLocalChunkManager(RootPath(rootPath))
+	ecm := NewEncryptedChunkManager(localCM, NewLocalKMS(keyFile), false)
+
+	insertLog := metautil.BuildInsertLogPath(rootPath, 1, 2, 3, 100, 1000)
+	deltaLog := metautil.BuildDeltaLogPath(rootPath, 1, 2, 3, 1001)
+	otherInsertLog := metautil.BuildInsertLogPath(rootPath, 10, 20, 30, 100, 1002)
+	indexFile := metautil.BuildSegmentIndexFilePath(rootPath, 1, 1, 2, 3, "IVF")
+	value := []byte("some plain segment data")
+
+	t.Run("write and read", func(t *testing.T) {
+		require.NoError(t, ecm.Write(ctx, insertLog, value))
+
+		raw, err := localCM.Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.True(t, isEncrypted(raw))
+		assert.NotContains(t, string(raw), string(value))
+		assert.Equal(t, encryptedHeaderSize+len(value)+encryptionTagSize, len(raw))
+
+		got, err := ecm.Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+
+		size, err := ecm.Size(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(value)), size)
+
+		got, err = ecm.ReadAt(ctx, insertLog, 5, 5)
+		require.NoError(t, err)
+		assert.Equal(t, value[5:10], got)
+		_, err = ecm.ReadAt(ctx, insertLog, 5, int64(len(value)))
+		assert.ErrorIs(t, err, io.EOF)
+
+		reader, err := ecm.Reader(ctx, insertLog)
+		require.NoError(t, err)
+		got, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+		assert.NoError(t, reader.Close())
+
+		_, err = ecm.Mmap(ctx, insertLog)
+		assert.ErrorIs(t, err, ErrEncryptedMmap)
+	})
+
+	t.Run("multi write and read", func(t *testing.T) {
+		contents := map[string][]byte{
+			deltaLog:       []byte("delta"),
+			otherInsertLog: []byte("another collection"),
+		}
+		require.NoError(t, ecm.MultiWrite(ctx, contents))
+
+		got, err := ecm.MultiRead(ctx, []string{deltaLog, otherInsertLog})
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{contents[deltaLog], contents[otherInsertLog]}, got)
+
+		paths, got, err := ecm.ReadWithPrefix(ctx, path.Join(rootPath, common.SegmentDeltaLogPath))
+		require.NoError(t, err)
+		assert.Equal(t, []string{deltaLog}, paths)
+		assert.Equal(t, [][]byte{contents[deltaLog]}, got)
+
+		// every collection owns a data key
+		keyPaths, _, err := localCM.ListWithPrefix(ctx, ecm.keyRootPath()+"/", true)
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(keyPaths))
+	})
+
+	t.Run("collection id from context", func(t *testing.T) {
+		require.NoError(t, ecm.Write(WithCollectionID(ctx, 1), indexFile, value))
+		raw, err := localCM.Read(ctx, indexFile)
+		require.NoError(t, err)
+		assert.Equal(t, ecm.activeKeys[1].ref.keyID, encryptedDataKeyID(raw))
+	})
+
+	t.Run("plain file", func(t *testing.T) {
+		plainFile := path.Join(rootPath, "plain")
+		require.NoError(t, localCM.Write(ctx, plainFile, value))
+
+		got, err := ecm.Read(ctx, plainFile)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+
+		size, err := ecm.Size(ctx, plainFile)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(value)), size)
+	})
+
+	t.Run("tampered file", func(t *testing.T) {
+		raw, err := localCM.Read(ctx, insertLog)
+		require.NoError(t, err)
+		tampered := path.Join(rootPath, "tampered")
+		raw[len(raw)-1] ^= 0xff
+		require.NoError(t, localCM.Write(ctx, tampered, raw))
+		_, err = ecm.Read(ctx, tampered)
+		assert.Error(t, err)
+	})
+
+	t.Run("chunks", func(t *testing.T) {
+		large := make([]byte, 3*encryptionChunkSize+100)
+		for i := range large {
+			large[i] = byte(i % 251)
+		}
+		largeLog := metautil.BuildInsertLogPath(rootPath, 1, 2, 3, 100, 1003)
+		require.NoError(t, ecm.Write(ctx, largeLog, large))
+
+		raw, err := localCM.Read(ctx, largeLog)
+		require.NoError(t, err)
+		assert.Equal(t, encryptedHeaderSize+len(large)+4*encryptionTagSize, len(raw))
+
+		size, err := ecm.Size(ctx, largeLog)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(large)), size)
+
+		got, err := ecm.Read(ctx, largeLog)
+		require.NoError(t, err)
+		assert.Equal(t, large, got)
+
+		// ranges inside a chunk, across chunks and at the end
+		for _, r := range [][2]int64{{0, 1}, {encryptionChunkSize - 10, 20}, {10, 2*encryptionChunkSize + 10}, {int64(len(large)) - 100, 100}} {
+			got, err = ecm.ReadAt(ctx, largeLog, r[0], r[1])
+			require.NoError(t, err)
+			assert.Equal(t, large[r[0]:r[0]+r[1]], got)
+		}
+		_, err = ecm.ReadAt(ctx, largeLog, int64(len(large))-1, 2)
+		assert.ErrorIs(t, err, io.EOF)
+
+		reader, err := ecm.Reader(ctx, largeLog)
+		require.NoError(t, err)
+		got, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, large, got)
+		assert.NoError(t, reader.Close())
+
+		// dropping the last chunk is detected although the remaining chunks are intact
+		truncated := path.Join(rootPath, "truncated")
+		require.NoError(t, localCM.Write(ctx, truncated, raw[:encryptedHeaderSize+3*(encryptionChunkSize+encryptionTagSize)]))
+		_, err = ecm.Read(ctx, truncated)
+		assert.Error(t, err)
+		reader, err = ecm.Reader(ctx, truncated)
+		require.NoError(t, err)
+		_, err = io.ReadAll(reader)
+		assert.Error(t, err)
+		assert.NoError(t, reader.Close())
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		emptyLog := metautil.BuildInsertLogPath(rootPath, 1, 2, 3, 100, 1004)
+		require.NoError(t, ecm.Write(ctx, emptyLog, []byte{}))
+		got, err := ecm.Read(ctx, emptyLog)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+		size, err := ecm.Size(ctx, emptyLog)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), size)
+	})
+
+	t.Run("export data keys", func(t *testing.T) {
+		active, err := ecm.activeDataKey(ctx, 1)
+		require.NoError(t, err)
+		content, err := ecm.ExportDataKeys(ctx, 1)
+		require.NoError(t, err)
+
+		var exported exportedDataKeys
+		require.NoError(t, json.Unmarshal([]byte(content), &exported))
+		assert.Equal(t, int64(1), exported.CollectionID)
+		assert.Equal(t, active.ref.keyID, exported.ActiveKeyID)
+		assert.False(t, exported.Strict)
+		assert.Equal(t, hex.EncodeToString(active.key), exported.Keys[strconv.FormatInt(active.ref.keyID, 10)])
+
+		got, ok := GetEncryptedChunkManager(ecm)
+		assert.True(t, ok)
+		assert.Same(t, ecm, got)
+		_, ok = GetEncryptedChunkManager(localCM)
+		assert.False(t, ok)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		strict := NewEncryptedChunkManager(localCM, NewLocalKMS(keyFile), true)
+		plainFile := path.Join(rootPath, "plain")
+
+		_, err := strict.Read(ctx, plainFile)
+		assert.ErrorIs(t, err, ErrNotEncrypted)
+		_, err = strict.ReadAt(ctx, plainFile, 0, 1)
+		assert.ErrorIs(t, err, ErrNotEncrypted)
+		_, err = strict.Reader(ctx, plainFile)
+		assert.ErrorIs(t, err, ErrNotEncrypted)
+		_, err = strict.Size(ctx, plainFile)
+		assert.ErrorIs(t, err, ErrNotEncrypted)
+
+		got, err := strict.Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+	})
+
+	t.Run("read by another node", func(t *testing.T) {
+		another := NewEncryptedChunkManager(localCM, NewLocalKMS(keyFile), false)
+		got, err := another.Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+
+		// the existing data key is reused
+		require.NoError(t, another.Write(ctx, insertLog, value))
+		keyPaths, _, err := localCM.ListWithPrefix(ctx, ecm.keyRootPath()+"/", true)
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(keyPaths))
+	})
+
+	t.Run("rotate data keys", func(t *testing.T) {
+		writeTestKeyFile(t, keyFile, "k2", "k1", "k2")
+		rotated, err := ecm.RotateDataKeys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, rotated)
+
+		rotated, err = ecm.RotateDataKeys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, rotated)
+
+		// the retired master key could be removed after rotation, data is still readable
+		writeTestKeyFile(t, keyFile, "k2", "k2")
+		another := NewEncryptedChunkManager(localCM, NewLocalKMS(keyFile), false)
+		got, err := another.Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, value, got)
+	})
+
+	t.Run("remove data keys", func(t *testing.T) {
+		collections, err := ecm.ListDataKeyCollections(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int64{1, 10}, lo.Keys(collections))
+
+		require.NoError(t, ecm.RemoveDataKeys(ctx, 10))
+		collections, err = ecm.ListDataKeyCollections(ctx)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int64{1}, lo.Keys(collections))
+		_, err = ecm.Read(ctx, otherInsertLog)
+		assert.Error(t, err)
+	})
+
+	t.Run("master key lost", func(t *testing.T) {
+		writeTestKeyFile(t, keyFile, "k3", "k3")
+		another := NewEncryptedChunkManager(localCM, NewLocalKMS(keyFile), false)
+		_, err := another.Read(ctx, insertLog)
+		assert.Error(t, err)
+	})
+}
+
+func encryptedDataKeyID(content []byte) int64 {
+	offset := len(encryptedFileMagic) + 1 + 8
+	return int64(common.Endian.Uint64(content[offset:]))
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
@@ -31,6 +32,20 @@ import (
 
 const originalSizeKey = "original_size"
 
+// nullableKey marks the binlog payload contains null rows
+const nullableKey = "nullable"
+
+// deltaLogVersionKey marks the layout of the deltalog payload. Deltalogs without it store
+// JSON encoded DeleteLog strings, v2 deltalogs store a pk column and a ts column.
+const (
+	deltaLogVersionKey = "deltalog_version"
+	deltaLogVersion2   = "2"
+)
+
+// eventChecksumsKey records the CRC32C checksums of the event payloads in order. Binlogs without it
+// are written before the checksums are introduced and are not verified.
+const eventChecksumsKey = "event_checksums"
+
 type descriptorEventData struct {
 	DescriptorEventDataFixPart
 	ExtraLength       int32
@@ -72,6 +87,31 @@ func (data *descriptorEventData) AddExtra(k string, v interface{}) {
 	data.Extras[k] = v
 }
 
+// GetEventChecksums returns the payload checksums of the events, ok is false if the binlog has no checksums.
+func (data *descriptorEventData) GetEventChecksums() (checksums []uint32, ok bool, err error) {
+	value, ok := data.Extras[eventChecksumsKey]
+	if !ok {
+		return nil, false, nil
+	}
+	switch values := value.(type) {
+	case []uint32:
+		return values, true, nil
+	case []interface{}:
+		checksums = make([]uint32, 0, len(values))
+		for _, v := range values {
+			// json numbers are unmarshaled as float64, which is able to hold any uint32
+			f, isNumber := v.(float64)
+			if !isNumber || f < 0 || f > math.MaxUint32 || f != math.Trunc(f) {
+				return nil, true, fmt.Errorf("invalid event checksum: %v", v)
+			}
+			checksums = append(checksums, uint32(f))
+		}
+		return checksums, true, nil
+	default:
+		return nil, true, fmt.Errorf("invalid event checksums: %v", value)
+	}
+}
+
 // FinishExtra marshal extras to json format.
 // Call before GetMemoryUsageInBytes to get an accurate length of description event.
 func (data *descriptorEventData) FinishExtra() error {
@@ -18,11 +18,16 @@ package storage
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"hash/crc32"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 )
 
+// ErrChecksumMismatch is returned if the payload of an event doesn't match the checksum recorded in the binlog.
+var ErrChecksumMismatch = errors.New("event checksum mismatch")
+
 // EventReader is used to parse the events contained in the Binlog file.
 type EventReader struct {
 	eventHeader
@@ -86,6 +91,15 @@ func (reader *EventReader) Close() {
 }
 
 func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventReader, error) {
+	return readEvent(datatype, buffer, nil)
+}
+
+// newEventReaderWithChecksum creates an EventReader after verifying the payload with the CRC32C @checksum.
+func newEventReaderWithChecksum(datatype schemapb.DataType, buffer *bytes.Buffer, checksum uint32) (*EventReader, error) {
+	return readEvent(datatype, buffer, &checksum)
+}
+
+func readEvent(datatype schemapb.DataType, buffer *bytes.Buffer, checksum *uint32) (*EventReader, error) {
 	reader := &EventReader{
 		eventHeader: eventHeader{
 			baseEventHeader{},
@@ -102,7 +116,15 @@ func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventRea
 	}
 
 	next := int(reader.EventLength - reader.eventHeader.GetMemoryUsageInBytes() - reader.GetEventDataFixPartSize())
+	if next < 0 || next > buffer.Len() {
+		return nil, fmt.Errorf("invalid event length %d, %d bytes left", reader.EventLength, buffer.Len())
+	}
 	payloadBuffer := buffer.Next(next)
+	if checksum != nil {
+		if err := verifyPayloadChecksum(payloadBuffer, *checksum); err != nil {
+			return nil, err
+		}
+	}
 	payloadReader, err := NewPayloadReader(datatype, payloadBuffer)
 	if err != nil {
 		return nil, err
@@ -110,3 +132,10 @@ func newEventReader(datatype schemapb.DataType, buffer *bytes.Buffer) (*EventRea
 	reader.PayloadReaderInterface = payloadReader
 	return reader, nil
 }
+
+func verifyPayloadChecksum(payload []byte, checksum uint32) error {
+	if actual := crc32.Checksum(payload, crc32cTable); actual != checksum {
+		return fmt.Errorf("%w, expected: %d, actual: %d", ErrChecksumMismatch, checksum, actual)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
@@ -28,6 +29,9 @@ import (
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// crc32cTable is the table of the checksums of event payloads
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
 // EventTypeCode represents event type by code
 type EventTypeCode int8
 
@@ -101,6 +105,8 @@ type EventWriter interface {
 	Write(buffer *bytes.Buffer) error
 	GetMemoryUsageInBytes() (int32, error)
 	SetOffset(offset int32)
+	// GetPayloadChecksum returns the CRC32C checksum of the payload, should call Finish first
+	GetPayloadChecksum() (uint32, error)
 }
 
 type baseEventWriter struct {
@@ -165,6 +171,20 @@ func (writer *baseEventWriter) Close() {
 
 func (writer *baseEventWriter) SetOffset(offset int32) {
 	writer.offset = offset
+	if writer.isFinish {
+		writer.NextPosition = writer.EventLength + writer.offset
+	}
+}
+
+func (writer *baseEventWriter) GetPayloadChecksum() (uint32, error) {
+	if !writer.isFinish {
+		return 0, errors.New("event writer hasn't finished")
+	}
+	data, err := writer.GetPayloadBufferFromWriter()
+	if err != nil {
+		return 0, err
+	}
+	return crc32.Checksum(data, crc32cTable), nil
 }
 
 type insertEventWriter struct {
@@ -264,6 +284,38 @@ func newDeleteEventWriter(dataType schemapb.DataType) (*deleteEventWriter, error
 	return writer, nil
 }
 
+// newDeltaEventWriter creates a delete event writer of v2 deltalog, whose payload stores <pk, ts> pairs in two columns.
+func newDeltaEventWriter(pkType schemapb.DataType) (*deleteEventWriter, error) {
+	payloadWriter, err := NewDeltaPayloadWriter(pkType)
+	if err != nil {
+		return nil, err
+	}
+	header := newEventHeader(DeleteEventType)
+	data := newDeleteEventData()
+
+	writer := &deleteEventWriter{
+		baseEventWriter: baseEventWriter{
+			eventHeader:            *header,
+			PayloadWriterInterface: payloadWriter,
+			isClosed:               false,
+			isFinish:               false,
+		},
+		deleteEventData: *data,
+	}
+	writer.baseEventWriter.getEventDataSize = writer.deleteEventData.GetEventDataFixPartSize
+	writer.baseEventWriter.writeEventData = writer.deleteEventData.WriteEventData
+	return writer, nil
+}
+
+// AddDeleteToPayload appends a <pk, ts> pair to the payload of a v2 delete event.
+func (writer *deleteEventWriter) AddDeleteToPayload(pk PrimaryKey, ts Timestamp) error {
+	deltaWriter, ok := writer.PayloadWriterInterface.(*DeltaPayloadWriter)
+	if !ok {
+		return errors.New("delete event is not written by delta payload writer")
+	}
+	return deltaWriter.AddDeleteToPayload(pk, ts)
+}
+
 func newCreateCollectionEventWriter(dataType schemapb.DataType) (*createCollectionEventWriter, error) {
 	if dataType != schemapb.DataType_String && dataType != schemapb.DataType_Int64 {
 		return nil, errors.New("incorrect data type")
@@ -13,10 +13,31 @@ type ChunkManagerFactory struct {
 }
 
 func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
+	commonOpts := []Option{
+		EncryptionEnabled(params.CommonCfg.EncryptionEnabled),
+		EncryptionStrict(params.CommonCfg.EncryptionStrict),
+		KMSType(params.CommonCfg.EncryptionKMSType),
+		KMSKeyFile(params.CommonCfg.EncryptionKeyFile),
+		Component(paramtable.GetRole()),
+		RetryAttempts(params.CommonCfg.StorageRetryAttempts),
+		RequestTimeout(params.CommonCfg.StorageRequestTimeout),
+		UploadRateLimit(params.CommonCfg.StorageUploadRateLimit),
+	}
 	if params.CommonCfg.StorageType == "local" {
-		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path.GetValue()))
+		return NewChunkManagerFactory("local", append(commonOpts, RootPath(params.LocalStorageCfg.Path.GetValue()))...)
+	}
+	if params.CommonCfg.StorageType == "azure" {
+		return NewChunkManagerFactory("azure", append(commonOpts,
+			RootPath(params.AzureCfg.RootPath.GetValue()),
+			Address(params.AzureCfg.Endpoint.GetValue()),
+			AccessKeyID(params.AzureCfg.AccountName.GetValue()),
+			SecretAccessKeyID(params.AzureCfg.AccountKey.GetValue()),
+			BucketName(params.AzureCfg.ContainerName.GetValue()),
+			UseIAM(params.AzureCfg.UseManagedIdentity.GetAsBool()),
+			ClientID(params.AzureCfg.ManagedIdentityClientID.GetValue()),
+			CreateBucket(true))...)
 	}
-	return NewChunkManagerFactory("minio",
+	return NewChunkManagerFactory("minio", append(commonOpts,
 		RootPath(params.MinioCfg.RootPath.GetValue()),
 		Address(params.MinioCfg.Address.GetValue()),
 		AccessKeyID(params.MinioCfg.AccessKeyID.GetValue()),
@@ -26,7 +47,7 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		UseIAM(params.MinioCfg.UseIAM.GetAsBool()),
 		CloudProvider(params.MinioCfg.CloudProvider.GetValue()),
 		IAMEndpoint(params.MinioCfg.IAMEndpoint.GetValue()),
-		CreateBucket(true))
+		CreateBucket(true))...)
 }
 
 func NewChunkManagerFactory(persistentStorage string, opts ...Option) *ChunkManagerFactory {
@@ -45,14 +66,43 @@ func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string
 	case "local":
 		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
 	case "minio":
-		return newMinioChunkManagerWithConfig(ctx, f.config)
+		cm, err := newMinioChunkManagerWithConfig(ctx, f.config)
+		if err != nil {
+			return nil, err
+		}
+		return newInstrumentedChunkManagerWithConfig(cm, f.config), nil
+	case "azure":
+		cm, err := newAzureChunkManagerWithConfig(ctx, f.config)
+		if err != nil {
+			return nil, err
+		}
+		return newInstrumentedChunkManagerWithConfig(cm, f.config), nil
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
 }
 
+func (f *ChunkManagerFactory) newKMS() (KMS, error) {
+	switch f.config.kmsType {
+	case "local":
+		return NewLocalKMS(f.config.kmsKeyFile), nil
+	default:
+		return nil, errors.New("no kms implemented with type: " + f.config.kmsType)
+	}
+}
+
+// NewPersistentStorageChunkManager creates the chunk manager of the persistent storage,
+// which is wrapped by an EncryptedChunkManager if encryption is enabled.
 func (f *ChunkManagerFactory) NewPersistentStorageChunkManager(ctx context.Context) (ChunkManager, error) {
-	return f.newChunkManager(ctx, f.persistentStorage)
+	cm, err := f.newChunkManager(ctx, f.persistentStorage)
+	if err != nil || !f.config.encryptionEnabled {
+		return cm, err
+	}
+	kms, err := f.newKMS()
+	if err != nil {
+		return nil, err
+	}
+	return NewEncryptedChunkManager(cm, kms, f.config.encryptionStrict), nil
 }
 
 type Factory interface {
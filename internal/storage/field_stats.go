@@ -0,0 +1,323 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/spaolacci/murmur3"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+const (
+	// distinctSketchPrecision is the number of index bits of the hyperloglog sketch,
+	// 2^8 registers give a standard error of about 6.5%.
+	distinctSketchPrecision = 8
+	distinctSketchSize      = 1 << distinctSketchPrecision
+)
+
+// FieldStats contains the zone map of a scalar field: the value range, the null count and
+// a sketch estimating the distinct count. The range only covers non-null values, it is
+// meaningless if all the rows are null.
+type FieldStats struct {
+	FieldID   int64             `json:"fieldID"`
+	Type      schemapb.DataType `json:"type"`
+	RowCount  int64             `json:"rowCount"`
+	NullCount int64             `json:"nullCount"`
+	IntMin    int64             `json:"intMin,omitempty"`
+	IntMax    int64             `json:"intMax,omitempty"`
+	FloatMin  float64           `json:"floatMin,omitempty"`
+	FloatMax  float64           `json:"floatMax,omitempty"`
+	StringMin string            `json:"stringMin,omitempty"`
+	StringMax string            `json:"stringMax,omitempty"`
+	Sketch    []byte            `json:"sketch,omitempty"`
+}
+
+// SupportFieldStats returns whether field stats are collected for @dataType.
+func SupportFieldStats(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewFieldStats creates an empty FieldStats.
+func NewFieldStats(fieldID int64, dataType schemapb.DataType) *FieldStats {
+	return &FieldStats{
+		FieldID: fieldID,
+		Type:    dataType,
+		Sketch:  make([]byte, distinctSketchSize),
+	}
+}
+
+// HasRange returns whether the stats contain a value range, i.e. there is at least one non-null row.
+func (stats *FieldStats) HasRange() bool {
+	return stats.RowCount > stats.NullCount
+}
+
+func (stats *FieldStats) addHash(h uint64) {
+	idx := h >> (64 - distinctSketchPrecision)
+	rank := uint8(bits.LeadingZeros64(h<<distinctSketchPrecision|1<<(distinctSketchPrecision-1)) + 1)
+	if stats.Sketch[idx] < rank {
+		stats.Sketch[idx] = rank
+	}
+}
+
+func (stats *FieldStats) addInt(v int64) {
+	if !stats.HasRange() || v < stats.IntMin {
+		stats.IntMin = v
+	}
+	if !stats.HasRange() || v > stats.IntMax {
+		stats.IntMax = v
+	}
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, uint64(v))
+	stats.addHash(murmur3.Sum64(b))
+}
+
+func (stats *FieldStats) addFloat(v float64) {
+	// inf and nan can't be marshaled as json, the range is widened to the max float instead
+	minValue, maxValue := v, v
+	switch {
+	case math.IsNaN(v):
+		minValue, maxValue = -math.MaxFloat64, math.MaxFloat64
+	case math.IsInf(v, 1):
+		minValue, maxValue = math.MaxFloat64, math.MaxFloat64
+	case math.IsInf(v, -1):
+		minValue, maxValue = -math.MaxFloat64, -math.MaxFloat64
+	}
+	if !stats.HasRange() || minValue < stats.FloatMin {
+		stats.FloatMin = minValue
+	}
+	if !stats.HasRange() || maxValue > stats.FloatMax {
+		stats.FloatMax = maxValue
+	}
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, math.Float64bits(v))
+	stats.addHash(murmur3.Sum64(b))
+}
+
+func (stats *FieldStats) addString(v string) {
+	if !stats.HasRange() || v < stats.StringMin {
+		stats.StringMin = v
+	}
+	if !stats.HasRange() || v > stats.StringMax {
+		stats.StringMax = v
+	}
+	stats.addHash(murmur3.Sum64([]byte(v)))
+}
+
+func updateFieldStats[T any](stats *FieldStats, data []T, validData []bool, add func(T)) {
+	for i, v := range data {
+		if len(validData) > 0 && !validData[i] {
+			stats.RowCount++
+			stats.NullCount++
+			continue
+		}
+		// the range is updated before RowCount, so that the first value initializes it
+		add(v)
+		stats.RowCount++
+	}
+}
+
+// Update adds the values of @msgs into stats.
+func (stats *FieldStats) Update(msgs FieldData) error {
+	switch data := msgs.(type) {
+	case *BoolFieldData:
+		updateFieldStats(stats, data.Data, data.ValidData, func(v bool) {
+			if v {
+				stats.addInt(1)
+			} else {
+				stats.addInt(0)
+			}
+		})
+	case *Int8FieldData:
+		updateFieldStats(stats, data.Data, data.ValidData, func(v int8) { stats.addInt(int64(v)) })
+	case *Int16FieldData:
+		updateFieldStats(stats, data.Data, data.ValidData, func(v int16) { stats.addInt(int64(v)) })
+	case *Int32FieldData:
+		updateFieldStats(stats, data.Data, data.ValidData, func(v int32) { stats.addInt(int64(v)) })
+	case *Int64FieldData:
+		updateFieldStats(stats, data.Data, data.ValidData, stats.addInt)
+	case *FloatFieldData:
+		updateFieldStats(stats, data.Data, data.ValidData, func(v float32) { stats.addFloat(float64(v)) })
+	case *DoubleFieldData:
+		updateFieldStats(stats, data.Data, data.ValidData, stats.addFloat)
+	case *StringFieldData:
+		updateFieldStats(stats, data.Data, data.ValidData, stats.addString)
+	default:
+		return fmt.Errorf("field stats of %T is not supported", msgs)
+	}
+	return nil
+}
+
+// Merge merges @other, the stats of another part of the same field, into stats.
+func (stats *FieldStats) Merge(other *FieldStats) {
+	if other.HasRange() {
+		if !stats.HasRange() || other.IntMin < stats.IntMin {
+			stats.IntMin = other.IntMin
+		}
+		if !stats.HasRange() || other.IntMax > stats.IntMax {
+			stats.IntMax = other.IntMax
+		}
+		if !stats.HasRange() || other.FloatMin < stats.FloatMin {
+			stats.FloatMin = other.FloatMin
+		}
+		if !stats.HasRange() || other.FloatMax > stats.FloatMax {
+			stats.FloatMax = other.FloatMax
+		}
+		if !stats.HasRange() || other.StringMin < stats.StringMin {
+			stats.StringMin = other.StringMin
+		}
+		if !stats.HasRange() || other.StringMax > stats.StringMax {
+			stats.StringMax = other.StringMax
+		}
+	}
+	stats.RowCount += other.RowCount
+	stats.NullCount += other.NullCount
+	if len(stats.Sketch) != distinctSketchSize {
+		stats.Sketch = make([]byte, distinctSketchSize)
+	}
+	for i := 0; i < len(other.Sketch) && i < distinctSketchSize; i++ {
+		if other.Sketch[i] > stats.Sketch[i] {
+			stats.Sketch[i] = other.Sketch[i]
+		}
+	}
+}
+
+// DistinctCount returns the estimated number of distinct non-null values.
+func (stats *FieldStats) DistinctCount() int64 {
+	if len(stats.Sketch) != distinctSketchSize {
+		return 0
+	}
+	m := float64(distinctSketchSize)
+	sum := 0.0
+	zeros := 0
+	for _, rank := range stats.Sketch {
+		sum += 1.0 / float64(uint64(1)<<rank)
+		if rank == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	// small range correction by linear counting
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	count := int64(estimate + 0.5)
+	if nonNull := stats.RowCount - stats.NullCount; count > nonNull {
+		count = nonNull
+	}
+	return count
+}
+
+// ToProto converts stats to the zone map stored in segment meta.
+func (stats *FieldStats) ToProto() *datapb.FieldStats {
+	return &datapb.FieldStats{
+		FieldID:        stats.FieldID,
+		DataType:       stats.Type,
+		RowCount:       stats.RowCount,
+		NullCount:      stats.NullCount,
+		DistinctCount:  stats.DistinctCount(),
+		DistinctSketch: stats.Sketch,
+		IntMin:         stats.IntMin,
+		IntMax:         stats.IntMax,
+		FloatMin:       stats.FloatMin,
+		FloatMax:       stats.FloatMax,
+		StringMin:      stats.StringMin,
+		StringMax:      stats.StringMax,
+	}
+}
+
+// NewFieldStatsFromProto converts the zone map stored in segment meta to FieldStats.
+func NewFieldStatsFromProto(pb *datapb.FieldStats) *FieldStats {
+	return &FieldStats{
+		FieldID:   pb.GetFieldID(),
+		Type:      pb.GetDataType(),
+		RowCount:  pb.GetRowCount(),
+		NullCount: pb.GetNullCount(),
+		IntMin:    pb.GetIntMin(),
+		IntMax:    pb.GetIntMax(),
+		FloatMin:  pb.GetFloatMin(),
+		FloatMax:  pb.GetFloatMax(),
+		StringMin: pb.GetStringMin(),
+		StringMax: pb.GetStringMax(),
+		Sketch:    pb.GetDistinctSketch(),
+	}
+}
+
+// MergeFieldStatsProto merges the zone maps of @incoming into @current by field id.
+func MergeFieldStatsProto(current []*datapb.FieldStats, incoming []*datapb.FieldStats) []*datapb.FieldStats {
+	if len(incoming) == 0 {
+		return current
+	}
+	merged := make(map[int64]*FieldStats, len(current))
+	order := make([]int64, 0, len(current)+len(incoming))
+	for _, pb := range append(append([]*datapb.FieldStats{}, current...), incoming...) {
+		stats, ok := merged[pb.GetFieldID()]
+		if !ok {
+			stats = NewFieldStats(pb.GetFieldID(), pb.GetDataType())
+			merged[pb.GetFieldID()] = stats
+			order = append(order, pb.GetFieldID())
+		}
+		stats.Merge(NewFieldStatsFromProto(pb))
+	}
+	result := make([]*datapb.FieldStats, 0, len(order))
+	for _, fieldID := range order {
+		result = append(result, merged[fieldID].ToProto())
+	}
+	return result
+}
+
+// GenerateFieldStats writes FieldStats from @msgs with @fieldID to @buffer
+func (sw *StatsWriter) GenerateFieldStats(fieldID int64, dataType schemapb.DataType, msgs FieldData) (*FieldStats, error) {
+	stats := NewFieldStats(fieldID, dataType)
+	if err := stats.Update(msgs); err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return nil, err
+	}
+	sw.buffer = b
+	return stats, nil
+}
+
+// DeserializeFieldStats deserialize @blobs as []*FieldStats
+func DeserializeFieldStats(blobs []*Blob) ([]*FieldStats, error) {
+	results := make([]*FieldStats, 0, len(blobs))
+	for _, blob := range blobs {
+		if blob.Value == nil {
+			continue
+		}
+		stats := &FieldStats{}
+		if err := json.Unmarshal(blob.Value, stats); err != nil {
+			return nil, err
+		}
+		results = append(results, stats)
+	}
+	return results, nil
+}
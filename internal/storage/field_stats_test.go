@@ -0,0 +1,172 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+)
+
+func TestFieldStats_Update(t *testing.T) {
+	stats := NewFieldStats(100, schemapb.DataType_Int32)
+	err := stats.Update(&Int32FieldData{
+		Data:      []int32{5, -3, 0, 7, 2},
+		ValidData: []bool{true, true, false, true, true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), stats.RowCount)
+	assert.Equal(t, int64(1), stats.NullCount)
+	assert.True(t, stats.HasRange())
+	assert.Equal(t, int64(-3), stats.IntMin)
+	assert.Equal(t, int64(7), stats.IntMax)
+	assert.Equal(t, int64(4), stats.DistinctCount())
+
+	stats = NewFieldStats(101, schemapb.DataType_VarChar)
+	err = stats.Update(&StringFieldData{Data: []string{"b", "abc", "z", "b"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", stats.StringMin)
+	assert.Equal(t, "z", stats.StringMax)
+	assert.Equal(t, int64(3), stats.DistinctCount())
+
+	stats = NewFieldStats(102, schemapb.DataType_Double)
+	err = stats.Update(&DoubleFieldData{Data: []float64{1.5, math.Inf(1), -2}})
+	assert.NoError(t, err)
+	assert.Equal(t, float64(-2), stats.FloatMin)
+	assert.Equal(t, math.MaxFloat64, stats.FloatMax)
+
+	stats = NewFieldStats(103, schemapb.DataType_Float)
+	err = stats.Update(&FloatFieldData{Data: []float32{float32(math.NaN()), 1}})
+	assert.NoError(t, err)
+	assert.Equal(t, -math.MaxFloat64, stats.FloatMin)
+	assert.Equal(t, math.MaxFloat64, stats.FloatMax)
+
+	stats = NewFieldStats(104, schemapb.DataType_Int64)
+	err = stats.Update(&Int64FieldData{Data: []int64{1, 2}, ValidData: []bool{false, false}})
+	assert.NoError(t, err)
+	assert.False(t, stats.HasRange())
+	assert.Equal(t, int64(0), stats.DistinctCount())
+
+	err = stats.Update(&FloatVectorFieldData{Data: []float32{1, 2}, Dim: 2})
+	assert.Error(t, err)
+}
+
+func TestFieldStats_Merge(t *testing.T) {
+	stats1 := NewFieldStats(100, schemapb.DataType_Int64)
+	err := stats1.Update(&Int64FieldData{Data: []int64{0, 10, 20}, ValidData: []bool{false, true, true}})
+	assert.NoError(t, err)
+	stats2 := NewFieldStats(100, schemapb.DataType_Int64)
+	err = stats2.Update(&Int64FieldData{Data: []int64{5, 10, 30}})
+	assert.NoError(t, err)
+
+	merged := NewFieldStats(100, schemapb.DataType_Int64)
+	merged.Merge(stats1)
+	merged.Merge(stats2)
+	assert.Equal(t, int64(6), merged.RowCount)
+	assert.Equal(t, int64(1), merged.NullCount)
+	assert.Equal(t, int64(5), merged.IntMin)
+	assert.Equal(t, int64(30), merged.IntMax)
+	assert.Equal(t, int64(4), merged.DistinctCount())
+}
+
+func TestFieldStats_DistinctCount(t *testing.T) {
+	stats := NewFieldStats(100, schemapb.DataType_Int64)
+	data := make([]int64, 0, 100000)
+	for i := 0; i < 100000; i++ {
+		data = append(data, int64(i%20000))
+	}
+	err := stats.Update(&Int64FieldData{Data: data})
+	assert.NoError(t, err)
+	assert.InDelta(t, 20000, stats.DistinctCount(), 20000*0.2)
+}
+
+func TestFieldStats_Proto(t *testing.T) {
+	stats := NewFieldStats(100, schemapb.DataType_VarChar)
+	err := stats.Update(&StringFieldData{Data: []string{"a", "b", "c"}})
+	assert.NoError(t, err)
+
+	pb := stats.ToProto()
+	assert.Equal(t, int64(3), pb.GetDistinctCount())
+	assert.Equal(t, stats, NewFieldStatsFromProto(pb))
+
+	merged := MergeFieldStatsProto(nil, []*datapb.FieldStats{pb})
+	other := NewFieldStats(100, schemapb.DataType_VarChar)
+	err = other.Update(&StringFieldData{Data: []string{"0", "d"}})
+	assert.NoError(t, err)
+	merged = MergeFieldStatsProto(merged, []*datapb.FieldStats{other.ToProto(), NewFieldStats(101, schemapb.DataType_Bool).ToProto()})
+	assert.Equal(t, 2, len(merged))
+	assert.Equal(t, int64(100), merged[0].GetFieldID())
+	assert.Equal(t, int64(5), merged[0].GetRowCount())
+	assert.Equal(t, "0", merged[0].GetStringMin())
+	assert.Equal(t, "d", merged[0].GetStringMax())
+	assert.Equal(t, int64(101), merged[1].GetFieldID())
+	assert.Equal(t, int64(0), merged[1].GetRowCount())
+}
+
+func TestFieldStats_Serialize(t *testing.T) {
+	sw := &StatsWriter{}
+	stats, err := sw.GenerateFieldStats(100, schemapb.DataType_Double, &DoubleFieldData{Data: []float64{math.Inf(-1), 1}})
+	assert.NoError(t, err)
+
+	results, err := DeserializeFieldStats([]*Blob{{Key: "100", Value: sw.GetBuffer()}, {Key: "101"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, stats, results[0])
+
+	_, err = sw.GenerateFieldStats(100, schemapb.DataType_Double, &BinaryVectorFieldData{Data: []byte{1}, Dim: 8})
+	assert.Error(t, err)
+
+	_, err = DeserializeFieldStats([]*Blob{{Key: "100", Value: []byte("{")}})
+	assert.Error(t, err)
+}
+
+func TestInsertCodec_SerializeFieldStats(t *testing.T) {
+	schema := &etcdpb.CollectionMeta{
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 0, DataType: schemapb.DataType_Int64},
+				{FieldID: 1, DataType: schemapb.DataType_Int64},
+				{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, DataType: schemapb.DataType_Int32},
+				{FieldID: 102, DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}
+	codec := NewInsertCodec(schema)
+	data := &InsertData{
+		Data: map[FieldID]FieldData{
+			0:   &Int64FieldData{Data: []int64{1, 2}},
+			1:   &Int64FieldData{Data: []int64{1, 2}},
+			100: &Int64FieldData{Data: []int64{1, 2}},
+			101: &Int32FieldData{Data: []int32{3, 4}},
+			102: &FloatVectorFieldData{Data: []float32{1, 2}, Dim: 1},
+		},
+	}
+	blobs, stats, err := codec.SerializeFieldStats(data)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(blobs))
+	assert.Equal(t, fmt.Sprint(101), blobs[0].Key)
+	assert.Equal(t, 1, len(stats))
+	assert.Equal(t, int64(3), stats[0].IntMin)
+	assert.Equal(t, int64(4), stats[0].IntMax)
+}
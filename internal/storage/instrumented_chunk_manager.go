@@ -0,0 +1,353 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/minio/minio-go/v7"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus/internal/metrics"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+const (
+	storageOpRead             = "read"
+	storageOpReadAt           = "read_at"
+	storageOpReader           = "reader"
+	storageOpMultiRead        = "multi_read"
+	storageOpReadWithPrefix   = "read_with_prefix"
+	storageOpListWithPrefix   = "list_with_prefix"
+	storageOpExist            = "exist"
+	storageOpSize             = "size"
+	storageOpPath             = "path"
+	storageOpWrite            = "write"
+	storageOpMultiWrite       = "multi_write"
+	storageOpRemove           = "remove"
+	storageOpMultiRemove      = "multi_remove"
+	storageOpRemoveWithPrefix = "remove_with_prefix"
+
+	defaultStorageRetryAttempts = 5
+	storageRetrySleep           = 100 * time.Millisecond
+	storageRetryMaxSleep        = 3 * time.Second
+	storageRetryJitter          = 0.5
+	uploadRateLimitWaitInterval = 10 * time.Millisecond
+)
+
+// nonRetryableStorageOps are the operations on multiple objects, a failed attempt may have partially succeeded,
+// and retrying the whole operation makes the caller unable to tell which objects are done.
+var nonRetryableStorageOps = map[string]struct{}{
+	storageOpMultiWrite:       {},
+	storageOpMultiRemove:      {},
+	storageOpRemoveWithPrefix: {},
+}
+
+// InstrumentedChunkManager wraps the ChunkManager of a remote storage, it retries the requests with jittered backoff,
+// applies a timeout to every attempt, limits the upload bandwidth and records the metrics of the requests.
+// Reads, single object writes and removes are retried since they are idempotent on object storages,
+// the operations on multiple objects are not. Errors caused by the request itself, such as 4xx responses
+// and authentication failures, are not retried either.
+type InstrumentedChunkManager struct {
+	ChunkManager
+	component     string
+	attempts      uint
+	timeout       time.Duration
+	uploadLimiter *ratelimitutil.Limiter
+}
+
+var _ ChunkManager = (*InstrumentedChunkManager)(nil)
+
+// NewInstrumentedChunkManager wraps @cm with an InstrumentedChunkManager.
+func NewInstrumentedChunkManager(cm ChunkManager, opts ...Option) *InstrumentedChunkManager {
+	c := newDefaultConfig()
+	for _, opt := range opts {
+		opt(c)
+	}
+	return newInstrumentedChunkManagerWithConfig(cm, c)
+}
+
+func newInstrumentedChunkManagerWithConfig(cm ChunkManager, c *config) *InstrumentedChunkManager {
+	icm := &InstrumentedChunkManager{
+		ChunkManager: cm,
+		component:    c.component,
+		attempts:     c.retryAttempts,
+		timeout:      c.requestTimeout,
+	}
+	if icm.attempts == 0 {
+		icm.attempts = defaultStorageRetryAttempts
+	}
+	if c.uploadRateLimit > 0 {
+		icm.uploadLimiter = ratelimitutil.NewLimiter(ratelimitutil.Limit(c.uploadRateLimit), c.uploadRateLimit)
+	}
+	return icm
+}
+
+// isRetryableStorageError returns false if @err would be returned by the retries too.
+func isRetryableStorageError(err error) bool {
+	if errors.Is(err, ErrNoSuchKey) || errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var authErr *azidentity.AuthenticationFailedError
+	if errors.As(err, &authErr) {
+		return false
+	}
+	if code := storageErrorStatusCode(err); code >= 400 && code < 500 {
+		// request timeout and throttling are transient
+		return code == http.StatusRequestTimeout || code == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// storageErrorStatusCode returns the http status code of the response carried by @err, 0 if there is none.
+func storageErrorStatusCode(err error) int {
+	var minioErr minio.ErrorResponse
+	if errors.As(err, &minioErr) {
+		return minioErr.StatusCode
+	}
+	var azureErr *azcore.ResponseError
+	if errors.As(err, &azureErr) {
+		return azureErr.StatusCode
+	}
+	return 0
+}
+
+// do calls @fn with retries unless @op is not retryable, and records the metrics of @op.
+// The error of the last attempt is returned.
+// @timeout is not applied if the result of @fn outlives the call, such as a reader.
+func (icm *InstrumentedChunkManager) do(ctx context.Context, op string, timeout bool, fn func(ctx context.Context) error) error {
+	start := time.Now()
+	attempts := icm.attempts
+	if _, ok := nonRetryableStorageOps[op]; ok {
+		attempts = 1
+	}
+	var lastErr error
+	attempt := 0
+	err := retry.Do(ctx, func() error {
+		if attempt > 0 {
+			metrics.StorageRequestRetries.WithLabelValues(icm.component, op).Inc()
+		}
+		attempt++
+		callCtx := ctx
+		if timeout && icm.timeout > 0 {
+			var cancel context.CancelFunc
+			callCtx, cancel = context.WithTimeout(ctx, icm.timeout)
+			defer cancel()
+		}
+		lastErr = fn(callCtx)
+		if lastErr != nil && !isRetryableStorageError(lastErr) {
+			return retry.Unrecoverable(lastErr)
+		}
+		return lastErr
+	}, retry.Attempts(attempts), retry.Sleep(storageRetrySleep), retry.MaxSleepTime(storageRetryMaxSleep),
+		retry.Jitter(storageRetryJitter))
+
+	metrics.StorageRequestLatency.WithLabelValues(icm.component, op).Observe(float64(time.Since(start).Milliseconds()))
+	if err != nil {
+		metrics.StorageRequestTotal.WithLabelValues(icm.component, op, metrics.FailLabel).Inc()
+		if lastErr == nil {
+			return err
+		}
+		return lastErr
+	}
+	metrics.StorageRequestTotal.WithLabelValues(icm.component, op, metrics.SuccessLabel).Inc()
+	return nil
+}
+
+func (icm *InstrumentedChunkManager) addBytes(op string, n int) {
+	metrics.StorageRequestBytes.WithLabelValues(icm.component, op).Add(float64(n))
+}
+
+// waitUpload blocks until @n bytes are allowed to be uploaded.
+func (icm *InstrumentedChunkManager) waitUpload(ctx context.Context, n int) error {
+	if icm.uploadLimiter == nil {
+		return nil
+	}
+	for !icm.uploadLimiter.AllowN(time.Now(), n) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(uploadRateLimitWaitInterval):
+		}
+	}
+	return nil
+}
+
+// Path returns the path of @filePath in the remote storage.
+func (icm *InstrumentedChunkManager) Path(ctx context.Context, filePath string) (string, error) {
+	var p string
+	err := icm.do(ctx, storageOpPath, true, func(ctx context.Context) (err error) {
+		p, err = icm.ChunkManager.Path(ctx, filePath)
+		return err
+	})
+	return p, err
+}
+
+// Size returns the size of @filePath.
+func (icm *InstrumentedChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	var size int64
+	err := icm.do(ctx, storageOpSize, true, func(ctx context.Context) (err error) {
+		size, err = icm.ChunkManager.Size(ctx, filePath)
+		return err
+	})
+	return size, err
+}
+
+// Write writes @content to @filePath, it's blocked if the upload bandwidth is limited.
+func (icm *InstrumentedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	if err := icm.waitUpload(ctx, len(content)); err != nil {
+		return err
+	}
+	err := icm.do(ctx, storageOpWrite, true, func(ctx context.Context) error {
+		return icm.ChunkManager.Write(ctx, filePath, content)
+	})
+	if err == nil {
+		icm.addBytes(storageOpWrite, len(content))
+	}
+	return err
+}
+
+// MultiWrite writes @contents, it's blocked if the upload bandwidth is limited.
+func (icm *InstrumentedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	size := 0
+	for _, content := range contents {
+		size += len(content)
+	}
+	if err := icm.waitUpload(ctx, size); err != nil {
+		return err
+	}
+	err := icm.do(ctx, storageOpMultiWrite, true, func(ctx context.Context) error {
+		return icm.ChunkManager.MultiWrite(ctx, contents)
+	})
+	if err == nil {
+		icm.addBytes(storageOpMultiWrite, size)
+	}
+	return err
+}
+
+// Exist returns true if @filePath exists.
+func (icm *InstrumentedChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	var exist bool
+	err := icm.do(ctx, storageOpExist, true, func(ctx context.Context) (err error) {
+		exist, err = icm.ChunkManager.Exist(ctx, filePath)
+		return err
+	})
+	return exist, err
+}
+
+// Read reads @filePath.
+func (icm *InstrumentedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	var content []byte
+	err := icm.do(ctx, storageOpRead, true, func(ctx context.Context) (err error) {
+		content, err = icm.ChunkManager.Read(ctx, filePath)
+		return err
+	})
+	icm.addBytes(storageOpRead, len(content))
+	return content, err
+}
+
+// Reader returns a reader of @filePath, the reader is not limited by the request timeout.
+func (icm *InstrumentedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	var reader FileReader
+	err := icm.do(ctx, storageOpReader, false, func(ctx context.Context) (err error) {
+		reader, err = icm.ChunkManager.Reader(ctx, filePath)
+		return err
+	})
+	return reader, err
+}
+
+// MultiRead reads @filePaths.
+func (icm *InstrumentedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	var contents [][]byte
+	err := icm.do(ctx, storageOpMultiRead, true, func(ctx context.Context) (err error) {
+		contents, err = icm.ChunkManager.MultiRead(ctx, filePaths)
+		return err
+	})
+	for _, content := range contents {
+		icm.addBytes(storageOpMultiRead, len(content))
+	}
+	return contents, err
+}
+
+// ListWithPrefix lists the files with @prefix.
+func (icm *InstrumentedChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	var filePaths []string
+	var modTimes []time.Time
+	err := icm.do(ctx, storageOpListWithPrefix, true, func(ctx context.Context) (err error) {
+		filePaths, modTimes, err = icm.ChunkManager.ListWithPrefix(ctx, prefix, recursive)
+		return err
+	})
+	return filePaths, modTimes, err
+}
+
+// ReadWithPrefix reads the files with @prefix.
+func (icm *InstrumentedChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	var filePaths []string
+	var contents [][]byte
+	err := icm.do(ctx, storageOpReadWithPrefix, true, func(ctx context.Context) (err error) {
+		filePaths, contents, err = icm.ChunkManager.ReadWithPrefix(ctx, prefix)
+		return err
+	})
+	for _, content := range contents {
+		icm.addBytes(storageOpReadWithPrefix, len(content))
+	}
+	return filePaths, contents, err
+}
+
+// Mmap is not supported by remote storages, it's passed through.
+func (icm *InstrumentedChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	return icm.ChunkManager.Mmap(ctx, filePath)
+}
+
+// ReadAt reads @length bytes of @filePath from @off.
+func (icm *InstrumentedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	var p []byte
+	err := icm.do(ctx, storageOpReadAt, true, func(ctx context.Context) (err error) {
+		p, err = icm.ChunkManager.ReadAt(ctx, filePath, off, length)
+		return err
+	})
+	icm.addBytes(storageOpReadAt, len(p))
+	return p, err
+}
+
+// Remove removes @filePath.
+func (icm *InstrumentedChunkManager) Remove(ctx context.Context, filePath string) error {
+	return icm.do(ctx, storageOpRemove, true, func(ctx context.Context) error {
+		return icm.ChunkManager.Remove(ctx, filePath)
+	})
+}
+
+// MultiRemove removes @filePaths.
+func (icm *InstrumentedChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	return icm.do(ctx, storageOpMultiRemove, true, func(ctx context.Context) error {
+		return icm.ChunkManager.MultiRemove(ctx, filePaths)
+	})
+}
+
+// RemoveWithPrefix removes the files with @prefix.
+func (icm *InstrumentedChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	return icm.do(ctx, storageOpRemoveWithPrefix, true, func(ctx context.Context) error {
+		return icm.ChunkManager.RemoveWithPrefix(ctx, prefix)
+	})
+}
@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyChunkManager fails the first @failures calls of every operation.
+type flakyChunkManager struct {
+	ChunkManager
+	failures int
+	calls    map[string]int
+	delay    time.Duration
+	err      error
+}
+
+func (cm *flakyChunkManager) call(ctx context.Context, op string) error {
+	cm.calls[op]++
+	if cm.delay > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(cm.delay):
+		}
+	}
+	if cm.calls[op] <= cm.failures {
+		if cm.err != nil {
+			return cm.err
+		}
+		return errors.New("service unavailable")
+	}
+	return nil
+}
+
+func (cm *flakyChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	if err := cm.call(ctx, storageOpMultiWrite); err != nil {
+		return err
+	}
+	return cm.ChunkManager.MultiWrite(ctx, contents)
+}
+
+func (cm *flakyChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	if err := cm.call(ctx, storageOpWrite); err != nil {
+		return err
+	}
+	return cm.ChunkManager.Write(ctx, filePath, content)
+}
+
+func (cm *flakyChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	if err := cm.call(ctx, storageOpRead); err != nil {
+		return nil, err
+	}
+	if exist, _ := cm.ChunkManager.Exist(ctx, filePath); !exist {
+		return nil, WrapErrNoSuchKey(filePath)
+	}
+	return cm.ChunkManager.Read(ctx, filePath)
+}
+
+func newFlakyChunkManager(t *testing.T, failures int) (*flakyChunkManager, string) {
+	rootPath := t.TempDir()
+	return &flakyChunkManager{
+		ChunkManager: NewLocalChunkManager(RootPath(rootPath)),
+		failures:     failures,
+		calls:        make(map[string]int),
+	}, rootPath
+}
+
+func TestInstrumentedChunkManager_Retry(t *testing.T) {
+	ctx := context.Background()
+	flaky, rootPath := newFlakyChunkManager(t, 2)
+	icm := NewInstrumentedChunkManager(flaky, Component("test"), RetryAttempts(3))
+	file := path.Join(rootPath, "a")
+
+	assert.NoError(t, icm.Write(ctx, file, []byte("abc")))
+	assert.Equal(t, 3, flaky.calls[storageOpWrite])
+	content, err := icm.Read(ctx, file)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("abc"), content)
+	assert.Equal(t, 3, flaky.calls[storageOpRead])
+
+	// missing files are not retried
+	_, err = icm.Read(ctx, path.Join(rootPath, "b"))
+	assert.True(t, errors.Is(err, ErrNoSuchKey))
+	assert.Equal(t, 4, flaky.calls[storageOpRead])
+
+	// the error of the last attempt is returned
+	flaky, rootPath = newFlakyChunkManager(t, 3)
+	icm = NewInstrumentedChunkManager(flaky, RetryAttempts(3))
+	err = icm.Write(ctx, path.Join(rootPath, "a"), []byte("abc"))
+	assert.EqualError(t, err, "service unavailable")
+	assert.Equal(t, 3, flaky.calls[storageOpWrite])
+
+	// operations on multiple objects are not retried
+	err = icm.MultiWrite(ctx, map[string][]byte{path.Join(rootPath, "a"): []byte("abc")})
+	assert.Error(t, err)
+	assert.Equal(t, 1, flaky.calls[storageOpMultiWrite])
+}
+
+func newAzureResponseError(code string, statusCode int) error {
+	req, _ := http.NewRequest(http.MethodPut, "https://account.blob.core.windows.net/container/a", nil)
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    req,
+	}
+	resp.Header.Set("x-ms-error-code", code)
+	return runtime.NewResponseError(resp)
+}
+
+func TestInstrumentedChunkManager_NonRetryableError(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		err       error
+		retryable bool
+	}{
+		{minio.ErrorResponse{Code: "AccessDenied", StatusCode: http.StatusForbidden}, false},
+		{minio.ErrorResponse{Code: "NoSuchBucket", StatusCode: http.StatusNotFound}, false},
+		{fmt.Errorf("wrapped: %w", minio.ErrorResponse{Code: "InvalidArgument", StatusCode: http.StatusBadRequest}), false},
+		{newAzureResponseError("AuthenticationFailed", http.StatusForbidden), false},
+		{minio.ErrorResponse{Code: "SlowDown", StatusCode: http.StatusServiceUnavailable}, true},
+		{minio.ErrorResponse{Code: "RequestTimeout", StatusCode: http.StatusRequestTimeout}, true},
+		{newAzureResponseError("ServerBusy", http.StatusTooManyRequests), true},
+	}
+	for _, c := range cases {
+		flaky, rootPath := newFlakyChunkManager(t, 1)
+		flaky.err = c.err
+		icm := NewInstrumentedChunkManager(flaky, RetryAttempts(3))
+		err := icm.Write(ctx, path.Join(rootPath, "a"), []byte("abc"))
+		if c.retryable {
+			assert.NoError(t, err, c.err.Error())
+			assert.Equal(t, 2, flaky.calls[storageOpWrite], c.err.Error())
+		} else {
+			assert.ErrorIs(t, err, c.err, c.err.Error())
+			assert.Equal(t, 1, flaky.calls[storageOpWrite], c.err.Error())
+		}
+	}
+}
+
+func TestInstrumentedChunkManager_Timeout(t *testing.T) {
+	ctx := context.Background()
+	flaky, rootPath := newFlakyChunkManager(t, 0)
+	flaky.delay = time.Second
+	icm := NewInstrumentedChunkManager(flaky, RetryAttempts(2), RequestTimeout(10*time.Millisecond))
+
+	start := time.Now()
+	err := icm.Write(ctx, path.Join(rootPath, "a"), []byte("abc"))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, 2, flaky.calls[storageOpWrite])
+	assert.Less(t, time.Since(start), time.Second)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = icm.Read(cancelCtx, path.Join(rootPath, "a"))
+	assert.Error(t, err)
+	assert.Equal(t, 1, flaky.calls[storageOpRead])
+}
+
+func TestInstrumentedChunkManager_UploadRateLimit(t *testing.T) {
+	ctx := context.Background()
+	flaky, rootPath := newFlakyChunkManager(t, 0)
+	icm := NewInstrumentedChunkManager(flaky, UploadRateLimit(1000))
+
+	// the first write takes the burst and the second one waits for the tokens
+	start := time.Now()
+	require.NoError(t, icm.Write(ctx, path.Join(rootPath, "a"), make([]byte, 1100)))
+	require.NoError(t, icm.Write(ctx, path.Join(rootPath, "b"), make([]byte, 100)))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Error(t, icm.Write(cancelCtx, path.Join(rootPath, "c"), make([]byte, 100)))
+	assert.Equal(t, 2, flaky.calls[storageOpWrite])
+
+	filePaths, contents, err := icm.ReadWithPrefix(ctx, rootPath)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(filePaths))
+	assert.Equal(t, 1100, len(contents[0]))
+}
@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// KMS wraps and unwraps data encryption keys with master keys it owns.
+// Master keys never leave the KMS, only wrapped data keys are persisted.
+type KMS interface {
+	// ActiveKeyID returns the id of the master key used to wrap new data keys.
+	ActiveKeyID(ctx context.Context) (string, error)
+	// WrapKey encrypts @dataKey with the master key @keyID.
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts @wrapped which was produced by WrapKey with the master key @keyID.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// localKeyFile is the content of the key file used by LocalKMS, say:
+//
+//	{"active_key_id": "k2", "keys": {"k1": "<base64 of 32 bytes>", "k2": "<base64 of 32 bytes>"}}
+//
+// Retired master keys must stay in the file until the data keys are re-wrapped.
+type localKeyFile struct {
+	ActiveKeyID string            `json:"active_key_id"`
+	Keys        map[string]string `json:"keys"`
+}
+
+// LocalKMS is a KMS which loads AES-256 master keys from a local key file.
+// The file is reloaded when it is modified, so a new master key could be activated without restarting.
+type LocalKMS struct {
+	path string
+
+	mu       sync.RWMutex
+	modTime  time.Time
+	activeID string
+	keys     map[string]cipher.AEAD
+}
+
+var _ KMS = (*LocalKMS)(nil)
+
+// NewLocalKMS creates a LocalKMS, the key file is loaded on first use.
+func NewLocalKMS(path string) *LocalKMS {
+	return &LocalKMS{path: path}
+}
+
+func (k *LocalKMS) load() error {
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat key file: %w", err)
+	}
+
+	k.mu.RLock()
+	loaded := k.keys != nil && info.ModTime().Equal(k.modTime)
+	k.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	content, err := os.ReadFile(k.path)
+	if err != nil {
+		return fmt.Errorf("failed to read key file: %w", err)
+	}
+	var file localKeyFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return fmt.Errorf("failed to parse key file %s: %w", k.path, err)
+	}
+
+	keys := make(map[string]cipher.AEAD, len(file.Keys))
+	for id, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("master key %s is not base64 encoded: %w", id, err)
+		}
+		if len(key) != 32 {
+			return fmt.Errorf("master key %s must be 32 bytes, got %d", id, len(key))
+		}
+		aead, err := newAESGCM(key)
+		if err != nil {
+			return err
+		}
+		keys[id] = aead
+	}
+	if _, ok := keys[file.ActiveKeyID]; !ok {
+		return fmt.Errorf("active master key %q not found in key file %s", file.ActiveKeyID, k.path)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.modTime = info.ModTime()
+	k.activeID = file.ActiveKeyID
+	k.keys = keys
+	return nil
+}
+
+func (k *LocalKMS) getKey(keyID string) (cipher.AEAD, error) {
+	if err := k.load(); err != nil {
+		return nil, err
+	}
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("master key %q not found", keyID)
+	}
+	return aead, nil
+}
+
+// ActiveKeyID returns the active master key id of the key file.
+func (k *LocalKMS) ActiveKeyID(ctx context.Context) (string, error) {
+	if err := k.load(); err != nil {
+		return "", err
+	}
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.activeID, nil
+}
+
+// WrapKey encrypts @dataKey by AES-GCM, the output is nonce followed by the sealed data key.
+func (k *LocalKMS) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	aead, err := k.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dataKey)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+// UnwrapKey decrypts the output of WrapKey.
+func (k *LocalKMS) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	aead, err := k.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, fmt.Errorf("wrapped key is too short")
+	}
+	dataKey, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key with master key %s: %w", keyID, err)
+	}
+	return dataKey, nil
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestKeyFile(t *testing.T, keyFile string, activeKeyID string, keyIDs ...string) {
+	file := localKeyFile{ActiveKeyID: activeKeyID, Keys: make(map[string]string)}
+	for _, id := range keyIDs {
+		// master keys are derived from the ids, so the same id always refers to the same key
+		key := sha256.Sum256([]byte(id))
+		file.Keys[id] = base64.StdEncoding.EncodeToString(key[:])
+	}
+	content, err := json.Marshal(&file)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, content, 0600))
+	// make sure the modification is observed even if the file system has a coarse mtime
+	modTime := time.Now().Add(time.Duration(len(keyIDs)) * time.Second)
+	require.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func TestLocalKMS(t *testing.T) {
+	ctx := context.Background()
+	keyFile := filepath.Join(t.TempDir(), "keys.json")
+
+	t.Run("key file not exist", func(t *testing.T) {
+		kms := NewLocalKMS(keyFile)
+		_, err := kms.ActiveKeyID(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("wrap and unwrap", func(t *testing.T) {
+		writeTestKeyFile(t, keyFile, "k1", "k1")
+		kms := NewLocalKMS(keyFile)
+
+		id, err := kms.ActiveKeyID(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "k1", id)
+
+		dataKey := []byte("0123456789abcdef0123456789abcdef")
+		wrapped, err := kms.WrapKey(ctx, id, dataKey)
+		require.NoError(t, err)
+		assert.NotContains(t, string(wrapped), string(dataKey))
+
+		unwrapped, err := kms.UnwrapKey(ctx, id, wrapped)
+		require.NoError(t, err)
+		assert.Equal(t, dataKey, unwrapped)
+
+		_, err = kms.UnwrapKey(ctx, id, wrapped[:4])
+		assert.Error(t, err)
+		wrapped[len(wrapped)-1] ^= 0xff
+		_, err = kms.UnwrapKey(ctx, id, wrapped)
+		assert.Error(t, err)
+		_, err = kms.WrapKey(ctx, "k_not_exist", dataKey)
+		assert.Error(t, err)
+	})
+
+	t.Run("reload key file", func(t *testing.T) {
+		writeTestKeyFile(t, keyFile, "k1", "k1")
+		kms := NewLocalKMS(keyFile)
+		wrapped, err := kms.WrapKey(ctx, "k1", []byte("data key"))
+		require.NoError(t, err)
+
+		writeTestKeyFile(t, keyFile, "k2", "k1", "k2")
+		id, err := kms.ActiveKeyID(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "k2", id)
+
+		unwrapped, err := kms.UnwrapKey(ctx, "k1", wrapped)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("data key"), unwrapped)
+	})
+
+	t.Run("invalid key file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(keyFile, []byte("{"), 0600))
+		_, err := NewLocalKMS(keyFile).ActiveKeyID(ctx)
+		assert.Error(t, err)
+
+		require.NoError(t, os.WriteFile(keyFile, []byte(`{"active_key_id": "k1", "keys": {"k1": "short"}}`), 0600))
+		_, err = NewLocalKMS(keyFile).ActiveKeyID(ctx)
+		assert.Error(t, err)
+
+		writeTestKeyFile(t, keyFile, "k3", "k1")
+		_, err = NewLocalKMS(keyFile).ActiveKeyID(ctx)
+		assert.Error(t, err)
+	})
+}
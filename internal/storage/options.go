@@ -1,5 +1,7 @@
 package storage
 
+import "time"
+
 // Option for setting params used by chunk manager client.
 type config struct {
 	address           string
@@ -12,6 +14,17 @@ type config struct {
 	useIAM            bool
 	cloudProvider     string
 	iamEndpoint       string
+	clientID          string
+
+	encryptionEnabled bool
+	encryptionStrict  bool
+	kmsType           string
+	kmsKeyFile        string
+
+	component       string
+	retryAttempts   uint
+	requestTimeout  time.Duration
+	uploadRateLimit float64
 }
 
 func newDefaultConfig() *config {
@@ -79,3 +92,66 @@ func IAMEndpoint(iamEndpoint string) Option {
 		c.iamEndpoint = iamEndpoint
 	}
 }
+
+// ClientID sets the client id of a user-assigned managed identity, used by azure when useIAM is true.
+func ClientID(clientID string) Option {
+	return func(c *config) {
+		c.clientID = clientID
+	}
+}
+
+// EncryptionEnabled sets whether the persistent storage is encrypted at rest.
+func EncryptionEnabled(enabled bool) Option {
+	return func(c *config) {
+		c.encryptionEnabled = enabled
+	}
+}
+
+// EncryptionStrict sets whether files without the encryption header are rejected.
+func EncryptionStrict(strict bool) Option {
+	return func(c *config) {
+		c.encryptionStrict = strict
+	}
+}
+
+// KMSType sets the type of the KMS which wraps data encryption keys, only "local" is supported now.
+func KMSType(kmsType string) Option {
+	return func(c *config) {
+		c.kmsType = kmsType
+	}
+}
+
+// KMSKeyFile sets the master key file used by the local KMS.
+func KMSKeyFile(keyFile string) Option {
+	return func(c *config) {
+		c.kmsKeyFile = keyFile
+	}
+}
+
+// Component sets the name of the component which accesses the storage, it's used as a label of the metrics.
+func Component(component string) Option {
+	return func(c *config) {
+		c.component = component
+	}
+}
+
+// RetryAttempts sets the max attempts of the idempotent requests to the remote storage.
+func RetryAttempts(attempts uint) Option {
+	return func(c *config) {
+		c.retryAttempts = attempts
+	}
+}
+
+// RequestTimeout sets the timeout of every attempt of the requests to the remote storage, 0 means no timeout.
+func RequestTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.requestTimeout = timeout
+	}
+}
+
+// UploadRateLimit sets the max upload bandwidth to the remote storage in bytes per second, 0 means unlimited.
+func UploadRateLimit(bytesPerSecond float64) Option {
+	return func(c *config) {
+		c.uploadRateLimit = bytesPerSecond
+	}
+}
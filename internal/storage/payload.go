@@ -36,6 +36,7 @@ import (
 // PayloadWriterInterface abstracts PayloadWriter
 type PayloadWriterInterface interface {
 	AddDataToPayload(msgs interface{}, dim ...int) error
+	AddNullableDataToPayload(msgs interface{}, validData []bool) error
 	AddBoolToPayload(msgs []bool) error
 	AddByteToPayload(msgs []byte) error
 	AddInt8ToPayload(msgs []int8) error
@@ -68,6 +69,7 @@ type PayloadReaderInterface interface {
 	GetStringFromPayload() ([]string, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
+	GetValidDataFromPayload() ([]bool, error)
 	GetPayloadLengthFromReader() (int, error)
 	ReleasePayloadReader()
 	Close()
@@ -174,6 +176,99 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 	}
 }
 
+// AddNullableDataToPayload adds scalar @msgs into payload, the rows whose @validData is false are added as null
+func (w *PayloadWriter) AddNullableDataToPayload(msgs interface{}, validData []bool) error {
+	var values unsafe.Pointer
+	var length int
+	switch w.colType {
+	case schemapb.DataType_Bool:
+		val, ok := msgs.([]bool)
+		if !ok {
+			return errors.New("incorrect data type")
+		}
+		values, length = slicePointer(val)
+	case schemapb.DataType_Int8:
+		val, ok := msgs.([]int8)
+		if !ok {
+			return errors.New("incorrect data type")
+		}
+		values, length = slicePointer(val)
+	case schemapb.DataType_Int16:
+		val, ok := msgs.([]int16)
+		if !ok {
+			return errors.New("incorrect data type")
+		}
+		values, length = slicePointer(val)
+	case schemapb.DataType_Int32:
+		val, ok := msgs.([]int32)
+		if !ok {
+			return errors.New("incorrect data type")
+		}
+		values, length = slicePointer(val)
+	case schemapb.DataType_Int64:
+		val, ok := msgs.([]int64)
+		if !ok {
+			return errors.New("incorrect data type")
+		}
+		values, length = slicePointer(val)
+	case schemapb.DataType_Float:
+		val, ok := msgs.([]float32)
+		if !ok {
+			return errors.New("incorrect data type")
+		}
+		values, length = slicePointer(val)
+	case schemapb.DataType_Double:
+		val, ok := msgs.([]float64)
+		if !ok {
+			return errors.New("incorrect data type")
+		}
+		values, length = slicePointer(val)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		val, ok := msgs.([]string)
+		if !ok {
+			return errors.New("incorrect data type")
+		}
+		if len(val) != len(validData) {
+			return fmt.Errorf("length of valid data %d mismatch the length of msgs %d", len(validData), len(val))
+		}
+		for i, msg := range val {
+			if validData[i] {
+				if err := w.AddOneStringToPayload(msg); err != nil {
+					return err
+				}
+				continue
+			}
+			// the C.AddOneStringToPayload appends null when the string is nil
+			status := C.AddOneStringToPayload(w.payloadWriterPtr, nil, C.int(-1))
+			if err := HandleCStatus(&status, "AddOneStringToPayload failed"); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("data type %s is not nullable", w.colType.String())
+	}
+
+	if length <= 0 {
+		return errors.New("can't add empty msgs into payload")
+	}
+	if length != len(validData) {
+		return fmt.Errorf("length of valid data %d mismatch the length of msgs %d", len(validData), length)
+	}
+
+	cValidData := (*C.bool)(unsafe.Pointer(&validData[0]))
+	status := C.AddNullableValuesToPayload(w.payloadWriterPtr, (*C.uint8_t)(values), cValidData, C.int(length))
+	return HandleCStatus(&status, "AddNullableValuesToPayload failed")
+}
+
+// slicePointer returns the pointer to the first element and the length of @s
+func slicePointer[T any](s []T) (unsafe.Pointer, int) {
+	if len(s) == 0 {
+		return nil, 0
+	}
+	return unsafe.Pointer(&s[0]), len(s)
+}
+
 // AddBoolToPayload adds @msgs into payload
 func (w *PayloadWriter) AddBoolToPayload(msgs []bool) error {
 	length := len(msgs)
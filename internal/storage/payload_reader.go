@@ -302,6 +302,37 @@ func (r *PayloadReader) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return ret, dim, nil
 }
 
+// GetValidDataFromPayload returns the validity of each row, a row is null if it's false.
+func (r *PayloadReader) GetValidDataFromPayload() ([]bool, error) {
+	validData := make([]bool, r.numRows)
+	var rowsRead int64
+	var err error
+	switch r.colType {
+	case schemapb.DataType_Bool:
+		rowsRead, err = readDataFromAllRowGroups[bool, *file.BooleanColumnChunkReader](r.reader, make([]bool, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		rowsRead, err = readDataFromAllRowGroups[int32, *file.Int32ColumnChunkReader](r.reader, make([]int32, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_Int64:
+		rowsRead, err = readDataFromAllRowGroups[int64, *file.Int64ColumnChunkReader](r.reader, make([]int64, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_Float:
+		rowsRead, err = readDataFromAllRowGroups[float32, *file.Float32ColumnChunkReader](r.reader, make([]float32, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_Double:
+		rowsRead, err = readDataFromAllRowGroups[float64, *file.Float64ColumnChunkReader](r.reader, make([]float64, r.numRows), validData, 0, r.numRows)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		rowsRead, err = readDataFromAllRowGroups[parquet.ByteArray, *file.ByteArrayColumnChunkReader](r.reader, make([]parquet.ByteArray, r.numRows), validData, 0, r.numRows)
+	default:
+		return nil, fmt.Errorf("failed to get valid data from datatype %v", r.colType.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if rowsRead != r.numRows {
+		return nil, fmt.Errorf("expect %d rows, but got rowsRead = %d", r.numRows, rowsRead)
+	}
+	return validData, nil
+}
+
 func (r *PayloadReader) GetPayloadLengthFromReader() (int, error) {
 	return int(r.numRows), nil
 }
@@ -313,9 +344,18 @@ func (r *PayloadReader) Close() {
 
 // ReadDataFromAllRowGroups iterates all row groups of file.Reader, and convert column to E.
 // then calls ReadBatch with provided parameters.
+// Null rows of nullable column are filled with zero value of T.
 func ReadDataFromAllRowGroups[T any, E interface {
 	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
 }](reader *file.Reader, values []T, columnIdx int, numRows int64) (int64, error) {
+	return readDataFromAllRowGroups[T, E](reader, values, nil, columnIdx, numRows)
+}
+
+// readDataFromAllRowGroups works like ReadDataFromAllRowGroups, and fills validData
+// with the validity of each row if validData is not nil.
+func readDataFromAllRowGroups[T any, E interface {
+	ReadBatch(int64, []T, []int16, []int16) (int64, int, error)
+}](reader *file.Reader, values []T, validData []bool, columnIdx int, numRows int64) (int64, error) {
 	var offset int64
 
 	for i := 0; i < reader.NumRowGroups(); i++ {
@@ -329,12 +369,41 @@ func ReadDataFromAllRowGroups[T any, E interface {
 			return -1, fmt.Errorf("expect type %T, but got %T", *new(E), column)
 		}
 
-		_, valuesRead, err := cReader.ReadBatch(numRows, values[offset:], nil, nil)
+		maxDefLevel := column.Descriptor().MaxDefinitionLevel()
+		if maxDefLevel == 0 {
+			_, valuesRead, err := cReader.ReadBatch(numRows, values[offset:], nil, nil)
+			if err != nil {
+				return -1, err
+			}
+			for j := offset; validData != nil && j < offset+int64(valuesRead); j++ {
+				validData[j] = true
+			}
+			offset += int64(valuesRead)
+			continue
+		}
+
+		defLevels := make([]int16, numRows-offset)
+		rowsRead, valuesRead, err := cReader.ReadBatch(numRows-offset, values[offset:], defLevels, nil)
 		if err != nil {
 			return -1, err
 		}
 
-		offset += int64(valuesRead)
+		// values of nullable column are read densely, move them to the positions of valid rows
+		next := valuesRead - 1
+		for j := rowsRead - 1; j >= 0; j-- {
+			valid := defLevels[j] == maxDefLevel
+			if valid {
+				values[offset+j] = values[offset+int64(next)]
+				next--
+			} else {
+				values[offset+j] = *new(T)
+			}
+			if validData != nil {
+				validData[offset+j] = valid
+			}
+		}
+
+		offset += rowsRead
 	}
 
 	return offset, nil
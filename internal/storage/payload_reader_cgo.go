@@ -293,6 +293,11 @@ func (r *PayloadReaderCgo) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return slice, int(cDim), nil
 }
 
+// GetValidDataFromPayload is not supported by cgo payload reader
+func (r *PayloadReaderCgo) GetValidDataFromPayload() ([]bool, error) {
+	return nil, errors.New("valid data is not supported by cgo payload reader")
+}
+
 func (r *PayloadReaderCgo) GetPayloadLengthFromReader() (int, error) {
 	length := C.GetPayloadLengthFromReader(r.payloadReaderPtr)
 	return int(length), nil
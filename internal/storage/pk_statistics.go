@@ -17,19 +17,104 @@
 package storage
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/bits-and-blooms/bloom/v3"
+	"go.uber.org/zap"
+
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+)
+
+const (
+	// pkStatsLoadTimeout bounds the lazy load of the bloom filter blocks
+	pkStatsLoadTimeout = 30 * time.Second
+	// pkStatsLoadRetryInterval is the interval to retry a failed lazy load, the pks are taken as existing meanwhile
+	pkStatsLoadRetryInterval = 10 * time.Second
 )
 
 // pkStatistics contains pk field statistic information
 type PkStatistics struct {
-	PkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
+	PkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment, the block new pks are added to
 	MinPK    PrimaryKey         //	minimal pk value, shortcut for checking whether a pk is inside this segment
 	MaxPK    PrimaryKey         //  maximal pk value, same above
+
+	Blocks []*bloom.BloomFilter // full bloom filter blocks, or the blocks loaded from stats log
+	Params BloomFilterParams    // parameters of new bloom filter blocks
+	numPKs uint                 // number of pks added to PkFilter
+
+	loadMu       sync.Mutex
+	load         func(ctx context.Context) ([]*bloom.BloomFilter, error) // loads Blocks lazily on the first test if not nil
+	loadErr      error
+	lastLoadTime time.Time
+}
+
+// NewPkStatistics creates an empty PkStatistics, whose bloom filter blocks are created with @params.
+func NewPkStatistics(params BloomFilterParams) *PkStatistics {
+	return &PkStatistics{Params: params}
+}
+
+// NewPkStatisticsFromStats creates PkStatistics with the bloom filter blocks of the stats log.
+func NewPkStatisticsFromStats(stats *PrimaryKeyStats) *PkStatistics {
+	return &PkStatistics{
+		MinPK:  stats.MinPk,
+		MaxPK:  stats.MaxPk,
+		Blocks: stats.BloomFilters(),
+	}
+}
+
+// LoadPkStatistics reads the pk stats from the stats log @path, the bloom filter blocks of binary stats logs are
+// not read until a pk in the range of the segment is tested.
+func LoadPkStatistics(ctx context.Context, cm ChunkManager, path string) (*PkStatistics, error) {
+	prefix, err := cm.ReadAt(ctx, path, 0, binaryPkStatsPrefixSize)
+	if err != nil {
+		return nil, err
+	}
+	headerSize, ok := parseBinaryPkStatsPrefix(prefix)
+	if !ok {
+		// json stats log
+		value, err := cm.Read(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		stats, err := DeserializeStats([]*Blob{{Value: value}})
+		if err != nil {
+			return nil, err
+		}
+		if len(stats) == 0 {
+			return nil, fmt.Errorf("no pk stats in stats log %s", path)
+		}
+		return NewPkStatisticsFromStats(stats[0]), nil
+	}
+
+	headerBytes, err := cm.ReadAt(ctx, path, binaryPkStatsPrefixSize, headerSize)
+	if err != nil {
+		return nil, err
+	}
+	header, err := parseBinaryPkStatsHeader(headerBytes)
+	if err != nil {
+		return nil, err
+	}
+	var blocksSize int64
+	for _, size := range header.Blocks {
+		blocksSize += size
+	}
+	return &PkStatistics{
+		MinPK: header.Stats.MinPk,
+		MaxPK: header.Stats.MaxPk,
+		load: func(ctx context.Context) ([]*bloom.BloomFilter, error) {
+			data, err := cm.ReadAt(ctx, path, binaryPkStatsPrefixSize+headerSize, blocksSize)
+			if err != nil {
+				return nil, err
+			}
+			return decodeBloomFilterBlocks(data, header.Blocks)
+		},
+	}, nil
 }
 
 // update set pk min/max value if input value is beyond former range.
@@ -52,27 +137,57 @@ func (st *PkStatistics) UpdateMinMax(pk PrimaryKey) error {
 	return nil
 }
 
+// activeFilter returns the bloom filter block to add a new pk to, a new block is appended if the current one is full.
+func (st *PkStatistics) activeFilter() *bloom.BloomFilter {
+	if st.PkFilter != nil && (st.Params.Capacity == 0 || st.numPKs < st.Params.Capacity) {
+		return st.PkFilter
+	}
+	if st.PkFilter != nil {
+		st.Blocks = append(st.Blocks, st.PkFilter)
+	}
+	if st.Params.Capacity == 0 {
+		st.Params = DefaultBloomFilterParams()
+	}
+	st.PkFilter = bloom.NewWithEstimates(st.Params.Capacity, st.Params.FalsePositive)
+	st.numPKs = 0
+	return st.PkFilter
+}
+
+// AddPK adds @pk into the range and the bloom filter.
+func (st *PkStatistics) AddPK(pk PrimaryKey) error {
+	err := st.UpdateMinMax(pk)
+	if err != nil {
+		return err
+	}
+	switch pk := pk.(type) {
+	case *Int64PrimaryKey:
+		buf := make([]byte, 8)
+		common.Endian.PutUint64(buf, uint64(pk.Value))
+		st.activeFilter().Add(buf)
+	case *VarCharPrimaryKey:
+		st.activeFilter().AddString(pk.Value)
+	default:
+		return fmt.Errorf("invalid data type for primary key: %T", pk)
+	}
+	st.numPKs++
+	return nil
+}
+
 func (st *PkStatistics) UpdatePKRange(ids FieldData) error {
 	switch pks := ids.(type) {
 	case *Int64FieldData:
-		buf := make([]byte, 8)
 		for _, pk := range pks.Data {
-			id := NewInt64PrimaryKey(pk)
-			err := st.UpdateMinMax(id)
+			err := st.AddPK(NewInt64PrimaryKey(pk))
 			if err != nil {
 				return err
 			}
-			common.Endian.PutUint64(buf, uint64(pk))
-			st.PkFilter.Add(buf)
 		}
 	case *StringFieldData:
 		for _, pk := range pks.Data {
-			id := NewVarCharPrimaryKey(pk)
-			err := st.UpdateMinMax(id)
+			err := st.AddPK(NewVarCharPrimaryKey(pk))
 			if err != nil {
 				return err
 			}
-			st.PkFilter.AddString(pk)
 		}
 	default:
 		return fmt.Errorf("invalid data type for primary key: %T", ids)
@@ -80,9 +195,46 @@ func (st *PkStatistics) UpdatePKRange(ids FieldData) error {
 	return nil
 }
 
+// loadBlocks loads the bloom filter blocks if they are loaded lazily,
+// a failed load is retried after pkStatsLoadRetryInterval.
+func (st *PkStatistics) loadBlocks() error {
+	st.loadMu.Lock()
+	defer st.loadMu.Unlock()
+	if st.load == nil {
+		return nil
+	}
+	if st.loadErr != nil && time.Since(st.lastLoadTime) < pkStatsLoadRetryInterval {
+		return st.loadErr
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pkStatsLoadTimeout)
+	defer cancel()
+	blocks, err := st.load(ctx)
+	st.lastLoadTime = time.Now()
+	if err != nil {
+		log.Warn("failed to load bloom filter blocks", zap.Error(err))
+		st.loadErr = err
+		return err
+	}
+	st.Blocks = append(st.Blocks, blocks...)
+	st.load, st.loadErr = nil, nil
+	return nil
+}
+
+// filters returns all the bloom filter blocks, the blocks are loaded first if they are loaded lazily.
+func (st *PkStatistics) filters() ([]*bloom.BloomFilter, error) {
+	if err := st.loadBlocks(); err != nil {
+		return nil, err
+	}
+	if st.PkFilter == nil {
+		return st.Blocks, nil
+	}
+	return append([]*bloom.BloomFilter{st.PkFilter}, st.Blocks...), nil
+}
+
 func (st *PkStatistics) PkExist(pk PrimaryKey) bool {
 	// empty pkStatics
-	if st.MinPK == nil || st.MaxPK == nil || st.PkFilter == nil {
+	if st.MinPK == nil || st.MaxPK == nil {
 		return false
 	}
 	// check pk range first, ugly but key it for now
@@ -90,16 +242,35 @@ func (st *PkStatistics) PkExist(pk PrimaryKey) bool {
 		return false
 	}
 
+	filters, err := st.filters()
+	if err != nil {
+		// no idea, just make it as false positive
+		return true
+	}
+	if len(filters) == 0 {
+		return false
+	}
+
 	// if in range, check bloom filter
 	switch pk.Type() {
 	case schemapb.DataType_Int64:
 		buf := make([]byte, 8)
 		int64Pk := pk.(*Int64PrimaryKey)
 		common.Endian.PutUint64(buf, uint64(int64Pk.Value))
-		return st.PkFilter.Test(buf)
+		for _, filter := range filters {
+			if filter.Test(buf) {
+				return true
+			}
+		}
+		return false
 	case schemapb.DataType_VarChar:
 		varCharPk := pk.(*VarCharPrimaryKey)
-		return st.PkFilter.TestString(varCharPk.Value)
+		for _, filter := range filters {
+			if filter.TestString(varCharPk.Value) {
+				return true
+			}
+		}
+		return false
 	default:
 		//TODO::
 	}
@@ -130,7 +130,11 @@ func printBinlogFile(filename string) error {
 			fmt.Printf("\tStartTimestamp: %v\n", physical)
 			physical, _ = tsoutil.ParseTS(evd.EndTimestamp)
 			fmt.Printf("\tEndTimestamp: %v\n", physical)
-			if err := printPayloadValues(r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
+			if r.Extras[deltaLogVersionKey] == deltaLogVersion2 {
+				if err := printDeltaPayloadValues(r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
+					return err
+				}
+			} else if err := printPayloadValues(r.descriptorEvent.descriptorEventData.PayloadDataType, event.PayloadReaderInterface); err != nil {
 				return err
 			}
 		case CreateCollectionEventType:
@@ -218,6 +222,18 @@ func printBinlogFile(filename string) error {
 	return nil
 }
 
+func printDeltaPayloadValues(pkType schemapb.DataType, reader PayloadReaderInterface) error {
+	fmt.Println("\tpayload values:")
+	pks, tss, err := readDeltaPayload(reader, pkType)
+	if err != nil {
+		return err
+	}
+	for i := range pks {
+		fmt.Printf("\t\t%d : pk = %v, ts = %d\n", i, pks[i].GetValue(), tss[i])
+	}
+	return nil
+}
+
 func printPayloadValues(colType schemapb.DataType, reader PayloadReaderInterface) error {
 	fmt.Println("\tpayload values:")
 	switch colType {
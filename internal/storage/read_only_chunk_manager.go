@@ -0,0 +1,58 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrReadOnly is returned by the write and remove operations of a ReadOnlyChunkManager.
+var ErrReadOnly = errors.New("chunk manager is read-only")
+
+// ReadOnlyChunkManager wraps a ChunkManager and rejects all the write and remove operations, it is used to read
+// files from the storages that do not belong to Milvus, e.g. the source of an import task.
+type ReadOnlyChunkManager struct {
+	ChunkManager
+}
+
+var _ ChunkManager = (*ReadOnlyChunkManager)(nil)
+
+// NewReadOnlyChunkManager wraps @cm with a ReadOnlyChunkManager.
+func NewReadOnlyChunkManager(cm ChunkManager) *ReadOnlyChunkManager {
+	return &ReadOnlyChunkManager{ChunkManager: cm}
+}
+
+func (rcm *ReadOnlyChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	return ErrReadOnly
+}
+
+func (rcm *ReadOnlyChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	return ErrReadOnly
+}
+
+func (rcm *ReadOnlyChunkManager) Remove(ctx context.Context, filePath string) error {
+	return ErrReadOnly
+}
+
+func (rcm *ReadOnlyChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	return ErrReadOnly
+}
+
+func (rcm *ReadOnlyChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	return ErrReadOnly
+}
@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyChunkManager(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	local := NewLocalChunkManager(RootPath(rootPath))
+	filePath := path.Join(rootPath, "a")
+	assert.NoError(t, local.Write(ctx, filePath, []byte("hello")))
+
+	cm := NewReadOnlyChunkManager(local)
+	assert.Equal(t, rootPath, cm.RootPath())
+	content, err := cm.Read(ctx, filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("hello"), content)
+	size, err := cm.Size(ctx, filePath)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), size)
+
+	assert.ErrorIs(t, cm.Write(ctx, path.Join(rootPath, "b"), []byte("world")), ErrReadOnly)
+	assert.ErrorIs(t, cm.MultiWrite(ctx, map[string][]byte{path.Join(rootPath, "b"): []byte("world")}), ErrReadOnly)
+	assert.ErrorIs(t, cm.Remove(ctx, filePath), ErrReadOnly)
+	assert.ErrorIs(t, cm.MultiRemove(ctx, []string{filePath}), ErrReadOnly)
+	assert.ErrorIs(t, cm.RemoveWithPrefix(ctx, rootPath), ErrReadOnly)
+
+	exist, err := local.Exist(ctx, filePath)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+	exist, err = local.Exist(ctx, path.Join(rootPath, "b"))
+	assert.NoError(t, err)
+	assert.False(t, exist)
+}
@@ -17,19 +17,74 @@
 package storage
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
 )
 
 const (
-	// TODO silverxia maybe need set from config
+	// BloomFilterSize and MaxBloomFalsePositive are the bloom filter parameters used if not configured
 	BloomFilterSize       uint    = 100000
 	MaxBloomFalsePositive float64 = 0.005
 )
 
+// BloomFilterParams are the parameters of the primary key bloom filter blocks of a collection
+type BloomFilterParams struct {
+	// Capacity is the max number of pks of a bloom filter block
+	Capacity uint
+	// FalsePositive is the false positive rate of a bloom filter block
+	FalsePositive float64
+}
+
+// DefaultBloomFilterParams returns the bloom filter parameters in config.
+func DefaultBloomFilterParams() BloomFilterParams {
+	params := BloomFilterParams{
+		Capacity:      BloomFilterSize,
+		FalsePositive: MaxBloomFalsePositive,
+	}
+	cfg := &paramtable.Get().CommonCfg
+	if cfg.BloomFilterSize > 0 {
+		params.Capacity = cfg.BloomFilterSize
+	}
+	if cfg.MaxBloomFalsePositive > 0 && cfg.MaxBloomFalsePositive < 1 {
+		params.FalsePositive = cfg.MaxBloomFalsePositive
+	}
+	return params
+}
+
+// GetBloomFilterParams returns the bloom filter parameters of the collection with @schema, which are
+// the type params of the primary key field, or the ones in config if not specified.
+func GetBloomFilterParams(schema *schemapb.CollectionSchema) BloomFilterParams {
+	params := DefaultBloomFilterParams()
+	for _, field := range schema.GetFields() {
+		if !field.GetIsPrimaryKey() {
+			continue
+		}
+		for _, param := range field.GetTypeParams() {
+			switch param.GetKey() {
+			case common.BloomFilterCapacityKey:
+				capacity, err := strconv.ParseUint(param.GetValue(), 10, 64)
+				if err == nil && capacity > 0 {
+					params.Capacity = uint(capacity)
+				}
+			case common.BloomFilterFPRKey:
+				fpr, err := strconv.ParseFloat(param.GetValue(), 64)
+				if err == nil && fpr > 0 && fpr < 1 {
+					params.FalsePositive = fpr
+				}
+			}
+		}
+	}
+	return params
+}
+
 // PrimaryKeyStats contains statistics data for pk column
 type PrimaryKeyStats struct {
 	FieldID int64              `json:"fieldID"`
@@ -39,6 +94,16 @@ type PrimaryKeyStats struct {
 	PkType  int64              `json:"pkType"`
 	MaxPk   PrimaryKey         `json:"maxPk"`
 	MinPk   PrimaryKey         `json:"minPk"`
+	// BFBlocks are the bloom filter blocks after BF, only the binary stats log has more than one block
+	BFBlocks []*bloom.BloomFilter `json:"-"`
+}
+
+// BloomFilters returns all the bloom filter blocks of stats.
+func (stats *PrimaryKeyStats) BloomFilters() []*bloom.BloomFilter {
+	if stats.BF == nil {
+		return stats.BFBlocks
+	}
+	return append([]*bloom.BloomFilter{stats.BF}, stats.BFBlocks...)
 }
 
 // UnmarshalJSON unmarshal bytes to PrimaryKeyStats
@@ -193,6 +258,173 @@ func (sw *StatsWriter) GeneratePrimaryKeyStats(fieldID int64, pkType schemapb.Da
 	return nil
 }
 
+// GenerateBinaryPrimaryKeyStats writes the primary key stats of @msgs with @fieldID to @buffer in the binary format,
+// the bloom filter is split into blocks by the capacity of @params.
+func (sw *StatsWriter) GenerateBinaryPrimaryKeyStats(fieldID int64, pkType schemapb.DataType, msgs FieldData, params BloomFilterParams) (*PrimaryKeyStats, error) {
+	stats := &PrimaryKeyStats{
+		FieldID: fieldID,
+		PkType:  int64(pkType),
+	}
+	rowNum := uint(msgs.RowNum())
+	if rowNum == 0 {
+		return nil, errors.New("no primary key to generate stats")
+	}
+	capacity := params.Capacity
+	if capacity == 0 || capacity > rowNum {
+		capacity = rowNum
+	}
+
+	var blocks []*bloom.BloomFilter
+	var current *bloom.BloomFilter
+	var count uint
+	// nextBlock returns the block to add the next pk to, blocks are sized by the remaining pks
+	nextBlock := func() *bloom.BloomFilter {
+		if current == nil || count%capacity == 0 {
+			size := capacity
+			if rowNum-count < size {
+				size = rowNum - count
+			}
+			current = bloom.NewWithEstimates(size, params.FalsePositive)
+			blocks = append(blocks, current)
+		}
+		count++
+		return current
+	}
+	switch data := msgs.(type) {
+	case *Int64FieldData:
+		b := make([]byte, 8)
+		for _, int64Value := range data.Data {
+			stats.updatePk(NewInt64PrimaryKey(int64Value))
+			common.Endian.PutUint64(b, uint64(int64Value))
+			nextBlock().Add(b)
+		}
+	case *StringFieldData:
+		for _, str := range data.Data {
+			stats.updatePk(NewVarCharPrimaryKey(str))
+			nextBlock().AddString(str)
+		}
+	default:
+		return nil, fmt.Errorf("invalid data type for primary key: %T", msgs)
+	}
+	stats.BF, stats.BFBlocks = blocks[0], blocks[1:]
+
+	b, err := encodeBinaryPkStats(stats)
+	if err != nil {
+		return nil, err
+	}
+	sw.buffer = b
+	return stats, nil
+}
+
+// binaryPkStatsMagic is the beginning of the binary stats log, the layout is
+// magic | header size (uint32) | header | bloom filter blocks
+var binaryPkStatsMagic = []byte("MPKS")
+
+// binaryPkStatsPrefixSize is the size of magic and header size
+const binaryPkStatsPrefixSize = 8
+
+// binaryPkStatsHeader is the json encoded header of the binary stats log
+type binaryPkStatsHeader struct {
+	// Stats contains the stats without bloom filters
+	Stats *PrimaryKeyStats `json:"stats"`
+	// Blocks are the encoded sizes of the bloom filter blocks
+	Blocks []int64 `json:"blocks"`
+}
+
+func encodeBinaryPkStats(stats *PrimaryKeyStats) ([]byte, error) {
+	filters := stats.BloomFilters()
+	blockBuffer := &bytes.Buffer{}
+	header := &binaryPkStatsHeader{
+		Stats: &PrimaryKeyStats{
+			FieldID: stats.FieldID,
+			PkType:  stats.PkType,
+			MaxPk:   stats.MaxPk,
+			MinPk:   stats.MinPk,
+		},
+		Blocks: make([]int64, 0, len(filters)),
+	}
+	if pk, ok := stats.MaxPk.(*Int64PrimaryKey); ok {
+		header.Stats.Max = pk.Value
+	}
+	if pk, ok := stats.MinPk.(*Int64PrimaryKey); ok {
+		header.Stats.Min = pk.Value
+	}
+	for _, filter := range filters {
+		n, err := filter.WriteTo(blockBuffer)
+		if err != nil {
+			return nil, err
+		}
+		header.Blocks = append(header.Blocks, n)
+	}
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := make([]byte, binaryPkStatsPrefixSize, binaryPkStatsPrefixSize+len(headerBytes)+blockBuffer.Len())
+	copy(buffer, binaryPkStatsMagic)
+	common.Endian.PutUint32(buffer[len(binaryPkStatsMagic):], uint32(len(headerBytes)))
+	buffer = append(buffer, headerBytes...)
+	return append(buffer, blockBuffer.Bytes()...), nil
+}
+
+// parseBinaryPkStatsPrefix returns the header size of the binary stats log, ok is false if @prefix is not the beginning of one.
+func parseBinaryPkStatsPrefix(prefix []byte) (headerSize int64, ok bool) {
+	if len(prefix) < binaryPkStatsPrefixSize || !bytes.Equal(prefix[:len(binaryPkStatsMagic)], binaryPkStatsMagic) {
+		return 0, false
+	}
+	return int64(common.Endian.Uint32(prefix[len(binaryPkStatsMagic):])), true
+}
+
+func parseBinaryPkStatsHeader(data []byte) (*binaryPkStatsHeader, error) {
+	header := &binaryPkStatsHeader{}
+	if err := json.Unmarshal(data, header); err != nil {
+		return nil, err
+	}
+	if header.Stats == nil {
+		return nil, errors.New("no primary key stats in stats log header")
+	}
+	return header, nil
+}
+
+// decodeBloomFilterBlocks decodes the bloom filter blocks with encoded @sizes from @data.
+func decodeBloomFilterBlocks(data []byte, sizes []int64) ([]*bloom.BloomFilter, error) {
+	reader := bytes.NewReader(data)
+	filters := make([]*bloom.BloomFilter, 0, len(sizes))
+	for _, size := range sizes {
+		filter := &bloom.BloomFilter{}
+		n, err := filter.ReadFrom(reader)
+		if err != nil {
+			return nil, err
+		}
+		if n != size {
+			return nil, fmt.Errorf("corrupted bloom filter block, expected size %d, actual %d", size, n)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+func decodeBinaryPkStats(data []byte) (*PrimaryKeyStats, error) {
+	headerSize, ok := parseBinaryPkStatsPrefix(data)
+	if !ok || int64(len(data)) < binaryPkStatsPrefixSize+headerSize {
+		return nil, errors.New("invalid binary primary key stats")
+	}
+	header, err := parseBinaryPkStatsHeader(data[binaryPkStatsPrefixSize : binaryPkStatsPrefixSize+headerSize])
+	if err != nil {
+		return nil, err
+	}
+	filters, err := decodeBloomFilterBlocks(data[binaryPkStatsPrefixSize+headerSize:], header.Blocks)
+	if err != nil {
+		return nil, err
+	}
+	stats := header.Stats
+	if len(filters) > 0 {
+		stats.BF, stats.BFBlocks = filters[0], filters[1:]
+	}
+	return stats, nil
+}
+
 // StatsReader reads stats
 type StatsReader struct {
 	buffer []byte
@@ -203,8 +435,11 @@ func (sr *StatsReader) SetBuffer(buffer []byte) {
 	sr.buffer = buffer
 }
 
-// GetInt64Stats returns buffer as PrimaryKeyStats
+// GetPrimaryKeyStats returns buffer as PrimaryKeyStats, the buffer is either json or binary encoded
 func (sr *StatsReader) GetPrimaryKeyStats() (*PrimaryKeyStats, error) {
+	if _, ok := parseBinaryPkStatsPrefix(sr.buffer); ok {
+		return decodeBinaryPkStats(sr.buffer)
+	}
 	stats := &PrimaryKeyStats{}
 	err := json.Unmarshal(sr.buffer, &stats)
 	if err != nil {
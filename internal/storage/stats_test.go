@@ -17,13 +17,18 @@
 package storage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"testing"
+	"time"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
 )
@@ -156,3 +161,149 @@ func TestStatsWriter_UpgradePrimaryKey(t *testing.T) {
 		assert.True(t, unmarshaledStats.BF.Test(buffer))
 	}
 }
+
+func TestStatsWriter_BinaryPrimaryKey(t *testing.T) {
+	data := &Int64FieldData{
+		Data: make([]int64, 0, 250),
+	}
+	for i := 0; i < 250; i++ {
+		data.Data = append(data.Data, int64(i*2))
+	}
+	sw := &StatsWriter{}
+	stats, err := sw.GenerateBinaryPrimaryKeyStats(common.RowIDField, schemapb.DataType_Int64, data, BloomFilterParams{Capacity: 100, FalsePositive: 0.01})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(stats.BloomFilters()))
+
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	decoded, err := sr.GetPrimaryKeyStats()
+	assert.NoError(t, err)
+	assert.Equal(t, common.RowIDField, int(decoded.FieldID))
+	assert.True(t, decoded.MinPk.EQ(NewInt64PrimaryKey(0)))
+	assert.True(t, decoded.MaxPk.EQ(NewInt64PrimaryKey(498)))
+	assert.Equal(t, 3, len(decoded.BloomFilters()))
+
+	pkStat := NewPkStatisticsFromStats(decoded)
+	for _, id := range data.Data {
+		assert.True(t, pkStat.PkExist(NewInt64PrimaryKey(id)))
+	}
+	assert.False(t, pkStat.PkExist(NewInt64PrimaryKey(500)))
+
+	// varchar
+	strData := &StringFieldData{Data: []string{"b", "a", "c"}}
+	stats, err = sw.GenerateBinaryPrimaryKeyStats(common.RowIDField, schemapb.DataType_VarChar, strData, BloomFilterParams{Capacity: 2, FalsePositive: 0.01})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(stats.BloomFilters()))
+	decodedStats, err := DeserializeStats([]*Blob{{Value: sw.GetBuffer()}})
+	assert.NoError(t, err)
+	assert.True(t, decodedStats[0].MinPk.EQ(NewVarCharPrimaryKey("a")))
+	assert.True(t, decodedStats[0].MaxPk.EQ(NewVarCharPrimaryKey("c")))
+	assert.True(t, NewPkStatisticsFromStats(decodedStats[0]).PkExist(NewVarCharPrimaryKey("c")))
+
+	_, err = sw.GenerateBinaryPrimaryKeyStats(common.RowIDField, schemapb.DataType_Int64, &Int64FieldData{}, DefaultBloomFilterParams())
+	assert.Error(t, err)
+	_, err = sw.GenerateBinaryPrimaryKeyStats(common.RowIDField, schemapb.DataType_Int64, &FloatFieldData{Data: []float32{1}}, DefaultBloomFilterParams())
+	assert.Error(t, err)
+
+	// corrupted
+	buffer := sw.GetBuffer()
+	sr.SetBuffer(buffer[:len(buffer)-1])
+	_, err = sr.GetPrimaryKeyStats()
+	assert.Error(t, err)
+}
+
+func TestGetBloomFilterParams(t *testing.T) {
+	defaultParams := DefaultBloomFilterParams()
+	assert.Less(t, uint(0), defaultParams.Capacity)
+	assert.Less(t, 0.0, defaultParams.FalsePositive)
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, DataType: schemapb.DataType_Int64, TypeParams: []*commonpb.KeyValuePair{{Key: common.BloomFilterCapacityKey, Value: "10"}}},
+			{FieldID: 101, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		},
+	}
+	assert.Equal(t, defaultParams, GetBloomFilterParams(schema))
+
+	schema.Fields[1].TypeParams = []*commonpb.KeyValuePair{
+		{Key: common.BloomFilterCapacityKey, Value: "1000"},
+		{Key: common.BloomFilterFPRKey, Value: "0.0001"},
+	}
+	assert.Equal(t, BloomFilterParams{Capacity: 1000, FalsePositive: 0.0001}, GetBloomFilterParams(schema))
+
+	// invalid values are ignored
+	schema.Fields[1].TypeParams = []*commonpb.KeyValuePair{
+		{Key: common.BloomFilterCapacityKey, Value: "-1"},
+		{Key: common.BloomFilterFPRKey, Value: "2"},
+	}
+	assert.Equal(t, defaultParams, GetBloomFilterParams(schema))
+}
+
+func TestPkStatistics_Blocks(t *testing.T) {
+	pkStat := NewPkStatistics(BloomFilterParams{Capacity: 10, FalsePositive: 0.01})
+	ids := &Int64FieldData{}
+	for i := 0; i < 25; i++ {
+		ids.Data = append(ids.Data, int64(i))
+	}
+	assert.NoError(t, pkStat.UpdatePKRange(ids))
+	assert.Equal(t, 2, len(pkStat.Blocks))
+	assert.NotNil(t, pkStat.PkFilter)
+	for _, id := range ids.Data {
+		assert.True(t, pkStat.PkExist(NewInt64PrimaryKey(id)))
+	}
+	assert.False(t, pkStat.PkExist(NewInt64PrimaryKey(25)))
+
+	assert.Error(t, pkStat.UpdatePKRange(&FloatFieldData{Data: []float32{1}}))
+	assert.False(t, NewPkStatistics(DefaultBloomFilterParams()).PkExist(NewInt64PrimaryKey(1)))
+}
+
+func TestLoadPkStatistics(t *testing.T) {
+	ctx := context.Background()
+	cm := NewLocalChunkManager(RootPath(t.TempDir()))
+
+	data := &Int64FieldData{Data: []int64{1, 3, 5, 7}}
+	sw := &StatsWriter{}
+	_, err := sw.GenerateBinaryPrimaryKeyStats(common.RowIDField, schemapb.DataType_Int64, data, BloomFilterParams{Capacity: 2, FalsePositive: 0.01})
+	require.NoError(t, err)
+	binaryPath := path.Join(cm.RootPath(), "binary")
+	require.NoError(t, cm.Write(ctx, binaryPath, sw.GetBuffer()))
+
+	require.NoError(t, sw.GeneratePrimaryKeyStats(common.RowIDField, schemapb.DataType_Int64, data))
+	jsonPath := path.Join(cm.RootPath(), "json")
+	require.NoError(t, cm.Write(ctx, jsonPath, sw.GetBuffer()))
+
+	pkStat, err := LoadPkStatistics(ctx, cm, binaryPath)
+	require.NoError(t, err)
+	assert.Empty(t, pkStat.Blocks)
+	// out of range, not loaded
+	assert.False(t, pkStat.PkExist(NewInt64PrimaryKey(8)))
+	assert.Empty(t, pkStat.Blocks)
+	assert.True(t, pkStat.PkExist(NewInt64PrimaryKey(5)))
+	assert.Equal(t, 2, len(pkStat.Blocks))
+
+	pkStat, err = LoadPkStatistics(ctx, cm, jsonPath)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(pkStat.Blocks))
+	assert.True(t, pkStat.PkExist(NewInt64PrimaryKey(7)))
+
+	// stats log removed before the blocks are loaded
+	content, err := cm.Read(ctx, binaryPath)
+	require.NoError(t, err)
+	pkStat, err = LoadPkStatistics(ctx, cm, binaryPath)
+	require.NoError(t, err)
+	require.NoError(t, cm.Remove(ctx, binaryPath))
+	assert.True(t, pkStat.PkExist(NewInt64PrimaryKey(4)))
+	assert.Error(t, pkStat.loadErr)
+
+	_, err = LoadPkStatistics(ctx, cm, binaryPath)
+	assert.Error(t, err)
+
+	// the failed load is retried after the retry interval
+	require.NoError(t, cm.Write(ctx, binaryPath, content))
+	assert.True(t, pkStat.PkExist(NewInt64PrimaryKey(4)))
+	assert.Empty(t, pkStat.Blocks)
+	pkStat.lastLoadTime = time.Now().Add(-pkStatsLoadRetryInterval)
+	assert.True(t, pkStat.PkExist(NewInt64PrimaryKey(5)))
+	assert.Equal(t, 2, len(pkStat.Blocks))
+	assert.NoError(t, pkStat.loadErr)
+}
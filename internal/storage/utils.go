@@ -553,6 +553,19 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 		}
 	}
 
+	for _, validData := range msg.GetValidData() {
+		fieldData, ok := idata.Data[validData.GetFieldID()]
+		if !ok {
+			return nil, fmt.Errorf("valid data of field %d not in collection schema", validData.GetFieldID())
+		}
+		if len(validData.GetValidData()) != fieldData.RowNum() {
+			return nil, fmt.Errorf("length of valid data %d mismatch the rows of field %d", len(validData.GetValidData()), validData.GetFieldID())
+		}
+		if err := setValidData(fieldData, validData.GetValidData()); err != nil {
+			return nil, err
+		}
+	}
+
 	return idata, nil
 }
 
@@ -563,6 +576,52 @@ func InsertMsgToInsertData(msg *msgstream.InsertMsg, schema *schemapb.Collection
 	return ColumnBasedInsertMsgToInsertData(msg, schema)
 }
 
+// MergeValidData merges the validity of @srcRows rows into the validity of @dstRows rows,
+// empty validity means all rows are valid.
+func MergeValidData(dst []bool, dstRows int, src []bool, srcRows int) []bool {
+	if len(dst) == 0 && len(src) == 0 {
+		return nil
+	}
+	if len(dst) == 0 {
+		dst = make([]bool, dstRows, dstRows+srcRows)
+		for i := range dst {
+			dst[i] = true
+		}
+	}
+	if len(src) == 0 {
+		for i := 0; i < srcRows; i++ {
+			dst = append(dst, true)
+		}
+		return dst
+	}
+	return append(dst, src...)
+}
+
+// setValidData sets the validity of each row of scalar field data.
+func setValidData(data FieldData, validData []bool) error {
+	switch data := data.(type) {
+	case *BoolFieldData:
+		data.ValidData = validData
+	case *Int8FieldData:
+		data.ValidData = validData
+	case *Int16FieldData:
+		data.ValidData = validData
+	case *Int32FieldData:
+		data.ValidData = validData
+	case *Int64FieldData:
+		data.ValidData = validData
+	case *FloatFieldData:
+		data.ValidData = validData
+	case *DoubleFieldData:
+		data.ValidData = validData
+	case *StringFieldData:
+		data.ValidData = validData
+	default:
+		return fmt.Errorf("field data of type %T is not nullable", data)
+	}
+	return nil
+}
+
 func mergeBoolField(data *InsertData, fid FieldID, field *BoolFieldData) {
 	if _, ok := data.Data[fid]; !ok {
 		fieldData := &BoolFieldData{
@@ -572,6 +631,7 @@ func mergeBoolField(data *InsertData, fid FieldID, field *BoolFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*BoolFieldData)
+	fieldData.ValidData = MergeValidData(fieldData.ValidData, fieldData.RowNum(), field.ValidData, field.RowNum())
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
@@ -585,6 +645,7 @@ func mergeInt8Field(data *InsertData, fid FieldID, field *Int8FieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*Int8FieldData)
+	fieldData.ValidData = MergeValidData(fieldData.ValidData, fieldData.RowNum(), field.ValidData, field.RowNum())
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
@@ -598,6 +659,7 @@ func mergeInt16Field(data *InsertData, fid FieldID, field *Int16FieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*Int16FieldData)
+	fieldData.ValidData = MergeValidData(fieldData.ValidData, fieldData.RowNum(), field.ValidData, field.RowNum())
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
@@ -611,6 +673,7 @@ func mergeInt32Field(data *InsertData, fid FieldID, field *Int32FieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*Int32FieldData)
+	fieldData.ValidData = MergeValidData(fieldData.ValidData, fieldData.RowNum(), field.ValidData, field.RowNum())
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
@@ -624,6 +687,7 @@ func mergeInt64Field(data *InsertData, fid FieldID, field *Int64FieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*Int64FieldData)
+	fieldData.ValidData = MergeValidData(fieldData.ValidData, fieldData.RowNum(), field.ValidData, field.RowNum())
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
@@ -637,6 +701,7 @@ func mergeFloatField(data *InsertData, fid FieldID, field *FloatFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*FloatFieldData)
+	fieldData.ValidData = MergeValidData(fieldData.ValidData, fieldData.RowNum(), field.ValidData, field.RowNum())
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
@@ -650,6 +715,7 @@ func mergeDoubleField(data *InsertData, fid FieldID, field *DoubleFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*DoubleFieldData)
+	fieldData.ValidData = MergeValidData(fieldData.ValidData, fieldData.RowNum(), field.ValidData, field.RowNum())
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
@@ -663,6 +729,7 @@ func mergeStringField(data *InsertData, fid FieldID, field *StringFieldData) {
 		data.Data[fid] = fieldData
 	}
 	fieldData := data.Data[fid].(*StringFieldData)
+	fieldData.ValidData = MergeValidData(fieldData.ValidData, fieldData.RowNum(), field.ValidData, field.RowNum())
 	fieldData.Data = append(fieldData.Data, field.Data...)
 	fieldData.NumRows[0] += int64(field.RowNum())
 }
@@ -743,6 +810,141 @@ func MergeInsertData(datas ...*InsertData) *InsertData {
 	return ret
 }
 
+// GenDefaultFieldData generates numRows rows for a field which doesn't exist in the stored data, e.g. segments
+// flushed before the field was added to the collection. The rows take the default value of the field if declared,
+// otherwise they are null.
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (FieldData, error) {
+	defaultValue, ok, err := typeutil.GetDefaultValue(field)
+	if err != nil {
+		return nil, err
+	}
+	if !ok && !typeutil.IsFieldNullable(field) {
+		return nil, fmt.Errorf("field %s is neither nullable nor has default value", field.GetName())
+	}
+
+	var data FieldData
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		values := make([]bool, numRows)
+		if ok {
+			for i := range values {
+				values[i] = defaultValue.(bool)
+			}
+		}
+		data = &BoolFieldData{NumRows: []int64{int64(numRows)}, Data: values}
+	case schemapb.DataType_Int8:
+		values := make([]int8, numRows)
+		if ok {
+			for i := range values {
+				values[i] = defaultValue.(int8)
+			}
+		}
+		data = &Int8FieldData{NumRows: []int64{int64(numRows)}, Data: values}
+	case schemapb.DataType_Int16:
+		values := make([]int16, numRows)
+		if ok {
+			for i := range values {
+				values[i] = defaultValue.(int16)
+			}
+		}
+		data = &Int16FieldData{NumRows: []int64{int64(numRows)}, Data: values}
+	case schemapb.DataType_Int32:
+		values := make([]int32, numRows)
+		if ok {
+			for i := range values {
+				values[i] = defaultValue.(int32)
+			}
+		}
+		data = &Int32FieldData{NumRows: []int64{int64(numRows)}, Data: values}
+	case schemapb.DataType_Int64:
+		values := make([]int64, numRows)
+		if ok {
+			for i := range values {
+				values[i] = defaultValue.(int64)
+			}
+		}
+		data = &Int64FieldData{NumRows: []int64{int64(numRows)}, Data: values}
+	case schemapb.DataType_Float:
+		values := make([]float32, numRows)
+		if ok {
+			for i := range values {
+				values[i] = defaultValue.(float32)
+			}
+		}
+		data = &FloatFieldData{NumRows: []int64{int64(numRows)}, Data: values}
+	case schemapb.DataType_Double:
+		values := make([]float64, numRows)
+		if ok {
+			for i := range values {
+				values[i] = defaultValue.(float64)
+			}
+		}
+		data = &DoubleFieldData{NumRows: []int64{int64(numRows)}, Data: values}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		values := make([]string, numRows)
+		if ok {
+			for i := range values {
+				values[i] = defaultValue.(string)
+			}
+		}
+		data = &StringFieldData{NumRows: []int64{int64(numRows)}, Data: values}
+	default:
+		return nil, fmt.Errorf("cannot generate default data for field %s of type %s", field.GetName(), field.GetDataType().String())
+	}
+
+	if !ok {
+		if err := setValidData(data, make([]bool, numRows)); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// FillMissingRows prepends rows of the default value or null to the field data until it reaches numRows rows.
+// Rows written before a field was added to the collection are always the leading rows of a segment.
+func FillMissingRows(field *schemapb.FieldSchema, data FieldData, numRows int) (FieldData, error) {
+	missing := numRows - data.RowNum()
+	if missing <= 0 {
+		return data, nil
+	}
+	defaults, err := GenDefaultFieldData(field, missing)
+	if err != nil {
+		return nil, err
+	}
+	ret := &InsertData{Data: make(map[FieldID]FieldData)}
+	MergeFieldData(ret, field.GetFieldID(), defaults)
+	MergeFieldData(ret, field.GetFieldID(), data)
+	return ret.Data[field.GetFieldID()], nil
+}
+
+// FillMissingFields completes the user fields of the insert data to the row count of the timestamp field,
+// fields absent from the insert data or shorter than it were added to the collection after the rows were written.
+func FillMissingFields(schema *schemapb.CollectionSchema, data *InsertData) error {
+	tsData, err := GetTimestampFromInsertData(data)
+	if err != nil {
+		return err
+	}
+	numRows := tsData.RowNum()
+	for _, field := range schema.GetFields() {
+		// only nullable or defaulted fields can be added to an existing collection
+		_, hasDefault, _ := typeutil.GetDefaultValue(field)
+		if field.GetFieldID() < common.StartOfUserFieldID || !(hasDefault || typeutil.IsFieldNullable(field)) {
+			continue
+		}
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			fieldData, err = GenDefaultFieldData(field, numRows)
+		} else if fieldData.RowNum() < numRows {
+			fieldData, err = FillMissingRows(field, fieldData, numRows)
+		}
+		if err != nil {
+			return err
+		}
+		data.Data[field.GetFieldID()] = fieldData
+	}
+	return nil
+}
+
 // TODO: string type.
 func GetPkFromInsertData(collSchema *schemapb.CollectionSchema, data *InsertData) (FieldData, error) {
 	helper, err := typeutil.CreateSchemaHelper(collSchema)
@@ -854,165 +1056,175 @@ func FieldDataToBytes(endian binary.ByteOrder, fieldData FieldData) ([]byte, err
 func TransferInsertDataToInsertRecord(insertData *InsertData) (*segcorepb.InsertRecord, error) {
 	insertRecord := &segcorepb.InsertRecord{}
 	for fieldID, rawData := range insertData.Data {
-		var fieldData *schemapb.FieldData
-		switch rawData := rawData.(type) {
-		case *BoolFieldData:
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_Bool,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Scalars{
-					Scalars: &schemapb.ScalarField{
-						Data: &schemapb.ScalarField_BoolData{
-							BoolData: &schemapb.BoolArray{
-								Data: rawData.Data,
-							},
+		fieldData, err := TransferFieldDataToSchemaFieldData(fieldID, rawData)
+		if err != nil {
+			return insertRecord, err
+		}
+
+		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+		insertRecord.NumRows = int64(rawData.RowNum())
+	}
+
+	return insertRecord, nil
+}
+
+// TransferFieldDataToSchemaFieldData converts the data of a field to schemapb.FieldData, the validity of rows is not converted.
+func TransferFieldDataToSchemaFieldData(fieldID FieldID, rawData FieldData) (*schemapb.FieldData, error) {
+	var fieldData *schemapb.FieldData
+	switch rawData := rawData.(type) {
+	case *BoolFieldData:
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_Bool,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BoolData{
+						BoolData: &schemapb.BoolArray{
+							Data: rawData.Data,
 						},
 					},
 				},
-			}
-		case *Int8FieldData:
-			int32Data := make([]int32, len(rawData.Data))
-			for index, v := range rawData.Data {
-				int32Data[index] = int32(v)
-			}
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_Int8,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Scalars{
-					Scalars: &schemapb.ScalarField{
-						Data: &schemapb.ScalarField_IntData{
-							IntData: &schemapb.IntArray{
-								Data: int32Data,
-							},
+			},
+		}
+	case *Int8FieldData:
+		int32Data := make([]int32, len(rawData.Data))
+		for index, v := range rawData.Data {
+			int32Data[index] = int32(v)
+		}
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_Int8,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{
+						IntData: &schemapb.IntArray{
+							Data: int32Data,
 						},
 					},
 				},
-			}
-		case *Int16FieldData:
-			int32Data := make([]int32, len(rawData.Data))
-			for index, v := range rawData.Data {
-				int32Data[index] = int32(v)
-			}
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_Int16,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Scalars{
-					Scalars: &schemapb.ScalarField{
-						Data: &schemapb.ScalarField_IntData{
-							IntData: &schemapb.IntArray{
-								Data: int32Data,
-							},
+			},
+		}
+	case *Int16FieldData:
+		int32Data := make([]int32, len(rawData.Data))
+		for index, v := range rawData.Data {
+			int32Data[index] = int32(v)
+		}
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_Int16,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{
+						IntData: &schemapb.IntArray{
+							Data: int32Data,
 						},
 					},
 				},
-			}
-		case *Int32FieldData:
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_Int32,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Scalars{
-					Scalars: &schemapb.ScalarField{
-						Data: &schemapb.ScalarField_IntData{
-							IntData: &schemapb.IntArray{
-								Data: rawData.Data,
-							},
+			},
+		}
+	case *Int32FieldData:
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_Int32,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{
+						IntData: &schemapb.IntArray{
+							Data: rawData.Data,
 						},
 					},
 				},
-			}
-		case *Int64FieldData:
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_Int64,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Scalars{
-					Scalars: &schemapb.ScalarField{
-						Data: &schemapb.ScalarField_LongData{
-							LongData: &schemapb.LongArray{
-								Data: rawData.Data,
-							},
+			},
+		}
+	case *Int64FieldData:
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_Int64,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: rawData.Data,
 						},
 					},
 				},
-			}
-		case *FloatFieldData:
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_Float,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Scalars{
-					Scalars: &schemapb.ScalarField{
-						Data: &schemapb.ScalarField_FloatData{
-							FloatData: &schemapb.FloatArray{
-								Data: rawData.Data,
-							},
+			},
+		}
+	case *FloatFieldData:
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_Float,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_FloatData{
+						FloatData: &schemapb.FloatArray{
+							Data: rawData.Data,
 						},
 					},
 				},
-			}
-		case *DoubleFieldData:
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_Double,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Scalars{
-					Scalars: &schemapb.ScalarField{
-						Data: &schemapb.ScalarField_DoubleData{
-							DoubleData: &schemapb.DoubleArray{
-								Data: rawData.Data,
-							},
+			},
+		}
+	case *DoubleFieldData:
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_Double,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_DoubleData{
+						DoubleData: &schemapb.DoubleArray{
+							Data: rawData.Data,
 						},
 					},
 				},
-			}
-		case *StringFieldData:
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_VarChar,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Scalars{
-					Scalars: &schemapb.ScalarField{
-						Data: &schemapb.ScalarField_StringData{
-							StringData: &schemapb.StringArray{
-								Data: rawData.Data,
-							},
+			},
+		}
+	case *StringFieldData:
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_VarChar,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: rawData.Data,
 						},
 					},
 				},
-			}
-		case *FloatVectorFieldData:
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_FloatVector,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Vectors{
-					Vectors: &schemapb.VectorField{
-						Data: &schemapb.VectorField_FloatVector{
-							FloatVector: &schemapb.FloatArray{
-								Data: rawData.Data,
-							},
+			},
+		}
+	case *FloatVectorFieldData:
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_FloatVector,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{
+							Data: rawData.Data,
 						},
-						Dim: int64(rawData.Dim),
 					},
+					Dim: int64(rawData.Dim),
 				},
-			}
-		case *BinaryVectorFieldData:
-			fieldData = &schemapb.FieldData{
-				Type:    schemapb.DataType_BinaryVector,
-				FieldId: fieldID,
-				Field: &schemapb.FieldData_Vectors{
-					Vectors: &schemapb.VectorField{
-						Data: &schemapb.VectorField_BinaryVector{
-							BinaryVector: rawData.Data,
-						},
-						Dim: int64(rawData.Dim),
+			},
+		}
+	case *BinaryVectorFieldData:
+		fieldData = &schemapb.FieldData{
+			Type:    schemapb.DataType_BinaryVector,
+			FieldId: fieldID,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Data: &schemapb.VectorField_BinaryVector{
+						BinaryVector: rawData.Data,
 					},
+					Dim: int64(rawData.Dim),
 				},
-			}
-		default:
-			return insertRecord, fmt.Errorf("unsupported data type when transter storage.InsertData to internalpb.InsertRecord")
+			},
 		}
-
-		insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
-		insertRecord.NumRows = int64(rawData.RowNum())
+	default:
+		return nil, fmt.Errorf("unsupported data type when transfer storage.FieldData to schemapb.FieldData")
 	}
 
-	return insertRecord, nil
+	return fieldData, nil
 }
 
 func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msgstream.InsertMsg) (*segcorepb.InsertRecord, error) {
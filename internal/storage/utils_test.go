@@ -800,6 +800,35 @@ func TestColumnBasedInsertMsgToInsertData(t *testing.T) {
 	}
 }
 
+func TestColumnBasedInsertMsgToInsertDataWithValidData(t *testing.T) {
+	numRows, fVecDim, bVecDim := 2, 2, 8
+	schema, _, fieldIDs := genAllFieldsSchema(fVecDim, bVecDim)
+	msg, _, _ := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim)
+
+	doubleFieldID := fieldIDs[6]
+	msg.ValidData = []*internalpb.FieldValidData{
+		{FieldID: doubleFieldID, ValidData: []bool{false, true}},
+	}
+	idata, err := ColumnBasedInsertMsgToInsertData(msg, schema)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{false, true}, idata.Data[doubleFieldID].GetValidData())
+	assert.Empty(t, idata.Data[fieldIDs[0]].GetValidData())
+
+	// length of valid data mismatch
+	msg.ValidData = []*internalpb.FieldValidData{
+		{FieldID: doubleFieldID, ValidData: []bool{false}},
+	}
+	_, err = ColumnBasedInsertMsgToInsertData(msg, schema)
+	assert.Error(t, err)
+
+	// vector field can not be null
+	msg.ValidData = []*internalpb.FieldValidData{
+		{FieldID: fieldIDs[7], ValidData: []bool{false, true}},
+	}
+	_, err = ColumnBasedInsertMsgToInsertData(msg, schema)
+	assert.Error(t, err)
+}
+
 func TestInsertMsgToInsertData(t *testing.T) {
 	numRows, fVecDim, bVecDim := 10, 8, 8
 	schema, _, fieldIDs := genAllFieldsSchema(fVecDim, bVecDim)
@@ -1011,6 +1040,90 @@ func TestMergeInsertData(t *testing.T) {
 	assert.Equal(t, []float32{0, 0}, f.(*FloatVectorFieldData).Data)
 }
 
+func TestMergeValidData(t *testing.T) {
+	assert.Nil(t, MergeValidData(nil, 2, nil, 3))
+	assert.Equal(t, []bool{true, true, false}, MergeValidData(nil, 2, []bool{false}, 1))
+	assert.Equal(t, []bool{false, true, true}, MergeValidData([]bool{false, true}, 2, nil, 1))
+	assert.Equal(t, []bool{false, true, false}, MergeValidData([]bool{false}, 1, []bool{true, false}, 2))
+}
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:    common.StartOfUserFieldID,
+		Name:       "age",
+		DataType:   schemapb.DataType_Int32,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}},
+	}
+	data, err := GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{18, 18}, data.(*Int32FieldData).Data)
+	assert.Nil(t, data.(*Int32FieldData).ValidData)
+
+	field = &schemapb.FieldSchema{
+		FieldID:    common.StartOfUserFieldID,
+		Name:       "name",
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	}
+	data, err = GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, data.RowNum())
+	assert.Equal(t, []bool{false, false}, data.(*StringFieldData).ValidData)
+
+	field = &schemapb.FieldSchema{
+		FieldID:  common.StartOfUserFieldID,
+		Name:     "score",
+		DataType: schemapb.DataType_Double,
+	}
+	_, err = GenDefaultFieldData(field, 2)
+	assert.Error(t, err)
+}
+
+func TestFillMissingRows(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:    common.StartOfUserFieldID,
+		Name:       "age",
+		DataType:   schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+	}
+	data := &Int64FieldData{NumRows: []int64{2}, Data: []int64{3, 4}}
+
+	filled, err := FillMissingRows(field, data, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, data, filled)
+
+	filled, err = FillMissingRows(field, data, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 3, 4}, filled.(*Int64FieldData).Data)
+	assert.Equal(t, []bool{false, true, true}, filled.(*Int64FieldData).ValidData)
+}
+
+func TestFillMissingFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.TimeStampField, Name: "ts", DataType: schemapb.DataType_Int64},
+			{FieldID: common.StartOfUserFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:    common.StartOfUserFieldID + 1,
+				Name:       "age",
+				DataType:   schemapb.DataType_Int8,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "1"}},
+			},
+		},
+	}
+	data := &InsertData{Data: map[FieldID]FieldData{
+		common.TimeStampField:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+		common.StartOfUserFieldID: &Int64FieldData{NumRows: []int64{2}, Data: []int64{3, 4}},
+	}}
+
+	err := FillMissingFields(schema, data)
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{1, 1}, data.Data[common.StartOfUserFieldID+1].(*Int8FieldData).Data)
+
+	err = FillMissingFields(schema, &InsertData{Data: map[FieldID]FieldData{}})
+	assert.Error(t, err)
+}
+
 func TestGetPkFromInsertData(t *testing.T) {
 	var nilSchema *schemapb.CollectionSchema
 	_, err := GetPkFromInsertData(nilSchema, nil)
@@ -123,9 +123,18 @@ func (vcm *VectorChunkManager) deserializeVectorFile(filePath string, content []
 		return nil, err
 	}
 
-	// Note: here we assume that only one field in the binlog.
+	// Note: here we assume that only one field in the binlog,
+	// or only one vector field in the columnar log holding all fields.
+	columnar := IsColumnarBinlog(content)
 	var results []byte
 	for _, singleData := range data.Data {
+		switch singleData.(type) {
+		case *FloatVectorFieldData, *BinaryVectorFieldData:
+		default:
+			if columnar {
+				continue
+			}
+		}
 		bs, err := FieldDataToBytes(common.Endian, singleData)
 		if err == nil {
 			results = bs
@@ -81,6 +81,8 @@ type DataNode interface {
 	GetCompactionState(ctx context.Context, req *datapb.CompactionStateRequest) (*datapb.CompactionStateResponse, error)
 	// SyncSegments is called by DataCoord, to sync the segments meta when complete compaction
 	SyncSegments(ctx context.Context, req *datapb.SyncSegmentsRequest) (*commonpb.Status, error)
+	// CancelCompaction is called by DataCoord, to stop a compaction plan and drop its result
+	CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error)
 
 	// Import data files(json, numpy, etc.) on MinIO/S3 storage, read and parse them into sealed segments
 	//
@@ -97,6 +99,12 @@ type DataNode interface {
 
 	// AddImportSegment puts the given import segment to current DataNode's flow graph.
 	AddImportSegment(ctx context.Context, req *datapb.AddImportSegmentRequest) (*datapb.AddImportSegmentResponse, error)
+
+	// Export writes the rows of the task segments into Parquet or JSON files on the object storage asynchronously,
+	// the progress and result are reported to DataCoord by ReportExport.
+	Export(ctx context.Context, req *datapb.ExportTask) (*commonpb.Status, error)
+	// CancelExport stops the executing export tasks of a job
+	CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error)
 }
 
 // DataNodeComponent is used by grpc server of DataNode
@@ -282,6 +290,21 @@ type DataCoord interface {
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	// GetCompactionStateWithPlans get the state of requested plan id
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
+	// ManualCompactionWithScope triggers a compaction limited to the given partitions or segments of a collection
+	ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error)
+	// CancelCompaction cancels the plans of a compaction which are not completed yet
+	CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error)
+	// GetCompactionPlanStates gets the state of each plan of a compaction, including the cancelled ones
+	GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error)
+
+	// Export starts a job which exports the flushed segments of a collection to Parquet or JSON files
+	Export(ctx context.Context, req *datapb.ExportRequest) (*datapb.ExportResponse, error)
+	// GetExportState gets the state and progress of an export job
+	GetExportState(ctx context.Context, req *datapb.GetExportStateRequest) (*datapb.GetExportStateResponse, error)
+	// CancelExport cancels an export job which is not finished yet
+	CancelExport(ctx context.Context, req *datapb.CancelExportRequest) (*commonpb.Status, error)
+	// ReportExport is called by DataNode to report the progress or result of an export task
+	ReportExport(ctx context.Context, req *datapb.ExportTaskResult) (*commonpb.Status, error)
 
 	// WatchChannels notifies DataCoord to watch vchannels of a collection
 	WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error)
@@ -777,6 +800,9 @@ type RootCoord interface {
 	ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error)
 
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+
+	// AddCollectionField appends a nullable or defaulted scalar field to a collection, the field id is assigned by RootCoord.
+	AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)
 }
 
 // RootCoordComponent is used by grpc server of RootCoord
@@ -1305,6 +1331,30 @@ type ProxyComponent interface {
 	SelectGrant(ctx context.Context, req *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error)
 
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+
+	// ValidateExpr checks whether the expression is valid against the schema of the collection without executing it.
+	ValidateExpr(ctx context.Context, req *proxypb.ValidateExprRequest) (*proxypb.ValidateExprResponse, error)
+
+	// ManualCompactionWithScope triggers a compaction limited to the given partitions or segments of a collection.
+	ManualCompactionWithScope(ctx context.Context, req *proxypb.ManualCompactionWithScopeRequest) (*milvuspb.ManualCompactionResponse, error)
+
+	// CancelCompaction cancels the plans of a compaction which are not completed yet.
+	CancelCompaction(ctx context.Context, req *proxypb.CancelCompactionRequest) (*commonpb.Status, error)
+
+	// GetCompactionPlanStates gets the state of each plan of a compaction, including the cancelled ones.
+	GetCompactionPlanStates(ctx context.Context, req *proxypb.GetCompactionPlanStatesRequest) (*datapb.GetCompactionPlanStatesResponse, error)
+
+	// Export starts a job which exports the flushed segments of a collection to Parquet or JSON files.
+	Export(ctx context.Context, req *proxypb.ExportRequest) (*datapb.ExportResponse, error)
+
+	// GetExportState gets the state and progress of an export job of the collection.
+	GetExportState(ctx context.Context, req *proxypb.GetExportStateRequest) (*datapb.GetExportStateResponse, error)
+
+	// CancelExport cancels an export job of the collection which is not finished yet.
+	CancelExport(ctx context.Context, req *proxypb.CancelExportRequest) (*commonpb.Status, error)
+
+	// AddCollectionField appends a nullable or defaulted scalar field to a collection.
+	AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)
 }
 
 // QueryNode is the interface `querynode` package implements
@@ -1338,6 +1388,8 @@ type QueryNode interface {
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	GetDataDistribution(context.Context, *querypb.GetDataDistributionRequest) (*querypb.GetDataDistributionResponse, error)
 	SyncDistribution(context.Context, *querypb.SyncDistributionRequest) (*commonpb.Status, error)
+	// UpdateSchema notifies QueryNode that fields were added to a loaded collection.
+	UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error)
 }
 
 // QueryNodeComponent is used by grpc server of QueryNode
@@ -1376,6 +1428,9 @@ type QueryCoord interface {
 	GetShardLeaders(ctx context.Context, req *querypb.GetShardLeadersRequest) (*querypb.GetShardLeadersResponse, error)
 
 	CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)
+
+	// UpdateCollectionSchema forwards the altered schema of a loaded collection to its query nodes.
+	UpdateCollectionSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error)
 }
 
 // QueryCoordComponent is used by grpc server of QueryCoord
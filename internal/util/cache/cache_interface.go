@@ -66,6 +66,9 @@ type LoadingCache[K comparable, V any] interface {
 	Refresh(K) error
 }
 
+// Weigher returns the weight of an entry, which is counted against the maximum weight of the cache.
+type Weigher[K comparable, V any] func(K, V) int64
+
 // LoaderFunc retrieves the value corresponding to given Key.
 type LoaderFunc[K comparable, V any] func(K) (V, error)
 
@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"container/heap"
+	"container/list"
+)
+
+// lfuCache is a LFU cache, the least recently used one of the least frequently used entries is evicted first.
+type lfuCache struct {
+	cache *cache
+	cap   int64
+	ls    list.List
+	freqs lfuHeap
+	clock uint64
+	// last is the entry written last, it's not evicted unless it's the only one,
+	// otherwise new entries are always evicted first as they are the least frequently used ones.
+	last *entry
+
+	// weigh returns the weight of an entry, every entry weighs 1 if it is nil.
+	weigh  func(en *entry) int64
+	weight int64
+}
+
+// init initializes cache list and heap.
+func (l *lfuCache) init(c *cache, cap int64) {
+	l.cache = c
+	l.cap = cap
+	l.ls.Init()
+	l.freqs = l.freqs[:0]
+	l.last = nil
+}
+
+// write adds new entry to the cache and returns evicted entry if necessary.
+func (l *lfuCache) write(en *entry) *entry {
+	if en.accessList != nil {
+		// Entry existed, update its status instead.
+		l.markAccess(en)
+		l.reweigh(en)
+		l.last = en
+		return l.evict()
+	}
+
+	cen := l.cache.getOrSet(en)
+	if cen != nil {
+		// Entry has already been added, update its value instead.
+		cen.setValue(en.getValue())
+		cen.setWriteTime(en.getWriteTime())
+		en = cen
+	}
+	if en.accessList == nil {
+		l.clock++
+		en.freq = 1
+		en.accessTick = l.clock
+		en.accessList = l.ls.PushFront(en)
+		heap.Push(&l.freqs, en)
+	} else {
+		l.markAccess(en)
+	}
+	l.reweigh(en)
+	l.last = en
+	return l.evict()
+}
+
+// reweigh updates the weight of the entry and the total weight.
+func (l *lfuCache) reweigh(en *entry) {
+	weight := int64(1)
+	if l.weigh != nil {
+		weight = l.weigh(en)
+	}
+	l.weight += weight - en.weight
+	en.weight = weight
+}
+
+// evict removes and returns the least frequently used entry if the capacity is exceeded.
+func (l *lfuCache) evict() *entry {
+	if l.cap <= 0 || l.weight <= l.cap || len(l.freqs) == 0 {
+		return nil
+	}
+	victim := l.freqs[0]
+	if victim == l.last && len(l.freqs) > 1 {
+		// the next least frequently used entry is one of the children of the root
+		victim = l.freqs[1]
+		if len(l.freqs) > 2 && l.freqs.Less(2, 1) {
+			victim = l.freqs[2]
+		}
+	}
+	return l.remove(victim)
+}
+
+// access updates cache entry for a get.
+func (l *lfuCache) access(en *entry) {
+	if en.accessList != nil {
+		l.markAccess(en)
+	}
+}
+
+// markAccess increases the frequency of the entry and marks it has just been accessed.
+// en.accessList must not be null.
+func (l *lfuCache) markAccess(en *entry) {
+	l.clock++
+	en.freq++
+	en.accessTick = l.clock
+	l.ls.MoveToFront(en.accessList)
+	heap.Fix(&l.freqs, en.heapIndex)
+}
+
+// remove an entry from the cache.
+func (l *lfuCache) remove(en *entry) *entry {
+	if en.accessList == nil {
+		// Already deleted
+		return nil
+	}
+	l.cache.delete(en)
+	l.ls.Remove(en.accessList)
+	en.accessList = nil
+	heap.Remove(&l.freqs, en.heapIndex)
+	en.freq = 0
+	l.weight -= en.weight
+	en.weight = 0
+	if en == l.last {
+		l.last = nil
+	}
+	return en
+}
+
+// iterate walks through all lists by access time.
+func (l *lfuCache) iterate(fn func(en *entry) bool) {
+	iterateListFromBack(&l.ls, fn)
+}
+
+// lfuHeap is a min heap of entries ordered by access frequency, then by access time.
+type lfuHeap []*entry
+
+func (h lfuHeap) Len() int {
+	return len(h)
+}
+
+func (h lfuHeap) Less(i, j int) bool {
+	if h[i].freq != h[j].freq {
+		return h[i].freq < h[j].freq
+	}
+	return h[i].accessTick < h[j].accessTick
+}
+
+func (h lfuHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].heapIndex = i
+	h[j].heapIndex = j
+}
+
+func (h *lfuHeap) Push(x interface{}) {
+	en := x.(*entry)
+	en.heapIndex = len(*h)
+	*h = append(*h, en)
+}
+
+func (h *lfuHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	en := old[n-1]
+	old[n-1] = nil
+	en.heapIndex = -1
+	*h = old[:n-1]
+	return en
+}
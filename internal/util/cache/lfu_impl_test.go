@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLFU(t *testing.T) {
+	var c cache
+	var lfu lfuCache
+	lfu.init(&c, 3)
+
+	en := createLRUEntries(5)
+	assert.Nil(t, lfu.write(en[0]))
+	assert.Nil(t, lfu.write(en[1]))
+	assert.Nil(t, lfu.write(en[2]))
+	// freq: 0:1 1:1 2:1
+	lfu.access(en[0])
+	lfu.access(en[0])
+	lfu.access(en[2])
+	// freq: 0:3 1:1 2:2
+
+	remEn := lfu.write(en[3])
+	// 1 is the least frequently used one
+	assert.Equal(t, en[1], remEn)
+	assert.Equal(t, 3, cacheSize(&c))
+	assert.Nil(t, c.get(1, 0))
+
+	// 3 is accessed later than 2 but less frequently
+	lfu.access(en[3])
+	lfu.access(en[3])
+	lfu.access(en[3])
+	// freq: 0:3 2:2 3:4
+	remEn = lfu.write(en[4])
+	assert.Equal(t, en[2], remEn)
+
+	// 0 and 4 have the same frequency after the accesses, 4 is accessed later
+	lfu.access(en[4])
+	lfu.access(en[4])
+	// freq: 0:3 3:4 4:3
+	remEn = lfu.remove(en[3])
+	assert.Equal(t, en[3], remEn)
+	assert.Nil(t, lfu.remove(en[3]))
+	assert.Nil(t, lfu.write(en[1]))
+	lfu.access(en[1])
+	lfu.access(en[1])
+	lfu.access(en[1])
+	// freq: 0:3 1:4 4:3
+	assert.Equal(t, en[0], lfu.write(en[2]))
+
+	found := ""
+	lfu.iterate(func(en *entry) bool {
+		found += en.getValue().(string) + " "
+		return true
+	})
+	assert.Equal(t, "4 1 2 ", found)
+}
+
+func TestLFUWeight(t *testing.T) {
+	var c cache
+	lfu := lfuCache{weigh: func(en *entry) int64 {
+		return int64(en.key.(int))
+	}}
+	lfu.init(&c, 10)
+
+	en := createLRUEntries(6)
+	for i := 1; i < 5; i++ {
+		assert.Nil(t, lfu.write(en[i]))
+	}
+	assert.Equal(t, int64(10), lfu.weight)
+	lfu.access(en[1])
+	lfu.access(en[2])
+
+	// 3 and 4 are evicted to make room for 5
+	assert.Equal(t, en[3], lfu.write(en[5]))
+	assert.Equal(t, en[4], lfu.evict())
+	assert.Nil(t, lfu.evict())
+	assert.Equal(t, int64(8), lfu.weight)
+}
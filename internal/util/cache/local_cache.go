@@ -54,6 +54,7 @@ type localCache[K comparable, V any] struct {
 
 	onInsertion Func[K, V]
 	onRemoval   Func[K, V]
+	weigher     Weigher[K, V]
 
 	loader         LoaderFunc[K, V]
 	getPreLoadData GetPreLoadDataFunc[K, V]
@@ -91,7 +92,13 @@ func newLocalCache[K comparable, V any]() *localCache[K, V] {
 
 // init initializes cache replacement policy after all user configuration properties are set.
 func (c *localCache[K, V]) init() {
-	c.accessQueue = newPolicy()
+	var weigh func(en *entry) int64
+	if c.weigher != nil {
+		weigh = func(en *entry) int64 {
+			return c.weigher(en.key.(K), en.getValue().(V))
+		}
+	}
+	c.accessQueue = newPolicy(c.policyName, weigh)
 	c.accessQueue.init(&c.cache, c.cap)
 	if c.expireAfterWrite > 0 || c.refreshAfterWrite > 0 {
 		c.writeQueue = &recencyQueue{}
@@ -152,7 +159,7 @@ func (c *localCache[K, V]) Put(k K, v V) {
 		c.setEntryAccessTime(en, now)
 		// Add to the cache directly so the new value is available immediately.
 		// However, only do this within the cache capacity (approximately).
-		if c.cap == 0 || int64(c.cache.len()) < c.cap {
+		if c.cap == 0 || c.weigher != nil || int64(c.cache.len()) < c.cap {
 			cen := c.cache.getOrSet(en)
 			if cen != nil {
 				cen.setValue(v)
@@ -303,7 +310,8 @@ func (c *localCache[K, V]) write(en *entry) {
 	if c.onInsertion != nil {
 		c.onInsertion(en.key.(K), en.getValue().(V))
 	}
-	if ren != nil {
+	// more than one entry may be evicted if entries are weighed
+	for ; ren != nil; ren = c.accessQueue.evict() {
 		c.writeQueue.remove(ren)
 		// An entry has been evicted
 		c.stats.RecordEviction()
@@ -528,6 +536,18 @@ func WithMaximumSize[K comparable, V any](size int64) Option[K, V] {
 	}
 }
 
+// WithMaximumWeight returns an Option which sets maximum total weight of the entries for the cache,
+// the weight of an entry is returned by weigher. Any non-positive numbers is considered as unlimited.
+func WithMaximumWeight[K comparable, V any](weight int64, weigher Weigher[K, V]) Option[K, V] {
+	if weight < 0 {
+		weight = 0
+	}
+	return func(c *localCache[K, V]) {
+		c.cap = weight
+		c.weigher = weigher
+	}
+}
+
 // WithRemovalListener returns an Option to set cache to call onRemoval for each
 // entry evicted from the cache.
 func WithRemovalListener[K comparable, V any](onRemoval Func[K, V]) Option[K, V] {
@@ -568,7 +588,7 @@ func WithStatsCounter[K comparable, V any](st StatsCounter) Option[K, V] {
 }
 
 // WithPolicy returns an option which sets cache policy associated to the given name.
-// Supported policies are: lru, slru.
+// Supported policies are: lru, lfu.
 func WithPolicy[K comparable, V any](name string) Option[K, V] {
 	return func(c *localCache[K, V]) {
 		c.policyName = name
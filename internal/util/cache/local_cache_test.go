@@ -500,3 +500,34 @@ func (t *mockTime) now() time.Time {
 	defer t.mu.RUnlock()
 	return t.value
 }
+
+func TestMaximumWeight(t *testing.T) {
+	removed := make(map[int]int)
+	wg := sync.WaitGroup{}
+	remFunc := func(k int, v int) {
+		removed[k] = v
+		wg.Done()
+	}
+	weigher := func(k int, v int) int64 {
+		return int64(v)
+	}
+	c := NewCache(WithMaximumWeight(10, weigher), WithPolicy[int, int]("lfu"), WithRemovalListener(remFunc))
+	defer c.Close()
+
+	for i := 1; i <= 4; i++ {
+		c.Put(i, i)
+	}
+	c.GetIfPresent(1)
+	c.GetIfPresent(2)
+	wg.Add(2)
+	c.Put(5, 5)
+	wg.Wait()
+	assert.Equal(t, map[int]int{3: 3, 4: 4}, removed)
+	_, ok := c.GetIfPresent(5)
+	assert.True(t, ok)
+
+	wg.Add(3)
+	c.InvalidateAll()
+	wg.Wait()
+	assert.Equal(t, 5, len(removed))
+}
@@ -25,6 +25,10 @@ type lruCache struct {
 	cache *cache
 	cap   int64
 	ls    list.List
+
+	// weigh returns the weight of an entry, every entry weighs 1 if it is nil.
+	weigh  func(en *entry) int64
+	weight int64
 }
 
 // init initializes cache list.
@@ -40,7 +44,8 @@ func (l *lruCache) write(en *entry) *entry {
 	if en.accessList != nil {
 		// Entry existed, update its status instead.
 		l.markAccess(en)
-		return nil
+		l.reweigh(en)
+		return l.evict()
 	}
 
 	// Try to add new entry to the list
@@ -48,6 +53,7 @@ func (l *lruCache) write(en *entry) *entry {
 	if cen == nil {
 		// Brand new entry, add to the LRU list.
 		en.accessList = l.ls.PushFront(en)
+		l.reweigh(en)
 	} else {
 		// Entry has already been added, update its value instead.
 		cen.setValue(en.getValue())
@@ -58,11 +64,26 @@ func (l *lruCache) write(en *entry) *entry {
 		} else {
 			l.markAccess(cen)
 		}
+		l.reweigh(cen)
 	}
-	if l.cap > 0 && int64(l.ls.Len()) > l.cap {
+	return l.evict()
+}
+
+// reweigh updates the weight of the entry and the total weight.
+func (l *lruCache) reweigh(en *entry) {
+	weight := int64(1)
+	if l.weigh != nil {
+		weight = l.weigh(en)
+	}
+	l.weight += weight - en.weight
+	en.weight = weight
+}
+
+// evict removes and returns the least recently used entry if the capacity is exceeded.
+func (l *lruCache) evict() *entry {
+	if l.cap > 0 && l.weight > l.cap && l.ls.Len() > 0 {
 		// Remove the last element when capacity exceeded.
-		en = getEntry(l.ls.Back())
-		return l.remove(en)
+		return l.remove(getEntry(l.ls.Back()))
 	}
 	return nil
 }
@@ -89,6 +110,8 @@ func (l *lruCache) remove(en *entry) *entry {
 	l.cache.delete(en)
 	l.ls.Remove(en.accessList)
 	en.accessList = nil
+	l.weight -= en.weight
+	en.weight = 0
 	return en
 }
 
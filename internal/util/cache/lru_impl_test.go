@@ -157,3 +157,29 @@ func createLRUEntries(n int) []*entry {
 	}
 	return en
 }
+
+func TestLRUWeight(t *testing.T) {
+	s := lruTest{t: t}
+	s.lru.weigh = func(en *entry) int64 {
+		return int64(en.key.(int))
+	}
+	s.lru.init(&s.c, 10)
+
+	en := createLRUEntries(6)
+	for i := 1; i < 5; i++ {
+		assert.Nil(t, s.lru.write(en[i]))
+	}
+	// 4 3 2 1
+	assert.Equal(t, int64(10), s.lru.weight)
+	s.lru.access(en[1])
+	// 1 4 3 2
+
+	remEn := s.lru.write(en[5])
+	// 5 1 4
+	s.assertEntry(remEn, 2, "2", 0)
+	remEn = s.lru.evict()
+	s.assertEntry(remEn, 3, "3", 0)
+	assert.Nil(t, s.lru.evict())
+	assert.Equal(t, int64(10), s.lru.weight)
+	s.assertLRULen(3)
+}
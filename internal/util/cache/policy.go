@@ -56,6 +56,14 @@ type entry struct {
 	writeList *list.Element
 	// listID is ID of the list which this entry is currently in.
 	listID uint8
+	// weight is the weight of this entry counted against the cache capacity.
+	weight int64
+	// freq is the number of accesses of this entry, only used by lfu policy.
+	freq uint64
+	// accessTick is the logical time of the last access of this entry, only used by lfu policy.
+	accessTick uint64
+	// heapIndex is the index of this entry in the frequency heap, only used by lfu policy.
+	heapIndex int
 }
 
 func newEntry(k interface{}, v interface{}, h uint64) *entry {
@@ -194,12 +202,21 @@ type policy interface {
 	access(entry *entry)
 	// remove the entry.
 	remove(entry *entry) *entry
+	// evict removes and returns an entry if the capacity is exceeded, or returns nil.
+	evict() *entry
 	// iterate all entries by their access time.
 	iterate(func(entry *entry) bool)
 }
 
-func newPolicy() policy {
-	return &lruCache{}
+// newPolicy returns the policy associated to the given name, lru is used by default.
+// Entries are weighed by weigh, every entry weighs 1 if it is nil.
+func newPolicy(name string, weigh func(entry *entry) int64) policy {
+	switch name {
+	case "lfu":
+		return &lfuCache{weigh: weigh}
+	default:
+		return &lruCache{weigh: weigh}
+	}
 }
 
 // recencyQueue manages cache entries by write time.
@@ -232,6 +249,10 @@ func (w *recencyQueue) remove(en *entry) *entry {
 	return en
 }
 
+func (w *recencyQueue) evict() *entry {
+	return nil
+}
+
 func (w *recencyQueue) iterate(fn func(en *entry) bool) {
 	iterateListFromBack(&w.ls, fn)
 }
@@ -252,6 +273,10 @@ func (discardingQueue) remove(en *entry) *entry {
 	return en
 }
 
+func (discardingQueue) evict() *entry {
+	return nil
+}
+
 func (discardingQueue) iterate(fn func(en *entry) bool) {
 }
 
@@ -18,11 +18,8 @@ package importutil
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
@@ -157,7 +154,10 @@ func (p *BinlogAdapter) Read(segmentHolder *SegmentFilesHolder) error {
 	// deleted list will be used to remove deleted entities
 	// if accumulate data exceed blockSize, call callFlushFunc to generate new binlog file
 	batchCount := 0
-	for _, files := range segmentHolder.fieldFiles {
+	for fieldID, files := range segmentHolder.fieldFiles {
+		if fieldID == common.ColumnarLogFieldID {
+			continue
+		}
 		batchCount = len(files)
 		break
 	}
@@ -180,7 +180,7 @@ func (p *BinlogAdapter) Read(segmentHolder *SegmentFilesHolder) error {
 		// once a new segment generated, the timestamp field will be re-generated, too.
 		batchFiles := make(map[storage.FieldID]string)
 		for fieldID, files := range segmentHolder.fieldFiles {
-			if fieldID == p.primaryKey || fieldID == common.TimeStampField {
+			if fieldID == p.primaryKey || fieldID == common.TimeStampField || fieldID == common.ColumnarLogFieldID {
 				continue
 			}
 			batchFiles[fieldID] = files[i]
@@ -247,6 +247,24 @@ func (p *BinlogAdapter) Read(segmentHolder *SegmentFilesHolder) error {
 		}
 	}
 
+	// step 4: read columnar logs, each columnar log holds all the fields of a batch
+	for _, logPath := range segmentHolder.fieldFiles[common.ColumnarLogFieldID] {
+		if isCanceled(p.ctx) {
+			log.Error("Binlog adapter: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+
+		err = p.readColumnarlog(logPath, segmentsData, intDeletedList, strDeletedList)
+		if err != nil {
+			return err
+		}
+
+		err = tryFlushBlocks(p.ctx, segmentsData, p.collectionSchema, p.callFlushFunc, p.blockSize, p.maxTotalSize, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	// finally, force to flush
 	return tryFlushBlocks(p.ctx, segmentsData, p.collectionSchema, p.callFlushFunc, p.blockSize, p.maxTotalSize, true)
 }
@@ -256,12 +274,22 @@ func (p *BinlogAdapter) Read(segmentHolder *SegmentFilesHolder) error {
 //  2. binlog file count of each field must be equal
 //  3. the collectionSchema doesn't contain TimeStampField and RowIDField since the import_wrapper excludes them,
 //     but the segmentHolder.fieldFiles need to contains the two fields.
+//  4. columnar logs hold all the fields, a segment that only has columnar logs is valid
 func (p *BinlogAdapter) verify(segmentHolder *SegmentFilesHolder) error {
 	if segmentHolder == nil {
 		log.Error("Binlog adapter: segment files holder is nil")
 		return errors.New("segment files holder is nil")
 	}
 
+	_, hasColumnar := segmentHolder.fieldFiles[common.ColumnarLogFieldID]
+	rowFieldCount := len(segmentHolder.fieldFiles)
+	if hasColumnar {
+		rowFieldCount--
+	}
+	if hasColumnar && rowFieldCount == 0 {
+		return nil
+	}
+
 	firstFieldFileCount := 0
 	//  each field must has binlog file
 	for i := 0; i < len(p.collectionSchema.Fields); i++ {
@@ -293,7 +321,10 @@ func (p *BinlogAdapter) verify(segmentHolder *SegmentFilesHolder) error {
 	}
 
 	// binlog file count of each field must be equal
-	for _, files := range segmentHolder.fieldFiles {
+	for fieldID, files := range segmentHolder.fieldFiles {
+		if fieldID == common.ColumnarLogFieldID {
+			continue
+		}
 		if firstFieldFileCount != len(files) {
 			log.Error("Binlog adapter: file count of each field must be equal", zap.Int("firstFieldFileCount", firstFieldFileCount))
 			return fmt.Errorf("binlog file count of each field must be equal, first field files count: %d, other field files count: %d",
@@ -338,35 +369,30 @@ func (p *BinlogAdapter) readDeltalogs(segmentHolder *SegmentFilesHolder) (map[in
 	}
 }
 
-// decodeDeleteLogs decodes string array(read from delta log) to storage.DeleteLog array
+// decodeDeleteLogs reads all delta logs of a segment to a storage.DeleteLog array
 func (p *BinlogAdapter) decodeDeleteLogs(segmentHolder *SegmentFilesHolder) ([]*storage.DeleteLog, error) {
-	// step 1: read all delta logs to construct a string array, each string is marshaled from storage.DeleteLog
-	stringArray := make([]string, 0)
+	// step 1: read all delta logs
+	allDeleteLogs := make([]*storage.DeleteLog, 0)
 	for _, deltalog := range segmentHolder.deltaFiles {
-		deltaStrings, err := p.readDeltalog(deltalog)
+		deleteLogs, err := p.readDeltalog(deltalog)
 		if err != nil {
 			return nil, err
 		}
-		stringArray = append(stringArray, deltaStrings...)
+		allDeleteLogs = append(allDeleteLogs, deleteLogs...)
 	}
 
-	if len(stringArray) == 0 {
+	if len(allDeleteLogs) == 0 {
 		return nil, nil // no delete log, return directly
 	}
 
 	// print out the first deletion information for diagnose purpose
-	log.Info("Binlog adapter: total deletion count", zap.Int("count", len(stringArray)), zap.String("firstDeletion", stringArray[0]))
+	log.Info("Binlog adapter: total deletion count", zap.Int("count", len(allDeleteLogs)),
+		zap.Any("firstDeletion", allDeleteLogs[0].Pk.GetValue()), zap.Uint64("firstDeletionTs", allDeleteLogs[0].Ts))
 
-	// step 2: decode each string to a storage.DeleteLog object
+	// step 2: only the ts between tsStartPoint and tsEndPoint is effective
+	// ignore deletions whose timestamp is larger than the tsEndPoint or less than tsStartPoint
 	deleteLogs := make([]*storage.DeleteLog, 0)
-	for i := 0; i < len(stringArray); i++ {
-		deleteLog, err := p.decodeDeleteLog(stringArray[i])
-		if err != nil {
-			return nil, err
-		}
-
-		// only the ts between tsStartPoint and tsEndPoint is effective
-		// ignore deletions whose timestamp is larger than the tsEndPoint or less than tsStartPoint
+	for _, deleteLog := range allDeleteLogs {
 		if deleteLog.Ts >= p.tsStartPoint && deleteLog.Ts <= p.tsEndPoint {
 			deleteLogs = append(deleteLogs, deleteLog)
 		}
@@ -387,65 +413,32 @@ func (p *BinlogAdapter) decodeDeleteLogs(segmentHolder *SegmentFilesHolder) ([]*
 	return deleteLogs, nil
 }
 
-// decodeDeleteLog decodes a string to storage.DeleteLog
-// Note: the following code is mainly come from data_codec.go, I suppose the code can compatible with old version 2.0
-func (p *BinlogAdapter) decodeDeleteLog(deltaStr string) (*storage.DeleteLog, error) {
-	deleteLog := &storage.DeleteLog{}
-	if err := json.Unmarshal([]byte(deltaStr), deleteLog); err != nil {
-		// compatible with versions that only support int64 type primary keys
-		// compatible with fmt.Sprintf("%d,%d", pk, ts)
-		// compatible error info (unmarshal err invalid character ',' after top-level value)
-		splits := strings.Split(deltaStr, ",")
-		if len(splits) != 2 {
-			log.Error("Binlog adapter: the format of deletion string is incorrect", zap.String("deltaStr", deltaStr))
-			return nil, fmt.Errorf("the format of deletion string is incorrect, '%s' can not be split", deltaStr)
-		}
-		pk, err := strconv.ParseInt(splits[0], 10, 64)
-		if err != nil {
-			log.Error("Binlog adapter: failed to parse primary key of deletion string from old version",
-				zap.String("deltaStr", deltaStr), zap.Error(err))
-			return nil, fmt.Errorf("failed to parse primary key of deletion string '%s' from old version, error: %w", deltaStr, err)
-		}
-		deleteLog.Pk = &storage.Int64PrimaryKey{
-			Value: pk,
-		}
-		deleteLog.PkType = int64(schemapb.DataType_Int64)
-		deleteLog.Ts, err = strconv.ParseUint(splits[1], 10, 64)
-		if err != nil {
-			log.Error("Binlog adapter: failed to parse timestamp of deletion string from old version",
-				zap.String("deltaStr", deltaStr), zap.Error(err))
-			return nil, fmt.Errorf("failed to parse timestamp of deletion string '%s' from old version, error: %w", deltaStr, err)
-		}
-	}
-
-	return deleteLog, nil
-}
-
-// readDeltalog parses a delta log file. Each delta log data type is varchar, marshaled from an array of storage.DeleteLog objects.
-func (p *BinlogAdapter) readDeltalog(logPath string) ([]string, error) {
-	// open the delta log file
-	binlogFile, err := NewBinlogFile(p.chunkManager)
-	if err != nil {
-		log.Error("Binlog adapter: failed to initialize binlog file", zap.String("logPath", logPath), zap.Error(err))
-		return nil, fmt.Errorf("failed to initialize binlog file '%s', error: %w", logPath, err)
+// readDeltalog parses a delta log file to an array of storage.DeleteLog objects.
+// Both the delta logs of pk/ts columns and the old delta logs of JSON encoded strings are supported.
+func (p *BinlogAdapter) readDeltalog(logPath string) ([]*storage.DeleteLog, error) {
+	if p.chunkManager == nil {
+		log.Error("Binlog adapter: chunk manager pointer is nil", zap.String("logPath", logPath))
+		return nil, errors.New("chunk manager pointer is nil")
 	}
 
-	err = binlogFile.Open(logPath)
+	buf, err := p.chunkManager.Read(p.ctx, logPath)
 	if err != nil {
 		log.Error("Binlog adapter: failed to open delta log", zap.String("logPath", logPath), zap.Error(err))
 		return nil, fmt.Errorf("failed to open delta log '%s', error: %w", logPath, err)
 	}
-	defer binlogFile.Close()
 
-	// delta log type is varchar, return a string array(marshaled from an array of storage.DeleteLog objects)
-	data, err := binlogFile.ReadVarchar()
+	_, _, deleteData, err := storage.NewDeleteCodec().Deserialize([]*storage.Blob{{Key: logPath, Value: buf}})
 	if err != nil {
 		log.Error("Binlog adapter: failed to read delta log", zap.String("logPath", logPath), zap.Error(err))
 		return nil, fmt.Errorf("failed to read delta log '%s', error: %w", logPath, err)
 	}
-	log.Info("Binlog adapter: successfully read deltalog", zap.Int("deleteCount", len(data)))
+	log.Info("Binlog adapter: successfully read deltalog", zap.Int64("deleteCount", deleteData.RowCount))
 
-	return data, nil
+	deleteLogs := make([]*storage.DeleteLog, 0, len(deleteData.Pks))
+	for i := range deleteData.Pks {
+		deleteLogs = append(deleteLogs, storage.NewDeleteLog(deleteData.Pks[i], deleteData.Tss[i]))
+	}
+	return deleteLogs, nil
 }
 
 // readTimestamp method reads data from int64 field, currently we use it to read the timestamp field.
@@ -755,6 +748,104 @@ func (p *BinlogAdapter) readInsertlog(fieldID storage.FieldID, logPath string,
 	return nil
 }
 
+// readColumnarlog method reads all the fields from a columnar log, uses the primary keys and timestamps
+// to generate a shard id list, then put each field into different FieldData according to the shard list.
+func (p *BinlogAdapter) readColumnarlog(logPath string, memoryData []map[storage.FieldID]storage.FieldData,
+	intDeletedList map[int64]uint64, strDeletedList map[string]uint64) error {
+	bytes, err := p.chunkManager.Read(p.ctx, logPath)
+	if err != nil {
+		log.Error("Binlog adapter: failed to open columnar log", zap.String("logPath", logPath), zap.Error(err))
+		return fmt.Errorf("failed to open columnar log %s, error: %w", logPath, err)
+	}
+
+	fieldIDs := make([]storage.FieldID, 0, len(p.collectionSchema.Fields)+1)
+	fieldIDs = append(fieldIDs, common.TimeStampField)
+	for _, schema := range p.collectionSchema.Fields {
+		fieldIDs = append(fieldIDs, schema.GetFieldID())
+	}
+
+	insertData := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+	blobs := []*storage.Blob{{Key: logPath, Value: bytes}}
+	_, _, _, err = storage.NewInsertCodec(nil).DeserializeFieldsInto(blobs, 0, insertData, fieldIDs...)
+	if err != nil {
+		log.Error("Binlog adapter: failed to decode columnar log", zap.String("logPath", logPath), zap.Error(err))
+		return fmt.Errorf("failed to decode columnar log %s, error: %w", logPath, err)
+	}
+
+	tsData, ok := insertData.Data[common.TimeStampField].(*storage.Int64FieldData)
+	if !ok {
+		log.Error("Binlog adapter: the columnar log has no timestamp field", zap.String("logPath", logPath))
+		return fmt.Errorf("the columnar log %s has no timestamp field", logPath)
+	}
+
+	var shardList []int32
+	switch pkData := insertData.Data[p.primaryKey].(type) {
+	case *storage.Int64FieldData:
+		shardList, err = p.getShardingListByPrimaryInt64(pkData.Data, tsData.Data, memoryData, intDeletedList)
+	case *storage.StringFieldData:
+		shardList, err = p.getShardingListByPrimaryVarchar(pkData.Data, tsData.Data, memoryData, strDeletedList)
+	default:
+		log.Error("Binlog adapter: the columnar log has no valid primary key field", zap.String("logPath", logPath))
+		return fmt.Errorf("the columnar log %s has no valid primary key field", logPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	// all the primary keys have been deleted(or skipped)
+	if len(shardList) == 0 {
+		return nil
+	}
+
+	for _, schema := range p.collectionSchema.Fields {
+		fieldID := schema.GetFieldID()
+		if fieldID == p.primaryKey {
+			continue
+		}
+		fieldData, ok := insertData.Data[fieldID]
+		if !ok {
+			log.Error("Binlog adapter: a field is missed in columnar log", zap.Int64("fieldID", fieldID), zap.String("logPath", logPath))
+			return fmt.Errorf("the field %d is missed in columnar log %s", fieldID, logPath)
+		}
+		err = p.dispatchFieldDataToShards(fieldData, memoryData, shardList, fieldID)
+		if err != nil {
+			return err
+		}
+	}
+	log.Info("Binlog adapter: read columnar log into shard list", zap.String("logPath", logPath), zap.Int("shardLen", len(shardList)))
+
+	return nil
+}
+
+// dispatchFieldDataToShards method dispatches a decoded FieldData by its concrete type.
+func (p *BinlogAdapter) dispatchFieldDataToShards(fieldData storage.FieldData, memoryData []map[storage.FieldID]storage.FieldData,
+	shardList []int32, fieldID storage.FieldID) error {
+	switch data := fieldData.(type) {
+	case *storage.BoolFieldData:
+		return p.dispatchBoolToShards(data.Data, memoryData, shardList, fieldID)
+	case *storage.Int8FieldData:
+		return p.dispatchInt8ToShards(data.Data, memoryData, shardList, fieldID)
+	case *storage.Int16FieldData:
+		return p.dispatchInt16ToShards(data.Data, memoryData, shardList, fieldID)
+	case *storage.Int32FieldData:
+		return p.dispatchInt32ToShards(data.Data, memoryData, shardList, fieldID)
+	case *storage.Int64FieldData:
+		return p.dispatchInt64ToShards(data.Data, memoryData, shardList, fieldID)
+	case *storage.FloatFieldData:
+		return p.dispatchFloatToShards(data.Data, memoryData, shardList, fieldID)
+	case *storage.DoubleFieldData:
+		return p.dispatchDoubleToShards(data.Data, memoryData, shardList, fieldID)
+	case *storage.StringFieldData:
+		return p.dispatchVarcharToShards(data.Data, memoryData, shardList, fieldID)
+	case *storage.BinaryVectorFieldData:
+		return p.dispatchBinaryVecToShards(data.Data, data.Dim, memoryData, shardList, fieldID)
+	case *storage.FloatVectorFieldData:
+		return p.dispatchFloatVecToShards(data.Data, data.Dim, memoryData, shardList, fieldID)
+	default:
+		return fmt.Errorf("unsupported field data type of field %d", fieldID)
+	}
+}
+
 func (p *BinlogAdapter) dispatchBoolToShards(data []bool, memoryData []map[storage.FieldID]storage.FieldData,
 	shardList []int32, fieldID storage.FieldID) error {
 	// verify row count
@@ -17,7 +17,6 @@ package importutil
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"math"
 	"strconv"
@@ -256,6 +255,20 @@ func Test_BinlogAdapterVerify(t *testing.T) {
 	}
 	err = adapter.verify(holder)
 	assert.Nil(t, err)
+
+	// columnar logs don't need to have the same count as the row binlogs
+	holder.fieldFiles[common.ColumnarLogFieldID] = []string{
+		"a", "b",
+	}
+	err = adapter.verify(holder)
+	assert.Nil(t, err)
+
+	// a segment which only has columnar logs is valid
+	holder.fieldFiles = map[storage.FieldID][]string{
+		common.ColumnarLogFieldID: {"a"},
+	}
+	err = adapter.verify(holder)
+	assert.Nil(t, err)
 }
 
 func Test_BinlogAdapterReadDeltalog(t *testing.T) {
@@ -342,59 +355,6 @@ func Test_BinlogAdapterDecodeDeleteLogs(t *testing.T) {
 	assert.Nil(t, deletions)
 }
 
-func Test_BinlogAdapterDecodeDeleteLog(t *testing.T) {
-	ctx := context.Background()
-
-	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
-		return nil
-	}
-
-	adapter, err := NewBinlogAdapter(ctx, sampleSchema(), 2, 1024, 2048, &MockChunkManager{}, flushFunc, 0, math.MaxUint64)
-	assert.NotNil(t, adapter)
-	assert.Nil(t, err)
-
-	// v2.1 format
-	st := &storage.DeleteLog{
-		Pk: &storage.Int64PrimaryKey{
-			Value: 100,
-		},
-		Ts:     uint64(450000),
-		PkType: 5,
-	}
-
-	m, _ := json.Marshal(st)
-
-	del, err := adapter.decodeDeleteLog(string(m))
-	assert.Nil(t, err)
-	assert.NotNil(t, del)
-	assert.True(t, del.Pk.EQ(st.Pk))
-	assert.Equal(t, st.Ts, del.Ts)
-	assert.Equal(t, st.PkType, del.PkType)
-
-	// v2.0 format
-	del, err = adapter.decodeDeleteLog("")
-	assert.Nil(t, del)
-	assert.NotNil(t, err)
-
-	del, err = adapter.decodeDeleteLog("a,b")
-	assert.Nil(t, del)
-	assert.NotNil(t, err)
-
-	del, err = adapter.decodeDeleteLog("5,b")
-	assert.Nil(t, del)
-	assert.NotNil(t, err)
-
-	del, err = adapter.decodeDeleteLog("5,1000")
-	assert.Nil(t, err)
-	assert.NotNil(t, del)
-	assert.True(t, del.Pk.EQ(&storage.Int64PrimaryKey{
-		Value: 5,
-	}))
-	tt, _ := strconv.ParseUint("1000", 10, 64)
-	assert.Equal(t, del.Ts, tt)
-	assert.Equal(t, del.PkType, int64(schemapb.DataType_Int64))
-}
-
 func Test_BinlogAdapterReadDeltalogs(t *testing.T) {
 	ctx := context.Background()
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/log"
@@ -31,9 +32,12 @@ import (
 // Note: for bulkoad function, we only handle normal insert log and delta log.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 // Typically, an insert log file size is 16MB.
+// A columnar log of segment format v2 holds all the fields of a segment, a field must be selected by SelectField before reading.
 type BinlogFile struct {
 	chunkManager storage.ChunkManager  // storage interfaces to read binlog files
 	reader       *storage.BinlogReader // binlog reader
+	columnar     *storage.InsertData   // decoded fields of a columnar log
+	field        storage.FieldData     // selected field of the columnar log
 }
 
 func NewBinlogFile(chunkManager storage.ChunkManager) (*BinlogFile, error) {
@@ -63,6 +67,18 @@ func (p *BinlogFile) Open(filePath string) error {
 		return fmt.Errorf("failed to open binlog %s", filePath)
 	}
 
+	if storage.IsColumnarBinlog(bytes) {
+		insertData := &storage.InsertData{Data: make(map[storage.FieldID]storage.FieldData)}
+		blobs := []*storage.Blob{{Key: filePath, Value: bytes}}
+		if _, _, _, err = storage.NewInsertCodec(nil).DeserializeInto(blobs, 0, insertData); err != nil {
+			log.Error("Binlog file: failed to decode columnar log", zap.String("filePath", filePath), zap.Error(err))
+			return fmt.Errorf("failed to decode columnar log %s, error: %w", filePath, err)
+		}
+		p.columnar = insertData
+		log.Info("Binlog file: open columnar log successfully", zap.String("filePath", filePath))
+		return nil
+	}
+
 	p.reader, err = storage.NewBinlogReader(bytes)
 	if err != nil {
 		log.Error("Binlog file: failed to initialize binlog reader", zap.String("filePath", filePath), zap.Error(err))
@@ -79,9 +95,50 @@ func (p *BinlogFile) Close() {
 		p.reader.Close()
 		p.reader = nil
 	}
+	p.columnar = nil
+	p.field = nil
+}
+
+// IsColumnar returns whether the opened file is a columnar log of segment format v2.
+func (p *BinlogFile) IsColumnar() bool {
+	return p.columnar != nil
+}
+
+// FieldIDs returns the ids of the fields held by the opened columnar log, in ascending order.
+func (p *BinlogFile) FieldIDs() []storage.FieldID {
+	if p.columnar == nil {
+		return nil
+	}
+
+	fieldIDs := make([]storage.FieldID, 0, len(p.columnar.Data))
+	for fieldID := range p.columnar.Data {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+	sort.Slice(fieldIDs, func(i, j int) bool { return fieldIDs[i] < fieldIDs[j] })
+	return fieldIDs
+}
+
+// SelectField selects the field of the opened columnar log to be read by the ReadXXX methods.
+func (p *BinlogFile) SelectField(fieldID storage.FieldID) error {
+	if p.columnar == nil {
+		log.Error("Binlog file: columnar log not yet opened")
+		return errors.New("columnar log not yet opened")
+	}
+
+	field, ok := p.columnar.Data[fieldID]
+	if !ok {
+		log.Error("Binlog file: field not found in columnar log", zap.Int64("fieldID", fieldID))
+		return fmt.Errorf("field %d not found in columnar log", fieldID)
+	}
+	p.field = field
+	return nil
 }
 
 func (p *BinlogFile) DataType() schemapb.DataType {
+	if p.columnar != nil {
+		return columnarDataType(p.field)
+	}
+
 	if p.reader == nil {
 		return schemapb.DataType_None
 	}
@@ -89,9 +146,46 @@ func (p *BinlogFile) DataType() schemapb.DataType {
 	return p.reader.PayloadDataType
 }
 
+// columnarDataType returns the data type of a field decoded from a columnar log.
+func columnarDataType(field storage.FieldData) schemapb.DataType {
+	switch field.(type) {
+	case *storage.BoolFieldData:
+		return schemapb.DataType_Bool
+	case *storage.Int8FieldData:
+		return schemapb.DataType_Int8
+	case *storage.Int16FieldData:
+		return schemapb.DataType_Int16
+	case *storage.Int32FieldData:
+		return schemapb.DataType_Int32
+	case *storage.Int64FieldData:
+		return schemapb.DataType_Int64
+	case *storage.FloatFieldData:
+		return schemapb.DataType_Float
+	case *storage.DoubleFieldData:
+		return schemapb.DataType_Double
+	case *storage.StringFieldData:
+		return schemapb.DataType_VarChar
+	case *storage.BinaryVectorFieldData:
+		return schemapb.DataType_BinaryVector
+	case *storage.FloatVectorFieldData:
+		return schemapb.DataType_FloatVector
+	default:
+		return schemapb.DataType_None
+	}
+}
+
 // ReadBool method reads all the blocks of a binlog by a data type.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 func (p *BinlogFile) ReadBool() ([]bool, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.BoolFieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not bool")
+			return nil, errors.New("binlog data type is not bool")
+		}
+		return data.Data, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, errors.New("binlog reader not yet initialized")
@@ -135,6 +229,15 @@ func (p *BinlogFile) ReadBool() ([]bool, error) {
 // ReadInt8 method reads all the blocks of a binlog by a data type.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 func (p *BinlogFile) ReadInt8() ([]int8, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.Int8FieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not int8")
+			return nil, errors.New("binlog data type is not int8")
+		}
+		return data.Data, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, errors.New("binlog reader not yet initialized")
@@ -178,6 +281,15 @@ func (p *BinlogFile) ReadInt8() ([]int8, error) {
 // ReadInt16 method reads all the blocks of a binlog by a data type.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 func (p *BinlogFile) ReadInt16() ([]int16, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.Int16FieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not int16")
+			return nil, errors.New("binlog data type is not int16")
+		}
+		return data.Data, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, errors.New("binlog reader not yet initialized")
@@ -221,6 +333,15 @@ func (p *BinlogFile) ReadInt16() ([]int16, error) {
 // ReadInt32 method reads all the blocks of a binlog by a data type.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 func (p *BinlogFile) ReadInt32() ([]int32, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.Int32FieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not int32")
+			return nil, errors.New("binlog data type is not int32")
+		}
+		return data.Data, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, errors.New("binlog reader not yet initialized")
@@ -264,6 +385,15 @@ func (p *BinlogFile) ReadInt32() ([]int32, error) {
 // ReadInt64 method reads all the blocks of a binlog by a data type.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 func (p *BinlogFile) ReadInt64() ([]int64, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.Int64FieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not int64")
+			return nil, errors.New("binlog data type is not int64")
+		}
+		return data.Data, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, errors.New("binlog reader not yet initialized")
@@ -307,6 +437,15 @@ func (p *BinlogFile) ReadInt64() ([]int64, error) {
 // ReadFloat method reads all the blocks of a binlog by a data type.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 func (p *BinlogFile) ReadFloat() ([]float32, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.FloatFieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not float")
+			return nil, errors.New("binlog data type is not float")
+		}
+		return data.Data, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, errors.New("binlog reader not yet initialized")
@@ -350,6 +489,15 @@ func (p *BinlogFile) ReadFloat() ([]float32, error) {
 // ReadDouble method reads all the blocks of a binlog by a data type.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 func (p *BinlogFile) ReadDouble() ([]float64, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.DoubleFieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not double")
+			return nil, errors.New("binlog data type is not double")
+		}
+		return data.Data, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, errors.New("binlog reader not yet initialized")
@@ -393,6 +541,15 @@ func (p *BinlogFile) ReadDouble() ([]float64, error) {
 // ReadVarchar method reads all the blocks of a binlog by a data type.
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 func (p *BinlogFile) ReadVarchar() ([]string, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.StringFieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not varchar")
+			return nil, errors.New("binlog data type is not varchar")
+		}
+		return data.Data, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, errors.New("binlog reader not yet initialized")
@@ -438,6 +595,15 @@ func (p *BinlogFile) ReadVarchar() ([]string, error) {
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 // return vectors data and the dimension
 func (p *BinlogFile) ReadBinaryVector() ([]byte, int, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.BinaryVectorFieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not binary vector")
+			return nil, 0, errors.New("binlog data type is not binary vector")
+		}
+		return data.Data, data.Dim, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, 0, errors.New("binlog reader not yet initialized")
@@ -484,6 +650,15 @@ func (p *BinlogFile) ReadBinaryVector() ([]byte, int, error) {
 // A binlog is designed to support multiple blocks, but so far each binlog always contains only one block.
 // return vectors data and the dimension
 func (p *BinlogFile) ReadFloatVector() ([]float32, int, error) {
+	if p.columnar != nil {
+		data, ok := p.field.(*storage.FloatVectorFieldData)
+		if !ok {
+			log.Error("Binlog file: binlog data type is not float vector")
+			return nil, 0, errors.New("binlog data type is not float vector")
+		}
+		return data.Data, data.Dim, nil
+	}
+
 	if p.reader == nil {
 		log.Error("Binlog file: binlog reader not yet initialized")
 		return nil, 0, errors.New("binlog reader not yet initialized")
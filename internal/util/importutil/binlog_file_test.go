@@ -22,6 +22,8 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/stretchr/testify/assert"
 )
@@ -198,6 +200,12 @@ func Test_BinlogFileOpen(t *testing.T) {
 	dt = binlogFile.DataType()
 	assert.Equal(t, schemapb.DataType_None, dt)
 
+	// failed to decode columnar log
+	chunkManager.readBuf["dummy"] = []byte("PAR1")
+	err = binlogFile.Open("dummy")
+	assert.Error(t, err)
+	assert.False(t, binlogFile.IsColumnar())
+
 	// nil reader protect
 	dataBool, err := binlogFile.ReadBool()
 	assert.Nil(t, dataBool)
@@ -759,3 +767,79 @@ func Test_BinlogFileFloatVector(t *testing.T) {
 
 	binlogFile.Close()
 }
+
+func Test_BinlogFileColumnar(t *testing.T) {
+	collectionMeta := &etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: common.RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+				{FieldID: common.TimeStampField, Name: "ts", DataType: schemapb.DataType_Int64},
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "str", DataType: schemapb.DataType_VarChar},
+				{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+		},
+	}
+	insertData := &storage.InsertData{
+		Data: map[storage.FieldID]storage.FieldData{
+			common.RowIDField:     &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			common.TimeStampField: &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{10, 20}},
+			100:                   &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{100, 200}},
+			101:                   &storage.StringFieldData{NumRows: []int64{2}, Data: []string{"a", "b"}},
+			102:                   &storage.FloatVectorFieldData{NumRows: []int64{2}, Data: []float32{1, 2, 3, 4}, Dim: 2},
+		},
+	}
+	blobs, _, _, err := storage.NewInsertCodec(collectionMeta).SerializeColumnar(2, 3, insertData)
+	assert.NoError(t, err)
+
+	chunkManager := &MockChunkManager{
+		readBuf: map[string][]byte{"dummy": blobs[0].Value},
+	}
+	binlogFile, err := NewBinlogFile(chunkManager)
+	assert.NoError(t, err)
+	defer binlogFile.Close()
+	err = binlogFile.Open("dummy")
+	assert.NoError(t, err)
+	assert.True(t, binlogFile.IsColumnar())
+	assert.Equal(t, []storage.FieldID{common.RowIDField, common.TimeStampField, 100, 101, 102}, binlogFile.FieldIDs())
+
+	// no field selected
+	assert.Equal(t, schemapb.DataType_None, binlogFile.DataType())
+	_, err = binlogFile.ReadInt64()
+	assert.Error(t, err)
+
+	err = binlogFile.SelectField(999)
+	assert.Error(t, err)
+
+	err = binlogFile.SelectField(100)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int64, binlogFile.DataType())
+	dataInt64, err := binlogFile.ReadInt64()
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{100, 200}, dataInt64)
+	_, err = binlogFile.ReadVarchar()
+	assert.Error(t, err)
+
+	err = binlogFile.SelectField(101)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_VarChar, binlogFile.DataType())
+	dataVarchar, err := binlogFile.ReadVarchar()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, dataVarchar)
+
+	err = binlogFile.SelectField(102)
+	assert.NoError(t, err)
+	dataFloatVector, dim, err := binlogFile.ReadFloatVector()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, dim)
+	assert.Equal(t, []float32{1, 2, 3, 4}, dataFloatVector)
+
+	// reopen a binlog
+	chunkManager.readBuf["binlog"] = createBinlogBuf(t, schemapb.DataType_Bool, []bool{true})
+	err = binlogFile.Open("binlog")
+	assert.NoError(t, err)
+	assert.False(t, binlogFile.IsColumnar())
+	assert.Nil(t, binlogFile.FieldIDs())
+	assert.Error(t, binlogFile.SelectField(100))
+}
@@ -0,0 +1,428 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// utf8BOM is the optional byte order mark at the beginning of a csv file exported by some tools
+const utf8BOM = "\ufeff"
+
+// CSVParser is the row-based csv format parser, the first line of the file is a header of field names.
+// Each cell is converted into the form decoded from JSON so that the same validators of the JSON parser
+// check and convert it, then the rows are split into shards and flushed by tryFlushBlocks.
+// A vector cell is either a JSON array or elements separated by CSVOptions.VectorSeparator.
+type CSVParser struct {
+	ctx              context.Context            // for canceling parse process
+	collectionSchema *schemapb.CollectionSchema // collection schema
+	rowIDAllocator   *allocator.IDAllocator     // autoid allocator
+	shardNum         int32                      // sharding number of the collection
+	blockSize        int64                      // maximum size of a read block(unit:byte)
+	bufSize          int64                      // rows consumed each time
+	options          CSVOptions                 // separator, null tokens and vector separator
+
+	validators   map[storage.FieldID]*Validator        // validators for each field
+	primaryKey   *schemapb.FieldSchema                 // primary key field
+	name2FieldID map[string]storage.FieldID            // fields need to be parsed
+	fieldTypes   map[storage.FieldID]schemapb.DataType // data type of each field, to convert cells
+	nullable     map[storage.FieldID]bool              // nullable fields, their columns can be omitted
+	defaults     map[storage.FieldID]interface{}       // default values in JSON form, their columns can be omitted
+	nullTokens   map[string]struct{}                   // cells regarded as null
+
+	rowCounter  int64   // how many rows have been consumed
+	autoIDRange []int64 // auto-generated id range, for example: [1, 10, 20, 25] means id from 1 to 10 and 20 to 25
+
+	report  *ImportReport                         // collects bad rows, nil means the first bad row fails the parsing
+	scratch map[storage.FieldID]storage.FieldData // scratch block to validate rows
+	chunk   ImportChunk                           // part of the rows to parse, the other rows are only read
+
+	callFlushFunc ImportFlushFunc // call back function to flush segment
+}
+
+// NewCSVParser is helper function to create a CSVParser
+func NewCSVParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema, idAlloc *allocator.IDAllocator,
+	shardNum int32, blockSize int64, options CSVOptions, flushFunc ImportFlushFunc) (*CSVParser, error) {
+	if collectionSchema == nil {
+		log.Error("CSV parser: collection schema is nil")
+		return nil, errors.New("collection schema is nil")
+	}
+
+	if idAlloc == nil {
+		log.Error("CSV parser: ID allocator is nil")
+		return nil, errors.New("ID allocator is nil")
+	}
+
+	if flushFunc == nil {
+		log.Error("CSV parser: flush function is nil")
+		return nil, errors.New("flush function is nil")
+	}
+
+	if len(options.VectorSeparator) == 0 {
+		log.Error("CSV parser: vector separator is empty")
+		return nil, errors.New("vector separator is empty")
+	}
+
+	p := &CSVParser{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		rowIDAllocator:   idAlloc,
+		shardNum:         shardNum,
+		blockSize:        blockSize,
+		bufSize:          MinBufferSize,
+		options:          options,
+		validators:       make(map[storage.FieldID]*Validator),
+		name2FieldID:     make(map[string]storage.FieldID),
+		fieldTypes:       make(map[storage.FieldID]schemapb.DataType),
+		nullable:         make(map[storage.FieldID]bool),
+		defaults:         make(map[storage.FieldID]interface{}),
+		nullTokens:       make(map[string]struct{}),
+		autoIDRange:      make([]int64, 0),
+		callFlushFunc:    flushFunc,
+	}
+
+	err := initValidators(collectionSchema, p.validators)
+	if err != nil {
+		log.Error("CSV parser: fail to initialize validators", zap.Error(err))
+		return nil, fmt.Errorf("fail to initialize validators, error: %w", err)
+	}
+
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		if schema.GetIsPrimaryKey() {
+			p.primaryKey = schema
+		}
+		// RowIDField and TimeStampField is internal field, auto-generated primary key is not provided by file
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField || schema.GetAutoID() {
+			continue
+		}
+
+		p.name2FieldID[schema.GetName()] = schema.GetFieldID()
+		p.fieldTypes[schema.GetFieldID()] = schema.GetDataType()
+		if typeutil.IsFieldNullable(schema) {
+			p.nullable[schema.GetFieldID()] = true
+		}
+		if value, ok := defaultJSONValue(schema); ok {
+			p.defaults[schema.GetFieldID()] = value
+		}
+	}
+
+	if p.primaryKey == nil {
+		log.Error("CSV parser: collection schema has no primary key")
+		return nil, errors.New("collection schema has no primary key")
+	}
+
+	for _, token := range options.NullTokens {
+		p.nullTokens[token] = struct{}{}
+	}
+
+	// consume a batch of rows no larger than a block each time
+	if sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema); sizePerRecord > 0 && SingleBlockSize/sizePerRecord > MinBufferSize {
+		p.bufSize = int64(SingleBlockSize / sizePerRecord)
+	}
+
+	return p, nil
+}
+
+func (p *CSVParser) IDRange() []int64 {
+	return p.autoIDRange
+}
+
+func (p *CSVParser) RowCount() int64 {
+	return p.rowCounter
+}
+
+// SetReport sets the report to collect bad rows, the bad rows are skipped if the report allows
+func (p *CSVParser) SetReport(report *ImportReport) {
+	p.report = report
+}
+
+// SetChunk sets the part of the rows to parse
+func (p *CSVParser) SetChunk(chunk ImportChunk) {
+	p.chunk = chunk
+}
+
+// Parse reads the header and all the rows of a csv file and flushes the rows into segments
+func (p *CSVParser) Parse(r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.Comma = p.options.Separator
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		log.Error("CSV parser: the CSV file is empty")
+		return errors.New("the CSV file is empty")
+	}
+	if err != nil {
+		log.Error("CSV parser: failed to read the header", zap.Error(err))
+		return fmt.Errorf("failed to read the header, error: %w", err)
+	}
+
+	columns, err := p.mapColumns(header)
+	if err != nil {
+		return err
+	}
+
+	blocksData := make([]map[storage.FieldID]storage.FieldData, 0, p.shardNum)
+	for i := 0; i < int(p.shardNum); i++ {
+		blockData := initSegmentData(p.collectionSchema)
+		if blockData == nil {
+			log.Error("CSV parser: failed to initialize FieldData list", zap.Int("shardID", i))
+			return fmt.Errorf("failed to initialize FieldData list for shard id %d", i)
+		}
+		blocksData = append(blocksData, blockData)
+	}
+
+	// rows and their line numbers in the file
+	rows := make([]map[storage.FieldID]interface{}, 0, p.bufSize)
+	lines := make([]int, 0, p.bufSize)
+	recordCount := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) && errors.Is(err, csv.ErrFieldCount) {
+			// the record is read completely, only this row is bad
+			recordCount++
+			if !p.chunk.containsRow(int64(recordCount - 1)) {
+				continue
+			}
+			if err = p.report.skipRow(int64(parseErr.StartLine), err); err != nil {
+				log.Error("CSV parser: wrong number of cells", zap.Error(err))
+				return fmt.Errorf("wrong number of cells, error: %w", err)
+			}
+			continue
+		}
+		if err != nil {
+			// csv.ParseError tells the line and column
+			log.Error("CSV parser: failed to read the CSV file", zap.Error(err))
+			return fmt.Errorf("failed to read the CSV file, error: %w", err)
+		}
+		recordCount++
+		if !p.chunk.containsRow(int64(recordCount - 1)) {
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		row, err := p.verifyRow(reader, record, columns)
+		if err == nil && p.report.validateRows() {
+			err = p.validateRow(row, line)
+		}
+		if err != nil {
+			if err = p.report.skipRow(int64(line), err); err != nil {
+				return err
+			}
+			continue
+		}
+		rows = append(rows, row)
+		lines = append(lines, line)
+
+		if len(rows) >= int(p.bufSize) {
+			if err = p.consume(rows, lines, blocksData); err != nil {
+				return err
+			}
+			rows = rows[:0]
+			lines = lines[:0]
+		}
+	}
+
+	// some rows in buffer not consumed, consume them
+	if len(rows) > 0 {
+		if err = p.consume(rows, lines, blocksData); err != nil {
+			return err
+		}
+	}
+
+	if recordCount == 0 {
+		log.Error("CSV parser: row count is 0")
+		return errors.New("row count is 0")
+	}
+
+	log.Info("CSV parser: finished", zap.Int64("rowCount", p.rowCounter))
+	// force flush at the end
+	return tryFlushBlocks(p.ctx, blocksData, p.collectionSchema, p.callFlushFunc, p.blockSize, MaxTotalSizeInMemory, true)
+}
+
+// mapColumns returns the field of each column, columns of the fields with default value or nullable can be omitted
+func (p *CSVParser) mapColumns(header []string) ([]storage.FieldID, error) {
+	columns := make([]storage.FieldID, 0, len(header))
+	mapped := make(map[storage.FieldID]struct{})
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, utf8BOM)
+		}
+		name = strings.TrimSpace(name)
+		fieldID, ok := p.name2FieldID[name]
+		if !ok {
+			log.Error("CSV parser: the column is not defined in collection schema", zap.String("columnName", name))
+			return nil, fmt.Errorf("the column '%s' is not defined in collection schema", name)
+		}
+		if _, ok := mapped[fieldID]; ok {
+			log.Error("CSV parser: duplicated column", zap.String("columnName", name))
+			return nil, fmt.Errorf("duplicated column '%s'", name)
+		}
+		mapped[fieldID] = struct{}{}
+		columns = append(columns, fieldID)
+	}
+
+	for name, fieldID := range p.name2FieldID {
+		_, ok := mapped[fieldID]
+		_, hasDefault := p.defaults[fieldID]
+		if !ok && !hasDefault && !p.nullable[fieldID] {
+			log.Error("CSV parser: the column of field is missed", zap.String("fieldName", name))
+			return nil, fmt.Errorf("column of field '%s' is missed", name)
+		}
+	}
+	return columns, nil
+}
+
+// verifyRow converts the cells of a record into the form decoded from JSON
+func (p *CSVParser) verifyRow(reader *csv.Reader, record []string, columns []storage.FieldID) (map[storage.FieldID]interface{}, error) {
+	row := make(map[storage.FieldID]interface{}, len(p.name2FieldID))
+	for i, cell := range record {
+		fieldID := columns[i]
+		value, err := p.cellValue(fieldID, cell)
+		if err != nil {
+			line, column := reader.FieldPos(i)
+			log.Error("CSV parser: failed to parse the cell", zap.Int("line", line), zap.Int("column", column),
+				zap.Int64("fieldID", fieldID), zap.Error(err))
+			return nil, &fieldError{field: p.validators[fieldID].fieldName,
+				err: fmt.Errorf("failed to parse the cell at line %d column %d, error: %w", line, column, err)}
+		}
+		row[fieldID] = value
+	}
+
+	// the column is omitted, use the default value or regard it as null
+	for _, fieldID := range p.name2FieldID {
+		if _, ok := row[fieldID]; !ok {
+			row[fieldID] = p.defaults[fieldID]
+		}
+	}
+	return row, nil
+}
+
+// cellValue returns the value of a cell in the form decoded from JSON:
+// bool, string, json.Number for numbers and []interface{} for vectors.
+func (p *CSVParser) cellValue(fieldID storage.FieldID, cell string) (interface{}, error) {
+	if _, ok := p.nullTokens[cell]; ok {
+		return nil, nil
+	}
+
+	validator := p.validators[fieldID]
+	switch p.fieldTypes[fieldID] {
+	case schemapb.DataType_Bool:
+		value, err := strconv.ParseBool(strings.TrimSpace(cell))
+		if err != nil {
+			return nil, fmt.Errorf("illegal value '%s' for bool type field '%s'", cell, validator.fieldName)
+		}
+		return value, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double:
+		return json.Number(strings.TrimSpace(cell)), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return cell, nil
+	case schemapb.DataType_BinaryVector, schemapb.DataType_FloatVector:
+		cell = strings.TrimSpace(cell)
+		if strings.HasPrefix(cell, "[") {
+			dec := json.NewDecoder(strings.NewReader(cell))
+			dec.UseNumber()
+			var value []interface{}
+			if err := dec.Decode(&value); err != nil {
+				return nil, fmt.Errorf("illegal JSON array '%s' for vector field '%s', error: %w", cell, validator.fieldName, err)
+			}
+			return value, nil
+		}
+		elements := strings.Split(cell, p.options.VectorSeparator)
+		value := make([]interface{}, 0, len(elements))
+		for _, element := range elements {
+			value = append(value, json.Number(strings.TrimSpace(element)))
+		}
+		return value, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type '%s' of field '%s'", getTypeName(p.fieldTypes[fieldID]), validator.fieldName)
+	}
+}
+
+// validateRow checks a row before it is consumed, so that a bad row can be skipped
+func (p *CSVParser) validateRow(row map[storage.FieldID]interface{}, line int) error {
+	if p.scratch == nil || p.scratch[p.primaryKey.GetFieldID()].RowNum() >= int(p.bufSize) {
+		p.scratch = initSegmentData(p.collectionSchema)
+	}
+	if err := validateRow(row, p.primaryKey, p.validators, p.scratch); err != nil {
+		log.Warn("CSV parser: invalid row", zap.Int("line", line), zap.Error(err))
+		return fmt.Errorf("invalid row at line %d, error: %w", line, err)
+	}
+	return nil
+}
+
+// consume appends a batch of rows to the blocks of their shards
+func (p *CSVParser) consume(rows []map[storage.FieldID]interface{}, lines []int, blocksData []map[storage.FieldID]storage.FieldData) error {
+	if isCanceled(p.ctx) {
+		log.Error("CSV parser: import task was canceled")
+		return errors.New("import task was canceled")
+	}
+
+	// generate auto id for primary key and rowid field
+	rowIDBegin, rowIDEnd, err := p.rowIDAllocator.Alloc(uint32(len(rows)))
+	if err != nil {
+		log.Error("CSV parser: failed to alloc row ID", zap.Int("count", len(rows)), zap.Error(err))
+		return fmt.Errorf("failed to alloc %d row ID, error: %w", len(rows), err)
+	}
+	if rowIDEnd-rowIDBegin != int64(len(rows)) {
+		log.Error("CSV parser: allocated row IDs are not enough", zap.Int("count", len(rows)),
+			zap.Int64("generated", rowIDEnd-rowIDBegin))
+		return fmt.Errorf("try to generate %d row IDs but only %d IDs were allocated", len(rows), rowIDEnd-rowIDBegin)
+	}
+	if p.primaryKey.GetAutoID() {
+		p.autoIDRange = append(p.autoIDRange, rowIDBegin, rowIDEnd)
+	}
+
+	for i, row := range rows {
+		err = appendRowToBlocks(row, rowIDBegin+int64(i), p.primaryKey, p.shardNum, p.validators, blocksData)
+		if err != nil {
+			log.Error("CSV parser: failed to consume the row", zap.Int("line", lines[i]), zap.Error(err))
+			err = fmt.Errorf("failed to consume the row at line %d, error: %w", lines[i], err)
+			// the row is validated if the report allows skipping, a row fails here cannot be skipped
+			if p.report.validateRows() {
+				return err
+			}
+			return p.report.skipRow(int64(lines[i]), err)
+		}
+	}
+	p.rowCounter += int64(len(rows))
+
+	// when the estimated size is close to blockSize, flush
+	return tryFlushBlocks(p.ctx, blocksData, p.collectionSchema, p.callFlushFunc, p.blockSize, MaxTotalSizeInMemory, false)
+}
@@ -0,0 +1,338 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const sampleCSVHeader = "FieldBool,FieldInt8,FieldInt16,FieldInt32,FieldInt64,FieldFloat,FieldDouble,FieldString,FieldBinaryVector,FieldFloatVector\n"
+
+func Test_NewCSVParser(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		return nil
+	}
+
+	// nil schema
+	parser, err := NewCSVParser(ctx, nil, nil, 2, 16, DefaultCSVOptions(), flushFunc)
+	assert.NotNil(t, err)
+	assert.Nil(t, parser)
+
+	// nil id allocator
+	parser, err = NewCSVParser(ctx, sampleSchema(), nil, 2, 16, DefaultCSVOptions(), flushFunc)
+	assert.NotNil(t, err)
+	assert.Nil(t, parser)
+
+	// nil flush function
+	idAllocator := newIDAllocator(ctx, t, nil)
+	parser, err = NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, DefaultCSVOptions(), nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, parser)
+
+	// empty vector separator
+	parser, err = NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, CSVOptions{Separator: ','}, flushFunc)
+	assert.NotNil(t, err)
+	assert.Nil(t, parser)
+
+	// succeed
+	parser, err = NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, DefaultCSVOptions(), flushFunc)
+	assert.Nil(t, err)
+	assert.NotNil(t, parser)
+	assert.Equal(t, 10, len(parser.name2FieldID))
+}
+
+func Test_CSVParserParse(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	rowCount := 0
+	vectors := make(map[int64][]float32)
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		pkField := fields[106].(*storage.Int64FieldData)
+		vectorField := fields[111].(*storage.FloatVectorFieldData)
+		for i, pk := range pkField.Data {
+			vectors[pk] = vectorField.Data[i*4 : (i+1)*4]
+		}
+		rowCount += pkField.RowNum()
+		assert.Equal(t, pkField.RowNum(), fields[common.RowIDField].RowNum())
+		assert.Equal(t, pkField.RowNum(), fields[110].RowNum())
+		return nil
+	}
+
+	// vectors are JSON arrays or separated by the vector separator, with UTF-8 BOM and quoted cells
+	// the vector separator of the last row is wrong
+	content := utf8BOM + sampleCSVHeader +
+		`true,10,101,1001,10001,3.14,1.56,"hello, world","[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n" +
+		`false,11,102,1002,10002,3.15,2.56,"say ""hi""",253;0,2.1;2.2;2.3;2.4` + "\n" +
+		`TRUE, 12 ,103,1003,10003,3.16,3.56,,"252,0","3.1, 3.2, 3.3, 3.4"` + "\n"
+	options := DefaultCSVOptions()
+	options.VectorSeparator = ";"
+	parser, err := NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, options, flushFunc)
+	assert.Nil(t, err)
+	err = parser.Parse(strings.NewReader(content))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 4")
+
+	parser, err = NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, options, flushFunc)
+	assert.Nil(t, err)
+	content = utf8BOM + sampleCSVHeader +
+		`true,10,101,1001,10001,3.14,1.56,"hello, world","[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n" +
+		`false,11,102,1002,10002,3.15,2.56,"say ""hi""",253;0,2.1;2.2;2.3;2.4` + "\n"
+	err = parser.Parse(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, rowCount)
+	assert.Equal(t, int64(2), parser.RowCount())
+	assert.Equal(t, []float32{1.1, 1.2, 1.3, 1.4}, vectors[10001])
+	assert.Equal(t, []float32{2.1, 2.2, 2.3, 2.4}, vectors[10002])
+	assert.Empty(t, parser.IDRange())
+
+	// tab separator, the vector separator is the default comma
+	options = DefaultCSVOptions()
+	options.Separator = '\t'
+	content = strings.ReplaceAll(sampleCSVHeader, ",", "\t") +
+		"true\t10\t101\t1001\t10003\t3.14\t1.56\thello, world\t254,0\t3.1,3.2,3.3,3.4\n"
+	parser, err = NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, options, flushFunc)
+	assert.Nil(t, err)
+	err = parser.Parse(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rowCount)
+	assert.Equal(t, []float32{3.1, 3.2, 3.3, 3.4}, vectors[10003])
+
+	// flush error
+	flushErrFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		return errors.New("error")
+	}
+	parser, err = NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, options, flushErrFunc)
+	assert.Nil(t, err)
+	err = parser.Parse(strings.NewReader(content))
+	assert.NotNil(t, err)
+
+	// canceled
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	parser, err = NewCSVParser(cancelCtx, sampleSchema(), idAllocator, 2, 16, options, flushFunc)
+	assert.Nil(t, err)
+	err = parser.Parse(strings.NewReader(content))
+	assert.NotNil(t, err)
+}
+
+func Test_CSVParserErrors(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		return nil
+	}
+	row := `true,10,101,1001,10001,3.14,1.56,hello,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n"
+
+	parseFunc := func(content string) error {
+		parser, err := NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, DefaultCSVOptions(), flushFunc)
+		assert.Nil(t, err)
+		return parser.Parse(strings.NewReader(content))
+	}
+
+	// empty file
+	assert.NotNil(t, parseFunc(""))
+
+	// no rows
+	assert.NotNil(t, parseFunc(sampleCSVHeader))
+
+	// redundant column
+	assert.NotNil(t, parseFunc(strings.TrimSuffix(sampleCSVHeader, "\n")+",dummy\n"+row))
+
+	// duplicated column
+	assert.NotNil(t, parseFunc(strings.TrimSuffix(sampleCSVHeader, "\n")+",FieldInt8\n"+row))
+
+	// missed column
+	assert.NotNil(t, parseFunc(strings.TrimPrefix(sampleCSVHeader, "FieldBool,")+row))
+
+	// wrong number of cells
+	err := parseFunc(sampleCSVHeader + row + "true,10\n")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 3")
+
+	// unclosed quote
+	err = parseFunc(sampleCSVHeader + row + row + `true,"10,101` + "\n")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 4")
+
+	// illegal bool value
+	err = parseFunc(sampleCSVHeader + row + strings.Replace(row, "true", "yes", 1))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 3 column 1")
+
+	// illegal JSON array
+	err = parseFunc(sampleCSVHeader + strings.Replace(row, "[254, 0]", "[254, 0", 1))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 2 column")
+
+	// int8 overflow
+	err = parseFunc(sampleCSVHeader + row + strings.Replace(row, ",10,", ",1000,", 1))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "line 3")
+	assert.Contains(t, err.Error(), "FieldInt8")
+
+	// wrong vector dimension
+	err = parseFunc(sampleCSVHeader + strings.Replace(row, "1.4]", "1.4, 1.5]", 1))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "FieldFloatVector")
+}
+
+func Test_CSVParserSkipBadRows(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+	rowCount := 0
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		rowCount += fields[106].RowNum()
+		return nil
+	}
+	row := `true,10,101,1001,10001,3.14,1.56,hello,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"` + "\n"
+	content := sampleCSVHeader + row +
+		"true,10\n" +
+		strings.Replace(row, ",10,", ",1000,", 1) +
+		strings.Replace(row, "10001", "10002", 1) +
+		strings.Replace(row, "1.4]", "1.4, 1.5]", 1)
+
+	// 3 bad rows are skipped
+	report := NewImportReport(3, false)
+	report.setFile("a.csv")
+	parser, err := NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, DefaultCSVOptions(), flushFunc)
+	assert.Nil(t, err)
+	parser.SetReport(report)
+	err = parser.Parse(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, rowCount)
+	assert.Equal(t, int64(2), parser.RowCount())
+	assert.Equal(t, int64(3), report.BadRows)
+	assert.Equal(t, int64(3), report.TotalRows)
+	assert.Equal(t, 3, len(report.Errors))
+	assert.Equal(t, int64(3), report.Errors[0].Row)
+	assert.Equal(t, int64(4), report.Errors[1].Row)
+	assert.Equal(t, "FieldInt8", report.Errors[1].Field)
+	assert.Equal(t, int64(6), report.Errors[2].Row)
+	assert.Equal(t, "FieldFloatVector", report.Errors[2].Field)
+
+	// too many bad rows
+	rowCount = 0
+	report = NewImportReport(2, false)
+	parser, err = NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 16, DefaultCSVOptions(), flushFunc)
+	assert.Nil(t, err)
+	parser.SetReport(report)
+	err = parser.Parse(strings.NewReader(content))
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, rowCount)
+	assert.Equal(t, int64(3), report.BadRows)
+}
+
+func Test_CSVParserChunk(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+	ids := make([]int64, 0)
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		ids = append(ids, fields[106].(*storage.Int64FieldData).Data...)
+		return nil
+	}
+
+	var builder strings.Builder
+	builder.WriteString(sampleCSVHeader)
+	for i := 0; i < chunkBlockRows+100; i++ {
+		builder.WriteString(fmt.Sprintf(`true,10,101,1001,%d,3.14,1.56,hello,"[254, 0]","[1.1, 1.2, 1.3, 1.4]"`+"\n", i))
+	}
+
+	// the first block of rows goes to the first chunk, the rest goes to the second
+	parser, err := NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 1024*1024, DefaultCSVOptions(), flushFunc)
+	assert.Nil(t, err)
+	parser.SetChunk(ImportChunk{Index: 1, Count: 2})
+	err = parser.Parse(strings.NewReader(builder.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), parser.RowCount())
+	assert.Equal(t, 100, len(ids))
+	for _, id := range ids {
+		assert.GreaterOrEqual(t, id, int64(chunkBlockRows))
+	}
+
+	ids = ids[:0]
+	parser, err = NewCSVParser(ctx, sampleSchema(), idAllocator, 2, 1024*1024, DefaultCSVOptions(), flushFunc)
+	assert.Nil(t, err)
+	parser.SetChunk(ImportChunk{Index: 0, Count: 2})
+	err = parser.Parse(strings.NewReader(builder.String()))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(chunkBlockRows), parser.RowCount())
+	assert.Equal(t, chunkBlockRows, len(ids))
+}
+
+func Test_CSVParserNullAndDefault(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 101, Name: "FieldVarChar", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "32"}}},
+			{
+				FieldID:    102,
+				Name:       "FieldDefault",
+				DataType:   schemapb.DataType_Int16,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}},
+			},
+			{
+				FieldID:    103,
+				Name:       "FieldNullable",
+				DataType:   schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			},
+		},
+	}
+
+	rowCount := 0
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		rowCount += fields[101].RowNum()
+		assert.Equal(t, fields[101].RowNum(), fields[102].RowNum())
+		assert.Equal(t, fields[101].RowNum(), fields[103].RowNum())
+		return nil
+	}
+
+	options := DefaultCSVOptions()
+	options.NullTokens = []string{"NULL", ""}
+	parser, err := NewCSVParser(ctx, schema, idAllocator, 2, 16, options, flushFunc)
+	assert.Nil(t, err)
+
+	// the column of default value field is omitted, null tokens for nullable field
+	content := "FieldVarChar,FieldNullable\na,1.5\nb,NULL\nc,\n"
+	err = parser.Parse(strings.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rowCount)
+
+	// null for a field which is not nullable
+	parser, err = NewCSVParser(ctx, schema, idAllocator, 2, 16, options, flushFunc)
+	assert.Nil(t, err)
+	content = "FieldVarChar,FieldNullable\nNULL,1.5\n"
+	err = parser.Parse(strings.NewReader(content))
+	assert.NotNil(t, err)
+}
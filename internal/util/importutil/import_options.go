@@ -18,9 +18,11 @@ package importutil
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
@@ -33,15 +35,80 @@ const (
 	StartTs      = "start_ts" // start timestamp to filter data, only data between StartTs and EndTs will be imported
 	EndTs        = "end_ts"   // end timestamp to filter data, only data between StartTs and EndTs will be imported
 	OptionFormat = "start_ts: 10-digit physical timestamp, e.g. 1665995420, default 0 \n" +
-		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n"
+		"end_ts: 10-digit physical timestamp, e.g. 1665995420, default math.MaxInt \n" +
+		"csv_separator: a single character except quote and line break, default ',' \n" +
+		"csv_null_tokens: comma-separated tokens of null value, e.g. NULL,\\N, default none \n" +
+		"csv_vector_separator: non-empty separator of vector elements, default ',' \n" +
+		"validate_only: true or false, only validate files without writing data, default false \n" +
+		"max_bad_rows: non-negative integer, how many bad rows can be skipped, default 0 \n" +
+		"source_type: minio or local, read files from an external source instead of the storage of Milvus \n" +
+		"source_address, bucket, source_access_key_id, source_secret_access_key, source_use_ssl, source_use_iam, " +
+		"source_cloud_provider: endpoint, bucket and credentials of the minio source \n" +
+		"source_root_path: absolute directory of the local source, allowed by dataNode.import.allowedLocalPaths \n"
 	BackupFlag = "backup"
+
+	ValidateOnly = "validate_only" // only validate files and collect bad rows, no data generated
+	MaxBadRows   = "max_bad_rows"  // how many bad rows can be skipped, the task fails if the bad rows exceed the limit
+
+	CSVSeparator       = "csv_separator"        // separator of csv file, a single character, default ','
+	CSVNullTokens      = "csv_null_tokens"      // comma-separated tokens that represent a null value in csv file, default none
+	CSVVectorSeparator = "csv_vector_separator" // separator of vector elements in a csv cell which is not a JSON array, default ','
 )
 
+// CSVOptions controls how the csv files are parsed
+type CSVOptions struct {
+	Separator       rune     // separator between cells
+	NullTokens      []string // cells equal to any of the tokens are regarded as null
+	VectorSeparator string   // separator of vector elements if the cell is not a JSON array
+}
+
+func DefaultCSVOptions() CSVOptions {
+	return CSVOptions{
+		Separator:       ',',
+		NullTokens:      []string{},
+		VectorSeparator: ",",
+	}
+}
+
+// chunkBlockRows is how many contiguous rows of a file go to the same chunk, if the row count of the file is only
+// known after it's parsed
+const chunkBlockRows = 8192
+
+// ImportChunk selects a part of the rows in the data files, so that the files are imported by several tasks in parallel.
+// The rows of a file whose row count is known ahead are split into Count contiguous ranges, other files are split
+// into blocks of chunkBlockRows rows which go to the chunks in turn. The Index-th part is imported.
+type ImportChunk struct {
+	Index int64
+	Count int64 // all the rows are imported if no more than 1
+}
+
+// whole returns true if the chunk is all the rows
+func (c ImportChunk) whole() bool {
+	return c.Count <= 1
+}
+
+// rowRange returns the range [begin, end) of the rows in the chunk, out of a file of total rows
+func (c ImportChunk) rowRange(total int64) (int64, int64) {
+	if c.whole() {
+		return 0, total
+	}
+	return total * c.Index / c.Count, total * (c.Index + 1) / c.Count
+}
+
+// containsRow returns true if the row, counted from 0, belongs to the chunk, for the files whose row count is unknown
+func (c ImportChunk) containsRow(row int64) bool {
+	return c.whole() || (row/chunkBlockRows)%c.Count == c.Index
+}
+
 type ImportOptions struct {
 	OnlyValidate bool
 	TsStartPoint uint64
 	TsEndPoint   uint64
-	IsBackup     bool // whether is triggered by backup tool
+	IsBackup     bool  // whether is triggered by backup tool
+	MaxBadRows   int64 // how many bad rows can be skipped
+	CSV          CSVOptions
+	Source       *SourceOptions // external source of the files, nil if the files are in the storage of Milvus
+	Chunk        ImportChunk    // part of the rows to import, ignored by the backup binlog import
 }
 
 func DefaultImportOptions() ImportOptions {
@@ -49,6 +116,7 @@ func DefaultImportOptions() ImportOptions {
 		OnlyValidate: false,
 		TsStartPoint: 0,
 		TsEndPoint:   math.MaxUint64,
+		CSV:          DefaultCSVOptions(),
 	}
 	return options
 }
@@ -81,7 +149,20 @@ func ValidateOptions(options []*commonpb.KeyValuePair) error {
 	if startTs > endTs {
 		return errors.New("start_ts shouldn't be larger than end_ts")
 	}
-	return nil
+	// ValidateOnly should be bool
+	if value, ok := optionMap[ValidateOnly]; ok {
+		if _, err = strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("illegal value '%s' for %s, it should be true or false", value, ValidateOnly)
+		}
+	}
+	if _, err = ParseMaxBadRows(options); err != nil {
+		return err
+	}
+	if _, err = ParseCSVFromOptions(options); err != nil {
+		return err
+	}
+	_, err = ParseSourceFromOptions(options)
+	return err
 }
 
 // ParseTSFromOptions get (start_ts, end_ts, error) from input options.
@@ -119,3 +200,49 @@ func IsBackup(options []*commonpb.KeyValuePair) bool {
 	}
 	return true
 }
+
+// ParseCSVFromOptions get csv options from input options, the options not provided are default values
+func ParseCSVFromOptions(options []*commonpb.KeyValuePair) (CSVOptions, error) {
+	csvOptions := DefaultCSVOptions()
+	optionMap := funcutil.KeyValuePair2Map(options)
+	if value, ok := optionMap[CSVSeparator]; ok {
+		runes := []rune(value)
+		if len(runes) != 1 || runes[0] == '"' || runes[0] == '\r' || runes[0] == '\n' || runes[0] == utf8.RuneError {
+			return csvOptions, fmt.Errorf("illegal csv separator '%s', it should be a single character except quote and line break", value)
+		}
+		csvOptions.Separator = runes[0]
+	}
+	if value, ok := optionMap[CSVNullTokens]; ok {
+		csvOptions.NullTokens = strings.Split(value, ",")
+	}
+	if value, ok := optionMap[CSVVectorSeparator]; ok {
+		if len(value) == 0 {
+			return csvOptions, errors.New("csv vector separator should not be empty")
+		}
+		csvOptions.VectorSeparator = value
+	}
+	return csvOptions, nil
+}
+
+// IsValidateOnly returns if the request only validates files without writing data
+func IsValidateOnly(options []*commonpb.KeyValuePair) bool {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(ValidateOnly, options)
+	if err != nil {
+		return false
+	}
+	validateOnly, err := strconv.ParseBool(value)
+	return err == nil && validateOnly
+}
+
+// ParseMaxBadRows returns how many bad rows can be skipped, default 0
+func ParseMaxBadRows(options []*commonpb.KeyValuePair) (int64, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(MaxBadRows, options)
+	if err != nil {
+		return 0, nil
+	}
+	maxBadRows, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || maxBadRows < 0 {
+		return 0, fmt.Errorf("illegal value '%s' for %s, it should be a non-negative integer", value, MaxBadRows)
+	}
+	return maxBadRows, nil
+}
@@ -106,3 +106,116 @@ func TestIsBackup(t *testing.T) {
 	})
 	assert.Equal(t, false, noBackup)
 }
+
+func TestParseCSVFromOptions(t *testing.T) {
+	csvOptions, err := ParseCSVFromOptions([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultCSVOptions(), csvOptions)
+
+	csvOptions, err = ParseCSVFromOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_separator", Value: "\t"},
+		{Key: "csv_null_tokens", Value: "NULL,\\N,"},
+		{Key: "csv_vector_separator", Value: " "},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, '\t', csvOptions.Separator)
+	assert.Equal(t, []string{"NULL", "\\N", ""}, csvOptions.NullTokens)
+	assert.Equal(t, " ", csvOptions.VectorSeparator)
+
+	_, err = ParseCSVFromOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_separator", Value: ";;"},
+	})
+	assert.Error(t, err)
+	_, err = ParseCSVFromOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_separator", Value: "\""},
+	})
+	assert.Error(t, err)
+	_, err = ParseCSVFromOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_vector_separator", Value: ""},
+	})
+	assert.Error(t, err)
+
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "csv_separator", Value: "\n"},
+	}))
+}
+
+func TestIsValidateOnly(t *testing.T) {
+	assert.True(t, IsValidateOnly([]*commonpb.KeyValuePair{
+		{Key: "validate_only", Value: "true"},
+	}))
+	assert.True(t, IsValidateOnly([]*commonpb.KeyValuePair{
+		{Key: "validate_only", Value: "True"},
+	}))
+	assert.False(t, IsValidateOnly([]*commonpb.KeyValuePair{
+		{Key: "validate_only", Value: "false"},
+	}))
+	assert.False(t, IsValidateOnly([]*commonpb.KeyValuePair{
+		{Key: "validate_only", Value: "dummy"},
+	}))
+	assert.False(t, IsValidateOnly([]*commonpb.KeyValuePair{}))
+
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "validate_only", Value: "true"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "validate_only", Value: "dummy"},
+	}))
+}
+
+func TestParseMaxBadRows(t *testing.T) {
+	maxBadRows, err := ParseMaxBadRows([]*commonpb.KeyValuePair{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), maxBadRows)
+
+	maxBadRows, err = ParseMaxBadRows([]*commonpb.KeyValuePair{
+		{Key: "max_bad_rows", Value: "100"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), maxBadRows)
+
+	_, err = ParseMaxBadRows([]*commonpb.KeyValuePair{
+		{Key: "max_bad_rows", Value: "-1"},
+	})
+	assert.Error(t, err)
+
+	_, err = ParseMaxBadRows([]*commonpb.KeyValuePair{
+		{Key: "max_bad_rows", Value: "1.5"},
+	})
+	assert.Error(t, err)
+
+	assert.NoError(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "max_bad_rows", Value: "10"},
+	}))
+	assert.Error(t, ValidateOptions([]*commonpb.KeyValuePair{
+		{Key: "max_bad_rows", Value: "dummy"},
+	}))
+}
+
+func TestImportChunk(t *testing.T) {
+	chunk := ImportChunk{}
+	assert.True(t, chunk.whole())
+	begin, end := chunk.rowRange(10)
+	assert.Equal(t, int64(0), begin)
+	assert.Equal(t, int64(10), end)
+	assert.True(t, chunk.containsRow(chunkBlockRows*3))
+
+	// the contiguous ranges of the chunks cover all the rows
+	var last int64
+	for i := int64(0); i < 3; i++ {
+		begin, end = ImportChunk{Index: i, Count: 3}.rowRange(10)
+		assert.Equal(t, last, begin)
+		last = end
+	}
+	assert.Equal(t, int64(10), last)
+
+	// the blocks of rows go to the chunks in turn
+	chunk = ImportChunk{Index: 1, Count: 2}
+	assert.False(t, chunk.whole())
+	assert.False(t, chunk.containsRow(0))
+	assert.False(t, chunk.containsRow(chunkBlockRows-1))
+	assert.True(t, chunk.containsRow(chunkBlockRows))
+	assert.True(t, chunk.containsRow(chunkBlockRows*2-1))
+	assert.False(t, chunk.containsRow(chunkBlockRows*2))
+	assert.True(t, chunk.containsRow(chunkBlockRows*3))
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+)
+
+const (
+	// ProcessedBytesKey is the key of the bytes of data files processed, in the infos of an ImportStarted import result
+	ProcessedBytesKey = "processed_bytes"
+
+	// TotalBytesKey is the key of the total bytes of data files, in the infos of an ImportStarted import result
+	TotalBytesKey = "total_bytes"
+)
+
+// ProgressReportInterval is the minimum interval between two progress reports of an import task
+var ProgressReportInterval = 10 * time.Second
+
+// ImportProgress tracks the bytes of data files processed by an import task, and reports the progress periodically.
+// The bytes of a JSON, CSV or numpy file are counted as they are read, a parquet file is counted once it is finished.
+type ImportProgress struct {
+	fileSizes     map[string]int64                       // size of each data file
+	totalBytes    int64                                  // total size of all data files
+	finishedBytes int64                                  // total size of the finished data files
+	readBytes     int64                                  // bytes read from the data file in progress
+	interval      time.Duration                          // minimum interval between two reports
+	lastReport    time.Time                              // time of the last report
+	reportFunc    func(processedBytes, totalBytes int64) // function to report the progress
+}
+
+func NewImportProgress(interval time.Duration, reportFunc func(processedBytes, totalBytes int64)) *ImportProgress {
+	return &ImportProgress{
+		fileSizes:  make(map[string]int64),
+		interval:   interval,
+		lastReport: time.Now(),
+		reportFunc: reportFunc,
+	}
+}
+
+// addFile counts the size of a data file into the total bytes
+func (p *ImportProgress) addFile(filePath string, size int64) {
+	if p == nil {
+		return
+	}
+	p.totalBytes += size - p.fileSizes[filePath]
+	p.fileSizes[filePath] = size
+}
+
+// reader returns a reader of the data file which counts the bytes read
+func (p *ImportProgress) reader(r io.Reader) io.Reader {
+	if p == nil {
+		return r
+	}
+	return &progressReader{reader: r, progress: p}
+}
+
+// finishFile counts the whole data file as processed
+func (p *ImportProgress) finishFile(filePath string) {
+	if p == nil {
+		return
+	}
+	p.finishedBytes += p.fileSizes[filePath]
+	p.readBytes = 0
+	p.tryReport()
+}
+
+// ProcessedBytes returns the bytes of data files processed, which never exceeds the total bytes
+func (p *ImportProgress) ProcessedBytes() int64 {
+	if p == nil {
+		return 0
+	}
+	processed := p.finishedBytes + p.readBytes
+	if processed > p.totalBytes {
+		return p.totalBytes
+	}
+	return processed
+}
+
+// TotalBytes returns the total size of all data files
+func (p *ImportProgress) TotalBytes() int64 {
+	if p == nil {
+		return 0
+	}
+	return p.totalBytes
+}
+
+// Infos returns the progress as key-value pairs, for the infos of an ImportStarted import result
+func (p *ImportProgress) Infos() []*commonpb.KeyValuePair {
+	return []*commonpb.KeyValuePair{
+		{Key: ProcessedBytesKey, Value: strconv.FormatInt(p.ProcessedBytes(), 10)},
+		{Key: TotalBytesKey, Value: strconv.FormatInt(p.TotalBytes(), 10)},
+	}
+}
+
+func (p *ImportProgress) tryReport() {
+	if p.reportFunc == nil || time.Since(p.lastReport) < p.interval {
+		return
+	}
+	p.lastReport = time.Now()
+	p.reportFunc(p.ProcessedBytes(), p.TotalBytes())
+}
+
+// progressReader counts the bytes read from a data file
+type progressReader struct {
+	reader   io.Reader
+	progress *ImportProgress
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.reader.Read(b)
+	r.progress.readBytes += int64(n)
+	r.progress.tryReport()
+	return n, err
+}
+
+// ParseProgressInfos returns the bytes processed and the total bytes in the infos of an ImportStarted import result,
+// ok is false if the infos contain no progress
+func ParseProgressInfos(infos []*commonpb.KeyValuePair) (processedBytes int64, totalBytes int64, ok bool) {
+	var processedOk, totalOk bool
+	for _, kv := range infos {
+		switch kv.GetKey() {
+		case ProcessedBytesKey:
+			value, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			processedBytes, processedOk = value, err == nil
+		case TotalBytesKey:
+			value, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			totalBytes, totalOk = value, err == nil
+		}
+	}
+	if !processedOk || !totalOk {
+		return 0, 0, false
+	}
+	return processedBytes, totalBytes, true
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ImportProgress(t *testing.T) {
+	reported := make([][2]int64, 0)
+	progress := NewImportProgress(0, func(processedBytes, totalBytes int64) {
+		reported = append(reported, [2]int64{processedBytes, totalBytes})
+	})
+
+	progress.addFile("a.json", 10)
+	progress.addFile("b.parquet", 20)
+	progress.addFile("b.parquet", 30)
+	assert.Equal(t, int64(40), progress.TotalBytes())
+	assert.Equal(t, int64(0), progress.ProcessedBytes())
+
+	// bytes are counted as they are read
+	reader := progress.reader(strings.NewReader("0123456789"))
+	buf := make([]byte, 4)
+	n, err := reader.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, int64(4), progress.ProcessedBytes())
+	assert.Equal(t, [2]int64{4, 40}, reported[len(reported)-1])
+
+	// the whole file is counted once it is finished
+	progress.finishFile("a.json")
+	assert.Equal(t, int64(10), progress.ProcessedBytes())
+	progress.finishFile("b.parquet")
+	assert.Equal(t, int64(40), progress.ProcessedBytes())
+	assert.Equal(t, [2]int64{40, 40}, reported[len(reported)-1])
+
+	processedBytes, totalBytes, ok := ParseProgressInfos(progress.Infos())
+	assert.True(t, ok)
+	assert.Equal(t, int64(40), processedBytes)
+	assert.Equal(t, int64(40), totalBytes)
+
+	// reports are throttled by the interval
+	count := 0
+	progress = NewImportProgress(time.Hour, func(processedBytes, totalBytes int64) {
+		count++
+	})
+	progress.addFile("a.json", 10)
+	progress.finishFile("a.json")
+	assert.Equal(t, 0, count)
+
+	// nil progress does nothing
+	var nilProgress *ImportProgress
+	nilProgress.addFile("a.json", 10)
+	nilProgress.finishFile("a.json")
+	assert.Equal(t, int64(0), nilProgress.ProcessedBytes())
+	assert.Equal(t, int64(0), nilProgress.TotalBytes())
+	r := strings.NewReader("abc")
+	assert.Equal(t, r, nilProgress.reader(r))
+}
+
+func Test_ParseProgressInfos(t *testing.T) {
+	_, _, ok := ParseProgressInfos(nil)
+	assert.False(t, ok)
+
+	_, _, ok = ParseProgressInfos([]*commonpb.KeyValuePair{
+		{Key: ProcessedBytesKey, Value: "10"},
+	})
+	assert.False(t, ok)
+
+	_, _, ok = ParseProgressInfos([]*commonpb.KeyValuePair{
+		{Key: ProcessedBytesKey, Value: "10"},
+		{Key: TotalBytesKey, Value: "x"},
+	})
+	assert.False(t, ok)
+
+	processedBytes, totalBytes, ok := ParseProgressInfos([]*commonpb.KeyValuePair{
+		{Key: "key", Value: "value"},
+		{Key: ProcessedBytesKey, Value: "10"},
+		{Key: TotalBytesKey, Value: "100"},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, int64(10), processedBytes)
+	assert.Equal(t, int64(100), totalBytes)
+}
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+const (
+	// ErrorReportKey is the key of the error report in the infos of import result and import task
+	ErrorReportKey = "error_report"
+
+	// MaxReportedErrors is the maximum number of bad rows whose details are kept in the error report,
+	// the bad rows beyond this limit are only counted
+	MaxReportedErrors = 100
+)
+
+// RowError is the detail of a bad row or the error which fails the import task
+type RowError struct {
+	File   string `json:"file"`
+	Row    int64  `json:"row"`             // row number counted from 1, line number for csv file, 0 if the error is not about a row
+	Field  string `json:"field,omitempty"` // name of the field, empty if the error is not about a field
+	Reason string `json:"reason"`
+}
+
+// ImportReport collects the bad rows of an import task. A bad row is skipped if the number of bad rows
+// doesn't exceed the limit, otherwise the task fails. In validate-only mode, all the bad rows are collected
+// and the task fails at the end if the number of bad rows exceeds the limit.
+type ImportReport struct {
+	TotalRows int64       `json:"total_rows"` // how many rows are parsed, including bad rows
+	BadRows   int64       `json:"bad_rows"`   // how many rows are bad
+	Errors    []*RowError `json:"errors,omitempty"`
+
+	maxBadRows   int64  // how many bad rows can be skipped
+	validateOnly bool   // only validate files, no data generated
+	file         string // the file being parsed
+	rowFailed    bool   // the task is failed by a bad row
+}
+
+func NewImportReport(maxBadRows int64, validateOnly bool) *ImportReport {
+	return &ImportReport{
+		Errors:       make([]*RowError, 0),
+		maxBadRows:   maxBadRows,
+		validateOnly: validateOnly,
+	}
+}
+
+// fieldError is an error about a value of a field
+type fieldError struct {
+	field string
+	err   error
+}
+
+func (e *fieldError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+func (r *ImportReport) setFile(file string) {
+	if r != nil {
+		r.file = file
+	}
+}
+
+// addRows counts the rows consumed, the bad rows are counted by skipRow
+func (r *ImportReport) addRows(count int64) {
+	if r != nil {
+		r.TotalRows += count
+	}
+}
+
+// validateRows returns true if each row should be validated before it is consumed, so that a bad row can be skipped
+func (r *ImportReport) validateRows() bool {
+	return r != nil && (r.validateOnly || r.maxBadRows > 0)
+}
+
+func (r *ImportReport) record(row int64, err error) {
+	if len(r.Errors) >= MaxReportedErrors {
+		return
+	}
+	rowErr := &RowError{
+		File:   r.file,
+		Row:    row,
+		Reason: err.Error(),
+	}
+	var fieldErr *fieldError
+	if errors.As(err, &fieldErr) {
+		rowErr.Field = fieldErr.field
+	}
+	r.Errors = append(r.Errors, rowErr)
+}
+
+// skipRow records a bad row, it returns nil if the row can be skipped, otherwise returns an error to fail the task
+func (r *ImportReport) skipRow(row int64, err error) error {
+	if r == nil {
+		return err
+	}
+
+	r.TotalRows++
+	r.BadRows++
+	r.record(row, err)
+	if r.validateOnly || r.BadRows <= r.maxBadRows {
+		log.Warn("import report: skip a bad row", zap.String("file", r.file), zap.Int64("row", row), zap.Error(err))
+		return nil
+	}
+	r.rowFailed = true
+	if r.maxBadRows == 0 {
+		return err
+	}
+	return fmt.Errorf("the number of bad rows exceeds the limit %d, error: %w", r.maxBadRows, err)
+}
+
+// fail records the error which fails the import task
+func (r *ImportReport) fail(err error) {
+	// the error of a bad row is already recorded
+	if r == nil || err == nil || r.rowFailed {
+		return
+	}
+	r.record(0, err)
+}
+
+// check returns an error if the number of bad rows exceeds the limit, it is called at the end of validate-only mode
+func (r *ImportReport) check() error {
+	if r == nil || r.BadRows <= r.maxBadRows {
+		return nil
+	}
+	return fmt.Errorf("%d bad rows are found, exceeds the limit %d", r.BadRows, r.maxBadRows)
+}
+
+// Infos returns the report as the infos of import result
+func (r *ImportReport) Infos() []*commonpb.KeyValuePair {
+	if r == nil {
+		return nil
+	}
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		log.Warn("import report: failed to marshal the report", zap.Error(err))
+		return nil
+	}
+	return []*commonpb.KeyValuePair{{Key: ErrorReportKey, Value: string(bytes)}}
+}
+
+// validateRow checks a row in the form decoded from JSON by converting it into a scratch block
+func validateRow(row map[storage.FieldID]interface{}, primaryKey *schemapb.FieldSchema,
+	validators map[storage.FieldID]*Validator, scratch map[storage.FieldID]storage.FieldData) error {
+	return appendRowToBlocks(row, 0, primaryKey, 1, validators, []map[storage.FieldID]storage.FieldData{scratch})
+}
@@ -0,0 +1,97 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ImportReportSkipRow(t *testing.T) {
+	// nil report, the first bad row fails the task
+	var report *ImportReport
+	err := report.skipRow(1, errors.New("error"))
+	assert.NotNil(t, err)
+	assert.False(t, report.validateRows())
+	assert.Nil(t, report.Infos())
+	assert.Nil(t, report.check())
+
+	// no bad row can be skipped, the original error is returned
+	report = NewImportReport(0, false)
+	report.setFile("a.json")
+	assert.False(t, report.validateRows())
+	badErr := errors.New("bad row")
+	err = report.skipRow(3, badErr)
+	assert.Equal(t, badErr, err)
+	assert.Equal(t, int64(1), report.BadRows)
+	assert.Equal(t, 1, len(report.Errors))
+	assert.Equal(t, &RowError{File: "a.json", Row: 3, Reason: "bad row"}, report.Errors[0])
+
+	// the error of the bad row is not recorded again
+	report.fail(fmt.Errorf("failed to parse, error: %w", err))
+	assert.Equal(t, 1, len(report.Errors))
+
+	// skip 2 bad rows, the field is known
+	report = NewImportReport(2, false)
+	report.setFile("b.csv")
+	report.addRows(10)
+	assert.True(t, report.validateRows())
+	fieldErr := fmt.Errorf("invalid row, error: %w", &fieldError{field: "FieldInt8", err: errors.New("overflow")})
+	assert.Nil(t, report.skipRow(2, fieldErr))
+	assert.Nil(t, report.skipRow(5, errors.New("error")))
+	assert.Nil(t, report.check())
+	err = report.skipRow(7, errors.New("error"))
+	assert.NotNil(t, err)
+	assert.NotNil(t, report.check())
+	assert.Equal(t, int64(13), report.TotalRows)
+	assert.Equal(t, int64(3), report.BadRows)
+	assert.Equal(t, "FieldInt8", report.Errors[0].Field)
+	assert.Equal(t, "", report.Errors[1].Field)
+
+	// all the bad rows are collected in validate-only mode, only the first MaxReportedErrors are detailed
+	report = NewImportReport(1, true)
+	assert.True(t, report.validateRows())
+	for i := 0; i < MaxReportedErrors+10; i++ {
+		assert.Nil(t, report.skipRow(int64(i+1), errors.New("error")))
+	}
+	assert.Equal(t, int64(MaxReportedErrors+10), report.BadRows)
+	assert.Equal(t, MaxReportedErrors, len(report.Errors))
+	assert.NotNil(t, report.check())
+}
+
+func Test_ImportReportInfos(t *testing.T) {
+	report := NewImportReport(0, false)
+	report.setFile("a.npy")
+	report.addRows(5)
+	report.fail(errors.New("error"))
+	report.fail(nil)
+
+	infos := report.Infos()
+	assert.Equal(t, 1, len(infos))
+	assert.Equal(t, ErrorReportKey, infos[0].GetKey())
+
+	decoded := &ImportReport{}
+	err := json.Unmarshal([]byte(infos[0].GetValue()), decoded)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), decoded.TotalRows)
+	assert.Equal(t, int64(0), decoded.BadRows)
+	assert.Equal(t, []*RowError{{File: "a.npy", Row: 0, Reason: "error"}}, decoded.Errors)
+}
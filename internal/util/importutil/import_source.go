@@ -0,0 +1,252 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"go.uber.org/zap"
+)
+
+// Option keys of an external import source, the files are read from the source instead of the storage of Milvus.
+// The credentials are only kept in memory, they are never persisted with the import task.
+const (
+	SourceType            = "source_type"              // "minio" or "local", the storage of Milvus is used if not provided
+	SourceAddress         = "source_address"           // endpoint of the minio/S3 source, the bucket is given by Bucket
+	SourceAccessKeyID     = "source_access_key_id"     // credential of the minio/S3 source
+	SourceSecretAccessKey = "source_secret_access_key" // credential of the minio/S3 source
+	SourceUseSSL          = "source_use_ssl"           // true or false, default false
+	SourceUseIAM          = "source_use_iam"           // true or false, default false, no credential is required if true
+	SourceCloudProvider   = "source_cloud_provider"    // "aws" or "gcp", default "aws"
+	SourceRootPath        = "source_root_path"         // directory of the local source, the files should be under it
+
+	SourceTypeMinio = "minio"
+	SourceTypeLocal = "local"
+)
+
+// SourceOptions describes an external storage to read the import files from
+type SourceOptions struct {
+	Type            string
+	Address         string
+	Bucket          string
+	AccessKeyID     string `json:"-"`
+	SecretAccessKey string `json:"-"`
+	UseSSL          bool
+	UseIAM          bool
+	CloudProvider   string
+	RootPath        string
+
+	// local directories that are allowed to be a local source, given by the configuration of datanode
+	AllowedLocalPaths []string
+}
+
+// ParseSourceFromOptions returns the external source of the import files, nil if the files are in the storage of Milvus
+func ParseSourceFromOptions(options []*commonpb.KeyValuePair) (*SourceOptions, error) {
+	optionMap := funcutil.KeyValuePair2Map(options)
+	sourceType, ok := optionMap[SourceType]
+	if !ok {
+		return nil, nil
+	}
+
+	parseBool := func(key string) (bool, error) {
+		value, ok := optionMap[key]
+		if !ok {
+			return false, nil
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, fmt.Errorf("illegal value '%s' for %s, it should be true or false", value, key)
+		}
+		return b, nil
+	}
+
+	source := &SourceOptions{
+		Type:            sourceType,
+		Address:         optionMap[SourceAddress],
+		Bucket:          optionMap[Bucket],
+		AccessKeyID:     optionMap[SourceAccessKeyID],
+		SecretAccessKey: optionMap[SourceSecretAccessKey],
+		CloudProvider:   optionMap[SourceCloudProvider],
+		RootPath:        optionMap[SourceRootPath],
+	}
+	var err error
+	if source.UseSSL, err = parseBool(SourceUseSSL); err != nil {
+		return nil, err
+	}
+	if source.UseIAM, err = parseBool(SourceUseIAM); err != nil {
+		return nil, err
+	}
+
+	switch sourceType {
+	case SourceTypeMinio:
+		if source.Address == "" || source.Bucket == "" {
+			return nil, fmt.Errorf("%s and %s are required by the minio source", SourceAddress, Bucket)
+		}
+		if !source.UseIAM && (source.AccessKeyID == "" || source.SecretAccessKey == "") {
+			return nil, fmt.Errorf("%s and %s are required by the minio source if %s is not true",
+				SourceAccessKeyID, SourceSecretAccessKey, SourceUseIAM)
+		}
+		if source.CloudProvider != "" && source.CloudProvider != storage.CloudProviderAWS &&
+			source.CloudProvider != storage.CloudProviderGCP {
+			return nil, fmt.Errorf("illegal value '%s' for %s, it should be %s or %s", source.CloudProvider,
+				SourceCloudProvider, storage.CloudProviderAWS, storage.CloudProviderGCP)
+		}
+	case SourceTypeLocal:
+		if !filepath.IsAbs(source.RootPath) {
+			return nil, fmt.Errorf("%s of the local source should be an absolute path", SourceRootPath)
+		}
+	default:
+		return nil, fmt.Errorf("illegal value '%s' for %s, it should be %s or %s", sourceType, SourceType,
+			SourceTypeMinio, SourceTypeLocal)
+	}
+	return source, nil
+}
+
+// IsSourceCredential returns if the option is a credential of the import source, which should not be persisted
+func IsSourceCredential(key string) bool {
+	return key == SourceAccessKeyID || key == SourceSecretAccessKey
+}
+
+// SplitSourceCredentials splits the credentials of the import source from the other options
+func SplitSourceCredentials(options []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, []*commonpb.KeyValuePair) {
+	infos := make([]*commonpb.KeyValuePair, 0, len(options))
+	credentials := make([]*commonpb.KeyValuePair, 0)
+	for _, kv := range options {
+		if IsSourceCredential(kv.GetKey()) {
+			credentials = append(credentials, kv)
+		} else {
+			infos = append(infos, kv)
+		}
+	}
+	return infos, credentials
+}
+
+// SourceRequiresCredentials returns if the import source cannot be accessed without credentials
+func SourceRequiresCredentials(options []*commonpb.KeyValuePair) bool {
+	optionMap := funcutil.KeyValuePair2Map(options)
+	if optionMap[SourceType] != SourceTypeMinio {
+		return false
+	}
+	useIAM, err := strconv.ParseBool(optionMap[SourceUseIAM])
+	return err != nil || !useIAM
+}
+
+// NewSourceChunkManager creates a read-only chunk manager to read files from the import source
+func NewSourceChunkManager(ctx context.Context, source *SourceOptions) (storage.ChunkManager, error) {
+	switch source.Type {
+	case SourceTypeMinio:
+		factory := storage.NewChunkManagerFactory("minio",
+			storage.Address(source.Address),
+			storage.BucketName(source.Bucket),
+			storage.AccessKeyID(source.AccessKeyID),
+			storage.SecretAccessKeyID(source.SecretAccessKey),
+			storage.UseSSL(source.UseSSL),
+			storage.UseIAM(source.UseIAM),
+			storage.CloudProvider(source.CloudProvider),
+			storage.CreateBucket(false))
+		cm, err := factory.NewPersistentStorageChunkManager(ctx)
+		if err != nil {
+			log.Error("import source: failed to connect the minio source", zap.String("address", source.Address),
+				zap.String("bucket", source.Bucket), zap.Error(err))
+			return nil, fmt.Errorf("failed to connect the minio source '%s', error: %w", source.Address, err)
+		}
+		return storage.NewReadOnlyChunkManager(cm), nil
+	case SourceTypeLocal:
+		rootPath, err := source.localRootPath()
+		if err != nil {
+			return nil, err
+		}
+		return storage.NewReadOnlyChunkManager(storage.NewLocalChunkManager(storage.RootPath(rootPath))), nil
+	default:
+		return nil, fmt.Errorf("illegal import source type '%s'", source.Type)
+	}
+}
+
+// localRootPath returns the real path of the local source after checking it is under an allowed directory
+func (s *SourceOptions) localRootPath() (string, error) {
+	rootPath, err := filepath.EvalSymlinks(s.RootPath)
+	if err != nil {
+		log.Error("import source: failed to resolve the local source", zap.String("rootPath", s.RootPath), zap.Error(err))
+		return "", fmt.Errorf("failed to resolve the local source '%s', error: %w", s.RootPath, err)
+	}
+	for _, allowedPath := range s.AllowedLocalPaths {
+		allowed, err := filepath.EvalSymlinks(allowedPath)
+		if err != nil {
+			log.Warn("import source: failed to resolve the allowed local path", zap.String("allowedPath", allowedPath), zap.Error(err))
+			continue
+		}
+		if isUnderPath(rootPath, allowed) {
+			return rootPath, nil
+		}
+	}
+	log.Error("import source: the local source is not allowed", zap.String("rootPath", s.RootPath),
+		zap.Strings("allowedLocalPaths", s.AllowedLocalPaths))
+	return "", fmt.Errorf("the local source '%s' is not under the allowed local paths of datanode", s.RootPath)
+}
+
+// ResolveFiles returns the paths of the files in the import source. The files of a local source are relative
+// to the root path or absolute paths under it, they are resolved to real paths so that no file outside
+// the root path can be read through a link or "..".
+func (s *SourceOptions) ResolveFiles(filePaths []string) ([]string, error) {
+	if s.Type != SourceTypeLocal {
+		return filePaths, nil
+	}
+	rootPath, err := s.localRootPath()
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]string, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		// the delta log path of backup files is optional to be empty
+		if filePath == "" {
+			resolved = append(resolved, filePath)
+			continue
+		}
+		if !filepath.IsAbs(filePath) {
+			filePath = filepath.Join(rootPath, filePath)
+		}
+		realPath, err := filepath.EvalSymlinks(filePath)
+		if err != nil {
+			log.Error("import source: failed to resolve the file", zap.String("filePath", filePath), zap.Error(err))
+			return nil, fmt.Errorf("failed to resolve the file '%s', error: %w", filePath, err)
+		}
+		if !isUnderPath(realPath, rootPath) || realPath == rootPath {
+			log.Error("import source: the file is out of the local source", zap.String("filePath", filePath),
+				zap.String("rootPath", rootPath))
+			return nil, fmt.Errorf("the file '%s' is out of the local source '%s'", filePath, s.RootPath)
+		}
+		resolved = append(resolved, realPath)
+	}
+	return resolved, nil
+}
+
+// isUnderPath returns if @target is @dir or under @dir, both of them should be cleaned absolute paths
+func isUnderPath(target string, dir string) bool {
+	if target == dir {
+		return true
+	}
+	return strings.HasPrefix(target, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
@@ -0,0 +1,254 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func Test_ParseSourceFromOptions(t *testing.T) {
+	// no source
+	source, err := ParseSourceFromOptions([]*commonpb.KeyValuePair{{Key: Bucket, Value: "a"}})
+	assert.NoError(t, err)
+	assert.Nil(t, source)
+
+	// minio source
+	options := []*commonpb.KeyValuePair{
+		{Key: SourceType, Value: SourceTypeMinio},
+		{Key: SourceAddress, Value: "localhost:9000"},
+		{Key: Bucket, Value: "a"},
+		{Key: SourceAccessKeyID, Value: "id"},
+		{Key: SourceSecretAccessKey, Value: "secret"},
+		{Key: SourceUseSSL, Value: "true"},
+	}
+	source, err = ParseSourceFromOptions(options)
+	assert.NoError(t, err)
+	assert.Equal(t, &SourceOptions{
+		Type:            SourceTypeMinio,
+		Address:         "localhost:9000",
+		Bucket:          "a",
+		AccessKeyID:     "id",
+		SecretAccessKey: "secret",
+		UseSSL:          true,
+	}, source)
+	assert.NoError(t, ValidateOptions(options))
+
+	// credentials are never logged
+	bytes, err := json.Marshal(source)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(bytes), "secret")
+
+	// credentials are not required by IAM
+	source, err = ParseSourceFromOptions([]*commonpb.KeyValuePair{
+		{Key: SourceType, Value: SourceTypeMinio},
+		{Key: SourceAddress, Value: "s3.amazonaws.com"},
+		{Key: Bucket, Value: "a"},
+		{Key: SourceUseIAM, Value: "true"},
+		{Key: SourceCloudProvider, Value: "aws"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, source.UseIAM)
+
+	// local source
+	source, err = ParseSourceFromOptions([]*commonpb.KeyValuePair{
+		{Key: SourceType, Value: SourceTypeLocal},
+		{Key: SourceRootPath, Value: "/data/import"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/data/import", source.RootPath)
+
+	illegalOptions := [][]*commonpb.KeyValuePair{
+		{{Key: SourceType, Value: "dummy"}},
+		{{Key: SourceType, Value: SourceTypeMinio}, {Key: Bucket, Value: "a"}, {Key: SourceUseIAM, Value: "true"}},
+		{{Key: SourceType, Value: SourceTypeMinio}, {Key: SourceAddress, Value: "localhost:9000"}, {Key: SourceUseIAM, Value: "true"}},
+		{{Key: SourceType, Value: SourceTypeMinio}, {Key: SourceAddress, Value: "localhost:9000"}, {Key: Bucket, Value: "a"}},
+		{{Key: SourceType, Value: SourceTypeMinio}, {Key: SourceAddress, Value: "localhost:9000"}, {Key: Bucket, Value: "a"},
+			{Key: SourceUseIAM, Value: "dummy"}},
+		{{Key: SourceType, Value: SourceTypeMinio}, {Key: SourceAddress, Value: "localhost:9000"}, {Key: Bucket, Value: "a"},
+			{Key: SourceUseIAM, Value: "true"}, {Key: SourceUseSSL, Value: "dummy"}},
+		{{Key: SourceType, Value: SourceTypeMinio}, {Key: SourceAddress, Value: "localhost:9000"}, {Key: Bucket, Value: "a"},
+			{Key: SourceUseIAM, Value: "true"}, {Key: SourceCloudProvider, Value: "dummy"}},
+		{{Key: SourceType, Value: SourceTypeLocal}},
+		{{Key: SourceType, Value: SourceTypeLocal}, {Key: SourceRootPath, Value: "data/import"}},
+	}
+	for _, options := range illegalOptions {
+		_, err = ParseSourceFromOptions(options)
+		assert.Error(t, err)
+		assert.Error(t, ValidateOptions(options))
+	}
+}
+
+func Test_SplitSourceCredentials(t *testing.T) {
+	options := []*commonpb.KeyValuePair{
+		{Key: SourceType, Value: SourceTypeMinio},
+		{Key: SourceAccessKeyID, Value: "id"},
+		{Key: SourceSecretAccessKey, Value: "secret"},
+		{Key: StartTs, Value: "0"},
+	}
+	infos, credentials := SplitSourceCredentials(options)
+	assert.Equal(t, []*commonpb.KeyValuePair{options[0], options[3]}, infos)
+	assert.Equal(t, []*commonpb.KeyValuePair{options[1], options[2]}, credentials)
+	assert.True(t, SourceRequiresCredentials(infos))
+
+	infos, credentials = SplitSourceCredentials(options[3:])
+	assert.Equal(t, options[3:], infos)
+	assert.Empty(t, credentials)
+	assert.False(t, SourceRequiresCredentials(infos))
+
+	assert.False(t, SourceRequiresCredentials([]*commonpb.KeyValuePair{
+		{Key: SourceType, Value: SourceTypeMinio},
+		{Key: SourceUseIAM, Value: "true"},
+	}))
+	assert.False(t, SourceRequiresCredentials([]*commonpb.KeyValuePair{
+		{Key: SourceType, Value: SourceTypeLocal},
+	}))
+}
+
+func Test_LocalSource(t *testing.T) {
+	ctx := context.Background()
+	allowedPath := t.TempDir()
+	rootPath := filepath.Join(allowedPath, "import")
+	err := os.MkdirAll(filepath.Join(rootPath, "sub"), os.ModePerm)
+	assert.NoError(t, err)
+	err = os.WriteFile(filepath.Join(rootPath, "sub", "a.json"), []byte("{}"), os.ModePerm)
+	assert.NoError(t, err)
+	outsidePath := t.TempDir()
+	err = os.WriteFile(filepath.Join(outsidePath, "b.json"), []byte("{}"), os.ModePerm)
+	assert.NoError(t, err)
+	err = os.Symlink(filepath.Join(outsidePath, "b.json"), filepath.Join(rootPath, "link.json"))
+	assert.NoError(t, err)
+
+	// local source is disabled if no path is allowed
+	source := &SourceOptions{Type: SourceTypeLocal, RootPath: rootPath}
+	_, err = NewSourceChunkManager(ctx, source)
+	assert.Error(t, err)
+	_, err = source.ResolveFiles([]string{"sub/a.json"})
+	assert.Error(t, err)
+
+	// the root path is not under the allowed paths
+	source.AllowedLocalPaths = []string{outsidePath, "/dummy"}
+	_, err = NewSourceChunkManager(ctx, source)
+	assert.Error(t, err)
+
+	// the root path doesn't exist
+	source.AllowedLocalPaths = []string{allowedPath}
+	source.RootPath = filepath.Join(allowedPath, "dummy")
+	_, err = NewSourceChunkManager(ctx, source)
+	assert.Error(t, err)
+
+	source.RootPath = rootPath
+	cm, err := NewSourceChunkManager(ctx, source)
+	assert.NoError(t, err)
+	realRoot, err := filepath.EvalSymlinks(rootPath)
+	assert.NoError(t, err)
+
+	// relative and absolute paths under the root path
+	filePaths, err := source.ResolveFiles([]string{"sub/a.json", filepath.Join(rootPath, "sub", "a.json"), ""})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(realRoot, "sub", "a.json"), filepath.Join(realRoot, "sub", "a.json"), ""}, filePaths)
+	content, err := cm.Read(ctx, filePaths[0])
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("{}"), content)
+	assert.ErrorIs(t, cm.Write(ctx, filePaths[0], []byte("[]")), storage.ErrReadOnly)
+	assert.ErrorIs(t, cm.Remove(ctx, filePaths[0]), storage.ErrReadOnly)
+
+	// files out of the root path
+	illegalFiles := []string{
+		"../../b.json",
+		filepath.Join(outsidePath, "b.json"),
+		"link.json",
+		"dummy.json",
+		".",
+	}
+	for _, filePath := range illegalFiles {
+		_, err = source.ResolveFiles([]string{filePath})
+		assert.Error(t, err, filePath)
+	}
+
+	// minio source is not required to resolve files
+	source = &SourceOptions{Type: SourceTypeMinio}
+	filePaths, err = source.ResolveFiles([]string{"a/b.json"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/b.json"}, filePaths)
+
+	_, err = NewSourceChunkManager(ctx, &SourceOptions{Type: "dummy"})
+	assert.Error(t, err)
+}
+
+func Test_ImportWrapperLocalSource(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	content := []byte(`{
+		"rows":[
+			{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": 10001, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]},
+			{"FieldBool": false, "FieldInt8": 11, "FieldInt16": 102, "FieldInt32": 1002, "FieldInt64": 10002, "FieldFloat": 3.15, "FieldDouble": 2.56, "FieldString": "hello world", "FieldBinaryVector": [253, 0], "FieldFloatVector": [2.1, 2.2, 2.3, 2.4]}
+		]
+	}`)
+	err := os.WriteFile(filepath.Join(rootPath, "rows.json"), content, os.ModePerm)
+	assert.NoError(t, err)
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+
+	// the chunk manager of Milvus is not used
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, &MockChunkManager{}, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	options := DefaultImportOptions()
+	options.Source = &SourceOptions{Type: SourceTypeLocal, RootPath: rootPath, AllowedLocalPaths: []string{rootPath}}
+	err = wrapper.Import([]string{"rows.json"}, options)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+
+	// the source is not allowed
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, &MockChunkManager{}, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	options.Source.AllowedLocalPaths = nil
+	err = wrapper.Import([]string{"rows.json"}, options)
+	assert.Error(t, err)
+
+	// the file is out of the source
+	options.Source.AllowedLocalPaths = []string{rootPath}
+	err = wrapper.Import([]string{"../rows.json"}, options)
+	assert.Error(t, err)
+}
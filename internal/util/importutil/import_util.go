@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 func isCanceled(ctx context.Context) bool {
@@ -326,8 +327,131 @@ func initValidators(collectionSchema *schemapb.CollectionSchema, validators map[
 		default:
 			return fmt.Errorf("unsupport data type: %s", getTypeName(collectionSchema.Fields[i].DataType))
 		}
+
+		if typeutil.IsFieldNullable(schema) {
+			validators[schema.GetFieldID()].convertFunc = nullableConvertFunc(validators[schema.GetFieldID()].convertFunc, schema.GetName())
+		}
+	}
+
+	return nil
+}
+
+// fillDefaultFieldsData fills the empty fields with rowCount default values, the fields without default value are untouched.
+func fillDefaultFieldsData(collectionSchema *schemapb.CollectionSchema, fieldsData map[storage.FieldID]storage.FieldData, rowCount int) error {
+	for _, schema := range collectionSchema.Fields {
+		if data, ok := fieldsData[schema.GetFieldID()]; ok && data.RowNum() > 0 {
+			continue
+		}
+		_, ok, err := typeutil.GetDefaultValue(schema)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		data, err := storage.GenDefaultFieldData(schema, rowCount)
+		if err != nil {
+			return err
+		}
+		fieldsData[schema.GetFieldID()] = data
+	}
+	return nil
+}
+
+// nullableConvertFunc wraps the convert function of a nullable field, a nil value is stored as
+// zero value with a false validity.
+func nullableConvertFunc(convertFunc func(obj interface{}, field storage.FieldData) error,
+	fieldName string) func(obj interface{}, field storage.FieldData) error {
+	return func(obj interface{}, field storage.FieldData) error {
+		rowCount := field.RowNum()
+		if obj != nil {
+			if err := convertFunc(obj, field); err != nil {
+				return err
+			}
+			return appendValidity(field, rowCount, true, fieldName)
+		}
+
+		switch f := field.(type) {
+		case *storage.BoolFieldData:
+			f.Data = append(f.Data, false)
+			f.NumRows[0]++
+		case *storage.Int8FieldData:
+			f.Data = append(f.Data, 0)
+			f.NumRows[0]++
+		case *storage.Int16FieldData:
+			f.Data = append(f.Data, 0)
+			f.NumRows[0]++
+		case *storage.Int32FieldData:
+			f.Data = append(f.Data, 0)
+			f.NumRows[0]++
+		case *storage.Int64FieldData:
+			f.Data = append(f.Data, 0)
+			f.NumRows[0]++
+		case *storage.FloatFieldData:
+			f.Data = append(f.Data, 0)
+			f.NumRows[0]++
+		case *storage.DoubleFieldData:
+			f.Data = append(f.Data, 0)
+			f.NumRows[0]++
+		case *storage.StringFieldData:
+			f.Data = append(f.Data, "")
+			f.NumRows[0]++
+		default:
+			return fmt.Errorf("field '%s' can not be null", fieldName)
+		}
+		return appendValidity(field, rowCount, false, fieldName)
+	}
+}
+
+// nullableAppendFunc wraps the append function of a nullable field to carry the validity of the source row.
+func nullableAppendFunc(appendFunc func(src storage.FieldData, n int, target storage.FieldData) error,
+	fieldName string) func(src storage.FieldData, n int, target storage.FieldData) error {
+	return func(src storage.FieldData, n int, target storage.FieldData) error {
+		rowCount := target.RowNum()
+		if err := appendFunc(src, n, target); err != nil {
+			return err
+		}
+		validData := src.GetValidData()
+		return appendValidity(target, rowCount, len(validData) == 0 || validData[n], fieldName)
 	}
+}
 
+// appendValidity records the validity of a new row, rowCount is the row number before the new row.
+// An empty ValidData means all rows are valid, so it is materialized only when the first null row arrives.
+func appendValidity(field storage.FieldData, rowCount int, valid bool, fieldName string) error {
+	var validData *[]bool
+	switch f := field.(type) {
+	case *storage.BoolFieldData:
+		validData = &f.ValidData
+	case *storage.Int8FieldData:
+		validData = &f.ValidData
+	case *storage.Int16FieldData:
+		validData = &f.ValidData
+	case *storage.Int32FieldData:
+		validData = &f.ValidData
+	case *storage.Int64FieldData:
+		validData = &f.ValidData
+	case *storage.FloatFieldData:
+		validData = &f.ValidData
+	case *storage.DoubleFieldData:
+		validData = &f.ValidData
+	case *storage.StringFieldData:
+		validData = &f.ValidData
+	default:
+		return fmt.Errorf("field '%s' can not be null", fieldName)
+	}
+
+	if len(*validData) == 0 {
+		if valid {
+			return nil
+		}
+		*validData = make([]bool, rowCount, rowCount+1)
+		for i := range *validData {
+			(*validData)[i] = true
+		}
+	}
+	*validData = append(*validData, valid)
 	return nil
 }
 
@@ -343,6 +467,11 @@ func printFieldsDataInfo(fieldsData map[storage.FieldID]storage.FieldData, msg s
 	log.Info(msg, stats...)
 }
 
+// IsRowBasedFileType returns true for the file types that each file contains all fields of rows
+func IsRowBasedFileType(fileType string) bool {
+	return fileType == JSONFileExt || fileType == ParquetFileExt || fileType == CSVFileExt
+}
+
 // GetFileNameAndExt extracts file name and extension
 // for example: "/a/b/c.ttt" returns "c" and ".ttt"
 func GetFileNameAndExt(filePath string) (string, string) {
@@ -491,6 +620,65 @@ func tryFlushBlocks(ctx context.Context,
 	return nil
 }
 
+// appendRowToBlocks appends a row in the form decoded from JSON to the block of its shard.
+// The shard is decided by the primary key, the rowID is used as primary key if the primary key is auto-generated.
+func appendRowToBlocks(row map[storage.FieldID]interface{}, rowID int64, primaryKey *schemapb.FieldSchema, shardNum int32,
+	validators map[storage.FieldID]*Validator, blocksData []map[storage.FieldID]storage.FieldData) error {
+	var shard uint32
+	pkID := primaryKey.GetFieldID()
+	if primaryKey.GetDataType() == schemapb.DataType_VarChar {
+		pk, ok := row[pkID].(string)
+		if !ok {
+			return &fieldError{field: primaryKey.GetName(),
+				err: fmt.Errorf("illegal value '%v' for varchar primary key '%s'", row[pkID], primaryKey.GetName())}
+		}
+		shard = typeutil.HashString2Uint32(pk) % uint32(shardNum)
+		pkArray := blocksData[shard][pkID].(*storage.StringFieldData)
+		pkArray.Data = append(pkArray.Data, pk)
+		pkArray.NumRows[0]++
+	} else {
+		pk := rowID
+		if !primaryKey.GetAutoID() {
+			num, ok := row[pkID].(json.Number)
+			if !ok {
+				return &fieldError{field: primaryKey.GetName(),
+					err: fmt.Errorf("illegal value '%v' for int64 primary key '%s'", row[pkID], primaryKey.GetName())}
+			}
+			var err error
+			pk, err = strconv.ParseInt(string(num), 10, 64)
+			if err != nil {
+				return &fieldError{field: primaryKey.GetName(),
+					err: fmt.Errorf("failed to parse primary key '%s', error: %w", num, err)}
+			}
+		}
+		hash, err := typeutil.Hash32Int64(pk)
+		if err != nil {
+			return fmt.Errorf("failed to hash primary key %d, error: %w", pk, err)
+		}
+		shard = hash % uint32(shardNum)
+		pkArray := blocksData[shard][pkID].(*storage.Int64FieldData)
+		pkArray.Data = append(pkArray.Data, pk)
+		pkArray.NumRows[0]++
+	}
+
+	// set rowid field
+	rowIDField := blocksData[shard][common.RowIDField].(*storage.Int64FieldData)
+	rowIDField.Data = append(rowIDField.Data, rowID)
+	rowIDField.NumRows[0]++
+
+	// convert value and consume
+	for fieldID, validator := range validators {
+		if validator.primaryKey {
+			continue
+		}
+		if err := validator.convertFunc(row[fieldID], blocksData[shard][fieldID]); err != nil {
+			return &fieldError{field: validator.fieldName,
+				err: fmt.Errorf("failed to convert value for field '%s', error: %w", validator.fieldName, err)}
+		}
+	}
+	return nil
+}
+
 func getTypeName(dt schemapb.DataType) string {
 	switch dt {
 	case schemapb.DataType_Bool:
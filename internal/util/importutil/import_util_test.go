@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/stretchr/testify/assert"
 )
@@ -447,6 +448,86 @@ func Test_InitValidators(t *testing.T) {
 	})
 }
 
+func Test_InitValidatorsNullable(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "FieldInt64",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:  101,
+				Name:     "FieldDouble",
+				DataType: schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.NullableKey, Value: "true"},
+				},
+			},
+		},
+	}
+
+	validators := make(map[storage.FieldID]*Validator)
+	err := initValidators(schema, validators)
+	assert.Nil(t, err)
+
+	fields := initSegmentData(schema)
+	fieldData := fields[101].(*storage.DoubleFieldData)
+
+	err = validators[101].convertFunc(jsonNumber("1.5"), fieldData)
+	assert.Nil(t, err)
+	assert.Empty(t, fieldData.ValidData)
+
+	err = validators[101].convertFunc(nil, fieldData)
+	assert.Nil(t, err)
+	err = validators[101].convertFunc(jsonNumber("2.5"), fieldData)
+	assert.Nil(t, err)
+	assert.Equal(t, []float64{1.5, 0, 2.5}, fieldData.Data)
+	assert.Equal(t, []bool{true, false, true}, fieldData.ValidData)
+	assert.Equal(t, 3, fieldData.RowNum())
+
+	err = validators[101].convertFunc("dummy", fieldData)
+	assert.NotNil(t, err)
+
+	// null value is illegal for the non-nullable field
+	err = validators[100].convertFunc(nil, fields[100])
+	assert.NotNil(t, err)
+}
+
+func Test_FillDefaultFieldsData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "ID", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID: 101, Name: "Age", DataType: schemapb.DataType_Int16,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}},
+			},
+			{
+				FieldID: 102, Name: "Weight", DataType: schemapb.DataType_Float,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "1.5"}},
+			},
+		},
+	}
+
+	fieldsData := initSegmentData(schema)
+	fieldsData[100] = &storage.Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}}
+	fieldsData[102] = &storage.FloatFieldData{NumRows: []int64{2}, Data: []float32{3, 4}}
+	err := fillDefaultFieldsData(schema, fieldsData, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []int16{18, 18}, fieldsData[101].(*storage.Int16FieldData).Data)
+	assert.Equal(t, []int64{2}, fieldsData[101].(*storage.Int16FieldData).NumRows)
+	// provided field is untouched
+	assert.Equal(t, []float32{3, 4}, fieldsData[102].(*storage.FloatFieldData).Data)
+
+	schema.Fields[1].TypeParams[0].Value = "dummy"
+	fieldsData[101] = &storage.Int16FieldData{NumRows: []int64{0}}
+	err = fillDefaultFieldsData(schema, fieldsData, 2)
+	assert.NotNil(t, err)
+}
+
 func Test_GetFileNameAndExt(t *testing.T) {
 	filePath := "aaa/bbb/ccc.txt"
 	name, ext := GetFileNameAndExt(filePath)
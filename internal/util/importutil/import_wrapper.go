@@ -20,7 +20,9 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 
+	"github.com/apache/arrow/go/v8/parquet"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
@@ -37,8 +39,10 @@ import (
 )
 
 const (
-	JSONFileExt  = ".json"
-	NumpyFileExt = ".npy"
+	JSONFileExt    = ".json"
+	NumpyFileExt   = ".npy"
+	ParquetFileExt = ".parquet"
+	CSVFileExt     = ".csv"
 
 	// supposed size of a single block, to control a binlog file size, the max biglog file size is no more than 2*SingleBlockSize
 	SingleBlockSize = 16 * 1024 * 1024 // 16MB
@@ -62,6 +66,25 @@ const (
 // ReportImportAttempts is the maximum # of attempts to retry when import fails.
 var ReportImportAttempts uint = 10
 
+// RetryableKey is the key in the infos of a failed import result, the task is retryable on another DataNode if it
+// failed by the DataNode or the services it depends on, rather than by the data files.
+const RetryableKey = "retryable"
+
+// RetryableInfo returns the info which marks a failed import result retryable
+func RetryableInfo() *commonpb.KeyValuePair {
+	return &commonpb.KeyValuePair{Key: RetryableKey, Value: "true"}
+}
+
+// IsRetryable returns true if the infos of a failed import result are marked retryable
+func IsRetryable(infos []*commonpb.KeyValuePair) bool {
+	for _, kv := range infos {
+		if kv.GetKey() == RetryableKey {
+			return kv.GetValue() == "true"
+		}
+	}
+	return false
+}
+
 type ImportFlushFunc func(fields map[storage.FieldID]storage.FieldData, shardID int) error
 type AssignSegmentFunc func(shardID int) (int64, string, error)
 type CreateBinlogsFunc func(fields map[storage.FieldID]storage.FieldData, segmentID int64) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, error)
@@ -95,6 +118,8 @@ type ImportWrapper struct {
 	reportImportAttempts uint                                      // attempts count if report function get error
 
 	workingSegments map[int]*WorkingSegment // a map shard id to working segments
+	report          *ImportReport           // bad rows of row-based files and the error which fails the task
+	progress        *ImportProgress         // bytes of data files processed
 }
 
 func NewImportWrapper(ctx context.Context, collectionSchema *schemapb.CollectionSchema, shardNum int32, segmentSize int64,
@@ -179,6 +204,14 @@ func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionS
 		}
 	}
 
+	// file of field with default value can be omitted
+	optionalFieldNames := make(map[string]interface{})
+	for _, schema := range p.collectionSchema.Fields {
+		if _, ok, _ := typeutil.GetDefaultValue(schema); ok {
+			optionalFieldNames[schema.GetName()] = nil
+		}
+	}
+
 	// check redundant file
 	fileNames := make(map[string]interface{})
 	for _, filePath := range filePaths {
@@ -194,7 +227,8 @@ func (p *ImportWrapper) validateColumnBasedFiles(filePaths []string, collectionS
 	// check missed file
 	for name := range requiredFieldNames {
 		_, ok := fileNames[name]
-		if !ok {
+		_, optional := optionalFieldNames[name]
+		if !ok && !optional {
 			log.Error("import wrapper: there is no file corresponding to field", zap.String("fieldName", name))
 			return fmt.Errorf("there is no file corresponding to field '%s'", name)
 		}
@@ -216,21 +250,21 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 		filePath := filePaths[i]
 		name, fileType := GetFileNameAndExt(filePath)
 
-		// only allow json file or numpy file
-		if fileType != JSONFileExt && fileType != NumpyFileExt {
+		// only allow json file, parquet file, csv file or numpy file
+		if fileType != JSONFileExt && fileType != ParquetFileExt && fileType != CSVFileExt && fileType != NumpyFileExt {
 			log.Error("import wrapper: unsupported file type", zap.String("filePath", filePath))
 			return false, fmt.Errorf("unsupported file type: '%s'", filePath)
 		}
 
 		// we use the first file to determine row-based or column-based
-		if i == 0 && fileType == JSONFileExt {
+		if i == 0 && IsRowBasedFileType(fileType) {
 			rowBased = true
 		}
 
 		// check file type
-		// row-based only support json type, column-based only support numpy type
+		// row-based only support json, parquet and csv type, column-based only support numpy type
 		if rowBased {
-			if fileType != JSONFileExt {
+			if !IsRowBasedFileType(fileType) {
 				log.Error("import wrapper: unsupported file type for row-based mode", zap.String("filePath", filePath))
 				return rowBased, fmt.Errorf("unsupported file type for row-based mode: '%s'", filePath)
 			}
@@ -268,6 +302,7 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 			return rowBased, fmt.Errorf("the file '%s' size exceeds the maximum size: %d bytes", filePath, MaxFileSize)
 		}
 		totalSize += size
+		p.progress.addFile(filePath, size)
 	}
 
 	// especially for column-base, total size of files cannot exceed MaxTotalSizeInMemory
@@ -294,12 +329,87 @@ func (p *ImportWrapper) fileValidation(filePaths []string) (bool, error) {
 // if onlyValidate is true, this process only do validation, no data generated, flushFunc will not be called
 func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error {
 	log.Info("import wrapper: begin import", zap.Any("filePaths", filePaths), zap.Any("options", options))
+	// files of an external source are read by a separate read-only chunk manager
+	if options.Source != nil {
+		cm, err := NewSourceChunkManager(p.ctx, options.Source)
+		if err != nil {
+			return err
+		}
+		filePaths, err = options.Source.ResolveFiles(filePaths)
+		if err != nil {
+			return err
+		}
+		p.chunkManager = cm
+	}
+
 	// data restore function to import milvus native binlog files(for backup/restore tools)
 	// the backup/restore tool provide two paths for a partition, the first path is binlog path, the second is deltalog path
 	if options.IsBackup && p.isBinlogImport(filePaths) {
 		return p.doBinlogImport(filePaths, options.TsStartPoint, options.TsEndPoint)
 	}
 
+	p.report = NewImportReport(options.MaxBadRows, options.OnlyValidate)
+	p.progress = NewImportProgress(ProgressReportInterval, p.reportProgress)
+	err := p.importFiles(filePaths, options)
+	if err != nil {
+		// the report is returned to rootcoord along with the failed reason
+		p.report.fail(err)
+		p.setReportInfos()
+	}
+	return err
+}
+
+// Report returns the report of the last import operation
+func (p *ImportWrapper) Report() *ImportReport {
+	return p.report
+}
+
+// reportProgress notifies the rootcoord of the bytes processed so far, the task is still in ImportStarted state.
+// The progress is only for display, a failed report is ignored.
+func (p *ImportWrapper) reportProgress(processedBytes int64, totalBytes int64) {
+	if p.importResult == nil || p.reportFunc == nil {
+		return
+	}
+	log.Info("import wrapper: report progress", zap.Int64("processedBytes", processedBytes), zap.Int64("totalBytes", totalBytes))
+	progress := &rootcoordpb.ImportResult{
+		Status:     p.importResult.GetStatus(),
+		TaskId:     p.importResult.GetTaskId(),
+		DatanodeId: p.importResult.GetDatanodeId(),
+		State:      commonpb.ImportState_ImportStarted,
+		RowCount:   p.importResult.GetRowCount(),
+		Infos:      p.progress.Infos(),
+	}
+	if err := p.reportFunc(progress); err != nil {
+		log.Warn("import wrapper: fail to report import progress to RootCoord", zap.Error(err))
+	}
+}
+
+// setReportInfos sets the report into the infos of import result
+func (p *ImportWrapper) setReportInfos() {
+	if p.importResult == nil {
+		return
+	}
+	infos := make([]*commonpb.KeyValuePair, 0, len(p.importResult.GetInfos())+1)
+	for _, kv := range p.importResult.GetInfos() {
+		if kv.GetKey() != ErrorReportKey {
+			infos = append(infos, kv)
+		}
+	}
+	p.importResult.Infos = append(infos, p.report.Infos()...)
+}
+
+// setRetryable marks the import result retryable, it is called when the task fails by assigning, saving or sealing
+// segments, which are done by the DataNode and DataCoord
+func (p *ImportWrapper) setRetryable() {
+	if p.importResult == nil || IsRetryable(p.importResult.GetInfos()) {
+		return
+	}
+	p.importResult.Infos = append(p.importResult.Infos, RetryableInfo())
+}
+
+// importFiles parses general data files, validates and consumes them
+func (p *ImportWrapper) importFiles(filePaths []string, options ImportOptions) error {
+
 	// normal logic for import general data files
 	rowBased, err := p.fileValidation(filePaths)
 	if err != nil {
@@ -314,14 +424,28 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 			filePath := filePaths[i]
 			_, fileType := GetFileNameAndExt(filePath)
 			log.Info("import wrapper:  row-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
+			p.report.setFile(filePath)
 
 			if fileType == JSONFileExt {
-				err = p.parseRowBasedJSON(filePath, options.OnlyValidate)
+				err = p.parseRowBasedJSON(filePath, options.OnlyValidate, options.Chunk)
 				if err != nil {
 					log.Error("import wrapper: failed to parse row-based json file", zap.Error(err), zap.String("filePath", filePath))
 					return err
 				}
+			} else if fileType == ParquetFileExt {
+				err = p.parseRowBasedParquet(filePath, options.OnlyValidate, options.Chunk)
+				if err != nil {
+					log.Error("import wrapper: failed to parse row-based parquet file", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
+			} else if fileType == CSVFileExt {
+				err = p.parseRowBasedCSV(filePath, options.OnlyValidate, options.Chunk, options.CSV)
+				if err != nil {
+					log.Error("import wrapper: failed to parse row-based csv file", zap.Error(err), zap.String("filePath", filePath))
+					return err
+				}
 			} // no need to check else, since the fileValidation() already do this
+			p.progress.finishFile(filePath)
 
 			// trigger gc after each file finished
 			triggerGC()
@@ -338,6 +462,7 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 		}
 
 		rowCount := 0
+		totalRows := 0 // row count of the numpy files, including the rows out of the chunk
 
 		// function to combine column data into fieldsData
 		combineFunc := func(fields map[storage.FieldID]storage.FieldData) error {
@@ -386,31 +511,60 @@ func (p *ImportWrapper) Import(filePaths []string, options ImportOptions) error
 			filePath := filePaths[i]
 			_, fileType := GetFileNameAndExt(filePath)
 			log.Info("import wrapper:  column-based file ", zap.Any("filePath", filePath), zap.Any("fileType", fileType))
+			p.report.setFile(filePath)
 
 			if fileType == NumpyFileExt {
-				err = p.parseColumnBasedNumpy(filePath, options.OnlyValidate, combineFunc)
-
+				// numpy files are fully parsed in validate-only mode to check values and row counts,
+				// the splitFieldsData() is skipped instead
+				fileRows, err := p.parseColumnBasedNumpy(filePath, false, options.Chunk, combineFunc)
 				if err != nil {
 					log.Error("import wrapper: failed to parse column-based numpy file", zap.Error(err), zap.String("filePath", filePath))
 					return err
 				}
+
+				// each chunk reads the same row range of all the files, the files must have the same row count
+				if fileRows > 0 {
+					if totalRows > 0 && totalRows != fileRows {
+						return fmt.Errorf("the numpy file '%s' row count %d doesn't equal to other files row count: %d", filePath, fileRows, totalRows)
+					}
+					totalRows = fileRows
+				}
 			}
 			// no need to check else, since the fileValidation() already do this
+			p.progress.finishFile(filePath)
 		}
 
 		// trigger after read finished
 		triggerGC()
 
-		// split fields data into segments
-		err := p.splitFieldsData(fieldsData, SingleBlockSize)
+		// fill the fields whose files are omitted with default values
+		err = fillDefaultFieldsData(p.collectionSchema, fieldsData, rowCount)
 		if err != nil {
+			log.Error("import wrapper: failed to fill default values", zap.Error(err))
 			return err
 		}
 
+		p.report.setFile("")
+		p.report.addRows(int64(rowCount))
+
+		// split fields data into segments, no data generated in validate-only mode or for an empty chunk
+		if !options.OnlyValidate && rowCount > 0 {
+			err := p.splitFieldsData(fieldsData, SingleBlockSize)
+			if err != nil {
+				return err
+			}
+		}
+
 		// trigger after write finished
 		triggerGC()
 	}
 
+	// in validate-only mode, all the bad rows are collected before the task fails
+	if err = p.report.check(); err != nil {
+		log.Error("import wrapper: too many bad rows", zap.Error(err))
+		return err
+	}
+
 	return p.reportPersisted(p.reportImportAttempts)
 }
 
@@ -424,6 +578,7 @@ func (p *ImportWrapper) reportPersisted(reportAttempts uint) error {
 
 	// report file process state
 	p.importResult.State = commonpb.ImportState_ImportPersisted
+	p.setReportInfos()
 	// persist state task is valuable, retry more times in case fail this task only because of network error
 	reportErr := retry.Do(p.ctx, func() error {
 		return p.reportFunc(p.importResult)
@@ -499,7 +654,7 @@ func (p *ImportWrapper) doBinlogImport(filePaths []string, tsStartPoint uint64,
 }
 
 // parseRowBasedJSON is the entry of row-based json import operation
-func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) error {
+func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool, chunk ImportChunk) error {
 	tr := timerecord.NewTimeRecorder("json row-based parser: " + filePath)
 
 	// for minio storage, chunkManager will download file into local memory
@@ -511,8 +666,13 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 	defer file.Close()
 
 	// parse file
-	reader := bufio.NewReader(file)
+	reader := bufio.NewReader(p.progress.reader(file))
 	parser := NewJSONParser(p.ctx, p.collectionSchema)
+	parser.SetChunk(chunk)
+	err = parser.SetReport(p.report)
+	if err != nil {
+		return err
+	}
 
 	// if only validate, we input a empty flushFunc so that the consumer do nothing but only validation.
 	var flushFunc ImportFlushFunc
@@ -534,6 +694,7 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 	}
 
 	err = parser.ParseRows(reader, consumer)
+	p.report.addRows(consumer.RowCount())
 	if err != nil {
 		return err
 	}
@@ -547,9 +708,135 @@ func (p *ImportWrapper) parseRowBasedJSON(filePath string, onlyValidate bool) er
 	return nil
 }
 
-// parseColumnBasedNumpy is the entry of column-based numpy import operation
-func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool,
-	combineFunc func(fields map[storage.FieldID]storage.FieldData) error) error {
+// chunkReaderAt reads a file of the chunk manager by ranges
+type chunkReaderAt struct {
+	ctx          context.Context
+	chunkManager storage.ChunkManager
+	filePath     string
+}
+
+func (r *chunkReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	data, err := r.chunkManager.ReadAt(r.ctx, r.filePath, off, int64(len(p)))
+	if err != nil {
+		return 0, err
+	}
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// parseRowBasedParquet is the entry of row-based parquet import operation
+func (p *ImportWrapper) parseRowBasedParquet(filePath string, onlyValidate bool, chunk ImportChunk) error {
+	tr := timerecord.NewTimeRecorder("parquet row-based parser: " + filePath)
+
+	// for minio storage, chunkManager will download file into local memory
+	// for local storage, chunkManager open the file directly
+	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// parquet footer is at the end of file, the reader must be able to seek
+	// read the file by ranges if the chunk manager reader can't seek, no need to load the whole file into memory
+	reader, ok := file.(parquet.ReaderAtSeeker)
+	if !ok {
+		size, err := p.chunkManager.Size(p.ctx, filePath)
+		if err != nil {
+			log.Error("import wrapper: failed to get parquet file size", zap.Error(err), zap.String("filePath", filePath))
+			return fmt.Errorf("failed to get size of parquet file '%s', error: %w", filePath, err)
+		}
+		reader = io.NewSectionReader(&chunkReaderAt{ctx: p.ctx, chunkManager: p.chunkManager, filePath: filePath}, 0, size)
+	}
+
+	// if only validate, we input a empty flushFunc so that the parser do nothing but only validation.
+	var flushFunc ImportFlushFunc
+	if onlyValidate {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			return nil
+		}
+	} else {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			var filePaths = []string{filePath}
+			printFieldsDataInfo(fields, "import wrapper: prepare to flush binlogs", filePaths)
+			return p.flushFunc(fields, shardID)
+		}
+	}
+
+	parser, err := NewParquetParser(p.ctx, p.collectionSchema, p.rowIDAllocator, p.shardNum, SingleBlockSize, flushFunc)
+	if err != nil {
+		return err
+	}
+
+	parser.SetReport(p.report)
+	parser.SetChunk(chunk)
+	err = parser.Parse(reader)
+	p.report.addRows(parser.RowCount())
+	if err != nil {
+		return err
+	}
+
+	// for row-based files, auto-id is generated within ParquetParser
+	p.importResult.AutoIds = append(p.importResult.AutoIds, parser.IDRange()...)
+
+	tr.Elapse("parsed")
+	return nil
+}
+
+// parseRowBasedCSV is the entry of row-based csv import operation
+func (p *ImportWrapper) parseRowBasedCSV(filePath string, onlyValidate bool, chunk ImportChunk, csvOptions CSVOptions) error {
+	tr := timerecord.NewTimeRecorder("csv row-based parser: " + filePath)
+
+	// for minio storage, chunkManager will download file into local memory
+	// for local storage, chunkManager open the file directly
+	file, err := p.chunkManager.Reader(p.ctx, filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// if only validate, we input a empty flushFunc so that the parser do nothing but only validation.
+	var flushFunc ImportFlushFunc
+	if onlyValidate {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			return nil
+		}
+	} else {
+		flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+			var filePaths = []string{filePath}
+			printFieldsDataInfo(fields, "import wrapper: prepare to flush binlogs", filePaths)
+			return p.flushFunc(fields, shardID)
+		}
+	}
+
+	parser, err := NewCSVParser(p.ctx, p.collectionSchema, p.rowIDAllocator, p.shardNum, SingleBlockSize, csvOptions, flushFunc)
+	if err != nil {
+		return err
+	}
+
+	parser.SetReport(p.report)
+	parser.SetChunk(chunk)
+	err = parser.Parse(bufio.NewReader(p.progress.reader(file)))
+	p.report.addRows(parser.RowCount())
+	if err != nil {
+		return err
+	}
+
+	// for row-based files, auto-id is generated within CSVParser
+	p.importResult.AutoIds = append(p.importResult.AutoIds, parser.IDRange()...)
+
+	tr.Elapse("parsed")
+	return nil
+}
+
+// parseColumnBasedNumpy is the entry of column-based numpy import operation, returns the row count of the file
+func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool, chunk ImportChunk,
+	combineFunc func(fields map[storage.FieldID]storage.FieldData) error) (int, error) {
 	tr := timerecord.NewTimeRecorder("numpy parser: " + filePath)
 
 	fileName, _ := GetFileNameAndExt(filePath)
@@ -558,7 +845,7 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 	// for local storage, chunkManager open the file directly
 	file, err := p.chunkManager.Reader(p.ctx, filePath)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer file.Close()
 
@@ -574,7 +861,7 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 
 	// if the numpy file name is not mapping to a field name, ignore it
 	if !found {
-		return nil
+		return 0, nil
 	}
 
 	// the numpy parser return a storage.FieldData, here construct a map[string]storage.FieldData to combine
@@ -586,13 +873,14 @@ func (p *ImportWrapper) parseColumnBasedNumpy(filePath string, onlyValidate bool
 
 	// for numpy file, we say the file name(without extension) is the filed name
 	parser := NewNumpyParser(p.ctx, p.collectionSchema, flushFunc)
-	err = parser.Parse(file, fileName, onlyValidate)
+	parser.SetChunk(chunk)
+	err = parser.Parse(p.progress.reader(file), fileName, onlyValidate)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	tr.Elapse("parsed")
-	return nil
+	return parser.TotalRows(), nil
 }
 
 // appendFunc defines the methods to append data to storage.FieldData
@@ -781,6 +1069,9 @@ func (p *ImportWrapper) splitFieldsData(fieldsData map[storage.FieldID]storage.F
 			log.Error("import wrapper: unsupported field data type")
 			return fmt.Errorf("unsupported field data type: %d", schema.GetDataType())
 		}
+		if typeutil.IsFieldNullable(schema) {
+			appendFuncErr = nullableAppendFunc(appendFuncErr, schema.GetName())
+		}
 		appendFunctions[schema.GetName()] = appendFuncErr
 	}
 
@@ -867,6 +1158,7 @@ func (p *ImportWrapper) flushFunc(fields map[storage.FieldID]storage.FieldData,
 		segID, channelName, err := p.assignSegmentFunc(shardID)
 		if err != nil {
 			log.Error("import wrapper: failed to assign a new segment", zap.Error(err), zap.Int("shardID", shardID))
+			p.setRetryable()
 			return fmt.Errorf("failed to assign a new segment for shard id %d, error: %w", shardID, err)
 		}
 
@@ -887,6 +1179,7 @@ func (p *ImportWrapper) flushFunc(fields map[storage.FieldID]storage.FieldData,
 	if err != nil {
 		log.Error("import wrapper: failed to save binlogs", zap.Error(err), zap.Int("shardID", shardID),
 			zap.Int64("segmentID", segment.segmentID), zap.String("targetChannel", segment.targetChName))
+		p.setRetryable()
 		return fmt.Errorf("failed to save binlogs, shard id %d, segment id %d, channel '%s', error: %w",
 			shardID, segment.segmentID, segment.targetChName, err)
 	}
@@ -916,6 +1209,7 @@ func (p *ImportWrapper) closeWorkingSegment(segment *WorkingSegment) error {
 			zap.Int("shardID", segment.shardID),
 			zap.Int64("segmentID", segment.segmentID),
 			zap.String("targetChannel", segment.targetChName))
+		p.setRetryable()
 		return fmt.Errorf("failed to seal segment, shard id %d, segment id %d, channel '%s', error: %w",
 			segment.shardID, segment.segmentID, segment.targetChName, err)
 	}
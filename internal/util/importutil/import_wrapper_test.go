@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"math"
 	"os"
 	"path"
@@ -650,6 +651,12 @@ func Test_ImportWrapperValidateColumnBasedFiles(t *testing.T) {
 	files = []string{"ID.npy", "Age.npy", "Vector.npy"}
 	err = wrapper.validateColumnBasedFiles(files, schema)
 	assert.Nil(t, err)
+
+	// file of field with default value can be omitted
+	schema.Fields[1].TypeParams = []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}}
+	files = []string{"ID.npy", "Vector.npy"}
+	err = wrapper.validateColumnBasedFiles(files, schema)
+	assert.Nil(t, err)
 }
 
 func Test_ImportWrapperFileValidation(t *testing.T) {
@@ -736,6 +743,22 @@ func Test_ImportWrapperFileValidation(t *testing.T) {
 	assert.Nil(t, err)
 	assert.False(t, rowBased)
 
+	files = []string{"a/1.parquet", "b/2.json"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.Nil(t, err)
+	assert.True(t, rowBased)
+
+	files = []string{"a/1.csv", "b/2.parquet"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.Nil(t, err)
+	assert.True(t, rowBased)
+
+	// unsupported file for row-based
+	files = []string{"a/1.parquet", "b/bol.npy"}
+	rowBased, err = wrapper.fileValidation(files)
+	assert.NotNil(t, err)
+	assert.True(t, rowBased)
+
 	// empty file
 	cm.size = 0
 	wrapper = NewImportWrapper(ctx, schema, int32(shardNum), int64(segmentSize), idAllocator, cm, nil, nil)
@@ -875,6 +898,190 @@ func Test_ImportWrapperReportFailColumnBased_numpy(t *testing.T) {
 	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
 }
 
+func Test_ImportWrapperBadRows(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	// the 2nd and the 4th rows are bad
+	content := []byte(`{
+		"rows":[
+			{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": 10001, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]},
+			{"FieldBool": false, "FieldInt8": 1000, "FieldInt16": 102, "FieldInt32": 1002, "FieldInt64": 10002, "FieldFloat": 3.15, "FieldDouble": 2.56, "FieldString": "hello world", "FieldBinaryVector": [253, 0], "FieldFloatVector": [2.1, 2.2, 2.3, 2.4]},
+			{"FieldBool": true, "FieldInt8": 12, "FieldInt16": 103, "FieldInt32": 1003, "FieldInt64": 10003, "FieldFloat": 3.16, "FieldDouble": 3.56, "FieldString": "hello world", "FieldBinaryVector": [252, 0], "FieldFloatVector": [3.1, 3.2, 3.3, 3.4]},
+			{"FieldBool": false, "FieldInt8": 13, "FieldInt16": 104, "FieldInt32": 1004, "FieldInt64": 10004, "FieldFloat": 3.17, "FieldDouble": 4.56, "FieldString": "hello world", "FieldBinaryVector": [251, 0], "FieldFloatVector": [4.1, 4.2, 4.3]},
+			{"FieldBool": true, "FieldInt8": 14, "FieldInt16": 105, "FieldInt32": 1005, "FieldInt64": 10005, "FieldFloat": 3.18, "FieldDouble": 5.56, "FieldString": "hello world", "FieldBinaryVector": [250, 0], "FieldFloatVector": [5.1, 5.2, 5.3, 5.4]}
+		]
+	}`)
+	filePath := TempFilesPath + "rows_bad.json"
+	err = cm.Write(ctx, filePath, content)
+	assert.NoError(t, err)
+	files := []string{filePath}
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+		Segments:   make([]int64, 0),
+		AutoIds:    make([]int64, 0),
+		RowCount:   0,
+	}
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		return nil
+	}
+	getReport := func() *ImportReport {
+		assert.Equal(t, 1, len(importResult.GetInfos()))
+		assert.Equal(t, ErrorReportKey, importResult.GetInfos()[0].GetKey())
+		report := &ImportReport{}
+		err := json.Unmarshal([]byte(importResult.GetInfos()[0].GetValue()), report)
+		assert.NoError(t, err)
+		return report
+	}
+
+	// validate-only, all the bad rows are reported and nothing is flushed
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, ImportOptions{OnlyValidate: true})
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+	report := getReport()
+	assert.Equal(t, int64(5), report.TotalRows)
+	assert.Equal(t, int64(2), report.BadRows)
+	assert.Equal(t, 2, len(report.Errors))
+	assert.Equal(t, &RowError{File: filePath, Row: 2, Field: "FieldInt8", Reason: report.Errors[0].Reason}, report.Errors[0])
+	assert.Equal(t, "FieldFloatVector", report.Errors[1].Field)
+
+	// validate-only, the bad rows are tolerated
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, ImportOptions{OnlyValidate: true, MaxBadRows: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	assert.Equal(t, int64(2), getReport().BadRows)
+
+	// the bad rows are skipped
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, ImportOptions{MaxBadRows: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	report = getReport()
+	assert.Equal(t, int64(5), report.TotalRows)
+	assert.Equal(t, int64(2), report.BadRows)
+	assert.Equal(t, report, wrapper.Report())
+
+	// too many bad rows
+	rowCounter.rowCount = 0
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(files, ImportOptions{MaxBadRows: 1})
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+	assert.NotEqual(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	assert.Equal(t, int64(2), getReport().BadRows)
+
+	// column-based files are validated without flushing
+	importResult.State = commonpb.ImportState_ImportStarted
+	wrapper = NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(createSampleNumpyFiles(t, cm), ImportOptions{OnlyValidate: true})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, rowCounter.rowCount)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.State)
+	report = getReport()
+	assert.Equal(t, int64(5), report.TotalRows)
+	assert.Equal(t, int64(0), report.BadRows)
+}
+
+func Test_ImportWrapperReportProgress(t *testing.T) {
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	f := storage.NewChunkManagerFactory("local", storage.RootPath(TempFilesPath))
+	ctx := context.Background()
+	cm, err := f.NewPersistentStorageChunkManager(ctx)
+	assert.NoError(t, err)
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	content := []byte(`{
+		"rows":[
+			{"FieldBool": true, "FieldInt8": 10, "FieldInt16": 101, "FieldInt32": 1001, "FieldInt64": 10001, "FieldFloat": 3.14, "FieldDouble": 1.56, "FieldString": "hello world", "FieldBinaryVector": [254, 0], "FieldFloatVector": [1.1, 1.2, 1.3, 1.4]},
+			{"FieldBool": false, "FieldInt8": 11, "FieldInt16": 102, "FieldInt32": 1002, "FieldInt64": 10002, "FieldFloat": 3.15, "FieldDouble": 2.56, "FieldString": "hello world", "FieldBinaryVector": [253, 0], "FieldFloatVector": [2.1, 2.2, 2.3, 2.4]}
+		]
+	}`)
+	filePaths := []string{TempFilesPath + "rows_1.json", TempFilesPath + "rows_2.json"}
+	for _, filePath := range filePaths {
+		err = cm.Write(ctx, filePath, content)
+		assert.NoError(t, err)
+	}
+
+	rowCounter := &rowCounterTest{}
+	assignSegmentFunc, flushFunc, saveSegmentFunc := createMockCallbackFunctions(t, rowCounter)
+
+	defer func(interval time.Duration) {
+		ProgressReportInterval = interval
+	}(ProgressReportInterval)
+	ProgressReportInterval = 0
+
+	importResult := &rootcoordpb.ImportResult{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+		},
+		TaskId:     1,
+		DatanodeId: 1,
+		State:      commonpb.ImportState_ImportStarted,
+	}
+	progresses := make([]*rootcoordpb.ImportResult, 0)
+	reportFunc := func(res *rootcoordpb.ImportResult) error {
+		if res.GetState() == commonpb.ImportState_ImportStarted {
+			progresses = append(progresses, res)
+		}
+		return nil
+	}
+	wrapper := NewImportWrapper(ctx, sampleSchema(), 2, 1, idAllocator, cm, importResult, reportFunc)
+	wrapper.SetCallbackFunctions(assignSegmentFunc, flushFunc, saveSegmentFunc)
+	err = wrapper.Import(filePaths, DefaultImportOptions())
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ImportState_ImportPersisted, importResult.GetState())
+
+	// the progress is reported while the files are read, the import result is not changed
+	assert.NotEmpty(t, progresses)
+	totalBytes := int64(len(content) * len(filePaths))
+	for _, progress := range progresses {
+		assert.Equal(t, int64(1), progress.GetTaskId())
+		assert.Empty(t, progress.GetSegments())
+		processedBytes, total, ok := ParseProgressInfos(progress.GetInfos())
+		assert.True(t, ok)
+		assert.Equal(t, totalBytes, total)
+		assert.LessOrEqual(t, processedBytes, total)
+	}
+	processedBytes, _, _ := ParseProgressInfos(progresses[len(progresses)-1].GetInfos())
+	assert.Equal(t, totalBytes, processedBytes)
+	assert.Equal(t, totalBytes, wrapper.progress.ProcessedBytes())
+	assert.Equal(t, 4, rowCounter.rowCount)
+}
+
 func Test_ImportWrapperIsBinlogImport(t *testing.T) {
 	err := os.MkdirAll(TempFilesPath, os.ModePerm)
 	assert.Nil(t, err)
@@ -1145,14 +1352,16 @@ func Test_ImportWrapperReportPersisted(t *testing.T) {
 	// success
 	err = wrapper.reportPersisted(2)
 	assert.Nil(t, err)
+	assert.False(t, IsRetryable(importResult.GetInfos()))
 
-	// error when closing segments
+	// error when closing segments, the task is retryable on another DataNode
 	wrapper.saveSegmentFunc = func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog, segmentID int64, targetChName string, rowCount int64) error {
 		return errors.New("error")
 	}
 	wrapper.workingSegments[0] = &WorkingSegment{}
 	err = wrapper.reportPersisted(2)
 	assert.Error(t, err)
+	assert.True(t, IsRetryable(importResult.GetInfos()))
 
 	// failed to report
 	wrapper.saveSegmentFunc = func(fieldsInsert []*datapb.FieldBinlog, fieldsStats []*datapb.FieldBinlog, segmentID int64, targetChName string, rowCount int64) error {
@@ -1164,3 +1373,38 @@ func Test_ImportWrapperReportPersisted(t *testing.T) {
 	err = wrapper.reportPersisted(2)
 	assert.Error(t, err)
 }
+
+func Test_ImportWrapperChunkReaderAt(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	filePath := path.Join(rootPath, "data.parquet")
+	content := []byte("0123456789")
+	err := os.WriteFile(filePath, content, os.ModePerm)
+	assert.NoError(t, err)
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+	reader := io.NewSectionReader(&chunkReaderAt{ctx: ctx, chunkManager: cm, filePath: filePath}, 0, int64(len(content)))
+
+	buf := make([]byte, 4)
+	n, err := reader.ReadAt(buf, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []byte("6789"), buf)
+
+	// the section reader stops at the end of file
+	n, err = reader.ReadAt(buf, 8)
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []byte("89"), buf[:n])
+
+	_, err = reader.Seek(-3, io.SeekEnd)
+	assert.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("789"), data)
+
+	// file not found
+	reader = io.NewSectionReader(&chunkReaderAt{ctx: ctx, chunkManager: cm, filePath: path.Join(rootPath, "dummy")}, 0, 4)
+	_, err = reader.ReadAt(buf, 0)
+	assert.Error(t, err)
+}
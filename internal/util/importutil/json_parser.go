@@ -46,12 +46,23 @@ type JSONParser struct {
 	bufSize      int64            // max rows in a buffer
 	fields       map[string]int64 // fields need to be parsed
 	name2FieldID map[string]storage.FieldID
+	nullable     map[storage.FieldID]bool        // nullable fields, value of these fields can be omitted
+	defaults     map[storage.FieldID]interface{} // default values in JSON form, value of these fields can be omitted
+
+	collectionSchema *schemapb.CollectionSchema            // collection schema
+	report           *ImportReport                         // collects bad rows, nil means the first bad row fails the parsing
+	validators       map[storage.FieldID]*Validator        // validators to check rows before they are handled, only for report
+	primaryKey       *schemapb.FieldSchema                 // primary key field, only for report
+	scratch          map[storage.FieldID]storage.FieldData // scratch block to validate rows
+	chunk            ImportChunk                           // part of the rows to parse, the other rows are only decoded
 }
 
 // NewJSONParser helper function to create a JSONParser
 func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema) *JSONParser {
 	fields := make(map[string]int64)
 	name2FieldID := make(map[string]storage.FieldID)
+	nullable := make(map[storage.FieldID]bool)
+	defaults := make(map[storage.FieldID]interface{})
 	for i := 0; i < len(collectionSchema.Fields); i++ {
 		schema := collectionSchema.Fields[i]
 		// RowIDField and TimeStampField is internal field, no need to parse
@@ -65,6 +76,12 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 
 		fields[schema.GetName()] = 0
 		name2FieldID[schema.GetName()] = schema.GetFieldID()
+		if typeutil.IsFieldNullable(schema) {
+			nullable[schema.GetFieldID()] = true
+		}
+		if value, ok := defaultJSONValue(schema); ok {
+			defaults[schema.GetFieldID()] = value
+		}
 	}
 
 	parser := &JSONParser{
@@ -72,12 +89,57 @@ func NewJSONParser(ctx context.Context, collectionSchema *schemapb.CollectionSch
 		bufSize:      MinBufferSize,
 		fields:       fields,
 		name2FieldID: name2FieldID,
+		nullable:     nullable,
+		defaults:     defaults,
+
+		collectionSchema: collectionSchema,
 	}
 	adjustBufSize(parser, collectionSchema)
 
 	return parser
 }
 
+// SetReport sets the report to collect bad rows, the bad rows are skipped before they are handled if the report allows
+func (p *JSONParser) SetReport(report *ImportReport) error {
+	p.report = report
+	if !report.validateRows() {
+		return nil
+	}
+
+	p.validators = make(map[storage.FieldID]*Validator)
+	if err := initValidators(p.collectionSchema, p.validators); err != nil {
+		log.Error("JSON parser: fail to initialize validators", zap.Error(err))
+		return fmt.Errorf("fail to initialize validators, error: %w", err)
+	}
+	for _, schema := range p.collectionSchema.GetFields() {
+		if schema.GetIsPrimaryKey() {
+			p.primaryKey = schema
+		}
+	}
+	if p.primaryKey == nil {
+		log.Error("JSON parser: collection schema has no primary key")
+		return errors.New("collection schema has no primary key")
+	}
+	return nil
+}
+
+// SetChunk sets the part of the rows to parse
+func (p *JSONParser) SetChunk(chunk ImportChunk) {
+	p.chunk = chunk
+}
+
+// validateRow checks a row before it is handled, so that a bad row can be skipped
+func (p *JSONParser) validateRow(row map[storage.FieldID]interface{}, rowNumber int64) error {
+	if p.scratch == nil || p.scratch[p.primaryKey.GetFieldID()].RowNum() >= int(p.bufSize) {
+		p.scratch = initSegmentData(p.collectionSchema)
+	}
+	if err := validateRow(row, p.primaryKey, p.validators, p.scratch); err != nil {
+		log.Warn("JSON parser: invalid row", zap.Int64("rowNumber", rowNumber), zap.Error(err))
+		return fmt.Errorf("invalid row %d, error: %w", rowNumber, err)
+	}
+	return nil
+}
+
 func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSchema) {
 	sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema)
 	if sizePerRecord <= 0 {
@@ -99,6 +161,21 @@ func adjustBufSize(parser *JSONParser, collectionSchema *schemapb.CollectionSche
 	parser.bufSize = int64(bufSize)
 }
 
+// defaultJSONValue returns the default value of the field in the form decoded from JSON, so that it can be
+// converted by validators like a value provided by user. The default value is validated when creating collection.
+func defaultJSONValue(schema *schemapb.FieldSchema) (interface{}, bool) {
+	value, ok, err := typeutil.GetDefaultValue(schema)
+	if !ok || err != nil {
+		return nil, false
+	}
+	switch schema.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_String, schemapb.DataType_VarChar:
+		return value, true
+	default:
+		return json.Number(fmt.Sprint(value)), true
+	}
+}
+
 func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}, error) {
 	stringMap, ok := raw.(map[string]interface{})
 	if !ok {
@@ -112,7 +189,7 @@ func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}
 		fieldID, ok := p.name2FieldID[k]
 		if !ok {
 			log.Error("JSON parser: the field is not defined in collection schema", zap.String("fieldName", k))
-			return nil, fmt.Errorf("the field '%s' is not defined in collection schema", k)
+			return nil, &fieldError{field: k, err: fmt.Errorf("the field '%s' is not defined in collection schema", k)}
 		}
 		row[fieldID] = v
 	}
@@ -121,9 +198,19 @@ func (p *JSONParser) verifyRow(raw interface{}) (map[storage.FieldID]interface{}
 	if len(row) != len(p.name2FieldID) {
 		for k, v := range p.name2FieldID {
 			_, ok := row[v]
+			if defaultValue, has := p.defaults[v]; !ok && has {
+				// value of field is omitted, use the default value
+				row[v] = defaultValue
+				continue
+			}
+			if !ok && p.nullable[v] {
+				// value of nullable field is omitted, regard it as null
+				row[v] = nil
+				continue
+			}
 			if !ok {
 				log.Error("JSON parser: a field value is missed", zap.String("fieldName", k))
-				return nil, fmt.Errorf("value of field '%s' is missed", k)
+				return nil, &fieldError{field: k, err: fmt.Errorf("value of field '%s' is missed", k)}
 			}
 		}
 	}
@@ -186,16 +273,29 @@ func (p *JSONParser) ParseRows(r io.Reader, handler JSONRowHandler) error {
 
 		// read buffer
 		buf := make([]map[storage.FieldID]interface{}, 0, MinBufferSize)
+		// row number counted from 1
+		rowNumber := int64(0)
 		for dec.More() {
 			var value interface{}
 			if err := dec.Decode(&value); err != nil {
 				log.Error("JSON parser: failed to parse row value", zap.Error(err))
 				return fmt.Errorf("failed to parse row value, error: %w", err)
 			}
+			rowNumber++
+			isEmpty = false
+			if !p.chunk.containsRow(rowNumber - 1) {
+				continue
+			}
 
 			row, err := p.verifyRow(value)
+			if err == nil && p.report.validateRows() {
+				err = p.validateRow(row, rowNumber)
+			}
 			if err != nil {
-				return err
+				if err = p.report.skipRow(rowNumber, err); err != nil {
+					return err
+				}
+				continue
 			}
 
 			buf = append(buf, row)
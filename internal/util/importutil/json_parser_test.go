@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
 	"strconv"
 	"strings"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -310,6 +312,152 @@ func Test_JSONParserParseRows_IntPK(t *testing.T) {
 	})
 }
 
+func Test_JSONParserParseRows_OmittedFields(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "ID", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID: 101, Name: "Age", DataType: schemapb.DataType_Int32,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}},
+			},
+			{
+				FieldID: 102, Name: "Name", DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "none"}},
+			},
+			{
+				FieldID: 103, Name: "Score", DataType: schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			},
+			{FieldID: 104, Name: "Flag", DataType: schemapb.DataType_Bool},
+		},
+	}
+	parser := NewJSONParser(ctx, schema)
+	consumer := &mockJSONRowConsumer{
+		rows: make([]map[int64]interface{}, 0),
+	}
+
+	reader := strings.NewReader(`{"rows": [{"ID": 1, "Flag": true}, {"ID": 2, "Age": 20, "Name": "a", "Score": 1.5, "Flag": false}]}`)
+	err := parser.ParseRows(reader, consumer)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(consumer.rows))
+	assert.Equal(t, json.Number("18"), consumer.rows[0][101])
+	assert.Equal(t, "none", consumer.rows[0][102])
+	assert.Nil(t, consumer.rows[0][103])
+	assert.Equal(t, json.Number("20"), consumer.rows[1][101])
+	assert.Equal(t, "a", consumer.rows[1][102])
+	assert.Equal(t, json.Number("1.5"), consumer.rows[1][103])
+
+	// field without default value can not be omitted
+	reader = strings.NewReader(`{"rows": [{"ID": 1}]}`)
+	err = parser.ParseRows(reader, consumer)
+	assert.NotNil(t, err)
+}
+
+func Test_JSONParserParseRows_Chunk(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "ID", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+
+	var builder strings.Builder
+	builder.WriteString(`{"rows": [`)
+	for i := 0; i < chunkBlockRows*2+10; i++ {
+		if i > 0 {
+			builder.WriteString(",")
+		}
+		builder.WriteString(fmt.Sprintf(`{"ID": %d}`, i))
+	}
+	builder.WriteString(`]}`)
+
+	// the blocks of rows go to the chunks in turn
+	parser := NewJSONParser(ctx, schema)
+	parser.SetChunk(ImportChunk{Index: 0, Count: 2})
+	consumer := &mockJSONRowConsumer{
+		rows: make([]map[int64]interface{}, 0),
+	}
+	err := parser.ParseRows(strings.NewReader(builder.String()), consumer)
+	assert.Nil(t, err)
+	assert.Equal(t, chunkBlockRows+10, len(consumer.rows))
+	assert.Equal(t, json.Number("0"), consumer.rows[0][100])
+	assert.Equal(t, json.Number(strconv.Itoa(chunkBlockRows*2)), consumer.rows[chunkBlockRows][100])
+
+	parser = NewJSONParser(ctx, schema)
+	parser.SetChunk(ImportChunk{Index: 1, Count: 2})
+	consumer = &mockJSONRowConsumer{
+		rows: make([]map[int64]interface{}, 0),
+	}
+	err = parser.ParseRows(strings.NewReader(builder.String()), consumer)
+	assert.Nil(t, err)
+	assert.Equal(t, chunkBlockRows, len(consumer.rows))
+	assert.Equal(t, json.Number(strconv.Itoa(chunkBlockRows)), consumer.rows[0][100])
+}
+
+func Test_JSONParserParseRows_SkipBadRows(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "ID", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "Age", DataType: schemapb.DataType_Int8},
+			{FieldID: 102, Name: "Flag", DataType: schemapb.DataType_Bool},
+		},
+	}
+	content := `{"rows": [{"ID": 1, "Age": 10, "Flag": true}, {"ID": 2, "Age": 1000, "Flag": true}, ` +
+		`{"ID": 3, "Age": 10}, {"ID": 4, "Age": 10, "Flag": false}, {"ID": "a", "Age": 10, "Flag": false}]}`
+
+	// 3 bad rows are skipped
+	report := NewImportReport(3, false)
+	parser := NewJSONParser(ctx, schema)
+	err := parser.SetReport(report)
+	assert.Nil(t, err)
+	consumer := &mockJSONRowConsumer{
+		rows: make([]map[int64]interface{}, 0),
+	}
+	err = parser.ParseRows(strings.NewReader(content), consumer)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(consumer.rows))
+	assert.Equal(t, json.Number("1"), consumer.rows[0][100])
+	assert.Equal(t, json.Number("4"), consumer.rows[1][100])
+	assert.Equal(t, int64(3), report.BadRows)
+	assert.Equal(t, 3, len(report.Errors))
+	assert.Equal(t, int64(2), report.Errors[0].Row)
+	assert.Equal(t, "Age", report.Errors[0].Field)
+	assert.Equal(t, int64(3), report.Errors[1].Row)
+	assert.Equal(t, "Flag", report.Errors[1].Field)
+	assert.Equal(t, int64(5), report.Errors[2].Row)
+	assert.Equal(t, "ID", report.Errors[2].Field)
+
+	// too many bad rows
+	report = NewImportReport(2, false)
+	parser = NewJSONParser(ctx, schema)
+	err = parser.SetReport(report)
+	assert.Nil(t, err)
+	err = parser.ParseRows(strings.NewReader(content), consumer)
+	assert.NotNil(t, err)
+
+	// no bad row can be skipped, the report records the first bad row
+	report = NewImportReport(0, false)
+	parser = NewJSONParser(ctx, schema)
+	err = parser.SetReport(report)
+	assert.Nil(t, err)
+	err = parser.ParseRows(strings.NewReader(content), consumer)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, len(report.Errors))
+	assert.Equal(t, "Flag", report.Errors[0].Field)
+
+	// schema without primary key
+	parser = NewJSONParser(ctx, &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 101, Name: "Age", DataType: schemapb.DataType_Int8}},
+	})
+	err = parser.SetReport(NewImportReport(1, false))
+	assert.NotNil(t, err)
+}
+
 func Test_JSONParserParseRows_StrPK(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
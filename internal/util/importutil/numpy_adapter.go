@@ -250,6 +250,58 @@ func (n *NumpyAdapter) checkCount(count int) int {
 	return count
 }
 
+// elementSize returns how many bytes an element occupies in the numpy file
+func (n *NumpyAdapter) elementSize() (int, error) {
+	switch n.dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_BinaryVector:
+		return 1, nil
+	case schemapb.DataType_Int16:
+		return 2, nil
+	case schemapb.DataType_Int32, schemapb.DataType_Float:
+		return 4, nil
+	case schemapb.DataType_Int64, schemapb.DataType_Double:
+		return 8, nil
+	case schemapb.DataType_VarChar:
+		maxLen, utf, err := stringLen(n.npyReader.Header.Descr.Type)
+		if err != nil || maxLen <= 0 {
+			return 0, fmt.Errorf("failed to get max length %d of varchar from numpy file header, error: %w", maxLen, err)
+		}
+		if utf {
+			return utf8.UTFMax * maxLen, nil
+		}
+		return maxLen, nil
+	default:
+		return 0, fmt.Errorf("unsupported numpy data type %s", getTypeName(n.dataType))
+	}
+}
+
+// Skip moves the read position forward by count elements without reading them, the reader is seeked if it's able to
+func (n *NumpyAdapter) Skip(count int) error {
+	if count <= 0 {
+		return nil
+	}
+	if n.checkCount(count) < count {
+		return fmt.Errorf("failed to skip %d elements, only %d elements are left", count, n.checkCount(count))
+	}
+	size, err := n.elementSize()
+	if err != nil {
+		return err
+	}
+
+	skipBytes := int64(count) * int64(size)
+	if seeker, ok := n.reader.(io.Seeker); ok {
+		_, err = seeker.Seek(skipBytes, io.SeekCurrent)
+	} else {
+		_, err = io.CopyN(ioutil.Discard, n.reader, skipBytes)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to skip %d elements, error: %w", count, err)
+	}
+
+	n.readPosition += count
+	return nil
+}
+
 func (n *NumpyAdapter) ReadBool(count int) ([]bool, error) {
 	if count <= 0 {
 		return nil, errors.New("cannot read bool data with a zero or nagative count")
@@ -42,6 +42,9 @@ type NumpyParser struct {
 
 	columnData    storage.FieldData                   // in-memory column data
 	callFlushFunc func(field storage.FieldData) error // call back function to output column data
+
+	chunk     ImportChunk // part of the rows to read, the rows before it are skipped
+	totalRows int         // row count of the numpy file
 }
 
 // NewNumpyParser is helper function to create a NumpyParser
@@ -61,6 +64,16 @@ func NewNumpyParser(ctx context.Context, collectionSchema *schemapb.CollectionSc
 	return parser
 }
 
+// SetChunk sets the part of the rows to read
+func (p *NumpyParser) SetChunk(chunk ImportChunk) {
+	p.chunk = chunk
+}
+
+// TotalRows returns the row count of the numpy file, including the rows out of the chunk
+func (p *NumpyParser) TotalRows() int {
+	return p.totalRows
+}
+
 func (p *NumpyParser) validate(adapter *NumpyAdapter, fieldName string) error {
 	if adapter == nil {
 		log.Error("Numpy parser: numpy adapter is nil")
@@ -326,11 +339,26 @@ func (p *NumpyParser) Parse(reader io.Reader, fieldName string, onlyValidate boo
 		return err
 	}
 
+	p.totalRows = adapter.GetShape()[0]
 	if onlyValidate {
 		return nil
 	}
 
-	// read all data from the numpy file
+	// skip the rows before the chunk, and read the rows in the chunk only
+	if !p.chunk.whole() && p.totalRows > 0 {
+		rowElements := p.columnDesc.elementCount / p.totalRows
+		begin, end := p.chunk.rowRange(int64(p.totalRows))
+		if err = adapter.Skip(int(begin) * rowElements); err != nil {
+			log.Error("Numpy parser: failed to skip the rows before the chunk", zap.Int64("rowCount", begin), zap.Error(err))
+			return err
+		}
+		p.columnDesc.elementCount = int(end-begin) * rowElements
+	}
+	if p.columnDesc.elementCount == 0 {
+		return nil
+	}
+
+	// read all data of the chunk from the numpy file
 	err = p.consume(adapter)
 	if err != nil {
 		return err
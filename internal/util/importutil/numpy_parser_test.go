@@ -18,7 +18,9 @@ package importutil
 
 import (
 	"context"
+	"io"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/sbinet/npyio/npy"
@@ -513,6 +515,67 @@ func Test_NumpyParserParse(t *testing.T) {
 	})
 }
 
+func Test_NumpyParserParseChunk(t *testing.T) {
+	ctx := context.Background()
+	err := os.MkdirAll(TempFilesPath, os.ModePerm)
+	assert.Nil(t, err)
+	defer os.RemoveAll(TempFilesPath)
+
+	schema := sampleSchema()
+
+	// parse a chunk of the file, returns the rows of the chunk
+	parseFunc := func(data interface{}, fieldName string, chunk ImportChunk, seekable bool) []interface{} {
+		filePath := TempFilesPath + fieldName + ".npy"
+		err := CreateNumpyFile(filePath, data)
+		assert.Nil(t, err)
+
+		file, err := os.Open(filePath)
+		assert.Nil(t, err)
+		defer file.Close()
+
+		rows := make([]interface{}, 0)
+		parser := NewNumpyParser(ctx, schema, func(field storage.FieldData) error {
+			for i := 0; i < field.RowNum(); i++ {
+				rows = append(rows, field.GetRow(i))
+			}
+			return nil
+		})
+		parser.SetChunk(chunk)
+		var reader io.Reader = file
+		if !seekable {
+			reader = struct{ io.Reader }{file}
+		}
+		err = parser.Parse(reader, fieldName, false)
+		assert.Nil(t, err)
+		assert.Equal(t, reflect.ValueOf(data).Len(), parser.TotalRows())
+		return rows
+	}
+
+	t.Run("parse scalar chunks", func(t *testing.T) {
+		data := []int32{1, 2, 3, 4, 5}
+		assert.Equal(t, []interface{}{int32(1), int32(2)}, parseFunc(data, "FieldInt32", ImportChunk{Index: 0, Count: 2}, true))
+		assert.Equal(t, []interface{}{int32(3), int32(4), int32(5)}, parseFunc(data, "FieldInt32", ImportChunk{Index: 1, Count: 2}, true))
+		assert.Equal(t, []interface{}{int32(3), int32(4), int32(5)}, parseFunc(data, "FieldInt32", ImportChunk{Index: 1, Count: 2}, false))
+		assert.Equal(t, 5, len(parseFunc(data, "FieldInt32", ImportChunk{}, true)))
+	})
+
+	t.Run("parse string chunks", func(t *testing.T) {
+		data := []string{"a", "bb", "ccc", "dddd"}
+		assert.Equal(t, []interface{}{"ccc", "dddd"}, parseFunc(data, "FieldString", ImportChunk{Index: 1, Count: 2}, true))
+	})
+
+	t.Run("parse vector chunks", func(t *testing.T) {
+		data := [][4]float32{{1, 1, 1, 1}, {2, 2, 2, 2}, {3, 3, 3, 3}}
+		rows := parseFunc(data, "FieldFloatVector", ImportChunk{Index: 2, Count: 3}, false)
+		assert.Equal(t, []interface{}{[]float32{3, 3, 3, 3}}, rows)
+	})
+
+	t.Run("parse empty chunk", func(t *testing.T) {
+		data := []int32{1, 2}
+		assert.Empty(t, parseFunc(data, "FieldInt32", ImportChunk{Index: 0, Count: 4}, true))
+	})
+}
+
 func Test_NumpyParserParse_perf(t *testing.T) {
 	ctx := context.Background()
 	err := os.MkdirAll(TempFilesPath, os.ModePerm)
@@ -0,0 +1,432 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/file"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+// ParquetParser is the row-based parquet format parser, each column of the file is named after a field.
+// Row groups are read batch by batch, values are converted into the JSON form so that the same validators
+// of the JSON parser check and convert them, then the rows are split into shards and flushed by tryFlushBlocks.
+// List<float> columns are mapped to float vectors, fixed-size binary columns to binary vectors.
+type ParquetParser struct {
+	ctx              context.Context            // for canceling parse process
+	collectionSchema *schemapb.CollectionSchema // collection schema
+	rowIDAllocator   *allocator.IDAllocator     // autoid allocator
+	shardNum         int32                      // sharding number of the collection
+	blockSize        int64                      // maximum size of a read block(unit:byte)
+	batchSize        int64                      // rows read from a row group each time
+
+	validators   map[storage.FieldID]*Validator  // validators for each field
+	primaryKey   *schemapb.FieldSchema           // primary key field
+	name2FieldID map[string]storage.FieldID      // fields need to be parsed
+	nullable     map[storage.FieldID]bool        // nullable fields, their columns can be omitted
+	defaults     map[storage.FieldID]interface{} // default values in JSON form, their columns can be omitted
+
+	rowCounter  int64   // how many rows have been consumed
+	readRows    int64   // position of the rows read in the file, including bad rows and skipped row groups
+	autoIDRange []int64 // auto-generated id range, for example: [1, 10, 20, 25] means id from 1 to 10 and 20 to 25
+
+	report  *ImportReport                         // collects bad rows, nil means the first bad row fails the parsing
+	scratch map[storage.FieldID]storage.FieldData // scratch block to validate rows
+	chunk   ImportChunk                           // part of the rows to parse, the row groups out of it are skipped
+	// range [rowBegin, rowEnd) of the rows in the chunk
+	rowBegin int64
+	rowEnd   int64
+
+	callFlushFunc ImportFlushFunc // call back function to flush segment
+}
+
+// NewParquetParser is helper function to create a ParquetParser
+func NewParquetParser(ctx context.Context, collectionSchema *schemapb.CollectionSchema, idAlloc *allocator.IDAllocator,
+	shardNum int32, blockSize int64, flushFunc ImportFlushFunc) (*ParquetParser, error) {
+	if collectionSchema == nil {
+		log.Error("Parquet parser: collection schema is nil")
+		return nil, errors.New("collection schema is nil")
+	}
+
+	if idAlloc == nil {
+		log.Error("Parquet parser: ID allocator is nil")
+		return nil, errors.New("ID allocator is nil")
+	}
+
+	if flushFunc == nil {
+		log.Error("Parquet parser: flush function is nil")
+		return nil, errors.New("flush function is nil")
+	}
+
+	p := &ParquetParser{
+		ctx:              ctx,
+		collectionSchema: collectionSchema,
+		rowIDAllocator:   idAlloc,
+		shardNum:         shardNum,
+		blockSize:        blockSize,
+		batchSize:        MinBufferSize,
+		validators:       make(map[storage.FieldID]*Validator),
+		name2FieldID:     make(map[string]storage.FieldID),
+		nullable:         make(map[storage.FieldID]bool),
+		defaults:         make(map[storage.FieldID]interface{}),
+		autoIDRange:      make([]int64, 0),
+		callFlushFunc:    flushFunc,
+	}
+
+	err := initValidators(collectionSchema, p.validators)
+	if err != nil {
+		log.Error("Parquet parser: fail to initialize validators", zap.Error(err))
+		return nil, fmt.Errorf("fail to initialize validators, error: %w", err)
+	}
+
+	for i := 0; i < len(collectionSchema.Fields); i++ {
+		schema := collectionSchema.Fields[i]
+		if schema.GetIsPrimaryKey() {
+			p.primaryKey = schema
+		}
+		// RowIDField and TimeStampField is internal field, auto-generated primary key is not provided by file
+		if schema.GetFieldID() == common.RowIDField || schema.GetFieldID() == common.TimeStampField || schema.GetAutoID() {
+			continue
+		}
+
+		p.name2FieldID[schema.GetName()] = schema.GetFieldID()
+		if typeutil.IsFieldNullable(schema) {
+			p.nullable[schema.GetFieldID()] = true
+		}
+		if value, ok := defaultJSONValue(schema); ok {
+			p.defaults[schema.GetFieldID()] = value
+		}
+	}
+
+	if p.primaryKey == nil {
+		log.Error("Parquet parser: collection schema has no primary key")
+		return nil, errors.New("collection schema has no primary key")
+	}
+
+	// read a batch of rows no larger than a block each time
+	if sizePerRecord, _ := typeutil.EstimateSizePerRecord(collectionSchema); sizePerRecord > 0 && SingleBlockSize/sizePerRecord > MinBufferSize {
+		p.batchSize = int64(SingleBlockSize / sizePerRecord)
+	}
+
+	return p, nil
+}
+
+func (p *ParquetParser) IDRange() []int64 {
+	return p.autoIDRange
+}
+
+func (p *ParquetParser) RowCount() int64 {
+	return p.rowCounter
+}
+
+// SetReport sets the report to collect bad rows, the bad rows are skipped if the report allows
+func (p *ParquetParser) SetReport(report *ImportReport) {
+	p.report = report
+}
+
+// SetChunk sets the part of the rows to parse
+func (p *ParquetParser) SetChunk(chunk ImportChunk) {
+	p.chunk = chunk
+}
+
+// Parse reads all the row groups of a parquet file and flushes the rows into segments
+func (p *ParquetParser) Parse(reader parquet.ReaderAtSeeker) error {
+	pqReader, err := file.NewParquetReader(reader)
+	if err != nil {
+		log.Error("Parquet parser: failed to open parquet file", zap.Error(err))
+		return fmt.Errorf("failed to open parquet file, error: %w", err)
+	}
+	defer pqReader.Close()
+
+	fileReader, err := pqarrow.NewFileReader(pqReader, pqarrow.ArrowReadProperties{BatchSize: p.batchSize}, memory.DefaultAllocator)
+	if err != nil {
+		log.Error("Parquet parser: failed to read parquet schema", zap.Error(err))
+		return fmt.Errorf("failed to read parquet schema, error: %w", err)
+	}
+
+	arrowSchema, err := fileReader.Schema()
+	if err != nil {
+		log.Error("Parquet parser: failed to convert parquet schema", zap.Error(err))
+		return fmt.Errorf("failed to convert parquet schema, error: %w", err)
+	}
+
+	columns, err := p.mapColumns(arrowSchema)
+	if err != nil {
+		return err
+	}
+
+	blocksData := make([]map[storage.FieldID]storage.FieldData, 0, p.shardNum)
+	for i := 0; i < int(p.shardNum); i++ {
+		blockData := initSegmentData(p.collectionSchema)
+		if blockData == nil {
+			log.Error("Parquet parser: failed to initialize FieldData list", zap.Int("shardID", i))
+			return fmt.Errorf("failed to initialize FieldData list for shard id %d", i)
+		}
+		blocksData = append(blocksData, blockData)
+	}
+
+	p.rowBegin, p.rowEnd = p.chunk.rowRange(pqReader.NumRows())
+	groupBegin := int64(0)
+	for rowGroup := 0; rowGroup < pqReader.NumRowGroups(); rowGroup++ {
+		if isCanceled(p.ctx) {
+			log.Error("Parquet parser: import task was canceled")
+			return errors.New("import task was canceled")
+		}
+
+		groupEnd := groupBegin + pqReader.RowGroup(rowGroup).NumRows()
+		if groupEnd > p.rowBegin && groupBegin < p.rowEnd {
+			p.readRows = groupBegin
+			err = p.parseRowGroup(fileReader, rowGroup, columns, blocksData)
+			if err != nil {
+				return err
+			}
+		}
+		groupBegin = groupEnd
+	}
+
+	log.Info("Parquet parser: finished", zap.Int64("rowCount", p.rowCounter), zap.Int("rowGroups", pqReader.NumRowGroups()))
+	// force flush at the end
+	return tryFlushBlocks(p.ctx, blocksData, p.collectionSchema, p.callFlushFunc, p.blockSize, MaxTotalSizeInMemory, true)
+}
+
+// mapColumns returns the column index of each field, columns of the fields with default value or nullable can be omitted
+func (p *ParquetParser) mapColumns(arrowSchema *arrow.Schema) (map[storage.FieldID]int, error) {
+	columns := make(map[storage.FieldID]int)
+	for i, field := range arrowSchema.Fields() {
+		fieldID, ok := p.name2FieldID[field.Name]
+		if !ok {
+			log.Error("Parquet parser: the column is not defined in collection schema", zap.String("columnName", field.Name))
+			return nil, fmt.Errorf("the column '%s' is not defined in collection schema", field.Name)
+		}
+		if _, ok := columns[fieldID]; ok {
+			log.Error("Parquet parser: duplicated column", zap.String("columnName", field.Name))
+			return nil, fmt.Errorf("duplicated column '%s'", field.Name)
+		}
+		columns[fieldID] = i
+	}
+
+	for name, fieldID := range p.name2FieldID {
+		_, ok := columns[fieldID]
+		_, hasDefault := p.defaults[fieldID]
+		if !ok && !hasDefault && !p.nullable[fieldID] {
+			log.Error("Parquet parser: the column of field is missed", zap.String("fieldName", name))
+			return nil, fmt.Errorf("column of field '%s' is missed", name)
+		}
+	}
+	return columns, nil
+}
+
+func (p *ParquetParser) parseRowGroup(fileReader *pqarrow.FileReader, rowGroup int, columns map[storage.FieldID]int,
+	blocksData []map[storage.FieldID]storage.FieldData) error {
+	recordReader, err := fileReader.GetRecordReader(p.ctx, nil, []int{rowGroup})
+	if err != nil {
+		log.Error("Parquet parser: failed to read row group", zap.Int("rowGroup", rowGroup), zap.Error(err))
+		return fmt.Errorf("failed to read row group %d, error: %w", rowGroup, err)
+	}
+	defer recordReader.Release()
+
+	for recordReader.Next() {
+		err = p.consume(recordReader.Record(), columns, blocksData)
+		if err != nil {
+			return err
+		}
+
+		// when the estimated size is close to blockSize, flush
+		err = tryFlushBlocks(p.ctx, blocksData, p.collectionSchema, p.callFlushFunc, p.blockSize, MaxTotalSizeInMemory, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// consume converts a batch of rows and appends them to the blocks of their shards
+func (p *ParquetParser) consume(record arrow.Record, columns map[storage.FieldID]int, blocksData []map[storage.FieldID]storage.FieldData) error {
+	rowCount := int(record.NumRows())
+	if rowCount == 0 {
+		return nil
+	}
+
+	// convert values and skip bad rows before row IDs are allocated
+	rows := make([]map[storage.FieldID]interface{}, 0, rowCount)
+	rowNumbers := make([]int64, 0, rowCount)
+	for i := 0; i < rowCount; i++ {
+		// row number counted from 1
+		rowNumber := p.readRows + int64(i) + 1
+		if rowNumber <= p.rowBegin || rowNumber > p.rowEnd {
+			continue
+		}
+		row, err := p.verifyRow(record, columns, i, rowNumber)
+		if err == nil && p.report.validateRows() {
+			if p.scratch == nil || p.scratch[p.primaryKey.GetFieldID()].RowNum() >= int(p.batchSize) {
+				p.scratch = initSegmentData(p.collectionSchema)
+			}
+			if err = validateRow(row, p.primaryKey, p.validators, p.scratch); err != nil {
+				log.Warn("Parquet parser: invalid row", zap.Int64("rowNumber", rowNumber), zap.Error(err))
+				err = fmt.Errorf("invalid row %d, error: %w", rowNumber, err)
+			}
+		}
+		if err != nil {
+			if err = p.report.skipRow(rowNumber, err); err != nil {
+				return err
+			}
+			continue
+		}
+		rows = append(rows, row)
+		rowNumbers = append(rowNumbers, rowNumber)
+	}
+	p.readRows += int64(rowCount)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// generate auto id for primary key and rowid field
+	rowIDBegin, rowIDEnd, err := p.rowIDAllocator.Alloc(uint32(len(rows)))
+	if err != nil {
+		log.Error("Parquet parser: failed to alloc row ID", zap.Int("count", len(rows)), zap.Error(err))
+		return fmt.Errorf("failed to alloc %d row ID, error: %w", len(rows), err)
+	}
+	if rowIDEnd-rowIDBegin != int64(len(rows)) {
+		log.Error("Parquet parser: allocated row IDs are not enough", zap.Int("count", len(rows)),
+			zap.Int64("generated", rowIDEnd-rowIDBegin))
+		return fmt.Errorf("try to generate %d row IDs but only %d IDs were allocated", len(rows), rowIDEnd-rowIDBegin)
+	}
+	if p.primaryKey.GetAutoID() {
+		p.autoIDRange = append(p.autoIDRange, rowIDBegin, rowIDEnd)
+	}
+
+	for i, row := range rows {
+		err = appendRowToBlocks(row, rowIDBegin+int64(i), p.primaryKey, p.shardNum, p.validators, blocksData)
+		if err != nil {
+			log.Error("Parquet parser: failed to consume the row", zap.Int64("rowNumber", rowNumbers[i]), zap.Error(err))
+			err = fmt.Errorf("failed to consume the row %d, error: %w", rowNumbers[i], err)
+			// the row is validated if the report allows skipping, a row fails here cannot be skipped
+			if p.report.validateRows() {
+				return err
+			}
+			return p.report.skipRow(rowNumbers[i], err)
+		}
+	}
+
+	p.rowCounter += int64(len(rows))
+	return nil
+}
+
+// verifyRow reads the i-th row of a record in the form decoded from JSON
+func (p *ParquetParser) verifyRow(record arrow.Record, columns map[storage.FieldID]int, i int,
+	rowNumber int64) (map[storage.FieldID]interface{}, error) {
+	row := make(map[storage.FieldID]interface{}, len(p.name2FieldID))
+	for _, fieldID := range p.name2FieldID {
+		column, ok := columns[fieldID]
+		if !ok {
+			// the column is omitted, use the default value or regard it as null
+			row[fieldID] = p.defaults[fieldID]
+			continue
+		}
+		value, err := arrowValue(record.Column(column), i)
+		if err != nil {
+			log.Error("Parquet parser: failed to read value at the row", zap.String("columnName", record.ColumnName(column)),
+				zap.Int64("rowNumber", rowNumber), zap.Error(err))
+			return nil, &fieldError{field: record.ColumnName(column),
+				err: fmt.Errorf("failed to read value of column '%s' at the row %d, error: %w", record.ColumnName(column), rowNumber, err)}
+		}
+		row[fieldID] = value
+	}
+	return row, nil
+}
+
+// arrowValue returns the i-th value of an arrow array in the form decoded from JSON:
+// bool, string, json.Number for numbers and []interface{} for lists and fixed-size binaries.
+func arrowValue(arr arrow.Array, i int) (interface{}, error) {
+	if arr.IsNull(i) {
+		return nil, nil
+	}
+
+	switch a := arr.(type) {
+	case *array.Boolean:
+		return a.Value(i), nil
+	case *array.Int8:
+		return json.Number(strconv.FormatInt(int64(a.Value(i)), 10)), nil
+	case *array.Int16:
+		return json.Number(strconv.FormatInt(int64(a.Value(i)), 10)), nil
+	case *array.Int32:
+		return json.Number(strconv.FormatInt(int64(a.Value(i)), 10)), nil
+	case *array.Int64:
+		return json.Number(strconv.FormatInt(a.Value(i), 10)), nil
+	case *array.Uint8:
+		return json.Number(strconv.FormatUint(uint64(a.Value(i)), 10)), nil
+	case *array.Uint16:
+		return json.Number(strconv.FormatUint(uint64(a.Value(i)), 10)), nil
+	case *array.Uint32:
+		return json.Number(strconv.FormatUint(uint64(a.Value(i)), 10)), nil
+	case *array.Uint64:
+		return json.Number(strconv.FormatUint(a.Value(i), 10)), nil
+	case *array.Float32:
+		return json.Number(strconv.FormatFloat(float64(a.Value(i)), 'g', -1, 32)), nil
+	case *array.Float64:
+		return json.Number(strconv.FormatFloat(a.Value(i), 'g', -1, 64)), nil
+	case *array.String:
+		return a.Value(i), nil
+	case *array.FixedSizeBinary:
+		// each byte represents 8 dimensions of a binary vector
+		value := a.Value(i)
+		elements := make([]interface{}, 0, len(value))
+		for _, b := range value {
+			elements = append(elements, json.Number(strconv.FormatUint(uint64(b), 10)))
+		}
+		return elements, nil
+	case *array.List:
+		offsets := a.Offsets()
+		j := i + a.Data().Offset()
+		return arrowListValue(a.ListValues(), int(offsets[j]), int(offsets[j+1]))
+	case *array.FixedSizeList:
+		n := int(a.DataType().(*arrow.FixedSizeListType).Len())
+		j := i + a.Data().Offset()
+		return arrowListValue(a.ListValues(), j*n, (j+1)*n)
+	default:
+		return nil, fmt.Errorf("unsupported column type %s", arr.DataType())
+	}
+}
+
+func arrowListValue(values arrow.Array, begin, end int) ([]interface{}, error) {
+	elements := make([]interface{}, 0, end-begin)
+	for k := begin; k < end; k++ {
+		value, err := arrowValue(values, k)
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, value)
+	}
+	return elements, nil
+}
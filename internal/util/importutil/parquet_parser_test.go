@@ -0,0 +1,375 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+	"github.com/apache/arrow/go/v8/parquet"
+	"github.com/apache/arrow/go/v8/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/schemapb"
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// sampleArrowSchema() return an arrow schema to represent sampleSchema() for testing
+func sampleArrowSchema() *arrow.Schema {
+	return arrow.NewSchema([]arrow.Field{
+		{Name: "FieldBool", Type: arrow.FixedWidthTypes.Boolean},
+		{Name: "FieldInt8", Type: arrow.PrimitiveTypes.Int8},
+		{Name: "FieldInt16", Type: arrow.PrimitiveTypes.Int16},
+		{Name: "FieldInt32", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "FieldInt64", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "FieldFloat", Type: arrow.PrimitiveTypes.Float32},
+		{Name: "FieldDouble", Type: arrow.PrimitiveTypes.Float64},
+		{Name: "FieldString", Type: arrow.BinaryTypes.String},
+		{Name: "FieldBinaryVector", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}},
+		{Name: "FieldFloatVector", Type: arrow.ListOf(arrow.PrimitiveTypes.Float32)},
+	}, nil)
+}
+
+// createParquetData() generates a parquet file for sampleSchema(), each row group has rowGroupSize rows
+func createParquetData(t *testing.T, arrowSchema *arrow.Schema, rowCount int, rowGroupSize int64) []byte {
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrowSchema)
+	defer builder.Release()
+
+	for i, field := range arrowSchema.Fields() {
+		for row := 0; row < rowCount; row++ {
+			switch b := builder.Field(i).(type) {
+			case *array.BooleanBuilder:
+				b.Append(row%2 == 0)
+			case *array.Int8Builder:
+				b.Append(int8(row))
+			case *array.Int16Builder:
+				b.Append(int16(row))
+			case *array.Int32Builder:
+				b.Append(int32(row))
+			case *array.Int64Builder:
+				b.Append(int64(row))
+			case *array.Float32Builder:
+				b.Append(float32(row) + 0.5)
+			case *array.Float64Builder:
+				b.Append(float64(row) + 0.25)
+			case *array.StringBuilder:
+				b.Append(field.Name)
+			case *array.FixedSizeBinaryBuilder:
+				b.Append([]byte{uint8(row), 255})
+			case *array.ListBuilder:
+				b.Append(true)
+				values := b.ValueBuilder().(*array.Float32Builder)
+				for k := 0; k < 4; k++ {
+					values.Append(float32(row*4 + k))
+				}
+			default:
+				t.Fatalf("unexpected builder type %T", b)
+			}
+		}
+	}
+
+	record := builder.NewRecord()
+	defer record.Release()
+	table := array.NewTableFromRecords(arrowSchema, []arrow.Record{record})
+	defer table.Release()
+
+	buf := new(bytes.Buffer)
+	err := pqarrow.WriteTable(table, buf, rowGroupSize, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
+
+func Test_NewParquetParser(t *testing.T) {
+	ctx := context.Background()
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		return nil
+	}
+
+	// nil schema
+	parser, err := NewParquetParser(ctx, nil, nil, 2, 16, flushFunc)
+	assert.NotNil(t, err)
+	assert.Nil(t, parser)
+
+	// nil id allocator
+	parser, err = NewParquetParser(ctx, sampleSchema(), nil, 2, 16, flushFunc)
+	assert.NotNil(t, err)
+	assert.Nil(t, parser)
+
+	// nil flush function
+	idAllocator := newIDAllocator(ctx, t, nil)
+	parser, err = NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, parser)
+
+	// no primary key
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 101, Name: "FieldInt64", DataType: schemapb.DataType_Int64},
+		},
+	}
+	parser, err = NewParquetParser(ctx, schema, idAllocator, 2, 16, flushFunc)
+	assert.NotNil(t, err)
+	assert.Nil(t, parser)
+
+	// succeed
+	parser, err = NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, flushFunc)
+	assert.Nil(t, err)
+	assert.NotNil(t, parser)
+	assert.Equal(t, 10, len(parser.name2FieldID))
+	assert.GreaterOrEqual(t, parser.batchSize, int64(MinBufferSize))
+}
+
+func Test_ParquetParserParse(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+
+	rowCount := 0
+	pks := make(map[int64]struct{})
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		pkField := fields[106].(*storage.Int64FieldData)
+		for _, pk := range pkField.Data {
+			pks[pk] = struct{}{}
+		}
+		rowCount += pkField.RowNum()
+		assert.Equal(t, pkField.RowNum(), fields[common.RowIDField].RowNum())
+		assert.Equal(t, pkField.RowNum(), fields[110].RowNum())
+		assert.Equal(t, pkField.RowNum(), fields[111].RowNum())
+		return nil
+	}
+
+	parser, err := NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, flushFunc)
+	assert.Nil(t, err)
+	// small batch to read a row group several times
+	parser.batchSize = 3
+
+	data := createParquetData(t, sampleArrowSchema(), 20, 8)
+	err = parser.Parse(bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, 20, rowCount)
+	assert.Equal(t, 20, len(pks))
+	assert.Equal(t, int64(20), parser.RowCount())
+	assert.Empty(t, parser.IDRange())
+
+	// not a parquet file
+	parser, err = NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, flushFunc)
+	assert.Nil(t, err)
+	err = parser.Parse(bytes.NewReader([]byte("dummy")))
+	assert.NotNil(t, err)
+
+	// flush error
+	flushErrFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		return errors.New("error")
+	}
+	parser, err = NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, flushErrFunc)
+	assert.Nil(t, err)
+	err = parser.Parse(bytes.NewReader(data))
+	assert.NotNil(t, err)
+
+	// canceled
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	parser, err = NewParquetParser(cancelCtx, sampleSchema(), idAllocator, 2, 16, flushFunc)
+	assert.Nil(t, err)
+	err = parser.Parse(bytes.NewReader(data))
+	assert.NotNil(t, err)
+}
+
+func Test_ParquetParserColumns(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		return nil
+	}
+
+	// redundant column
+	fields := append(sampleArrowSchema().Fields(), arrow.Field{Name: "dummy", Type: arrow.PrimitiveTypes.Int64})
+	parser, err := NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, flushFunc)
+	assert.Nil(t, err)
+	err = parser.Parse(bytes.NewReader(createParquetData(t, arrow.NewSchema(fields, nil), 5, 5)))
+	assert.NotNil(t, err)
+
+	// missed column
+	fields = sampleArrowSchema().Fields()[1:]
+	err = parser.Parse(bytes.NewReader(createParquetData(t, arrow.NewSchema(fields, nil), 5, 5)))
+	assert.NotNil(t, err)
+
+	// illegal vector dimension
+	fields = sampleArrowSchema().Fields()
+	fields[8] = arrow.Field{Name: "FieldBinaryVector", Type: &arrow.FixedSizeBinaryType{ByteWidth: 3}}
+	err = parser.Parse(bytes.NewReader(createParquetData(t, arrow.NewSchema(fields, nil), 5, 5)))
+	assert.NotNil(t, err)
+
+	// missed columns of the fields with default value or nullable
+	schema := &schemapb.CollectionSchema{
+		Name: "schema",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 101, Name: "FieldInt64", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "FieldInt32", DataType: schemapb.DataType_Int32},
+			{
+				FieldID:    103,
+				Name:       "FieldDefault",
+				DataType:   schemapb.DataType_Int16,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "18"}},
+			},
+			{
+				FieldID:    104,
+				Name:       "FieldNullable",
+				DataType:   schemapb.DataType_Double,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}},
+			},
+		},
+	}
+	rowCount := 0
+	flushFunc = func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		rowCount += fields[101].RowNum()
+		assert.Equal(t, fields[101].RowNum(), fields[103].RowNum())
+		return nil
+	}
+	parser, err = NewParquetParser(ctx, schema, idAllocator, 2, 16, flushFunc)
+	assert.Nil(t, err)
+	fields = []arrow.Field{{Name: "FieldInt32", Type: arrow.PrimitiveTypes.Int32}}
+	err = parser.Parse(bytes.NewReader(createParquetData(t, arrow.NewSchema(fields, nil), 5, 5)))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, rowCount)
+	assert.Equal(t, 2, len(parser.IDRange()))
+}
+
+func Test_ParquetParserSkipBadRows(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+	rowCount := 0
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		rowCount += fields[106].RowNum()
+		assert.Equal(t, fields[106].RowNum(), fields[102].RowNum())
+		return nil
+	}
+
+	// values of FieldInt8 overflow from the row 129, 22 bad rows
+	fields := sampleArrowSchema().Fields()
+	fields[1] = arrow.Field{Name: "FieldInt8", Type: arrow.PrimitiveTypes.Int16}
+	data := createParquetData(t, arrow.NewSchema(fields, nil), 150, 50)
+
+	report := NewImportReport(22, false)
+	report.setFile("a.parquet")
+	parser, err := NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, flushFunc)
+	assert.Nil(t, err)
+	parser.SetReport(report)
+	parser.batchSize = 7
+	err = parser.Parse(bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, 128, rowCount)
+	assert.Equal(t, int64(128), parser.RowCount())
+	assert.Equal(t, int64(22), report.BadRows)
+	assert.Equal(t, 22, len(report.Errors))
+	assert.Equal(t, &RowError{File: "a.parquet", Row: 129, Field: "FieldInt8", Reason: report.Errors[0].Reason}, report.Errors[0])
+	assert.Equal(t, int64(150), report.Errors[21].Row)
+
+	// too many bad rows
+	report = NewImportReport(21, false)
+	parser, err = NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, flushFunc)
+	assert.Nil(t, err)
+	parser.SetReport(report)
+	err = parser.Parse(bytes.NewReader(data))
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(22), report.BadRows)
+}
+
+func Test_ParquetParserChunk(t *testing.T) {
+	ctx := context.Background()
+	idAllocator := newIDAllocator(ctx, t, nil)
+	ids := make([]int64, 0)
+	flushFunc := func(fields map[storage.FieldID]storage.FieldData, shardID int) error {
+		ids = append(ids, fields[106].(*storage.Int64FieldData).Data...)
+		return nil
+	}
+	data := createParquetData(t, sampleArrowSchema(), 150, 50)
+
+	// each chunk reads a contiguous range of rows, the row groups out of the range are skipped
+	total := int64(0)
+	for i := int64(0); i < 4; i++ {
+		ids = ids[:0]
+		parser, err := NewParquetParser(ctx, sampleSchema(), idAllocator, 2, 16, flushFunc)
+		assert.Nil(t, err)
+		parser.SetChunk(ImportChunk{Index: i, Count: 4})
+		parser.batchSize = 7
+		err = parser.Parse(bytes.NewReader(data))
+		assert.Nil(t, err)
+
+		begin, end := ImportChunk{Index: i, Count: 4}.rowRange(150)
+		assert.Equal(t, end-begin, parser.RowCount())
+		assert.Equal(t, int(end-begin), len(ids))
+		for _, id := range ids {
+			assert.GreaterOrEqual(t, id, begin)
+			assert.Less(t, id, end)
+		}
+		total += parser.RowCount()
+	}
+	assert.Equal(t, int64(150), total)
+}
+
+func Test_ArrowValue(t *testing.T) {
+	mem := memory.DefaultAllocator
+
+	int8Builder := array.NewInt8Builder(mem)
+	int8Builder.AppendValues([]int8{-3, 5}, nil)
+	int8Builder.AppendNull()
+	int8Array := int8Builder.NewArray()
+	value, err := arrowValue(int8Array, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, json.Number("-3"), value)
+	value, err = arrowValue(int8Array, 2)
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+
+	float32Builder := array.NewFloat32Builder(mem)
+	float32Builder.AppendValues([]float32{1.1}, nil)
+	value, err = arrowValue(float32Builder.NewArray(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, json.Number("1.1"), value)
+
+	listBuilder := array.NewFixedSizeListBuilder(mem, 2, arrow.PrimitiveTypes.Float64)
+	valueBuilder := listBuilder.ValueBuilder().(*array.Float64Builder)
+	listBuilder.Append(true)
+	valueBuilder.AppendValues([]float64{1, 2}, nil)
+	listBuilder.Append(true)
+	valueBuilder.AppendValues([]float64{3, 4}, nil)
+	value, err = arrowValue(listBuilder.NewArray(), 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{json.Number("3"), json.Number("4")}, value)
+
+	binaryBuilder := array.NewFixedSizeBinaryBuilder(mem, &arrow.FixedSizeBinaryType{ByteWidth: 2})
+	binaryBuilder.Append([]byte{1, 254})
+	value, err = arrowValue(binaryBuilder.NewArray(), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{json.Number("1"), json.Number("254")}, value)
+
+	// unsupported type
+	dateBuilder := array.NewDate32Builder(mem)
+	dateBuilder.Append(arrow.Date32(1))
+	value, err = arrowValue(dateBuilder.NewArray(), 0)
+	assert.NotNil(t, err)
+	assert.Nil(t, value)
+}
@@ -50,8 +50,10 @@ type CgoIndex struct {
 	close    bool
 }
 
+// NewCgoIndex creates an index, @encryptionKeys are the data keys exported by the EncryptedChunkManager,
+// which are used to encrypt the disk index files, it's empty if encryption is disabled.
 // TODO: use proto.Marshal instead of proto.MarshalTextString for better compatibility.
-func NewCgoIndex(dtype schemapb.DataType, typeParams, indexParams map[string]string, config *indexpb.StorageConfig) (CodecIndex, error) {
+func NewCgoIndex(dtype schemapb.DataType, typeParams, indexParams map[string]string, config *indexpb.StorageConfig, encryptionKeys string) (CodecIndex, error) {
 	protoTypeParams := &indexcgopb.TypeParams{
 		Params: make([]*commonpb.KeyValuePair, 0),
 	}
@@ -82,6 +84,7 @@ func NewCgoIndex(dtype schemapb.DataType, typeParams, indexParams map[string]str
 	cRootPath := C.CString(config.GetRootPath())
 	cStorageType := C.CString(storageType)
 	cIamEndPoint := C.CString(config.GetIAMEndpoint())
+	cEncryptionKeys := C.CString(encryptionKeys)
 	defer C.free(unsafe.Pointer(cAddress))
 	defer C.free(unsafe.Pointer(cBucketName))
 	defer C.free(unsafe.Pointer(cAccessKey))
@@ -89,6 +92,7 @@ func NewCgoIndex(dtype schemapb.DataType, typeParams, indexParams map[string]str
 	defer C.free(unsafe.Pointer(cRootPath))
 	defer C.free(unsafe.Pointer(cStorageType))
 	defer C.free(unsafe.Pointer(cIamEndPoint))
+	defer C.free(unsafe.Pointer(cEncryptionKeys))
 	storageConfig := C.CStorageConfig{
 		address:          cAddress,
 		bucket_name:      cBucketName,
@@ -99,6 +103,7 @@ func NewCgoIndex(dtype schemapb.DataType, typeParams, indexParams map[string]str
 		iam_endpoint:     cIamEndPoint,
 		useSSL:           C.bool(config.GetUseSSL()),
 		useIAM:           C.bool(config.GetUseIAM()),
+		encryption_keys:  cEncryptionKeys,
 	}
 
 	var indexPtr C.CIndex
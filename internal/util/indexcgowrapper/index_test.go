@@ -130,7 +130,7 @@ func TestCIndex_New(t *testing.T) {
 	for _, c := range generateTestCases() {
 		typeParams, indexParams := generateParams(c.indexType, c.metricType)
 
-		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig())
+		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig(), "")
 		assert.Equal(t, err, nil)
 		assert.NotEqual(t, index, nil)
 
@@ -143,7 +143,7 @@ func TestCIndex_BuildFloatVecIndex(t *testing.T) {
 	for _, c := range generateFloatVectorTestCases() {
 		typeParams, indexParams := generateParams(c.indexType, c.metricType)
 
-		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig())
+		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig(), "")
 		assert.Equal(t, err, nil)
 		assert.NotEqual(t, index, nil)
 
@@ -160,7 +160,7 @@ func TestCIndex_BuildBinaryVecIndex(t *testing.T) {
 	for _, c := range generateBinaryVectorTestCases() {
 		typeParams, indexParams := generateParams(c.indexType, c.metricType)
 
-		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig())
+		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig(), "")
 		assert.Equal(t, err, nil)
 		assert.NotEqual(t, index, nil)
 
@@ -177,7 +177,7 @@ func TestCIndex_Codec(t *testing.T) {
 	for _, c := range generateTestCases() {
 		typeParams, indexParams := generateParams(c.indexType, c.metricType)
 
-		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig())
+		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig(), "")
 		assert.Equal(t, err, nil)
 		assert.NotEqual(t, index, nil)
 
@@ -194,7 +194,7 @@ func TestCIndex_Codec(t *testing.T) {
 		blobs, err := index.Serialize()
 		assert.Equal(t, err, nil)
 
-		copyIndex, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig())
+		copyIndex, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig(), "")
 		assert.NotEqual(t, copyIndex, nil)
 		assert.Equal(t, err, nil)
 		err = copyIndex.Load(blobs)
@@ -216,7 +216,7 @@ func TestCIndex_Delete(t *testing.T) {
 	for _, c := range generateTestCases() {
 		typeParams, indexParams := generateParams(c.indexType, c.metricType)
 
-		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig())
+		index, err := NewCgoIndex(c.dtype, typeParams, indexParams, genStorageConfig(), "")
 		assert.Equal(t, err, nil)
 		assert.NotEqual(t, index, nil)
 
@@ -229,7 +229,7 @@ func TestCIndex_Error(t *testing.T) {
 	indexParams := make(map[string]string)
 	indexParams["index_type"] = "IVF_FLAT"
 	indexParams["metric_type"] = "L2"
-	indexPtr, err := NewCgoIndex(schemapb.DataType_FloatVector, nil, indexParams, genStorageConfig())
+	indexPtr, err := NewCgoIndex(schemapb.DataType_FloatVector, nil, indexParams, genStorageConfig(), "")
 	assert.Nil(t, err)
 
 	t.Run("Serialize error", func(t *testing.T) {
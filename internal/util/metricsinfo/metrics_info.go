@@ -168,11 +168,33 @@ type DataCoordConfiguration struct {
 	SegmentMaxSize float64 `json:"segment_max_size"`
 }
 
+// CompactionTaskMetrics records the score and state of a compaction task.
+type CompactionTaskMetrics struct {
+	PlanID        int64   `json:"plan_id"`
+	NodeID        int64   `json:"node_id"`
+	Channel       string  `json:"channel"`
+	Type          string  `json:"type"`
+	State         string  `json:"state"`
+	Queued        bool    `json:"queued"`
+	Priority      float64 `json:"priority"`
+	DeleteRatio   float64 `json:"delete_ratio"`
+	DeltalogRatio float64 `json:"deltalog_ratio"`
+	Fragmentation float64 `json:"fragmentation"`
+	ExpiredRatio  float64 `json:"expired_ratio"`
+}
+
+// DataCoordCompactionMetrics records the compaction tasks scheduled by DataCoord.
+type DataCoordCompactionMetrics struct {
+	SlotsPerNode int                     `json:"slots_per_node"`
+	Tasks        []CompactionTaskMetrics `json:"tasks"`
+}
+
 // DataCoordInfos implements ComponentInfos
 type DataCoordInfos struct {
 	BaseComponentInfos
-	SystemConfigurations DataCoordConfiguration `json:"system_configurations"`
-	QuotaMetrics         *DataCoordQuotaMetrics `json:"quota_metrics"`
+	SystemConfigurations DataCoordConfiguration      `json:"system_configurations"`
+	QuotaMetrics         *DataCoordQuotaMetrics      `json:"quota_metrics"`
+	CompactionMetrics    *DataCoordCompactionMetrics `json:"compaction_metrics"`
 }
 
 // RootCoordConfiguration records the configuration of RootCoord.
@@ -108,6 +108,34 @@ func (m *DataCoordClient) GetCompactionStateWithPlans(ctx context.Context, req *
 	return &milvuspb.GetCompactionPlansResponse{}, m.Err
 }
 
+func (m *DataCoordClient) ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	return &milvuspb.ManualCompactionResponse{}, m.Err
+}
+
+func (m *DataCoordClient) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *DataCoordClient) GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest, opts ...grpc.CallOption) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return &datapb.GetCompactionPlanStatesResponse{}, m.Err
+}
+
+func (m *DataCoordClient) Export(ctx context.Context, req *datapb.ExportRequest, opts ...grpc.CallOption) (*datapb.ExportResponse, error) {
+	return &datapb.ExportResponse{}, m.Err
+}
+
+func (m *DataCoordClient) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest, opts ...grpc.CallOption) (*datapb.GetExportStateResponse, error) {
+	return &datapb.GetExportStateResponse{}, m.Err
+}
+
+func (m *DataCoordClient) CancelExport(ctx context.Context, req *datapb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *DataCoordClient) ReportExport(ctx context.Context, req *datapb.ExportTaskResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *DataCoordClient) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest, opts ...grpc.CallOption) (*datapb.WatchChannelsResponse, error) {
 	return &datapb.WatchChannelsResponse{}, m.Err
 }
@@ -122,6 +122,34 @@ func (m *GrpcDataCoordClient) GetCompactionStateWithPlans(ctx context.Context, r
 	return &milvuspb.GetCompactionPlansResponse{}, m.Err
 }
 
+func (m *GrpcDataCoordClient) ManualCompactionWithScope(ctx context.Context, req *datapb.ManualCompactionWithScopeRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
+	return &milvuspb.ManualCompactionResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetCompactionPlanStates(ctx context.Context, req *datapb.GetCompactionPlanStatesRequest, opts ...grpc.CallOption) (*datapb.GetCompactionPlanStatesResponse, error) {
+	return &datapb.GetCompactionPlanStatesResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) Export(ctx context.Context, req *datapb.ExportRequest, opts ...grpc.CallOption) (*datapb.ExportResponse, error) {
+	return &datapb.ExportResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) GetExportState(ctx context.Context, req *datapb.GetExportStateRequest, opts ...grpc.CallOption) (*datapb.GetExportStateResponse, error) {
+	return &datapb.GetExportStateResponse{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) CancelExport(ctx context.Context, req *datapb.CancelExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataCoordClient) ReportExport(ctx context.Context, req *datapb.ExportTaskResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataCoordClient) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest, opts ...grpc.CallOption) (*datapb.WatchChannelsResponse, error) {
 	return &datapb.WatchChannelsResponse{}, m.Err
 }
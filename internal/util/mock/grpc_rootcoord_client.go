@@ -224,3 +224,7 @@ func (m *GrpcRootCoordClient) GetCredential(ctx context.Context, in *rootcoordpb
 func (m *GrpcRootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
+
+func (m *GrpcRootCoordClient) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
@@ -120,3 +120,7 @@ func (q QueryNodeClient) GetDataDistribution(ctx context.Context, req *querypb.G
 func (q QueryNodeClient) SyncDistribution(ctx context.Context, req *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	return q.grpcClient.SyncDistribution(ctx, req)
 }
+
+func (q QueryNodeClient) UpdateSchema(ctx context.Context, req *querypb.UpdateSchemaRequest) (*commonpb.Status, error) {
+	return q.grpcClient.UpdateSchema(ctx, req)
+}
@@ -151,12 +151,24 @@ type commonConfig struct {
 	StorageType string
 	SimdType    string
 
+	StorageRetryAttempts   uint
+	StorageRequestTimeout  time.Duration
+	StorageUploadRateLimit float64
+
 	AuthorizationEnabled bool
 
+	EncryptionEnabled bool
+	EncryptionStrict  bool
+	EncryptionKMSType string
+	EncryptionKeyFile string
+
 	ClusterName string
 
 	SessionTTL        int64
 	SessionRetryTimes int64
+
+	BloomFilterSize       uint
+	MaxBloomFalsePositive float64
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -199,14 +211,25 @@ func (p *commonConfig) init(base *BaseTable) {
 	p.initBeamWidthRatio()
 	p.initGracefulTime()
 	p.initStorageType()
+	p.initStorageRetryAttempts()
+	p.initStorageRequestTimeout()
+	p.initStorageUploadRateLimit()
 	p.initThreadCoreCoefficient()
 
 	p.initEnableAuthorization()
 
+	p.initEncryptionEnabled()
+	p.initEncryptionStrict()
+	p.initEncryptionKMSType()
+	p.initEncryptionKeyFile()
+
 	p.initClusterName()
 
 	p.initSessionTTL()
 	p.initSessionRetryTimes()
+
+	p.initBloomFilterSize()
+	p.initMaxBloomFalsePositive()
 }
 
 func (p *commonConfig) initClusterPrefix() {
@@ -438,10 +461,43 @@ func (p *commonConfig) initStorageType() {
 	p.StorageType = p.Base.LoadWithDefault("common.storageType", "minio")
 }
 
+func (p *commonConfig) initStorageRetryAttempts() {
+	attempts := p.Base.ParseIntWithDefault("common.storage.retryAttempts", 5)
+	if attempts < 1 {
+		attempts = 1
+	}
+	p.StorageRetryAttempts = uint(attempts)
+}
+
+func (p *commonConfig) initStorageRequestTimeout() {
+	p.StorageRequestTimeout = time.Duration(p.Base.ParseInt64WithDefault("common.storage.requestTimeout", 300)) * time.Second
+}
+
+// upload bandwidth in bytes per second, 0 means unlimited
+func (p *commonConfig) initStorageUploadRateLimit() {
+	p.StorageUploadRateLimit = p.Base.ParseFloatWithDefault("common.storage.uploadRateLimit", 0) * 1024 * 1024
+}
+
 func (p *commonConfig) initEnableAuthorization() {
 	p.AuthorizationEnabled = p.Base.ParseBool("common.security.authorizationEnabled", false)
 }
 
+func (p *commonConfig) initEncryptionEnabled() {
+	p.EncryptionEnabled = p.Base.ParseBool("common.security.encryption.enabled", false)
+}
+
+func (p *commonConfig) initEncryptionStrict() {
+	p.EncryptionStrict = p.Base.ParseBool("common.security.encryption.strict", false)
+}
+
+func (p *commonConfig) initEncryptionKMSType() {
+	p.EncryptionKMSType = p.Base.LoadWithDefault("common.security.encryption.kms", "local")
+}
+
+func (p *commonConfig) initEncryptionKeyFile() {
+	p.EncryptionKeyFile = p.Base.LoadWithDefault("common.security.encryption.localKeyFile", "")
+}
+
 func (p *commonConfig) initClusterName() {
 	p.ClusterName = p.Base.LoadWithDefault("common.cluster.name", "")
 }
@@ -454,6 +510,14 @@ func (p *commonConfig) initSessionRetryTimes() {
 	p.SessionRetryTimes = p.Base.ParseInt64WithDefault("common.session.retryTimes", 30)
 }
 
+func (p *commonConfig) initBloomFilterSize() {
+	p.BloomFilterSize = uint(p.Base.ParseInt64WithDefault("common.bloomFilter.size", 100000))
+}
+
+func (p *commonConfig) initMaxBloomFalsePositive() {
+	p.MaxBloomFalsePositive = p.Base.ParseFloatWithDefault("common.bloomFilter.maxFalsePositive", 0.005)
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- rootcoord ---
 type rootCoordConfig struct {
@@ -464,6 +528,8 @@ type rootCoordConfig struct {
 	MinSegmentSizeToEnableIndex int64
 	ImportTaskExpiration        float64
 	ImportTaskRetention         float64
+	ImportSubTaskMaxAttempts    int
+	ImportChunksPerFile         int
 
 	// --- ETCD Path ---
 	ImportTaskSubPath string
@@ -481,6 +547,8 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 	p.MinSegmentSizeToEnableIndex = p.Base.ParseInt64WithDefault("rootCoord.minSegmentSizeToEnableIndex", 1024)
 	p.ImportTaskExpiration = p.Base.ParseFloatWithDefault("rootCoord.importTaskExpiration", 15*60)
 	p.ImportTaskRetention = p.Base.ParseFloatWithDefault("rootCoord.importTaskRetention", 24*60*60)
+	p.ImportSubTaskMaxAttempts = p.Base.ParseIntWithDefault("rootCoord.importSubTaskMaxAttempts", 3)
+	p.ImportChunksPerFile = p.Base.ParseIntWithDefault("rootCoord.importChunksPerFile", 4)
 	p.ImportTaskSubPath = "importtask"
 	p.EnableActiveStandby = p.Base.ParseBool("rootCoord.enableActiveStandby", false)
 }
@@ -521,6 +589,9 @@ type proxyConfig struct {
 	MaxFieldNum              int64
 	MaxShardNum              int32
 	MaxDimension             int64
+	MaxExprLength            int64
+	MaxExprTermSize          int64
+	MaxExprDepth             int64
 	GinLogging               bool
 	MaxUserNum               int
 	MaxRoleNum               int
@@ -548,6 +619,9 @@ func (p *proxyConfig) init(base *BaseTable) {
 	p.initMaxFieldNum()
 	p.initMaxShardNum()
 	p.initMaxDimension()
+	p.initMaxExprLength()
+	p.initMaxExprTermSize()
+	p.initMaxExprDepth()
 
 	p.initMaxTaskNum()
 	p.initGinLogging()
@@ -639,6 +713,18 @@ func (p *proxyConfig) initMaxDimension() {
 	p.MaxDimension = maxDimension
 }
 
+func (p *proxyConfig) initMaxExprLength() {
+	p.MaxExprLength = p.Base.ParseInt64WithDefault("proxy.maxExprLength", 1048576)
+}
+
+func (p *proxyConfig) initMaxExprTermSize() {
+	p.MaxExprTermSize = p.Base.ParseInt64WithDefault("proxy.maxExprTermSize", 65536)
+}
+
+func (p *proxyConfig) initMaxExprDepth() {
+	p.MaxExprDepth = p.Base.ParseInt64WithDefault("proxy.maxExprDepth", 1024)
+}
+
 func (p *proxyConfig) initMaxTaskNum() {
 	p.MaxTaskNum = p.Base.ParseInt64WithDefault("proxy.maxTaskNum", 1024)
 }
@@ -1183,12 +1269,37 @@ type dataCoordConfig struct {
 	SingleCompactionBinlogMaxNum      int64
 	GlobalCompactionInterval          time.Duration
 
+	// clustering compaction
+	EnableClusteringCompaction      bool
+	ClusteringCompactionMinSegment  int
+	ClusteringCompactionMaxPlanSize float64
+
+	// compaction scheduling
+	CompactionSlotsPerNode        int
+	CompactionDeleteRatioWeight   float64
+	CompactionDeltalogWeight      float64
+	CompactionFragmentationWeight float64
+	CompactionExpiredRatioWeight  float64
+	CompactionScoreDeltalogNum    int64
+
 	// Garbage Collection
 	EnableGarbageCollection bool
 	GCInterval              time.Duration
 	GCMissingTolerance      time.Duration
 	GCDropTolerance         time.Duration
 	EnableActiveStandby     bool
+
+	EncryptionKeyRotationInterval time.Duration
+
+	// Binlog Verification
+	EnableBinlogVerification   bool
+	BinlogVerificationInterval time.Duration
+
+	// Export
+	ExportSegmentsPerTask int
+	ExportTasksPerNode    int
+	ExportTaskMaxAttempts int
+	ExportJobRetention    time.Duration
 }
 
 func (p *dataCoordConfig) init(base *BaseTable) {
@@ -1219,11 +1330,29 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	p.initSingleCompactionBinlogMaxNum()
 	p.initGlobalCompactionInterval()
 
+	p.initEnableClusteringCompaction()
+	p.initClusteringCompactionMinSegment()
+	p.initClusteringCompactionMaxPlanSize()
+
+	p.initCompactionSlotsPerNode()
+	p.initCompactionScoreWeights()
+	p.initCompactionScoreDeltalogNum()
+
 	p.initEnableGarbageCollection()
 	p.initGCInterval()
 	p.initGCMissingTolerance()
 	p.initGCDropTolerance()
 	p.initEnableActiveStandby()
+
+	p.initEncryptionKeyRotationInterval()
+
+	p.initEnableBinlogVerification()
+	p.initBinlogVerificationInterval()
+
+	p.initExportSegmentsPerTask()
+	p.initExportTasksPerNode()
+	p.initExportTaskMaxAttempts()
+	p.initExportJobRetention()
 }
 
 func (p *dataCoordConfig) initMaxWatchDuration() {
@@ -1274,6 +1403,38 @@ func (p *dataCoordConfig) initEnableAutoCompaction() {
 	p.EnableAutoCompaction.Store(p.Base.ParseBool("dataCoord.compaction.enableAutoCompaction", false))
 }
 
+func (p *dataCoordConfig) initEnableClusteringCompaction() {
+	p.EnableClusteringCompaction = p.Base.ParseBool("dataCoord.compaction.clustering.enable", false)
+}
+
+// trigger clustering compaction of a partition when it has at least this number of unclustered segments
+func (p *dataCoordConfig) initClusteringCompactionMinSegment() {
+	p.ClusteringCompactionMinSegment = p.Base.ParseIntWithDefault("dataCoord.compaction.clustering.minSegment", 2)
+}
+
+// max size in MB of the segments of a clustering compaction plan, a partition with more unclustered data is split into plans
+func (p *dataCoordConfig) initClusteringCompactionMaxPlanSize() {
+	p.ClusteringCompactionMaxPlanSize = p.Base.ParseFloatWithDefault("dataCoord.compaction.clustering.maxPlanSize", 4096.0)
+}
+
+// number of compaction plans a datanode executes at the same time, the rest wait in a queue ordered by score
+func (p *dataCoordConfig) initCompactionSlotsPerNode() {
+	p.CompactionSlotsPerNode = p.Base.ParseIntWithDefault("dataCoord.compaction.slotsPerNode", 2)
+}
+
+// weights of the factors which make up the score of a compaction plan
+func (p *dataCoordConfig) initCompactionScoreWeights() {
+	p.CompactionDeleteRatioWeight = p.Base.ParseFloatWithDefault("dataCoord.compaction.score.deleteRatioWeight", 1.0)
+	p.CompactionDeltalogWeight = p.Base.ParseFloatWithDefault("dataCoord.compaction.score.deltalogWeight", 0.5)
+	p.CompactionFragmentationWeight = p.Base.ParseFloatWithDefault("dataCoord.compaction.score.fragmentationWeight", 0.5)
+	p.CompactionExpiredRatioWeight = p.Base.ParseFloatWithDefault("dataCoord.compaction.score.expiredRatioWeight", 1.0)
+}
+
+// the deltalog factor of a plan reaches its maximum at this number of deltalogs
+func (p *dataCoordConfig) initCompactionScoreDeltalogNum() {
+	p.CompactionScoreDeltalogNum = p.Base.ParseInt64WithDefault("dataCoord.compaction.score.deltalogNum", 64)
+}
+
 func (p *dataCoordConfig) initCompactionMinSegment() {
 	p.MinSegmentToMerge = p.Base.ParseIntWithDefault("dataCoord.compaction.min.segment", 4)
 }
@@ -1333,10 +1494,47 @@ func (p *dataCoordConfig) initGCMissingTolerance() {
 	p.GCMissingTolerance = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.missingTolerance", 24*60*60)) * time.Second
 }
 
+func (p *dataCoordConfig) initEncryptionKeyRotationInterval() {
+	p.EncryptionKeyRotationInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.encryption.keyRotationInterval", 60*60)) * time.Second
+}
+
 func (p *dataCoordConfig) initGCDropTolerance() {
 	p.GCDropTolerance = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.gc.dropTolerance", 24*60*60)) * time.Second
 }
 
+func (p *dataCoordConfig) initEnableBinlogVerification() {
+	p.EnableBinlogVerification = p.Base.ParseBool("dataCoord.binlogVerification.enable", false)
+}
+
+func (p *dataCoordConfig) initBinlogVerificationInterval() {
+	p.BinlogVerificationInterval = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.binlogVerification.interval", 24*60*60)) * time.Second
+}
+
+func (p *dataCoordConfig) initExportSegmentsPerTask() {
+	p.ExportSegmentsPerTask = p.Base.ParseIntWithDefault("dataCoord.export.segmentsPerTask", 4)
+	if p.ExportSegmentsPerTask <= 0 {
+		p.ExportSegmentsPerTask = 1
+	}
+}
+
+func (p *dataCoordConfig) initExportTasksPerNode() {
+	p.ExportTasksPerNode = p.Base.ParseIntWithDefault("dataCoord.export.tasksPerNode", 2)
+	if p.ExportTasksPerNode <= 0 {
+		p.ExportTasksPerNode = 1
+	}
+}
+
+func (p *dataCoordConfig) initExportTaskMaxAttempts() {
+	p.ExportTaskMaxAttempts = p.Base.ParseIntWithDefault("dataCoord.export.taskMaxAttempts", 3)
+	if p.ExportTaskMaxAttempts <= 0 {
+		p.ExportTaskMaxAttempts = 1
+	}
+}
+
+func (p *dataCoordConfig) initExportJobRetention() {
+	p.ExportJobRetention = time.Duration(p.Base.ParseInt64WithDefault("dataCoord.export.jobRetention", 24*60*60)) * time.Second
+}
+
 func (p *dataCoordConfig) SetEnableAutoCompaction(enable bool) {
 	p.EnableAutoCompaction.Store(enable)
 }
@@ -1365,6 +1563,8 @@ type dataNodeConfig struct {
 	FlushInsertBufferSize  int64
 	FlushDeleteBufferBytes int64
 	SyncPeriod             time.Duration
+	// write all fields of a sync into one columnar file (segment format v2)
+	ColumnarFormat bool
 
 	Alias string // Different datanode in one machine
 
@@ -1374,6 +1574,15 @@ type dataNodeConfig struct {
 	// io concurrency to fetch stats logs
 	IOConcurrency int
 
+	// object storage download bandwidth of the datanode in MB/s, 0 means no limit,
+	// the upload bandwidth is limited by common.storage.uploadRateLimit
+	IODownloadRate float64
+	// number of compactions running at the same time
+	CompactionConcurrency int
+
+	// local directories that import tasks are allowed to read files from, empty means local sources are disabled
+	ImportAllowedLocalPaths []string
+
 	CreatedTime time.Time
 	UpdatedTime time.Time
 }
@@ -1385,7 +1594,11 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	p.initFlushInsertBufferSize()
 	p.initFlushDeleteBufferSize()
 	p.initSyncPeriod()
+	p.initColumnarFormat()
 	p.initIOConcurrency()
+	p.initIODownloadRate()
+	p.initCompactionConcurrency()
+	p.initImportAllowedLocalPaths()
 
 	p.initChannelWatchPath()
 }
@@ -1423,6 +1636,10 @@ func (p *dataNodeConfig) initSyncPeriod() {
 	p.SyncPeriod = time.Duration(syncPeriodInSeconds) * time.Second
 }
 
+func (p *dataNodeConfig) initColumnarFormat() {
+	p.ColumnarFormat = p.Base.ParseBool("datanode.segment.columnarFormat", false)
+}
+
 func (p *dataNodeConfig) initChannelWatchPath() {
 	p.ChannelWatchSubPath = "channelwatch"
 }
@@ -1431,6 +1648,30 @@ func (p *dataNodeConfig) initIOConcurrency() {
 	p.IOConcurrency = p.Base.ParseIntWithDefault("dataNode.dataSync.ioConcurrency", 10)
 }
 
+func (p *dataNodeConfig) initIODownloadRate() {
+	p.IODownloadRate = p.Base.ParseFloatWithDefault("dataNode.io.downloadRate", 0)
+}
+
+func (p *dataNodeConfig) initCompactionConcurrency() {
+	defaultConcurrency := runtime.GOMAXPROCS(0) / 2
+	if defaultConcurrency < 1 {
+		defaultConcurrency = 1
+	}
+	p.CompactionConcurrency = p.Base.ParseIntWithDefault("dataNode.compaction.concurrency", defaultConcurrency)
+	if p.CompactionConcurrency < 1 {
+		p.CompactionConcurrency = defaultConcurrency
+	}
+}
+
+func (p *dataNodeConfig) initImportAllowedLocalPaths() {
+	p.ImportAllowedLocalPaths = make([]string, 0)
+	for _, localPath := range strings.Split(p.Base.LoadWithDefault("dataNode.import.allowedLocalPaths", ""), ",") {
+		if localPath = strings.TrimSpace(localPath); localPath != "" {
+			p.ImportAllowedLocalPaths = append(p.ImportAllowedLocalPaths, localPath)
+		}
+	}
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- indexcoord ---
 type indexCoordConfig struct {
@@ -68,6 +68,13 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.GracefulTime, int64(DefaultGracefulTime))
 		t.Logf("default grafeful time = %d", Params.GracefulTime)
 
+		assert.Equal(t, uint(5), Params.StorageRetryAttempts)
+		assert.Equal(t, 300*time.Second, Params.StorageRequestTimeout)
+		assert.Equal(t, float64(0), Params.StorageUploadRateLimit)
+		Params.Base.Save("common.storage.retryAttempts", "0")
+		Params.initStorageRetryAttempts()
+		assert.Equal(t, uint(1), Params.StorageRetryAttempts)
+
 		// -- proxy --
 		assert.Equal(t, Params.ProxySubName, "by-dev-proxy")
 		t.Logf("ProxySubName: %s", Params.ProxySubName)
@@ -119,6 +126,13 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("default session TTL time = %d", Params.SessionTTL)
 		assert.Equal(t, Params.SessionRetryTimes, int64(DefaultSessionRetryTimes))
 		t.Logf("default session retry times = %d", Params.SessionRetryTimes)
+
+		assert.False(t, Params.EncryptionEnabled)
+		assert.False(t, Params.EncryptionStrict)
+		assert.Equal(t, "local", Params.EncryptionKMSType)
+
+		assert.Equal(t, uint(100000), Params.BloomFilterSize)
+		assert.Equal(t, 0.005, Params.MaxBloomFalsePositive)
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -130,6 +144,8 @@ func TestComponentParam(t *testing.T) {
 		t.Logf("master MinSegmentSizeToEnableIndex = %d", Params.MinSegmentSizeToEnableIndex)
 		assert.NotEqual(t, Params.ImportTaskExpiration, 0)
 		t.Logf("master ImportTaskRetention = %f", Params.ImportTaskRetention)
+		assert.Equal(t, 3, Params.ImportSubTaskMaxAttempts)
+		assert.Equal(t, 4, Params.ImportChunksPerFile)
 		assert.Equal(t, Params.EnableActiveStandby, false)
 		t.Logf("rootCoord EnableActiveStandby = %t", Params.EnableActiveStandby)
 
@@ -154,6 +170,12 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("MaxDimension: %d", Params.MaxDimension)
 
+		t.Logf("MaxExprLength: %d", Params.MaxExprLength)
+
+		t.Logf("MaxExprTermSize: %d", Params.MaxExprTermSize)
+
+		t.Logf("MaxExprDepth: %d", Params.MaxExprDepth)
+
 		t.Logf("MaxTaskNum: %d", Params.MaxTaskNum)
 
 		t.Logf("AccessLog.Enable: %t", Params.AccessLog.Enable)
@@ -213,6 +235,21 @@ func TestComponentParam(t *testing.T) {
 			Params.initMaxDimension()
 		})
 
+		shouldPanic(t, "proxy.maxExprLength", func() {
+			Params.Base.Save("proxy.maxExprLength", "abc")
+			Params.initMaxExprLength()
+		})
+
+		shouldPanic(t, "proxy.maxExprTermSize", func() {
+			Params.Base.Save("proxy.maxExprTermSize", "abc")
+			Params.initMaxExprTermSize()
+		})
+
+		shouldPanic(t, "proxy.maxExprDepth", func() {
+			Params.Base.Save("proxy.maxExprDepth", "abc")
+			Params.initMaxExprDepth()
+		})
+
 		shouldPanic(t, "proxy.maxTaskNum", func() {
 			Params.Base.Save("proxy.maxTaskNum", "-asdf")
 			Params.initMaxTaskNum()
@@ -297,6 +334,19 @@ func TestComponentParam(t *testing.T) {
 		Params := params.DataCoordCfg
 		assert.Equal(t, 24*60*60*time.Second, Params.SegmentMaxLifetime)
 		assert.True(t, Params.EnableGarbageCollection)
+		assert.Equal(t, time.Hour, Params.EncryptionKeyRotationInterval)
+		assert.False(t, Params.EnableBinlogVerification)
+		assert.False(t, Params.EnableClusteringCompaction)
+		assert.Equal(t, 2, Params.ClusteringCompactionMinSegment)
+		assert.Equal(t, 4096.0, Params.ClusteringCompactionMaxPlanSize)
+		assert.Equal(t, 2, Params.CompactionSlotsPerNode)
+		assert.Equal(t, 1.0, Params.CompactionDeleteRatioWeight)
+		assert.Equal(t, int64(64), Params.CompactionScoreDeltalogNum)
+		assert.Equal(t, 24*time.Hour, Params.BinlogVerificationInterval)
+		assert.Equal(t, 4, Params.ExportSegmentsPerTask)
+		assert.Equal(t, 2, Params.ExportTasksPerNode)
+		assert.Equal(t, 3, Params.ExportTaskMaxAttempts)
+		assert.Equal(t, 24*time.Hour, Params.ExportJobRetention)
 		assert.Equal(t, Params.EnableActiveStandby, false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby)
 	})
@@ -324,6 +374,10 @@ func TestComponentParam(t *testing.T) {
 		period := Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod)
+		assert.False(t, Params.ColumnarFormat)
+		assert.Equal(t, float64(0), Params.IODownloadRate)
+		assert.LessOrEqual(t, 1, Params.CompactionConcurrency)
+		assert.Empty(t, Params.ImportAllowedLocalPaths)
 
 		Params.CreatedTime = time.Now()
 		t.Logf("CreatedTime: %v", Params.CreatedTime)
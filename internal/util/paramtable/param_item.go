@@ -64,6 +64,12 @@ func (pi *ParamItem) GetAsInt() int {
 	return getAndConvert(pi, strconv.Atoi, 0)
 }
 
+func (pi *ParamItem) GetAsInt64() int64 {
+	return getAndConvert(pi, func(value string) (int64, error) {
+		return strconv.ParseInt(value, 10, 64)
+	}, 0)
+}
+
 type CompositeParamItem struct {
 	Items  []*ParamItem
 	Format func(map[string]string) string
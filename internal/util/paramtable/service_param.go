@@ -51,6 +51,7 @@ type ServiceParam struct {
 	KafkaCfg        KafkaConfig
 	RocksmqCfg      RocksmqConfig
 	MinioCfg        MinioConfig
+	AzureCfg        AzureConfig
 }
 
 func (p *ServiceParam) Init() {
@@ -67,6 +68,7 @@ func (p *ServiceParam) Init() {
 	p.KafkaCfg.Init(&p.BaseTable)
 	p.RocksmqCfg.Init(&p.BaseTable)
 	p.MinioCfg.Init(&p.BaseTable)
+	p.AzureCfg.Init(&p.BaseTable)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -219,6 +221,11 @@ func (p *EtcdConfig) Init(base *BaseTable) {
 
 type LocalStorageConfig struct {
 	Path ParamItem
+
+	CacheEnabled  ParamItem
+	CachePath     ParamItem
+	CacheCapacity ParamItem
+	CachePolicy   ParamItem
 }
 
 func (p *LocalStorageConfig) Init(base *BaseTable) {
@@ -228,6 +235,40 @@ func (p *LocalStorageConfig) Init(base *BaseTable) {
 		DefaultValue: "/var/lib/milvus/data",
 	}
 	p.Path.Init(base.mgr)
+
+	p.CacheEnabled = ParamItem{
+		Key:          "localStorage.cache.enabled",
+		Version:      "2.2.0",
+		DefaultValue: "false",
+	}
+	p.CacheEnabled.Init(base.mgr)
+
+	p.CachePath = ParamItem{
+		Key:     "localStorage.cache.path",
+		Version: "2.2.0",
+		Formatter: func(v string) string {
+			if v == "" {
+				return path.Join(p.Path.GetValue(), "cache")
+			}
+			return v
+		},
+	}
+	p.CachePath.Init(base.mgr)
+
+	// in MB
+	p.CacheCapacity = ParamItem{
+		Key:          "localStorage.cache.capacity",
+		Version:      "2.2.0",
+		DefaultValue: "10240",
+	}
+	p.CacheCapacity.Init(base.mgr)
+
+	p.CachePolicy = ParamItem{
+		Key:          "localStorage.cache.policy",
+		Version:      "2.2.0",
+		DefaultValue: "lru",
+	}
+	p.CachePolicy.Init(base.mgr)
 }
 
 type MetaStoreConfig struct {
@@ -614,3 +655,66 @@ func (p *MinioConfig) Init(base *BaseTable) {
 	}
 	p.IAMEndpoint.Init(base.mgr)
 }
+
+// /////////////////////////////////////////////////////////////////////////////
+// --- azure ---
+type AzureConfig struct {
+	AccountName             ParamItem
+	AccountKey              ParamItem
+	Endpoint                ParamItem
+	ContainerName           ParamItem
+	RootPath                ParamItem
+	UseManagedIdentity      ParamItem
+	ManagedIdentityClientID ParamItem
+}
+
+func (p *AzureConfig) Init(base *BaseTable) {
+	p.AccountName = ParamItem{
+		Key:          "azure.accountName",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	p.AccountName.Init(base.mgr)
+
+	p.AccountKey = ParamItem{
+		Key:          "azure.accountKey",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	p.AccountKey.Init(base.mgr)
+
+	p.Endpoint = ParamItem{
+		Key:          "azure.endpoint",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	p.Endpoint.Init(base.mgr)
+
+	p.ContainerName = ParamItem{
+		Key:          "azure.containerName",
+		DefaultValue: "a-bucket",
+		Version:      "2.2.0",
+	}
+	p.ContainerName.Init(base.mgr)
+
+	p.RootPath = ParamItem{
+		Key:          "azure.rootPath",
+		DefaultValue: "files",
+		Version:      "2.2.0",
+	}
+	p.RootPath.Init(base.mgr)
+
+	p.UseManagedIdentity = ParamItem{
+		Key:          "azure.useManagedIdentity",
+		DefaultValue: "false",
+		Version:      "2.2.0",
+	}
+	p.UseManagedIdentity.Init(base.mgr)
+
+	p.ManagedIdentityClientID = ParamItem{
+		Key:          "azure.managedIdentityClientID",
+		DefaultValue: "",
+		Version:      "2.2.0",
+	}
+	p.ManagedIdentityClientID.Init(base.mgr)
+}
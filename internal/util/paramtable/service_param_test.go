@@ -12,6 +12,7 @@
 package paramtable
 
 import (
+	"path"
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/config"
@@ -139,6 +140,15 @@ func TestServiceParam(t *testing.T) {
 		}
 	})
 
+	t.Run("test localStorageConfig", func(t *testing.T) {
+		Params := &SParams.LocalStorageCfg
+
+		assert.False(t, Params.CacheEnabled.GetAsBool())
+		assert.Equal(t, path.Join(Params.Path.GetValue(), "cache"), Params.CachePath.GetValue())
+		assert.Equal(t, int64(10240), Params.CacheCapacity.GetAsInt64())
+		assert.Equal(t, "lru", Params.CachePolicy.GetValue())
+	})
+
 	t.Run("test minioConfig", func(t *testing.T) {
 		Params := &SParams.MinioCfg
 
@@ -163,4 +173,22 @@ func TestServiceParam(t *testing.T) {
 
 		t.Logf("Minio rootpath = %s", Params.RootPath.GetValue())
 	})
+
+	t.Run("test azureConfig", func(t *testing.T) {
+		Params := &SParams.AzureCfg
+
+		assert.Equal(t, "devstoreaccount1", Params.AccountName.GetValue())
+
+		assert.NotEmpty(t, Params.AccountKey.GetValue())
+
+		assert.Equal(t, false, Params.UseManagedIdentity.GetAsBool())
+
+		assert.Equal(t, "", Params.ManagedIdentityClientID.GetValue())
+
+		t.Logf("Azure endpoint = %s", Params.Endpoint.GetValue())
+
+		t.Logf("Azure ContainerName = %s", Params.ContainerName.GetValue())
+
+		t.Logf("Azure rootpath = %s", Params.RootPath.GetValue())
+	})
 }
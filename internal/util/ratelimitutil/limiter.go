@@ -103,6 +103,21 @@ func (lim *Limiter) AllowN(now time.Time, n int) bool {
 	return ok
 }
 
+// Cancel gives the n tokens taken by AllowN back to the limiter.
+// A Limiter with zero Limit never refills, with Cancel it works as a semaphore of size b.
+func (lim *Limiter) Cancel(n int) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+
+	if lim.limit == Inf {
+		return
+	} else if lim.limit == 0 {
+		lim.burst += float64(n)
+		return
+	}
+	lim.tokens += float64(n)
+}
+
 // SetLimit sets a new Limit for the limiter.
 func (lim *Limiter) SetLimit(newLimit Limit) {
 	lim.mu.Lock()
@@ -170,6 +170,22 @@ func TestLimit(t *testing.T) {
 			t.Errorf("Limit(0, 1) want false when already used")
 		}
 	})
+
+	t.Run("test cancel", func(t *testing.T) {
+		lim := NewLimiter(10, 10)
+		run(t, lim, []allow{{t0, 15, true, -5}})
+		lim.Cancel(15)
+		run(t, lim, []allow{{t0, 10, true, 0}})
+
+		r := NewLimiter(0, 1)
+		if !r.AllowN(time.Now(), 1) {
+			t.Errorf("Limit(0, 1) want true when first used")
+		}
+		r.Cancel(1)
+		if !r.AllowN(time.Now(), 1) {
+			t.Errorf("Limit(0, 1) want true after canceled")
+		}
+	})
 }
 
 // testTime is a fake time used for testing.
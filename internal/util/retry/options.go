@@ -17,6 +17,7 @@ type config struct {
 	attempts     uint
 	sleep        time.Duration
 	maxSleepTime time.Duration
+	jitter       float64
 }
 
 func newDefaultConfig() *config {
@@ -59,3 +60,16 @@ func MaxSleepTime(maxSleepTime time.Duration) Option {
 		}
 	}
 }
+
+// Jitter randomizes every retry interval by adding up to @jitter times of it, so that the retries
+// of concurrent callers are spread out. @jitter is in range [0, 1].
+func Jitter(jitter float64) Option {
+	return func(c *config) {
+		if jitter < 0 {
+			jitter = 0
+		} else if jitter > 1 {
+			jitter = 1
+		}
+		c.jitter = jitter
+	}
+}
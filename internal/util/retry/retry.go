@@ -13,6 +13,7 @@ package retry
 
 import (
 	"context"
+	"math/rand"
 	"time"
 
 	"go.uber.org/zap"
@@ -45,8 +46,12 @@ func Do(ctx context.Context, fn func() error, opts ...Option) error {
 				return el
 			}
 
+			sleep := c.sleep
+			if c.jitter > 0 {
+				sleep += time.Duration(rand.Float64() * c.jitter * float64(c.sleep))
+			}
 			select {
-			case <-time.After(c.sleep):
+			case <-time.After(sleep):
 			case <-ctx.Done():
 				el = append(el, ctx.Err())
 				return el
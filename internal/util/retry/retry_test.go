@@ -73,6 +73,22 @@ func TestSleep(t *testing.T) {
 	fmt.Println(err)
 }
 
+func TestJitter(t *testing.T) {
+	ctx := context.Background()
+
+	testFn := func() error {
+		return fmt.Errorf("some error")
+	}
+
+	start := time.Now()
+	err := Do(ctx, testFn, Attempts(3), Sleep(10*time.Millisecond), Jitter(1))
+	assert.NotNil(t, err)
+	elapsed := time.Since(start)
+	// sleeps 10ms and 20ms, at most doubled by the jitter
+	assert.GreaterOrEqual(t, elapsed, 30*time.Millisecond)
+	assert.Less(t, elapsed, time.Second)
+}
+
 func TestAllError(t *testing.T) {
 	ctx := context.Background()
 
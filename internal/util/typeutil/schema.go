@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/schemapb"
 	"github.com/milvus-io/milvus/internal/common"
@@ -271,6 +272,256 @@ func IsStringType(dataType schemapb.DataType) bool {
 	}
 }
 
+// IsFieldNullable returns true if the field is declared nullable by its type params, otherwise false
+func IsFieldNullable(field *schemapb.FieldSchema) bool {
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() == common.NullableKey {
+			nullable, err := strconv.ParseBool(param.GetValue())
+			return err == nil && nullable
+		}
+	}
+	return false
+}
+
+// IsClusteringKeyField returns true if the field is declared as the clustering key by its type params, otherwise false
+func IsClusteringKeyField(field *schemapb.FieldSchema) bool {
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() == common.ClusteringKey {
+			clustering, err := strconv.ParseBool(param.GetValue())
+			return err == nil && clustering
+		}
+	}
+	return false
+}
+
+// GetClusteringKeyField returns the clustering key field of the schema, nil if the collection has none
+func GetClusteringKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, field := range schema.GetFields() {
+		if IsClusteringKeyField(field) {
+			return field
+		}
+	}
+	return nil
+}
+
+// GetDefaultValue returns the default value of the field declared by its type params, the value is
+// parsed into the go type of field data, ok is false if the field has no default value.
+func GetDefaultValue(field *schemapb.FieldSchema) (value interface{}, ok bool, err error) {
+	var str string
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() == common.DefaultValueKey {
+			str, ok = param.GetValue(), true
+			break
+		}
+	}
+	if !ok {
+		return nil, false, nil
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		value, err = strconv.ParseBool(str)
+	case schemapb.DataType_Int8:
+		var v int64
+		v, err = strconv.ParseInt(str, 10, 8)
+		value = int8(v)
+	case schemapb.DataType_Int16:
+		var v int64
+		v, err = strconv.ParseInt(str, 10, 16)
+		value = int16(v)
+	case schemapb.DataType_Int32:
+		var v int64
+		v, err = strconv.ParseInt(str, 10, 32)
+		value = int32(v)
+	case schemapb.DataType_Int64:
+		value, err = strconv.ParseInt(str, 10, 64)
+	case schemapb.DataType_Float:
+		var v float64
+		v, err = strconv.ParseFloat(str, 32)
+		value = float32(v)
+	case schemapb.DataType_Double:
+		value, err = strconv.ParseFloat(str, 64)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		value = str
+	default:
+		return nil, true, fmt.Errorf("field %s of type %s can not have default value", field.GetName(), field.GetDataType().String())
+	}
+	if err != nil {
+		return nil, true, fmt.Errorf("invalid default value %s for field %s of type %s", str, field.GetName(), field.GetDataType().String())
+	}
+	return value, true, nil
+}
+
+const (
+	// maxVarCharLengthKey is the type param of the max length of a VarChar field
+	maxVarCharLengthKey = "max_length"
+	// maxVarCharLength is the upper bound of the max length of a VarChar field
+	maxVarCharLength = 65535
+)
+
+// ValidateFieldName checks the field name is not longer than maxNameLength, starts with an underscore or letter,
+// and contains only numbers, letters and underscores.
+func ValidateFieldName(fieldName string, maxNameLength int64) error {
+	fieldName = strings.TrimSpace(fieldName)
+
+	if fieldName == "" {
+		return errors.New("field name should not be empty")
+	}
+
+	invalidMsg := "Invalid field name: " + fieldName + ". "
+	if int64(len(fieldName)) > maxNameLength {
+		msg := invalidMsg + "The length of a field name must be less than " +
+			strconv.FormatInt(maxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := fieldName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a field name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	fieldNameSize := len(fieldName)
+	for i := 1; i < fieldNameSize; i++ {
+		c := fieldName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Field name cannot only contain numbers, letters, and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+func isAlpha(c uint8) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+}
+
+func isNumber(c uint8) bool {
+	return '0' <= c && c <= '9'
+}
+
+// ValidateMaxLength checks the max_length type param of a VarChar field is specified and in (0, 65535].
+func ValidateMaxLength(collectionName string, field *schemapb.FieldSchema) error {
+	exist := false
+	for _, param := range field.TypeParams {
+		if param.Key == common.NullableKey || param.Key == common.DefaultValueKey ||
+			param.Key == common.BloomFilterCapacityKey || param.Key == common.BloomFilterFPRKey ||
+			param.Key == common.ClusteringKey {
+			continue
+		}
+		if param.Key != maxVarCharLengthKey {
+			return fmt.Errorf("type param key(max_length) should be specified for varChar field, not %s", param.Key)
+		}
+
+		maxLengthPerRow, err := strconv.ParseInt(param.Value, 10, 64)
+		if err != nil {
+			return err
+		}
+		if maxLengthPerRow > maxVarCharLength || maxLengthPerRow <= 0 {
+			return fmt.Errorf("the maximum length specified for a VarChar shoule be in (0, 65535]")
+		}
+		exist = true
+	}
+	// if not exist type params max_length, return error
+	if !exist {
+		return fmt.Errorf("type param(max_length) should be specified for varChar field of collection %s", collectionName)
+	}
+
+	return nil
+}
+
+// ValidateNullableField checks the nullable type param, only non-primary scalar fields can be nullable.
+func ValidateNullableField(field *schemapb.FieldSchema) error {
+	for _, param := range field.TypeParams {
+		if param.Key != common.NullableKey {
+			continue
+		}
+		if _, err := strconv.ParseBool(param.Value); err != nil {
+			return fmt.Errorf("invalid value %s of type param(nullable) for field %s", param.Value, field.Name)
+		}
+	}
+	if !IsFieldNullable(field) {
+		return nil
+	}
+	if field.IsPrimaryKey {
+		return fmt.Errorf("primary field %s can not be nullable", field.Name)
+	}
+	if IsVectorType(field.DataType) {
+		return fmt.Errorf("vector field %s can not be nullable", field.Name)
+	}
+	return nil
+}
+
+// ValidateDefaultValue checks the default value type param is compatible with the field type,
+// primary key, auto id and vector fields can not have default value.
+func ValidateDefaultValue(field *schemapb.FieldSchema) error {
+	defaultValue, ok, err := GetDefaultValue(field)
+	if !ok {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if field.IsPrimaryKey || field.AutoID {
+		return fmt.Errorf("primary field %s can not have default value", field.Name)
+	}
+	if field.DataType == schemapb.DataType_VarChar {
+		for _, param := range field.TypeParams {
+			if param.Key != maxVarCharLengthKey {
+				continue
+			}
+			maxLength, err := strconv.Atoi(param.Value)
+			if err != nil {
+				return err
+			}
+			if len(defaultValue.(string)) > maxLength {
+				return fmt.Errorf("the length of default value of field %s exceeds max length %d", field.Name, maxLength)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateAddedField checks the field to append to an existing collection, rows inserted before the field
+// existed take its default value or null, so the field must be a nullable or defaulted scalar field.
+func ValidateAddedField(schema *schemapb.CollectionSchema, field *schemapb.FieldSchema, maxNameLength int64) error {
+	if err := ValidateFieldName(field.GetName(), maxNameLength); err != nil {
+		return err
+	}
+	if field.GetName() == common.RowIDFieldName || field.GetName() == common.TimeStampFieldName {
+		return fmt.Errorf("added field %s can not be a system field", field.GetName())
+	}
+	for _, f := range schema.GetFields() {
+		if f.GetName() == field.GetName() {
+			return fmt.Errorf("field %s already exists in collection %s", field.GetName(), schema.GetName())
+		}
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+	default:
+		return fmt.Errorf("only scalar field can be added to collection, field %s is of type %s", field.GetName(), field.GetDataType().String())
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() {
+		return fmt.Errorf("added field %s can not be primary key or auto id", field.GetName())
+	}
+	if IsClusteringKeyField(field) {
+		return fmt.Errorf("added field %s can not be clustering key", field.GetName())
+	}
+	if _, ok, _ := GetDefaultValue(field); !ok && !IsFieldNullable(field) {
+		return fmt.Errorf("added field %s must be nullable or have a default value", field.GetName())
+	}
+	if field.GetDataType() == schemapb.DataType_VarChar {
+		if err := ValidateMaxLength(schema.GetName(), field); err != nil {
+			return err
+		}
+	}
+	if err := ValidateNullableField(field); err != nil {
+		return err
+	}
+	return ValidateDefaultValue(field)
+}
+
 // AppendFieldData appends fields data of specified index from src to dst
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
 	for i, fieldData := range src {
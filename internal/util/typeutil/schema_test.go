@@ -611,6 +611,96 @@ func TestGetPrimaryFieldSchema(t *testing.T) {
 	assert.Equal(t, schemapb.DataType_Int64, primaryField.DataType)
 }
 
+func TestIsFieldNullable(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:  1,
+		Name:     "field",
+		DataType: schemapb.DataType_Int64,
+	}
+	assert.False(t, IsFieldNullable(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+	assert.True(t, IsFieldNullable(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "false"}}
+	assert.False(t, IsFieldNullable(field))
+
+	field.TypeParams = []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "invalid"}}
+	assert.False(t, IsFieldNullable(field))
+}
+
+func TestGetClusteringKeyField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "ts", DataType: schemapb.DataType_Int64},
+		},
+	}
+	assert.Nil(t, GetClusteringKeyField(schema))
+
+	schema.Fields[1].TypeParams = []*commonpb.KeyValuePair{{Key: common.ClusteringKey, Value: "invalid"}}
+	assert.False(t, IsClusteringKeyField(schema.Fields[1]))
+	assert.Nil(t, GetClusteringKeyField(schema))
+
+	schema.Fields[1].TypeParams = []*commonpb.KeyValuePair{{Key: common.ClusteringKey, Value: "true"}}
+	assert.True(t, IsClusteringKeyField(schema.Fields[1]))
+	assert.EqualValues(t, 101, GetClusteringKeyField(schema).GetFieldID())
+}
+
+func TestGetDefaultValue(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "field", DataType: schemapb.DataType_Int64}
+	_, ok, err := GetDefaultValue(field)
+	assert.False(t, ok)
+	assert.NoError(t, err)
+
+	cases := []struct {
+		dataType schemapb.DataType
+		str      string
+		value    interface{}
+	}{
+		{schemapb.DataType_Bool, "true", true},
+		{schemapb.DataType_Int8, "-8", int8(-8)},
+		{schemapb.DataType_Int16, "16", int16(16)},
+		{schemapb.DataType_Int32, "32", int32(32)},
+		{schemapb.DataType_Int64, "64", int64(64)},
+		{schemapb.DataType_Float, "1.5", float32(1.5)},
+		{schemapb.DataType_Double, "2.5", float64(2.5)},
+		{schemapb.DataType_VarChar, "abc", "abc"},
+	}
+	for _, c := range cases {
+		field = &schemapb.FieldSchema{
+			Name:       "field",
+			DataType:   c.dataType,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: c.str}},
+		}
+		value, ok, err := GetDefaultValue(field)
+		assert.True(t, ok)
+		assert.NoError(t, err)
+		assert.Equal(t, c.value, value)
+	}
+
+	invalidCases := []struct {
+		dataType schemapb.DataType
+		str      string
+	}{
+		{schemapb.DataType_Bool, "yes"},
+		{schemapb.DataType_Int8, "128"},
+		{schemapb.DataType_Int64, "1.5"},
+		{schemapb.DataType_Double, "abc"},
+		{schemapb.DataType_FloatVector, "1"},
+	}
+	for _, c := range invalidCases {
+		field = &schemapb.FieldSchema{
+			Name:       "field",
+			DataType:   c.dataType,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: c.str}},
+		}
+		_, ok, err := GetDefaultValue(field)
+		assert.True(t, ok)
+		assert.Error(t, err)
+	}
+}
+
 func TestGetPK(t *testing.T) {
 	type args struct {
 		data *schemapb.IDs
@@ -787,3 +877,104 @@ func TestComparePk(t *testing.T) {
 	less = ComparePKInSlice(strPks, 2, 1)
 	assert.False(t, less)
 }
+
+func TestValidateNullableField(t *testing.T) {
+	nullableParams := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+
+	assert.NoError(t, ValidateNullableField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64}))
+	assert.NoError(t, ValidateNullableField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Double, TypeParams: nullableParams}))
+	assert.NoError(t, ValidateNullableField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "false"}}}))
+
+	// invalid value
+	assert.Error(t, ValidateNullableField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "yes"}}}))
+	// primary key
+	assert.Error(t, ValidateNullableField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: nullableParams}))
+	// vector
+	assert.Error(t, ValidateNullableField(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_BinaryVector, TypeParams: nullableParams}))
+
+	// nullable param is allowed for varchar field
+	assert.NoError(t, ValidateMaxLength("coll", &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: maxVarCharLengthKey, Value: "10"}, {Key: common.NullableKey, Value: "true"}}}))
+}
+
+func TestValidateDefaultValue(t *testing.T) {
+	defaultParams := func(value string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: value}}
+	}
+
+	assert.NoError(t, ValidateDefaultValue(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64}))
+	assert.NoError(t, ValidateDefaultValue(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int16, TypeParams: defaultParams("10")}))
+	assert.NoError(t, ValidateDefaultValue(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_VarChar,
+		TypeParams: append(defaultParams("abc"), &commonpb.KeyValuePair{Key: maxVarCharLengthKey, Value: "3"})}))
+
+	// type mismatch
+	assert.Error(t, ValidateDefaultValue(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int8, TypeParams: defaultParams("1000")}))
+	assert.Error(t, ValidateDefaultValue(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Bool, TypeParams: defaultParams("abc")}))
+	// exceeds max length
+	assert.Error(t, ValidateDefaultValue(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_VarChar,
+		TypeParams: append(defaultParams("abcd"), &commonpb.KeyValuePair{Key: maxVarCharLengthKey, Value: "3"})}))
+	// primary key and vector
+	assert.Error(t, ValidateDefaultValue(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: defaultParams("1")}))
+	assert.Error(t, ValidateDefaultValue(&schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector, TypeParams: defaultParams("1")}))
+
+	// default value param is allowed for varchar field
+	assert.NoError(t, ValidateMaxLength("coll", &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_VarChar,
+		TypeParams: append(defaultParams("a"), &commonpb.KeyValuePair{Key: maxVarCharLengthKey, Value: "10"})}))
+}
+
+func TestValidateAddedField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	nullable := []*commonpb.KeyValuePair{{Key: common.NullableKey, Value: "true"}}
+
+	assert.NoError(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int32, TypeParams: nullable}, 255))
+	assert.NoError(t, ValidateAddedField(schema, &schemapb.FieldSchema{
+		Name:     "name",
+		DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: maxVarCharLengthKey, Value: "8"},
+			{Key: common.DefaultValueKey, Value: "unknown"},
+		},
+	}, 255))
+
+	// invalid name
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "1age", DataType: schemapb.DataType_Int32, TypeParams: nullable}, 255))
+	// duplicated name
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int32, TypeParams: nullable}, 255))
+	// vector field
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: nullable}, 255))
+	// neither nullable nor defaulted
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int32}, 255))
+	// primary key
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "age", IsPrimaryKey: true, DataType: schemapb.DataType_Int32, TypeParams: nullable}, 255))
+	// invalid default value
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{
+		Name:       "age",
+		DataType:   schemapb.DataType_Int32,
+		TypeParams: []*commonpb.KeyValuePair{{Key: common.DefaultValueKey, Value: "a"}},
+	}, 255))
+	// varchar without max length
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "name", DataType: schemapb.DataType_VarChar, TypeParams: nullable}, 255))
+	// varchar default value longer than max length
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{
+		Name:     "name",
+		DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: maxVarCharLengthKey, Value: "4"},
+			{Key: common.DefaultValueKey, Value: "unknown"},
+		},
+	}, 255))
+	// system field
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64, TypeParams: nullable}, 255))
+	// clustering key
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int32,
+		TypeParams: append(nullable, &commonpb.KeyValuePair{Key: common.ClusteringKey, Value: "true"})}, 255))
+	// name too long
+	assert.Error(t, ValidateAddedField(schema, &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int32, TypeParams: nullable}, 2))
+}